@@ -79,8 +79,10 @@ const (
 	details response = "details"
 )
 
+// PreviewThenPrompt previews the operation and then asks the user whether to proceed with it. If the user allows the
+// replacement or deletion of resources guarded by the project's replacementGuard, op is updated to allow them.
 func PreviewThenPrompt(ctx context.Context, kind apitype.UpdateKind, stack Stack,
-	op UpdateOperation, apply Applier,
+	op *UpdateOperation, apply Applier,
 ) (*deploy.Plan, sdkDisplay.ResourceChanges, result.Result) {
 	// create a channel to hear about the update events from the engine. this will be used so that
 	// we can build up the diff display in case the user asks to see the details of the diff
@@ -117,7 +119,7 @@ func PreviewThenPrompt(ctx context.Context, kind apitype.UpdateKind, stack Stack
 		ShowLink: true,
 	}
 
-	plan, changes, res := apply(ctx, kind, stack, op, opts, eventsChannel)
+	plan, changes, res := apply(ctx, kind, stack, *op, opts, eventsChannel)
 	if res != nil {
 		close(eventsChannel)
 		return plan, changes, res
@@ -157,6 +159,15 @@ func PreviewThenPrompt(ctx context.Context, kind apitype.UpdateKind, stack Stack
 		fmt.Print("\n")
 	}
 
+	// Ask the user to allow the replacement or deletion of any resources guarded by the project's replacementGuard.
+	if guarded := guardedResources(events, op.Opts.Engine.ReplacementGuard); len(guarded) != 0 {
+		if err := confirmGuardedResources(kind, guarded, op.Opts); err != nil {
+			close(eventsChannel)
+			return nil, changes, result.WrapIfNonNil(err)
+		}
+		op.Opts.Engine.ReplacementGuard = op.Opts.Engine.ReplacementGuard.Allow(guarded...)
+	}
+
 	// Otherwise, ensure the user wants to proceed.
 	plan, err := confirmBeforeUpdating(kind, stack, events, plan, op.Opts)
 	close(eventsChannel)
@@ -229,6 +240,65 @@ func confirmBeforeUpdating(kind apitype.UpdateKind, stack Stack,
 	}
 }
 
+// guardedResources returns the URNs of the resources whose replacement or deletion in the given preview events is
+// blocked by the given replacement guard.
+func guardedResources(events []engine.Event, guard *deploy.ReplacementGuard) []resource.URN {
+	if guard == nil {
+		return nil
+	}
+
+	var urns []resource.URN
+	seen := map[resource.URN]bool{}
+	for _, e := range events {
+		if e.Type != engine.ResourcePreEvent {
+			continue
+		}
+		p, ok := e.Payload().(engine.ResourcePreEventPayload)
+		if !ok {
+			continue
+		}
+		var inputs resource.PropertyMap
+		if p.Metadata.Old != nil && p.Metadata.Old.State != nil {
+			inputs = p.Metadata.Old.State.Inputs
+		}
+		if guard.Blocks(p.Metadata.Op, p.Metadata.URN, inputs) && !seen[p.Metadata.URN] {
+			seen[p.Metadata.URN] = true
+			urns = append(urns, p.Metadata.URN)
+		}
+	}
+	return urns
+}
+
+// confirmGuardedResources asks the user whether to allow the replacement or deletion of the given guarded resources.
+// A nil error means yes.
+func confirmGuardedResources(kind apitype.UpdateKind, urns []resource.URN, opts UpdateOptions) error {
+	warningPrefix := opts.Display.Color.Colorize(colors.SpecWarning + "warning: " + colors.Reset)
+	fmt.Printf("%sThis %s will replace or delete %d resource(s) guarded by the project's replacementGuard:\n",
+		warningPrefix, kind, len(urns))
+	for _, urn := range urns {
+		fmt.Printf("    - %s\n", urn)
+	}
+	fmt.Print("\n")
+
+	surveycore.DisableColor = true
+	surveyIcons := survey.WithIcons(func(icons *survey.IconSet) {
+		icons.Question = survey.Icon{}
+	})
+
+	confirm := false
+	prompt := "\b" + opts.Display.Color.Colorize(
+		colors.SpecPrompt+"Do you want to allow the replacement or deletion of these resources?"+colors.Reset)
+	if err := survey.AskOne(&survey.Confirm{
+		Message: prompt,
+	}, &confirm, surveyIcons); err != nil {
+		return fmt.Errorf("confirmation cancelled, not proceeding with the %s: %w", kind, err)
+	}
+	if !confirm {
+		return result.FprintBailf(os.Stdout, "confirmation declined, not proceeding with the %s", kind)
+	}
+	return nil
+}
+
 func PreviewThenPromptThenExecute(ctx context.Context, kind apitype.UpdateKind, stack Stack,
 	op UpdateOperation, apply Applier,
 ) (sdkDisplay.ResourceChanges, result.Result) {
@@ -244,7 +314,7 @@ func PreviewThenPromptThenExecute(ctx context.Context, kind apitype.UpdateKind,
 			originalPlan = op.Opts.Engine.Plan.Clone()
 		}
 
-		plan, changes, res := PreviewThenPrompt(ctx, kind, stack, op, apply)
+		plan, changes, res := PreviewThenPrompt(ctx, kind, stack, &op, apply)
 		if res != nil || kind == apitype.PreviewUpdate {
 			return changes, res
 		}
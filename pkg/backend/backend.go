@@ -393,12 +393,31 @@ func (c *backendClient) GetStackResourceOutputs(
 	return pm, nil
 }
 
+// GetStackDependents returns the stacks that read the outputs of the stack with the given name, mapped to the names of
+// the outputs that each of them read.
+func (c *backendClient) GetStackDependents(
+	ctx context.Context, name string,
+) (map[string][]resource.PropertyKey, error) {
+	ref, err := c.backend.ParseStackReference(name)
+	if err != nil {
+		return nil, err
+	}
+	dependents, err := GetStackDependents(ctx, c.backend, ref)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]resource.PropertyKey, len(dependents))
+	for _, d := range dependents {
+		result[d.Stack.String()] = d.Outputs
+	}
+	return result, nil
+}
+
 // ErrTeamsNotSupported is returned by backends
 // which do not support the teams feature.
 var ErrTeamsNotSupported = errors.New("teams are not supported")
 
 // CreateStackOptions provides options for stack creation.
-// At present, options only apply to the Service.
 type CreateStackOptions struct {
 	// Teams is a list of teams who should have access to
 	// the newly created stack.
@@ -408,4 +427,8 @@ type CreateStackOptions struct {
 	// The backend may return ErrTeamsNotSupported
 	// if Teams is specified but not supported.
 	Teams []string
+
+	// Tags are tags to set on the newly created stack,
+	// in addition to those derived from the environment.
+	Tags map[apitype.StackTagName]string
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/secrets"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 )
@@ -121,3 +122,16 @@ func liveState(typ, name string, outs resource.PropertyMap) *resource.State {
 		Delete: false, Type: tokens.Type(typ), URN: testURN(typ, name), Outputs: outs,
 	}
 }
+
+//nolint:paralleltest // mutates environment variables
+func TestGetEnvironmentTagsForCurrentStackNoCI(t *testing.T) {
+	t.Setenv("PULUMI_DISABLE_CI_DETECTION", "")
+	t.Setenv("PULUMI_CI_SYSTEM", "generic-ci-system")
+	t.Setenv("PULUMI_CI_BUILD_ID", "123")
+
+	// CI metadata is only added as stack tags by the DIY backend.
+	tags, err := GetEnvironmentTagsForCurrentStack("", nil, nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, tags, apitype.CISystemTag)
+	assert.NotContains(t, tags, apitype.CIBuildIDTag)
+}
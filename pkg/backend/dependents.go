@@ -0,0 +1,162 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// StackDependent is a stack that reads the outputs of another stack through stack references.
+type StackDependent struct {
+	// Stack is the dependent stack.
+	Stack StackReference
+	// Outputs are the names of the outputs that the dependent stack read.
+	Outputs []resource.PropertyKey
+}
+
+// stackDependentsParallelism is the number of stacks that GetStackDependents reads at a time.
+const stackDependentsParallelism = 8
+
+// GetStackDependents returns the stacks in the organization of the given stack whose latest deployment records that
+// they read the outputs of the stack. Stacks that cannot be read are skipped with a warning. The dependents are sorted
+// by name.
+func GetStackDependents(ctx context.Context, b Backend, stackRef StackReference) ([]StackDependent, error) {
+	// Stack references can refer to stacks in other projects, but only within the same organization, so there is no
+	// need to read the deployments of every stack that the user can see.
+	var filter ListStacksFilter
+	if parts := strings.Split(string(stackRef.FullyQualifiedName()), "/"); len(parts) == 3 {
+		filter.Organization = &parts[0]
+	}
+
+	var refs []StackReference
+	var inContToken ContinuationToken
+	for {
+		summaries, outContToken, err := b.ListStacks(ctx, filter, inContToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, summary := range summaries {
+			if ref := summary.Name(); ref.FullyQualifiedName() != stackRef.FullyQualifiedName() {
+				refs = append(refs, ref)
+			}
+		}
+
+		if outContToken == nil {
+			break
+		}
+		inContToken = outContToken
+	}
+
+	// Each stack's deployment has to be read in full, so read several at once.
+	outputs := make([][]resource.PropertyKey, len(refs))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(stackDependentsParallelism)
+	for i, ref := range refs {
+		i, ref := i, ref
+		g.Go(func() error {
+			names, err := readStackOutputNames(gctx, b, ref, stackRef)
+			if err != nil {
+				if gctx.Err() != nil {
+					return gctx.Err()
+				}
+				// One stack that can't be read, e.g. because of its permissions, shouldn't hide the others.
+				logging.Warningf("skipping stack %v: %v", ref, err)
+				return nil
+			}
+			outputs[i] = names
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var dependents []StackDependent
+	for i, ref := range refs {
+		if outputs[i] != nil {
+			dependents = append(dependents, StackDependent{Stack: ref, Outputs: outputs[i]})
+		}
+	}
+	sort.Slice(dependents, func(i, j int) bool {
+		return dependents[i].Stack.String() < dependents[j].Stack.String()
+	})
+	return dependents, nil
+}
+
+// readStackOutputNames returns the names of the outputs of the target stack that were read by the given stack, or nil
+// if it doesn't read the target stack. The deployment is read without decrypting it, as only the names of the outputs
+// are required.
+func readStackOutputNames(
+	ctx context.Context, b Backend, ref StackReference, target StackReference,
+) ([]resource.PropertyKey, error) {
+	s, err := b.GetStack(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+	untyped, err := b.ExportDeployment(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := stack.UnmarshalUntypedDeployment(ctx, untyped)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []resource.PropertyKey
+	seen := map[string]bool{}
+	for _, res := range deployment.Resources {
+		dep := res.StackDependency
+		if res.Delete || dep == nil || qualifyStackName(dep.Stack, ref) != string(target.FullyQualifiedName()) {
+			continue
+		}
+		if outputs == nil {
+			outputs = []resource.PropertyKey{}
+		}
+		for _, k := range dep.Outputs {
+			if !seen[k] {
+				seen[k] = true
+				outputs = append(outputs, resource.PropertyKey(k))
+			}
+		}
+	}
+	sort.Slice(outputs, func(i, j int) bool { return outputs[i] < outputs[j] })
+	return outputs, nil
+}
+
+// qualifyStackName returns the fully qualified name of the stack that a stack reference in the given stack refers to.
+// Stack references may omit the organization and project, in which case they are those of the referring stack.
+func qualifyStackName(name string, ref StackReference) string {
+	parts := strings.Split(name, "/")
+	refParts := strings.Split(string(ref.FullyQualifiedName()), "/")
+	if len(parts) >= 3 || len(refParts) != 3 {
+		return name
+	}
+	if len(parts) == 1 {
+		return fmt.Sprintf("%s/%s/%s", refParts[0], refParts[1], name)
+	}
+	return fmt.Sprintf("%s/%s/%s", parts[0], refParts[1], parts[1])
+}
@@ -0,0 +1,113 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+type dependentsStackSummary struct {
+	ref StackReference
+}
+
+func (s dependentsStackSummary) Name() StackReference   { return s.ref }
+func (s dependentsStackSummary) LastUpdate() *time.Time { return nil }
+func (s dependentsStackSummary) ResourceCount() *int    { return nil }
+
+func TestGetStackDependents(t *testing.T) {
+	t.Parallel()
+
+	newRef := func(project, name string) *MockStackReference {
+		return &MockStackReference{
+			StringV:             project + "/" + name,
+			NameV:               tokens.MustParseStackName(name),
+			ProjectV:            tokens.Name(project),
+			FullyQualifiedNameV: tokens.QName("org/" + project + "/" + name),
+		}
+	}
+	network := newRef("network", "dev")
+	app := newRef("app", "dev")
+	db := newRef("network", "db")
+	other := newRef("other", "dev")
+	private := newRef("private", "dev")
+
+	// The stack dependencies recorded by each stack's resources.
+	deps := map[StackReference][]*apitype.StackDependencyV1{
+		network: nil,
+		app: {
+			{Stack: "org/network/dev", Outputs: []string{"vpcId"}},
+			{Stack: "org/network/dev", Outputs: []string{"subnetIds", "vpcId"}},
+		},
+		// Names without a project refer to stacks in the same project.
+		db: {{Stack: "dev", Outputs: []string{"subnetIds"}}, {Stack: "org/dev", Outputs: []string{"subnetIds"}}},
+		// This refers to other/dev, not network/dev.
+		other: {{Stack: "dev", Outputs: []string{"vpcId"}}},
+	}
+
+	be := &MockBackend{
+		ListStacksF: func(_ context.Context, filter ListStacksFilter, _ ContinuationToken) (
+			[]StackSummary, ContinuationToken, error,
+		) {
+			// Only the stacks in the organization of the stack can refer to it.
+			require.NotNil(t, filter.Organization)
+			assert.Equal(t, "org", *filter.Organization)
+			return []StackSummary{
+				dependentsStackSummary{network},
+				dependentsStackSummary{app},
+				dependentsStackSummary{db},
+				dependentsStackSummary{other},
+				dependentsStackSummary{private},
+			}, nil, nil
+		},
+		GetStackF: func(ctx context.Context, ref StackReference) (Stack, error) {
+			return &MockStack{RefF: func() StackReference { return ref }}, nil
+		},
+		ExportDeploymentF: func(ctx context.Context, s Stack) (*apitype.UntypedDeployment, error) {
+			// Stacks that can't be read are skipped rather than failing the lookup.
+			if s.Ref() == private {
+				return nil, errors.New("forbidden")
+			}
+			var resources []apitype.ResourceV3
+			for i, dep := range deps[s.Ref()] {
+				resources = append(resources, apitype.ResourceV3{
+					URN:             resource.URN("urn:pulumi:dev::app::pulumi:pulumi:StackReference::ref" + string(rune('a'+i))),
+					Type:            "pulumi:pulumi:StackReference",
+					StackDependency: dep,
+				})
+			}
+			bytes, err := json.Marshal(apitype.DeploymentV3{Resources: resources})
+			require.NoError(t, err)
+			return &apitype.UntypedDeployment{Version: 3, Deployment: bytes}, nil
+		},
+	}
+
+	dependents, err := GetStackDependents(context.Background(), be, network)
+	require.NoError(t, err)
+	assert.Equal(t, []StackDependent{
+		{Stack: app, Outputs: []resource.PropertyKey{"subnetIds", "vpcId"}},
+		{Stack: db, Outputs: []resource.PropertyKey{"subnetIds"}},
+	}, dependents)
+}
@@ -126,6 +126,8 @@ func RenderDiffEvent(event engine.Event, seen map[resource.URN]engine.StepEventM
 		return renderDiffPolicyRemediationEvent(event.Payload().(engine.PolicyRemediationEventPayload), "", true, opts)
 	case engine.PolicyViolationEvent:
 		return renderDiffPolicyViolationEvent(event.Payload().(engine.PolicyViolationEventPayload), "", "", opts)
+	case engine.ResourceHookEvent:
+		return renderDiffResourceHookEvent(event.Payload().(engine.ResourceHookEventPayload), opts)
 
 	default:
 		contract.Failf("unknown event type '%s'", event.Type)
@@ -140,6 +142,19 @@ func renderDiffDiagEvent(payload engine.DiagEventPayload, opts Options) string {
 	return opts.Color.Colorize(payload.Prefix + payload.Message)
 }
 
+// renderResourceHookEvent renders a one line description of a resource hook that has run.
+func renderResourceHookEvent(payload engine.ResourceHookEventPayload) string {
+	if payload.Error != "" {
+		return fmt.Sprintf("%s%s hook '%s' failed%s", colors.SpecError, payload.Event, payload.Hook, colors.Reset)
+	}
+	return fmt.Sprintf("%s%s hook '%s' ran%s", colors.SpecInfo, payload.Event, payload.Hook, colors.Reset)
+}
+
+func renderDiffResourceHookEvent(payload engine.ResourceHookEventPayload, opts Options) string {
+	return opts.Color.Colorize(fmt.Sprintf("    %s  (%s: %s)\n",
+		renderResourceHookEvent(payload), payload.URN.Type(), payload.URN.Name()))
+}
+
 func renderDiffPolicyRemediationEvent(payload engine.PolicyRemediationEventPayload,
 	prefix string, detailed bool, opts Options,
 ) string {
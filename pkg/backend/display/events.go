@@ -106,6 +106,19 @@ func ConvertEngineEvent(e engine.Event, showSecrets bool) (apitype.EngineEvent,
 			After:                after,
 		}
 
+	case engine.ResourceHookEvent:
+		p, ok := e.Payload().(engine.ResourceHookEventPayload)
+		if !ok {
+			return apiEvent, eventTypePayloadMismatch
+		}
+		apiEvent.ResourceHookEvent = &apitype.ResourceHookEvent{
+			URN:   string(p.URN),
+			Op:    apitype.OpType(p.Op),
+			Hook:  p.Hook,
+			Event: string(p.Event),
+			Error: p.Error,
+		}
+
 	case engine.PreludeEvent:
 		p, ok := e.Payload().(engine.PreludeEventPayload)
 		if !ok {
@@ -333,6 +346,16 @@ func ConvertJSONEvent(apiEvent apitype.EngineEvent) (engine.Event, error) {
 			After:             after,
 		})
 
+	case apiEvent.ResourceHookEvent != nil:
+		p := apiEvent.ResourceHookEvent
+		event = engine.NewEvent(engine.ResourceHookEventPayload{
+			URN:   resource.URN(p.URN),
+			Op:    display.StepOp(p.Op),
+			Hook:  p.Hook,
+			Event: resource.ResourceHookEvent(p.Event),
+			Error: p.Error,
+		})
+
 	case apiEvent.PreludeEvent != nil:
 		p := apiEvent.PreludeEvent
 
@@ -182,6 +182,8 @@ func getEventUrnAndMetadata(event engine.Event) (resource.URN, *engine.StepEvent
 		return event.Payload().(engine.PolicyRemediationEventPayload).ResourceURN, nil
 	case engine.PolicyViolationEvent:
 		return event.Payload().(engine.PolicyViolationEventPayload).ResourceURN, nil
+	case engine.ResourceHookEvent:
+		return event.Payload().(engine.ResourceHookEventPayload).URN, nil
 	default:
 		return "", nil
 	}
@@ -1004,6 +1006,8 @@ func (display *ProgressDisplay) processNormalEvent(event engine.Event) {
 	} else if event.Type == engine.PolicyRemediationEvent {
 		// record this remediation so we print it at the end.
 		row.RecordPolicyRemediationEvent(event)
+	} else if event.Type == engine.ResourceHookEvent {
+		row.RecordResourceHookEvent(event)
 	} else {
 		contract.Failf("Unhandled event type '%s'", event.Type)
 	}
@@ -65,6 +65,7 @@ type ResourceRow interface {
 	RecordDiagEvent(diagEvent engine.Event)
 	RecordPolicyViolationEvent(diagEvent engine.Event)
 	RecordPolicyRemediationEvent(diagEvent engine.Event)
+	RecordResourceHookEvent(hookEvent engine.Event)
 }
 
 // Implementation of a Row, used for the header of the grid.
@@ -131,6 +132,7 @@ type resourceRowData struct {
 	diagInfo                  *DiagInfo
 	policyPayloads            []engine.PolicyViolationEventPayload
 	policyRemediationPayloads []engine.PolicyRemediationEventPayload
+	hookPayloads              []engine.ResourceHookEventPayload
 
 	// If this row should be hidden by default.  We will hide unless we have any child nodes
 	// we need to show.
@@ -244,6 +246,12 @@ func (data *resourceRowData) RecordPolicyRemediationEvent(event engine.Event) {
 	data.policyRemediationPayloads = append(data.policyRemediationPayloads, tPayload)
 }
 
+// RecordResourceHookEvent records a resource hook that has run with the resourceRowData.
+func (data *resourceRowData) RecordResourceHookEvent(event engine.Event) {
+	hPayload := event.Payload().(engine.ResourceHookEventPayload)
+	data.hookPayloads = append(data.hookPayloads, hPayload)
+}
+
 type column int
 
 const (
@@ -403,7 +411,16 @@ func (data *resourceRowData) getInfoColumn() string {
 			appendDiagMessage(fmt.Sprintf("%d %s%s%s",
 				c, colors.SpecDebug, english.PluralWord(c, "debug", ""), colors.Reset))
 		}
+		if c := len(data.hookPayloads); c > 0 {
+			appendDiagMessage(fmt.Sprintf("%d %s%s%s",
+				c, colors.SpecInfo, english.PluralWord(c, "hook", ""), colors.Reset))
+		}
 	} else {
+		// Show the last hook that ran, so that long running hooks are visible.
+		if n := len(data.hookPayloads); n > 0 {
+			appendDiagMessage(renderResourceHookEvent(data.hookPayloads[n-1]))
+		}
+
 		// If we're not totally done, and we're in the tree-view, just print out the last error (if
 		// there is one) next to the status message. This is helpful for long running tasks to know
 		// something bad has happened. However, once done, we print the diagnostics at the bottom, so we don't
@@ -50,7 +50,7 @@ func ShowWatchEvents(op string, events <-chan engine.Event, done chan<- bool, op
 			continue
 		// Events occurring early:
 		case engine.PreludeEvent, engine.SummaryEvent, engine.StdoutColorEvent,
-			engine.PolicyLoadEvent, engine.PolicyRemediationEvent:
+			engine.PolicyLoadEvent, engine.PolicyRemediationEvent, engine.ResourceHookEvent:
 			// Ignore it
 			continue
 		case engine.PolicyViolationEvent:
@@ -626,7 +626,7 @@ func (b *diyBackend) ListPolicyPacks(ctx context.Context, orgName string, _ back
 }
 
 func (b *diyBackend) SupportsTags() bool {
-	return false
+	return true
 }
 
 func (b *diyBackend) SupportsOrganizations() bool {
@@ -725,7 +725,21 @@ func (b *diyBackend) CreateStack(ctx context.Context, stackRef backend.StackRefe
 		return nil, err
 	}
 
-	stack := newStack(diyStackRef, b)
+	tags, err := backend.GetEnvironmentTagsForCurrentStack(root, b.currentProject.Load(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting stack tags: %w", err)
+	}
+	addCIMetadataToStackTags(tags)
+	if opts != nil {
+		for k, v := range opts.Tags {
+			tags[k] = v
+		}
+	}
+	if err := b.saveTags(ctx, diyStackRef, tags); err != nil {
+		return nil, err
+	}
+
+	stack := newStack(diyStackRef, b, tags)
 	b.d.Infof(diag.Message("", "Created stack '%s'"), stack.Ref())
 
 	return stack, nil
@@ -745,7 +759,12 @@ func (b *diyBackend) GetStack(ctx context.Context, stackRef backend.StackReferen
 		return nil, err
 	}
 
-	return newStack(diyStackRef, b), nil
+	tags, err := b.getTags(ctx, diyStackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStack(diyStackRef, b, tags), nil
 }
 
 func (b *diyBackend) ListStacks(
@@ -757,8 +776,8 @@ func (b *diyBackend) ListStacks(
 		return nil, nil, err
 	}
 
-	// Note that the provided stack filter is only partially honored, since fields like organizations and tags
-	// aren't persisted in the diy backend.
+	// Note that the provided stack filter is only partially honored, since organizations aren't persisted in the diy
+	// backend.
 	results := slice.Prealloc[backend.StackSummary](len(stacks))
 	for _, stackRef := range stacks {
 		// We can check for project name filter here, but be careful about legacy stores where project is always blank.
@@ -767,6 +786,18 @@ func (b *diyBackend) ListStacks(
 			continue
 		}
 
+		// Tags are stored apart from the checkpoint, so we can check the tag filter before reading it.
+		if filter.TagName != nil {
+			tags, err := b.getTags(ctx, stackRef)
+			if err != nil {
+				return nil, nil, err
+			}
+			value, has := tags[*filter.TagName]
+			if !has || (filter.TagValue != nil && value != *filter.TagValue) {
+				continue
+			}
+		}
+
 		chk, err := b.getCheckpoint(ctx, stackRef)
 		if err != nil {
 			return nil, nil, err
@@ -872,6 +903,18 @@ func (b *diyBackend) renameStack(ctx context.Context, oldRef *diyBackendReferenc
 		return err
 	}
 
+	// Move the tags to the new stack.
+	tags, err := b.getTags(ctx, oldRef)
+	if err != nil {
+		return err
+	}
+	if err := b.saveTags(ctx, newRef, tags); err != nil {
+		return err
+	}
+	if err := b.saveTags(ctx, oldRef, nil); err != nil {
+		return err
+	}
+
 	// To remove the old stack, just make a backup of the file and don't write out anything new.
 	file := b.stackPath(ctx, oldRef)
 	backupTarget(ctx, b.bucket, file, false)
@@ -1045,6 +1088,18 @@ func (b *diyBackend) apply(
 		return nil, nil, result.FromError(err)
 	}
 
+	// Like the service, we use this opportunity to record the latest tags, to pick up any metadata changes.
+	if !opts.DryRun {
+		tags, err := backend.GetMergedStackTags(ctx, stack, op.Root, op.Proj, op.StackConfiguration.Config)
+		if err != nil {
+			return nil, nil, result.FromError(fmt.Errorf("getting stack tags: %w", err))
+		}
+		addCIMetadataToStackTags(tags)
+		if err := b.saveTags(ctx, diyStackRef, tags); err != nil {
+			return nil, nil, result.FromError(err)
+		}
+	}
+
 	// Spawn a display loop to show events on the CLI.
 	displayEvents := make(chan engine.Event)
 	displayDone := make(chan bool)
@@ -1324,8 +1379,18 @@ func (b *diyBackend) getStacks(ctx context.Context) ([]*diyBackendReference, err
 func (b *diyBackend) UpdateStackTags(ctx context.Context,
 	stack backend.Stack, tags map[apitype.StackTagName]string,
 ) error {
-	// The diy backend does not currently persist tags.
-	return errors.New("stack tags not supported in diy mode")
+	diyStackRef, err := b.getReference(stack.Ref())
+	if err != nil {
+		return err
+	}
+
+	err = b.Lock(ctx, diyStackRef)
+	if err != nil {
+		return err
+	}
+	defer b.Unlock(ctx, diyStackRef)
+
+	return b.saveTags(ctx, diyStackRef, tags)
 }
 
 func (b *diyBackend) CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error {
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -1419,3 +1420,112 @@ func TestDisableIntegrityChecking(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, snap)
 }
+
+func TestStackTags(t *testing.T) {
+	t.Parallel()
+
+	stateDir := t.TempDir()
+	ctx := context.Background()
+	b, err := New(ctx, diagtest.LogSink(t), "file://"+filepath.ToSlash(stateDir), nil)
+	require.NoError(t, err)
+	assert.True(t, b.SupportsTags())
+
+	aRef, err := b.ParseStackReference("organization/project/a")
+	require.NoError(t, err)
+	tags := map[apitype.StackTagName]string{apitype.StackExpiresAtTag: "2024-03-21T12:00:00Z"}
+	aStack, err := b.CreateStack(ctx, aRef, "", &backend.CreateStackOptions{Tags: tags})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-21T12:00:00Z", aStack.Tags()[apitype.StackExpiresAtTag])
+
+	// The tags are stored alongside the checkpoint, and the tags file isn't mistaken for a stack.
+	tagsFile := filepath.Join(stateDir, ".pulumi", "stacks", "project", "a.tags")
+	assert.FileExists(t, tagsFile)
+	summaries, _, err := b.ListStacks(ctx, backend.ListStacksFilter{}, nil)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "organization/project/a", summaries[0].Name().String())
+
+	tags = map[apitype.StackTagName]string{"owner": "team-a"}
+	require.NoError(t, b.UpdateStackTags(ctx, aStack, tags))
+	aStack, err = b.GetStack(ctx, aRef)
+	require.NoError(t, err)
+	assert.Equal(t, tags, aStack.Tags())
+
+	// Renaming the stack moves its tags.
+	bRef, err := b.RenameStack(ctx, aStack, "organization/project/b")
+	require.NoError(t, err)
+	assert.NoFileExists(t, tagsFile)
+	bStack, err := b.GetStack(ctx, bRef)
+	require.NoError(t, err)
+	assert.Equal(t, tags, bStack.Tags())
+
+	// Removing the stack removes its tags.
+	_, err = b.RemoveStack(ctx, bStack, false)
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(stateDir, ".pulumi", "stacks", "project", "b.tags"))
+}
+
+func TestListStacksTagFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b, err := newDIYBackend(ctx, diagtest.LogSink(t), "file://"+filepath.ToSlash(t.TempDir()),
+		&workspace.Project{Name: "testproj", Runtime: workspace.NewProjectRuntimeInfo("nodejs", nil)}, nil)
+	require.NoError(t, err)
+
+	for name, tags := range map[string]map[apitype.StackTagName]string{
+		"a": {"env": "dev"},
+		"b": {"env": "prod"},
+		"c": nil,
+	} {
+		ref, err := b.ParseStackReference(name)
+		require.NoError(t, err)
+		s, err := b.CreateStack(ctx, ref, "", &backend.CreateStackOptions{Tags: tags})
+		require.NoError(t, err)
+
+		// Stacks are created with the tags of the current project.
+		assert.Equal(t, "testproj", s.Tags()[apitype.ProjectNameTag])
+		assert.Equal(t, "nodejs", s.Tags()[apitype.ProjectRuntimeTag])
+	}
+
+	list := func(filter backend.ListStacksFilter) []string {
+		summaries, _, err := b.ListStacks(ctx, filter, nil)
+		require.NoError(t, err)
+		var names []string
+		for _, summary := range summaries {
+			names = append(names, summary.Name().Name().String())
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	tagName, tagValue := "env", "prod"
+	assert.Equal(t, []string{"a", "b", "c"}, list(backend.ListStacksFilter{}))
+	assert.Equal(t, []string{"a", "b"}, list(backend.ListStacksFilter{TagName: &tagName}))
+	assert.Equal(t, []string{"b"}, list(backend.ListStacksFilter{TagName: &tagName, TagValue: &tagValue}))
+
+	projectTag := apitype.ProjectNameTag
+	assert.Equal(t, []string{"a", "b", "c"}, list(backend.ListStacksFilter{TagName: &projectTag}))
+}
+
+//nolint:paralleltest // mutates environment variables
+func TestAddCIMetadataToStackTags(t *testing.T) {
+	t.Setenv("PULUMI_DISABLE_CI_DETECTION", "")
+	t.Setenv("TRAVIS", "")
+	t.Setenv("GITHUB_ACTIONS", "")
+	t.Setenv("PULUMI_CI_SYSTEM", "generic-ci-system")
+	t.Setenv("PULUMI_CI_BUILD_ID", "123")
+	t.Setenv("PULUMI_CI_BUILD_URL", "")
+	t.Setenv("PULUMI_CI_PULL_REQUEST_SHA", "")
+
+	tags := map[apitype.StackTagName]string{}
+	addCIMetadataToStackTags(tags)
+	assert.Equal(t, "generic-ci-system", tags[apitype.CISystemTag])
+	assert.Equal(t, "123", tags[apitype.CIBuildIDTag])
+	assert.NotContains(t, tags, apitype.CIBuildURLTag)
+
+	t.Setenv("PULUMI_DISABLE_CI_DETECTION", "true")
+	tags = map[apitype.StackTagName]string{}
+	addCIMetadataToStackTags(tags)
+	assert.NotContains(t, tags, apitype.CISystemTag)
+}
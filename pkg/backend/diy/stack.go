@@ -41,16 +41,19 @@ type diyStack struct {
 	// a snapshot representing the latest deployment state, allocated on first use. It's valid for the
 	// snapshot itself to be nil.
 	snapshot atomic.Pointer[*deploy.Snapshot]
+	// the stack's tags, as read from its tags file.
+	tags map[apitype.StackTagName]string
 	// a pointer to the backend this stack belongs to.
 	b *diyBackend
 }
 
-func newStack(ref *diyBackendReference, b *diyBackend) backend.Stack {
+func newStack(ref *diyBackendReference, b *diyBackend, tags map[apitype.StackTagName]string) backend.Stack {
 	contract.Requiref(ref != nil, "ref", "ref was nil")
 
 	return &diyStack{
-		ref: ref,
-		b:   b,
+		ref:  ref,
+		tags: tags,
+		b:    b,
 	}
 }
 
@@ -69,7 +72,7 @@ func (s *diyStack) Snapshot(ctx context.Context, secretsProvider secrets.Provide
 	return snap, nil
 }
 func (s *diyStack) Backend() backend.Backend              { return s.b }
-func (s *diyStack) Tags() map[apitype.StackTagName]string { return nil }
+func (s *diyStack) Tags() map[apitype.StackTagName]string { return s.tags }
 
 func (s *diyStack) Remove(ctx context.Context, force bool) (bool, error) {
 	return backend.RemoveStack(ctx, s, force)
@@ -16,6 +16,7 @@ package diy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -39,6 +40,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/ciutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
@@ -328,10 +330,80 @@ func (b *diyBackend) removeStack(ctx context.Context, ref *diyBackendReference)
 	file := b.stackPath(ctx, ref)
 	backupTarget(ctx, b.bucket, file, false)
 
+	if err := b.saveTags(ctx, ref, nil); err != nil {
+		return err
+	}
+
 	historyDir := ref.HistoryDir()
 	return removeAllByPrefix(ctx, b.bucket, historyDir)
 }
 
+// tagsPath returns the path to the file, alongside the stack's checkpoint, where the stack's tags are stored. Because
+// ".tags" is not a checkpoint extension, the file is never mistaken for a stack.
+func tagsPath(ref *diyBackendReference) string {
+	return filepath.ToSlash(ref.StackBasePath()) + ".tags"
+}
+
+// getTags reads the tags of the given stack. A stack without a tags file has no tags.
+func (b *diyBackend) getTags(ctx context.Context, ref *diyBackendReference) (map[apitype.StackTagName]string, error) {
+	byts, err := b.bucket.ReadAll(ctx, tagsPath(ref))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading tags of stack %s: %w", ref, err)
+	}
+
+	var tags map[apitype.StackTagName]string
+	if err := json.Unmarshal(byts, &tags); err != nil {
+		return nil, fmt.Errorf("parsing tags of stack %s: %w", ref, err)
+	}
+	return tags, nil
+}
+
+// saveTags replaces the tags of the given stack, removing its tags file if there are none.
+func (b *diyBackend) saveTags(ctx context.Context, ref *diyBackendReference,
+	tags map[apitype.StackTagName]string,
+) error {
+	file := tagsPath(ref)
+	if len(tags) == 0 {
+		if err := b.bucket.Delete(ctx, file); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return fmt.Errorf("removing tags of stack %s: %w", ref, err)
+		}
+		return nil
+	}
+
+	byts, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("marshalling tags of stack %s: %w", ref, err)
+	}
+	if err := b.bucket.WriteAll(ctx, file, byts, nil); err != nil {
+		return fmt.Errorf("writing tags of stack %s: %w", ref, err)
+	}
+	return nil
+}
+
+// addCIMetadataToStackTags detects whether we are running in a CI system, and if so adds the CI build's metadata as
+// stack tags. The service records this metadata with each update rather than as stack tags, so only the DIY backend
+// adds it.
+func addCIMetadataToStackTags(tags map[apitype.StackTagName]string) {
+	vars := ciutil.DetectVars()
+	if vars.Name == "" {
+		return
+	}
+
+	tags[apitype.CISystemTag] = string(vars.Name)
+	for tag, value := range map[apitype.StackTagName]string{
+		apitype.CIBuildIDTag:  vars.BuildID,
+		apitype.CIBuildURLTag: vars.BuildURL,
+		apitype.CIPRNumberTag: vars.PRNumber,
+	} {
+		if value != "" {
+			tags[tag] = value
+		}
+	}
+}
+
 // backupTarget makes a backup of an existing file, in preparation for writing a new one.
 func backupTarget(ctx context.Context, bucket Bucket, file string, keepOriginal bool) string {
 	contract.Requiref(file != "", "file", "must not be empty")
@@ -0,0 +1,96 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// StackExpiryTag returns the value of the expiry tag of a stack that lives for the given duration from now.
+func StackExpiryTag(now time.Time, ttl time.Duration) string {
+	return now.Add(ttl).UTC().Format(time.RFC3339)
+}
+
+// StackExpiry returns the time after which a stack with the given tags expires, and false if it doesn't expire.
+func StackExpiry(tags map[apitype.StackTagName]string) (time.Time, bool, error) {
+	value, has := tags[apitype.StackExpiresAtTag]
+	if !has {
+		return time.Time{}, false, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s tag %q: %w", apitype.StackExpiresAtTag, value, err)
+	}
+	return expiresAt, true, nil
+}
+
+// ExpiredStack is a stack whose expiry has passed.
+type ExpiredStack struct {
+	// Stack is the expired stack.
+	Stack Stack
+	// ExpiresAt is the time after which the stack expired.
+	ExpiresAt time.Time
+}
+
+// GetExpiredStacks returns the stacks in the backend that expired before the given time, sorted by their expiry.
+// Stacks whose expiry tag is not a valid time are skipped with a warning.
+func GetExpiredStacks(ctx context.Context, b Backend, now time.Time) ([]ExpiredStack, error) {
+	tagName := apitype.StackExpiresAtTag
+	filter := ListStacksFilter{TagName: &tagName}
+
+	var expired []ExpiredStack
+	var inContToken ContinuationToken
+	for {
+		summaries, outContToken, err := b.ListStacks(ctx, filter, inContToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range summaries {
+			s, err := b.GetStack(ctx, summary.Name())
+			if err != nil {
+				return nil, fmt.Errorf("reading stack %v: %w", summary.Name(), err)
+			}
+			if s == nil {
+				continue
+			}
+
+			expiresAt, has, err := StackExpiry(s.Tags())
+			if err != nil {
+				logging.Warningf("skipping stack %v: %v", s.Ref(), err)
+				continue
+			}
+			if has && expiresAt.Before(now) {
+				expired = append(expired, ExpiredStack{Stack: s, ExpiresAt: expiresAt})
+			}
+		}
+
+		if outContToken == nil {
+			break
+		}
+		inContToken = outContToken
+	}
+
+	sort.SliceStable(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(expired[j].ExpiresAt)
+	})
+	return expired, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestStackExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 19, 12, 0, 0, 0, time.UTC)
+	tag := StackExpiryTag(now, 48*time.Hour)
+	assert.Equal(t, "2024-03-21T12:00:00Z", tag)
+
+	expiresAt, has, err := StackExpiry(map[apitype.StackTagName]string{apitype.StackExpiresAtTag: tag})
+	require.NoError(t, err)
+	assert.True(t, has)
+	assert.Equal(t, now.Add(48*time.Hour), expiresAt)
+
+	_, has, err = StackExpiry(map[apitype.StackTagName]string{apitype.ProjectNameTag: "proj"})
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	_, _, err = StackExpiry(map[apitype.StackTagName]string{apitype.StackExpiresAtTag: "tomorrow"})
+	assert.ErrorContains(t, err, `invalid pulumi:expiresAt tag "tomorrow"`)
+}
+
+func TestGetExpiredStacks(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 19, 12, 0, 0, 0, time.UTC)
+	tags := map[string]map[apitype.StackTagName]string{
+		"expired-later":   {apitype.StackExpiresAtTag: StackExpiryTag(now, -time.Hour)},
+		"expired-earlier": {apitype.StackExpiresAtTag: StackExpiryTag(now, -48*time.Hour)},
+		"live":            {apitype.StackExpiresAtTag: StackExpiryTag(now, time.Hour)},
+		"invalid":         {apitype.StackExpiresAtTag: "yesterday"},
+		"permanent":       nil,
+	}
+
+	var filter ListStacksFilter
+	be := &MockBackend{
+		ListStacksF: func(_ context.Context, f ListStacksFilter, _ ContinuationToken) (
+			[]StackSummary, ContinuationToken, error,
+		) {
+			filter = f
+			var summaries []StackSummary
+			for name := range tags {
+				summaries = append(summaries, dependentsStackSummary{&MockStackReference{
+					StringV: name,
+					NameV:   tokens.MustParseStackName(name),
+				}})
+			}
+			return summaries, nil, nil
+		},
+		GetStackF: func(_ context.Context, ref StackReference) (Stack, error) {
+			return &MockStack{
+				RefF:  func() StackReference { return ref },
+				TagsF: func() map[apitype.StackTagName]string { return tags[ref.String()] },
+			}, nil
+		},
+	}
+
+	expired, err := GetExpiredStacks(context.Background(), be, now)
+	require.NoError(t, err)
+	require.NotNil(t, filter.TagName)
+	assert.Equal(t, apitype.StackExpiresAtTag, *filter.TagName)
+
+	require.Len(t, expired, 2)
+	assert.Equal(t, "expired-earlier", expired[0].Stack.Ref().String())
+	assert.Equal(t, now.Add(-48*time.Hour), expired[0].ExpiresAt)
+	assert.Equal(t, "expired-later", expired[1].Stack.Ref().String())
+}
@@ -951,6 +951,9 @@ func (b *cloudBackend) CreateStack(
 	if err != nil {
 		return nil, fmt.Errorf("getting stack tags: %w", err)
 	}
+	for k, v := range opts.Tags {
+		tags[k] = v
+	}
 
 	apistack, err := b.client.CreateStack(ctx, stackID, tags, opts.Teams)
 	if err != nil {
@@ -1993,6 +1996,12 @@ func (c httpstateBackendClient) GetStackResourceOutputs(
 	return c.backend.GetStackResourceOutputs(ctx, name)
 }
 
+func (c httpstateBackendClient) GetStackDependents(
+	ctx context.Context, name string,
+) (map[string][]resource.PropertyKey, error) {
+	return c.backend.GetStackDependents(ctx, name)
+}
+
 // Represents feature-detected capabilities of the service the backend is connected to.
 type capabilities struct {
 	// If non-nil, indicates that delta checkpoint updates are supported.
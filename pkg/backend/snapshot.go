@@ -240,6 +240,22 @@ func (ssm *sameSnapshotMutation) mustWrite(step *deploy.SameStep) bool {
 		return true
 	}
 
+	// If the ignoreChanges attribute of this resource has changed, we must write the checkpoint.
+	if len(old.IgnoreChanges) != 0 || len(new.IgnoreChanges) != 0 {
+		if !reflect.DeepEqual(old.IgnoreChanges, new.IgnoreChanges) {
+			logging.V(9).Infof("SnapshotManager: mustWrite() true because of IgnoreChanges")
+			return true
+		}
+	}
+
+	// If the deletion ordering constraints of this resource have changed, we must write the checkpoint.
+	if len(old.DeleteDependencies) != 0 || len(new.DeleteDependencies) != 0 {
+		if !reflect.DeepEqual(old.DeleteDependencies, new.DeleteDependencies) {
+			logging.V(9).Infof("SnapshotManager: mustWrite() true because of DeleteDependencies")
+			return true
+		}
+	}
+
 	// If the protection attribute of this resource has changed, we must write the checkpoint.
 	if old.Protect != new.Protect {
 		logging.V(9).Infof("SnapshotManager: mustWrite() true because of Protect")
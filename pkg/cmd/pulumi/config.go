@@ -15,6 +15,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -120,6 +121,7 @@ func newConfigCmd() *cobra.Command {
 	cmd.AddCommand(newConfigSetAllCmd(&stack))
 	cmd.AddCommand(newConfigRefreshCmd(&stack))
 	cmd.AddCommand(newConfigCopyCmd(&stack))
+	cmd.AddCommand(newConfigDiffCmd(&stack))
 	cmd.AddCommand(newConfigEnvCmd(&stack))
 
 	return cmd
@@ -127,13 +129,16 @@ func newConfigCmd() *cobra.Command {
 
 func newConfigCopyCmd(stack *string) *cobra.Command {
 	var path bool
+	var changedOnly bool
 	var destinationStackName string
 
 	cpCommand := &cobra.Command{
 		Use:   "cp [key]",
 		Short: "Copy config to another stack",
 		Long: "Copies the config from the current stack to the destination stack. If `key` is omitted,\n" +
-			"then all of the config from the current stack will be copied to the destination stack.",
+			"then all of the config from the current stack will be copied to the destination stack.\n\n" +
+			"The `--changed-only` flag can be used to only copy the keys whose values differ between the\n" +
+			"two stacks (as reported by `pulumi config diff`), leaving keys that already match untouched.",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
@@ -171,12 +176,19 @@ func newConfigCopyCmd(stack *string) *cobra.Command {
 
 			// Do we need to copy a single value or the entire map
 			if len(args) > 0 {
+				if changedOnly {
+					return errors.New("--changed-only cannot be used when copying a single key")
+				}
 				// A single key was specified so we only need to copy that specific value
 				return copySingleConfigKey(args[0], path, currentStack, currentProjectStack, destinationStack,
 					destinationProjectStack)
 			}
 
-			requiresSaving, err := copyEntireConfigMap(
+			copyConfig := copyEntireConfigMap
+			if changedOnly {
+				copyConfig = copyChangedConfigKeys
+			}
+			requiresSaving, err := copyConfig(
 				currentStack,
 				currentProjectStack,
 				destinationStack,
@@ -204,6 +216,9 @@ func newConfigCopyCmd(stack *string) *cobra.Command {
 	cpCommand.PersistentFlags().StringVarP(
 		&destinationStackName, "dest", "d", "",
 		"The name of the new stack to copy the config to")
+	cpCommand.PersistentFlags().BoolVar(
+		&changedOnly, "changed-only", false,
+		"Only copy the keys whose values differ between the current and destination stacks")
 
 	return cpCommand
 }
@@ -300,6 +315,82 @@ func copyEntireConfigMap(currentStack backend.Stack,
 	return requiresSaving, nil
 }
 
+// copyChangedConfigKeys is like copyEntireConfigMap, but only copies the keys whose values differ between the two
+// stacks. Keys that already have the same value in the destination stack are left as they are, which avoids
+// needlessly re-encrypting secrets.
+func copyChangedConfigKeys(currentStack backend.Stack,
+	currentProjectStack *workspace.ProjectStack, destinationStack backend.Stack,
+	destinationProjectStack *workspace.ProjectStack,
+) (bool, error) {
+	getDecrypter := func(stack backend.Stack, ps *workspace.ProjectStack) (config.Decrypter, error) {
+		if !ps.Config.HasSecureValue() {
+			return config.NewPanicCrypter(), nil
+		}
+		dec, needsSave, err := getStackDecrypter(stack, ps)
+		if err != nil {
+			return nil, err
+		}
+		contract.Assertf(!needsSave, "We're reading a secure value so the encryption information must be present already")
+		return dec, nil
+	}
+
+	decrypter, err := getDecrypter(currentStack, currentProjectStack)
+	if err != nil {
+		return false, err
+	}
+	destinationDecrypter, err := getDecrypter(destinationStack, destinationProjectStack)
+	if err != nil {
+		return false, err
+	}
+
+	ctx := context.TODO()
+	currentConfig, err := currentProjectStack.Config.AsDecryptedPropertyMap(ctx, decrypter)
+	if err != nil {
+		return false, err
+	}
+	destinationConfig, err := destinationProjectStack.Config.AsDecryptedPropertyMap(ctx, destinationDecrypter)
+	if err != nil {
+		return false, err
+	}
+
+	var requiresSaving bool
+	if diff := destinationConfig.Diff(currentConfig); diff != nil {
+		encrypter, _, err := getStackEncrypter(destinationStack, destinationProjectStack)
+		if err != nil {
+			return false, err
+		}
+
+		for _, k := range diff.ChangedKeys() {
+			// Keys that only exist in the destination stack are not part of the copy.
+			if diff.Deleted(k) {
+				continue
+			}
+
+			key, err := config.ParseKey(string(k))
+			if err != nil {
+				return false, err
+			}
+			val, err := currentProjectStack.Config[key].Copy(decrypter, encrypter)
+			if err != nil {
+				return false, err
+			}
+			if err = destinationProjectStack.Config.Set(key, val, false); err != nil {
+				return false, err
+			}
+			requiresSaving = true
+		}
+	}
+
+	currentEnvironments := currentProjectStack.Environment
+	if currentEnvironments != nil && len(currentEnvironments.Imports()) > 0 &&
+		!bytes.Equal(currentProjectStack.EnvironmentBytes(), destinationProjectStack.EnvironmentBytes()) {
+		destinationProjectStack.Environment = currentEnvironments
+		requiresSaving = true
+	}
+
+	return requiresSaving, nil
+}
+
 func newConfigGetCmd(stack *string) *cobra.Command {
 	var jsonOut bool
 	var open bool
@@ -0,0 +1,394 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/esc"
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newConfigDiffCmd(stack *string) *cobra.Command {
+	var showSecrets bool
+	var jsonOut bool
+	var open bool
+
+	diffCmd := &cobra.Command{
+		Use:   "diff [base-stack] <stack>",
+		Short: "Show the configuration differences between two stacks",
+		Long: "Show the configuration differences between two stacks.\n\n" +
+			"If only one stack is given, the current stack is compared against it. Configuration from\n" +
+			"the project and from any environments imported by the stacks is included in the comparison.\n" +
+			"Keys that are added, removed or changed are reported, and values inside maps and lists are\n" +
+			"compared individually and reported using their property path (e.g. `outer.inner` or `names[0]`).\n\n" +
+			"Secret values are only decrypted and compared when `--show-secrets` is passed; otherwise\n" +
+			"two secret values are always considered equal.",
+		Args: cmdutil.RangeArgs(1, 2),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if stackConfigFile != "" {
+				return errors.New("--config-file cannot be used when comparing the configuration of two stacks")
+			}
+
+			project, _, err := readProject()
+			if err != nil {
+				return err
+			}
+
+			baseName, otherName := *stack, args[0]
+			if len(args) == 2 {
+				baseName, otherName = args[0], args[1]
+			}
+
+			baseStack, err := requireStack(ctx, baseName, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+			otherStack, err := requireStack(ctx, otherName, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+
+			// If --open is explicitly set, use that value. Otherwise, default to true if --show-secrets is set.
+			openEnvironment := showSecrets
+			if cmd.Flags().Changed("open") {
+				openEnvironment = open
+			}
+
+			baseConfig, err := loadResolvedStackConfig(ctx, project, baseStack, showSecrets, openEnvironment)
+			if err != nil {
+				return fmt.Errorf("loading configuration for stack '%s': %w", baseStack.Ref(), err)
+			}
+			otherConfig, err := loadResolvedStackConfig(ctx, project, otherStack, showSecrets, openEnvironment)
+			if err != nil {
+				return fmt.Errorf("loading configuration for stack '%s': %w", otherStack.Ref(), err)
+			}
+
+			diffs := diffConfig(baseConfig, otherConfig)
+			if jsonOut {
+				err = printConfigDiffJSON(os.Stdout, diffs)
+			} else {
+				err = printConfigDiff(os.Stdout, project, baseStack, otherStack, diffs)
+			}
+			if err != nil {
+				return err
+			}
+
+			if showSecrets {
+				log3rdPartySecretsProviderDecryptionEvent(ctx, baseStack, "", "pulumi config diff")
+				log3rdPartySecretsProviderDecryptionEvent(ctx, otherStack, "", "pulumi config diff")
+			}
+			return nil
+		}),
+	}
+
+	diffCmd.Flags().BoolVar(
+		&showSecrets, "show-secrets", false,
+		"Decrypt and compare secret values, and show them in the output")
+	diffCmd.Flags().BoolVar(
+		&open, "open", false,
+		"Open and resolve any environments listed in the stack configuration. "+
+			"Defaults to true if --show-secrets is set, false otherwise")
+	diffCmd.Flags().BoolVarP(
+		&jsonOut, "json", "j", false,
+		"Emit output as JSON")
+
+	return diffCmd
+}
+
+// loadResolvedStackConfig loads the configuration for the given stack, including values that come from the project
+// and from the stack's environment, and returns it as a property map keyed by fully qualified configuration key.
+// Secret values are decrypted if showSecrets is true, and blinded otherwise.
+func loadResolvedStackConfig(
+	ctx context.Context,
+	project *workspace.Project,
+	stack backend.Stack,
+	showSecrets bool,
+	openEnvironment bool,
+) (resource.PropertyMap, error) {
+	ps, err := loadProjectStack(project, stack)
+	if err != nil {
+		return nil, err
+	}
+
+	var env *esc.Environment
+	var diags []apitype.EnvironmentDiagnostic
+	if openEnvironment {
+		env, diags, err = openStackEnv(ctx, stack, ps)
+	} else {
+		env, diags, err = checkStackEnv(ctx, stack, ps)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(diags) != 0 {
+		printESCDiagnostics(os.Stderr, diags)
+		return nil, errors.New("opening environment: too many errors")
+	}
+
+	var pulumiEnv esc.Value
+	var envCrypter config.Encrypter
+	if env != nil {
+		pulumiEnv = env.Properties["pulumiConfig"]
+
+		stackEncrypter, needsSave, err := getStackEncrypter(stack, ps)
+		if err != nil {
+			return nil, err
+		}
+		// This may have setup the stack's secrets provider, so save the stack if needed.
+		if needsSave {
+			if err = saveProjectStack(stack, ps); err != nil {
+				return nil, fmt.Errorf("save stack config: %w", err)
+			}
+		}
+		envCrypter = stackEncrypter
+	}
+
+	cfg, err := ps.Config.Copy(config.NopDecrypter, config.NopEncrypter)
+	if err != nil {
+		return nil, fmt.Errorf("copying config: %w", err)
+	}
+
+	err = workspace.ApplyProjectConfig(stack.Ref().Name().String(), project, pulumiEnv, cfg, envCrypter)
+	if err != nil {
+		return nil, err
+	}
+
+	// By default, we will use a blinding decrypter so that all secrets compare equal. If requested, decrypt them.
+	decrypter := config.NewBlindingDecrypter()
+	if cfg.HasSecureValue() && showSecrets {
+		stackDecrypter, needsSave, err := getStackDecrypter(stack, ps)
+		if err != nil {
+			return nil, err
+		}
+		// This may have setup the stack's secrets provider, so save the stack if needed.
+		if needsSave {
+			if err = saveProjectStack(stack, ps); err != nil {
+				return nil, fmt.Errorf("save stack config: %w", err)
+			}
+		}
+		decrypter = stackDecrypter
+	}
+
+	return cfg.AsDecryptedPropertyMap(ctx, decrypter)
+}
+
+// configDiffKind describes how a configuration value differs between two stacks.
+type configDiffKind string
+
+const (
+	configDiffAdded   configDiffKind = "added"
+	configDiffRemoved configDiffKind = "removed"
+	configDiffChanged configDiffKind = "changed"
+)
+
+// configDiff is a single difference between two sets of configuration. Path addresses the value inside the
+// configuration value for Key, and is empty if the value as a whole differs.
+type configDiff struct {
+	Key  config.Key
+	Path resource.PropertyPath
+	Kind configDiffKind
+	Old  resource.PropertyValue
+	New  resource.PropertyValue
+}
+
+// diffConfig computes the differences between two decrypted configuration maps, as returned by
+// loadResolvedStackConfig. The results are sorted by key and path.
+func diffConfig(base, other resource.PropertyMap) []configDiff {
+	var diffs []configDiff
+	objectDiff := base.Diff(other)
+	if objectDiff == nil {
+		return nil
+	}
+
+	for _, k := range objectDiff.Keys() {
+		key, err := config.ParseKey(string(k))
+		if err != nil {
+			// The keys of a config.Map are always valid, so this can only happen if our caller misused us.
+			continue
+		}
+		diffs = appendObjectKeyDiff(diffs, key, nil, objectDiff, k)
+	}
+	return diffs
+}
+
+func appendObjectKeyDiff(
+	diffs []configDiff, key config.Key, path resource.PropertyPath, diff *resource.ObjectDiff, k resource.PropertyKey,
+) []configDiff {
+	switch {
+	case diff.Added(k):
+		return append(diffs, configDiff{Key: key, Path: path, Kind: configDiffAdded, New: diff.Adds[k]})
+	case diff.Deleted(k):
+		return append(diffs, configDiff{Key: key, Path: path, Kind: configDiffRemoved, Old: diff.Deletes[k]})
+	case diff.Updated(k):
+		return appendValueDiff(diffs, key, path, diff.Updates[k])
+	default:
+		return diffs
+	}
+}
+
+func appendValueDiff(
+	diffs []configDiff, key config.Key, path resource.PropertyPath, diff resource.ValueDiff,
+) []configDiff {
+	switch {
+	case diff.Object != nil:
+		for _, k := range diff.Object.Keys() {
+			diffs = appendObjectKeyDiff(diffs, key, appendPath(path, string(k)), diff.Object, k)
+		}
+	case diff.Array != nil:
+		for i := 0; i < diff.Array.Len(); i++ {
+			elementPath := appendPath(path, i)
+			if v, ok := diff.Array.Adds[i]; ok {
+				diffs = append(diffs, configDiff{Key: key, Path: elementPath, Kind: configDiffAdded, New: v})
+			} else if v, ok := diff.Array.Deletes[i]; ok {
+				diffs = append(diffs, configDiff{Key: key, Path: elementPath, Kind: configDiffRemoved, Old: v})
+			} else if d, ok := diff.Array.Updates[i]; ok {
+				diffs = appendValueDiff(diffs, key, elementPath, d)
+			}
+		}
+	default:
+		diffs = append(diffs, configDiff{Key: key, Path: path, Kind: configDiffChanged, Old: diff.Old, New: diff.New})
+	}
+	return diffs
+}
+
+// appendPath returns a copy of path with the given element appended, so that sibling diffs don't share storage.
+func appendPath(path resource.PropertyPath, element interface{}) resource.PropertyPath {
+	result := make(resource.PropertyPath, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, element)
+}
+
+// displayPath returns the path of a diff, prefixed by the given rendering of its key.
+func (d configDiff) displayPath(key string) string {
+	if len(d.Path) == 0 {
+		return key
+	}
+	path := d.Path.String()
+	if strings.HasPrefix(path, "[") {
+		return key + path
+	}
+	return key + "." + path
+}
+
+// configDiffValue returns a plain Go representation of a configuration value, unwrapping secrets.
+func configDiffValue(v resource.PropertyValue) interface{} {
+	return v.MapRepl(nil, func(v resource.PropertyValue) (interface{}, bool) {
+		if v.IsSecret() {
+			return configDiffValue(v.SecretValue().Element), true
+		}
+		return nil, false
+	})
+}
+
+// formatConfigDiffValue renders a configuration value for display in a table.
+func formatConfigDiffValue(v resource.PropertyValue) (string, error) {
+	switch v := configDiffValue(v).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+func printConfigDiff(
+	stdout io.Writer, project *workspace.Project, base, other backend.Stack, diffs []configDiff,
+) error {
+	if len(diffs) == 0 {
+		fmt.Fprintf(stdout, "No configuration differences between stacks '%s' and '%s'\n",
+			base.Ref().Name(), other.Ref().Name())
+		return nil
+	}
+
+	rows := make([]cmdutil.TableRow, 0, len(diffs))
+	for _, d := range diffs {
+		oldValue, err := formatConfigDiffValue(d.Old)
+		if err != nil {
+			return err
+		}
+		newValue, err := formatConfigDiffValue(d.New)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, cmdutil.TableRow{Columns: []string{
+			d.displayPath(prettyKeyForProject(d.Key, project)),
+			string(d.Kind),
+			oldValue,
+			newValue,
+		}})
+	}
+
+	fprintTable(stdout, cmdutil.Table{
+		Headers: []string{"KEY", "CHANGE", base.Ref().Name().String(), other.Ref().Name().String()},
+		Rows:    rows,
+	}, nil)
+	return nil
+}
+
+// configDiffJSON is the shape of the --json output for a configuration difference.  While we can add fields to this
+// structure in the future, we should not change existing fields.
+type configDiffJSON struct {
+	Key  string `json:"key"`
+	Path string `json:"path,omitempty"`
+	// Kind is one of "added", "removed" or "changed".
+	Kind string `json:"kind"`
+	// When the value is encrypted and --show-secrets was not passed, the values will be "[secret]".
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+	Secret bool        `json:"secret"`
+}
+
+func printConfigDiffJSON(stdout io.Writer, diffs []configDiff) error {
+	entries := make([]configDiffJSON, 0, len(diffs))
+	for _, d := range diffs {
+		entry := configDiffJSON{
+			Key:    d.Key.String(),
+			Kind:   string(d.Kind),
+			Old:    configDiffValue(d.Old),
+			New:    configDiffValue(d.New),
+			Secret: d.Old.ContainsSecrets() || d.New.ContainsSecrets(),
+		}
+		if len(d.Path) != 0 {
+			entry.Path = d.Path.String()
+		}
+		entries = append(entries, entry)
+	}
+	return fprintJSON(stdout, entries)
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+func mustDecryptedConfig(t *testing.T, m config.Map, decrypter config.Decrypter) resource.PropertyMap {
+	t.Helper()
+
+	pm, err := m.AsDecryptedPropertyMap(context.Background(), decrypter)
+	require.NoError(t, err)
+	return pm
+}
+
+func TestDiffConfig(t *testing.T) {
+	t.Parallel()
+
+	base := mustDecryptedConfig(t, config.Map{
+		config.MustMakeKey("proj", "same"):    config.NewValue("a"),
+		config.MustMakeKey("proj", "changed"): config.NewValue("a"),
+		config.MustMakeKey("proj", "removed"): config.NewValue("a"),
+		config.MustMakeKey("proj", "obj"):     config.NewObjectValue(`{"inner":"a","gone":true,"list":[1,2,3]}`),
+	}, config.NopDecrypter)
+	other := mustDecryptedConfig(t, config.Map{
+		config.MustMakeKey("proj", "same"):    config.NewValue("a"),
+		config.MustMakeKey("proj", "changed"): config.NewValue("b"),
+		config.MustMakeKey("aws", "region"):   config.NewValue("us-west-2"),
+		config.MustMakeKey("proj", "obj"):     config.NewObjectValue(`{"inner":"b","list":[1,4]}`),
+	}, config.NopDecrypter)
+
+	diffs := diffConfig(base, other)
+
+	type summary struct {
+		path string
+		kind configDiffKind
+	}
+	actual := make([]summary, len(diffs))
+	for i, d := range diffs {
+		actual[i] = summary{path: d.displayPath(d.Key.String()), kind: d.Kind}
+	}
+	assert.Equal(t, []summary{
+		{"aws:region", configDiffAdded},
+		{"proj:changed", configDiffChanged},
+		{"proj:obj.gone", configDiffRemoved},
+		{"proj:obj.inner", configDiffChanged},
+		{"proj:obj.list[1]", configDiffChanged},
+		{"proj:obj.list[2]", configDiffRemoved},
+		{"proj:removed", configDiffRemoved},
+	}, actual)
+
+	assert.Equal(t, "a", configDiffValue(diffs[1].Old))
+	assert.Equal(t, "b", configDiffValue(diffs[1].New))
+	assert.Nil(t, configDiffValue(diffs[0].Old))
+}
+
+func TestDiffConfigNoChanges(t *testing.T) {
+	t.Parallel()
+
+	m := config.Map{
+		config.MustMakeKey("proj", "a"): config.NewValue("a"),
+		config.MustMakeKey("proj", "b"): config.NewObjectValue(`{"c":[1,2]}`),
+	}
+	pm := mustDecryptedConfig(t, m, config.NopDecrypter)
+	assert.Empty(t, diffConfig(pm, pm.Copy()))
+}
+
+func TestDiffConfigSecrets(t *testing.T) {
+	t.Parallel()
+
+	base := config.Map{
+		config.MustMakeKey("proj", "password"): config.NewSecureValue("c2VjcmV0MQ=="),
+		config.MustMakeKey("proj", "token"):    config.NewValue("plain"),
+	}
+	other := config.Map{
+		config.MustMakeKey("proj", "password"): config.NewSecureValue("c2VjcmV0Mg=="),
+		config.MustMakeKey("proj", "token"):    config.NewSecureValue("cGxhaW4="),
+	}
+
+	// When blinded, two secrets always compare equal, but a value becoming secret is still a change.
+	blinded := diffConfig(
+		mustDecryptedConfig(t, base, config.NewBlindingDecrypter()),
+		mustDecryptedConfig(t, other, config.NewBlindingDecrypter()))
+	require.Len(t, blinded, 1)
+	assert.Equal(t, config.MustMakeKey("proj", "token"), blinded[0].Key)
+	assert.Equal(t, "[secret]", configDiffValue(blinded[0].New))
+
+	// When decrypted, the secret values themselves are compared.
+	decrypted := diffConfig(
+		mustDecryptedConfig(t, base, config.Base64Crypter),
+		mustDecryptedConfig(t, other, config.Base64Crypter))
+	require.Len(t, decrypted, 2)
+	assert.Equal(t, config.MustMakeKey("proj", "password"), decrypted[0].Key)
+	assert.Equal(t, "secret1", configDiffValue(decrypted[0].Old))
+	assert.Equal(t, "secret2", configDiffValue(decrypted[0].New))
+
+	var buf bytes.Buffer
+	require.NoError(t, printConfigDiffJSON(&buf, decrypted))
+	var entries []configDiffJSON
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entries))
+	require.Len(t, entries, 2)
+	assert.Equal(t, "proj:password", entries[0].Key)
+	assert.Equal(t, "changed", entries[0].Kind)
+	assert.True(t, entries[0].Secret)
+	assert.Equal(t, "proj:token", entries[1].Key)
+	assert.True(t, entries[1].Secret)
+}
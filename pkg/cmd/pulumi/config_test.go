@@ -322,6 +322,36 @@ func TestCopyConfig(t *testing.T) {
 		assert.Contains(t, envImports, "test")
 		assert.NotContains(t, envImports, "test2")
 	})
+
+	t.Run("TestCopyChangedConfigKeys", func(t *testing.T) {
+		destinationStack := getMockStack("mystack3")
+
+		source := workspace.ProjectStack{Config: config.Map{
+			config.MustMakeKey("project", "same"):    config.NewValue("a"),
+			config.MustMakeKey("project", "changed"): config.NewValue("new"),
+			config.MustMakeKey("project", "added"):   config.NewValue("b"),
+		}}
+		destination := workspace.ProjectStack{Config: config.Map{
+			config.MustMakeKey("project", "same"):    config.NewValue("a"),
+			config.MustMakeKey("project", "changed"): config.NewValue("old"),
+			config.MustMakeKey("project", "extra"):   config.NewValue("c"),
+		}}
+
+		requiresSaving, err := copyChangedConfigKeys(sourceStack, &source, destinationStack, &destination)
+		require.NoError(t, err)
+		assert.True(t, requiresSaving, "expected config file changes requiring saving")
+		assert.Equal(t, config.Map{
+			config.MustMakeKey("project", "same"):    config.NewValue("a"),
+			config.MustMakeKey("project", "changed"): config.NewValue("new"),
+			config.MustMakeKey("project", "added"):   config.NewValue("b"),
+			config.MustMakeKey("project", "extra"):   config.NewValue("c"),
+		}, destination.Config)
+
+		// Copying again is a no-op.
+		requiresSaving, err = copyChangedConfigKeys(sourceStack, &source, destinationStack, &destination)
+		require.NoError(t, err)
+		assert.False(t, requiresSaving, "expected no config file changes")
+	})
 }
 
 func TestOpenStackEnvDiags(t *testing.T) {
@@ -109,7 +109,11 @@ func newConvertCmd() *cobra.Command {
 			"\n" +
 			"The source program to convert will default to the current working directory.\n" +
 			"\n" +
-			"Valid source languages: yaml, terraform, bicep, arm, kubernetes\n" +
+			"Valid source languages: yaml, terraform, bicep, arm, kubernetes, pcl, program\n" +
+			"\n" +
+			"The 'program' source converts the Pulumi program in the current directory, in any language, by\n" +
+			"previewing it and recording the resources that it declares. Logic that cannot be represented,\n" +
+			"such as values computed from the outputs of resources, is reported as a warning.\n" +
 			"\n" +
 			"Valid target languages: typescript, python, csharp, go, java, yaml" +
 			"\n" +
@@ -360,6 +364,16 @@ func runConvert(
 			return fmt.Errorf("remove temporary directory: %w", err)
 		}
 		pclDirectory = cwd
+	} else if from == "program" {
+		// The source code is a Pulumi program in any language, run it to record the resources it declares.
+		diagnostics, err := convertProgramToPCL(pCtx, cwd, pclDirectory, loader)
+		if err != nil {
+			return err
+		}
+		printDiagnostics(pCtx.Diag, diagnostics)
+		if diagnostics.HasErrors() {
+			return errors.New("conversion failed")
+		}
 	} else {
 		converter, err := loadConverterPlugin(pCtx, from, log)
 		if err != nil {
@@ -0,0 +1,169 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/importer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// convertStackName is the name of the stack that programs are run in when they are converted to PCL.
+const convertStackName = "dev"
+
+// programConfigKeys returns the keys of the config variables in the project's namespace that are declared by the
+// project or set by any of its stacks.
+func programConfigKeys(proj *workspace.Project, root string) ([]config.Key, error) {
+	keys := map[config.Key]bool{}
+	for name, typ := range proj.Config {
+		if typ.Type != nil && *typ.Type != "string" {
+			continue
+		}
+		key, err := config.ParseKey(name)
+		if err != nil {
+			key = config.MustMakeKey(string(proj.Name), name)
+		}
+		keys[key] = true
+	}
+
+	paths, err := filepath.Glob(filepath.Join(root, "Pulumi.*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		stack, err := workspace.LoadProjectStack(proj, path)
+		if err != nil {
+			return nil, fmt.Errorf("loading stack configuration %s: %w", path, err)
+		}
+		for key, v := range stack.Config {
+			if !v.Object() {
+				keys[key] = true
+			}
+		}
+	}
+
+	result := make([]config.Key, 0, len(keys))
+	for key := range keys {
+		if key.Namespace() == string(proj.Name) {
+			result = append(result, key)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].String() < result[j].String() })
+	return result, nil
+}
+
+// convertProgramToPCL converts the Pulumi program in the current directory to PCL by running a preview of it against
+// a resource monitor that records its config, resources, invokes and stack outputs. The program is written to
+// main.pp in pclDirectory. The returned diagnostics describe the parts of the program that could not be converted.
+func convertProgramToPCL(
+	pCtx *plugin.Context, cwd, pclDirectory string, loader schema.Loader,
+) (hcl.Diagnostics, error) {
+	path, err := workspace.DetectProjectPathFrom(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("finding project: %w", err)
+	}
+	proj, err := workspace.LoadProject(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading project: %w", err)
+	}
+	root := filepath.Dir(path)
+	pwd, main, err := (&engine.Projinfo{Proj: proj, Root: root}).GetPwdMain()
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := programConfigKeys(proj, root)
+	if err != nil {
+		return nil, err
+	}
+	recorder := importer.NewProgramRecorder(loader, convertStackName, proj.Name)
+	cfg := map[config.Key]string{}
+	cfgPropertyMap := resource.PropertyMap{}
+	for _, key := range keys {
+		v := recorder.ConfigPlaceholder(key)
+		cfg[key] = v
+		cfgPropertyMap[resource.PropertyKey(key.String())] = resource.NewStringProperty(v)
+	}
+
+	grpcServer, err := plugin.NewServer(pCtx, func(srv *grpc.Server) {
+		pulumirpc.RegisterResourceMonitorServer(srv, recorder)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(grpcServer)
+
+	info := plugin.NewProgramInfo(root, pwd, main, proj.Runtime.Options())
+	langhost, err := pCtx.Host.LanguageRuntime(proj.Runtime.Name(), info)
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch language host %s: %w", proj.Runtime.Name(), err)
+	}
+
+	progerr, bail, err := langhost.Run(plugin.RunInfo{
+		Info:              info,
+		MonitorAddress:    grpcServer.Addr(),
+		Project:           string(proj.Name),
+		Stack:             convertStackName,
+		Pwd:               pwd,
+		Config:            cfg,
+		ConfigPropertyMap: cfgPropertyMap,
+		DryRun:            true,
+		Parallel:          1,
+	})
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("running program: %w", err)
+	case bail:
+		return nil, errors.New("running program: the program bailed")
+	case progerr != "":
+		return nil, fmt.Errorf("running program: an unhandled error occurred: %v", progerr)
+	}
+
+	var text bytes.Buffer
+	diagnostics, err := recorder.GenerateProgram(&text)
+	if err != nil {
+		return nil, fmt.Errorf("generating program: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(pclDirectory, "main.pp"), text.Bytes(), 0o600); err != nil {
+		return nil, err
+	}
+
+	// Carry the project's name and description over to the converted project.
+	pclProject := &workspace.Project{
+		Name:        proj.Name,
+		Description: proj.Description,
+		Runtime:     workspace.NewProjectRuntimeInfo(proj.Runtime.Name(), nil),
+	}
+	if err := pclProject.Save(filepath.Join(pclDirectory, "Pulumi.yaml")); err != nil {
+		return nil, fmt.Errorf("saving project: %w", err)
+	}
+	return diagnostics, nil
+}
@@ -67,6 +67,7 @@ func newDestroyCmd() *cobra.Command {
 	var suppressOutputs bool
 	var suppressProgress bool
 	var suppressPermalink string
+	var frozen bool
 	var yes bool
 	var targets *[]string
 	var targetDependents bool
@@ -277,6 +278,7 @@ func newDestroyCmd() *cobra.Command {
 				DisableProviderPreview:    disableProviderPreview(),
 				DisableResourceReferences: disableResourceReferences(),
 				DisableOutputValues:       disableOutputValues(),
+				FrozenPluginLock:          frozen,
 				Experimental:              hasExperimentalCommands(),
 			}
 
@@ -383,6 +385,9 @@ func newDestroyCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressProgress, "suppress-progress", false,
 		"Suppress display of periodic progress dots")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
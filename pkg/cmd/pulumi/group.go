@@ -0,0 +1,437 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	survey "github.com/AlecAivazis/survey/v2"
+	surveycore "github.com/AlecAivazis/survey/v2/core"
+	"github.com/blang/semver"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/version"
+	"github.com/pulumi/pulumi/sdk/v3"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+)
+
+// defaultGroupFile is the name of the group definition file that the group commands read by default.
+const defaultGroupFile = "Pulumi.group.yaml"
+
+// groupArgs are the flags shared by the group commands.
+type groupArgs struct {
+	file     string
+	parallel int
+}
+
+func newGroupCmd() *cobra.Command {
+	var args groupArgs
+
+	cmd := &cobra.Command{
+		Use:   "group",
+		Short: "Manage a group of dependent stacks",
+		Long: "Manage a group of dependent stacks\n" +
+			"\n" +
+			"A group of stacks is described by a group definition file, `" + defaultGroupFile + "` by default,\n" +
+			"that lists the stacks in the group along with the directories of their projects:\n" +
+			"\n" +
+			"    parallel: 4\n" +
+			"    stacks:\n" +
+			"      - name: network\n" +
+			"        stack: myorg/network/prod\n" +
+			"        path: ./network\n" +
+			"      - name: app\n" +
+			"        stack: myorg/app/prod\n" +
+			"        path: ./app\n" +
+			"        dependsOn: [network]\n" +
+			"\n" +
+			"Stacks are operated on in dependency order, and stacks that do not depend on each other are operated\n" +
+			"on in parallel. Dependencies are declared with `dependsOn`, and are also detected from the stack\n" +
+			"references recorded by each stack's latest update. When an operation on a stack fails, the stacks\n" +
+			"that depend on it are skipped.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&args.file, "file", "f", defaultGroupFile,
+		"The group definition file")
+	cmd.PersistentFlags().IntVar(
+		&args.parallel, "parallel", 0,
+		"The maximum number of stacks to operate on at once, overriding the group definition. "+
+			"Zero means the limit in the group definition is used")
+
+	cmd.AddCommand(newGroupPreviewCmd(&args))
+	cmd.AddCommand(newGroupUpCmd(&args))
+	cmd.AddCommand(newGroupDestroyCmd(&args))
+
+	return cmd
+}
+
+func newGroupPreviewCmd(args *groupArgs) *cobra.Command {
+	return &cobra.Command{
+		Use:   "preview",
+		Short: "Preview the stacks in a group",
+		Long: "Preview the stacks in a group\n" +
+			"\n" +
+			"This command previews each stack in the group in dependency order. Because each stack is previewed\n" +
+			"against the current outputs of the stacks that it depends on, the summary lists the outputs of those\n" +
+			"stacks that will change and that each stack reads.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			group, err := loadStackGroup(ctx, args.file)
+			if err != nil {
+				return err
+			}
+
+			res, err := group.Preview(ctx, groupOptions(args)...)
+			printGroupResult(res, opts)
+			return err
+		}),
+	}
+}
+
+func newGroupUpCmd(args *groupArgs) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Update the stacks in a group",
+		Long: "Update the stacks in a group\n" +
+			"\n" +
+			"This command updates each stack in the group in dependency order. Unless --yes is passed, the group\n" +
+			"is previewed first and the update must be confirmed.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			yes = yes || skipConfirmations()
+			if !yes && !cmdutil.Interactive() {
+				return errors.New("--yes must be passed in to proceed when running in non-interactive mode")
+			}
+
+			group, err := loadStackGroup(ctx, args.file)
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				res, err := group.Preview(ctx, groupOptions(args)...)
+				printGroupResult(res, opts)
+				if err != nil {
+					return err
+				}
+				if err := confirmGroupOperation("update", group.Stacks(), opts); err != nil {
+					return err
+				}
+			}
+
+			res, err := group.Up(ctx, groupOptions(args)...)
+			printGroupResult(res, opts)
+			return err
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Automatically approve and perform the update after previewing it")
+
+	return cmd
+}
+
+func newGroupDestroyCmd(args *groupArgs) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "destroy",
+		Short: "Destroy the stacks in a group",
+		Long: "Destroy the stacks in a group\n" +
+			"\n" +
+			"This command destroys all of the resources of each stack in the group, in reverse dependency order.\n" +
+			"A stack is not destroyed if a stack that depends on it fails to be destroyed. The stacks themselves\n" +
+			"are not removed.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			yes = yes || skipConfirmations()
+			if !yes && !cmdutil.Interactive() {
+				return errors.New("--yes must be passed in to proceed when running in non-interactive mode")
+			}
+
+			group, err := loadStackGroup(ctx, args.file)
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				stacks := group.Stacks()
+				for i, j := 0, len(stacks)-1; i < j; i, j = i+1, j-1 {
+					stacks[i], stacks[j] = stacks[j], stacks[i]
+				}
+				if err := confirmGroupOperation("destroy", stacks, opts); err != nil {
+					return err
+				}
+			}
+
+			res, err := group.Destroy(ctx, groupOptions(args)...)
+			printGroupResult(res, opts)
+			return err
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Skip confirmation prompts, and proceed with the destruction anyway")
+
+	return cmd
+}
+
+// loadStackGroup loads the group described by the given group definition file. The stacks are operated on with the
+// running pulumi binary, so that they use the same version of the CLI as the group operation.
+func loadStackGroup(ctx context.Context, file string) (*auto.StackGroup, error) {
+	pulumi, err := newSelfCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := auto.LoadStackGroup(ctx, file, auto.Pulumi(pulumi))
+	if err != nil {
+		return nil, fmt.Errorf("loading stack group: %w", err)
+	}
+	return group, nil
+}
+
+// selfCommand is an auto.PulumiCommand that runs the running pulumi binary, rather than the pulumi binary that is on
+// the PATH.
+type selfCommand struct {
+	path    string
+	version semver.Version
+}
+
+func newSelfCommand() (*selfCommand, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("locating the pulumi binary: %w", err)
+	}
+	v, err := semver.ParseTolerant(version.Version)
+	if err != nil {
+		// Development builds aren't stamped with a version, but are built from the same tree as the SDK.
+		v = sdk.Version
+	}
+	return &selfCommand{path: exe, version: v}, nil
+}
+
+func (c *selfCommand) Run(ctx context.Context,
+	workdir string,
+	stdin io.Reader,
+	additionalOutput []io.Writer,
+	additionalErrorOutput []io.Writer,
+	additionalEnv []string,
+	args ...string,
+) (string, string, int, error) {
+	// Like the automation API, fail rather than prompt for input, which nobody would answer.
+	nonInteractive := false
+	for _, arg := range args {
+		nonInteractive = nonInteractive || arg == "--non-interactive"
+	}
+	if !nonInteractive {
+		args = append(args, "--non-interactive")
+	}
+
+	cmd := exec.CommandContext(ctx, c.path, args...)
+	cmd.Dir = workdir
+	cmd.Env = append(os.Environ(), additionalEnv...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = io.MultiWriter(append(additionalOutput, &stdout)...)
+	cmd.Stderr = io.MultiWriter(append(additionalErrorOutput, &stderr)...)
+	cmd.Stdin = stdin
+
+	code := -1
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if err == nil {
+		code = 0
+	}
+	return stdout.String(), stderr.String(), code, err
+}
+
+func (c *selfCommand) Version() semver.Version {
+	return c.version
+}
+
+// groupOptions returns the options of a group operation, which prefix the progress of each stack with its name.
+func groupOptions(args *groupArgs) []auto.GroupOption {
+	var lock sync.Mutex
+	opts := []auto.GroupOption{
+		auto.GroupProgressStreams(func(name string) []io.Writer {
+			return []io.Writer{&prefixWriter{prefix: "[" + name + "] ", w: os.Stdout, lock: &lock}}
+		}),
+	}
+	if args.parallel > 0 {
+		opts = append(opts, auto.GroupParallel(args.parallel))
+	}
+	return opts
+}
+
+// prefixWriter writes each complete line written to it to an underlying writer, prefixed with a fixed string. Writers
+// that share a lock may be written to concurrently without interleaving their lines.
+type prefixWriter struct {
+	prefix string
+	w      io.Writer
+	lock   *sync.Mutex
+	buf    bytes.Buffer
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := w.buf.Next(i + 1)
+
+		w.lock.Lock()
+		_, err := fmt.Fprintf(w.w, "%s%s", w.prefix, line)
+		w.lock.Unlock()
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func confirmGroupOperation(op string, stacks []string, opts display.Options) error {
+	fmt.Printf("This %s will operate on %d stack(s), in order:\n", op, len(stacks))
+	for _, name := range stacks {
+		fmt.Printf("    - %s\n", name)
+	}
+	fmt.Print("\n")
+
+	surveycore.DisableColor = true
+	surveyIcons := survey.WithIcons(func(icons *survey.IconSet) {
+		icons.Question = survey.Icon{}
+	})
+
+	confirm := false
+	prompt := "\b" + opts.Color.Colorize(
+		colors.SpecPrompt+fmt.Sprintf("Do you want to perform this %s?", op)+colors.Reset)
+	if err := survey.AskOne(&survey.Confirm{
+		Message: prompt,
+	}, &confirm, surveyIcons); err != nil {
+		return fmt.Errorf("confirmation cancelled, not proceeding with the %s: %w", op, err)
+	}
+	if !confirm {
+		return result.FprintBailf(os.Stdout, "confirmation declined, not proceeding with the %s", op)
+	}
+	return nil
+}
+
+// printGroupResult prints a summary of the outcome of an operation on each stack in a group.
+func printGroupResult(res auto.GroupResult, opts display.Options) {
+	if len(res.Stacks) == 0 {
+		return
+	}
+
+	rows := make([]cmdutil.TableRow, len(res.Stacks))
+	for i, s := range res.Stacks {
+		status := string(s.Status)
+		switch s.Status {
+		case auto.GroupStackFailed:
+			status = opts.Color.Colorize(colors.SpecError + status + colors.Reset)
+		case auto.GroupStackSkipped:
+			status = opts.Color.Colorize(colors.SpecWarning + status + colors.Reset)
+		}
+		rows[i] = cmdutil.TableRow{Columns: []string{
+			s.Name, status, formatGroupChanges(s.ResourceChanges), formatGroupNotes(s),
+		}}
+	}
+
+	fmt.Println()
+	printTable(cmdutil.Table{
+		Headers: []string{"STACK", "STATUS", "CHANGES", "NOTES"},
+		Rows:    rows,
+	}, nil)
+}
+
+// formatGroupChanges formats the resource changes of a stack, omitting unchanged resources.
+func formatGroupChanges(changes map[string]int) string {
+	var parts []string
+	for op, n := range changes {
+		if op != "same" && n != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, op))
+		}
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
+
+// formatGroupNotes formats why a stack failed or was skipped, or which of its and its dependencies' outputs a preview
+// shows will change.
+func formatGroupNotes(s auto.GroupStackResult) string {
+	switch s.Status {
+	case auto.GroupStackFailed:
+		if s.Err != nil {
+			msg, _, _ := strings.Cut(s.Err.Error(), "\n")
+			return msg
+		}
+		return ""
+	case auto.GroupStackSkipped:
+		return fmt.Sprintf("skipped because %s failed", s.SkippedBecause)
+	}
+
+	var notes []string
+	if len(s.ChangedOutputs) != 0 {
+		notes = append(notes, "changes outputs "+strings.Join(s.ChangedOutputs, ", "))
+	}
+	deps := make([]string, 0, len(s.UpstreamChanges))
+	for dep := range s.UpstreamChanges {
+		deps = append(deps, dep)
+	}
+	sort.Strings(deps)
+	for _, dep := range deps {
+		notes = append(notes, fmt.Sprintf("reads changed outputs %s of %s",
+			strings.Join(s.UpstreamChanges[dep], ", "), dep))
+	}
+	return strings.Join(notes, "; ")
+}
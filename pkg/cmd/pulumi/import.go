@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/blang/semver"
@@ -157,6 +158,10 @@ type importSpec struct {
 	Component         bool        `json:"component,omitempty"`
 	Remote            bool        `json:"remote,omitempty"`
 
+	// DependsOn lists the names of resources that this resource depends on, in addition to those that are inferred
+	// from its properties. The names may refer to other resources being imported or to entries in the name table.
+	DependsOn []string `json:"dependsOn,omitempty"`
+
 	// LogicalName is the resources Pulumi name (i.e. the first argument to `new Resource`).
 	LogicalName string `json:"logicalName,omitempty"`
 }
@@ -421,6 +426,18 @@ func parseImportFile(
 			}
 		}
 
+		for _, dep := range spec.DependsOn {
+			if takenNames[dep] {
+				pusherrf("%v has an ambiguous dependency '%v'", describeResource(i, spec), dep)
+			}
+			urn, ok := urnMapping[dep]
+			if !ok {
+				pusherrf("the dependency '%v' of %v has no entry in 'nameTable'", dep, describeResource(i, spec))
+			} else {
+				imp.Dependencies = append(imp.Dependencies, urn)
+			}
+		}
+
 		if spec.Version != "" {
 			v, err := semver.ParseTolerant(spec.Version)
 			if err != nil {
@@ -465,6 +482,61 @@ type programGeneratorFunc func(
 	loader schema.ReferenceLoader,
 ) (map[string][]byte, hcl.Diagnostics, error)
 
+// newProgramGenerator returns a programGeneratorFunc that generates programs for the given language runtime.
+func newProgramGenerator(pCtx *plugin.Context, runtime string) programGeneratorFunc {
+	wrapper := func(
+		f func(*pcl.Program) (map[string][]byte, hcl.Diagnostics, error),
+	) func(*pcl.Program, schema.ReferenceLoader) (map[string][]byte, hcl.Diagnostics, error) {
+		return func(p *pcl.Program, loader schema.ReferenceLoader) (map[string][]byte, hcl.Diagnostics, error) {
+			return f(p)
+		}
+	}
+
+	switch runtime {
+	case "dotnet":
+		return wrapper(dotnet.GenerateProgram)
+	case "java":
+		return wrapper(javagen.GenerateProgram)
+	case "yaml":
+		return wrapper(yamlgen.GenerateProgram)
+	default:
+		return func(
+			program *pcl.Program, loader schema.ReferenceLoader,
+		) (map[string][]byte, hcl.Diagnostics, error) {
+			cwd, err := os.Getwd()
+			if err != nil {
+				return nil, nil, err
+			}
+			sink := cmdutil.Diag()
+
+			ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer contract.IgnoreClose(pCtx.Host)
+			programInfo := plugin.NewProgramInfo(cwd, cwd, "entry", nil)
+			languagePlugin, err := ctx.Host.LanguageRuntime(runtime, programInfo)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			loaderServer := schema.NewLoaderServer(loader)
+			grpcServer, err := plugin.NewServer(pCtx, schema.LoaderRegistration(loaderServer))
+			if err != nil {
+				return nil, nil, err
+			}
+			defer contract.IgnoreClose(grpcServer)
+
+			files, diagnostics, err := languagePlugin.GenerateProgram(program.Source(), grpcServer.Addr())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return files, diagnostics, nil
+		}
+	}
+}
+
 func generateImportedDefinitions(ctx *plugin.Context,
 	out io.Writer, stackName tokens.StackName, projectName tokens.PackageName,
 	snap *deploy.Snapshot, programGenerator programGeneratorFunc, names importer.NameTable,
@@ -510,8 +582,21 @@ func generateImportedDefinitions(ctx *plugin.Context,
 		return false, nil
 	}
 
+	// Resources in the name table that are not being imported may be referred to by the imported resources.
+	imported := map[resource.URN]bool{}
+	for _, r := range resources {
+		imported[r.URN] = true
+	}
+	var existing []*resource.State
+	for urn := range names {
+		if state, ok := resourceTable[urn]; ok && !imported[urn] {
+			existing = append(existing, state)
+		}
+	}
+	sort.Slice(existing, func(i, j int) bool { return existing[i].URN < existing[j].URN })
+
 	loader := schema.NewPluginLoader(ctx.Host)
-	return true, importer.GenerateLanguageDefinitions(out, loader, func(w io.Writer, p *pcl.Program) error {
+	return true, importer.GenerateImportDefinitions(out, loader, func(w io.Writer, p *pcl.Program) error {
 		files, _, err := programGenerator(p, loader)
 		if err != nil {
 			return err
@@ -526,7 +611,7 @@ func generateImportedDefinitions(ctx *plugin.Context,
 			return err
 		}
 		return nil
-	}, resources, names)
+	}, resources, existing, names)
 }
 
 func newImportCmd() *cobra.Command {
@@ -552,11 +637,13 @@ func newImportCmd() *cobra.Command {
 	var suppressOutputs bool
 	var suppressProgress bool
 	var suppressPermalink string
+	var frozen bool
 	var yes bool
 	var protectResources bool
 	var properties []string
 
 	var from string
+	var discover bool
 
 	cmd := &cobra.Command{
 		Use:   "import [type] [name] [id]",
@@ -610,6 +697,7 @@ func newImportCmd() *cobra.Command {
 			"                \"pluginDownloadUrl\": \"optional-provider-plugin-url\",\n" +
 			"                \"logicalName\": \"optionalLogicalName\",\n" +
 			"                \"properties\": [\"optional-property-names\"],\n" +
+			"                \"dependsOn\": [\"optional-dependency-names\"],\n" +
 			"                \"component\": false,\n" +
 			"                \"remote\": false,\n" +
 			"            },\n" +
@@ -639,11 +727,28 @@ func newImportCmd() *cobra.Command {
 			"A resource can also be declared as a \"component\" (and optionally as \"remote\"). These resources\n" +
 			"don't have an id set and instead just create an empty placeholder component resource in the Pulumi state.\n" +
 			"\n" +
+			"Each resource may specify the names of the resources it depends on. Dependencies\n" +
+			"are also inferred from the resources' properties: a property whose value matches the\n" +
+			"ID or an output of exactly one other resource, whether it is being imported or is\n" +
+			"already in the stack, is recorded as a dependency and generated as a reference to\n" +
+			"that resource. Values that match more than one resource are reported, and can be\n" +
+			"resolved by listing the intended resource in dependsOn.\n" +
+			"\n" +
 			"Each resource may specify which input properties to import with;\n" +
 			"\n" +
 			"If a resource does not specify any properties the default behaviour is to\n" +
 			"import using all required properties.\n" +
 			"\n" +
+			"If the provider supports listing existing resources, use --discover with one or more type\n" +
+			"tokens to find the existing resources of those types and choose which ones to import:\n" +
+			"\n" +
+			"    pulumi import --discover 'aws:s3/bucket:Bucket' 'aws:s3/bucketPolicy:BucketPolicy'\n" +
+			"\n" +
+			"The discovered resources are given names based on their IDs or the names suggested by the\n" +
+			"provider, and are imported as children of their discovered parents. They are discovered\n" +
+			"using the default provider configured by the stack's configuration, or the provider\n" +
+			"given by --provider.\n" +
+			"\n" +
 			"You can use `pulumi preview` with the `--import-file` option to emit an import file\n" +
 			"for all resources that need creating from the preview. This will fill in all the name,\n" +
 			"type, parent and provider information for you and just require you to fill in resource\n" +
@@ -671,11 +776,29 @@ func newImportCmd() *cobra.Command {
 					contract.IgnoreError(cmd.Help())
 					return result.Errorf("a converter may not be specified in conjunction with an import file")
 				}
+				if discover {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("--discover may not be specified in conjunction with an import file")
+				}
 				f, err := readImportFile(importFilePath)
 				if err != nil {
 					return result.FromError(fmt.Errorf("could not read import file: %w", err))
 				}
 				importFile = f
+			} else if discover {
+				if from != "" {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("a converter may not be specified in conjunction with --discover")
+				}
+				if len(args) == 0 {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("at least one resource type must be specified with --discover")
+				}
+				if parentSpec != "" || len(properties) != 0 {
+					contract.IgnoreError(cmd.Help())
+					return result.Errorf("--parent and --properties may not be specified in conjunction with --discover")
+				}
+				// The import file is built once the stack's configuration has been loaded.
 			} else if from != "" {
 				log := func(sev diag.Severity, msg string) {
 					pCtx.Diag.Logf(sev, diag.RawMessage("", msg))
@@ -841,63 +964,7 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
-			imports, nameTable, err := parseImportFile(importFile, s.Ref().Name(), proj.Name, protectResources)
-			if err != nil {
-				return result.FromError(err)
-			}
-
-			wrapper := func(
-				f func(*pcl.Program) (map[string][]byte, hcl.Diagnostics, error),
-			) func(*pcl.Program, schema.ReferenceLoader) (map[string][]byte, hcl.Diagnostics, error) {
-				return func(p *pcl.Program, loader schema.ReferenceLoader) (map[string][]byte, hcl.Diagnostics, error) {
-					return f(p)
-				}
-			}
-
-			var programGenerator programGeneratorFunc
-			switch proj.Runtime.Name() {
-			case "dotnet":
-				programGenerator = wrapper(dotnet.GenerateProgram)
-			case "java":
-				programGenerator = wrapper(javagen.GenerateProgram)
-			case "yaml":
-				programGenerator = wrapper(yamlgen.GenerateProgram)
-			default:
-				programGenerator = func(
-					program *pcl.Program, loader schema.ReferenceLoader,
-				) (map[string][]byte, hcl.Diagnostics, error) {
-					cwd, err := os.Getwd()
-					if err != nil {
-						return nil, nil, err
-					}
-					sink := cmdutil.Diag()
-
-					ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
-					if err != nil {
-						return nil, nil, err
-					}
-					defer contract.IgnoreClose(pCtx.Host)
-					programInfo := plugin.NewProgramInfo(cwd, cwd, "entry", nil)
-					languagePlugin, err := ctx.Host.LanguageRuntime(proj.Runtime.Name(), programInfo)
-					if err != nil {
-						return nil, nil, err
-					}
-
-					loaderServer := schema.NewLoaderServer(loader)
-					grpcServer, err := plugin.NewServer(pCtx, schema.LoaderRegistration(loaderServer))
-					if err != nil {
-						return nil, nil, err
-					}
-					defer contract.IgnoreClose(grpcServer)
-
-					files, diagnostics, err := languagePlugin.GenerateProgram(program.Source(), grpcServer.Addr())
-					if err != nil {
-						return nil, nil, err
-					}
-
-					return files, diagnostics, nil
-				}
-			}
+			programGenerator := newProgramGenerator(pCtx, proj.Runtime.Name())
 
 			m, err := getUpdateMetadata(message, root, execKind, execAgent, false, cmd.Flags())
 			if err != nil {
@@ -930,11 +997,28 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(fmt.Errorf("validating stack config: %w", configErr))
 			}
 
+			if discover {
+				f, err := discoverImportFile(ctx, pCtx, s, proj.Name, cfg, args, providerSpec, interactive && !yes)
+				if err != nil {
+					return result.FromError(err)
+				}
+				if len(f.Resources) == 0 {
+					return result.Errorf("no resources were selected to import")
+				}
+				importFile = f
+			}
+
+			imports, nameTable, err := parseImportFile(importFile, s.Ref().Name(), proj.Name, protectResources)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts.Engine = engine.UpdateOptions{
-				Parallel:      parallel,
-				Debug:         debug,
-				UseLegacyDiff: useLegacyDiff(),
-				Experimental:  hasExperimentalCommands(),
+				Parallel:         parallel,
+				Debug:            debug,
+				UseLegacyDiff:    useLegacyDiff(),
+				FrozenPluginLock: frozen,
+				Experimental:     hasExperimentalCommands(),
 			}
 
 			_, res := s.Import(ctx, backend.UpdateOperation{
@@ -989,9 +1073,9 @@ func newImportCmd() *cobra.Command {
 					return result.FromError(errors.New("import cancelled"))
 				}
 
-				// If we did a conversion import (i.e. from!="") then lets write the file we've built out to the local
+				// If we did a conversion or discovery import then lets write the file we've built out to the local
 				// directory so if there's any issues users can manually edit the file and try again with --file
-				if from != "" {
+				if from != "" || discover {
 					path, err := writeImportFileToTemp(importFile)
 					if err != nil {
 						return result.FromError(err)
@@ -1020,6 +1104,9 @@ func newImportCmd() *cobra.Command {
 		&importFilePath, "file", "f", "", "The path to a JSON-encoded file containing a list of resources to import")
 	cmd.PersistentFlags().StringVarP(
 		&outputFilePath, "out", "o", "", "The path to the file that will contain the generated resource declarations")
+	cmd.PersistentFlags().BoolVar(
+		&discover, "discover", false,
+		"Discover the existing resources of the given types using the provider and choose which to import")
 	cmd.PersistentFlags().BoolVar(
 		&generateCode, "generate-code", true, "Generate resource declaration code for the imported resources")
 
@@ -1055,6 +1142,9 @@ func newImportCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressProgress, "suppress-progress", false,
 		"Suppress display of periodic progress dots")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
@@ -0,0 +1,361 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	surveycore "github.com/AlecAivazis/survey/v2/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/importer"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// discoveredResource is an existing resource found by listing a resource type.
+type discoveredResource struct {
+	Type tokens.Type
+	plugin.ListedResource
+}
+
+// describe returns a one line description of the resource, for selecting which resources to import.
+func (r discoveredResource) describe() string {
+	if r.Name != "" && r.Name != string(r.ID) {
+		return fmt.Sprintf("%v %v (%v)", r.Type, r.ID, r.Name)
+	}
+	return fmt.Sprintf("%v %v", r.Type, r.ID)
+}
+
+// discoveryProvider describes the provider that is used to discover resources. If urn is empty, the stack's default
+// provider for the package is used.
+type discoveryProvider struct {
+	name    string
+	urn     resource.URN
+	inputs  resource.PropertyMap
+	version string
+}
+
+// loadDiscoveryProvider returns the provider that will be used to discover resources of the given types: either the
+// explicit provider given by providerSpec, which must exist in the stack, or the default provider configured with the
+// stack's configuration for the package.
+func loadDiscoveryProvider(
+	snap *deploy.Snapshot, target *deploy.Target, types []tokens.Type, providerSpec string,
+) (discoveryProvider, error) {
+	var pkg tokens.Package
+	for _, typ := range types {
+		if pkg != "" && typ.Package() != pkg {
+			return discoveryProvider{}, fmt.Errorf(
+				"all discovered types must belong to the same package, but got '%v' and '%v'", pkg, typ.Package())
+		}
+		pkg = typ.Package()
+	}
+
+	if providerSpec == "" {
+		inputs, err := target.GetPackageConfig(pkg)
+		if err != nil {
+			return discoveryProvider{}, fmt.Errorf("getting configuration for package '%v': %w", pkg, err)
+		}
+		return discoveryProvider{inputs: inputs}, nil
+	}
+
+	name, urn, err := parseResourceSpec(providerSpec)
+	if err != nil {
+		name, urn = "provider", resource.URN(providerSpec)
+	}
+	if providers.GetProviderPackage(urn.Type()) != pkg {
+		return discoveryProvider{}, fmt.Errorf("provider '%v' is not a provider for package '%v'", urn, pkg)
+	}
+	if snap != nil {
+		for _, r := range snap.Resources {
+			if r.URN != urn || r.Delete {
+				continue
+			}
+			version, err := providers.GetProviderVersion(r.Inputs)
+			if err != nil {
+				return discoveryProvider{}, err
+			}
+			provider := discoveryProvider{name: name, urn: urn, inputs: r.Inputs}
+			if version != nil {
+				provider.version = version.String()
+			}
+			return provider, nil
+		}
+	}
+	return discoveryProvider{}, fmt.Errorf("provider '%v' does not exist in the stack", urn)
+}
+
+// discoverResources asks the provider for the existing resources of each of the given types. If the provider does not
+// implement List, the conventional `get<Resource>s` function is invoked instead, which returns the resources' IDs.
+func discoverResources(provider plugin.Provider, types []tokens.Type) ([]discoveredResource, error) {
+	var discovered []discoveredResource
+	for _, typ := range types {
+		listed, err := listResources(provider, typ)
+		if errors.Is(err, plugin.ErrNotYetImplemented) || status.Code(err) == codes.Unimplemented {
+			listed, err = listResourcesByInvoke(provider, typ)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of type '%v': %w", typ, err)
+		}
+		for _, r := range listed {
+			discovered = append(discovered, discoveredResource{Type: typ, ListedResource: r})
+		}
+	}
+	return discovered, nil
+}
+
+// listResources lists the resources of the given type using the provider's List method, fetching every page.
+func listResources(provider plugin.Provider, typ tokens.Type) ([]plugin.ListedResource, error) {
+	var listed []plugin.ListedResource
+	pageToken := ""
+	for {
+		page, err := provider.List(typ, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		listed = append(listed, page.Resources...)
+		if pageToken = page.NextPageToken; pageToken == "" {
+			return listed, nil
+		}
+	}
+}
+
+// listInvokeToken returns the token of the conventional function that lists the IDs of the resources of the given
+// type, e.g. `aws:s3/getBuckets:getBuckets` for `aws:s3/bucket:Bucket`.
+func listInvokeToken(typ tokens.Type) (tokens.ModuleMember, bool) {
+	components := strings.Split(string(typ), ":")
+	if len(components) != 3 || components[2] == "" {
+		return "", false
+	}
+	pkg, mod, name := components[0], components[1], components[2]
+
+	fn := "get" + name + "s"
+	if slash := strings.Index(mod, "/"); slash != -1 {
+		mod = mod[:slash] + "/" + fn
+	}
+	return tokens.ModuleMember(pkg + ":" + mod + ":" + fn), true
+}
+
+// listResourcesByInvoke lists the resources of the given type using the conventional `get<Resource>s` function,
+// whose result holds the resources' IDs in its `ids` property.
+func listResourcesByInvoke(provider plugin.Provider, typ tokens.Type) ([]plugin.ListedResource, error) {
+	tok, ok := listInvokeToken(typ)
+	if !ok {
+		return nil, errors.New("the provider does not support listing resources")
+	}
+
+	result, failures, err := provider.Invoke(tok, resource.PropertyMap{})
+	if err != nil {
+		return nil, fmt.Errorf("the provider does not support listing resources, and invoking '%v' failed: %w", tok, err)
+	}
+	if len(failures) != 0 {
+		return nil, fmt.Errorf("the provider does not support listing resources, and invoking '%v' failed: %v",
+			tok, failures[0].Reason)
+	}
+
+	ids := result["ids"]
+	if ids.IsSecret() {
+		ids = ids.SecretValue().Element
+	}
+	if !ids.IsArray() {
+		return nil, fmt.Errorf("the provider does not support listing resources, and '%v' did not return ids", tok)
+	}
+	var listed []plugin.ListedResource
+	for _, id := range ids.ArrayValue() {
+		if !id.IsString() {
+			return nil, fmt.Errorf("'%v' returned an id that is not a string: %v", tok, id)
+		}
+		listed = append(listed, plugin.ListedResource{ID: resource.ID(id.StringValue())})
+	}
+	return listed, nil
+}
+
+// selectDiscoveredResources asks the user which of the discovered resources to import. All of the resources are
+// selected by default.
+func selectDiscoveredResources(
+	resources []discoveredResource, color colors.Colorization,
+) ([]discoveredResource, error) {
+	options := make([]string, len(resources))
+	for i, r := range resources {
+		options[i] = r.describe()
+	}
+
+	surveycore.DisableColor = true
+	var selected []int
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message:  "\b" + color.Colorize(colors.SpecPrompt+"Select the resources to import:"+colors.Reset),
+		Options:  options,
+		Default:  options,
+		PageSize: optimalPageSize(optimalPageSizeOpts{nopts: len(options)}),
+	}, &selected, surveyIcons(color)); err != nil {
+		return nil, err
+	}
+
+	result := make([]discoveredResource, len(selected))
+	for i, index := range selected {
+		result[i] = resources[index]
+	}
+	return result, nil
+}
+
+// makeImportFileFromDiscoveredResources builds an import file for the given discovered resources. Each resource is
+// given a unique name based on the name suggested by the provider or on its ID, and resources whose listed parent is
+// also being imported are imported as children of it.
+func makeImportFileFromDiscoveredResources(
+	resources []discoveredResource, provider discoveryProvider,
+) importFile {
+	nameTable := map[string]resource.URN{}
+	used := map[string]bool{}
+	if provider.urn != "" {
+		nameTable[provider.name] = provider.urn
+		used[provider.name] = true
+	}
+
+	type key struct {
+		typ tokens.Type
+		id  resource.ID
+	}
+	names := map[key]string{}
+	specs := make([]importSpec, len(resources))
+	for i, r := range resources {
+		logicalName := r.Name
+		if logicalName == "" {
+			logicalName = string(r.ID)
+		}
+		base := importer.VariableName(logicalName)
+		name := base
+		for n := 2; used[name]; n++ {
+			name = base + strconv.Itoa(n)
+		}
+		used[name] = true
+		names[key{r.Type, r.ID}] = name
+
+		specs[i] = importSpec{
+			Type:    r.Type,
+			Name:    name,
+			ID:      r.ID,
+			Version: provider.version,
+		}
+		if logicalName != name {
+			specs[i].LogicalName = logicalName
+		}
+		if provider.urn != "" {
+			specs[i].Provider = provider.name
+		}
+	}
+
+	for i, r := range resources {
+		if r.ParentType == "" || r.ParentID == "" {
+			continue
+		}
+		if parent, ok := names[key{r.ParentType, r.ParentID}]; ok {
+			specs[i].Parent = parent
+		}
+	}
+
+	return importFile{
+		NameTable: nameTable,
+		Resources: specs,
+	}
+}
+
+// configureDiscoveryProvider loads and configures the plugin for the given provider.
+func configureDiscoveryProvider(
+	host plugin.Host, stack tokens.QName, proj tokens.PackageName, pkg tokens.Package, provider discoveryProvider,
+) (plugin.Provider, error) {
+	version, err := providers.GetProviderVersion(provider.inputs)
+	if err != nil {
+		return nil, err
+	}
+	p, err := host.Provider(pkg, version)
+	if err != nil {
+		return nil, fmt.Errorf("loading provider for package '%v': %w", pkg, err)
+	}
+
+	urn := provider.urn
+	if urn == "" {
+		urn = resource.NewURN(stack, proj, "", providers.MakeProviderType(pkg), "default")
+	}
+	inputs, failures, err := p.CheckConfig(urn, nil, provider.inputs, true)
+	if err == nil && len(failures) != 0 {
+		err = fmt.Errorf("invalid configuration: %v", failures[0].Reason)
+	}
+	if err == nil {
+		err = p.Configure(inputs)
+	}
+	if err != nil {
+		contract.IgnoreError(host.CloseProvider(p))
+		return nil, fmt.Errorf("configuring provider for package '%v': %w", pkg, err)
+	}
+	return p, nil
+}
+
+// discoverImportFile discovers the existing resources of the given types using the stack's provider for their package,
+// optionally asks the user which of them to import, and returns an import file for the selected resources.
+func discoverImportFile(
+	ctx context.Context, pCtx *plugin.Context, s backend.Stack, proj tokens.PackageName,
+	cfg backend.StackConfiguration, args []string, providerSpec string, prompt bool,
+) (importFile, error) {
+	types := make([]tokens.Type, len(args))
+	for i, arg := range args {
+		types[i] = tokens.Type(arg)
+	}
+
+	snap, err := getCurrentDeploymentForStack(ctx, s)
+	if err != nil {
+		return importFile{}, err
+	}
+	target := &deploy.Target{Config: cfg.Config, Decrypter: cfg.Decrypter}
+	provider, err := loadDiscoveryProvider(snap, target, types, providerSpec)
+	if err != nil {
+		return importFile{}, err
+	}
+
+	pkg := types[0].Package()
+	p, err := configureDiscoveryProvider(pCtx.Host, s.Ref().Name().Q(), proj, pkg, provider)
+	if err != nil {
+		return importFile{}, err
+	}
+	defer func() { contract.IgnoreError(pCtx.Host.CloseProvider(p)) }()
+
+	resources, err := discoverResources(p, types)
+	if err != nil {
+		return importFile{}, err
+	}
+	if len(resources) == 0 {
+		return importFile{}, errors.New("no existing resources of the given types were found")
+	}
+
+	if prompt {
+		resources, err = selectDiscoveredResources(resources, cmdutil.GetGlobalColorization())
+		if err != nil {
+			return importFile{}, err
+		}
+	}
+	return makeImportFileFromDiscoveredResources(resources, provider), nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+type discoveryTestProvider struct {
+	plugin.UnimplementedProvider
+
+	list   func(typ tokens.Type, pageToken string) (plugin.ListResult, error)
+	invoke func(tok tokens.ModuleMember) (resource.PropertyMap, error)
+}
+
+func (p *discoveryTestProvider) List(typ tokens.Type, pageToken string) (plugin.ListResult, error) {
+	if p.list == nil {
+		return p.UnimplementedProvider.List(typ, pageToken)
+	}
+	return p.list(typ, pageToken)
+}
+
+func (p *discoveryTestProvider) Invoke(
+	tok tokens.ModuleMember, args resource.PropertyMap,
+) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	result, err := p.invoke(tok)
+	return result, nil, err
+}
+
+func TestDiscoverResources(t *testing.T) {
+	t.Parallel()
+
+	provider := &discoveryTestProvider{
+		list: func(typ tokens.Type, pageToken string) (plugin.ListResult, error) {
+			// Each type is listed in two pages.
+			if pageToken == "" {
+				return plugin.ListResult{
+					Resources:     []plugin.ListedResource{{ID: resource.ID("id-" + typ.Name())}},
+					NextPageToken: "2",
+				}, nil
+			}
+			return plugin.ListResult{
+				Resources: []plugin.ListedResource{{ID: resource.ID("id-" + typ.Name().String() + "-" + pageToken)}},
+			}, nil
+		},
+	}
+	resources, err := discoverResources(provider, []tokens.Type{"pkg:index:A", "pkg:index:B"})
+	require.NoError(t, err)
+	assert.Equal(t, []discoveredResource{
+		{Type: "pkg:index:A", ListedResource: plugin.ListedResource{ID: "id-A"}},
+		{Type: "pkg:index:A", ListedResource: plugin.ListedResource{ID: "id-A-2"}},
+		{Type: "pkg:index:B", ListedResource: plugin.ListedResource{ID: "id-B"}},
+		{Type: "pkg:index:B", ListedResource: plugin.ListedResource{ID: "id-B-2"}},
+	}, resources)
+}
+
+func TestDiscoverResourcesByInvoke(t *testing.T) {
+	t.Parallel()
+
+	var invoked []tokens.ModuleMember
+	provider := &discoveryTestProvider{
+		invoke: func(tok tokens.ModuleMember) (resource.PropertyMap, error) {
+			invoked = append(invoked, tok)
+			return resource.PropertyMap{
+				"ids": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("one"),
+					resource.NewStringProperty("two"),
+				}),
+			}, nil
+		},
+	}
+	resources, err := discoverResources(provider, []tokens.Type{"aws:s3/bucket:Bucket"})
+	require.NoError(t, err)
+	assert.Equal(t, []tokens.ModuleMember{"aws:s3/getBuckets:getBuckets"}, invoked)
+	assert.Equal(t, []discoveredResource{
+		{Type: "aws:s3/bucket:Bucket", ListedResource: plugin.ListedResource{ID: "one"}},
+		{Type: "aws:s3/bucket:Bucket", ListedResource: plugin.ListedResource{ID: "two"}},
+	}, resources)
+
+	provider.invoke = func(tok tokens.ModuleMember) (resource.PropertyMap, error) {
+		return resource.PropertyMap{}, nil
+	}
+	_, err = discoverResources(provider, []tokens.Type{"aws:s3/bucket:Bucket"})
+	assert.ErrorContains(t, err, "the provider does not support listing resources")
+}
+
+func TestListInvokeToken(t *testing.T) {
+	t.Parallel()
+
+	tok, ok := listInvokeToken("aws:s3/bucket:Bucket")
+	assert.True(t, ok)
+	assert.Equal(t, tokens.ModuleMember("aws:s3/getBuckets:getBuckets"), tok)
+
+	tok, ok = listInvokeToken("testprovider:index:Echo")
+	assert.True(t, ok)
+	assert.Equal(t, tokens.ModuleMember("testprovider:index:getEchos"), tok)
+
+	_, ok = listInvokeToken("notAType")
+	assert.False(t, ok)
+}
+
+func TestMakeImportFileFromDiscoveredResources(t *testing.T) {
+	t.Parallel()
+
+	const echo = tokens.Type("testprovider:index:Echo")
+	resources := []discoveredResource{
+		{Type: echo, ListedResource: plugin.ListedResource{ID: "echo-a", Name: "a"}},
+		{Type: echo, ListedResource: plugin.ListedResource{
+			ID: "echo-b", Name: "a", ParentType: echo, ParentID: "echo-a",
+		}},
+		{Type: echo, ListedResource: plugin.ListedResource{ID: "echo-c"}},
+		{Type: echo, ListedResource: plugin.ListedResource{ID: "echo-d", ParentType: echo, ParentID: "missing"}},
+	}
+
+	f := makeImportFileFromDiscoveredResources(resources, discoveryProvider{})
+	assert.Equal(t, importFile{
+		NameTable: map[string]resource.URN{},
+		Resources: []importSpec{
+			{Type: echo, Name: "a", ID: "echo-a"},
+			{Type: echo, Name: "a2", ID: "echo-b", Parent: "a", LogicalName: "a"},
+			{Type: echo, Name: "echo-c", ID: "echo-c"},
+			{Type: echo, Name: "echo-d", ID: "echo-d"},
+		},
+	}, f)
+
+	providerURN := resource.URN("urn:pulumi:dev::proj::pulumi:providers:testprovider::prov")
+	f = makeImportFileFromDiscoveredResources(resources[:1], discoveryProvider{
+		name: "prov", urn: providerURN, version: "1.2.3",
+	})
+	assert.Equal(t, importFile{
+		NameTable: map[string]resource.URN{"prov": providerURN},
+		Resources: []importSpec{
+			{Type: echo, Name: "a", ID: "echo-a", Provider: "prov", Version: "1.2.3"},
+		},
+	}, f)
+}
+
+func TestLoadDiscoveryProvider(t *testing.T) {
+	t.Parallel()
+
+	providerURN := resource.URN("urn:pulumi:dev::proj::pulumi:providers:testprovider::prov")
+	snap := &deploy.Snapshot{
+		Resources: []*resource.State{{
+			URN:    providerURN,
+			Custom: true,
+			Inputs: resource.PropertyMap{
+				"version": resource.NewStringProperty("1.2.3"),
+				"region":  resource.NewStringProperty("west"),
+			},
+		}},
+	}
+	types := []tokens.Type{"testprovider:index:Echo"}
+
+	provider, err := loadDiscoveryProvider(snap, nil, types, "prov="+string(providerURN))
+	require.NoError(t, err)
+	assert.Equal(t, "prov", provider.name)
+	assert.Equal(t, providerURN, provider.urn)
+	assert.Equal(t, "1.2.3", provider.version)
+	assert.Equal(t, resource.NewStringProperty("west"), provider.inputs["region"])
+
+	_, err = loadDiscoveryProvider(snap, nil, types, "urn:pulumi:dev::proj::pulumi:providers:testprovider::other")
+	assert.ErrorContains(t, err, "does not exist in the stack")
+
+	_, err = loadDiscoveryProvider(snap, nil, types, "urn:pulumi:dev::proj::pulumi:providers:aws::prov")
+	assert.ErrorContains(t, err, "is not a provider for package 'testprovider'")
+
+	_, err = loadDiscoveryProvider(snap, nil, []tokens.Type{"testprovider:index:Echo", "aws:s3/bucket:Bucket"}, "")
+	assert.ErrorContains(t, err, "must belong to the same package")
+
+	provider, err = loadDiscoveryProvider(snap, nil, types, "")
+	require.NoError(t, err)
+	assert.Equal(t, discoveryProvider{inputs: resource.PropertyMap{}}, provider)
+}
@@ -35,6 +35,7 @@ import (
 
 func newInstallCmd() *cobra.Command {
 	var reinstall bool
+	var frozen bool
 
 	cmd := &cobra.Command{
 		Use:   "install",
@@ -42,7 +43,12 @@ func newInstallCmd() *cobra.Command {
 		Short: "Install packages and plugins for the current program",
 		Long: "Install packages and plugins for the current program.\n" +
 			"\n" +
-			"This command is used to manually install packages and plugins required by your program.",
+			"This command is used to manually install packages and plugins required by your program.\n" +
+			"\n" +
+			"The exact versions, download locations and checksums of the installed plugins are recorded in\n" +
+			"the project's Pulumi.lock file, which Pulumi uses to pin the plugins the program uses on every\n" +
+			"subsequent operation. Use `--frozen` to install exactly the plugins recorded in Pulumi.lock\n" +
+			"and fail if the program requires anything different.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			displayOpts := display.Options{
@@ -55,6 +61,14 @@ func newInstallCmd() *cobra.Command {
 				return err
 			}
 
+			// Regenerate the SDKs of local packages whose component's schema has changed since they were added, so
+			// that the up-to-date SDKs are installed below. A component that cannot be loaded, for example because
+			// its own dependencies are missing, must not prevent the rest of the project from being installed, so
+			// failures are reported as warnings; the SDKs that failed are regenerated again on the next install.
+			if err := regenerateLocalPackages(ctx, proj, root); err != nil {
+				cmdutil.Diag().Warningf(diag.Message("", "could not regenerate local package SDKs: %v"), err)
+			}
+
 			span := opentracing.SpanFromContext(ctx)
 			projinfo := &engine.Projinfo{Proj: proj, Root: root}
 			pwd, main, pctx, err := engine.ProjectInfoContext(
@@ -91,8 +105,19 @@ func newInstallCmd() *cobra.Command {
 				return err
 			}
 
+			// The plugin lock is rewritten to contain exactly the plugins the project needs.
+			lock, err := newPluginLockWriter(root, frozen, true /*replace*/)
+			if err != nil {
+				return err
+			}
+
 			// Now for each kind, name, version pair, download it from the release website, and install it.
 			for _, install := range installs {
+				install, err := lock.Pin(install)
+				if err != nil {
+					return err
+				}
+
 				// PluginSpec.String() just returns the name and version, we want the kind too.
 				label := fmt.Sprintf("%s plugin %s", install.Kind, install)
 
@@ -101,11 +126,13 @@ func newInstallCmd() *cobra.Command {
 					if install.Version != nil {
 						if workspace.HasPlugin(install) {
 							logging.V(1).Infof("%s skipping install (existing == match)", label)
+							lock.Record(install)
 							continue
 						}
 					} else {
-						if has, _ := workspace.HasPluginGTE(install); has {
+						if installed, _ := workspace.GetPluginGTE(install); installed != nil {
 							logging.V(1).Infof("%s skipping install (existing >= match)", label)
+							lock.Record(*installed)
 							continue
 						}
 					}
@@ -134,20 +161,27 @@ func newInstallCmd() *cobra.Command {
 					}
 				}()
 
+				if install, err = withDownloadChecksum(install, r.Name()); err != nil {
+					return fmt.Errorf("%s: %w", label, err)
+				}
+
 				payload := workspace.TarPlugin(r)
 
 				logging.V(1).Infof("%s installing tarball ...", label)
 				if err = install.InstallWithContext(ctx, payload, reinstall); err != nil {
 					return fmt.Errorf("installing %s: %w", label, err)
 				}
+				lock.Record(install)
 			}
 
-			return nil
+			return lock.Save()
 		}),
 	}
 
 	cmd.PersistentFlags().BoolVar(&reinstall,
 		"reinstall", false, "Reinstall a plugin even if it already exists")
+	cmd.PersistentFlags().BoolVar(&frozen,
+		"frozen", false, "Fail if the plugins required by the program deviate from Pulumi.lock, instead of updating it")
 
 	return cmd
 }
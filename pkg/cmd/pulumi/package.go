@@ -51,6 +51,9 @@ Subcommands of this command are useful to package authors during development.`,
 		newExtractSchemaCommand(),
 		newExtractMappingCommand(),
 		newGenSdkCommand(),
+		newGenDocsCommand(),
+		newPackageAddCmd(),
+		newPackageVerifyExamplesCmd(),
 		newPackagePublishCmd(),
 		newPackagePackCmd(),
 	)
@@ -112,9 +115,10 @@ func schemaFromSchemaSource(packageSource string) (*schema.Package, error) {
 	return bind(spec)
 }
 
-// providerFromSource takes a plugin name or path.
+// providerFromSource takes a plugin name or path. A path may also be the directory of a plugin project, i.e. a
+// directory with a PulumiPlugin.yaml file, in which case the plugin is run from source by its language runtime.
 //
-// PLUGIN[@VERSION] | PATH_TO_PLUGIN
+// PLUGIN[@VERSION] | PATH_TO_PLUGIN | PATH_TO_PLUGIN_PROJECT
 func providerFromSource(packageSource string) (plugin.Provider, error) {
 	wd, err := os.Getwd()
 	if err != nil {
@@ -200,6 +204,15 @@ func providerFromSource(packageSource string) (plugin.Provider, error) {
 		return nil, fmt.Errorf("could not find file %s", pkg)
 	} else if err != nil {
 		return nil, err
+	} else if info.IsDir() {
+		if _, err := os.Stat(filepath.Join(pkg, "PulumiPlugin.yaml")); err != nil {
+			return nil, fmt.Errorf("%q is not a plugin project: %w", pkg, err)
+		}
+		if p, err := filepath.Abs(pkg); err == nil {
+			pkg = p
+		}
+		// The binary does not exist, so the plugin is launched through the runtime named in PulumiPlugin.yaml.
+		pkg = filepath.Join(pkg, "pulumi-resource-"+filepath.Base(pkg))
 	} else if !isExecutable(info) {
 		if p, err := filepath.Abs(pkg); err == nil {
 			pkg = p
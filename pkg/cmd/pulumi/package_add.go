@@ -0,0 +1,465 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/modfile"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/executable"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// localPackageHashFile is the file in a local SDK that records the hash of the schema the SDK was generated from, so
+// that the SDK is only regenerated when the schema changes.
+const localPackageHashFile = ".schema-sha256"
+
+// localGoSDKVersion is the version that Go projects require local SDKs at. The requirement is replaced by the SDK's
+// directory, so the version is never fetched.
+const localGoSDKVersion = "v0.0.0-00010101000000-000000000000"
+
+func newPackageAddCmd() *cobra.Command {
+	var force bool
+	cmd := &cobra.Command{
+		Use:   "add <component_project>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add a local component package to the current project",
+		Long: `Add a local component package to the current project.
+
+<component_project> is the path to the directory of a component provider project, i.e. a directory with a
+PulumiPlugin.yaml file. The component provider is run from source to read its schema, and an SDK for the
+language of the current project is generated into the project's sdks directory and added to the project's
+dependencies. The component provider is added to the plugins of the project, so that Pulumi runs it from
+source as well.
+
+The SDK is regenerated when the schema of the component changes, either by running this command again or by
+running ` + "`pulumi install`" + `.
+
+Node.js, Python and Go projects are supported.`,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			proj, projPath, err := workspace.DetectProjectAndPath()
+			if err != nil {
+				return err
+			}
+			return addLocalPackage(cmd.Context(), proj, projPath, args[0], force)
+		}),
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "Regenerate the SDK even if the schema of the component has not changed")
+	return cmd
+}
+
+// addLocalPackage generates an SDK for the component project at source, wires it into the project and adds the
+// component to the project's plugins.
+func addLocalPackage(ctx context.Context, proj *workspace.Project, projPath, source string, force bool) error {
+	root := filepath.Dir(projPath)
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return err
+	}
+
+	name, err := installLocalPackage(ctx, proj.Runtime.Name(), root, source, force)
+	if err != nil {
+		return err
+	}
+
+	// Plugin paths are relative to the project root, which keeps the project usable from other checkouts.
+	pluginPath, err := filepath.Rel(root, source)
+	if err != nil {
+		pluginPath = source
+	}
+	pluginPath = filepath.ToSlash(pluginPath)
+
+	if proj.Plugins == nil {
+		proj.Plugins = &workspace.Plugins{}
+	}
+	found := false
+	for i, provider := range proj.Plugins.Providers {
+		if provider.Name == name {
+			proj.Plugins.Providers[i].Path = pluginPath
+			found = true
+		}
+	}
+	if !found {
+		proj.Plugins.Providers = append(proj.Plugins.Providers, workspace.PluginOptions{Name: name, Path: pluginPath})
+	}
+	if err := proj.Save(projPath); err != nil {
+		return fmt.Errorf("save project: %w", err)
+	}
+
+	fmt.Printf("Added %s from %s. Run `pulumi install` to install the project's dependencies.\n", name, pluginPath)
+	return nil
+}
+
+// regenerateLocalPackages regenerates the SDKs of the project's local packages whose schema has changed. Local
+// packages are the provider plugins of the project that have an SDK generated by `pulumi package add`. Every local
+// package is regenerated even if others fail, and the errors are returned together.
+func regenerateLocalPackages(ctx context.Context, proj *workspace.Project, root string) error {
+	if proj.Plugins == nil {
+		return nil
+	}
+	var errs []error
+	for _, provider := range proj.Plugins.Providers {
+		if _, err := os.Stat(filepath.Join(root, "sdks", provider.Name, localPackageHashFile)); err != nil {
+			continue
+		}
+		source := provider.Path
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(root, source)
+		}
+		if _, err := installLocalPackage(ctx, proj.Runtime.Name(), root, source, false /*force*/); err != nil {
+			errs = append(errs, fmt.Errorf("regenerate SDK for %s: %w", provider.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// installLocalPackage generates the SDK for the component project at source into the project's sdks directory,
+// unless it is already up to date, and adds the SDK to the project's dependencies. It returns the name of the
+// package.
+func installLocalPackage(ctx context.Context, language, root, source string, force bool) (string, error) {
+	switch language {
+	case "nodejs", "python", "go":
+	default:
+		return "", fmt.Errorf("local packages are not supported for %s projects", language)
+	}
+
+	p, err := providerFromSource(source)
+	if err != nil {
+		return "", err
+	}
+	defer contract.IgnoreClose(p)
+	schemaBytes, err := p.GetSchema(0)
+	if err != nil {
+		return "", fmt.Errorf("get schema of %s: %w", source, err)
+	}
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		return "", fmt.Errorf("read schema of %s: %w", source, err)
+	}
+	if spec.Name == "" {
+		return "", fmt.Errorf("schema of %s does not have a name", source)
+	}
+
+	out := filepath.Join(root, "sdks", spec.Name)
+	sum := sha256.Sum256(schemaBytes)
+	hash := hex.EncodeToString(sum[:])
+
+	upToDate := false
+	if existing, err := os.ReadFile(filepath.Join(out, localPackageHashFile)); err == nil && !force {
+		upToDate = strings.TrimSpace(string(existing)) == hash
+	}
+
+	var goModulePath string
+	if language == "go" {
+		if goModulePath, err = localGoModulePath(root, spec.Name); err != nil {
+			return "", err
+		}
+	}
+
+	if upToDate {
+		logging.V(1).Infof("SDK for %s is up to date", spec.Name)
+	} else {
+		fmt.Printf("Generating %s SDK for %s into %s...\n", language, spec.Name, out)
+		if err := generateLocalSDK(ctx, language, spec, root, out, goModulePath+"/"+spec.Name); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filepath.Join(out, localPackageHashFile), []byte(hash+"\n"), 0o600); err != nil {
+			return "", err
+		}
+	}
+
+	switch language {
+	case "nodejs":
+		err = linkNodeJSPackage(ctx, root, out)
+	case "python":
+		err = linkPythonPackage(root, out)
+	case "go":
+		err = linkGoPackage(root, out, goModulePath)
+	}
+	if err != nil {
+		return "", fmt.Errorf("add SDK for %s to the project: %w", spec.Name, err)
+	}
+	return spec.Name, nil
+}
+
+// generateLocalSDK generates the SDK of a local package into out. SDKs are normally versioned when they are
+// published; local SDKs are instead generated at the version in the schema, so that they can be used as they are.
+//
+// Go SDKs are generated at goImportBasePath, and out becomes the module that contains it.
+func generateLocalSDK(
+	ctx context.Context, language string, spec schema.PackageSpec, root, out, goImportBasePath string,
+) error {
+	if spec.Version == "" {
+		spec.Version = "0.0.0"
+	}
+	options := map[string]interface{}{"respectSchemaVersion": true}
+	schemaLanguage := language
+	switch language {
+	case "nodejs", "python":
+	case "dotnet":
+		schemaLanguage = "csharp"
+	case "go":
+		options["importBasePath"] = goImportBasePath
+	default:
+		return fmt.Errorf("local SDKs are not supported for %s", language)
+	}
+	if err := setLanguageOptions(&spec, schemaLanguage, options); err != nil {
+		return err
+	}
+
+	pkg, diags, err := schema.BindSpec(spec, nil)
+	if err != nil {
+		return err
+	}
+	if diags.HasErrors() {
+		return diags
+	}
+	if err := genSDKInto(language, out, pkg, ""); err != nil {
+		return err
+	}
+
+	switch language {
+	case "nodejs":
+		return buildNodeJSPackage(ctx, out)
+	case "go":
+		return writeLocalGoModule(root, out, path.Dir(goImportBasePath))
+	}
+	return nil
+}
+
+// setLanguageOptions sets options in the language section of a schema, keeping the options the schema already sets.
+func setLanguageOptions(spec *schema.PackageSpec, language string, options map[string]interface{}) error {
+	merged := map[string]interface{}{}
+	if raw, ok := spec.Language[language]; ok {
+		if err := json.Unmarshal(raw, &merged); err != nil {
+			return fmt.Errorf("read %s options of schema: %w", language, err)
+		}
+	}
+	for k, v := range options {
+		merged[k] = v
+	}
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	if spec.Language == nil {
+		spec.Language = map[string]schema.RawMessage{}
+	}
+	spec.Language[language] = raw
+	return nil
+}
+
+// buildNodeJSPackage compiles a generated Node.js SDK, which is only shipped as TypeScript source.
+func buildNodeJSPackage(ctx context.Context, dir string) error {
+	path := filepath.Join(dir, "package.json")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var packageJSON map[string]interface{}
+	if err := json.Unmarshal(b, &packageJSON); err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	packageJSON["main"] = "bin/index.js"
+	packageJSON["types"] = "bin/index.d.ts"
+	if b, err = json.MarshalIndent(packageJSON, "", "    "); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o600); err != nil {
+		return err
+	}
+
+	if err := runNPM(ctx, dir, "install", "--no-audit", "--no-fund"); err != nil {
+		return err
+	}
+	return runNPM(ctx, dir, "run", "build")
+}
+
+// linkNodeJSPackage adds the Node.js SDK in dir to the dependencies of the project.
+func linkNodeJSPackage(ctx context.Context, root, dir string) error {
+	b, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return err
+	}
+	var packageJSON struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(b, &packageJSON); err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return err
+	}
+	// npm pkg edits package.json in place, keeping its formatting and order of keys.
+	return runNPM(ctx, root, "pkg", "set", "dependencies."+packageJSON.Name+"=file:"+filepath.ToSlash(rel))
+}
+
+func runNPM(ctx context.Context, dir string, args ...string) error {
+	npm, err := executable.FindExecutable("npm")
+	if err != nil {
+		return fmt.Errorf("find npm: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, npm, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	logging.V(1).Infof("Running %s", cmd)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("npm %s: %w", strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// linkPythonPackage adds the Python SDK in dir to the requirements of the project. The SDK is installed in editable
+// mode, so that regenerating it does not require reinstalling it.
+func linkPythonPackage(root, dir string) error {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return err
+	}
+	requirement := "-e " + filepath.ToSlash(rel)
+
+	path := filepath.Join(root, "requirements.txt")
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		fmt.Printf("The project does not have a requirements.txt file; add %s to its dependencies.\n", rel)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == requirement {
+			return nil
+		}
+	}
+	if len(b) > 0 && !bytes.HasSuffix(b, []byte("\n")) {
+		b = append(b, '\n')
+	}
+	b = append(b, requirement+"\n"...)
+	return os.WriteFile(path, b, 0o600)
+}
+
+// localGoModulePath returns the module path of the local Go SDK of a package, which is nested in the module of the
+// project.
+func localGoModulePath(root, name string) (string, error) {
+	b, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("read go.mod: %w", err)
+	}
+	modPath := modfile.ModulePath(b)
+	if modPath == "" {
+		return "", fmt.Errorf("no module path in %s", filepath.Join(root, "go.mod"))
+	}
+	return modPath + "/sdks/" + name, nil
+}
+
+// writeLocalGoModule writes the go.mod of the local Go SDK in dir. The SDK requires the same Go and Pulumi SDK
+// versions as the project.
+func writeLocalGoModule(root, dir, modPath string) error {
+	b, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return fmt.Errorf("read go.mod: %w", err)
+	}
+	project, err := modfile.ParseLax("go.mod", b, nil)
+	if err != nil {
+		return err
+	}
+
+	gomod := &modfile.File{}
+	if err := gomod.AddModuleStmt(modPath); err != nil {
+		return err
+	}
+	if project.Go != nil {
+		if err := gomod.AddGoStmt(project.Go.Version); err != nil {
+			return err
+		}
+	}
+	for _, req := range project.Require {
+		if req.Mod.Path == "github.com/pulumi/pulumi/sdk/v3" {
+			if err := gomod.AddRequire(req.Mod.Path, req.Mod.Version); err != nil {
+				return err
+			}
+		}
+	}
+	out, err := gomod.Format()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "go.mod"), out, 0o600)
+}
+
+// linkGoPackage requires the local Go SDK in dir from the project's go.mod, replaced by the SDK's directory.
+func linkGoPackage(root, dir, modPath string) error {
+	path := filepath.Join(root, "go.mod")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read go.mod: %w", err)
+	}
+	gomod, err := modfile.Parse(path, b, nil)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return err
+	}
+	required := false
+	for _, req := range gomod.Require {
+		required = required || req.Mod.Path == modPath
+		// A module that contains the SDK's module path would make the SDK's packages ambiguous.
+		if strings.HasPrefix(modPath, req.Mod.Path+"/") {
+			if err := gomod.DropRequire(req.Mod.Path); err != nil {
+				return err
+			}
+		}
+	}
+	if !required {
+		if err := gomod.AddRequire(modPath, localGoSDKVersion); err != nil {
+			return err
+		}
+	}
+	if err := gomod.AddReplace(modPath, "", "./"+filepath.ToSlash(rel), ""); err != nil {
+		return err
+	}
+	gomod.SortBlocks()
+	gomod.Cleanup()
+	out, err := gomod.Format()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o600)
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+func TestSetLanguageOptions(t *testing.T) {
+	t.Parallel()
+
+	spec := schema.PackageSpec{
+		Name: "component",
+		Language: map[string]schema.RawMessage{
+			"go": schema.RawMessage(`{"importBasePath": "github.com/example/component", "generics": "side-by-side"}`),
+		},
+	}
+	require.NoError(t, setLanguageOptions(&spec, "go", map[string]interface{}{
+		"importBasePath":       "example.com/project/sdks/component/component",
+		"respectSchemaVersion": true,
+	}))
+	require.NoError(t, setLanguageOptions(&spec, "python", map[string]interface{}{"respectSchemaVersion": true}))
+
+	assert.JSONEq(t, `{
+		"importBasePath": "example.com/project/sdks/component/component",
+		"generics": "side-by-side",
+		"respectSchemaVersion": true
+	}`, string(spec.Language["go"]))
+	assert.JSONEq(t, `{"respectSchemaVersion": true}`, string(spec.Language["python"]))
+}
+
+func TestLinkPythonPackage(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	requirements := filepath.Join(root, "requirements.txt")
+	require.NoError(t, os.WriteFile(requirements, []byte("pulumi>=3.0.0,<4.0.0"), 0o600))
+
+	sdk := filepath.Join(root, "sdks", "component")
+	require.NoError(t, linkPythonPackage(root, sdk))
+	// Linking the package again does not add a second requirement.
+	require.NoError(t, linkPythonPackage(root, sdk))
+
+	b, err := os.ReadFile(requirements)
+	require.NoError(t, err)
+	assert.Equal(t, "pulumi>=3.0.0,<4.0.0\n-e sdks/component\n", string(b))
+}
+
+func TestLinkGoPackage(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	gomod := filepath.Join(root, "go.mod")
+	require.NoError(t, os.WriteFile(gomod, []byte(`module example.com/project
+
+go 1.21
+
+require github.com/pulumi/pulumi/sdk/v3 v3.110.0
+`), 0o600))
+
+	modPath, err := localGoModulePath(root, "component")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com/project/sdks/component", modPath)
+
+	sdk := filepath.Join(root, "sdks", "component")
+	require.NoError(t, os.MkdirAll(sdk, 0o700))
+	require.NoError(t, writeLocalGoModule(root, sdk, modPath))
+	b, err := os.ReadFile(filepath.Join(sdk, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, `module example.com/project/sdks/component
+
+go 1.21
+
+require github.com/pulumi/pulumi/sdk/v3 v3.110.0
+`, string(b))
+
+	require.NoError(t, linkGoPackage(root, sdk, modPath))
+	// Linking the package again does not change go.mod.
+	require.NoError(t, linkGoPackage(root, sdk, modPath))
+	b, err = os.ReadFile(gomod)
+	require.NoError(t, err)
+	assert.Equal(t, `module example.com/project
+
+go 1.21
+
+require (
+	example.com/project/sdks/component v0.0.0-00010101000000-000000000000
+	github.com/pulumi/pulumi/sdk/v3 v3.110.0
+)
+
+replace example.com/project/sdks/component => ./sdks/component
+`, string(b))
+}
+
+func TestLinkGoPackageDropsContainingModule(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	gomod := filepath.Join(root, "go.mod")
+	require.NoError(t, os.WriteFile(gomod, []byte(`module bucket-1
+
+go 1.20
+
+require (
+	github.com/pulumi/pulumi-test/sdk/v2 v2.0.0
+	github.com/pulumi/pulumi/sdk/v3 v3.30.0
+)
+`), 0o600))
+
+	// The local SDK provides the packages that the published module would have.
+	sdk := filepath.Join(root, "sdks", "test")
+	require.NoError(t, linkGoPackage(root, sdk, "github.com/pulumi/pulumi-test/sdk/v2/go"))
+
+	b, err := os.ReadFile(gomod)
+	require.NoError(t, err)
+	assert.Equal(t, `module bucket-1
+
+go 1.20
+
+require (
+	github.com/pulumi/pulumi-test/sdk/v2/go v0.0.0-00010101000000-000000000000
+	github.com/pulumi/pulumi/sdk/v3 v3.30.0
+)
+
+replace github.com/pulumi/pulumi-test/sdk/v2/go => ./sdks/test
+`, string(b))
+}
@@ -0,0 +1,96 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/docs"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newGenDocsCommand() *cobra.Command {
+	var formats []string
+	var out string
+	cmd := &cobra.Command{
+		Use:   "gen-docs <schema_source>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Generate docs from a package or schema",
+		Long: `Generate docs from a package or schema.
+
+The docs are written as plain Markdown, with one file per module, resource and function, and/or as JSON
+files that describe each module, resource and function, including its constructor or function signatures in each
+language, its properties and its examples. Each format is written to a subdirectory of the output directory.
+
+<schema_source> can be a package name, the path to a plugin binary, or the path to a schema file.`,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			source := args[0]
+
+			for _, format := range formats {
+				switch docs.Format(format) {
+				case docs.FormatMarkdown, docs.FormatJSON:
+				default:
+					return fmt.Errorf("unknown docs format %q: expected one of [markdown|json]", format)
+				}
+			}
+
+			pkg, err := schemaFromSchemaSource(source)
+			if err != nil {
+				return err
+			}
+
+			for _, format := range formats {
+				if err := genDocs(docs.Format(format), out, pkg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringSliceVar(&formats, "format", []string{string(docs.FormatMarkdown)},
+		"The formats of the docs to generate: [markdown|json]")
+	cmd.Flags().StringVarP(&out, "out", "o", "./docs",
+		"The directory to write the docs to")
+	return cmd
+}
+
+func genDocs(format docs.Format, out string, pkg *schema.Package) error {
+	files, err := docs.GeneratePackageFormat("pulumi", pkg, format)
+	if err != nil {
+		return fmt.Errorf("generate %s docs: %w", format, err)
+	}
+
+	// Ensure the target directory is clean.
+	root := filepath.Join(out, string(format))
+	err = os.RemoveAll(root)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for k, v := range files {
+		path := filepath.Join(root, k)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, v, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
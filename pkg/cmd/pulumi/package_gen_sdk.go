@@ -42,7 +42,8 @@ func newGenSdkCommand() *cobra.Command {
 		Short: "Generate SDK(s) from a package or schema",
 		Long: `Generate SDK(s) from a package or schema.
 
-<schema_source> can be a package name, the path to a plugin binary, or the path to a schema file.`,
+<schema_source> can be a package name, the path to a plugin binary, the path to a plugin project directory,
+or the path to a schema file.`,
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			source := args[0]
 
@@ -80,6 +81,11 @@ func newGenSdkCommand() *cobra.Command {
 }
 
 func genSDK(language, out string, pkg *schema.Package, overlays string) error {
+	return genSDKInto(language, filepath.Join(out, language), pkg, overlays)
+}
+
+// genSDKInto generates the SDK for language into root, replacing anything that is already there.
+func genSDKInto(language, root string, pkg *schema.Package, overlays string) error {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("get current working directory: %w", err)
@@ -194,7 +200,6 @@ func genSDK(language, out string, pkg *schema.Package, overlays string) error {
 		}
 	}
 
-	root := filepath.Join(out, language)
 	err = generatePackage(root, pkg, extraFiles)
 	if err != nil {
 		return err
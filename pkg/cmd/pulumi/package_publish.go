@@ -47,11 +47,25 @@ func newPackagePublishCmd() *cobra.Command {
 		`The path to the root of your package.
 	Example: ./sdk/nodejs
 	`)
+	cmd.PersistentFlags().StringVar(&publCmd.Version, "version", "",
+		"The version to publish. Required for go, where it is the version of the tag to create. "+
+			"For python and dotnet it overrides the version of the built package")
+	cmd.PersistentFlags().StringVar(&publCmd.Repository, "repository", "",
+		"The URL of the registry to publish to. Defaults to "+defaultPyPIRepository+" for python, "+
+			defaultNuGetSource+" for dotnet and the first proxy in GOPROXY for go")
+	cmd.PersistentFlags().StringVar(&publCmd.Remote, "remote", "origin",
+		"The git remote to push the tags of Go modules to")
+	cmd.PersistentFlags().BoolVar(&publCmd.DryRun, "dry-run", false,
+		"Build and verify the package, but do not publish it")
 	return cmd
 }
 
 type publishCmd struct {
-	Path string
+	Path       string
+	Version    string
+	Repository string
+	Remote     string
+	DryRun     bool
 }
 
 func (cmd *publishCmd) Run(ctx context.Context, args []string) error {
@@ -66,7 +80,13 @@ func (cmd *publishCmd) Run(ctx context.Context, args []string) error {
 		if err != nil {
 			return err
 		}
-	case "all", "python", "java", "dotnet":
+	case "python":
+		return publishToPyPI(ctx, cmd.Path, cmd.Version, cmd.Repository, cmd.DryRun)
+	case "dotnet":
+		return publishToNuGet(ctx, cmd.Path, cmd.Version, cmd.Repository, cmd.DryRun)
+	case "go":
+		return publishToGoProxy(ctx, cmd.Path, cmd.Version, cmd.Repository, cmd.Remote, cmd.DryRun)
+	case "all", "java":
 		return fmt.Errorf("support for %q coming soon", lang)
 
 	default:
@@ -76,8 +96,8 @@ func (cmd *publishCmd) Run(ctx context.Context, args []string) error {
 	return nil
 }
 
-func publishToNPM(path string) error {
-	// verify path
+// verifyPackagePath checks that the path to the root of a package is a directory.
+func verifyPackagePath(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
 		return fmt.Errorf("reading path %s: %w", path, err)
@@ -85,6 +105,13 @@ func publishToNPM(path string) error {
 	if !info.IsDir() {
 		return fmt.Errorf("not a directory: %s", path)
 	}
+	return nil
+}
+
+func publishToNPM(path string) error {
+	if err := verifyPackagePath(path); err != nil {
+		return err
+	}
 
 	// Verify npm exists and is set up: npm, user login
 	npm, err := executable.FindExecutable("npm")
@@ -0,0 +1,197 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/executable"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// defaultNuGetSource is the service index of the public NuGet feed.
+const defaultNuGetSource = "https://api.nuget.org/v3/index.json"
+
+func publishToNuGet(ctx context.Context, path, version, source string, dryRun bool) error {
+	if err := verifyPackagePath(path); err != nil {
+		return err
+	}
+	if source == "" {
+		source = defaultNuGetSource
+	}
+
+	// The API key is read from the environment so that it does not end up in shell histories or process listings.
+	apiKey := os.Getenv("NUGET_PUBLISH_KEY")
+	if apiKey == "" && !dryRun {
+		return errors.New("NUGET_PUBLISH_KEY must be set to publish to a NuGet feed")
+	}
+
+	out, err := os.MkdirTemp("", "pulumi-publish-dotnet")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(out)
+
+	nupkg, err := packNuGetPackage(ctx, path, version, out)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Printf("would push %s to %s\n", filepath.Base(nupkg), source)
+		return nil
+	}
+
+	fmt.Printf("Pushing %s to %s...\n", filepath.Base(nupkg), source)
+	pushed, err := pushToNuGet(ctx, http.DefaultClient, source, apiKey, nupkg)
+	if err != nil {
+		return err
+	}
+	if !pushed {
+		fmt.Printf("did not push %s because it already exists\n", filepath.Base(nupkg))
+		return nil
+	}
+	fmt.Println("success! published to NuGet")
+	return nil
+}
+
+// packNuGetPackage packs the .NET project at path into out and returns the path of the package.
+func packNuGetPackage(ctx context.Context, path, version, out string) (string, error) {
+	dotnet, err := executable.FindExecutable("dotnet")
+	if err != nil {
+		return "", fmt.Errorf("find dotnet: %w", err)
+	}
+
+	args := []string{"pack", path, "--configuration", "Release", "--output", out}
+	if version != "" {
+		args = append(args, "-p:Version="+strings.TrimPrefix(version, "v"))
+	}
+	packCmd := exec.CommandContext(ctx, dotnet, args...)
+	packCmd.Stdout = os.Stderr
+	packCmd.Stderr = os.Stderr
+	logging.V(1).Infof("Running %s", packCmd)
+	if err := packCmd.Run(); err != nil {
+		return "", fmt.Errorf("dotnet pack: %w", err)
+	}
+
+	// The pattern does not match symbol packages (.snupkg), which are not published.
+	matches, err := filepath.Glob(filepath.Join(out, "*.nupkg"))
+	if err != nil {
+		return "", err
+	}
+	if len(matches) != 1 {
+		return "", fmt.Errorf("expected dotnet pack to produce one package, found %d", len(matches))
+	}
+	return matches[0], nil
+}
+
+// nugetPublishEndpoint returns the URL to push packages to for a NuGet source. Sources that end with index.json are
+// V3 service indexes, which list the URL of their package publish resource. Any other source is taken to be the
+// publish URL itself.
+func nugetPublishEndpoint(ctx context.Context, client *http.Client, source string) (string, error) {
+	if !strings.HasSuffix(source, "index.json") {
+		return source, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("read service index %s: %w", source, err)
+	}
+	defer contract.IgnoreClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("read service index %s: %s", source, resp.Status)
+	}
+
+	var index struct {
+		Resources []struct {
+			ID   string `json:"@id"`
+			Type string `json:"@type"`
+		} `json:"resources"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return "", fmt.Errorf("read service index %s: %w", source, err)
+	}
+	for _, resource := range index.Resources {
+		if resource.Type == "PackagePublish/2.0.0" {
+			return resource.ID, nil
+		}
+	}
+	return "", fmt.Errorf("service index %s does not have a package publish resource", source)
+}
+
+// pushToNuGet pushes a package to a NuGet source using the package publish API. It returns false if the source
+// already has the package.
+func pushToNuGet(ctx context.Context, client *http.Client, source, apiKey, nupkg string) (bool, error) {
+	endpoint, err := nugetPublishEndpoint(ctx, client, source)
+	if err != nil {
+		return false, err
+	}
+
+	content, err := os.ReadFile(nupkg)
+	if err != nil {
+		return false, err
+	}
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("package", filepath.Base(nupkg))
+	if err != nil {
+		return false, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return false, err
+	}
+	if err := w.Close(); err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, &body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Header.Set("X-NuGet-ApiKey", apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("push %s: %w", filepath.Base(nupkg), err)
+	}
+	defer contract.IgnoreClose(resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return true, nil
+	case resp.StatusCode == http.StatusConflict:
+		return false, nil
+	default:
+		respBody, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf("push %s: %s: %s", filepath.Base(nupkg), resp.Status, strings.TrimSpace(string(respBody)))
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/executable"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// goModuleRelease is a version of a Go module that is published by tagging the commit of the repository that contains
+// the module.
+type goModuleRelease struct {
+	// Module is the path of the module.
+	Module string
+	// Version is the canonical semantic version of the release.
+	Version string
+	// Tag is the name of the tag for the release, which is prefixed by the directory of the module in the repository.
+	Tag string
+	// Repository is the root directory of the repository.
+	Repository string
+}
+
+// publishToGoProxy publishes a Go module by tagging the current commit and pushing the tag to the remote. Module
+// proxies serve the new version once they are asked for it, which the command does to verify the release.
+func publishToGoProxy(ctx context.Context, path, version, proxy, remote string, dryRun bool) error {
+	if err := verifyPackagePath(path); err != nil {
+		return err
+	}
+	if version == "" {
+		return errors.New("--version is required to publish a Go module")
+	}
+
+	release, err := newGoModuleRelease(ctx, path, version)
+	if err != nil {
+		return err
+	}
+
+	// Read the settings the way the go command resolves them, so that values set with `go env -w` are respected.
+	env, err := goEnv(ctx, path, "GOPROXY", "GOPRIVATE", "GONOPROXY")
+	if err != nil {
+		return err
+	}
+	if proxy == "" {
+		proxy = goProxyFromEnv(env["GOPROXY"])
+	}
+	if proxy != "" && goModuleIsPrivate(env, release.Module) {
+		// The go command never asks a proxy for private modules, so neither do we: that would disclose the path.
+		fmt.Printf("not checking %s for %s because the module is private (GOPRIVATE or GONOPROXY)\n",
+			proxy, release.Module)
+		proxy = ""
+	}
+
+	if proxy != "" {
+		published, err := goProxyHasVersion(ctx, http.DefaultClient, proxy, release.Module, release.Version)
+		if err != nil {
+			return err
+		}
+		if published {
+			fmt.Printf("did not publish %s because version %s is already available from %s\n",
+				release.Module, release.Version, proxy)
+			return nil
+		}
+	}
+
+	if dryRun {
+		fmt.Printf("would tag %s and push it to %s\n", release.Tag, remote)
+		return nil
+	}
+
+	if err := release.tag(ctx, remote); err != nil {
+		return err
+	}
+
+	if proxy != "" {
+		published, err := goProxyHasVersion(ctx, http.DefaultClient, proxy, release.Module, release.Version)
+		if err != nil {
+			return err
+		}
+		if !published {
+			// Proxies that cannot reach the repository, e.g. because it is private, never serve the module. The
+			// tag is still usable directly through GOPRIVATE.
+			fmt.Printf("warning: %s does not serve %s@%s yet\n", proxy, release.Module, release.Version)
+		}
+	}
+	fmt.Printf("success! published %s@%s\n", release.Module, release.Version)
+	return nil
+}
+
+// newGoModuleRelease checks that the module at path can be published at version, and returns the release.
+func newGoModuleRelease(ctx context.Context, path, version string) (*goModuleRelease, error) {
+	gomod, err := os.ReadFile(filepath.Join(path, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("read go.mod: %w", err)
+	}
+	modPath := modfile.ModulePath(gomod)
+	if modPath == "" {
+		return nil, fmt.Errorf("no module path in %s", filepath.Join(path, "go.mod"))
+	}
+
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) || semver.Canonical(version) != version {
+		return nil, fmt.Errorf("invalid version %q: Go modules require a semantic version such as v1.2.3", version)
+	}
+	_, pathMajor, ok := module.SplitPathVersion(modPath)
+	if !ok {
+		return nil, fmt.Errorf("invalid module path %q", modPath)
+	}
+	if err := module.CheckPathMajor(version, pathMajor); err != nil {
+		return nil, fmt.Errorf("cannot publish %s at %s: %w", modPath, version, err)
+	}
+
+	root, err := runGit(ctx, path, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	if status, err := runGit(ctx, path, "status", "--porcelain", "--", "."); err != nil {
+		return nil, err
+	} else if status != "" {
+		return nil, fmt.Errorf("%s has uncommitted changes; commit them before publishing:\n%s", path, status)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	// Resolve symlinks so that the path is relative to the root that git reports.
+	if abs, err = filepath.EvalSymlinks(abs); err != nil {
+		return nil, err
+	}
+	dir, err := filepath.Rel(root, abs)
+	if err != nil {
+		return nil, err
+	}
+	dir = filepath.ToSlash(dir)
+	// Modules in a major version subdirectory are tagged without the subdirectory.
+	if major := strings.TrimPrefix(pathMajor, "/"); major != "" && major != pathMajor {
+		if dir == major {
+			dir = "."
+		} else {
+			dir = strings.TrimSuffix(dir, "/"+major)
+		}
+	}
+
+	tag := version
+	if dir != "." {
+		tag = dir + "/" + version
+	}
+	return &goModuleRelease{
+		Module:     modPath,
+		Version:    version,
+		Tag:        tag,
+		Repository: root,
+	}, nil
+}
+
+// tag tags the current commit with the release's tag, unless it is already tagged, and pushes the tag to remote.
+func (r *goModuleRelease) tag(ctx context.Context, remote string) error {
+	head, err := runGit(ctx, r.Repository, "rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	tagged, err := runGit(ctx, r.Repository, "rev-parse", "-q", "--verify", "refs/tags/"+r.Tag+"^{commit}")
+	switch {
+	case err != nil:
+		// rev-parse --verify fails if the tag does not exist.
+		fmt.Printf("Tagging %s as %s...\n", head, r.Tag)
+		if _, err := runGit(ctx, r.Repository, "tag", r.Tag, head); err != nil {
+			return err
+		}
+	case tagged != head:
+		return fmt.Errorf("tag %s already exists and points to %s rather than the current commit %s", r.Tag, tagged, head)
+	}
+
+	fmt.Printf("Pushing %s to %s...\n", r.Tag, remote)
+	_, err = runGit(ctx, r.Repository, "push", remote, "refs/tags/"+r.Tag)
+	return err
+}
+
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	git, err := executable.FindExecutable("git")
+	if err != nil {
+		return "", fmt.Errorf("find git: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, git, args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	logging.V(1).Infof("Running %s", cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// goEnv returns the values of the named go environment variables, as reported by `go env` in dir.
+func goEnv(ctx context.Context, dir string, names ...string) (map[string]string, error) {
+	gobin, err := executable.FindExecutable("go")
+	if err != nil {
+		return nil, fmt.Errorf("find go: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, gobin, append([]string{"env", "-json"}, names...)...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	logging.V(1).Infof("Running %s", cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go env: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	var env map[string]string
+	if err := json.Unmarshal(out, &env); err != nil {
+		return nil, fmt.Errorf("parse go env: %w", err)
+	}
+	return env, nil
+}
+
+// goModuleIsPrivate returns true if GOPRIVATE or GONOPROXY in env match the module path, in which case the go command
+// fetches the module directly rather than through a proxy.
+func goModuleIsPrivate(env map[string]string, modPath string) bool {
+	return module.MatchPrefixPatterns(env["GONOPROXY"], modPath) || module.MatchPrefixPatterns(env["GOPRIVATE"], modPath)
+}
+
+// goProxyFromEnv returns the first module proxy in the value of GOPROXY, or the empty string if the value does not
+// list any proxy.
+func goProxyFromEnv(goproxy string) string {
+	if goproxy == "" {
+		goproxy = "https://proxy.golang.org,direct"
+	}
+	for _, entry := range strings.FieldsFunc(goproxy, func(r rune) bool { return r == ',' || r == '|' }) {
+		if entry != "direct" && entry != "off" {
+			return entry
+		}
+	}
+	return ""
+}
+
+// goProxyHasVersion returns true if the module proxy serves the version of the module.
+func goProxyHasVersion(ctx context.Context, client *http.Client, proxy, modPath, version string) (bool, error) {
+	escapedPath, err := module.EscapePath(modPath)
+	if err != nil {
+		return false, err
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return false, err
+	}
+	url := fmt.Sprintf("%s/%s/@v/%s.info", strings.TrimSuffix(proxy, "/"), escapedPath, escapedVersion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("query module proxy: %w", err)
+	}
+	defer contract.IgnoreClose(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound, http.StatusGone:
+		return false, nil
+	default:
+		return false, fmt.Errorf("query module proxy %s: %s", url, resp.Status)
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5" //nolint:gosec // The upload API requires an MD5 digest alongside the SHA256 digest.
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/mail"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+
+	pygen "github.com/pulumi/pulumi/pkg/v3/codegen/python"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/python"
+)
+
+// defaultPyPIRepository is the upload endpoint of the public Python package index.
+const defaultPyPIRepository = "https://upload.pypi.org/legacy/"
+
+// pypiCredentials are the credentials for uploading to a Python package index. They are read from the PYPI_USERNAME
+// and PYPI_PASSWORD environment variables; to upload with an API token, set PYPI_USERNAME to __token__ and
+// PYPI_PASSWORD to the token.
+type pypiCredentials struct {
+	Username string
+	Password string
+}
+
+func pypiCredentialsFromEnv() (pypiCredentials, error) {
+	creds := pypiCredentials{
+		Username: os.Getenv("PYPI_USERNAME"),
+		Password: os.Getenv("PYPI_PASSWORD"),
+	}
+	if creds.Password == "" {
+		return creds, errors.New("PYPI_PASSWORD must be set to publish to a Python package index")
+	}
+	if creds.Username == "" {
+		creds.Username = "__token__"
+	}
+	return creds, nil
+}
+
+func publishToPyPI(ctx context.Context, path, version, repository string, dryRun bool) error {
+	if err := verifyPackagePath(path); err != nil {
+		return err
+	}
+	if repository == "" {
+		repository = defaultPyPIRepository
+	}
+
+	var creds pypiCredentials
+	if !dryRun {
+		var err error
+		if creds, err = pypiCredentialsFromEnv(); err != nil {
+			return err
+		}
+	}
+
+	dist, err := os.MkdirTemp("", "pulumi-publish-python")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dist)
+
+	artifacts, err := buildPythonDistributions(ctx, path, version, dist)
+	if err != nil {
+		return err
+	}
+
+	for _, artifact := range artifacts {
+		if dryRun {
+			fmt.Printf("would upload %s to %s\n", filepath.Base(artifact), repository)
+			continue
+		}
+
+		fmt.Printf("Uploading %s to %s...\n", filepath.Base(artifact), repository)
+		uploaded, err := uploadToPyPI(ctx, http.DefaultClient, repository, creds, artifact)
+		if err != nil {
+			return err
+		}
+		if !uploaded {
+			fmt.Printf("did not upload %s because it already exists\n", filepath.Base(artifact))
+		}
+	}
+	if !dryRun {
+		fmt.Println("success! published to the Python package index")
+	}
+	return nil
+}
+
+// buildPythonDistributions builds the source distribution and wheel of the Python package at path into dist, and
+// returns the paths of the built files.
+func buildPythonDistributions(ctx context.Context, path, version, dist string) ([]string, error) {
+	buildCmd, err := python.Command(ctx, "-m", "build", "--sdist", "--wheel", "--outdir", dist)
+	if err != nil {
+		return nil, err
+	}
+	buildCmd.Dir = path
+	buildCmd.Env = os.Environ()
+	if version != "" {
+		v, err := semver.ParseTolerant(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", version, err)
+		}
+		// The generated setup.py reads its version from the environment.
+		buildCmd.Env = append(buildCmd.Env, "PULUMI_PYTHON_VERSION="+pygen.PypiVersion(v))
+	}
+	buildCmd.Stdout = os.Stderr
+	buildCmd.Stderr = os.Stderr
+	logging.V(1).Infof("Running %s", buildCmd)
+	if err := buildCmd.Run(); err != nil {
+		return nil, fmt.Errorf("run python build (is the build package installed?): %w", err)
+	}
+
+	entries, err := os.ReadDir(dist)
+	if err != nil {
+		return nil, fmt.Errorf("read distributions: %w", err)
+	}
+	var artifacts []string
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".whl") || strings.HasSuffix(entry.Name(), ".tar.gz") {
+			artifacts = append(artifacts, filepath.Join(dist, entry.Name()))
+		}
+	}
+	if len(artifacts) == 0 {
+		return nil, fmt.Errorf("python build did not produce any distributions in %s", dist)
+	}
+	// Upload the source distribution first, as twine does.
+	sort.Slice(artifacts, func(i, j int) bool {
+		return strings.HasSuffix(artifacts[i], ".tar.gz") && !strings.HasSuffix(artifacts[j], ".tar.gz")
+	})
+	return artifacts, nil
+}
+
+// uploadToPyPI uploads a distribution to a package index using the upload API of PyPI, which is also implemented by
+// other package indexes. It returns false if the index already has the file.
+func uploadToPyPI(
+	ctx context.Context, client *http.Client, repository string, creds pypiCredentials, artifact string,
+) (bool, error) {
+	content, err := os.ReadFile(artifact)
+	if err != nil {
+		return false, err
+	}
+
+	name := filepath.Base(artifact)
+	var filetype, pyversion string
+	var metadata []byte
+	switch {
+	case strings.HasSuffix(name, ".whl"):
+		// Wheels are named {distribution}-{version}(-{build tag})?-{python tag}-{abi tag}-{platform tag}.whl.
+		parts := strings.Split(strings.TrimSuffix(name, ".whl"), "-")
+		if len(parts) < 5 {
+			return false, fmt.Errorf("invalid wheel name %q", name)
+		}
+		filetype, pyversion = "bdist_wheel", parts[len(parts)-3]
+		metadata, err = wheelMetadata(content)
+	case strings.HasSuffix(name, ".tar.gz"):
+		filetype, pyversion = "sdist", "source"
+		metadata, err = sdistMetadata(content)
+	default:
+		return false, fmt.Errorf("unknown distribution type of %q", name)
+	}
+	if err != nil {
+		return false, fmt.Errorf("read metadata of %s: %w", name, err)
+	}
+
+	fields, err := pypiMetadataFields(metadata)
+	if err != nil {
+		return false, fmt.Errorf("read metadata of %s: %w", name, err)
+	}
+	sha := sha256.Sum256(content)
+	md := md5.Sum(content) //nolint:gosec
+	fields = append(fields,
+		[2]string{":action", "file_upload"},
+		[2]string{"protocol_version", "1"},
+		[2]string{"filetype", filetype},
+		[2]string{"pyversion", pyversion},
+		[2]string{"sha256_digest", hex.EncodeToString(sha[:])},
+		[2]string{"md5_digest", hex.EncodeToString(md[:])},
+	)
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, field := range fields {
+		if err := w.WriteField(field[0], field[1]); err != nil {
+			return false, err
+		}
+	}
+	part, err := w.CreateFormFile("content", name)
+	if err != nil {
+		return false, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return false, err
+	}
+	if err := w.Close(); err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, repository, &body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.SetBasicAuth(creds.Username, creds.Password)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("upload %s: %w", name, err)
+	}
+	defer contract.IgnoreClose(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, fmt.Errorf("upload %s: %w", name, err)
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return true, nil
+	case resp.StatusCode == http.StatusConflict,
+		resp.StatusCode == http.StatusBadRequest && bytes.Contains(respBody, []byte("already exists")):
+		return false, nil
+	default:
+		return false, fmt.Errorf("upload %s: %s: %s", name, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+}
+
+// wheelMetadata returns the core metadata file of a wheel.
+func wheelMetadata(wheel []byte) ([]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(wheel), int64(len(wheel)))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range r.File {
+		if dir, file := path.Split(f.Name); file == "METADATA" && strings.HasSuffix(dir, ".dist-info/") {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer contract.IgnoreClose(rc)
+			return io.ReadAll(rc)
+		}
+	}
+	return nil, errors.New("no .dist-info/METADATA file")
+}
+
+// sdistMetadata returns the core metadata file of a source distribution.
+func sdistMetadata(sdist []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(sdist))
+	if err != nil {
+		return nil, err
+	}
+	r := tar.NewReader(gz)
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			return nil, errors.New("no PKG-INFO file")
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The metadata of the distribution is at the root of its single top-level directory.
+		if strings.Count(hdr.Name, "/") == 1 && path.Base(hdr.Name) == "PKG-INFO" {
+			return io.ReadAll(r)
+		}
+	}
+}
+
+// pypiMetadataFields converts a core metadata file to the form fields of the upload API. The fields are named after
+// the lower-cased metadata fields, with dashes replaced by underscores, and the body of the file is the description.
+func pypiMetadataFields(metadata []byte) ([][2]string, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(bytes.ReplaceAll(metadata, []byte("\r\n"), []byte("\n"))))
+	if err != nil {
+		return nil, err
+	}
+
+	multiple := map[string]string{
+		"Classifier":     "classifiers",
+		"Project-Url":    "project_urls",
+		"Requires-Dist":  "requires_dist",
+		"Provides-Extra": "provides_extra",
+	}
+
+	keys := make([]string, 0, len(msg.Header))
+	for k := range msg.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var fields [][2]string
+	for _, k := range keys {
+		name, ok := multiple[k]
+		if !ok {
+			name = strings.ReplaceAll(strings.ToLower(k), "-", "_")
+		}
+		for _, v := range msg.Header[k] {
+			fields = append(fields, [2]string{name, v})
+		}
+	}
+	for _, required := range []string{"metadata_version", "name", "version"} {
+		found := false
+		for _, f := range fields {
+			found = found || f[0] == required
+		}
+		if !found {
+			return nil, fmt.Errorf("missing %s", required)
+		}
+	}
+
+	description, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	if description := strings.TrimSpace(string(description)); description != "" {
+		fields = append(fields, [2]string{"description", description})
+	}
+	return fields, nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePackageIndex is a stand-in for a package registry. It records the uploads that it accepts and rejects uploads
+// of files that it already has.
+type fakePackageIndex struct {
+	t *testing.T
+
+	m       sync.Mutex
+	uploads map[string][]byte
+	forms   map[string]map[string][]string
+}
+
+func newFakePackageIndex(t *testing.T) *fakePackageIndex {
+	return &fakePackageIndex{
+		t:       t,
+		uploads: map[string][]byte{},
+		forms:   map[string]map[string][]string{},
+	}
+}
+
+// serveUpload accepts an upload of the given multipart form file. It responds with 409 Conflict if the index already
+// has a file of the same name.
+func (idx *fakePackageIndex) serveUpload(w http.ResponseWriter, r *http.Request, field string) {
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f, hdr, err := r.FormFile(field)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	content, err := io.ReadAll(f)
+	require.NoError(idx.t, err)
+
+	idx.m.Lock()
+	defer idx.m.Unlock()
+	if _, ok := idx.uploads[hdr.Filename]; ok {
+		http.Error(w, "File already exists.", http.StatusConflict)
+		return
+	}
+	idx.uploads[hdr.Filename] = content
+	idx.forms[hdr.Filename] = r.MultipartForm.Value
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeTestWheel(t *testing.T, dir string) string {
+	path := filepath.Join(dir, "pulumi_test-1.2.3-py3-none-any.whl")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	metadata, err := w.Create("pulumi_test-1.2.3.dist-info/METADATA")
+	require.NoError(t, err)
+	_, err = io.WriteString(metadata, `Metadata-Version: 2.1
+Name: pulumi_test
+Version: 1.2.3
+Summary: A test package
+Requires-Python: >=3.8
+Requires-Dist: parver>=0.2.1
+Requires-Dist: pulumi<4.0.0,>=3.0.0
+Description-Content-Type: text/markdown
+
+# pulumi_test
+
+A test package.
+`)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return path
+}
+
+func TestUploadToPyPI(t *testing.T) {
+	t.Parallel()
+
+	index := newFakePackageIndex(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "__token__" || password != "secret" {
+			http.Error(w, "Invalid or non-existent authentication information.", http.StatusForbidden)
+			return
+		}
+		index.serveUpload(w, r, "content")
+	}))
+	defer server.Close()
+
+	wheel := writeTestWheel(t, t.TempDir())
+	creds := pypiCredentials{Username: "__token__", Password: "secret"}
+
+	uploaded, err := uploadToPyPI(context.Background(), server.Client(), server.URL, creds, wheel)
+	require.NoError(t, err)
+	assert.True(t, uploaded)
+
+	content, err := os.ReadFile(wheel)
+	require.NoError(t, err)
+	sha := sha256.Sum256(content)
+	form := index.forms[filepath.Base(wheel)]
+	assert.Equal(t, content, index.uploads[filepath.Base(wheel)])
+	assert.Equal(t, []string{"file_upload"}, form[":action"])
+	assert.Equal(t, []string{"bdist_wheel"}, form["filetype"])
+	assert.Equal(t, []string{"py3"}, form["pyversion"])
+	assert.Equal(t, []string{"2.1"}, form["metadata_version"])
+	assert.Equal(t, []string{"pulumi_test"}, form["name"])
+	assert.Equal(t, []string{"1.2.3"}, form["version"])
+	assert.Equal(t, []string{"parver>=0.2.1", "pulumi<4.0.0,>=3.0.0"}, form["requires_dist"])
+	assert.Equal(t, []string{"# pulumi_test\n\nA test package."}, form["description"])
+	assert.Equal(t, []string{hex.EncodeToString(sha[:])}, form["sha256_digest"])
+
+	// Uploading the same file again is not an error.
+	uploaded, err = uploadToPyPI(context.Background(), server.Client(), server.URL, creds, wheel)
+	require.NoError(t, err)
+	assert.False(t, uploaded)
+
+	_, err = uploadToPyPI(context.Background(), server.Client(), server.URL,
+		pypiCredentials{Username: "__token__", Password: "wrong"}, wheel)
+	assert.ErrorContains(t, err, "403 Forbidden: Invalid or non-existent authentication information.")
+}
+
+func TestPushToNuGet(t *testing.T) {
+	t.Parallel()
+
+	index := newFakePackageIndex(t)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/v3/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version": "3.0.0", "resources": [
+			{"@id": "%[1]s/query", "@type": "SearchQueryService"},
+			{"@id": "%[1]s/api/v2/package", "@type": "PackagePublish/2.0.0"}
+		]}`, server.URL)
+	})
+	mux.HandleFunc("/api/v2/package", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.Header.Get("X-NuGet-ApiKey") != "secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		index.serveUpload(w, r, "package")
+	})
+
+	nupkg := filepath.Join(t.TempDir(), "Pulumi.Test.1.2.3.nupkg")
+	require.NoError(t, os.WriteFile(nupkg, []byte("nupkg"), 0o600))
+
+	pushed, err := pushToNuGet(context.Background(), server.Client(), server.URL+"/v3/index.json", "secret", nupkg)
+	require.NoError(t, err)
+	assert.True(t, pushed)
+	assert.Equal(t, []byte("nupkg"), index.uploads["Pulumi.Test.1.2.3.nupkg"])
+
+	pushed, err = pushToNuGet(context.Background(), server.Client(), server.URL+"/api/v2/package", "secret", nupkg)
+	require.NoError(t, err)
+	assert.False(t, pushed)
+
+	_, err = pushToNuGet(context.Background(), server.Client(), server.URL+"/v3/index.json", "wrong", nupkg)
+	assert.ErrorContains(t, err, "401 Unauthorized")
+}
+
+func TestGoProxyFromEnv(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://proxy.golang.org", goProxyFromEnv(""))
+	assert.Equal(t, "https://goproxy.example.com", goProxyFromEnv("direct|https://goproxy.example.com,off"))
+	assert.Equal(t, "", goProxyFromEnv("direct"))
+}
+
+func TestGoModuleIsPrivate(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{"GOPRIVATE": "example.com/private", "GONOPROXY": "*.corp.example.com"}
+	assert.True(t, goModuleIsPrivate(env, "example.com/private/sdk"))
+	assert.True(t, goModuleIsPrivate(env, "git.corp.example.com/sdk/v2"))
+	assert.False(t, goModuleIsPrivate(env, "example.com/public/sdk"))
+	assert.False(t, goModuleIsPrivate(map[string]string{}, "example.com/private/sdk"))
+}
+
+func TestGoEnv(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not found")
+	}
+	t.Setenv("GOPRIVATE", "example.com/private")
+
+	env, err := goEnv(context.Background(), t.TempDir(), "GOPRIVATE", "GONOPROXY")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com/private", env["GOPRIVATE"])
+	// The go command defaults GONOPROXY to GOPRIVATE.
+	assert.Equal(t, "example.com/private", env["GONOPROXY"])
+}
+
+func TestGoProxyHasVersion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github.com/!example/sdk/@v/v1.0.0.info":
+			fmt.Fprint(w, `{"Version": "v1.0.0", "Time": "2024-03-19T00:00:00Z"}`)
+		case "/github.com/!example/sdk/@v/v1.1.0.info":
+			http.Error(w, "not found", http.StatusNotFound)
+		default:
+			http.Error(w, "unexpected request", http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	published, err := goProxyHasVersion(context.Background(), server.Client(), server.URL+"/",
+		"github.com/Example/sdk", "v1.0.0")
+	require.NoError(t, err)
+	assert.True(t, published)
+
+	published, err = goProxyHasVersion(context.Background(), server.Client(), server.URL,
+		"github.com/Example/sdk", "v1.1.0")
+	require.NoError(t, err)
+	assert.False(t, published)
+
+	_, err = goProxyHasVersion(context.Background(), server.Client(), server.URL, "github.com/Example/other", "v1.0.0")
+	assert.ErrorContains(t, err, "500 Internal Server Error")
+}
+
+func TestGoModuleRelease(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	writeModule := func(dir, modPath string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, dir), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(repo, dir, "go.mod"), []byte("module "+modPath+"\n"), 0o600))
+	}
+	writeModule(".", "github.com/example/root")
+	writeModule("sdk", "github.com/example/root/sdk")
+	writeModule("sdk/v2", "github.com/example/root/sdk/v2")
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "%s", out)
+	}
+	git("init", "-q")
+	git("add", "-A")
+	git("-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init")
+	git("tag", "sdk/v1.0.0")
+
+	ctx := context.Background()
+	cases := []struct {
+		dir, version, tag string
+	}{
+		{".", "1.0.0", "v1.0.0"},
+		{"sdk", "v1.2.3", "sdk/v1.2.3"},
+		{"sdk/v2", "2.0.0-alpha.1", "sdk/v2.0.0-alpha.1"},
+	}
+	for _, c := range cases {
+		release, err := newGoModuleRelease(ctx, filepath.Join(repo, c.dir), c.version)
+		require.NoError(t, err, c.dir)
+		assert.Equal(t, c.tag, release.Tag)
+	}
+
+	_, err := newGoModuleRelease(ctx, filepath.Join(repo, "sdk"), "2.0.0")
+	assert.ErrorContains(t, err, "cannot publish github.com/example/root/sdk at v2.0.0")
+	_, err = newGoModuleRelease(ctx, filepath.Join(repo, "sdk/v2"), "1.0.0")
+	assert.ErrorContains(t, err, "cannot publish github.com/example/root/sdk/v2 at v1.0.0")
+	_, err = newGoModuleRelease(ctx, filepath.Join(repo, "sdk"), "1.0")
+	assert.ErrorContains(t, err, `invalid version "v1.0"`)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "sdk", "sdk.go"), []byte("package sdk\n"), 0o600))
+	_, err = newGoModuleRelease(ctx, filepath.Join(repo, "sdk"), "1.0.0")
+	assert.ErrorContains(t, err, "has uncommitted changes")
+
+	// The existing tag points to the current commit, so tagging only pushes it.
+	release, err := newGoModuleRelease(ctx, filepath.Join(repo, "sdk", "v2"), "2.0.0")
+	require.NoError(t, err)
+	remote := t.TempDir()
+	git("init", "-q", "--bare", remote)
+	require.NoError(t, release.tag(ctx, remote))
+	existing := &goModuleRelease{Tag: "sdk/v1.0.0", Repository: repo}
+	require.NoError(t, existing.tag(ctx, remote))
+
+	cmd := exec.Command("git", "tag", "--list")
+	cmd.Dir = remote
+	tags, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Equal(t, "sdk/v1.0.0\nsdk/v2.0.0\n", string(tags))
+}
@@ -0,0 +1,679 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pgavlin/goldmark/ast"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/executable"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v3/python"
+)
+
+// pclExampleLanguage is the language of code blocks that hold examples written in PCL.
+const pclExampleLanguage = "pcl"
+
+func newPackageVerifyExamplesCmd() *cobra.Command {
+	var language string
+	var out string
+	cmd := &cobra.Command{
+		Use:   "verify-examples <schema_source>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Check that the examples in a package's schema compile",
+		Long: `Check that the examples in a package's schema compile.
+
+<schema_source> can be a package name, the path to a plugin binary, the path to a plugin project directory,
+or the path to a schema file.
+
+Examples are the code blocks inside the {{% example %}} sections of the descriptions of the package and its
+resources and functions. An example may be written in PCL (in a code block with the language "pcl"), in
+which case programs are generated from it for each language, or directly in TypeScript, Python, Go or C#.
+
+The SDK of the package is generated for each language, and each example is type-checked against it with the
+language's own tools: tsc for TypeScript, mypy or pyright for Python, go vet for Go and dotnet build for C#.
+Languages whose tools are not installed are skipped. Type-checking installs the dependencies of the examples,
+which requires network access.`,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			pkg, err := schemaFromSchemaSource(args[0])
+			if err != nil {
+				return err
+			}
+
+			languages := exampleLanguages()
+			if language != "all" {
+				switch language {
+				case "csharp", "c#":
+					language = "dotnet"
+				case "typescript":
+					language = "nodejs"
+				}
+				lang, ok := languages[language]
+				if !ok {
+					return fmt.Errorf("unsupported language %q", language)
+				}
+				languages = map[string]*exampleLanguage{language: lang}
+			}
+
+			if out == "" {
+				if out, err = os.MkdirTemp("", "pulumi-verify-examples-"); err != nil {
+					return err
+				}
+				defer os.RemoveAll(out)
+			}
+
+			results, err := verifyExamples(cmd.Context(), pkg, languages, out)
+			if err != nil {
+				return err
+			}
+			return reportExampleResults(results)
+		}),
+	}
+	cmd.Flags().StringVarP(&language, "language", "", "all",
+		"The language to check the examples in: [nodejs|python|go|dotnet|all]")
+	cmd.Flags().StringVarP(&out, "out", "o", "",
+		"The directory to write the example programs to; defaults to a temporary directory that is removed afterwards")
+	return cmd
+}
+
+// schemaExample is an example from the description of a package, resource or function.
+type schemaExample struct {
+	// Token is the token of the resource or function that the example is for, or the name of the package.
+	Token string
+	// Index is the number of the example among the examples for the same token, starting at 1.
+	Index int
+	Title string
+	// Snippets maps the language of each of the example's code blocks to its code.
+	Snippets map[string]string
+}
+
+func (e schemaExample) String() string {
+	return fmt.Sprintf("%s #%d", e.Token, e.Index)
+}
+
+// slug returns a name for the example that can be used as a project name and a directory name.
+func (e schemaExample) slug() string {
+	name := e.Token
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(name))
+	return fmt.Sprintf("%s-%d", name, e.Index)
+}
+
+// extractSchemaExamples returns the examples in the descriptions of a package and its resources and functions.
+func extractSchemaExamples(pkg *schema.Package) []schemaExample {
+	examples := extractExamples(pkg.Name, pkg.Description)
+	if pkg.Provider != nil {
+		examples = append(examples, extractExamples(pkg.Provider.Token, pkg.Provider.Comment)...)
+	}
+	for _, r := range pkg.Resources {
+		examples = append(examples, extractExamples(r.Token, r.Comment)...)
+	}
+	for _, f := range pkg.Functions {
+		examples = append(examples, extractExamples(f.Token, f.Comment)...)
+	}
+	return examples
+}
+
+// extractExamples returns the examples in a description. Each {{% example %}} section is an example, titled by its
+// first level 3 heading. A section with more than one code block in the same language holds more than one example.
+func extractExamples(token, docs string) []schemaExample {
+	if !strings.Contains(docs, schema.ExampleShortcode) {
+		return nil
+	}
+	source := []byte(docs)
+
+	var examples []schemaExample
+	var current *schemaExample
+	push := func() {
+		if current != nil && len(current.Snippets) > 0 {
+			current.Index = len(examples) + 1
+			examples = append(examples, *current)
+		}
+		current = &schemaExample{Token: token, Snippets: map[string]string{}}
+	}
+
+	var example *schema.Shortcode
+	err := ast.Walk(schema.ParseDocs(source), func(n ast.Node, enter bool) (ast.WalkStatus, error) {
+		if shortcode, ok := n.(*schema.Shortcode); ok && string(shortcode.Name) == schema.ExampleShortcode {
+			if enter && example == nil {
+				example = shortcode
+				push()
+			} else if !enter && shortcode == example {
+				push()
+				example = nil
+			}
+			return ast.WalkContinue, nil
+		}
+		if !enter || example == nil {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Heading:
+			if n.Level == 3 && current.Title == "" {
+				current.Title = strings.TrimSpace(string(n.Text(source)))
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock:
+			language := string(n.Language(source))
+			if language == "" {
+				return ast.WalkContinue, nil
+			}
+			var code bytes.Buffer
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				code.Write(line.Value(source))
+			}
+			if _, ok := current.Snippets[language]; ok {
+				title := current.Title
+				push()
+				current.Title = title
+			}
+			current.Snippets[language] = code.String()
+		}
+		return ast.WalkContinue, nil
+	})
+	contract.AssertNoErrorf(err, "error walking AST")
+	return examples
+}
+
+// exampleLanguage describes how to generate and type-check examples in a language.
+type exampleLanguage struct {
+	// name is the name of the language's runtime, and fence the language of its code blocks in examples.
+	name, fence string
+	// main is the file that examples written in the language are saved as.
+	main string
+	// available returns an error if the tools that are needed to type-check programs are not installed.
+	available func() error
+	// link adds the SDK in sdk to the dependencies of the project in dir.
+	link func(ctx context.Context, dir, sdk, goModulePath string) error
+	// check installs the dependencies of the project in dir and type-checks it, writing the output of the tools it
+	// runs to out.
+	check func(ctx context.Context, dir string, out io.Writer) error
+}
+
+func exampleLanguages() map[string]*exampleLanguage {
+	findTools := func(names ...string) func() error {
+		return func() error {
+			for _, name := range names {
+				if _, err := executable.FindExecutable(name); err != nil {
+					return fmt.Errorf("%s is not installed", name)
+				}
+			}
+			return nil
+		}
+	}
+
+	return map[string]*exampleLanguage{
+		"nodejs": {
+			name:      "nodejs",
+			fence:     "typescript",
+			main:      "index.ts",
+			available: findTools("npm"),
+			link: func(ctx context.Context, dir, sdk, _ string) error {
+				return linkNodeJSPackage(ctx, dir, sdk)
+			},
+			check: func(ctx context.Context, dir string, out io.Writer) error {
+				if err := runExampleCommand(ctx, dir, out, "npm", "install", "--no-audit", "--no-fund"); err != nil {
+					return err
+				}
+				return runExampleCommand(ctx, dir, out, "npm", "exec", "--no", "--", "tsc", "--noEmit")
+			},
+		},
+		"python": {
+			name:  "python",
+			fence: "python",
+			main:  "__main__.py",
+			available: func() error {
+				if _, _, err := python.CommandPath(); err != nil {
+					return err
+				}
+				if _, err := pythonTypeChecker(); err != nil {
+					return err
+				}
+				return nil
+			},
+			link: func(_ context.Context, dir, sdk, _ string) error {
+				return linkPythonPackage(dir, sdk)
+			},
+			check: func(ctx context.Context, dir string, out io.Writer) error {
+				venv := filepath.Join(dir, "venv")
+				if err := python.InstallDependenciesWithWriters(ctx, dir, venv, false, out, out); err != nil {
+					return err
+				}
+				checker, err := pythonTypeChecker()
+				if err != nil {
+					return err
+				}
+				venvPython := python.VirtualEnvCommand(venv, "python").Path
+				if checker == "mypy" {
+					return runExampleCommand(ctx, dir, out, checker, "--python-executable", venvPython, "__main__.py")
+				}
+				return runExampleCommand(ctx, dir, out, checker, "--pythonpath", venvPython, "__main__.py")
+			},
+		},
+		"go": {
+			name:      "go",
+			fence:     "go",
+			main:      "main.go",
+			available: findTools("go"),
+			link: func(_ context.Context, dir, sdk, goModulePath string) error {
+				return linkGoPackage(dir, sdk, goModulePath)
+			},
+			check: func(ctx context.Context, dir string, out io.Writer) error {
+				if err := runExampleCommand(ctx, dir, out, "go", "mod", "tidy"); err != nil {
+					return err
+				}
+				return runExampleCommand(ctx, dir, out, "go", "vet", "./...")
+			},
+		},
+		"dotnet": {
+			name:      "dotnet",
+			fence:     "csharp",
+			main:      "Program.cs",
+			available: findTools("dotnet"),
+			link: func(ctx context.Context, dir, sdk, _ string) error {
+				return linkDotnetPackage(ctx, dir, sdk)
+			},
+			check: func(ctx context.Context, dir string, out io.Writer) error {
+				return runExampleCommand(ctx, dir, out, "dotnet", "build", "--nologo")
+			},
+		},
+	}
+}
+
+// pythonTypeChecker returns the name of the Python type checker to use, preferring mypy over pyright.
+func pythonTypeChecker() (string, error) {
+	for _, checker := range []string{"mypy", "pyright"} {
+		if _, err := executable.FindExecutable(checker); err == nil {
+			return checker, nil
+		}
+	}
+	return "", errors.New("neither mypy nor pyright is installed")
+}
+
+// linkDotnetPackage replaces the reference to the package of the .NET SDK in sdk by a reference to the SDK's project.
+func linkDotnetPackage(ctx context.Context, dir, sdk string) error {
+	sdkProjects, err := filepath.Glob(filepath.Join(sdk, "*.csproj"))
+	if err != nil {
+		return err
+	}
+	if len(sdkProjects) != 1 {
+		return fmt.Errorf("expected one project file in %s, found %d", sdk, len(sdkProjects))
+	}
+	name := strings.TrimSuffix(filepath.Base(sdkProjects[0]), ".csproj")
+
+	projects, err := filepath.Glob(filepath.Join(dir, "*.csproj"))
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	for _, project := range projects {
+		b, err := os.ReadFile(project)
+		if err != nil {
+			return err
+		}
+		if bytes.Contains(b, []byte(`Include="`+name+`"`)) {
+			if err := runExampleCommand(ctx, dir, &out, "dotnet", "remove", "package", name); err != nil {
+				return fmt.Errorf("%w\n%s", err, out.String())
+			}
+		}
+	}
+	if err := runExampleCommand(ctx, dir, &out, "dotnet", "add", "reference", sdkProjects[0]); err != nil {
+		return fmt.Errorf("%w\n%s", err, out.String())
+	}
+	return nil
+}
+
+func runExampleCommand(ctx context.Context, dir string, out io.Writer, name string, args ...string) error {
+	path, err := executable.FindExecutable(name)
+	if err != nil {
+		return fmt.Errorf("find %s: %w", name, err)
+	}
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Dir = dir
+	cmd.Stdout = out
+	cmd.Stderr = out
+	fmt.Fprintf(out, "$ %s %s\n", name, strings.Join(args, " "))
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s: %w", name, strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// exampleResult is the result of checking an example in a language.
+type exampleResult struct {
+	Example  schemaExample
+	Language string
+	// Source is how the example program was written: "pcl" if it was generated from PCL, or the language of the
+	// example's code block otherwise.
+	Source string
+	// Status is one of "passed", "failed" or "skipped".
+	Status string
+	// Output is the reason the example failed or was skipped, including the output of any tools that were run.
+	Output string
+}
+
+// exampleLoader loads the package whose examples are being verified from its own schema, and any other packages from
+// their plugins.
+type exampleLoader struct {
+	schema.ReferenceLoader
+
+	pkg *schema.Package
+}
+
+func (l *exampleLoader) LoadPackage(pkg string, version *semver.Version) (*schema.Package, error) {
+	if pkg == l.pkg.Name {
+		return l.pkg, nil
+	}
+	return l.ReferenceLoader.LoadPackage(pkg, version)
+}
+
+func (l *exampleLoader) LoadPackageReference(pkg string, version *semver.Version) (schema.PackageReference, error) {
+	if pkg == l.pkg.Name {
+		return l.pkg.Reference(), nil
+	}
+	return l.ReferenceLoader.LoadPackageReference(pkg, version)
+}
+
+// exampleProjectGenerator generates the project of a program in a language, given the directory of the program's
+// PCL.
+type exampleProjectGenerator func(language, source, target string, project workspace.Project) error
+
+// newExampleProjectGenerator returns a project generator that generates .NET projects directly, and projects in other
+// languages through their language hosts, as `pulumi convert` does.
+func newExampleProjectGenerator(
+	pCtx *plugin.Context, cwd string, loader schema.ReferenceLoader,
+) (exampleProjectGenerator, io.Closer, error) {
+	loaderServer := schema.NewLoaderServer(loader)
+	grpcServer, err := plugin.NewServer(pCtx, schema.LoaderRegistration(loaderServer))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return func(language, source, target string, project workspace.Project) error {
+		if language == "dotnet" {
+			program, diags, err := pcl.BindDirectory(source, loader)
+			if err != nil {
+				return err
+			}
+			if diags.HasErrors() {
+				return diags
+			}
+			return dotnet.GenerateProject(target, project, program, nil /*localDependencies*/)
+		}
+
+		programInfo := plugin.NewProgramInfo(cwd, cwd, "entry", nil)
+		languagePlugin, err := pCtx.Host.LanguageRuntime(language, programInfo)
+		if err != nil {
+			return err
+		}
+		projectBytes, err := encoding.JSON.Marshal(project)
+		if err != nil {
+			return err
+		}
+		diags, err := languagePlugin.GenerateProject(
+			source, target, string(projectBytes), true /*strict*/, grpcServer.Addr(), nil /*localDependencies*/)
+		if err != nil {
+			return err
+		}
+		if diags.HasErrors() {
+			return diags
+		}
+		return nil
+	}, grpcServer, nil
+}
+
+// verifyExamples generates a project in each language for each example of pkg under dir, and type-checks the
+// projects for the languages whose tools are installed.
+func verifyExamples(
+	ctx context.Context, pkg *schema.Package, languages map[string]*exampleLanguage, dir string,
+) ([]exampleResult, error) {
+	spec, err := pkg.MarshalSpec()
+	if err != nil {
+		return nil, err
+	}
+	examples := extractSchemaExamples(pkg)
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("the schema of %s does not have any examples", pkg.Name)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	pCtx, err := newPluginContext(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("create plugin context: %w", err)
+	}
+	defer contract.IgnoreClose(pCtx.Host)
+	loader := &exampleLoader{ReferenceLoader: schema.NewPluginLoader(pCtx.Host), pkg: pkg}
+	generateProject, closer, err := newExampleProjectGenerator(pCtx, cwd, loader)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(closer)
+
+	// The PCL of each example is bound once, up front, so that errors in it are reported once rather than by each
+	// language. Examples without PCL are given the project of an empty program.
+	sources := make([]string, len(examples))
+	bindErrors := make([]error, len(examples))
+	for i, example := range examples {
+		sources[i] = filepath.Join(dir, pclExampleLanguage, example.slug())
+		if err := os.MkdirAll(sources[i], 0o700); err != nil {
+			return nil, err
+		}
+		if code, ok := example.Snippets[pclExampleLanguage]; ok {
+			if err := os.WriteFile(filepath.Join(sources[i], "main.pp"), []byte(code), 0o600); err != nil {
+				return nil, err
+			}
+			_, diags, err := pcl.BindDirectory(sources[i], loader)
+			if err != nil {
+				bindErrors[i] = err
+			} else if diags.HasErrors() {
+				bindErrors[i] = diags
+			}
+		}
+	}
+	emptySource := filepath.Join(dir, pclExampleLanguage, "empty")
+	if err := os.MkdirAll(emptySource, 0o700); err != nil {
+		return nil, err
+	}
+
+	var results []exampleResult
+	for _, name := range []string{"nodejs", "python", "go", "dotnet"} {
+		lang, ok := languages[name]
+		if !ok {
+			continue
+		}
+		unavailable := lang.available()
+
+		var checks []int
+		var projects []string
+		for i, example := range examples {
+			result := exampleResult{Example: example, Language: lang.name, Source: pclExampleLanguage}
+			code, written := example.Snippets[lang.fence]
+			_, hasPCL := example.Snippets[pclExampleLanguage]
+			if written {
+				result.Source = lang.fence
+			} else if !hasPCL {
+				continue
+			}
+
+			if !written && bindErrors[i] != nil {
+				result.Status, result.Output = "failed", fmt.Sprintf("bind PCL: %v", bindErrors[i])
+				results = append(results, result)
+				continue
+			}
+
+			// Examples written in the language still get the project of their PCL, for its dependencies.
+			source := sources[i]
+			if !hasPCL || bindErrors[i] != nil {
+				source = emptySource
+			}
+			project := filepath.Join(dir, lang.name, example.slug())
+			if err := writeExampleProject(generateProject, lang, example, source, project, code, written); err != nil {
+				result.Status, result.Output = "failed", err.Error()
+			} else if unavailable != nil {
+				result.Status, result.Output = "skipped", unavailable.Error()
+			} else {
+				checks, projects = append(checks, len(results)), append(projects, project)
+			}
+			results = append(results, result)
+		}
+		if len(checks) == 0 {
+			continue
+		}
+
+		sdk := filepath.Join(dir, lang.name, "sdks", pkg.Name)
+		goModulePath, err := generateExampleSDK(ctx, lang.name, *spec, projects[0], sdk)
+		for j, project := range projects {
+			result := &results[checks[j]]
+			if err != nil {
+				result.Status, result.Output = "failed", fmt.Sprintf("generate SDK: %v", err)
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "Checking %s in %s...\n", result.Example, lang.name)
+			var out bytes.Buffer
+			if err := lang.link(ctx, project, sdk, goModulePath); err != nil {
+				result.Status, result.Output = "failed", fmt.Sprintf("add SDK to project: %v", err)
+			} else if err := lang.check(ctx, project, &out); err != nil {
+				result.Status, result.Output = "failed", fmt.Sprintf("%v\n%s", err, out.String())
+			} else {
+				result.Status = "passed"
+			}
+		}
+	}
+	return results, nil
+}
+
+// writeExampleProject generates the project of an example in a language into dir from the PCL in source. If the
+// example is written in the language, written is set and the generated program is replaced by code.
+func writeExampleProject(
+	generateProject exampleProjectGenerator, lang *exampleLanguage, example schemaExample, source, dir, code string,
+	written bool,
+) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	project := workspace.Project{Name: tokens.PackageName(example.slug())}
+	if err := generateProject(lang.name, source, dir, project); err != nil {
+		return fmt.Errorf("generate %s program: %w", lang.name, err)
+	}
+	if written {
+		return os.WriteFile(filepath.Join(dir, lang.main), []byte(code), 0o600)
+	}
+	return nil
+}
+
+// generateExampleSDK generates the SDK that examples are checked against into out. Go SDKs are generated at the
+// schema's own import path, so that examples can import them as they would import the published SDK; the module path
+// of the SDK is returned.
+func generateExampleSDK(
+	ctx context.Context, language string, spec schema.PackageSpec, root, out string,
+) (string, error) {
+	fmt.Fprintf(os.Stderr, "Generating %s SDK for %s...\n", language, spec.Name)
+
+	var goImportBasePath string
+	if language == "go" {
+		var options struct {
+			ImportBasePath string `json:"importBasePath"`
+		}
+		if raw, ok := spec.Language["go"]; ok {
+			if err := json.Unmarshal(raw, &options); err != nil {
+				return "", fmt.Errorf("read go options of schema: %w", err)
+			}
+		}
+		goImportBasePath = options.ImportBasePath
+		if goImportBasePath == "" {
+			// This is the import path that Go programs use for packages without one.
+			vPath := ""
+			if v, err := semver.ParseTolerant(spec.Version); err == nil && v.Major > 1 {
+				vPath = fmt.Sprintf("/v%d", v.Major)
+			}
+			goImportBasePath = fmt.Sprintf("github.com/pulumi/pulumi-%s/sdk%s/go/%s", spec.Name, vPath, spec.Name)
+		}
+	}
+
+	if err := generateLocalSDK(ctx, language, spec, root, out, goImportBasePath); err != nil {
+		return "", err
+	}
+	if goImportBasePath == "" {
+		return "", nil
+	}
+	return path.Dir(goImportBasePath), nil
+}
+
+func reportExampleResults(results []exampleResult) error {
+	rows := make([]cmdutil.TableRow, len(results))
+	failed := 0
+	for i, result := range results {
+		rows[i] = cmdutil.TableRow{Columns: []string{
+			result.Example.String(), result.Example.Title, result.Language, result.Source, result.Status,
+		}}
+		if result.Status == "failed" {
+			failed++
+		}
+	}
+	printTable(cmdutil.Table{
+		Headers: []string{"EXAMPLE", "TITLE", "LANGUAGE", "SOURCE", "RESULT"},
+		Rows:    rows,
+	}, nil)
+
+	for _, result := range results {
+		if result.Status != "failed" {
+			continue
+		}
+		fmt.Printf("\n%s (%s) failed:\n%s\n", result.Example, result.Language, strings.TrimSpace(result.Output))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d example programs failed", failed, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractExamples(t *testing.T) {
+	t.Parallel()
+
+	docs := "Manages a bucket.\n" +
+		"\n" +
+		"{{% examples %}}\n" +
+		"## Example Usage\n" +
+		"{{% example %}}\n" +
+		"### Basic\n" +
+		"\n" +
+		"```pcl\n" +
+		"resource bucket \"test:index:Bucket\" {}\n" +
+		"```\n" +
+		"```typescript\n" +
+		"import * as test from \"@pulumi/test\";\n" +
+		"\n" +
+		"const bucket = new test.Bucket(\"bucket\");\n" +
+		"```\n" +
+		"{{% /example %}}\n" +
+		"{{% example %}}\n" +
+		"### Two buckets\n" +
+		"\n" +
+		"```pcl\n" +
+		"resource first \"test:index:Bucket\" {}\n" +
+		"```\n" +
+		"```pcl\n" +
+		"resource second \"test:index:Bucket\" {}\n" +
+		"```\n" +
+		"{{% /example %}}\n" +
+		"{{% /examples %}}\n" +
+		"\n" +
+		"```pcl\n" +
+		"resource notAnExample \"test:index:Bucket\" {}\n" +
+		"```\n"
+
+	examples := extractExamples("test:index:Bucket", docs)
+	require.Len(t, examples, 3)
+
+	assert.Equal(t, schemaExample{
+		Token: "test:index:Bucket",
+		Index: 1,
+		Title: "Basic",
+		Snippets: map[string]string{
+			"pcl":        "resource bucket \"test:index:Bucket\" {}\n",
+			"typescript": "import * as test from \"@pulumi/test\";\n\nconst bucket = new test.Bucket(\"bucket\");\n",
+		},
+	}, examples[0])
+	assert.Equal(t, "test:index:Bucket #1", examples[0].String())
+	assert.Equal(t, "bucket-1", examples[0].slug())
+
+	// A second code block in the same language starts a new example with the same title.
+	assert.Equal(t, "Two buckets", examples[1].Title)
+	assert.Equal(t, map[string]string{"pcl": "resource first \"test:index:Bucket\" {}\n"}, examples[1].Snippets)
+	assert.Equal(t, "Two buckets", examples[2].Title)
+	assert.Equal(t, map[string]string{"pcl": "resource second \"test:index:Bucket\" {}\n"}, examples[2].Snippets)
+	assert.Equal(t, "bucket-3", examples[2].slug())
+
+	assert.Empty(t, extractExamples("test:index:Bucket", "Manages a bucket."))
+}
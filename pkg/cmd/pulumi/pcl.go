@@ -0,0 +1,76 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPCLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pcl",
+		Short: "Format and lint PCL programs",
+		Long: `Format and lint PCL programs
+
+PCL, the Pulumi Configuration Language, is the language-neutral form of Pulumi programs that is produced by
+pulumi convert and pulumi import, and from which programs in each language are generated. Subcommands of this command
+can be used to check PCL programs before they are converted to other languages.`,
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newPCLFmtCmd())
+	cmd.AddCommand(newPCLLintCmd())
+	return cmd
+}
+
+// findPCLFiles returns the PCL files named by the given paths, in order. Directories are searched recursively for
+// files with the .pp extension.
+func findPCLFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		var found []string
+		err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && filepath.Ext(path) == ".pp" {
+				found = append(found, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(found)
+		files = append(files, found...)
+	}
+	return files, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// pclFormatResult describes the result of formatting a PCL file.
+type pclFormatResult struct {
+	// The path of the file.
+	Path string `json:"path"`
+	// True if the file was not formatted canonically.
+	Changed bool `json:"changed"`
+}
+
+func newPCLFmtCmd() *cobra.Command {
+	var check bool
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "fmt [path...]",
+		Short: "Format PCL files",
+		Long: "Format PCL files.\n" +
+			"\n" +
+			"Rewrite each of the given PCL files, or the .pp files within each of the given\n" +
+			"directories, in canonical form. If no paths are given, the PCL files within the\n" +
+			"current directory are formatted. The paths of the files that were changed are\n" +
+			"printed.\n" +
+			"\n" +
+			"With --check, files are not rewritten; instead, the command fails if any file is\n" +
+			"not formatted canonically, so it can be used in CI.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				args = []string{"."}
+			}
+			files, err := findPCLFiles(args)
+			if err != nil {
+				return err
+			}
+
+			results, err := formatPCLFiles(files, !check)
+			if err != nil {
+				return err
+			}
+
+			var changed int
+			for _, r := range results {
+				if r.Changed {
+					changed++
+				}
+			}
+			if jsonOut {
+				if results == nil {
+					results = []pclFormatResult{}
+				}
+				if err := printJSON(results); err != nil {
+					return err
+				}
+			} else {
+				printPCLFormatResults(os.Stdout, results)
+			}
+
+			if check && changed > 0 {
+				return fmt.Errorf("%d file(s) are not formatted", changed)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVar(
+		&check, "check", false, "Report the files that are not formatted without rewriting them")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the results as JSON")
+
+	return cmd
+}
+
+// formatPCLFiles formats each of the given files, rewriting those that change if write is true. Files that cannot be
+// parsed are reported and cause the command to fail once every file has been checked.
+func formatPCLFiles(files []string, write bool) ([]pclFormatResult, error) {
+	var results []pclFormatResult
+	var failed bool
+	for _, path := range files {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		formatted, diags := syntax.Format(src, path)
+		if diags.HasErrors() {
+			writePCLDiagnostics(os.Stderr, map[string][]byte{path: src}, diags)
+			failed = true
+			continue
+		}
+
+		changed := !bytes.Equal(src, formatted)
+		if changed && write {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(path, formatted, info.Mode()); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, pclFormatResult{Path: path, Changed: changed})
+	}
+	if failed {
+		return nil, errors.New("some files could not be parsed")
+	}
+	return results, nil
+}
+
+// printPCLFormatResults prints the paths of the files that were not formatted canonically.
+func printPCLFormatResults(w io.Writer, results []pclFormatResult) {
+	for _, r := range results {
+		if r.Changed {
+			fmt.Fprintln(w, r.Path)
+		}
+	}
+}
+
+// writePCLDiagnostics writes the given diagnostics to w, with the source of the files that they refer to.
+func writePCLDiagnostics(w io.Writer, sources map[string][]byte, diags hcl.Diagnostics) {
+	files := map[string]*hcl.File{}
+	for path, src := range sources {
+		files[path] = &hcl.File{Bytes: src}
+	}
+	err := hcl.NewDiagnosticTextWriter(w, files, 0, true).WriteDiagnostics(diags)
+	contract.IgnoreError(err)
+}
@@ -0,0 +1,58 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatPCLFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	formatted := "output name {\n  value = \"pet\"\n}\n"
+	unformatted := "output name {\nvalue=\"pet\"\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.pp"), []byte(formatted), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "component"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "component", "b.pp"), []byte(unformatted), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Readme\n"), 0o600))
+
+	files, err := findPCLFiles([]string{dir})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.pp"), filepath.Join(dir, "component", "b.pp")}, files)
+
+	// Checking the files does not rewrite them.
+	results, err := formatPCLFiles(files, false)
+	require.NoError(t, err)
+	assert.Equal(t, []pclFormatResult{
+		{Path: files[0], Changed: false},
+		{Path: files[1], Changed: true},
+	}, results)
+	b, err := os.ReadFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, unformatted, string(b))
+
+	results, err = formatPCLFiles(files, true)
+	require.NoError(t, err)
+	assert.True(t, results[1].Changed)
+	b, err = os.ReadFile(files[1])
+	require.NoError(t, err)
+	assert.Equal(t, formatted, string(b))
+}
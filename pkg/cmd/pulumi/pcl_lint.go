@@ -0,0 +1,173 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// pclLintPosition is a position within a PCL file.
+type pclLintPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// pclLintDiagnostic is the JSON form of a diagnostic reported by pulumi pcl lint.
+type pclLintDiagnostic struct {
+	// The lint rule that produced the diagnostic, or empty if the diagnostic was produced by the parser or binder.
+	Rule     string           `json:"rule,omitempty"`
+	Severity string           `json:"severity"`
+	Summary  string           `json:"summary"`
+	Detail   string           `json:"detail,omitempty"`
+	File     string           `json:"file,omitempty"`
+	Start    *pclLintPosition `json:"start,omitempty"`
+	End      *pclLintPosition `json:"end,omitempty"`
+}
+
+func newPCLLintCmd() *cobra.Command {
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "lint [dir]",
+		Args:  cmdutil.MaximumNArgs(1),
+		Short: "Check a PCL program for problems",
+		Long: "Check a PCL program for problems.\n" +
+			"\n" +
+			"Bind the PCL program in the given directory, or in the current directory, against\n" +
+			"the schemas of the packages that it uses and report the problems that the binder\n" +
+			"finds, along with the following checks:\n" +
+			"\n" +
+			"  - unused-local: local variables that are not used\n" +
+			"  - unused-config: config variables that are not used\n" +
+			"  - unknown-resource-type: resources whose type is not defined by its package\n" +
+			"  - secret-output: outputs that are computed from secrets but are not marked as\n" +
+			"    secret, or that expose secrets with unsecret within a larger expression;\n" +
+			"    wrap the whole value of an output in unsecret to expose it explicitly\n" +
+			"\n" +
+			"The command fails if any errors are reported.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			dir := "."
+			if len(args) == 1 {
+				dir = args[0]
+			}
+
+			pCtx, err := newPluginContext(dir)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(pCtx.Host)
+
+			sources, diags, err := lintPCLDirectory(dir, schema.NewPluginLoader(pCtx.Host))
+			if err != nil {
+				return err
+			}
+
+			if jsonOut {
+				if err := printJSON(pclLintDiagnostics(diags)); err != nil {
+					return err
+				}
+			} else {
+				writePCLDiagnostics(os.Stderr, sources, diags)
+			}
+
+			if diags.HasErrors() {
+				return fmt.Errorf("found %d error(s)", len(diags.Errs()))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the diagnostics as JSON")
+
+	return cmd
+}
+
+// lintPCLDirectory parses and lints the PCL program in the given directory. It returns the source of each file, by
+// the name used in the diagnostics, along with the diagnostics.
+func lintPCLDirectory(dir string, loader schema.ReferenceLoader) (map[string][]byte, hcl.Diagnostics, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parser := syntax.NewParser()
+	sources := map[string][]byte{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pp" {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := parser.ParseFile(bytes.NewReader(src), entry.Name()); err != nil {
+			return nil, nil, err
+		}
+		sources[entry.Name()] = src
+	}
+	if len(sources) == 0 {
+		return nil, nil, fmt.Errorf("no PCL files found in %s", dir)
+	}
+	if parser.Diagnostics.HasErrors() {
+		return sources, parser.Diagnostics, nil
+	}
+
+	diags, err := pcl.Lint(parser.Files,
+		pcl.Loader(loader),
+		pcl.DirPath(dir),
+		pcl.ComponentBinder(pcl.ComponentProgramBinderFromFileSystem()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sources, append(parser.Diagnostics, diags...), nil
+}
+
+// pclLintDiagnostics converts the given diagnostics to their JSON form.
+func pclLintDiagnostics(diags hcl.Diagnostics) []pclLintDiagnostic {
+	result := make([]pclLintDiagnostic, len(diags))
+	for i, d := range diags {
+		severity := "warning"
+		if d.Severity == hcl.DiagError {
+			severity = "error"
+		}
+		result[i] = pclLintDiagnostic{
+			Rule:     string(pcl.DiagnosticLintRule(d)),
+			Severity: severity,
+			Summary:  d.Summary,
+		}
+		if d.Detail != d.Summary {
+			result[i].Detail = d.Detail
+		}
+		if d.Subject != nil {
+			result[i].File = d.Subject.Filename
+			result[i].Start = &pclLintPosition{Line: d.Subject.Start.Line, Column: d.Subject.Start.Column}
+			result[i].End = &pclLintPosition{Line: d.Subject.End.Line, Column: d.Subject.End.Column}
+		}
+	}
+	return result
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -53,7 +54,10 @@ func newPluginInstallCmd() *cobra.Command {
 			"\n" +
 			"If VERSION is specified, it cannot be a range; it must be a specific number.\n" +
 			"If VERSION is unspecified, Pulumi will attempt to look up the latest version of\n" +
-			"the plugin, though the result is not guaranteed.",
+			"the plugin, though the result is not guaranteed.\n" +
+			"\n" +
+			"When run inside a project, the installed plugins are recorded in the project's\n" +
+			"Pulumi.lock file. Use `--frozen` to fail instead if a plugin deviates from it.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			return picmd.Run(ctx, args)
@@ -70,6 +74,8 @@ func newPluginInstallCmd() *cobra.Command {
 		"reinstall", false, "Reinstall a plugin even if it already exists")
 	cmd.PersistentFlags().StringVar(&picmd.checksum,
 		"checksum", "", "The expected SHA256 checksum for the plugin archive")
+	cmd.PersistentFlags().BoolVar(&picmd.frozen,
+		"frozen", false, "Fail if a plugin deviates from the project's Pulumi.lock, instead of updating it")
 
 	return cmd
 }
@@ -80,6 +86,7 @@ type pluginInstallCmd struct {
 	file      string
 	reinstall bool
 	checksum  string
+	frozen    bool
 
 	diag  diag.Sink
 	env   env.Env
@@ -106,6 +113,7 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 
 	// Parse the kind, name, and version, if specified.
 	var installs []workspace.PluginSpec
+	var lock *pluginLockWriter
 	if len(args) > 0 {
 		if !workspace.IsPluginKind(args[0]) {
 			return fmt.Errorf("unrecognized plugin kind: %s", args[0])
@@ -164,8 +172,22 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 				diag.Message("", "Plugin download URL set to %s"), pluginSpec.PluginDownloadURL)
 		}
 
+		// If we're in a project, record the plugin in its plugin lock. Plugins installed from a file can't be
+		// downloaded again by anyone else, so they are never recorded.
+		if projectPath, err := workspace.DetectProjectPath(); err == nil && projectPath != "" && cmd.file == "" {
+			if lock, err = newPluginLockWriter(filepath.Dir(projectPath), cmd.frozen, false /*replace*/); err != nil {
+				return err
+			}
+			// If the plugin is locked, prefer the locked version over the latest one.
+			if pluginSpec, err = lock.Pin(pluginSpec); err != nil {
+				return err
+			}
+		} else if cmd.frozen {
+			return errors.New("--frozen can only be used inside a project that has a Pulumi.lock file")
+		}
+
 		// If we don't have a version try to look one up
-		if version == nil {
+		if pluginSpec.Version == nil {
 			latestVersion, err := cmd.pluginGetLatestVersion(pluginSpec)
 			if err != nil {
 				return err
@@ -194,10 +216,25 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 				installs = append(installs, plugin)
 			}
 		}
+
+		_, root, err := readProject()
+		if err != nil {
+			return err
+		}
+		if lock, err = newPluginLockWriter(root, cmd.frozen, false /*replace*/); err != nil {
+			return err
+		}
 	}
 
 	// Now for each kind, name, version pair, download it from the release website, and install it.
 	for _, install := range installs {
+		if lock != nil {
+			var err error
+			if install, err = lock.Pin(install); err != nil {
+				return err
+			}
+		}
+
 		label := fmt.Sprintf("[%s plugin %s]", install.Kind, install)
 
 		// If the plugin already exists, don't download it unless --reinstall was passed.  Note that
@@ -206,11 +243,17 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 			if cmd.exact {
 				if workspace.HasPlugin(install) {
 					logging.V(1).Infof("%s skipping install (existing == match)", label)
+					if lock != nil {
+						lock.Record(install)
+					}
 					continue
 				}
 			} else {
-				if has, _ := workspace.HasPluginGTE(install); has {
+				if installed, _ := workspace.GetPluginGTE(install); installed != nil {
 					logging.V(1).Infof("%s skipping install (existing >= match)", label)
+					if lock != nil {
+						lock.Record(*installed)
+					}
 					continue
 				}
 			}
@@ -238,6 +281,10 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 			}
 			defer func() { contract.IgnoreError(os.Remove(r.Name())) }()
 
+			if install, err = withDownloadChecksum(install, r.Name()); err != nil {
+				return fmt.Errorf("%s: %w", label, err)
+			}
+
 			payload = workspace.TarPlugin(r)
 		} else {
 			source = cmd.file
@@ -251,8 +298,14 @@ func (cmd *pluginInstallCmd) Run(ctx context.Context, args []string) error {
 		if err = install.InstallWithContext(ctx, payload, cmd.reinstall); err != nil {
 			return fmt.Errorf("installing %s from %s: %w", label, source, err)
 		}
+		if lock != nil {
+			lock.Record(install)
+		}
 	}
 
+	if lock != nil {
+		return lock.Save()
+	}
 	return nil
 }
 
@@ -0,0 +1,73 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// pluginLimitFlags are the values of the global --plugin-limit flag.
+var pluginLimitFlags []string
+
+// parsePluginLimitFlags parses --plugin-limit KEY=VALUE flags into plugin limits.
+func parsePluginLimitFlags(flags []string) (workspace.PluginLimits, error) {
+	var limits workspace.PluginLimits
+	for _, flag := range flags {
+		key, value, ok := strings.Cut(flag, "=")
+		if !ok || value == "" {
+			return limits, fmt.Errorf("invalid --plugin-limit %q: must be of the form KEY=VALUE", flag)
+		}
+
+		switch key {
+		case "memory":
+			limits.Memory = value
+		case "cpuTime":
+			limits.CPUTime = value
+		case "openFiles":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return limits, fmt.Errorf("invalid --plugin-limit %q: openFiles must be a positive number", flag)
+			}
+			limits.OpenFiles = n
+		case "env":
+			limits.Env = strings.Split(value, ",")
+		case "workingDirectory":
+			limits.WorkingDirectory = value
+		default:
+			return limits, fmt.Errorf("invalid --plugin-limit %q: unknown limit %q, expected one of memory, cpuTime, "+
+				"openFiles, env or workingDirectory", flag, key)
+		}
+	}
+	return limits, nil
+}
+
+// setPluginLimitOverride makes the limits given by --plugin-limit flags override the limits configured in Pulumi.yaml
+// for all of the plugins that are launched.
+func setPluginLimitOverride(flags []string) error {
+	if len(flags) == 0 {
+		return nil
+	}
+	override, err := parsePluginLimitFlags(flags)
+	if err != nil {
+		return err
+	}
+	plugin.SetPluginLimitOverride(&override)
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestParsePluginLimitFlags(t *testing.T) {
+	t.Parallel()
+
+	limits, err := parsePluginLimitFlags([]string{"memory=2GiB", "openFiles=128", "env=PATH,AWS_*"})
+	require.NoError(t, err)
+	assert.Equal(t, workspace.PluginLimits{Memory: "2GiB", OpenFiles: 128, Env: []string{"PATH", "AWS_*"}}, limits)
+}
+
+func TestParsePluginLimitFlagsInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := parsePluginLimitFlags([]string{"memory"})
+	assert.ErrorContains(t, err, "must be of the form KEY=VALUE")
+
+	_, err = parsePluginLimitFlags([]string{"openFiles=many"})
+	assert.ErrorContains(t, err, "openFiles must be a positive number")
+
+	_, err = parsePluginLimitFlags([]string{"disk=1GB"})
+	assert.ErrorContains(t, err, `unknown limit "disk"`)
+}
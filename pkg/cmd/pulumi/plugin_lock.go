@@ -0,0 +1,127 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// pluginLockWriter pins the plugins installed by a command to the project's plugin lock file, and records the
+// plugins that were installed back into it.
+type pluginLockWriter struct {
+	// The path of the plugin lock file.
+	path string
+	// The plugin lock as it was when the command started, or nil if there was none.
+	existing *workspace.PluginLock
+	// The plugin lock that will be saved.
+	updated *workspace.PluginLock
+	// If true, plugins must match the existing lock and the lock is never written.
+	frozen bool
+}
+
+// newPluginLockWriter creates a pluginLockWriter for the project in the given directory. If replace is true, the
+// lock is rewritten from scratch with only the plugins recorded by this command; otherwise recorded plugins are added
+// to the existing lock.
+func newPluginLockWriter(projectDir string, frozen, replace bool) (*pluginLockWriter, error) {
+	path := workspace.PluginLockPath(projectDir)
+	existing, err := workspace.LoadPluginLock(path)
+	if err != nil {
+		return nil, err
+	}
+	if frozen && existing == nil {
+		return nil, fmt.Errorf("--frozen requires a %s file, but none was found in %s", workspace.PluginLockFile, projectDir)
+	}
+
+	updated := &workspace.PluginLock{}
+	if existing != nil && !replace {
+		updated.Plugins = append(updated.Plugins, existing.Plugins...)
+	}
+	return &pluginLockWriter{
+		path:     path,
+		existing: existing,
+		updated:  updated,
+		frozen:   frozen,
+	}, nil
+}
+
+// Pin pins the given plugin to the existing plugin lock. If the plugin deviates from the lock, an error is returned
+// when frozen, otherwise the plugin is returned unchanged so that the lock will be updated to match it.
+func (w *pluginLockWriter) Pin(spec workspace.PluginSpec) (workspace.PluginSpec, error) {
+	pinned, err := w.existing.Pin(spec)
+	if err != nil {
+		if w.frozen {
+			return spec, err
+		}
+		logging.V(7).Infof("updating %s: %v", workspace.PluginLockFile, err)
+	}
+	return pinned, nil
+}
+
+// Record records the given plugin, which has been installed, in the plugin lock.
+func (w *pluginLockWriter) Record(spec workspace.PluginSpec) {
+	if !workspace.IsPluginLockable(spec.Kind, spec.Name) {
+		return
+	}
+	if spec.Version == nil {
+		logging.V(7).Infof("not recording %s plugin %s in %s: no version", spec.Kind, spec.Name, workspace.PluginLockFile)
+		return
+	}
+	w.updated.Record(spec)
+}
+
+// Save writes the plugin lock, unless it is frozen or there is nothing to record.
+func (w *pluginLockWriter) Save() error {
+	if w.frozen || (w.existing == nil && len(w.updated.Plugins) == 0) {
+		return nil
+	}
+	return w.updated.Save(w.path)
+}
+
+// withDownloadChecksum returns the given plugin with the checksum of its downloaded archive recorded for the
+// current platform, unless a checksum for the current platform is already known (in which case the download has
+// already been verified against it).
+func withDownloadChecksum(spec workspace.PluginSpec, archive string) (workspace.PluginSpec, error) {
+	platform := runtime.GOOS + "-" + runtime.GOARCH
+	if _, has := spec.Checksums[platform]; has {
+		return spec, nil
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return spec, err
+	}
+	defer contract.IgnoreClose(f)
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return spec, fmt.Errorf("computing checksum of %s: %w", archive, err)
+	}
+
+	checksums := make(map[string][]byte, len(spec.Checksums)+1)
+	for k, v := range spec.Checksums {
+		checksums[k] = v
+	}
+	checksums[platform] = hasher.Sum(nil)
+	spec.Checksums = checksums
+	return spec, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestPluginLockWriter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	v1 := semver.MustParse("1.0.0")
+	v2 := semver.MustParse("2.0.0")
+
+	// Without an existing lock, nothing is pinned and installed plugins are recorded.
+	lock, err := newPluginLockWriter(dir, false /*frozen*/, true /*replace*/)
+	require.NoError(t, err)
+	spec, err := lock.Pin(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v1})
+	require.NoError(t, err)
+	lock.Record(spec)
+	lock.Record(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: "unversioned"})
+	require.NoError(t, lock.Save())
+
+	saved, err := workspace.LoadPluginLock(workspace.PluginLockPath(dir))
+	require.NoError(t, err)
+	assert.Equal(t, []workspace.LockedPlugin{
+		{Kind: workspace.ResourcePlugin, Name: "aws", Version: "1.0.0"},
+	}, saved.Plugins)
+
+	// A frozen lock rejects deviations and is never written.
+	frozen, err := newPluginLockWriter(dir, true /*frozen*/, true /*replace*/)
+	require.NoError(t, err)
+	_, err = frozen.Pin(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v2})
+	assert.ErrorContains(t, err, "does not match the version(s) recorded in Pulumi.lock: 1.0.0")
+	spec, err = frozen.Pin(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: "aws"})
+	require.NoError(t, err)
+	assert.Equal(t, &v1, spec.Version)
+
+	// An unfrozen lock accepts deviations and records them.
+	unfrozen, err := newPluginLockWriter(dir, false /*frozen*/, false /*replace*/)
+	require.NoError(t, err)
+	spec, err = unfrozen.Pin(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v2})
+	require.NoError(t, err)
+	unfrozen.Record(spec)
+	require.NoError(t, unfrozen.Save())
+
+	saved, err = workspace.LoadPluginLock(workspace.PluginLockPath(dir))
+	require.NoError(t, err)
+	assert.Len(t, saved.Plugins, 2)
+}
+
+func TestPluginLockWriterFrozenWithoutLock(t *testing.T) {
+	t.Parallel()
+
+	_, err := newPluginLockWriter(t.TempDir(), true /*frozen*/, false /*replace*/)
+	assert.ErrorContains(t, err, "--frozen requires a Pulumi.lock file")
+}
+
+func TestWithDownloadChecksum(t *testing.T) {
+	t.Parallel()
+
+	archive := filepath.Join(t.TempDir(), "plugin.tar.gz")
+	require.NoError(t, os.WriteFile(archive, []byte("contents"), 0o600))
+	expected := sha256.Sum256([]byte("contents"))
+	platform := runtime.GOOS + "-" + runtime.GOARCH
+
+	spec, err := withDownloadChecksum(workspace.PluginSpec{
+		Checksums: map[string][]byte{"other-platform": {0x01}},
+	}, archive)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"other-platform": {0x01},
+		platform:         expected[:],
+	}, spec.Checksums)
+
+	// A known checksum for the current platform is kept as is.
+	spec, err = withDownloadChecksum(workspace.PluginSpec{
+		Checksums: map[string][]byte{platform: {0x02}},
+	}, archive)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{platform: {0x02}}, spec.Checksums)
+}
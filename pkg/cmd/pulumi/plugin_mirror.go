@@ -0,0 +1,251 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/spf13/cobra"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	pkgWorkspace "github.com/pulumi/pulumi/pkg/v3/workspace"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPluginMirrorCmd() *cobra.Command {
+	var platforms []string
+	var serverURL string
+
+	cmd := &cobra.Command{
+		Use:   "mirror DESTINATION [KIND NAME VERSION]...",
+		Args:  cmdutil.MinimumNArgs(1),
+		Short: "Copy plugins into a plugin mirror for offline use",
+		Long: "Copy plugins into a plugin mirror for offline use.\n" +
+			"\n" +
+			"This command downloads plugins into DESTINATION, which may be a local directory or a\n" +
+			"file://, s3://, gs:// or azblob:// URL, and records them in the mirror's index. The plugins\n" +
+			"to mirror may be listed as KIND NAME VERSION arguments; otherwise all the plugins recorded\n" +
+			"in the current project's Pulumi.lock file are mirrored.\n" +
+			"\n" +
+			"To download plugins from a mirror instead of their usual sources, set the\n" +
+			"PULUMI_PLUGIN_MIRROR environment variable or the `plugins.mirror` option in Pulumi.yaml\n" +
+			"to the mirror's location. Every plugin downloaded from a mirror is verified against the\n" +
+			"checksum recorded in the mirror's index.",
+		Example: "pulumi plugin mirror ./mirror --platform linux-amd64 --platform darwin-arm64\n" +
+			"pulumi plugin mirror s3://my-bucket/plugins resource aws 6.0.0",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			specs, err := pluginMirrorSpecs(args[1:], serverURL)
+			if err != nil {
+				return err
+			}
+			targets, err := parsePluginMirrorPlatforms(platforms)
+			if err != nil {
+				return err
+			}
+
+			bucket, err := pkgWorkspace.OpenPluginMirrorBucket(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(bucket)
+
+			// Plugins are always downloaded from where they are published, even if a mirror (possibly the one being
+			// populated) is configured.
+			return mirrorPlugins(ctx, bucket, specs, targets, workspace.PluginSpec.DownloadFromOrigin, os.Stdout)
+		}),
+	}
+
+	cmd.PersistentFlags().StringArrayVar(&platforms,
+		"platform", nil, "The OS-ARCH platform to mirror plugins for, e.g. linux-amd64 (defaults to the current platform)")
+	cmd.PersistentFlags().StringVar(&serverURL,
+		"server", "", "A URL to download the listed plugins from")
+
+	return cmd
+}
+
+// pluginMirrorSpecs returns the plugins to mirror, either from the given KIND NAME VERSION arguments or from the
+// current project's plugin lock.
+func pluginMirrorSpecs(args []string, serverURL string) ([]workspace.PluginSpec, error) {
+	if len(args) == 0 {
+		_, root, err := readProject()
+		if err != nil {
+			return nil, fmt.Errorf("no plugins listed, and the plugins of the current project could not be read: %w", err)
+		}
+		lock, err := workspace.LoadPluginLock(workspace.PluginLockPath(root))
+		if err != nil {
+			return nil, err
+		}
+		if lock == nil || len(lock.Plugins) == 0 {
+			return nil, fmt.Errorf("no plugins listed, and no plugins are recorded in %s; run `pulumi install` first",
+				workspace.PluginLockPath(root))
+		}
+
+		specs := make([]workspace.PluginSpec, len(lock.Plugins))
+		for i, p := range lock.Plugins {
+			spec, err := p.Spec()
+			contract.AssertNoErrorf(err, "locked plugins are validated on load")
+			specs[i] = spec
+		}
+		return specs, nil
+	}
+
+	if len(args)%3 != 0 {
+		return nil, errors.New("plugins to mirror must be listed as KIND NAME VERSION arguments")
+	}
+
+	var specs []workspace.PluginSpec
+	for i := 0; i < len(args); i += 3 {
+		kind, name, rawVersion := args[i], args[i+1], args[i+2]
+		if !workspace.IsPluginKind(kind) {
+			return nil, fmt.Errorf("unrecognized plugin kind: %s", kind)
+		}
+		version, err := semver.ParseTolerant(rawVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q for %s plugin %s: %w", rawVersion, kind, name, err)
+		}
+		specs = append(specs, workspace.PluginSpec{
+			Kind:              workspace.PluginKind(kind),
+			Name:              name,
+			Version:           &version,
+			PluginDownloadURL: serverURL,
+		})
+	}
+	return specs, nil
+}
+
+// pluginMirrorPlatform is an OS and architecture to mirror plugins for.
+type pluginMirrorPlatform struct {
+	OS   string
+	Arch string
+}
+
+func parsePluginMirrorPlatforms(platforms []string) ([]pluginMirrorPlatform, error) {
+	if len(platforms) == 0 {
+		return []pluginMirrorPlatform{{OS: runtime.GOOS, Arch: runtime.GOARCH}}, nil
+	}
+
+	result := make([]pluginMirrorPlatform, len(platforms))
+	for i, platform := range platforms {
+		opSy, arch, ok := strings.Cut(platform, "-")
+		if !ok || opSy == "" || arch == "" {
+			return nil, fmt.Errorf("invalid platform %q: must be of the form OS-ARCH, e.g. linux-amd64", platform)
+		}
+		result[i] = pluginMirrorPlatform{OS: opSy, Arch: arch}
+	}
+	return result, nil
+}
+
+// mirrorPlugins downloads the given plugins for each of the given platforms into the plugin mirror in the given
+// bucket, and updates the mirror's index. Plugins that are already in the mirror are skipped.
+func mirrorPlugins(
+	ctx context.Context, bucket *blob.Bucket, specs []workspace.PluginSpec, platforms []pluginMirrorPlatform,
+	download func(spec workspace.PluginSpec, opSy, arch string) (io.ReadCloser, int64, error), w io.Writer,
+) error {
+	index := &workspace.PluginMirrorIndex{}
+	b, err := bucket.ReadAll(ctx, workspace.PluginMirrorIndexFile)
+	switch {
+	case err == nil:
+		if index, err = workspace.ReadPluginMirrorIndex(bytes.NewReader(b)); err != nil {
+			return err
+		}
+	case gcerrors.Code(err) != gcerrors.NotFound:
+		return fmt.Errorf("reading plugin mirror index: %w", err)
+	}
+
+	for _, spec := range specs {
+		contract.Assertf(spec.Version != nil, "mirrored plugins must have a version")
+
+		for _, platform := range platforms {
+			label := fmt.Sprintf("%s plugin %s for %s-%s", spec.Kind, spec, platform.OS, platform.Arch)
+			file := workspace.PluginMirrorFile(spec.Kind, spec.Name, *spec.Version, platform.OS, platform.Arch)
+
+			if index.Find(spec.Kind, spec.Name, *spec.Version, platform.OS, platform.Arch) != nil {
+				if exists, err := bucket.Exists(ctx, file); err == nil && exists {
+					fmt.Fprintf(w, "%s is already mirrored\n", label)
+					continue
+				}
+			}
+
+			checksum, err := mirrorPlugin(ctx, bucket, file, func() (io.ReadCloser, int64, error) {
+				return download(spec, platform.OS, platform.Arch)
+			})
+			if err != nil {
+				return fmt.Errorf("mirroring %s: %w", label, err)
+			}
+
+			index.Add(workspace.PluginMirrorEntry{
+				Kind:    spec.Kind,
+				Name:    spec.Name,
+				Version: spec.Version.String(),
+				OS:      platform.OS,
+				Arch:    platform.Arch,
+				File:    file,
+				SHA256:  hex.EncodeToString(checksum),
+			})
+			fmt.Fprintf(w, "Mirrored %s\n", label)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := index.Write(&buf); err != nil {
+		return err
+	}
+	return bucket.WriteAll(ctx, workspace.PluginMirrorIndexFile, buf.Bytes(), nil)
+}
+
+// mirrorPlugin copies a single plugin archive into the bucket and returns its SHA256 checksum.
+func mirrorPlugin(
+	ctx context.Context, bucket *blob.Bucket, file string, download func() (io.ReadCloser, int64, error),
+) ([]byte, error) {
+	r, _, err := download()
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(r)
+
+	// Cancelling the context discards the partially written file if the copy fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer, err := bucket.NewWriter(ctx, file, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(writer, hasher), r); err != nil {
+		cancel()
+		contract.IgnoreClose(writer)
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
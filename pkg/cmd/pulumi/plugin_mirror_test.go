@@ -0,0 +1,148 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgWorkspace "github.com/pulumi/pulumi/pkg/v3/workspace"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestMirrorPlugins(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	bucket, err := pkgWorkspace.OpenPluginMirrorBucket(ctx, filepath.Join(dir, "mirror"))
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	v1 := semver.MustParse("1.0.0")
+	specs := []workspace.PluginSpec{{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v1}}
+	platforms := []pluginMirrorPlatform{{OS: "linux", Arch: "amd64"}, {OS: "darwin", Arch: "arm64"}}
+
+	var downloads []string
+	download := func(spec workspace.PluginSpec, opSy, arch string) (io.ReadCloser, int64, error) {
+		contents := spec.Name + "-" + opSy + "-" + arch
+		downloads = append(downloads, contents)
+		return io.NopCloser(strings.NewReader(contents)), int64(len(contents)), nil
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, mirrorPlugins(ctx, bucket, specs, platforms, download, &out))
+	assert.Equal(t, []string{"aws-linux-amd64", "aws-darwin-arm64"}, downloads)
+	assert.Equal(t, "Mirrored resource plugin aws-1.0.0 for linux-amd64\n"+
+		"Mirrored resource plugin aws-1.0.0 for darwin-arm64\n", out.String())
+
+	f, err := os.Open(filepath.Join(dir, "mirror", workspace.PluginMirrorIndexFile))
+	require.NoError(t, err)
+	defer f.Close()
+	index, err := workspace.ReadPluginMirrorIndex(f)
+	require.NoError(t, err)
+
+	entry := index.Find(workspace.ResourcePlugin, "aws", v1, "linux", "amd64")
+	require.NotNil(t, entry)
+	assert.Equal(t, "resource/aws/pulumi-resource-aws-v1.0.0-linux-amd64.tar.gz", entry.File)
+	checksum := sha256.Sum256([]byte("aws-linux-amd64"))
+	assert.Equal(t, hex.EncodeToString(checksum[:]), entry.SHA256)
+	contents, err := os.ReadFile(filepath.Join(dir, "mirror", filepath.FromSlash(entry.File)))
+	require.NoError(t, err)
+	assert.Equal(t, "aws-linux-amd64", string(contents))
+
+	// Mirroring the same plugins again doesn't download anything.
+	downloads = nil
+	out.Reset()
+	require.NoError(t, mirrorPlugins(ctx, bucket, specs, platforms, download, &out))
+	assert.Empty(t, downloads)
+	assert.Contains(t, out.String(), "resource plugin aws-1.0.0 for linux-amd64 is already mirrored")
+}
+
+//nolint:paralleltest // sets PULUMI_PLUGIN_MIRROR
+func TestMirrorPluginsIgnoresConfiguredMirror(t *testing.T) {
+	ctx := context.Background()
+	mirror := filepath.Join(t.TempDir(), "mirror")
+	// The mirror being populated is also the configured one, but doesn't have the plugin yet.
+	t.Setenv("PULUMI_PLUGIN_MIRROR", mirror)
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		_, err := w.Write([]byte("archive"))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	bucket, err := pkgWorkspace.OpenPluginMirrorBucket(ctx, mirror)
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	v1 := semver.MustParse("1.0.0")
+	specs := []workspace.PluginSpec{{
+		Kind: workspace.ResourcePlugin, Name: "aws", Version: &v1, PluginDownloadURL: server.URL,
+	}}
+	platforms := []pluginMirrorPlatform{{OS: "linux", Arch: "amd64"}}
+
+	var out bytes.Buffer
+	require.NoError(t, mirrorPlugins(ctx, bucket, specs, platforms, workspace.PluginSpec.DownloadFromOrigin, &out))
+	assert.Equal(t, []string{"/pulumi-resource-aws-v1.0.0-linux-amd64.tar.gz"}, requests)
+
+	contents, err := os.ReadFile(filepath.Join(mirror, "resource", "aws", "pulumi-resource-aws-v1.0.0-linux-amd64.tar.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(contents))
+}
+
+func TestPluginMirrorSpecs(t *testing.T) {
+	t.Parallel()
+
+	args := []string{"resource", "aws", "v6.0.0", "language", "go", "3.0.0"}
+	specs, err := pluginMirrorSpecs(args, "https://example.com")
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, "aws-6.0.0", specs[0].String())
+	assert.Equal(t, "https://example.com", specs[0].PluginDownloadURL)
+	assert.Equal(t, workspace.LanguagePlugin, specs[1].Kind)
+
+	_, err = pluginMirrorSpecs([]string{"resource", "aws"}, "")
+	assert.ErrorContains(t, err, "must be listed as KIND NAME VERSION arguments")
+
+	_, err = pluginMirrorSpecs([]string{"nope", "aws", "1.0.0"}, "")
+	assert.ErrorContains(t, err, "unrecognized plugin kind: nope")
+}
+
+func TestParsePluginMirrorPlatforms(t *testing.T) {
+	t.Parallel()
+
+	platforms, err := parsePluginMirrorPlatforms([]string{"linux-amd64", "windows-arm64"})
+	require.NoError(t, err)
+	assert.Equal(t, []pluginMirrorPlatform{{OS: "linux", Arch: "amd64"}, {OS: "windows", Arch: "arm64"}}, platforms)
+
+	_, err = parsePluginMirrorPlatforms([]string{"linux"})
+	assert.ErrorContains(t, err, `invalid platform "linux"`)
+}
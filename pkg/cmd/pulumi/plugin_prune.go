@@ -0,0 +1,233 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPluginPruneCmd() *cobra.Command {
+	var dirs []string
+	var unusedFor time.Duration
+	var dryRun bool
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Args:  cmdutil.NoArgs,
+		Short: "Remove unused plugins from the download cache",
+		Long: "Remove unused plugins from the download cache.\n" +
+			"\n" +
+			"A plugin is removed if it is not recorded in the Pulumi.lock file of any project found\n" +
+			"under the directories given by `--dir` (by default, the current directory), and it has\n" +
+			"not been used for longer than `--unused-for`. Pulumi records when a plugin was last used\n" +
+			"every time it loads it.\n" +
+			"\n" +
+			"This removal cannot be undone.  If a deleted plugin is subsequently required\n" +
+			"in order to execute a Pulumi program, it must be re-downloaded and installed\n" +
+			"using the plugin install command.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			yes = yes || skipConfirmations()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			locked, err := findLockedPlugins(dirs)
+			if err != nil {
+				return err
+			}
+
+			plugins, err := workspace.GetPluginsWithMetadata()
+			if err != nil {
+				return fmt.Errorf("loading plugins: %w", err)
+			}
+			prunes := selectPrunablePlugins(plugins, locked, time.Now().Add(-unusedFor))
+			if len(prunes) == 0 {
+				cmdutil.Diag().Infof(diag.Message("", "no plugins found to prune"))
+				return nil
+			}
+
+			var totalSize uint64
+			rows := make([]cmdutil.TableRow, len(prunes))
+			for i, plugin := range prunes {
+				size := naString
+				if plugin.Size != 0 {
+					size = humanize.Bytes(uint64(plugin.Size))
+				}
+				lastUsed := humanNeverTime
+				if !plugin.LastUsedTime.IsZero() {
+					lastUsed = humanize.Time(plugin.LastUsedTime)
+				}
+				rows[i] = cmdutil.TableRow{
+					Columns: []string{plugin.Name, string(plugin.Kind), plugin.Version.String(), size, lastUsed},
+				}
+				totalSize += uint64(plugin.Size)
+			}
+
+			var suffix string
+			if len(prunes) != 1 {
+				suffix = "s"
+			}
+			if dryRun {
+				fmt.Printf("This would remove %d plugin%s from the cache, freeing %s:\n",
+					len(prunes), suffix, humanize.Bytes(totalSize))
+			} else {
+				fmt.Print(opts.Color.Colorize(fmt.Sprintf("%sThis will remove %d plugin%s from the cache, freeing %s:%s\n",
+					colors.SpecAttention, len(prunes), suffix, humanize.Bytes(totalSize), colors.Reset)))
+			}
+			printTable(cmdutil.Table{
+				Headers: []string{"NAME", "KIND", "VERSION", "SIZE", "LAST USED"},
+				Rows:    rows,
+				Prefix:  "    ",
+			}, nil)
+			if dryRun {
+				return nil
+			}
+
+			// Confirm that the user wants to do this (unless --yes was passed).
+			if !yes && !confirmPrompt("", "yes", opts) {
+				return nil
+			}
+
+			// Run the actual delete operations. Each plugin is deleted under its install lock, so that a plugin
+			// that is concurrently being reinstalled is never removed from under the installer.
+			var result error
+			var freed uint64
+			for _, plugin := range prunes {
+				if err := plugin.DeleteWithLock(); err == nil {
+					fmt.Printf("removed: %s %v\n", plugin.Kind, plugin)
+					freed += uint64(plugin.Size)
+				} else {
+					result = multierror.Append(
+						result, fmt.Errorf("failed to delete %s plugin %s: %w", plugin.Kind, plugin, err))
+				}
+			}
+			fmt.Printf("Freed %s\n", humanize.Bytes(freed))
+			return result
+		}),
+	}
+
+	cmd.PersistentFlags().StringArrayVar(
+		&dirs, "dir", []string{"."},
+		"A directory to search for projects whose Pulumi.lock plugins should be kept; may be repeated")
+	cmd.PersistentFlags().DurationVar(
+		&unusedFor, "unused-for", 30*24*time.Hour,
+		"Only remove plugins that have not been used for at least this long")
+	cmd.PersistentFlags().BoolVar(
+		&dryRun, "dry-run", false,
+		"Only show the plugins that would be removed, without removing them")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Skip confirmation prompts, and proceed with removal anyway")
+
+	return cmd
+}
+
+// lockedPluginKey identifies a plugin recorded in a plugin lock.
+type lockedPluginKey struct {
+	kind    workspace.PluginKind
+	name    string
+	version string
+}
+
+// findLockedPlugins searches the given directories for project plugin lock files, and returns the set of plugins
+// recorded in them. Dependency and VCS directories are not searched.
+func findLockedPlugins(dirs []string) (map[lockedPluginKey]bool, error) {
+	locked := map[lockedPluginKey]bool{}
+	for _, root := range dirs {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if path != root && (strings.HasPrefix(name, ".") || name == "node_modules" || name == "venv") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Name() != workspace.PluginLockFile {
+				return nil
+			}
+
+			lock, err := workspace.LoadPluginLock(path)
+			if err != nil {
+				return err
+			}
+			for _, p := range lock.Plugins {
+				spec, err := p.Spec()
+				if err != nil {
+					return err
+				}
+				locked[lockedPluginKey{kind: spec.Kind, name: spec.Name, version: spec.Version.String()}] = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("searching %s for plugin lock files: %w", root, err)
+		}
+	}
+	return locked, nil
+}
+
+// selectPrunablePlugins returns the plugins that are not locked and have not been used since the given time, sorted
+// by name and version. Plugins that have never been recorded as used are judged by their install time instead.
+func selectPrunablePlugins(
+	plugins []workspace.PluginInfo, locked map[lockedPluginKey]bool, cutoff time.Time,
+) []workspace.PluginInfo {
+	var prunes []workspace.PluginInfo
+	for _, plugin := range plugins {
+		if plugin.Version == nil ||
+			locked[lockedPluginKey{kind: plugin.Kind, name: plugin.Name, version: plugin.Version.String()}] {
+			continue
+		}
+
+		lastUsed := plugin.LastUsedTime
+		if lastUsed.IsZero() {
+			lastUsed = plugin.InstallTime
+		}
+		if lastUsed.After(cutoff) {
+			continue
+		}
+		prunes = append(prunes, plugin)
+	}
+
+	sort.Slice(prunes, func(i, j int) bool {
+		pi, pj := prunes[i], prunes[j]
+		if pi.Name != pj.Name {
+			return pi.Name < pj.Name
+		}
+		if pi.Kind != pj.Kind {
+			return pi.Kind < pj.Kind
+		}
+		return pi.Version.LT(*pj.Version)
+	})
+	return prunes
+}
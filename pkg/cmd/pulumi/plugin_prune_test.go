@@ -0,0 +1,82 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestFindLockedPlugins(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeLock := func(dir string, name, version string) {
+		require.NoError(t, os.MkdirAll(dir, 0o700))
+		v := semver.MustParse(version)
+		lock := &workspace.PluginLock{}
+		lock.Record(workspace.PluginSpec{Kind: workspace.ResourcePlugin, Name: name, Version: &v})
+		require.NoError(t, lock.Save(workspace.PluginLockPath(dir)))
+	}
+	writeLock(filepath.Join(root, "a"), "aws", "1.0.0")
+	writeLock(filepath.Join(root, "b", "nested"), "gcp", "2.0.0")
+	// Lock files in dependency directories are ignored.
+	writeLock(filepath.Join(root, "a", "node_modules", "dep"), "azure", "3.0.0")
+
+	locked, err := findLockedPlugins([]string{root})
+	require.NoError(t, err)
+	assert.Equal(t, map[lockedPluginKey]bool{
+		{kind: workspace.ResourcePlugin, name: "aws", version: "1.0.0"}: true,
+		{kind: workspace.ResourcePlugin, name: "gcp", version: "2.0.0"}: true,
+	}, locked)
+}
+
+func TestSelectPrunablePlugins(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	v1 := semver.MustParse("1.0.0")
+	v2 := semver.MustParse("2.0.0")
+	plugins := []workspace.PluginInfo{
+		// Recently used.
+		{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v2, LastUsedTime: now},
+		// Unused, but locked.
+		{Kind: workspace.ResourcePlugin, Name: "gcp", Version: &v1, LastUsedTime: now.Add(-60 * 24 * time.Hour)},
+		// Unused.
+		{Kind: workspace.ResourcePlugin, Name: "aws", Version: &v1, LastUsedTime: now.Add(-60 * 24 * time.Hour)},
+		// Never used, and installed long ago.
+		{Kind: workspace.LanguagePlugin, Name: "aws", Version: &v1, InstallTime: now.Add(-60 * 24 * time.Hour)},
+		// Never used, but recently installed.
+		{Kind: workspace.ResourcePlugin, Name: "azure", Version: &v1, InstallTime: now},
+	}
+	locked := map[lockedPluginKey]bool{
+		{kind: workspace.ResourcePlugin, name: "gcp", version: "1.0.0"}: true,
+	}
+
+	prunes := selectPrunablePlugins(plugins, locked, now.Add(-30*24*time.Hour))
+	require.Len(t, prunes, 2)
+	assert.Equal(t, workspace.LanguagePlugin, prunes[0].Kind)
+	assert.Equal(t, "aws-1.0.0", prunes[0].String())
+	assert.Equal(t, workspace.ResourcePlugin, prunes[1].Kind)
+	assert.Equal(t, "aws-1.0.0", prunes[1].String())
+}
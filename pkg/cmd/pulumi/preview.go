@@ -266,9 +266,11 @@ func newPreviewCmd() *cobra.Command {
 	var suppressOutputs bool
 	var suppressProgress bool
 	var suppressPermalink string
+	var frozen bool
 	var targets []string
 	var replaces []string
 	var targetReplaces []string
+	var allowReplaces []string
 	var targetDependents bool
 
 	use, cmdArgs := "preview", cmdutil.NoArgs
@@ -430,10 +432,12 @@ func newPreviewCmd() *cobra.Command {
 					DisableOutputValues:       disableOutputValues(),
 					Targets:                   deploy.NewUrnTargets(targetURNs),
 					TargetDependents:          targetDependents,
+					ReplacementGuard:          getReplacementGuard(proj, allowReplaces),
 					// If we're trying to save a plan then we _need_ to generate it. We also turn this on in
 					// experimental mode to just get more testing of it.
-					GeneratePlan: hasExperimentalCommands() || planFilePath != "",
-					Experimental: hasExperimentalCommands(),
+					GeneratePlan:     hasExperimentalCommands() || planFilePath != "",
+					FrozenPluginLock: frozen,
+					Experimental:     hasExperimentalCommands(),
 				},
 				Display: displayOpts,
 			}
@@ -557,6 +561,10 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().StringArrayVar(
 		&replaces, "replace", []string{},
 		"Specify resources to replace. Multiple resources can be specified using --replace urn1 --replace urn2")
+	cmd.PersistentFlags().StringArrayVar(
+		&allowReplaces, "allow-replace", []string{},
+		"Allow the replacement or deletion of a resource guarded by the project's replacementGuard."+
+			" Multiple resources can be specified using --allow-replace urn1 --allow-replace urn2. Wildcards supported")
 	cmd.PersistentFlags().StringArrayVar(
 		&targetReplaces, "target-replace", []string{},
 		"Specify a single resource URN to replace. Other resources will not be updated."+
@@ -607,6 +615,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressProgress, "suppress-progress", false,
 		"Suppress display of periodic progress dots")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
@@ -209,6 +209,10 @@ func NewPulumiCmd() *cobra.Command {
 				}
 			}
 
+			if err := setPluginLimitOverride(pluginLimitFlags); err != nil {
+				return err
+			}
+
 			logging.InitLogging(logToStderr, verbose, logFlow)
 			cmdutil.InitTracing("pulumi-cli", "pulumi", tracingFlag)
 
@@ -304,6 +308,9 @@ func NewPulumiCmd() *cobra.Command {
 		"Enable verbose logging (e.g., v=3); anything >3 is very verbose")
 	cmd.PersistentFlags().StringVar(
 		&color, "color", "auto", "Colorize output. Choices are: always, never, raw, auto")
+	cmd.PersistentFlags().StringArrayVar(&pluginLimitFlags, "plugin-limit", nil,
+		"Limit the resources of all plugin processes, overriding Pulumi.yaml. Of the form KEY=VALUE, where KEY is one "+
+			"of memory, cpuTime, openFiles, env (a comma separated allow-list) or workingDirectory; may be repeated")
 
 	setCommandGroups(cmd, []commandGroup{
 		// Common commands:
@@ -317,6 +324,7 @@ func NewPulumiCmd() *cobra.Command {
 				newImportCmd(),
 				newRefreshCmd(),
 				newStateCmd(),
+				newGroupCmd(),
 				newInstallCmd(),
 			},
 		},
@@ -382,6 +390,7 @@ func NewPulumiCmd() *cobra.Command {
 			Commands: []*cobra.Command{
 				newQueryCmd(),
 				newConvertCmd(),
+				newPCLCmd(),
 				newWatchCmd(),
 				newLogsCmd(),
 			},
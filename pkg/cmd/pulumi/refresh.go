@@ -62,6 +62,7 @@ func newRefreshCmd() *cobra.Command {
 	var suppressOutputs bool
 	var suppressProgress bool
 	var suppressPermalink string
+	var frozen bool
 	var yes bool
 	var targets *[]string
 
@@ -264,6 +265,7 @@ func newRefreshCmd() *cobra.Command {
 				DisableResourceReferences: disableResourceReferences(),
 				DisableOutputValues:       disableOutputValues(),
 				Targets:                   deploy.NewUrnTargets(targetUrns),
+				FrozenPluginLock:          frozen,
 				Experimental:              hasExperimentalCommands(),
 			}
 
@@ -340,6 +342,9 @@ func newRefreshCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&suppressProgress, "suppress-progress", false,
 		"Suppress display of periodic progress dots")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
@@ -26,10 +26,13 @@ func newSchemaCmd() *cobra.Command {
 		Long: `Analyze package schemas
 
 Subcommands of this command can be used to analyze Pulumi package schemas. This can be useful to check hand-authored
-package schemas for errors.`,
+package schemas for errors, to compare two versions of a package's schema for breaking changes, or to manage the
+cache of package schemas.`,
 		Args: cmdutil.NoArgs,
 	}
 
 	cmd.AddCommand(newSchemaCheckCommand())
+	cmd.AddCommand(newSchemaDiffCommand())
+	cmd.AddCommand(newSchemaCacheCmd())
 	return cmd
 }
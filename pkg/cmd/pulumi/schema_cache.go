@@ -0,0 +1,187 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newSchemaCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the schema cache",
+		Long: "Manage the schema cache.\n" +
+			"\n" +
+			"Pulumi caches the schemas of the packages it loads, so that plugins do not have to be run\n" +
+			"just to read their schemas. The cache is shared by all Pulumi commands and language hosts,\n" +
+			"and can be disabled by setting PULUMI_DISABLE_SCHEMA_CACHE=true.",
+		Args: cmdutil.NoArgs,
+	}
+	cmd.AddCommand(newSchemaCacheLsCmd())
+	cmd.AddCommand(newSchemaCachePruneCmd())
+	return cmd
+}
+
+func newSchemaCacheLsCmd() *cobra.Command {
+	var jsonOut bool
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List cached schemas",
+		Args:  cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			cache, err := schema.DefaultSchemaCache()
+			if err != nil {
+				return err
+			}
+			entries, err := cache.List()
+			if err != nil {
+				return fmt.Errorf("listing schema cache: %w", err)
+			}
+			if jsonOut {
+				return formatSchemaCacheJSON(entries)
+			}
+			formatSchemaCacheConsole(entries)
+			return nil
+		}),
+	}
+	cmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "Emit output as JSON")
+	return cmd
+}
+
+// schemaCacheEntryJSON is the shape of the --json output of `pulumi schema cache ls`.
+type schemaCacheEntryJSON struct {
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	Digest       string `json:"digest"`
+	Size         int64  `json:"size"`
+	CreatedTime  string `json:"createdTime"`
+	LastUsedTime string `json:"lastUsedTime"`
+}
+
+func formatSchemaCacheJSON(entries []schema.SchemaCacheEntry) error {
+	out := make([]schemaCacheEntryJSON, len(entries))
+	for i, entry := range entries {
+		out[i] = schemaCacheEntryJSON{
+			Name:         entry.Name,
+			Version:      entry.Version,
+			Digest:       entry.Digest,
+			Size:         entry.Size,
+			CreatedTime:  entry.CreatedAt.UTC().Format(timeFormat),
+			LastUsedTime: entry.LastUsed.UTC().Format(timeFormat),
+		}
+	}
+	return printJSON(out)
+}
+
+func formatSchemaCacheConsole(entries []schema.SchemaCacheEntry) {
+	rows := make([]cmdutil.TableRow, len(entries))
+	// Packages that share a schema share its storage, so the total only counts each schema once.
+	var totalSize uint64
+	digests := map[string]bool{}
+	for i, entry := range entries {
+		rows[i] = cmdutil.TableRow{Columns: []string{
+			entry.Name, entry.Version, entry.Digest[:12], humanize.Bytes(uint64(entry.Size)),
+			humanize.Time(entry.CreatedAt), humanize.Time(entry.LastUsed),
+		}}
+		if !digests[entry.Digest] {
+			digests[entry.Digest] = true
+			totalSize += uint64(entry.Size)
+		}
+	}
+
+	printTable(cmdutil.Table{
+		Headers: []string{"NAME", "VERSION", "DIGEST", "SIZE", "CACHED", "LAST USED"},
+		Rows:    rows,
+	}, nil)
+
+	fmt.Printf("\n")
+	fmt.Printf("TOTAL schema cache size: %s\n", humanize.Bytes(totalSize))
+}
+
+func newSchemaCachePruneCmd() *cobra.Command {
+	var unusedFor time.Duration
+	var all bool
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove unused schemas from the schema cache",
+		Long: "Remove unused schemas from the schema cache.\n" +
+			"\n" +
+			"A schema is removed if it has not been used for longer than `--unused-for`, or in any case if\n" +
+			"`--all` is passed. Removed schemas are read from their plugins again when they are next used.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			cache, err := schema.DefaultSchemaCache()
+			if err != nil {
+				return err
+			}
+			entries, err := cache.List()
+			if err != nil {
+				return fmt.Errorf("listing schema cache: %w", err)
+			}
+			prunes := selectPrunableSchemas(entries, all, time.Now().Add(-unusedFor))
+			if len(prunes) == 0 {
+				fmt.Println("no schemas found to prune")
+				return nil
+			}
+
+			for _, entry := range prunes {
+				if dryRun {
+					fmt.Printf("would remove: %s@%s\n", entry.Name, entry.Version)
+				} else {
+					fmt.Printf("removing: %s@%s\n", entry.Name, entry.Version)
+				}
+			}
+			if dryRun {
+				return nil
+			}
+
+			freed, err := cache.Remove(prunes)
+			fmt.Printf("Freed %s\n", humanize.Bytes(uint64(freed)))
+			return err
+		}),
+	}
+	cmd.PersistentFlags().DurationVar(
+		&unusedFor, "unused-for", 30*24*time.Hour,
+		"Only remove schemas that have not been used for at least this long")
+	cmd.PersistentFlags().BoolVar(
+		&all, "all", false,
+		"Remove all schemas, regardless of when they were last used")
+	cmd.PersistentFlags().BoolVar(
+		&dryRun, "dry-run", false,
+		"Only show the schemas that would be removed, without removing them")
+	return cmd
+}
+
+// selectPrunableSchemas returns the cache entries that have not been used since the given time, or all entries if all
+// is set.
+func selectPrunableSchemas(
+	entries []schema.SchemaCacheEntry, all bool, unusedSince time.Time,
+) []schema.SchemaCacheEntry {
+	var prunes []schema.SchemaCacheEntry
+	for _, entry := range entries {
+		if all || entry.LastUsed.Before(unusedSince) {
+			prunes = append(prunes, entry)
+		}
+	}
+	return prunes
+}
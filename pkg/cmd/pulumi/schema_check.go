@@ -43,30 +43,9 @@ func newSchemaCheckCommand() *cobra.Command {
 			"schema spec as well as additional requirements imposed by the supported\n" +
 			"target languages.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
-			file := args[0]
-
-			// Read from stdin or a specified file
-			reader := os.Stdin
-			if file != "-" {
-				f, err := os.Open(file)
-				if err != nil {
-					return fmt.Errorf("could not open file %v: %w", file, err)
-				}
-				reader = f
-			}
-			schemaBytes, err := io.ReadAll(reader)
+			pkgSpec, err := readPackageSpec(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to read schema: %w", err)
-			}
-
-			var pkgSpec schema.PackageSpec
-			if ext := filepath.Ext(file); ext == ".yaml" || ext == ".yml" {
-				err = yaml.Unmarshal(schemaBytes, &pkgSpec)
-			} else {
-				err = json.Unmarshal(schemaBytes, &pkgSpec)
-			}
-			if err != nil {
-				return fmt.Errorf("failed to unmarshal schema: %w", err)
+				return err
 			}
 
 			_, diags, err := schema.BindSpec(pkgSpec, nil)
@@ -82,3 +61,32 @@ func newSchemaCheckCommand() *cobra.Command {
 
 	return cmd
 }
+
+// readPackageSpec reads a package schema from the given JSON or YAML file, or from stdin if the file is "-".
+func readPackageSpec(file string) (schema.PackageSpec, error) {
+	// Read from stdin or a specified file
+	reader := os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return schema.PackageSpec{}, fmt.Errorf("could not open file %v: %w", file, err)
+		}
+		defer contract.IgnoreClose(f)
+		reader = f
+	}
+	schemaBytes, err := io.ReadAll(reader)
+	if err != nil {
+		return schema.PackageSpec{}, fmt.Errorf("failed to read schema: %w", err)
+	}
+
+	var pkgSpec schema.PackageSpec
+	if ext := filepath.Ext(file); ext == ".yaml" || ext == ".yml" {
+		err = yaml.Unmarshal(schemaBytes, &pkgSpec)
+	} else {
+		err = json.Unmarshal(schemaBytes, &pkgSpec)
+	}
+	if err != nil {
+		return schema.PackageSpec{}, fmt.Errorf("failed to unmarshal schema: %w", err)
+	}
+	return pkgSpec, nil
+}
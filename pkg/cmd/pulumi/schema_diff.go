@@ -0,0 +1,176 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+func newSchemaDiffCommand() *cobra.Command {
+	var jsonOut bool
+	var failOn string
+
+	cmd := &cobra.Command{
+		Use:   "diff <old> <new>",
+		Args:  cmdutil.ExactArgs(2),
+		Short: "Compare two versions of a Pulumi package schema",
+		Long: "Compare two versions of a Pulumi package schema.\n" +
+			"\n" +
+			"Report the changes to each resource, function and type of a package between two\n" +
+			"versions of its schema, and whether each change may break programs or stacks that\n" +
+			"use the old version. Where a change affects the SDK of some languages differently,\n" +
+			"its impact on each of those languages is described.\n" +
+			"\n" +
+			"The command fails if any change matches the --fail-on policy, so it can be used to\n" +
+			"check provider releases in CI:\n" +
+			"\n" +
+			"  - breaking (the default) fails if there are breaking changes\n" +
+			"  - any fails if there are any changes\n" +
+			"  - none never fails",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			if failOn != "breaking" && failOn != "any" && failOn != "none" {
+				return fmt.Errorf("invalid --fail-on policy %q; must be one of breaking, any or none", failOn)
+			}
+
+			oldPkg, err := bindPackageSpec(args[0])
+			if err != nil {
+				return err
+			}
+			newPkg, err := bindPackageSpec(args[1])
+			if err != nil {
+				return err
+			}
+
+			changes := schema.Diff(oldPkg, newPkg)
+			if jsonOut {
+				if changes == nil {
+					changes = []schema.Change{}
+				}
+				if err := printJSON(changes); err != nil {
+					return err
+				}
+			} else {
+				printSchemaChanges(os.Stdout, changes)
+			}
+
+			var breaking int
+			for _, c := range changes {
+				if c.Breaking {
+					breaking++
+				}
+			}
+			switch {
+			case failOn == "breaking" && breaking > 0:
+				return fmt.Errorf("found %d breaking change(s)", breaking)
+			case failOn == "any" && len(changes) > 0:
+				return fmt.Errorf("found %d change(s)", len(changes))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit the changes as JSON")
+	cmd.PersistentFlags().StringVar(
+		&failOn, "fail-on", "breaking",
+		"The changes that cause the command to fail: breaking, any or none")
+
+	return cmd
+}
+
+// bindPackageSpec reads and binds the package schema in the given file, printing any diagnostics to stderr.
+func bindPackageSpec(file string) (*schema.Package, error) {
+	pkgSpec, err := readPackageSpec(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, diags, err := schema.BindSpec(pkgSpec, nil)
+	diagWriter := hcl.NewDiagnosticTextWriter(os.Stderr, nil, 0, true)
+	wrErr := diagWriter.WriteDiagnostics(diags)
+	contract.IgnoreError(wrErr)
+	if err != nil {
+		return nil, fmt.Errorf("binding schema %v: %w", file, err)
+	}
+	if diags.HasErrors() {
+		return nil, errors.New("schema validation failed for " + file)
+	}
+	return pkg, nil
+}
+
+// printSchemaChanges prints the given changes, breaking changes first, grouped by the member that they apply to.
+func printSchemaChanges(w io.Writer, changes []schema.Change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+
+	var breaking, nonBreaking []schema.Change
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		} else {
+			nonBreaking = append(nonBreaking, c)
+		}
+	}
+
+	printGroup := func(title string, changes []schema.Change) {
+		if len(changes) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s:\n", title)
+		var kind, token string
+		for i, c := range changes {
+			if i == 0 || c.Kind != kind || c.Token != token {
+				kind, token = c.Kind, c.Token
+				if token == "" {
+					fmt.Fprintf(w, "  %s\n", kind)
+				} else {
+					fmt.Fprintf(w, "  %s %s\n", kind, token)
+				}
+			}
+			if c.Property != "" {
+				fmt.Fprintf(w, "    - %s: %s\n", c.Property, c.Message)
+			} else {
+				fmt.Fprintf(w, "    - %s\n", c.Message)
+			}
+
+			langs := make([]string, 0, len(c.Impact))
+			for lang := range c.Impact {
+				langs = append(langs, lang)
+			}
+			sort.Strings(langs)
+			for _, lang := range langs {
+				fmt.Fprintf(w, "        %s: %s\n", lang, c.Impact[lang])
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	printGroup("Breaking changes", breaking)
+	printGroup("Non-breaking changes", nonBreaking)
+
+	fmt.Fprintf(w, "%d breaking and %d non-breaking change(s)\n", len(breaking), len(nonBreaking))
+}
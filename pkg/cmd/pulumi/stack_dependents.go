@@ -0,0 +1,110 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newStackDependentsCmd() *cobra.Command {
+	var stack string
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "dependents",
+		Short: "List the stacks that read a stack's outputs",
+		Long: "List the stacks that read a stack's outputs\n" +
+			"\n" +
+			"This command lists the stacks that read the outputs of the given stack through stack references,\n" +
+			"along with the outputs that each of them read, as recorded by their latest update.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			s, err := requireStack(ctx, stack, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+
+			dependents, err := backend.GetStackDependents(ctx, s.Backend(), s.Ref())
+			if err != nil {
+				return fmt.Errorf("getting stack dependents: %w", err)
+			}
+
+			if jsonOut {
+				return printStackDependentsJSON(dependents)
+			}
+
+			if len(dependents) == 0 {
+				fmt.Printf("No stacks read the outputs of %s\n", s.Ref())
+				return nil
+			}
+			printStackDependents(dependents)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"Choose a stack other than the currently selected one")
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit output as JSON")
+
+	return cmd
+}
+
+// stackDependentJSON is the shape of the --json output of a stack dependent.
+type stackDependentJSON struct {
+	Name    string   `json:"name"`
+	Outputs []string `json:"outputs"`
+}
+
+func printStackDependentsJSON(dependents []backend.StackDependent) error {
+	result := make([]stackDependentJSON, len(dependents))
+	for i, d := range dependents {
+		outputs := make([]string, len(d.Outputs))
+		for j, k := range d.Outputs {
+			outputs[j] = string(k)
+		}
+		result[i] = stackDependentJSON{Name: d.Stack.String(), Outputs: outputs}
+	}
+	return printJSON(result)
+}
+
+func printStackDependents(dependents []backend.StackDependent) {
+	rows := make([]cmdutil.TableRow, len(dependents))
+	for i, d := range dependents {
+		outputs := make([]string, len(d.Outputs))
+		for j, k := range d.Outputs {
+			outputs[j] = string(k)
+		}
+		rows[i] = cmdutil.TableRow{Columns: []string{d.Stack.String(), strings.Join(outputs, ", ")}}
+	}
+
+	printTable(cmdutil.Table{
+		Headers: []string{"STACK", "OUTPUTS"},
+		Rows:    rows,
+	}, nil)
+}
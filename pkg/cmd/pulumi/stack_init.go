@@ -19,11 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
@@ -66,7 +68,11 @@ func newStackInitCmd() *cobra.Command {
 			"\n" +
 			"A stack can be created based on the configuration of an existing stack by passing the\n" +
 			"`--copy-config-from` flag.\n" +
-			"* `pulumi stack init --copy-config-from dev`",
+			"* `pulumi stack init --copy-config-from dev`\n" +
+			"\n" +
+			"An ephemeral stack can be created by passing the `--ttl` flag. The stack is tagged with its expiry,\n" +
+			"after which `pulumi stack reap` destroys and removes it.\n" +
+			"* `pulumi stack init pr-123 --ttl 48h`",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			return sicmd.Run(ctx, args)
@@ -83,6 +89,8 @@ func newStackInitCmd() *cobra.Command {
 	cmd.PersistentFlags().StringArrayVar(&sicmd.teams, "teams", nil, "A list of team "+
 		"names that should have permission to read and update this stack,"+
 		" once created")
+	cmd.PersistentFlags().DurationVar(
+		&sicmd.ttl, "ttl", 0, "How long the stack lives before `pulumi stack reap` destroys and removes it, e.g. 48h")
 	return cmd
 }
 
@@ -93,6 +101,7 @@ type stackInitCmd struct {
 	stackToCopy     string
 	noSelect        bool
 	teams           []string
+	ttl             time.Duration
 
 	// currentBackend is a reference to the top-level currentBackend function.
 	// This is used to override the default implementation for testing purposes.
@@ -168,7 +177,21 @@ func (cmd *stackInitCmd) Run(ctx context.Context, args []string) error {
 		return projectErr
 	}
 
+	if cmd.ttl < 0 {
+		return errors.New("--ttl must not be negative")
+	}
+	// The expiry is recorded as a stack tag, so it would be silently dropped by backends without tags.
+	if cmd.ttl > 0 && !b.SupportsTags() {
+		return fmt.Errorf("stack %s uses the %s backend: "+
+			"%s does not support --ttl", cmd.stackName, b.Name(), b.Name())
+	}
+
 	createOpts := newCreateStackOptions(cmd.teams)
+	if cmd.ttl > 0 {
+		createOpts.Tags = map[apitype.StackTagName]string{
+			apitype.StackExpiresAtTag: backend.StackExpiryTag(time.Now(), cmd.ttl),
+		}
+	}
 	newStack, err := createStack(ctx, b, stackRef, root, createOpts, !cmd.noSelect, cmd.secretsProvider)
 	if err != nil {
 		if errors.Is(err, backend.ErrTeamsNotSupported) {
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
@@ -49,6 +50,46 @@ func TestStackInit_teamsUnsupportedByBackend(t *testing.T) {
 	assert.ErrorContains(t, err, "stack dev uses the mock backend: mock does not support --teams")
 }
 
+// When a backend doesn't support stack tags, which record the expiry,
+// stack creation with --ttl should fail.
+func TestStackInit_ttlUnsupportedByBackend(t *testing.T) {
+	t.Parallel()
+
+	mockBackend := &backend.MockBackend{
+		NameF: func() string {
+			return "mock"
+		},
+		ParseStackReferenceF: func(ref string) (backend.StackReference, error) {
+			return &backend.MockStackReference{}, nil
+		},
+		ValidateStackNameF: func(name string) error {
+			return nil
+		},
+		SupportsTagsF: func() bool {
+			return false
+		},
+		CreateStackF: func(
+			ctx context.Context,
+			ref backend.StackReference,
+			projectRoot string,
+			opts *backend.CreateStackOptions,
+		) (backend.Stack, error) {
+			t.Fatal("CreateStack should not be called")
+			return nil, nil
+		},
+	}
+	cmd := &stackInitCmd{
+		ttl:       time.Hour,
+		stackName: "dev",
+		currentBackend: func(context.Context, *workspace.Project, display.Options) (backend.Backend, error) {
+			return mockBackend, nil
+		},
+	}
+
+	err := cmd.Run(context.Background(), nil /* args */)
+	assert.ErrorContains(t, err, "stack dev uses the mock backend: mock does not support --ttl")
+}
+
 // This test demonstrates that newCreateStackOptions will filter
 // out teams consisting exclusively of whitespace. NB: It's not intended
 // to fully validate the correctness of team names. For example, it doesn't
@@ -0,0 +1,212 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newStackReapCmd() *cobra.Command {
+	var dryRun bool
+	var yes bool
+	var concurrency int
+
+	cmd := &cobra.Command{
+		Use:   "reap",
+		Short: "Destroy and remove expired stacks",
+		Long: "Destroy and remove expired stacks\n" +
+			"\n" +
+			"This command finds the stacks in the current backend whose expiry, as set by\n" +
+			"`pulumi stack init --ttl`, has passed, then destroys their resources and removes them.\n" +
+			"A stack that fails to be destroyed, for example because it has protected resources,\n" +
+			"is left in place.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			yes = yes || skipConfirmations()
+			if !dryRun && !yes && !cmdutil.Interactive() {
+				return errors.New("--yes must be passed in to proceed when running in non-interactive mode")
+			}
+			if concurrency < 1 {
+				return errors.New("--concurrency must be at least 1")
+			}
+
+			project, _, err := readProject()
+			if err != nil && !errors.Is(err, workspace.ErrProjectNotFound) {
+				return err
+			}
+			b, err := currentBackend(ctx, project, opts)
+			if err != nil {
+				return err
+			}
+
+			expired, err := backend.GetExpiredStacks(ctx, b, time.Now())
+			if err != nil {
+				return fmt.Errorf("finding expired stacks: %w", err)
+			}
+			if len(expired) == 0 {
+				fmt.Println("No stacks have expired")
+				return nil
+			}
+
+			fmt.Printf("%d stack(s) have expired:\n", len(expired))
+			for _, e := range expired {
+				fmt.Printf("    - %s (expired %s)\n", e.Stack.Ref(), e.ExpiresAt.Local().Format(time.RFC1123))
+			}
+			fmt.Println()
+			if dryRun {
+				return nil
+			}
+
+			prompt := fmt.Sprintf("This will irreversibly destroy the resources of %d stack(s) and remove them!",
+				len(expired))
+			if !yes && !confirmPrompt(prompt, "reap", opts) {
+				return result.FprintBailf(os.Stdout, "confirmation declined")
+			}
+
+			errs := reapStacks(ctx, expired, concurrency, opts)
+			var failed int
+			for i, e := range expired {
+				if errs[i] != nil {
+					failed++
+					fmt.Printf("error: failed to reap %s: %v\n", e.Stack.Ref(), errs[i])
+					continue
+				}
+				msg := fmt.Sprintf("%sStack '%s' has been reaped!%s", colors.SpecAttention, e.Stack.Ref(), colors.Reset)
+				fmt.Println(opts.Color.Colorize(msg))
+			}
+			if failed > 0 {
+				return fmt.Errorf("failed to reap %d of %d stack(s)", failed, len(expired))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVar(
+		&dryRun, "dry-run", false,
+		"Only list the expired stacks, without destroying or removing them")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Skip confirmation prompts, and proceed with destroying and removing the expired stacks")
+	cmd.PersistentFlags().IntVar(
+		&concurrency, "concurrency", 1,
+		"The number of stacks to destroy at once")
+
+	return cmd
+}
+
+// reapStacks destroys and removes the given stacks, the given number at once, returning the error that reaping each
+// stack failed with. The progress of each stack is prefixed with its name.
+func reapStacks(ctx context.Context, expired []backend.ExpiredStack, concurrency int,
+	opts display.Options,
+) []error {
+	errs := make([]error, len(expired))
+	sem := make(chan struct{}, concurrency)
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for i, e := range expired {
+		i, s := i, e.Stack
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			displayOpts := opts
+			displayOpts.Stdout = &prefixWriter{prefix: "[" + s.Ref().String() + "] ", w: os.Stdout, lock: &lock}
+			displayOpts.Stderr = &prefixWriter{prefix: "[" + s.Ref().String() + "] ", w: os.Stderr, lock: &lock}
+			errs[i] = reapStack(ctx, s, displayOpts)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// reapStack destroys the resources of the given stack and removes it. The stack's project need not be the current
+// project, so the stack is destroyed using the configuration of its latest update.
+func reapStack(ctx context.Context, s backend.Stack, opts display.Options) error {
+	snap, err := s.Snapshot(ctx, stack.DefaultSecretsProvider)
+	if err != nil {
+		return err
+	}
+
+	if snap != nil && len(snap.Resources) > 0 {
+		cfg, err := backend.GetLatestConfiguration(ctx, s)
+		if err != nil && !errors.Is(err, backend.ErrNoPreviousDeployment) {
+			return fmt.Errorf("getting stack configuration: %w", err)
+		}
+
+		var decrypter config.Decrypter = config.NopDecrypter
+		if snap.SecretsManager != nil {
+			if decrypter, err = snap.SecretsManager.Decrypter(); err != nil {
+				return fmt.Errorf("getting stack decrypter: %w", err)
+			}
+		}
+
+		projectName, _ := s.Ref().Project()
+		m, err := getUpdateMetadata("Reaping expired stack", "", "", "", false, nil)
+		if err != nil {
+			return fmt.Errorf("gathering environment metadata: %w", err)
+		}
+
+		_, res := s.Destroy(ctx, backend.UpdateOperation{
+			Proj: &workspace.Project{Name: tokens.PackageName(projectName)},
+			M:    m,
+			Opts: backend.UpdateOptions{
+				Engine: engine.UpdateOptions{
+					Parallel:     defaultParallel,
+					Experimental: hasExperimentalCommands(),
+				},
+				Display:     opts,
+				AutoApprove: true,
+				SkipPreview: true,
+			},
+			StackConfiguration: backend.StackConfiguration{Config: cfg, Decrypter: decrypter},
+			SecretsManager:     snap.SecretsManager,
+			SecretsProvider:    stack.DefaultSecretsProvider,
+			Scopes:             backend.CancellationScopes,
+		})
+		if res != nil {
+			return res.Error()
+		}
+	}
+
+	_, err = s.Remove(ctx, false)
+	return err
+}
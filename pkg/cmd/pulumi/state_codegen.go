@@ -0,0 +1,275 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/importer"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+func newStateCodegenCommand() *cobra.Command {
+	var stackName string
+	var types []string
+	var language string
+	var outputFilePath string
+
+	cmd := &cobra.Command{
+		Use:   "codegen [resource URN...]",
+		Short: "Generate program code for resources in a stack's state",
+		Long: `Generate program code for resources in a stack's state
+
+This command generates the program code that declares the given resources as they are recorded in the stack's
+state. Resources may be selected by URN, by type using --type, or, if neither is given, the whole stack is
+generated.
+
+The explicit providers and parents of the selected resources are generated as well, so that the resources keep
+their relationships. Property values that hold the ID or URN of another generated resource are generated as
+references to that resource, and resource options such as protect, retainOnDelete and ignoreChanges are preserved.
+
+Component resources cannot be generated from state. Children of components are generated at the top level of the
+program, and will need an alias to their original URN to avoid being replaced.
+
+To see the list of URNs in a stack, use ` + "`pulumi stack --show-urns`" + `.`,
+		Example: "  pulumi state codegen --language typescript --out index.ts\n" +
+			"  pulumi state codegen --type 'aws:s3/*' --language python\n" +
+			"  pulumi state codegen 'urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs'",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if language == "" {
+				proj, _, err := readProject()
+				if err != nil {
+					return errors.New("--language must be passed when not running in a Pulumi project")
+				}
+				language = proj.Runtime.Name()
+			}
+			// Translate well known languages to runtimes
+			switch language {
+			case "csharp", "c#":
+				language = "dotnet"
+			case "typescript":
+				language = "nodejs"
+			}
+
+			opts := display.Options{Color: cmdutil.GetGlobalColorization()}
+			s, err := requireStack(ctx, stackName, stackLoadOnly, opts)
+			if err != nil {
+				return err
+			}
+			snap, err := getCurrentDeploymentForStack(ctx, s)
+			if err != nil {
+				return err
+			}
+			if snap == nil {
+				return errors.New("the stack has no resources")
+			}
+
+			urns := make([]resource.URN, len(args))
+			for i, arg := range args {
+				urns[i] = resource.URN(arg)
+			}
+			states, skipped, err := selectCodegenResources(snap, urns, types)
+			if err != nil {
+				return err
+			}
+			if len(states) == 0 {
+				return errors.New("no resources matched")
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			sink := cmdutil.Diag()
+			pCtx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(pCtx.Host)
+
+			for _, urn := range skipped {
+				pCtx.Diag.Warningf(diag.Message(urn, "component resource %v cannot be generated from state; "+
+					"its children are generated without a parent"), urn)
+			}
+
+			var out io.Writer = os.Stdout
+			if outputFilePath != "" {
+				f, err := os.Create(outputFilePath)
+				if err != nil {
+					return err
+				}
+				defer contract.IgnoreClose(f)
+				out = f
+			}
+
+			programGenerator := newProgramGenerator(pCtx, language)
+			loader := schema.NewPluginLoader(pCtx.Host)
+			err = importer.GenerateStateDefinitions(out, loader, func(w io.Writer, p *pcl.Program) error {
+				files, diags, err := programGenerator(p, loader)
+				if err != nil {
+					return err
+				}
+				printDiagnostics(pCtx.Diag, diags)
+				fileNames := make([]string, 0, len(files))
+				for name := range files {
+					fileNames = append(fileNames, name)
+				}
+				sort.Strings(fileNames)
+				for _, name := range fileNames {
+					if _, err := w.Write(files[name]); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, states, makeCodegenNameTable(states))
+			if err != nil {
+				var diagsErr *importer.DiagnosticsError
+				if errors.As(err, &diagsErr) {
+					printDiagnostics(pCtx.Diag, diagsErr.Diagnostics())
+					return errors.New("the resources' recorded inputs do not match their schema")
+				}
+				return err
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stackName, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.Flags().StringArrayVar(&types, "type", nil,
+		"Generate all resources whose type matches the given glob, e.g. 'aws:s3/*'. May be repeated")
+	cmd.Flags().StringVar(&language, "language", "",
+		"The language to generate: typescript, python, csharp, go, java or yaml. Defaults to the project's runtime")
+	cmd.Flags().StringVarP(&outputFilePath, "out", "o", "", "The path to the file that will contain the generated code")
+
+	return cmd
+}
+
+// selectCodegenResources returns the resources in the snapshot that match the given URNs and type globs, along with
+// the explicit providers and parents they need, in snapshot order. If no URNs or types are given, every resource is
+// selected. Component resources cannot be generated; the URNs of any that were needed as parents are returned.
+func selectCodegenResources(
+	snap *deploy.Snapshot, urns []resource.URN, types []string,
+) ([]*resource.State, []resource.URN, error) {
+	all := map[resource.URN]*resource.State{}
+	for _, r := range snap.Resources {
+		if !r.Delete {
+			all[r.URN] = r
+		}
+	}
+
+	for _, glob := range types {
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, nil, fmt.Errorf("invalid type pattern %q: %w", glob, err)
+		}
+	}
+
+	selected := map[resource.URN]bool{}
+	var skipped []resource.URN
+	var add func(urn resource.URN)
+	add = func(urn resource.URN) {
+		r, ok := all[urn]
+		if !ok || selected[urn] || urn.QualifiedType() == resource.RootStackType {
+			return
+		}
+		if providers.IsProviderType(r.Type) && providers.IsDefaultProvider(urn) {
+			return
+		}
+		if !r.Custom {
+			skipped = append(skipped, urn)
+			selected[urn] = true
+			return
+		}
+
+		selected[urn] = true
+		if r.Parent != "" {
+			add(r.Parent)
+		}
+		if r.Provider != "" {
+			if ref, err := providers.ParseReference(r.Provider); err == nil {
+				add(ref.URN())
+			}
+		}
+	}
+
+	for _, urn := range urns {
+		r, ok := all[urn]
+		if !ok {
+			return nil, nil, fmt.Errorf("no such resource %q exists in the current state", urn)
+		}
+		if !r.Custom {
+			return nil, nil, fmt.Errorf("resource %q is a component and cannot be generated from state", urn)
+		}
+		add(urn)
+	}
+	for _, r := range snap.Resources {
+		if r.Delete {
+			continue
+		}
+		matched := len(urns) == 0 && len(types) == 0
+		for _, glob := range types {
+			if ok, _ := path.Match(glob, string(r.Type)); ok {
+				matched = true
+			}
+		}
+		if matched {
+			add(r.URN)
+		}
+	}
+
+	var states []*resource.State
+	for _, r := range snap.Resources {
+		if !r.Delete && selected[r.URN] && r.Custom {
+			states = append(states, r)
+		}
+	}
+	return states, skipped, nil
+}
+
+// makeCodegenNameTable assigns each resource a unique variable name based on its logical name.
+func makeCodegenNameTable(states []*resource.State) importer.NameTable {
+	names := importer.NameTable{}
+	used := map[string]bool{}
+	for _, r := range states {
+		base := importer.VariableName(r.URN.Name())
+		name := base
+		for i := 2; used[name]; i++ {
+			name = base + strconv.Itoa(i)
+		}
+		used[name] = true
+		names[r.URN] = name
+	}
+	return names
+}
@@ -0,0 +1,106 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/importer"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestSelectCodegenResources(t *testing.T) {
+	t.Parallel()
+
+	urn := func(parent, typ tokens.Type, name string) resource.URN {
+		return resource.NewURN("dev", "proj", parent, typ, name)
+	}
+	stackURN := urn("", resource.RootStackType, "proj-dev")
+	defaultProviderURN := urn("", providers.MakeProviderType("aws"), "default_6_0_0")
+	providerURN := urn("", providers.MakeProviderType("aws"), "west")
+	componentURN := urn("", "my:index:Component", "comp")
+	bucketURN := urn("my:index:Component", "aws:s3/bucket:Bucket", "logs")
+	objectURN := urn("", "aws:s3/bucketObject:BucketObject", "index")
+	queueURN := urn("", "aws:sqs/queue:Queue", "queue")
+
+	defaultRef, err := providers.NewReference(defaultProviderURN, "id1")
+	require.NoError(t, err)
+	providerRef, err := providers.NewReference(providerURN, "id2")
+	require.NoError(t, err)
+
+	snap := &deploy.Snapshot{
+		Resources: []*resource.State{
+			{URN: stackURN, Type: resource.RootStackType},
+			{URN: defaultProviderURN, Type: defaultProviderURN.Type(), Custom: true, ID: "id1"},
+			{URN: providerURN, Type: providerURN.Type(), Custom: true, ID: "id2"},
+			{URN: componentURN, Type: componentURN.Type(), Parent: stackURN},
+			{
+				URN: bucketURN, Type: bucketURN.Type(), Custom: true, Parent: componentURN,
+				Provider: providerRef.String(),
+			},
+			{URN: objectURN, Type: objectURN.Type(), Custom: true, Provider: defaultRef.String()},
+			{URN: queueURN, Type: queueURN.Type(), Custom: true, Provider: defaultRef.String()},
+			{URN: queueURN, Type: queueURN.Type(), Custom: true, Delete: true},
+		},
+	}
+
+	urnsOf := func(states []*resource.State) []resource.URN {
+		urns := make([]resource.URN, len(states))
+		for i, s := range states {
+			urns[i] = s.URN
+		}
+		return urns
+	}
+
+	states, skipped, err := selectCodegenResources(snap, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.URN{providerURN, bucketURN, objectURN, queueURN}, urnsOf(states))
+	assert.Equal(t, []resource.URN{componentURN}, skipped)
+
+	states, _, err = selectCodegenResources(snap, nil, []string{"aws:s3/*"})
+	require.NoError(t, err)
+	assert.Equal(t, []resource.URN{providerURN, bucketURN, objectURN}, urnsOf(states))
+
+	states, skipped, err = selectCodegenResources(snap, []resource.URN{queueURN}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.URN{queueURN}, urnsOf(states))
+	assert.Empty(t, skipped)
+
+	_, _, err = selectCodegenResources(snap, []resource.URN{componentURN}, nil)
+	assert.ErrorContains(t, err, "is a component")
+
+	_, _, err = selectCodegenResources(snap, []resource.URN{"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::nope"}, nil)
+	assert.ErrorContains(t, err, "no such resource")
+
+	_, _, err = selectCodegenResources(snap, nil, []string{"aws:s3/["})
+	assert.ErrorContains(t, err, "invalid type pattern")
+}
+
+func TestMakeCodegenNameTable(t *testing.T) {
+	t.Parallel()
+
+	a := resource.NewURN("dev", "proj", "", "aws:s3/bucket:Bucket", "my-bucket")
+	b := resource.NewURN("dev", "proj", "", "aws:sqs/queue:Queue", "my-bucket")
+	c := resource.NewURN("dev", "proj", "", "aws:sqs/queue:Queue", "1st queue")
+
+	names := makeCodegenNameTable([]*resource.State{{URN: a}, {URN: b}, {URN: c}})
+	assert.Equal(t, importer.NameTable{a: "my-bucket", b: "my-bucket2", c: "_1st_queue"}, names)
+}
@@ -77,11 +77,13 @@ func newUpCmd() *cobra.Command {
 	var suppressOutputs bool
 	var suppressProgress bool
 	var suppressPermalink string
+	var frozen bool
 	var yes bool
 	var secretsProvider string
 	var targets []string
 	var replaces []string
 	var targetReplaces []string
+	var allowReplaces []string
 	var targetDependents bool
 	var planFilePath string
 
@@ -158,10 +160,12 @@ func newUpCmd() *cobra.Command {
 			DisableOutputValues:       disableOutputValues(),
 			Targets:                   deploy.NewUrnTargets(targetURNs),
 			TargetDependents:          targetDependents,
+			ReplacementGuard:          getReplacementGuard(proj, allowReplaces),
 			// Trigger a plan to be generated during the preview phase which can be constrained to during the
 			// update phase.
-			GeneratePlan: true,
-			Experimental: hasExperimentalCommands(),
+			GeneratePlan:     true,
+			FrozenPluginLock: frozen,
+			Experimental:     hasExperimentalCommands(),
 		}
 
 		if planFilePath != "" {
@@ -376,10 +380,12 @@ func newUpCmd() *cobra.Command {
 			Parallel:         parallel,
 			Debug:            debug,
 			Refresh:          refreshOption,
+			ReplacementGuard: getReplacementGuard(proj, allowReplaces),
 			// If we're in experimental mode then we trigger a plan to be generated during the preview phase
 			// which will be constrained to during the update phase.
-			GeneratePlan: hasExperimentalCommands(),
-			Experimental: hasExperimentalCommands(),
+			GeneratePlan:     hasExperimentalCommands(),
+			FrozenPluginLock: frozen,
+			Experimental:     hasExperimentalCommands(),
 		}
 
 		// TODO for the URL case:
@@ -556,6 +562,10 @@ func newUpCmd() *cobra.Command {
 		&replaces, "replace", []string{},
 		"Specify a single resource URN to replace. Multiple resources can be specified using --replace urn1 --replace urn2."+
 			" Wildcards (*, **) are also supported")
+	cmd.PersistentFlags().StringArrayVar(
+		&allowReplaces, "allow-replace", []string{},
+		"Allow the replacement or deletion of a resource guarded by the project's replacementGuard."+
+			" Multiple resources can be specified using --allow-replace urn1 --allow-replace urn2. Wildcards supported")
 	cmd.PersistentFlags().StringArrayVar(
 		&targetReplaces, "target-replace", []string{},
 		"Specify a single resource URN to replace. Other resources will not be updated."+
@@ -613,6 +623,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&showFullOutput, "show-full-output", true,
 		"Display full length of stack outputs")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().StringVar(
 		&suppressPermalink, "suppress-permalink", "",
 		"Suppress display of the state permalink")
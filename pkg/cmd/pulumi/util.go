@@ -537,6 +537,11 @@ func readProject() (*workspace.Project, string, error) {
 		return nil, "", err
 	}
 
+	// Download plugins from the project's plugin mirror, if it has one.
+	if proj.Plugins != nil {
+		workspace.SetProjectPluginMirror(proj.Plugins.Mirror, filepath.Dir(path))
+	}
+
 	return proj, filepath.Dir(path), nil
 }
 
@@ -969,6 +974,16 @@ func getRefreshOption(proj *workspace.Project, refresh string) (bool, error) {
 	return false, nil
 }
 
+// getReplacementGuard returns the replacement guard configured by the project, if any, allowing the given
+// URNs (which may contain wildcards) to be replaced or deleted regardless.
+func getReplacementGuard(proj *workspace.Project, allowed []string) *deploy.ReplacementGuard {
+	if proj.ReplacementGuard == nil {
+		return nil
+	}
+	return deploy.NewReplacementGuard(
+		proj.ReplacementGuard.Types, proj.ReplacementGuard.URNs, proj.ReplacementGuard.Tags, allowed)
+}
+
 func writePlan(path string, plan *deploy.Plan, enc config.Encrypter, showSecrets bool) error {
 	f, err := os.Create(path)
 	if err != nil {
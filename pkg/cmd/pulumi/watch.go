@@ -47,6 +47,7 @@ func newWatchCmd() *cobra.Command {
 	var policyPackConfigPaths []string
 	var parallel int
 	var refresh bool
+	var frozen bool
 	var showConfig bool
 	var showReplacementSteps bool
 	var showSames bool
@@ -146,6 +147,7 @@ func newWatchCmd() *cobra.Command {
 				DisableProviderPreview:    disableProviderPreview(),
 				DisableResourceReferences: disableResourceReferences(),
 				DisableOutputValues:       disableOutputValues(),
+				FrozenPluginLock:          frozen,
 				Experimental:              hasExperimentalCommands(),
 			}
 
@@ -212,6 +214,9 @@ func newWatchCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&refresh, "refresh", "r", false,
 		"Refresh the state of the stack's resources before each update")
+	cmd.PersistentFlags().BoolVar(
+		&frozen, "frozen", false,
+		"Fail if the plugins used by the stack deviate from Pulumi.lock, instead of warning")
 	cmd.PersistentFlags().BoolVar(
 		&showConfig, "show-config", false,
 		"Show configuration keys and variables")
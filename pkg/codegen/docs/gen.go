@@ -30,6 +30,7 @@ import (
 	"path"
 	"sort"
 	"strings"
+	texttemplate "text/template"
 
 	"github.com/golang/glog"
 
@@ -180,6 +181,9 @@ type docGenContext struct {
 
 	// Maps a *modContext, *schema.Resource, or *schema.Function to the link that was assigned to it.
 	moduleConflictLinkMap map[interface{}]string
+
+	// markdownTemplates are the templates for the Markdown format, which are parsed on first use.
+	markdownTemplates *texttemplate.Template
 }
 
 // modules is a map of a module name and information
@@ -332,17 +332,44 @@ func (mod *modContext) genFunctionPython(f *schema.Function, resourceName string
 	return params
 }
 
+// genFunctionParams generates the typed formal parameters of a given Function for each language.
+func (mod *modContext) genFunctionParams(f *schema.Function, funcNameMap map[string]string,
+	outputVersion bool,
+) map[string][]formalParam {
+	dctx := mod.docGenContext
+	functionParams := make(map[string][]formalParam)
+
+	for _, lang := range dctx.supportedLanguages {
+		var params []formalParam
+		switch lang {
+		case "nodejs":
+			params = mod.genFunctionTS(f, funcNameMap["nodejs"], outputVersion)
+		case "go":
+			params = mod.genFunctionGo(f, funcNameMap["go"], outputVersion)
+		case "csharp":
+			params = mod.genFunctionCS(f, funcNameMap["csharp"], outputVersion)
+		case "java":
+			params = mod.genFunctionJava(f, funcNameMap["java"], outputVersion)
+		case "yaml":
+			// Left blank
+		case "python":
+			params = mod.genFunctionPython(f, funcNameMap["python"], outputVersion)
+		}
+		if len(params) != 0 {
+			functionParams[lang] = params
+		}
+	}
+	return functionParams
+}
+
 // genFunctionArgs generates the arguments string for a given Function that can be
 // rendered directly into a template.
 func (mod *modContext) genFunctionArgs(f *schema.Function, funcNameMap map[string]string, outputVersion bool) map[string]string {
 	dctx := mod.docGenContext
 	functionParams := make(map[string]string)
 
-	for _, lang := range dctx.supportedLanguages {
-		var (
-			paramTemplate string
-			params        []formalParam
-		)
+	for lang, params := range mod.genFunctionParams(f, funcNameMap, outputVersion) {
+		var paramTemplate string
 		b := &bytes.Buffer{}
 
 		paramSeparatorTemplate := "param_separator"
@@ -350,21 +377,14 @@ func (mod *modContext) genFunctionArgs(f *schema.Function, funcNameMap map[strin
 
 		switch lang {
 		case "nodejs":
-			params = mod.genFunctionTS(f, funcNameMap["nodejs"], outputVersion)
 			paramTemplate = "ts_formal_param"
 		case "go":
-			params = mod.genFunctionGo(f, funcNameMap["go"], outputVersion)
 			paramTemplate = "go_formal_param"
 		case "csharp":
-			params = mod.genFunctionCS(f, funcNameMap["csharp"], outputVersion)
 			paramTemplate = "csharp_formal_param"
 		case "java":
-			params = mod.genFunctionJava(f, funcNameMap["java"], outputVersion)
 			paramTemplate = "java_formal_param"
-		case "yaml":
-			// Left blank
 		case "python":
-			params = mod.genFunctionPython(f, funcNameMap["python"], outputVersion)
 			paramTemplate = "py_formal_param"
 			paramSeparatorTemplate = "py_param_separator"
 
@@ -374,10 +394,6 @@ func (mod *modContext) genFunctionArgs(f *schema.Function, funcNameMap map[strin
 		}
 
 		n := len(params)
-		if n == 0 {
-			continue
-		}
-
 		for i, p := range params {
 			if err := dctx.templates.ExecuteTemplate(b, paramTemplate, p); err != nil {
 				panic(err)
@@ -0,0 +1,100 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/markdown/*.tmpl
+var markdownTemplates embed.FS
+
+var markdownLanguageNames = map[string]string{
+	"nodejs": "TypeScript",
+	"python": "Python",
+	"go":     "Go",
+	"csharp": "C#",
+	"java":   "Java",
+	"yaml":   "YAML",
+}
+
+var markdownFenceLanguages = map[string]string{
+	"nodejs": "typescript",
+	"python": "python",
+	"go":     "go",
+	"csharp": "csharp",
+	"java":   "java",
+	"yaml":   "yaml",
+}
+
+var newlines = regexp.MustCompile(`\s*\n\s*`)
+
+// tableCell formats a string so that it can be written into a cell of a Markdown table.
+func tableCell(s string) string {
+	s = newlines.ReplaceAllString(strings.TrimSpace(s), " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+func parseMarkdownTemplates() (*template.Template, error) {
+	return template.New("").Funcs(template.FuncMap{
+		"languages":     func() []string { return docLanguages },
+		"languageName":  func(lang string) string { return markdownLanguageNames[lang] },
+		"fenceLanguage": func(lang string) string { return markdownFenceLanguages[lang] },
+		"tableCell":     tableCell,
+		"last":          func(sigs []SignatureDoc) SignatureDoc { return sigs[len(sigs)-1] },
+		"hasDescriptions": func(params []ParameterDoc) bool {
+			for _, p := range params {
+				if p.Description != "" {
+					return true
+				}
+			}
+			return false
+		},
+	}).ParseFS(markdownTemplates, "templates/markdown/*.tmpl")
+}
+
+// renderMarkdown renders a ModuleDoc, ResourceDoc or FunctionDoc as Markdown.
+func (dctx *docGenContext) renderMarkdown(doc interface{}) ([]byte, error) {
+	if dctx.markdownTemplates == nil {
+		templates, err := parseMarkdownTemplates()
+		if err != nil {
+			return nil, fmt.Errorf("initializing Markdown templates: %w", err)
+		}
+		dctx.markdownTemplates = templates
+	}
+
+	var name string
+	switch doc.(type) {
+	case ModuleDoc:
+		name = "index"
+	case ResourceDoc:
+		name = "resource"
+	case FunctionDoc:
+		name = "function"
+	default:
+		return nil, fmt.Errorf("cannot render %T as Markdown", doc)
+	}
+
+	var buf bytes.Buffer
+	if err := dctx.markdownTemplates.ExecuteTemplate(&buf, name, doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
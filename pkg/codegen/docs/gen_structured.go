@@ -0,0 +1,524 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// Format is an output format for the docs of a package that is independent of the Pulumi registry.
+type Format string
+
+const (
+	// FormatMarkdown renders a plain Markdown file for each module, resource and function.
+	FormatMarkdown Format = "markdown"
+	// FormatJSON renders a JSON description of each module, resource and function. The files contain the
+	// JSON encoding of ModuleDoc, ResourceDoc and FunctionDoc respectively.
+	FormatJSON Format = "json"
+)
+
+// docLanguages are the languages that the Markdown and JSON docs cover, in the order in which they are listed.
+var docLanguages = []string{"nodejs", "python", "go", "csharp", "java", "yaml"}
+
+// ModuleDoc describes a module of a package.
+type ModuleDoc struct {
+	// Name is the name of the module, or the empty string for the root module of the package.
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+
+	Modules   []IndexEntryDoc `json:"modules,omitempty"`
+	Resources []IndexEntryDoc `json:"resources,omitempty"`
+	Functions []IndexEntryDoc `json:"functions,omitempty"`
+}
+
+// IndexEntryDoc is an entry in the index of a module. Path is relative to the module's directory, without a file
+// extension.
+type IndexEntryDoc struct {
+	Name  string `json:"name"`
+	Token string `json:"token,omitempty"`
+	Path  string `json:"path"`
+}
+
+// ResourceDoc describes a resource. Its per-language fields are keyed by language name ("nodejs", "python", "go",
+// "csharp", "java" or "yaml").
+type ResourceDoc struct {
+	Token              string       `json:"token"`
+	Name               string       `json:"name"`
+	Module             string       `json:"module,omitempty"`
+	Description        string       `json:"description,omitempty"`
+	DeprecationMessage string       `json:"deprecationMessage,omitempty"`
+	Examples           []ExampleDoc `json:"examples,omitempty"`
+	Import             string       `json:"import,omitempty"`
+
+	// Constructors are the overloads of the resource's constructor in each language.
+	Constructors     map[string][]SignatureDoc `json:"constructors"`
+	InputProperties  map[string][]PropertyDoc  `json:"inputProperties,omitempty"`
+	OutputProperties map[string][]PropertyDoc  `json:"outputProperties,omitempty"`
+	// StateInputs are the properties that can be used to look up an existing resource.
+	StateInputs map[string][]PropertyDoc `json:"stateInputs,omitempty"`
+	NestedTypes []NestedTypeDoc          `json:"nestedTypes,omitempty"`
+}
+
+// FunctionDoc describes a function. Its per-language fields are keyed by language name.
+type FunctionDoc struct {
+	Token              string       `json:"token"`
+	Name               string       `json:"name"`
+	Module             string       `json:"module,omitempty"`
+	Description        string       `json:"description,omitempty"`
+	DeprecationMessage string       `json:"deprecationMessage,omitempty"`
+	Examples           []ExampleDoc `json:"examples,omitempty"`
+
+	// Signatures are the overloads of the function in each language, including the variant that accepts and returns
+	// outputs if the language has one.
+	Signatures       map[string][]SignatureDoc `json:"signatures"`
+	InputProperties  map[string][]PropertyDoc  `json:"inputProperties,omitempty"`
+	OutputProperties map[string][]PropertyDoc  `json:"outputProperties,omitempty"`
+	NestedTypes      []NestedTypeDoc           `json:"nestedTypes,omitempty"`
+}
+
+// ExampleDoc is a titled example with a code snippet per language.
+type ExampleDoc struct {
+	Title    string            `json:"title,omitempty"`
+	Snippets map[string]string `json:"snippets"`
+}
+
+// SignatureDoc is a signature of a constructor or function.
+type SignatureDoc struct {
+	// Signature is the rendered declaration of the constructor or function.
+	Signature  string         `json:"signature"`
+	Parameters []ParameterDoc `json:"parameters,omitempty"`
+}
+
+// ParameterDoc is a formal parameter of a constructor or function.
+type ParameterDoc struct {
+	Name         string `json:"name"`
+	Type         string `json:"type,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// PropertyDoc is a property of a resource, function or object type.
+type PropertyDoc struct {
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	Types              []string `json:"types"`
+	Required           bool     `json:"required,omitempty"`
+	ReplaceOnChanges   bool     `json:"replaceOnChanges,omitempty"`
+	DeprecationMessage string   `json:"deprecationMessage,omitempty"`
+}
+
+// NestedTypeDoc is an object or enum type that is used by a resource or function.
+type NestedTypeDoc struct {
+	Name       string                    `json:"name"`
+	Properties map[string][]PropertyDoc  `json:"properties,omitempty"`
+	EnumValues map[string][]EnumValueDoc `json:"enumValues,omitempty"`
+}
+
+// EnumValueDoc is a value of an enum type.
+type EnumValueDoc struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Description        string `json:"description,omitempty"`
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+}
+
+// plainText removes the HTML escaping and word-breaks that the registry templates need from a string.
+func plainText(s string) string {
+	return html.UnescapeString(strings.ReplaceAll(s, "<wbr>", ""))
+}
+
+func (t propertyType) plainName() string {
+	if t.DisplayName != "" {
+		return plainText(t.DisplayName)
+	}
+	return plainText(t.Name)
+}
+
+// renderSignature renders a constructor or function declaration for a language. The declaration is formatted as
+// prefix(params)suffix, with each parameter formatted the same way as the registry's formal_param templates.
+func renderSignature(lang, prefix string, params []formalParam, suffix string) SignatureDoc {
+	separator := ", "
+	if lang == "python" {
+		separator = ",\n" + strings.Repeat(" ", len(prefix)+1)
+	}
+
+	rendered := make([]string, len(params))
+	docs := make([]ParameterDoc, len(params))
+	for i, p := range params {
+		typ := p.Type.plainName()
+		switch lang {
+		case "nodejs":
+			rendered[i] = fmt.Sprintf("%s%s: %s", p.Name, p.OptionalFlag, typ)
+		case "go":
+			rendered[i] = fmt.Sprintf("%s %s%s", p.Name, p.OptionalFlag, typ)
+		case "csharp":
+			rendered[i] = fmt.Sprintf("%s%s %s%s", typ, p.OptionalFlag, p.Name, p.DefaultValue)
+		case "java":
+			rendered[i] = fmt.Sprintf("%s %s%s", typ, p.Name, p.DefaultValue)
+		case "python":
+			rendered[i] = p.Name
+			if typ != "" {
+				rendered[i] += ": " + typ
+			}
+			rendered[i] += p.DefaultValue
+		}
+
+		docs[i] = ParameterDoc{
+			Name:         p.Name,
+			Type:         typ,
+			DefaultValue: strings.TrimPrefix(p.DefaultValue, " = "),
+			Description:  p.Comment,
+		}
+	}
+
+	return SignatureDoc{
+		Signature:  prefix + "(" + strings.Join(rendered, separator) + ")" + suffix,
+		Parameters: docs,
+	}
+}
+
+// renderYAMLSignature renders the declaration of a resource or function invocation in a YAML program.
+func renderYAMLSignature(declaration string, params []formalParam) SignatureDoc {
+	lines := []string{declaration}
+	docs := make([]ParameterDoc, len(params))
+	for i, p := range params {
+		lines = append(lines, fmt.Sprintf("%s: # %s", p.Name, p.Comment))
+		docs[i] = ParameterDoc{Name: p.Name, Description: p.Comment}
+	}
+	return SignatureDoc{
+		Signature:  strings.Join(lines, "\n"),
+		Parameters: docs,
+	}
+}
+
+func constructorSignatures(args resourceDocArgs) map[string][]SignatureDoc {
+	res := func(lang string) string {
+		return args.ConstructorResource[lang].plainName()
+	}
+	params := args.ConstructorParamsTyped
+
+	return map[string][]SignatureDoc{
+		"nodejs": {renderSignature("nodejs", "new "+res("nodejs"), params["nodejs"], ";")},
+		"python": {
+			renderSignature("python", "def "+res("python"), params["python"], ""),
+			renderSignature("python", "def "+res("python"), params["pythonargs"], ""),
+		},
+		"go":     {renderSignature("go", "func New"+res("go"), params["go"], fmt.Sprintf(" (*%s, error)", res("go")))},
+		"csharp": {renderSignature("csharp", "public "+res("csharp"), params["csharp"], "")},
+		"java": {
+			renderSignature("java", "public "+res("java"), params["javaargs"], ""),
+			renderSignature("java", "public "+res("java"), params["java"], ""),
+		},
+		"yaml": {renderYAMLSignature("type: "+res("yaml"), params["yaml"])},
+	}
+}
+
+func (mod *modContext) functionSignatures(f *schema.Function, args functionDocArgs) map[string][]SignatureDoc {
+	params := mod.genFunctionParams(f, args.FunctionName, false /*outputVersion*/)
+	var outputParams map[string][]formalParam
+	if f.NeedsOutputVersion() {
+		outputParams = mod.genFunctionParams(f, args.FunctionName, true /*outputVersion*/)
+	}
+
+	signatures := map[string][]SignatureDoc{}
+	for _, lang := range docLanguages {
+		name, result := args.FunctionName[lang], args.FunctionResult[lang].plainName()
+		hasOutputVersion := args.HasOutputVersion[lang] && outputParams != nil
+
+		var sigs []SignatureDoc
+		switch lang {
+		case "nodejs":
+			sigs = append(sigs, renderSignature(lang, "function "+name, params[lang], fmt.Sprintf(": Promise<%s>", result)))
+			if hasOutputVersion {
+				sigs = append(sigs, renderSignature(lang, "function "+name+"Output", outputParams[lang],
+					fmt.Sprintf(": Output<%s>", result)))
+			}
+		case "python":
+			sigs = append(sigs, renderSignature(lang, "def "+name, params[lang], " -> "+result))
+			if hasOutputVersion {
+				sigs = append(sigs, renderSignature(lang, "def "+name+"_output", outputParams[lang],
+					fmt.Sprintf(" -> Output[%s]", result)))
+			}
+		case "go":
+			sigs = append(sigs, renderSignature(lang, "func "+name, params[lang], fmt.Sprintf(" (*%s, error)", result)))
+			if hasOutputVersion {
+				outputResult := args.FunctionResultOutputVersion[lang].plainName()
+				sigs = append(sigs, renderSignature(lang, "func "+name+"Output", outputParams[lang], " "+outputResult))
+			}
+		case "csharp":
+			sigs = append(sigs, renderSignature(lang, fmt.Sprintf("public static Task<%s> %s.InvokeAsync", result, name),
+				params[lang], ""))
+			if hasOutputVersion {
+				sigs = append(sigs, renderSignature(lang, fmt.Sprintf("public static Output<%s> %s.Invoke", result, name),
+					outputParams[lang], ""))
+			}
+		case "java":
+			sigs = append(sigs, renderSignature(lang,
+				fmt.Sprintf("public static CompletableFuture<%s> %s", result, name), params[lang], ""))
+		case "yaml":
+			sigs = append(sigs, SignatureDoc{
+				Signature: fmt.Sprintf("fn::invoke:\n  function: %s\n  arguments:\n    # arguments dictionary", name),
+			})
+		}
+		signatures[lang] = sigs
+	}
+	return signatures
+}
+
+// snippetCode returns the code of a fenced code block.
+func snippetCode(snippet string) string {
+	snippet = strings.TrimSpace(snippet)
+	if !strings.HasPrefix(snippet, "```") {
+		return snippet
+	}
+	if i := strings.IndexByte(snippet, '\n'); i != -1 {
+		snippet = snippet[i+1:]
+	} else {
+		snippet = ""
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(snippet, "```"), "\n")
+}
+
+func exampleDocs(sections []exampleSection) []ExampleDoc {
+	var examples []ExampleDoc
+	for _, section := range sections {
+		snippets := map[string]string{}
+		for lang, snippet := range section.Snippets {
+			if snippet == defaultMissingExampleSnippetPlaceholder {
+				continue
+			}
+			// Snippets are keyed by the language of their code fence.
+			if lang == "typescript" {
+				lang = "nodejs"
+			}
+			snippets[lang] = snippetCode(snippet)
+		}
+		if len(snippets) != 0 {
+			// Titles that come from headings keep their Markdown heading marker.
+			title := strings.TrimSpace(strings.TrimLeft(section.Title, "#"))
+			examples = append(examples, ExampleDoc{Title: title, Snippets: snippets})
+		}
+	}
+	return examples
+}
+
+func propertyDocs(props map[string][]property) map[string][]PropertyDoc {
+	if len(props) == 0 {
+		return nil
+	}
+	docs := make(map[string][]PropertyDoc, len(props))
+	for lang, langProps := range props {
+		langDocs := make([]PropertyDoc, len(langProps))
+		for i, p := range langProps {
+			types := make([]string, len(p.Types))
+			for j, t := range p.Types {
+				types[j] = t.plainName()
+			}
+			langDocs[i] = PropertyDoc{
+				Name:               p.Name,
+				Description:        strings.TrimSpace(p.Comment),
+				Types:              types,
+				Required:           p.IsRequired,
+				ReplaceOnChanges:   p.IsReplaceOnChanges,
+				DeprecationMessage: p.DeprecationMessage,
+			}
+		}
+		docs[lang] = langDocs
+	}
+	return docs
+}
+
+func nestedTypeDocs(types []docNestedType) []NestedTypeDoc {
+	docs := make([]NestedTypeDoc, len(types))
+	for i, t := range types {
+		var enumValues map[string][]EnumValueDoc
+		if len(t.EnumValues) != 0 {
+			enumValues = make(map[string][]EnumValueDoc, len(t.EnumValues))
+			for lang, values := range t.EnumValues {
+				langValues := make([]EnumValueDoc, len(values))
+				for j, v := range values {
+					langValues[j] = EnumValueDoc{
+						Name:               v.Name,
+						Value:              v.Value,
+						Description:        strings.TrimSpace(v.Comment),
+						DeprecationMessage: v.DeprecationMessage,
+					}
+				}
+				enumValues[lang] = langValues
+			}
+		}
+		docs[i] = NestedTypeDoc{
+			Name:       plainText(t.Name),
+			Properties: propertyDocs(t.Properties),
+			EnumValues: enumValues,
+		}
+	}
+	return docs
+}
+
+func (mod *modContext) resourceDoc(r *schema.Resource) ResourceDoc {
+	args := mod.genResource(r)
+	return ResourceDoc{
+		Token:              r.Token,
+		Name:               resourceName(r),
+		Module:             mod.mod,
+		Description:        strings.TrimSpace(args.Comment),
+		DeprecationMessage: args.DeprecationMessage,
+		Examples:           exampleDocs(args.ExamplesSection),
+		Import:             strings.TrimSpace(args.ImportDocs),
+		Constructors:       constructorSignatures(args),
+		InputProperties:    propertyDocs(args.InputProperties),
+		OutputProperties:   propertyDocs(args.OutputProperties),
+		StateInputs:        propertyDocs(args.StateInputs),
+		NestedTypes:        nestedTypeDocs(args.NestedTypes),
+	}
+}
+
+func (mod *modContext) functionDoc(f *schema.Function) FunctionDoc {
+	args := mod.genFunction(f)
+	return FunctionDoc{
+		Token:              f.Token,
+		Name:               tokenToName(f.Token),
+		Module:             mod.mod,
+		Description:        strings.TrimSpace(args.Comment),
+		DeprecationMessage: args.DeprecationMessage,
+		Examples:           exampleDocs(args.ExamplesSection),
+		Signatures:         mod.functionSignatures(f, args),
+		InputProperties:    propertyDocs(args.InputProperties),
+		OutputProperties:   propertyDocs(args.OutputProperties),
+		NestedTypes:        nestedTypeDocs(args.NestedTypes),
+	}
+}
+
+// genFormat generates the docs for the module in the given format. The docs for the module's resources and functions
+// are written next to the module's index.
+func (mod *modContext) genFormat(fs codegen.Fs, format Format) error {
+	modName := mod.getModuleFileName()
+	conflictResolver := mod.docGenContext.newModuleConflictResolver()
+
+	def, err := mod.pkg.Definition()
+	contract.AssertNoErrorf(err, "failed to get definition for package %q", mod.pkg.Name())
+
+	index := ModuleDoc{Name: mod.mod, Title: modName}
+	if mod.mod == "" {
+		index.Title = def.DisplayName
+		if index.Title == "" {
+			index.Title = getPackageDisplayName(mod.pkg.Name())
+		}
+		index.Description = mod.pkg.Description()
+	}
+
+	addFile := func(name string, doc interface{}) error {
+		var contents []byte
+		var err error
+		switch format {
+		case FormatMarkdown:
+			name += ".md"
+			contents, err = mod.docGenContext.renderMarkdown(doc)
+		case FormatJSON:
+			name += ".json"
+			contents, err = json.MarshalIndent(doc, "", "    ")
+			contents = append(contents, '\n')
+		default:
+			err = fmt.Errorf("unknown docs format %q", format)
+		}
+		if err != nil {
+			return err
+		}
+		fs.Add(path.Join(modName, name), contents)
+		return nil
+	}
+
+	for _, child := range mod.children {
+		childName := child.getModuleFileName()
+		index.Modules = append(index.Modules, IndexEntryDoc{
+			Name: modFilenameToDisplayName(childName),
+			Path: path.Join(strings.TrimPrefix(childName, modName+"/"), "index"),
+		})
+	}
+
+	for _, r := range mod.resources {
+		name := resourceName(r)
+		link := conflictResolver.getSafeName(getResourceLink(name), r)
+		if link == "" {
+			continue // unresolved conflict
+		}
+		if err := addFile(link, mod.resourceDoc(r)); err != nil {
+			return err
+		}
+		index.Resources = append(index.Resources, IndexEntryDoc{Name: name, Token: r.Token, Path: link})
+	}
+
+	for _, f := range mod.functions {
+		name := tokenToName(f.Token)
+		link := conflictResolver.getSafeName(getFunctionLink(name), f)
+		if link == "" {
+			continue // unresolved conflict
+		}
+		if err := addFile(link, mod.functionDoc(f)); err != nil {
+			return err
+		}
+		index.Functions = append(index.Functions, IndexEntryDoc{Name: name, Token: f.Token, Path: link})
+	}
+
+	for _, entries := range [][]IndexEntryDoc{index.Modules, index.Resources, index.Functions} {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	}
+
+	return addFile("index", index)
+}
+
+func (dctx *docGenContext) generatePackageFormat(format Format) (map[string][]byte, error) {
+	if dctx.modules() == nil {
+		return nil, errors.New("must call initialize before generating the docs package")
+	}
+
+	defer glog.Flush()
+
+	files := codegen.Fs{}
+	modules := make([]string, 0, len(dctx.modules()))
+	for k := range dctx.modules() {
+		modules = append(modules, k)
+	}
+	sort.Strings(modules)
+	for _, mod := range modules {
+		if err := dctx.modules()[mod].genFormat(files, format); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// GeneratePackageFormat generates docs for the package in the given format. Unlike GeneratePackage, the docs do
+// not depend on the Pulumi registry, and GeneratePackageFormat does not require a prior call to Initialize. The
+// returned map contains the filename with path as the key and the contents as its value.
+func GeneratePackageFormat(tool string, pkg *schema.Package, format Format) (map[string][]byte, error) {
+	dctx := newDocGenContext()
+	dctx.initialize(tool, pkg)
+	return dctx.generatePackageFormat(format)
+}
@@ -0,0 +1,152 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+func TestGeneratePackageFormatJSON(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := schema.ImportSpec(newTestPackageSpec(), nil)
+	require.NoError(t, err)
+
+	files, err := GeneratePackageFormat(unitTestTool, pkg, FormatJSON)
+	require.NoError(t, err)
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{
+		"getpackageresource.json",
+		"index.json",
+		"module/getmoduleresource.json",
+		"module/index.json",
+		"module/resource.json",
+		"module2/index.json",
+		"module2/resource2.json",
+		"packagelevelresource.json",
+		"provider.json",
+	}, names)
+
+	var index ModuleDoc
+	require.NoError(t, json.Unmarshal(files["index.json"], &index))
+	assert.Equal(t, "A fake provider package used for testing.", index.Description)
+	assert.Equal(t, []IndexEntryDoc{
+		{Name: "module", Path: "module/index"},
+		{Name: "module2", Path: "module2/index"},
+	}, index.Modules)
+	assert.Equal(t, []IndexEntryDoc{
+		{
+			Name:  "PackageLevelResource",
+			Token: "prov:/packageLevelResource:PackageLevelResource",
+			Path:  "packagelevelresource",
+		},
+		{Name: "Provider", Token: "pulumi:providers:prov", Path: "provider"},
+	}, index.Resources)
+
+	var resource ResourceDoc
+	require.NoError(t, json.Unmarshal(files["module/resource.json"], &resource))
+	assert.Equal(t, "prov:module/resource:Resource", resource.Token)
+	assert.Equal(t, "This is a module-level resource called Resource.", resource.Description)
+	assert.Contains(t, resource.Import, "pulumi import prov:module/resource:Resource test test")
+
+	require.Len(t, resource.Examples, 2)
+	assert.Equal(t, "Basic Example", resource.Examples[0].Title)
+	assert.Equal(t, map[string]string{
+		"nodejs": "\t\t\t\t\t// Some TypeScript code.",
+		"python": "\t\t\t\t\t# Some Python code.",
+	}, resource.Examples[0].Snippets)
+
+	for _, lang := range docLanguages {
+		assert.NotEmpty(t, resource.Constructors[lang], lang)
+	}
+	assert.Equal(t, "new Resource(name: string, args?: ResourceArgs, opts?: CustomResourceOptions);",
+		resource.Constructors["nodejs"][0].Signature)
+	assert.Equal(t,
+		"func NewResource(ctx *Context, name string, args *ResourceArgs, opts ...ResourceOption) (*Resource, error)",
+		resource.Constructors["go"][0].Signature)
+	assert.Equal(t, ParameterDoc{Name: "name", Type: "string", Description: ctorNameArgComment},
+		resource.Constructors["nodejs"][0].Parameters[0])
+
+	assert.Contains(t, resource.InputProperties["python"], PropertyDoc{
+		Name:        "string_prop",
+		Description: "This is stringProp's description.",
+		Types:       []string{"str"},
+	})
+
+	var function FunctionDoc
+	require.NoError(t, json.Unmarshal(files["module/getmoduleresource.json"], &function))
+	assert.Equal(t, "A module-level function.", function.Description)
+	assert.Equal(t,
+		"function getModuleResource(args: GetModuleResourceArgs, opts?: InvokeOptions): Promise<GetModuleResourceResult>",
+		function.Signatures["nodejs"][0].Signature)
+	assert.Len(t, function.OutputProperties["go"], 2)
+}
+
+func TestGeneratePackageFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := schema.ImportSpec(newTestPackageSpec(), nil)
+	require.NoError(t, err)
+
+	files, err := GeneratePackageFormat(unitTestTool, pkg, FormatMarkdown)
+	require.NoError(t, err)
+
+	assert.Equal(t, `# prov
+
+A fake provider package used for testing.
+
+## Modules
+
+- [module](module/index.md)
+- [module2](module2/index.md)
+
+## Resources
+
+- [PackageLevelResource](packagelevelresource.md)
+- [Provider](provider.md)
+
+## Functions
+
+- [getPackageResource](getpackageresource.md)
+`, string(files["index.md"]))
+
+	resource := string(files["module/resource.md"])
+	assert.Contains(t, resource, "# Resource\n\n`prov:module/resource:Resource`\n\nThis is a module-level resource")
+	assert.Contains(t, resource, "### Basic Example\n\n#### TypeScript\n\n"+
+		codeFence+"typescript\n\t\t\t\t\t// Some TypeScript code.\n"+codeFence+"\n")
+	assert.Contains(t, resource, codeFence+"typescript\n"+
+		"new Resource(name: string, args?: ResourceArgs, opts?: CustomResourceOptions);\n"+codeFence+"\n")
+	assert.Contains(t, resource, "| `string_prop` | `str` | No | This is stringProp's description. |\n")
+	assert.Contains(t, resource, "## Import\n\nThe import docs would be here")
+	assert.NotContains(t, resource, "<wbr>")
+}
+
+func TestTableCell(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `A \| B C`, tableCell("A | B\n\n  C\n"))
+}
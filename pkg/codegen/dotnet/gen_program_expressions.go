@@ -337,6 +337,25 @@ var functionNamespaces = map[string][]string{
 	"fromBase64":       {"System"},
 	"sha1":             {"System.Security.Cryptography", "System.Text"},
 	"singleOrNone":     {"System.Linq"},
+	"sha256":           {"System", "System.Security.Cryptography", "System.Text"},
+	"sha512":           {"System", "System.Security.Cryptography", "System.Text"},
+	"md5":              {"System", "System.Security.Cryptography", "System.Text"},
+	"regexMatch":       {"System.Text.RegularExpressions"},
+	"regexReplace":     {"System.Text.RegularExpressions"},
+	"format":           {"System", "System.Globalization", "System.Text.RegularExpressions"},
+	"cidrHost":         {"System.Net"},
+	"cidrSubnet":       {"System.Net"},
+	"cidrNetmask":      {"System.Net"},
+	"merge":            {"System.Collections.Generic"},
+	"flatten":          {"System.Linq"},
+	"keys":             {"System", "System.Linq"},
+	"values":           {"System", "System.Linq"},
+	"min":              {"System.Linq"},
+	"max":              {"System.Linq"},
+	"timestamp":        {"System", "System.Globalization"},
+	"timeAdd": {
+		"System", "System.Collections.Generic", "System.Globalization", "System.Linq", "System.Text.RegularExpressions",
+	},
 }
 
 func (g *generator) genFunctionUsings(x *model.FunctionCallExpression) []string {
@@ -637,6 +656,72 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 	case "sha1":
 		// Assuming the existence of the following helper method located earlier in the preamble
 		g.Fgenf(w, "ComputeSHA1(%v)", expr.Args[0])
+	case "sha256":
+		// Assuming the existence of the following helper method located earlier in the preamble
+		g.Fgenf(w, "ComputeSHA256(%v)", expr.Args[0])
+	case "sha512":
+		// Assuming the existence of the following helper method located earlier in the preamble
+		g.Fgenf(w, "ComputeSHA512(%v)", expr.Args[0])
+	case "md5":
+		// Assuming the existence of the following helper method located earlier in the preamble
+		g.Fgenf(w, "ComputeMD5(%v)", expr.Args[0])
+	case "upper":
+		g.Fgenf(w, "%.20v.ToUpperInvariant()", expr.Args[0])
+	case "lower":
+		g.Fgenf(w, "%.20v.ToLowerInvariant()", expr.Args[0])
+	case "trimSpace":
+		g.Fgenf(w, "%.20v.Trim()", expr.Args[0])
+	case "trimPrefix":
+		g.Fgenf(w, "TrimPrefix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "trimSuffix":
+		g.Fgenf(w, "TrimSuffix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "startsWith":
+		g.Fgenf(w, "%.20v.StartsWith(%v)", expr.Args[0], expr.Args[1])
+	case "endsWith":
+		g.Fgenf(w, "%.20v.EndsWith(%v)", expr.Args[0], expr.Args[1])
+	case "replace":
+		g.Fgenf(w, "%.20v.Replace(%v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "regexMatch":
+		g.Fgenf(w, "Regex.IsMatch(%v, %v)", expr.Args[1], expr.Args[0])
+	case "regexReplace":
+		g.Fgenf(w, "Regex.Replace(%v, %v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "format":
+		g.genCall(w, "FormatString", expr.Args)
+	case "cidrHost":
+		g.Fgenf(w, "CidrHost(%v, %v)", expr.Args[0], expr.Args[1])
+	case "cidrSubnet":
+		g.Fgenf(w, "CidrSubnet(%v, %v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "cidrNetmask":
+		g.Fgenf(w, "CidrNetmask(%v)", expr.Args[0])
+	case "merge":
+		g.genCall(w, "Merge", expr.Args)
+	case "flatten":
+		// The argument is known to be a list of lists, so its elements can be typed as arrays of the same type.
+		g.Fgenf(w, "%.v.SelectMany(items => items).ToList()", expr.Args[0])
+	case "keys":
+		g.genDictionaryOrTuple(w, expr.Args[0])
+		g.Fgen(w, ".Keys.OrderBy(key => key, StringComparer.Ordinal).ToList()")
+	case "values":
+		g.genDictionaryOrTuple(w, expr.Args[0])
+		g.Fgen(w, ".OrderBy(entry => entry.Key, StringComparer.Ordinal).Select(entry => entry.Value).ToList()")
+	case "min", "max":
+		method := "Min"
+		if expr.Name == "max" {
+			method = "Max"
+		}
+		g.Fgen(w, "new[] { ")
+		for i, arg := range expr.Args {
+			if i > 0 {
+				g.Fgen(w, ", ")
+			}
+			g.Fgenf(w, "%v", arg)
+		}
+		g.Fgenf(w, " }.%s()", method)
+	case "timestamp":
+		g.Fgen(w, `DateTime.UtcNow.ToString("yyyy-MM-dd'T'HH:mm:ss'Z'", CultureInfo.InvariantCulture)`)
+	case "timeAdd":
+		// Assuming the existence of the following helper method located earlier in the preamble
+		g.Fgenf(w, "TimeAdd(%v, %v)", expr.Args[0], expr.Args[1])
 	case "stack":
 		g.Fgen(w, "Deployment.Instance.StackName")
 	case "project":
@@ -648,6 +733,19 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 	}
 }
 
+// genCall generates a call to the named method with the given arguments, generating object and tuple arguments as
+// dictionaries and arrays.
+func (g *generator) genCall(w io.Writer, name string, args []model.Expression) {
+	g.Fgenf(w, "%s(", name)
+	for i, arg := range args {
+		if i > 0 {
+			g.Fgen(w, ", ")
+		}
+		g.genDictionaryOrTuple(w, arg)
+	}
+	g.Fgen(w, ")")
+}
+
 func (g *generator) genDictionaryOrTuple(w io.Writer, expr model.Expression) {
 	switch expr := expr.(type) {
 	case *model.ObjectConsExpression:
@@ -145,6 +145,110 @@ func getHelperMethodIfNeeded(functionName string, indent string) (string, bool)
 %s    var hash = SHA1.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
 %s    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
 %s}`, indent, indent, indent, indent, indent), true
+	case "sha256":
+		return fmt.Sprintf(`
+%[1]sstring ComputeSHA256(string input)
+%[1]s{
+%[1]s    var hash = SHA256.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+%[1]s    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+%[1]s}`, indent), true
+	case "sha512":
+		return fmt.Sprintf(`
+%[1]sstring ComputeSHA512(string input)
+%[1]s{
+%[1]s    var hash = SHA512.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+%[1]s    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+%[1]s}`, indent), true
+	case "md5":
+		return fmt.Sprintf(`
+%[1]sstring ComputeMD5(string input)
+%[1]s{
+%[1]s    var hash = MD5.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+%[1]s    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+%[1]s}`, indent), true
+	case "trimPrefix":
+		return fmt.Sprintf(`
+%[1]sstring TrimPrefix(string value, string prefix)
+%[1]s{
+%[1]s    return value.StartsWith(prefix) ? value.Substring(prefix.Length) : value;
+%[1]s}`, indent), true
+	case "trimSuffix":
+		return fmt.Sprintf(`
+%[1]sstring TrimSuffix(string value, string suffix)
+%[1]s{
+%[1]s    return value.EndsWith(suffix) ? value.Substring(0, value.Length - suffix.Length) : value;
+%[1]s}`, indent), true
+	case "format":
+		return fmt.Sprintf(`
+%[1]sstring FormatString(string spec, params object[] args)
+%[1]s{
+%[1]s    var i = 0;
+%[1]s    return Regex.Replace(spec, "%%[%%sdv]", verb =>
+%[1]s        verb.Value == "%%%%" ? "%%" : Convert.ToString(args[i++], CultureInfo.InvariantCulture));
+%[1]s}`, indent), true
+	case "cidrHost":
+		return fmt.Sprintf(`
+%[1]sstring CidrHost(string prefix, double hostnum)
+%[1]s{
+%[1]s    var parts = prefix.Split('/');
+%[1]s    var bytes = IPAddress.Parse(parts[0]).GetAddressBytes();
+%[1]s    var ip = (ulong)bytes[0] << 24 | (ulong)bytes[1] << 16 | (ulong)bytes[2] << 8 | bytes[3];
+%[1]s    var host = ip - ip %% (1UL << (32 - int.Parse(parts[1]))) + (ulong)hostnum;
+%[1]s    var address = new IPAddress(new[] { (byte)(host >> 24), (byte)(host >> 16), (byte)(host >> 8), (byte)host });
+%[1]s    return address.ToString();
+%[1]s}`, indent), true
+	case "cidrSubnet":
+		return fmt.Sprintf(`
+%[1]sstring CidrSubnet(string prefix, double newbits, double netnum)
+%[1]s{
+%[1]s    var parts = prefix.Split('/');
+%[1]s    var bytes = IPAddress.Parse(parts[0]).GetAddressBytes();
+%[1]s    var ip = (ulong)bytes[0] << 24 | (ulong)bytes[1] << 16 | (ulong)bytes[2] << 8 | bytes[3];
+%[1]s    var bits = int.Parse(parts[1]);
+%[1]s    var length = bits + (int)newbits;
+%[1]s    var subnet = ip - ip %% (1UL << (32 - bits)) + ((ulong)netnum << (32 - length));
+%[1]s    var address = new IPAddress(new[]
+%[1]s    {
+%[1]s        (byte)(subnet >> 24), (byte)(subnet >> 16), (byte)(subnet >> 8), (byte)subnet,
+%[1]s    });
+%[1]s    return $"{address}/{length}";
+%[1]s}`, indent), true
+	case "cidrNetmask":
+		return fmt.Sprintf(`
+%[1]sstring CidrNetmask(string prefix)
+%[1]s{
+%[1]s    var mask = (1UL << 32) - (1UL << (32 - int.Parse(prefix.Split('/')[1])));
+%[1]s    var address = new IPAddress(new[] { (byte)(mask >> 24), (byte)(mask >> 16), (byte)(mask >> 8), (byte)mask });
+%[1]s    return address.ToString();
+%[1]s}`, indent), true
+	case "merge":
+		return fmt.Sprintf(`
+%[1]sDictionary<string, T> Merge<T>(params IDictionary<string, T>[] maps)
+%[1]s{
+%[1]s    var merged = new Dictionary<string, T>();
+%[1]s    foreach (var map in maps)
+%[1]s    {
+%[1]s        foreach (var entry in map)
+%[1]s        {
+%[1]s            merged[entry.Key] = entry.Value;
+%[1]s        }
+%[1]s    }
+%[1]s    return merged;
+%[1]s}`, indent), true
+	case "timeAdd":
+		return fmt.Sprintf(`
+%[1]sstring TimeAdd(string timestamp, string duration)
+%[1]s{
+%[1]s    var units = new Dictionary<string, double> { ["h"] = 3600, ["m"] = 60, ["s"] = 1 };
+%[1]s    var seconds = Regex.Matches(duration, @"([\d.]+)([hms])")
+%[1]s        .Sum(m => double.Parse(m.Groups[1].Value, CultureInfo.InvariantCulture) * units[m.Groups[2].Value]);
+%[1]s    if (duration.StartsWith("-"))
+%[1]s    {
+%[1]s        seconds = -seconds;
+%[1]s    }
+%[1]s    var time = DateTimeOffset.Parse(timestamp, CultureInfo.InvariantCulture).AddSeconds(seconds);
+%[1]s    return time.UtcDateTime.ToString("yyyy-MM-dd'T'HH:mm:ss'Z'", CultureInfo.InvariantCulture);
+%[1]s}`, indent), true
 	case "notImplemented":
 		return fmt.Sprintf(`
 %sobject NotImplemented(string errorMessage) 
@@ -320,6 +320,12 @@ func (pkg *pkgContext) tokenToResource(tok string) string {
 	mod, name := pkg.tokenToPackage(tok), components[2]
 
 	name = Title(name)
+	if modPkg, ok := pkg.packages[mod]; ok {
+		// Refer to the resource by the name that it is declared with, see disambiguatedResourceName.
+		if newName, renamed := modPkg.renamed[name]; renamed {
+			name = newName
+		}
+	}
 
 	if mod == pkg.mod {
 		return name
@@ -986,6 +992,18 @@ func (pkg *pkgContext) genericElementType(schemaType schema.Type) (string, bool)
 	}
 }
 
+// isGenericCollectionType returns true if the generic variant represents values of the given type as plain Go slices
+// or maps. These have no output type of their own, so collections of them use pulumix.ArrayOutput and
+// pulumix.MapOutput rather than the variants that are parameterized by the output type of their elements.
+func isGenericCollectionType(t schema.Type) bool {
+	switch codegen.UnwrapType(t).(type) {
+	case *schema.ArrayType, *schema.MapType:
+		return true
+	default:
+		return false
+	}
+}
+
 // genericOutputTypeImpl is similar to outputTypeImpl, but it generates the generic variant.
 // for example instead of pulumi.StringOutput, it generates pulumix.Output[string]
 func (pkg *pkgContext) genericOutputTypeImpl(t schema.Type) string {
@@ -1021,7 +1039,7 @@ func (pkg *pkgContext) genericOutputTypeImpl(t schema.Type) string {
 		return fmt.Sprintf("pulumix.Output[%s]", elementType)
 	case *schema.ArrayType:
 		elementType, isPrimitive := pkg.genericElementType(t.ElementType)
-		if isPrimitive {
+		if isPrimitive || isGenericCollectionType(t.ElementType) {
 			return fmt.Sprintf("pulumix.ArrayOutput[%s]", elementType)
 		}
 
@@ -1030,7 +1048,7 @@ func (pkg *pkgContext) genericOutputTypeImpl(t schema.Type) string {
 		return fmt.Sprintf("pulumix.GArrayOutput[%s, %sOutput]", elementType, elementType)
 	case *schema.MapType:
 		elementType, isPrimitive := pkg.genericElementType(t.ElementType)
-		if isPrimitive {
+		if isPrimitive || isGenericCollectionType(t.ElementType) {
 			return fmt.Sprintf("pulumix.MapOutput[%s]", elementType)
 		}
 
@@ -1753,7 +1771,8 @@ func (pkg *pkgContext) genObjectDefaultFunc(w io.Writer, name string,
 			} else {
 				member = fmt.Sprintf("tmp.%[1]s.%[2]s()", pkg.fieldName(nil, p), funcName)
 				sigil := ""
-				if p.IsRequired() {
+				// The generic variant declares object-typed fields as pointers even when they are required.
+				if p.IsRequired() && !(useGenericTypes && strings.HasPrefix(pkg.plainGenericInputType(p.Type), "*")) {
 					sigil = "*"
 				}
 				pkg.assignProperty(w, p, "tmp", sigil+member, false, useGenericTypes)
@@ -2517,7 +2536,7 @@ func (pkg *pkgContext) genResource(
 			// Check the error before proceeding.
 			fmt.Fprintf(w, "\tif err != nil {\n")
 			if useGenericVariant {
-				fmt.Fprint(w, "\t\treturn nil, err\n")
+				fmt.Fprintf(w, "\t\treturn %s{}, err\n", pkg.genericOutputType(objectReturnType.Properties[0].Type))
 			} else {
 				fmt.Fprintf(w, "\t\treturn %s{}, err\n", pkg.outputType(objectReturnType.Properties[0].Type))
 			}
@@ -2613,8 +2632,16 @@ func (pkg *pkgContext) genResource(
 					fmt.Fprintf(w, "\treturn o.ApplyT(func (v %s%sResult) %s { return v.%s }).(%s)\n", outputStructName, methodName,
 						pkg.typeString(codegen.ResolvedType(p.Type)), Title(p.Name), outputTypeName)
 				} else {
-					fmt.Fprintf(w, "\treturn pulumix.Apply(o, func(v %s%sResult) %s { return v.%s })\n", outputStructName, methodName,
-						pkg.typeString(codegen.ResolvedType(p.Type)), Title(p.Name))
+					// The result output doesn't implement pulumix.Input, so wrap its state in a typed output.
+					resultType := outputStructName + methodName + "Result"
+					fmt.Fprintf(w, "\tvalue := pulumix.Apply[%s](pulumix.Output[%s]{OutputState: o.OutputState}, "+
+						"func(v %s) %s { return v.%s })\n", resultType, resultType, resultType,
+						pkg.plainGenericInputType(p.Type), Title(p.Name))
+					if genericTypeNeedsExplicitCasting(outputTypeName) {
+						fmt.Fprintf(w, "\treturn %s{OutputState: value.OutputState}\n", outputTypeName)
+					} else {
+						fmt.Fprintf(w, "\treturn value\n")
+					}
 				}
 
 				fmt.Fprintf(w, "}\n")
@@ -2728,7 +2755,7 @@ func goPackageInfo(packageReference schema.PackageReference) GoPackageInfo {
 		"Could not import languages")
 	if info, ok := def.Language["go"].(GoPackageInfo); ok {
 		if info.Generics == "" {
-			info.Generics = GenericsSettingNone
+			info.Generics = GenericsSettingGenericsOnly
 		}
 		return info
 	}
@@ -2780,8 +2807,9 @@ func (pkg *pkgContext) genGenericVariantFunctionCodeFile(f *schema.Function) (st
 	importsAndAliases[path.Join(pkg.importBasePath, pkg.internalModuleName)] = ""
 	buffer := &bytes.Buffer{}
 
+	// Like the legacy variant, the output version of the function is generated whenever it returns a value.
 	var imports []string
-	if NeedsGoOutputVersion(f) {
+	if f.NeedsOutputVersion() {
 		imports = []string{"context", "reflect"}
 	}
 
@@ -4564,8 +4592,8 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 	}
 
 	if goPkgInfo.Generics == "" {
-		// default is emitting the non-generic variant only
-		goPkgInfo.Generics = GenericsSettingNone
+		// default is emitting the generic variant only, along with the shims that keep the legacy API compiling
+		goPkgInfo.Generics = GenericsSettingGenericsOnly
 	}
 
 	emitOnlyGenericVariant := goPkgInfo.Generics == GenericsSettingGenericsOnly
@@ -4875,6 +4903,19 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 
 			setGenericVariantFile(path.Join(mod, "init.go"), genericVariantBuffer.String())
 		}
+
+		// When the generic variant replaces the legacy variant, alias the legacy names of the types it no longer
+		// declares so that existing programs continue to compile.
+		if emitOnlyGenericVariant {
+			buffer := &bytes.Buffer{}
+			hasShims, err := pkg.genCompatibilityShims(buffer)
+			if err != nil {
+				return nil, err
+			}
+			if hasShims {
+				setGenericVariantFile(path.Join(mod, "pulumiCompat.go"), buffer.String())
+			}
+		}
 	}
 
 	return files, nil
@@ -4898,8 +4939,9 @@ func generateTypes(
 		pkg.collectNestedCollectionTypes(collectionTypes, t)
 	}
 
-	// All collection types have Outputs
-	if len(collectionTypes) > 0 {
+	// All collection types have Outputs. The generic variant uses the generic collection types from pulumix instead
+	// of generating its own.
+	if len(collectionTypes) > 0 && !useGenericTypes {
 		hasOutputs = true
 	}
 
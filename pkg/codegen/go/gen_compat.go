@@ -0,0 +1,161 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// compatibilityShim is a declaration emitted by the generics-only variant of an SDK under a name that the legacy
+// variant declares, so that code written against the legacy variant continues to compile.
+type compatibilityShim struct {
+	name string
+	// alias is the generic type that the legacy name refers to.
+	alias string
+}
+
+// genCompatibilityShims generates the compatibility shims for the enums and object types of the module, and returns
+// false if the module has no types that need them.
+//
+// The generics-only variant of an SDK replaces the per-type Input, Output, Ptr, Array and Map types of the legacy
+// variant with instantiations of the generic types in pulumix. The shims alias the legacy names of these types to
+// their generic replacements, make enum values usable as inputs, and provide the legacy constructors for pointers to
+// enum values.
+func (pkg *pkgContext) genCompatibilityShims(w io.Writer) (bool, error) {
+	var enums, types bytes.Buffer
+	for _, e := range pkg.enums {
+		if e.IsOverlay {
+			continue
+		}
+		pkg.genEnumCompatibilityShims(&enums, e)
+	}
+	for _, t := range pkg.types {
+		if t.IsOverlay {
+			continue
+		}
+		genCompatibilityAliases(&types, pkg.objectCompatibilityShims(t))
+	}
+	if enums.Len() == 0 && types.Len() == 0 {
+		return false, nil
+	}
+
+	var goImports []string
+	if enums.Len() != 0 {
+		goImports = []string{"context", "reflect"}
+	}
+	importsAndAliases := map[string]string{"github.com/pulumi/pulumi/sdk/v3/go/pulumix": ""}
+	pkg.genHeader(w, goImports, importsAndAliases, false /* isUtil */)
+
+	if _, err := enums.WriteTo(w); err != nil {
+		return false, err
+	}
+	if _, err := types.WriteTo(w); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func genCompatibilityAliases(w io.Writer, shims []compatibilityShim) {
+	switch len(shims) {
+	case 0:
+		return
+	case 1:
+		fmt.Fprintf(w, "type %s = %s\n\n", shims[0].name, shims[0].alias)
+		return
+	}
+	fmt.Fprintf(w, "type (\n")
+	for _, shim := range shims {
+		fmt.Fprintf(w, "\t%s = %s\n", shim.name, shim.alias)
+	}
+	fmt.Fprintf(w, ")\n\n")
+}
+
+// genEnumCompatibilityShims generates the shims for an enum type. Values of the enum type implement
+// pulumix.Input[T] so that they can be passed directly as the inputs of resources and functions, as they can in the
+// legacy variant.
+func (pkg *pkgContext) genEnumCompatibilityShims(w io.Writer, enumType *schema.EnumType) {
+	name := pkg.tokenToEnum(enumType.Token)
+	details := pkg.detailsForType(enumType)
+
+	if details.input || details.ptrInput {
+		fmt.Fprintf(w, "func (%s) ElementType() reflect.Type {\n", name)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%s)(nil)).Elem()\n", name)
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "func (e %[1]s) ToOutput(ctx context.Context) pulumix.Output[%[1]s] {\n", name)
+		fmt.Fprintf(w, "\treturn pulumix.Val(e)\n")
+		fmt.Fprintf(w, "}\n\n")
+	}
+	if details.ptrInput {
+		elementGoType := pkg.typeString(enumType.ElementType)
+		fmt.Fprintf(w, "func %[1]sPtr(v %[2]s) %[1]sPtrInput {\n", name, elementGoType)
+		fmt.Fprintf(w, "\treturn pulumix.Ptr(%s(v))\n", name)
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	genCompatibilityAliases(w, pkg.compatibilityShims(name, enumType, enumType, details, true /* hasOutput */))
+}
+
+// objectCompatibilityShims returns the shims for an object type. The legacy variant's Output type for the object is
+// also declared by the generics-only variant, so it needs no shim.
+func (pkg *pkgContext) objectCompatibilityShims(obj *schema.ObjectType) []compatibilityShim {
+	name := pkg.tokenToType(obj.Token)
+	return pkg.compatibilityShims(name, obj.InputShape, obj, pkg.detailsForType(obj), false /* hasOutput */)
+}
+
+// compatibilityShims returns the aliases from the legacy names of the Input, Output, Ptr, Array and Map types of a
+// schema type to their generic replacements. inputType and outputType are the input and plain shapes of the type.
+func (pkg *pkgContext) compatibilityShims(
+	name string, inputType, outputType schema.Type, details *typeDetails, hasOutput bool,
+) []compatibilityShim {
+	var shims []compatibilityShim
+	add := func(suffix string, alias string) {
+		shims = append(shims, compatibilityShim{name: name + suffix, alias: alias})
+	}
+	// Like the legacy variant, omit the collection types whose names are taken by other declarations.
+	collides := func(suffix string) bool {
+		return pkg.names.Has(name + suffix)
+	}
+
+	if details.input {
+		add("Input", pkg.genericInputType(inputType))
+	}
+	if details.ptrInput {
+		add("PtrInput", pkg.genericInputType(&schema.OptionalType{ElementType: inputType}))
+	}
+	if details.arrayInput && !collides("Array") {
+		add("ArrayInput", pkg.genericInputType(&schema.ArrayType{ElementType: inputType}))
+	}
+	if details.mapInput && !collides("Map") {
+		add("MapInput", pkg.genericInputType(&schema.MapType{ElementType: inputType}))
+	}
+	if details.output && hasOutput {
+		add("Output", pkg.genericOutputType(outputType))
+	}
+	if details.ptrOutput {
+		add("PtrOutput", pkg.genericOutputType(&schema.OptionalType{ElementType: outputType}))
+	}
+	if details.arrayOutput && !collides("Array") {
+		add("ArrayOutput", pkg.genericOutputType(&schema.ArrayType{ElementType: outputType}))
+	}
+	if details.mapOutput && !collides("Map") {
+		add("MapOutput", pkg.genericOutputType(&schema.MapType{ElementType: outputType}))
+	}
+	return shims
+}
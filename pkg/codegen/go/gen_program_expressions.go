@@ -234,6 +234,8 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 			g.genTemplateExpression(w, arg, expr.Type())
 		case *model.ScopeTraversalExpression:
 			g.genScopeTraversalExpression(w, arg, expr.Type())
+		case *model.FunctionCallExpression:
+			g.genFunctionCallConversion(w, arg, expr.Type())
 		default:
 			g.Fgenf(w, "%.v", expr.Args[0])
 		}
@@ -333,6 +335,74 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 		g.Fgenf(w, "mime.TypeByExtension(path.Ext(%.v))", expr.Args[0])
 	case "sha1":
 		g.Fgenf(w, "sha1Hash(%v)", expr.Args[0])
+	case "sha256":
+		g.Fgenf(w, "sha256Hash(%v)", expr.Args[0])
+	case "sha512":
+		g.Fgenf(w, "sha512Hash(%v)", expr.Args[0])
+	case "md5":
+		g.Fgenf(w, "md5Hash(%v)", expr.Args[0])
+	case "upper":
+		g.Fgenf(w, "strings.ToUpper(%v)", expr.Args[0])
+	case "lower":
+		g.Fgenf(w, "strings.ToLower(%v)", expr.Args[0])
+	case "trimSpace":
+		g.Fgenf(w, "strings.TrimSpace(%v)", expr.Args[0])
+	case "trimPrefix":
+		g.Fgenf(w, "strings.TrimPrefix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "trimSuffix":
+		g.Fgenf(w, "strings.TrimSuffix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "startsWith":
+		g.Fgenf(w, "strings.HasPrefix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "endsWith":
+		g.Fgenf(w, "strings.HasSuffix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "replace":
+		g.Fgenf(w, "strings.ReplaceAll(%v, %v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "regexMatch":
+		g.Fgenf(w, "regexp.MustCompile(%v).MatchString(%v)", expr.Args[0], expr.Args[1])
+	case "regexReplace":
+		g.Fgenf(w, "regexp.MustCompile(%v).ReplaceAllString(%v, %v)", expr.Args[1], expr.Args[0], expr.Args[2])
+	case "format":
+		g.genHelperCall(w, "formatString", expr.Args)
+	case "cidrHost":
+		g.Fgenf(w, "cidrHost(%v, %v)", expr.Args[0], expr.Args[1])
+	case "cidrSubnet":
+		g.Fgenf(w, "cidrSubnet(%v, %v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "cidrNetmask":
+		g.Fgenf(w, "cidrNetmask(%v)", expr.Args[0])
+	case "merge":
+		// Generate literal arguments with the type of the result so that they agree on the type of their elements.
+		g.Fgen(w, "mergeMaps(")
+		for i, arg := range expr.Args {
+			if i > 0 {
+				g.Fgen(w, ", ")
+			}
+			if obj, ok := arg.(*model.ObjectConsExpression); ok {
+				g.genObjectConsExpression(w, obj, expr.Signature.ReturnType, false)
+			} else {
+				g.Fgenf(w, "%v", arg)
+			}
+		}
+		g.Fgen(w, ")")
+	case "flatten":
+		if tuple, ok := expr.Args[0].(*model.TupleConsExpression); ok {
+			g.Fgen(w, "flatten(")
+			g.genTupleConsExpression(w, tuple, model.NewListType(expr.Signature.ReturnType))
+			g.Fgen(w, ")")
+		} else {
+			g.Fgenf(w, "flatten(%v)", expr.Args[0])
+		}
+	case "keys":
+		g.Fgenf(w, "sortedKeys(%v)", expr.Args[0])
+	case "values":
+		g.Fgenf(w, "sortedValues(%v)", expr.Args[0])
+	case "min":
+		g.genHelperCall(w, "minOf", expr.Args)
+	case "max":
+		g.genHelperCall(w, "maxOf", expr.Args)
+	case "timestamp":
+		g.Fgen(w, "time.Now().UTC().Format(time.RFC3339)")
+	case "timeAdd":
+		g.Fgenf(w, "timeAdd(%v, %v)", expr.Args[0], expr.Args[1])
 	case "goOptionalFloat64":
 		g.Fgenf(w, "pulumi.Float64Ref(%.v)", expr.Args[0])
 	case "goOptionalBool":
@@ -356,6 +426,32 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 	}
 }
 
+// genFunctionCallConversion generates a function call that is converted to the given type. Functions that return a
+// prompt primitive value, such as strings.TrimPrefix or formatString, are wrapped in the corresponding pulumi type
+// when they are passed as inputs.
+func (g *generator) genFunctionCallConversion(w io.Writer, expr *model.FunctionCallExpression, destType model.Type) {
+	switch expr.Type() {
+	case model.StringType, model.NumberType, model.IntType, model.BoolType:
+		if argTypeName := g.argumentTypeName(expr, destType, false); strings.HasPrefix(argTypeName, "pulumi.") {
+			g.Fgenf(w, "%s(%.v)", argTypeName, expr)
+			return
+		}
+	}
+	g.Fgenf(w, "%.v", expr)
+}
+
+// genHelperCall generates a call to the named helper method with the given arguments.
+func (g *generator) genHelperCall(w io.Writer, name string, args []model.Expression) {
+	g.Fgenf(w, "%s(", name)
+	for i, arg := range args {
+		if i > 0 {
+			g.Fgen(w, ", ")
+		}
+		g.Fgenf(w, "%v", arg)
+	}
+	g.Fgen(w, ")")
+}
+
 // Currently args type for output-versioned invokes are named
 // `FOutputArgs`, but this is not yet understood by `tokenToType`. Use
 // this function to compensate.
@@ -1224,6 +1320,27 @@ var functionPackages = map[string][]string{
 	"filebase64sha256": {"crypto/sha256", "os"},
 	"cwd":              {"os"},
 	"singleOrNone":     {"fmt"},
+	"sha256":           {"crypto/sha256", "encoding/hex"},
+	"sha512":           {"crypto/sha512", "encoding/hex"},
+	"md5":              {"crypto/md5", "encoding/hex"},
+	"upper":            {"strings"},
+	"lower":            {"strings"},
+	"trimSpace":        {"strings"},
+	"trimPrefix":       {"strings"},
+	"trimSuffix":       {"strings"},
+	"startsWith":       {"strings"},
+	"endsWith":         {"strings"},
+	"replace":          {"strings"},
+	"regexMatch":       {"regexp"},
+	"regexReplace":     {"regexp"},
+	"format":           {"fmt", "regexp"},
+	"cidrHost":         {"encoding/binary", "net"},
+	"cidrSubnet":       {"encoding/binary", "fmt", "net"},
+	"cidrNetmask":      {"net"},
+	"keys":             {"sort"},
+	"values":           {"sort"},
+	"timestamp":        {"time"},
+	"timeAdd":          {"time"},
 }
 
 func (g *generator) genFunctionPackages(x *model.FunctionCallExpression) []string {
@@ -91,6 +91,133 @@ func getHelperMethodIfNeeded(functionName string, indent string) (string, bool)
 				hash := sha1.Sum([]byte(input))
 				return hex.EncodeToString(hash[:])
 			}`, true
+	case "sha256":
+		return `func sha256Hash(input string) string {
+				hash := sha256.Sum256([]byte(input))
+				return hex.EncodeToString(hash[:])
+			}`, true
+	case "sha512":
+		return `func sha512Hash(input string) string {
+				hash := sha512.Sum512([]byte(input))
+				return hex.EncodeToString(hash[:])
+			}`, true
+	case "md5":
+		return `func md5Hash(input string) string {
+				hash := md5.Sum([]byte(input))
+				return hex.EncodeToString(hash[:])
+			}`, true
+	case "format":
+		return `func formatString(spec string, args ...interface{}) string {
+				return regexp.MustCompile("%[%sdv]").ReplaceAllStringFunc(spec, func(verb string) string {
+					if verb == "%%" {
+						return "%"
+					}
+					value := fmt.Sprint(args[0])
+					args = args[1:]
+					return value
+				})
+			}`, true
+	case "cidrHost":
+		return `func cidrHost(prefix string, hostnum float64) string {
+				_, network, err := net.ParseCIDR(prefix)
+				if err != nil {
+					panic(err.Error())
+				}
+				host := binary.BigEndian.Uint32(network.IP.To4()) + uint32(hostnum)
+				return net.IPv4(byte(host>>24), byte(host>>16), byte(host>>8), byte(host)).String()
+			}`, true
+	case "cidrSubnet":
+		return `func cidrSubnet(prefix string, newbits float64, netnum float64) string {
+				_, network, err := net.ParseCIDR(prefix)
+				if err != nil {
+					panic(err.Error())
+				}
+				ones, bits := network.Mask.Size()
+				length := ones + int(newbits)
+				subnet := binary.BigEndian.Uint32(network.IP.To4()) | uint32(netnum)<<(bits-length)
+				ip := net.IPv4(byte(subnet>>24), byte(subnet>>16), byte(subnet>>8), byte(subnet))
+				return fmt.Sprintf("%v/%d", ip, length)
+			}`, true
+	case "cidrNetmask":
+		return `func cidrNetmask(prefix string) string {
+				_, network, err := net.ParseCIDR(prefix)
+				if err != nil {
+					panic(err.Error())
+				}
+				return net.IP(network.Mask).String()
+			}`, true
+	case "merge":
+		return `func mergeMaps[T any](maps ...map[string]T) map[string]T {
+				merged := map[string]T{}
+				for _, m := range maps {
+					for k, v := range m {
+						merged[k] = v
+					}
+				}
+				return merged
+			}`, true
+	case "flatten":
+		return `func flatten[T any](lists [][]T) []T {
+				var flattened []T
+				for _, list := range lists {
+					flattened = append(flattened, list...)
+				}
+				return flattened
+			}`, true
+	case "keys":
+		return `func sortedKeys[T any](m map[string]T) []string {
+				keys := make([]string, 0, len(m))
+				for k := range m {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				return keys
+			}`, true
+	case "values":
+		return `func sortedValues[T any](m map[string]T) []T {
+				keys := make([]string, 0, len(m))
+				for k := range m {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				values := make([]T, len(keys))
+				for i, k := range keys {
+					values[i] = m[k]
+				}
+				return values
+			}`, true
+	case "min":
+		return `func minOf(values ...float64) float64 {
+				result := values[0]
+				for _, v := range values[1:] {
+					if v < result {
+						result = v
+					}
+				}
+				return result
+			}`, true
+	case "max":
+		return `func maxOf(values ...float64) float64 {
+				result := values[0]
+				for _, v := range values[1:] {
+					if v > result {
+						result = v
+					}
+				}
+				return result
+			}`, true
+	case "timeAdd":
+		return `func timeAdd(timestamp string, duration string) string {
+				t, err := time.Parse(time.RFC3339, timestamp)
+				if err != nil {
+					panic(err.Error())
+				}
+				d, err := time.ParseDuration(duration)
+				if err != nil {
+					panic(err.Error())
+				}
+				return t.Add(d).UTC().Format(time.RFC3339)
+			}`, true
 	case "notImplemented":
 		return fmt.Sprintf(`
 %sfunc notImplemented(message string) pulumi.AnyOutput {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -16,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/test"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
@@ -612,3 +615,118 @@ func TestRegressTypeDuplicatesInChunking(t *testing.T) {
 		assert.NotContains(t, typedefs1, typ)
 	}
 }
+
+// BenchmarkGenericsOnly compares the legacy and generics-only variants of the SDKs generated for the schemas that the
+// codegen tests generate in both modes. Each iteration compiles the SDK's packages from scratch; the size of the
+// generated source and of a program that links the SDK are reported alongside the compile time:
+//
+//	go test -run '^$' -bench BenchmarkGenericsOnly ./codegen/go
+func BenchmarkGenericsOnly(b *testing.B) {
+	dirs := []string{
+		"assets-and-archives",
+		"output-funcs",
+		"plain-and-default",
+		"secrets",
+		"simple-enum-schema",
+		"simple-plain-schema",
+	}
+	for _, dir := range dirs {
+		dir := dir
+		for _, generics := range []string{GenericsSettingNone, GenericsSettingGenericsOnly} {
+			generics := generics
+			b.Run(dir+"/"+generics, func(b *testing.B) {
+				benchmarkGeneratedSDK(b, dir, generics)
+			})
+		}
+	}
+}
+
+func benchmarkGeneratedSDK(b *testing.B, dir, generics string) {
+	if testing.Short() {
+		b.Skip("skipping SDK compilation in short mode")
+	}
+
+	schemaBytes, err := os.ReadFile(filepath.Join(testdataPath, dir, "schema.json"))
+	require.NoError(b, err)
+	var spec schema.PackageSpec
+	require.NoError(b, json.Unmarshal(schemaBytes, &spec))
+	var goInfo map[string]interface{}
+	require.NoError(b, json.Unmarshal(spec.Language["go"], &goInfo))
+	goInfo["generics"] = generics
+	spec.Language["go"], err = json.Marshal(goInfo)
+	require.NoError(b, err)
+
+	pkg, diags, err := schema.BindSpec(spec, schema.NewPluginLoader(utils.NewHost(testdataPath)))
+	require.NoError(b, err)
+	require.False(b, diags.HasErrors(), "%v", diags)
+	files, err := GeneratePackage("test", pkg)
+	require.NoError(b, err)
+
+	// The SDK is written to the root of a module named for the first element of its import path, along with a
+	// program that links all of its packages.
+	moduleName := strings.Split(goInfo["importBasePath"].(string), "/")[0]
+	codeDir := b.TempDir()
+	sourceBytes, packageSet := 0, codegen.NewStringSet()
+	for name, contents := range files {
+		if strings.HasSuffix(name, ".go") {
+			sourceBytes += len(contents)
+			// Internal packages are linked through the packages that import them.
+			if dir := filepath.ToSlash(filepath.Dir(name)); path.Base(dir) != "internal" {
+				packageSet.Add(moduleName + "/" + dir)
+			}
+		}
+		require.NoError(b, os.MkdirAll(filepath.Join(codeDir, filepath.Dir(name)), 0o755))
+		require.NoError(b, os.WriteFile(filepath.Join(codeDir, name), contents, 0o600))
+	}
+
+	var program bytes.Buffer
+	fmt.Fprintf(&program, "package main\n\nimport (\n")
+	packages := packageSet.SortedValues()
+	for _, p := range packages {
+		fmt.Fprintf(&program, "\t_ %q\n", p)
+	}
+	fmt.Fprintf(&program, "\n\t\"github.com/pulumi/pulumi/sdk/v3/go/pulumi\"\n)\n\n")
+	fmt.Fprintf(&program, "func main() {\n\tpulumi.Run(func(*pulumi.Context) error { return nil })\n}\n")
+	require.NoError(b, os.MkdirAll(filepath.Join(codeDir, "cmd", "program"), 0o755))
+	require.NoError(b, os.WriteFile(filepath.Join(codeDir, "cmd", "program", "main.go"), program.Bytes(), 0o600))
+
+	sdk, err := filepath.Abs(filepath.Join("..", "..", "..", "sdk"))
+	require.NoError(b, err)
+	goExe, err := executable.FindExecutable("go")
+	require.NoError(b, err)
+	run := func(args ...string) {
+		cmd := exec.Command(goExe, args...)
+		cmd.Dir = codeDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(b, err, "go %v:\n%s", strings.Join(args, " "), out)
+	}
+	run("mod", "init", moduleName)
+	run("mod", "edit", "-replace", "github.com/pulumi/pulumi/sdk/v3="+sdk)
+	run("mod", "tidy")
+	// Build once so that only the SDK's own packages are compiled by each iteration.
+	run("build", "./...")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Change every file of the SDK so that the build cache cannot be used for any of its packages.
+		b.StopTimer()
+		for name, contents := range files {
+			if strings.HasSuffix(name, ".go") {
+				stamped := append(append([]byte{}, contents...), fmt.Sprintf("\n// Iteration %d.\n", i)...)
+				require.NoError(b, os.WriteFile(filepath.Join(codeDir, name), stamped, 0o600))
+			}
+		}
+		b.StartTimer()
+
+		run(append([]string{"build"}, packages...)...)
+	}
+	b.StopTimer()
+
+	binary := filepath.Join(codeDir, "program")
+	run("build", "-o", binary, "./cmd/program")
+	info, err := os.Stat(binary)
+	require.NoError(b, err)
+
+	b.ReportMetric(float64(sourceBytes), "source-bytes")
+	b.ReportMetric(float64(info.Size()), "binary-bytes")
+}
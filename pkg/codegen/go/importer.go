@@ -94,9 +94,11 @@ type GoPackageInfo struct {
 
 	// Specifies how to handle generating a variant of the SDK that uses generics.
 	// Allowed values are the following:
-	// - "none" (default): do not generate a generics variant of the SDK
+	// - "none": do not generate a generics variant of the SDK
 	// - "side-by-side": generate a side-by-side generics variant of the SDK under the x subdirectory
-	// - "only-generics": generate a generics variant of the SDK only
+	// - "generics-only" (default): generate a generics variant of the SDK only, as the SDK's default package. Type
+	//   aliases and helpers are generated under the legacy names of the types it no longer declares so that existing
+	//   programs continue to compile
 	Generics string `json:"generics,omitempty"`
 }
 
@@ -0,0 +1,149 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"bytes"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// Format returns the canonical formatting of the given HCL2 source. In addition to the indentation, spacing and
+// alignment applied by hclwrite, the canonical form:
+//
+//   - separates the items of multi-line object expressions with newlines alone, rather than trailing commas
+//   - closes multi-line blocks and objects on their own line
+//   - has no blank lines at the start or end of a block, object or file, and no consecutive blank lines elsewhere
+//   - ends with a single newline
+//
+// If the source cannot be parsed, the source is returned unchanged along with the parser's diagnostics.
+func Format(src []byte, filename string) ([]byte, hcl.Diagnostics) {
+	if _, diags := hclsyntax.ParseConfig(src, filename, hcl.Pos{}); diags.HasErrors() {
+		return src, diags
+	}
+	f, diags := hclwrite.ParseConfig(src, filename, hcl.Pos{})
+	if diags.HasErrors() {
+		return src, diags
+	}
+
+	tokens := f.BuildTokens(nil)
+	tokens = removeTrailingCommas(tokens)
+	tokens = breakClosingBraces(tokens)
+	tokens = removeBlankLines(tokens)
+
+	formatted := hclwrite.Format(tokens.Bytes())
+	formatted = bytes.TrimRight(formatted, "\n")
+	if len(formatted) == 0 {
+		return formatted, nil
+	}
+	return append(formatted, '\n'), nil
+}
+
+// endsLine returns true if the given token ends a line. Line comments include the newline that ends them.
+func endsLine(t *hclwrite.Token) bool {
+	switch t.Type {
+	case hclsyntax.TokenNewline:
+		return true
+	case hclsyntax.TokenComment:
+		return bytes.HasSuffix(t.Bytes, []byte("\n"))
+	default:
+		return false
+	}
+}
+
+// removeTrailingCommas removes the commas that end the lines of object expressions. Newlines already separate the
+// items of an object, so these commas are redundant.
+func removeTrailingCommas(tokens hclwrite.Tokens) hclwrite.Tokens {
+	// Track the kind of bracket that encloses each token. Commas within tuples and function calls are required, even
+	// at the end of a line, so only commas directly within braces are removed. Block bodies never contain commas.
+	var brackets []hclsyntax.TokenType
+	result := make(hclwrite.Tokens, 0, len(tokens))
+	for i, t := range tokens {
+		switch t.Type {
+		case hclsyntax.TokenOBrace, hclsyntax.TokenOBrack, hclsyntax.TokenOParen,
+			hclsyntax.TokenTemplateInterp, hclsyntax.TokenTemplateControl:
+			brackets = append(brackets, t.Type)
+		case hclsyntax.TokenCBrace, hclsyntax.TokenCBrack, hclsyntax.TokenCParen, hclsyntax.TokenTemplateSeqEnd:
+			if len(brackets) > 0 {
+				brackets = brackets[:len(brackets)-1]
+			}
+		case hclsyntax.TokenComma:
+			inObject := len(brackets) > 0 && brackets[len(brackets)-1] == hclsyntax.TokenOBrace
+			if inObject && i+1 < len(tokens) && endsLine(tokens[i+1]) {
+				continue
+			}
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// breakClosingBraces starts a new line before the closing brace of each block or object whose opening brace ends a
+// line, so that the contents of the block or object are indented consistently.
+func breakClosingBraces(tokens hclwrite.Tokens) hclwrite.Tokens {
+	// multiline records whether each enclosing brace ends its line.
+	var multiline []bool
+	result := make(hclwrite.Tokens, 0, len(tokens))
+	for i, t := range tokens {
+		switch t.Type {
+		case hclsyntax.TokenOBrace:
+			multiline = append(multiline, i+1 < len(tokens) && endsLine(tokens[i+1]))
+		case hclsyntax.TokenCBrace:
+			if len(multiline) > 0 {
+				if multiline[len(multiline)-1] && len(result) > 0 && !endsLine(result[len(result)-1]) {
+					result = append(result, &hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")})
+				}
+				multiline = multiline[:len(multiline)-1]
+			}
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// removeBlankLines removes blank lines at the start and end of the file and of each block or object, and collapses
+// consecutive blank lines into one.
+func removeBlankLines(tokens hclwrite.Tokens) hclwrite.Tokens {
+	// afterBlank is true if the previous line was blank, opened a block or object, or if there is no previous line.
+	afterBlank := true
+	lineHasContent, lineOpensBrace := false, false
+
+	result := make(hclwrite.Tokens, 0, len(tokens))
+	for i, t := range tokens {
+		switch {
+		case t.Type == hclsyntax.TokenNewline && !lineHasContent:
+			next := hclsyntax.TokenEOF
+			for _, u := range tokens[i+1:] {
+				if u.Type != hclsyntax.TokenNewline {
+					next = u.Type
+					break
+				}
+			}
+			if afterBlank || next == hclsyntax.TokenCBrace || next == hclsyntax.TokenEOF {
+				continue
+			}
+			afterBlank = true
+		case endsLine(t):
+			afterBlank = t.Type == hclsyntax.TokenNewline && lineOpensBrace
+			lineHasContent, lineOpensBrace = false, false
+		default:
+			lineHasContent, lineOpensBrace = true, t.Type == hclsyntax.TokenOBrace
+		}
+		result = append(result, t)
+	}
+	return result
+}
@@ -0,0 +1,157 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			source:   "\n\n",
+			expected: "",
+		},
+		{
+			name: "indentation and alignment",
+			source: `resource pet "random:index/randomPet:RandomPet" {
+    length=2
+	prefix = "pet"
+}
+`,
+			expected: `resource pet "random:index/randomPet:RandomPet" {
+  length = 2
+  prefix = "pet"
+}
+`,
+		},
+		{
+			name: "blank lines",
+			source: `
+
+config name string {
+
+}
+
+
+
+# The pet.
+resource pet "random:index/randomPet:RandomPet" {
+
+  prefix = name
+
+
+  length = 2
+
+}
+
+`,
+			expected: `config name string {
+}
+
+# The pet.
+resource pet "random:index/randomPet:RandomPet" {
+  prefix = name
+
+  length = 2
+}
+`,
+		},
+		{
+			name: "object commas",
+			source: `tags = {
+
+  "a" = 1,
+
+  "b" = [
+    1,
+    2,
+  ], // the list
+  "c" = { d = 3, e = 4 }}
+`,
+			expected: `tags = {
+  "a" = 1
+
+  "b" = [
+    1,
+    2,
+  ] // the list
+  "c" = { d = 3, e = 4 }
+}
+`,
+		},
+		{
+			name: "closing braces",
+			source: `result = invoke("pkg:index:fn", {
+value = "a"})
+`,
+			expected: `result = invoke("pkg:index:fn", {
+  value = "a"
+})
+`,
+		},
+		{
+			name: "heredoc",
+			source: `text = <<EOT
+hello,
+
+
+world
+EOT
+`,
+			expected: `text = <<EOT
+hello,
+
+
+world
+EOT
+`,
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, diags := Format([]byte(c.source), "main.pp")
+			require.Empty(t, diags)
+			assert.Equal(t, c.expected, string(actual))
+
+			// Formatting is idempotent.
+			again, diags := Format(actual, "main.pp")
+			require.Empty(t, diags)
+			assert.Equal(t, string(actual), string(again))
+		})
+	}
+}
+
+func TestFormatInvalid(t *testing.T) {
+	t.Parallel()
+
+	source := "resource pet {\n"
+	actual, diags := Format([]byte(source), "main.pp")
+	assert.True(t, diags.HasErrors())
+	assert.Equal(t, source, string(actual))
+}
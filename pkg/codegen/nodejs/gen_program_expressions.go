@@ -108,6 +108,11 @@ func (g *generator) GenAnonymousFunctionExpression(w io.Writer, expr *model.Anon
 		g.Fgen(w, "])")
 	}
 
+	// An arrow function whose body starts with a brace is parsed as a block, so object spreads must be parenthesized.
+	if call, ok := expr.Body.(*model.FunctionCallExpression); ok && call.Name == "merge" {
+		g.Fgenf(w, " => (%.v)", expr.Body)
+		return
+	}
 	g.Fgenf(w, " => %.v", expr.Body)
 }
 
@@ -290,6 +295,9 @@ var functionImports = map[string][]string{
 	"readFile":           {"fs"},
 	"readDir":            {"fs"},
 	"sha1":               {"crypto"},
+	"sha256":             {"crypto"},
+	"sha512":             {"crypto"},
+	"md5":                {"crypto"},
 }
 
 func (g *generator) getFunctionImports(x *model.FunctionCallExpression) []string {
@@ -490,6 +498,63 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 		}
 	case "sha1":
 		g.Fgenf(w, "crypto.createHash('sha1').update(%v).digest('hex')", expr.Args[0])
+	case "sha256", "sha512", "md5":
+		g.Fgenf(w, "crypto.createHash('%s').update(%v).digest('hex')", expr.Name, expr.Args[0])
+	case "upper":
+		g.Fgenf(w, "%.20v.toUpperCase()", expr.Args[0])
+	case "lower":
+		g.Fgenf(w, "%.20v.toLowerCase()", expr.Args[0])
+	case "trimSpace":
+		g.Fgenf(w, "%.20v.trim()", expr.Args[0])
+	case "trimPrefix":
+		// Assuming the existence of the following helper method
+		g.Fgenf(w, "trimPrefix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "trimSuffix":
+		// Assuming the existence of the following helper method
+		g.Fgenf(w, "trimSuffix(%v, %v)", expr.Args[0], expr.Args[1])
+	case "startsWith":
+		g.Fgenf(w, "%.20v.startsWith(%v)", expr.Args[0], expr.Args[1])
+	case "endsWith":
+		g.Fgenf(w, "%.20v.endsWith(%v)", expr.Args[0], expr.Args[1])
+	case "replace":
+		g.Fgenf(w, "%.20v.split(%v).join(%v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "regexMatch":
+		g.Fgenf(w, "new RegExp(%v).test(%v)", expr.Args[0], expr.Args[1])
+	case "regexReplace":
+		g.Fgenf(w, "%.20v.replace(new RegExp(%v, \"g\"), %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "format":
+		// Assuming the existence of the following helper method
+		g.genCall(w, "formatString", expr.Args)
+	case "cidrHost":
+		g.Fgenf(w, "cidrHost(%v, %v)", expr.Args[0], expr.Args[1])
+	case "cidrSubnet":
+		g.Fgenf(w, "cidrSubnet(%v, %v, %v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "cidrNetmask":
+		g.Fgenf(w, "cidrNetmask(%v)", expr.Args[0])
+	case "merge":
+		g.Fgen(w, "{")
+		for i, arg := range expr.Args {
+			if i > 0 {
+				g.Fgen(w, ", ")
+			}
+			g.Fgenf(w, "...%v", arg)
+		}
+		g.Fgen(w, "}")
+	case "flatten":
+		g.Fgenf(w, "%.20v.flat()", expr.Args[0])
+	case "keys":
+		g.Fgenf(w, "Object.keys(%v).sort()", expr.Args[0])
+	case "values":
+		g.Fgenf(w, "Object.entries(%v).sort(([a], [b]) => a < b ? -1 : 1).map(([, v]) => v)", expr.Args[0])
+	case "min":
+		g.genCall(w, "Math.min", expr.Args)
+	case "max":
+		g.genCall(w, "Math.max", expr.Args)
+	case "timestamp":
+		g.Fgen(w, `new Date().toISOString().replace(/\.\d+Z$/, "Z")`)
+	case "timeAdd":
+		// Assuming the existence of the following helper method
+		g.Fgenf(w, "timeAdd(%v, %v)", expr.Args[0], expr.Args[1])
 	case "stack":
 		g.Fgenf(w, "pulumi.getStack()")
 	case "project":
@@ -506,6 +571,18 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 	}
 }
 
+// genCall generates a call to the named function with the given arguments.
+func (g *generator) genCall(w io.Writer, name string, args []model.Expression) {
+	g.Fgenf(w, "%s(", name)
+	for i, arg := range args {
+		if i > 0 {
+			g.Fgen(w, ", ")
+		}
+		g.Fgenf(w, "%v", arg)
+	}
+	g.Fgen(w, ")")
+}
+
 func (g *generator) GenIndexExpression(w io.Writer, expr *model.IndexExpression) {
 	g.Fgenf(w, "%.20v[%.v]", expr.Collection, expr.Key)
 }
@@ -26,6 +26,52 @@ func getHelperMethodIfNeeded(functionName string, indent string) (string, bool)
 %s    }
 %s    return elements[0];
 %s}`, indent, indent, indent, indent, indent, indent), true
+	case "trimPrefix":
+		return `function trimPrefix(value: string, prefix: string): string {
+    return value.startsWith(prefix) ? value.slice(prefix.length) : value;
+}`, true
+	case "trimSuffix":
+		return `function trimSuffix(value: string, suffix: string): string {
+    return suffix && value.endsWith(suffix) ? value.slice(0, -suffix.length) : value;
+}`, true
+	case "format":
+		return `function formatString(spec: string, ...args: any[]): string {
+    let i = 0;
+    return spec.replace(/%[%sdv]/g, verb => verb === "%%" ? "%" : String(args[i++]));
+}`, true
+	case "cidrHost":
+		return `function cidrHost(prefix: string, hostnum: number): string {
+    const [address, bits] = prefix.split("/");
+    const ip = address.split(".").reduce((value, octet) => value * 256 + Number(octet), 0);
+    const host = ip - ip % 2 ** (32 - Number(bits)) + hostnum;
+    return [3, 2, 1, 0].map(i => Math.floor(host / 256 ** i) % 256).join(".");
+}`, true
+	case "cidrSubnet":
+		return `function cidrSubnet(prefix: string, newbits: number, netnum: number): string {
+    const [address, bits] = prefix.split("/");
+    const ip = address.split(".").reduce((value, octet) => value * 256 + Number(octet), 0);
+    const length = Number(bits) + newbits;
+    const subnet = ip - ip % 2 ** (32 - Number(bits)) + netnum * 2 ** (32 - length);
+    return [3, 2, 1, 0].map(i => Math.floor(subnet / 256 ** i) % 256).join(".") + "/" + length;
+}`, true
+	case "cidrNetmask":
+		return `function cidrNetmask(prefix: string): string {
+    const mask = 2 ** 32 - 2 ** (32 - Number(prefix.split("/")[1]));
+    return [3, 2, 1, 0].map(i => Math.floor(mask / 256 ** i) % 256).join(".");
+}`, true
+	case "timeAdd":
+		return `function timeAdd(timestamp: string, duration: string): string {
+    const units: Record<string, number> = { h: 3600000, m: 60000, s: 1000 };
+    let milliseconds = 0;
+    duration.replace(/([\d.]+)([hms])/g, (match, n, unit) => {
+        milliseconds += Number(n) * units[unit];
+        return match;
+    });
+    if (duration.startsWith("-")) {
+        milliseconds = -milliseconds;
+    }
+    return new Date(Date.parse(timestamp) + milliseconds).toISOString().replace(/\.\d+Z$/, "Z");
+}`, true
 	case "mimeType":
 		return fmt.Sprintf(`%sfunction mimeType(path: string): string {
 %s    throw new Error("mimeType not implemented, use the mime or mime-types package instead");
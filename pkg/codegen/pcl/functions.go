@@ -15,6 +15,8 @@
 package pcl
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
 )
@@ -50,6 +52,118 @@ func getEntriesSignature(
 	return signature, diagnostics
 }
 
+// newStringFunction returns a function whose parameters, with the given names, are all strings.
+func newStringFunction(returnType model.Type, names ...string) *model.Function {
+	parameters := make([]model.Parameter, len(names))
+	for i, name := range names {
+		parameters[i] = model.Parameter{Name: name, Type: model.StringType}
+	}
+	return model.NewFunction(model.StaticFunctionSignature{
+		Parameters: parameters,
+		ReturnType: returnType,
+	})
+}
+
+// promptParameters returns a parameter for each of the given arguments, typed as the argument without outputs so that
+// output arguments are applied before the function is called.
+func promptParameters(name string, args []model.Expression) []model.Parameter {
+	parameters := make([]model.Parameter, len(args))
+	for i, arg := range args {
+		parameters[i] = model.Parameter{
+			Name: fmt.Sprintf("%s%d", name, i),
+			Type: model.ResolveOutputs(arg.Type()),
+		}
+	}
+	return parameters
+}
+
+// listElementType returns the type of the elements of the given list or tuple type, and false if the type is neither.
+func listElementType(t model.Type) (model.Type, bool) {
+	switch t := model.ResolveOutputs(t).(type) {
+	case *model.ListType:
+		return t.ElementType, true
+	case *model.TupleType:
+		if len(t.ElementTypes) == 0 {
+			return model.DynamicType, true
+		}
+		_, elementType := model.UnifyTypes(t.ElementTypes...)
+		return elementType, true
+	default:
+		return model.DynamicType, t == model.DynamicType
+	}
+}
+
+// mapElementType returns the type of the elements of the given map or object type, and false if the type is neither.
+func mapElementType(t model.Type) (model.Type, bool) {
+	switch t := model.ResolveOutputs(t).(type) {
+	case *model.MapType:
+		return t.ElementType, true
+	case *model.ObjectType:
+		if len(t.Properties) == 0 {
+			return model.DynamicType, true
+		}
+		var elementType model.Type
+		for _, t := range t.Properties {
+			_, elementType = model.UnifyTypes(elementType, t)
+		}
+		return elementType, true
+	default:
+		return model.DynamicType, t == model.DynamicType
+	}
+}
+
+// getMapSignature returns the signature of a function that takes a single map or object argument and returns a value
+// of a type derived from the type of the map's elements.
+func getMapSignature(
+	name string,
+	args []model.Expression,
+	returnType func(elementType model.Type) model.Type,
+) (model.StaticFunctionSignature, hcl.Diagnostics) {
+	var diagnostics hcl.Diagnostics
+
+	mapType, elementType := model.Type(model.DynamicType), model.Type(model.DynamicType)
+	if len(args) > 0 {
+		mapType = model.ResolveOutputs(args[0].Type())
+		t, ok := mapElementType(mapType)
+		if !ok {
+			rng := args[0].SyntaxNode().Range()
+			diagnostics = hcl.Diagnostics{&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  fmt.Sprintf("the argument to '%s' must be a map or object", name),
+				Subject:  &rng,
+			}}
+		}
+		elementType = t
+	}
+	return model.StaticFunctionSignature{
+		Parameters: []model.Parameter{{
+			Name: "map",
+			Type: mapType,
+		}},
+		ReturnType: returnType(elementType),
+	}, diagnostics
+}
+
+// getNumbersSignature returns the signature of a function that takes one or more numbers and returns a number.
+func getNumbersSignature(name string, args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+	var diagnostics hcl.Diagnostics
+	if len(args) == 0 {
+		diagnostics = hcl.Diagnostics{&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  fmt.Sprintf("'%s' expects at least one argument", name),
+		}}
+	}
+
+	parameters := make([]model.Parameter, len(args))
+	for i := range args {
+		parameters[i] = model.Parameter{Name: fmt.Sprintf("number%d", i), Type: model.NumberType}
+	}
+	return model.StaticFunctionSignature{
+		Parameters: parameters,
+		ReturnType: model.NumberType,
+	}, diagnostics
+}
+
 func pulumiBuiltins(options bindOptions) map[string]*model.Function {
 	return map[string]*model.Function{
 		"element": model.NewFunction(model.GenericFunctionSignature(
@@ -304,6 +418,170 @@ func pulumiBuiltins(options bindOptions) map[string]*model.Function {
 			}},
 			ReturnType: model.StringType,
 		}),
+		"sha256": newStringFunction(model.StringType, "input"),
+		"sha512": newStringFunction(model.StringType, "input"),
+		"md5":    newStringFunction(model.StringType, "input"),
+		// String functions
+		"upper":      newStringFunction(model.StringType, "value"),
+		"lower":      newStringFunction(model.StringType, "value"),
+		"trimSpace":  newStringFunction(model.StringType, "value"),
+		"trimPrefix": newStringFunction(model.StringType, "value", "prefix"),
+		"trimSuffix": newStringFunction(model.StringType, "value", "suffix"),
+		"startsWith": newStringFunction(model.BoolType, "value", "prefix"),
+		"endsWith":   newStringFunction(model.BoolType, "value", "suffix"),
+		// Replaces every occurrence of search in value with replacement
+		"replace": newStringFunction(model.StringType, "value", "search", "replacement"),
+		// Returns whether value contains a match of the regular expression pattern
+		"regexMatch": newStringFunction(model.BoolType, "pattern", "value"),
+		// Replaces every match of the regular expression pattern in value with replacement, which may refer to
+		// capture groups as $1, $2, and so on
+		"regexReplace": newStringFunction(model.StringType, "value", "pattern", "replacement"),
+		// Replaces each %s, %d or %v in spec with the next argument, and each %% with %
+		"format": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				if len(args) == 0 {
+					return model.StaticFunctionSignature{
+						Parameters: []model.Parameter{{Name: "spec", Type: model.StringType}},
+						ReturnType: model.StringType,
+					}, nil
+				}
+				return model.StaticFunctionSignature{
+					Parameters: append(
+						[]model.Parameter{{Name: "spec", Type: model.StringType}},
+						promptParameters("arg", args[1:])...),
+					ReturnType: model.StringType,
+				}, nil
+			})),
+		// CIDR functions, which support IPv4 prefixes
+		"cidrHost": model.NewFunction(model.StaticFunctionSignature{
+			Parameters: []model.Parameter{
+				{
+					Name: "prefix",
+					Type: model.StringType,
+				},
+				{
+					Name: "hostnum",
+					Type: model.NumberType,
+				},
+			},
+			ReturnType: model.StringType,
+		}),
+		"cidrSubnet": model.NewFunction(model.StaticFunctionSignature{
+			Parameters: []model.Parameter{
+				{
+					Name: "prefix",
+					Type: model.StringType,
+				},
+				{
+					Name: "newbits",
+					Type: model.NumberType,
+				},
+				{
+					Name: "netnum",
+					Type: model.NumberType,
+				},
+			},
+			ReturnType: model.StringType,
+		}),
+		"cidrNetmask": newStringFunction(model.StringType, "prefix"),
+		// Collection functions
+		"merge": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				var diagnostics hcl.Diagnostics
+
+				elementTypes := make([]model.Type, 0, len(args))
+				for _, arg := range args {
+					elementType, ok := mapElementType(arg.Type())
+					if !ok {
+						rng := arg.SyntaxNode().Range()
+						diagnostics = append(diagnostics, &hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "the arguments to 'merge' must be maps or objects",
+							Subject:  &rng,
+						})
+					}
+					elementTypes = append(elementTypes, elementType)
+				}
+
+				elementType := model.Type(model.DynamicType)
+				if len(elementTypes) > 0 {
+					_, elementType = model.UnifyTypes(elementTypes...)
+				}
+				return model.StaticFunctionSignature{
+					Parameters: promptParameters("map", args),
+					ReturnType: model.NewMapType(elementType),
+				}, diagnostics
+			})),
+		"flatten": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				var diagnostics hcl.Diagnostics
+
+				listType, elementType := model.Type(model.DynamicType), model.Type(model.DynamicType)
+				if len(args) > 0 {
+					listType = model.ResolveOutputs(args[0].Type())
+
+					var listTypes []model.Type
+					switch t := listType.(type) {
+					case *model.ListType:
+						listTypes = []model.Type{t.ElementType}
+					case *model.TupleType:
+						listTypes = t.ElementTypes
+					}
+
+					elementTypes := make([]model.Type, 0, len(listTypes))
+					ok := listType == model.DynamicType || listTypes != nil
+					for _, t := range listTypes {
+						elementType, isList := listElementType(t)
+						elementTypes, ok = append(elementTypes, elementType), ok && isList
+					}
+					if !ok {
+						rng := args[0].SyntaxNode().Range()
+						diagnostics = hcl.Diagnostics{&hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "the argument to 'flatten' must be a list of lists",
+							Subject:  &rng,
+						}}
+					}
+					if len(elementTypes) > 0 {
+						_, elementType = model.UnifyTypes(elementTypes...)
+					}
+				}
+				return model.StaticFunctionSignature{
+					Parameters: []model.Parameter{{
+						Name: "lists",
+						Type: listType,
+					}},
+					ReturnType: model.NewListType(elementType),
+				}, diagnostics
+			})),
+		// Returns the keys of a map, sorted lexically
+		"keys": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				return getMapSignature("keys", args, func(model.Type) model.Type {
+					return model.NewListType(model.StringType)
+				})
+			})),
+		// Returns the values of a map, sorted by their keys
+		"values": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				return getMapSignature("values", args, func(elementType model.Type) model.Type {
+					return model.NewListType(elementType)
+				})
+			})),
+		"min": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				return getNumbersSignature("min", args)
+			})),
+		"max": model.NewFunction(model.GenericFunctionSignature(
+			func(args []model.Expression) (model.StaticFunctionSignature, hcl.Diagnostics) {
+				return getNumbersSignature("max", args)
+			})),
+		// Returns the current time as an RFC 3339 timestamp in UTC
+		"timestamp": model.NewFunction(model.StaticFunctionSignature{
+			ReturnType: model.StringType,
+		}),
+		// Adds a duration such as "1h30m" to an RFC 3339 timestamp, returning the result in UTC
+		"timeAdd": newStringFunction(model.StringType, "timestamp", "duration"),
 		"split": model.NewFunction(model.StaticFunctionSignature{
 			Parameters: []model.Parameter{
 				{
@@ -0,0 +1,256 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcl
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// A LintRule identifies one of the checks performed by Lint. Diagnostics produced by a check carry its rule in their
+// Extra field.
+type LintRule string
+
+const (
+	// LintUnusedLocal reports local variables that are not referenced.
+	LintUnusedLocal LintRule = "unused-local"
+	// LintUnusedConfig reports config variables that are not referenced.
+	LintUnusedConfig LintRule = "unused-config"
+	// LintUnknownResourceType reports resources whose type is not defined by the schema of its package.
+	LintUnknownResourceType LintRule = "unknown-resource-type"
+	// LintSecretOutput reports outputs whose values are computed from secrets but that are not marked as secret, or
+	// that expose secrets by passing them to unsecret within a larger expression.
+	LintSecretOutput LintRule = "secret-output"
+)
+
+// DiagnosticLintRule returns the lint rule that produced the given diagnostic, or the empty string if the diagnostic
+// was produced by the parser or the binder.
+func DiagnosticLintRule(d *hcl.Diagnostic) LintRule {
+	rule, _ := d.Extra.(LintRule)
+	return rule
+}
+
+func lintf(rule LintRule, severity hcl.DiagnosticSeverity, subject hcl.Range,
+	f string, args ...interface{},
+) *hcl.Diagnostic {
+	d := diagf(severity, subject, f, args...)
+	d.Extra = rule
+	return d
+}
+
+// Lint binds the given files and checks the resulting program for problems that do not prevent it from binding. The
+// returned diagnostics include those produced by the binder. Resource and invoke types are checked leniently, so that
+// the rest of the program can be checked even if some of its types cannot be resolved; resources of unknown types
+// are reported as errors.
+func Lint(files []*syntax.File, opts ...BindOption) (hcl.Diagnostics, error) {
+	opts = append([]BindOption{SkipResourceTypechecking, SkipInvokeTypechecking}, opts...)
+	program, diagnostics, err := BindProgram(files, opts...)
+	if program == nil {
+		if diagnostics != nil {
+			return diagnostics, nil
+		}
+		return nil, err
+	}
+
+	// The binder reports the problems with each resource type that cannot be resolved, and with the properties of its
+	// resources, as warnings; these are replaced by a single error for each resource.
+	var unknownTypes []hcl.Range
+	var lints hcl.Diagnostics
+	for _, n := range program.Nodes {
+		if r, ok := n.(*Resource); ok && r.Schema == nil && len(r.syntax.LabelRanges) == 2 {
+			unknownTypes = append(unknownTypes, r.syntax.Range())
+			lints = append(lints, lintf(LintUnknownResourceType, hcl.DiagError, r.syntax.LabelRanges[1],
+				"unknown resource type '%s'", r.syntax.Labels[1]))
+		}
+	}
+	result := hcl.Diagnostics{}
+	for _, d := range diagnostics {
+		if d.Severity == hcl.DiagWarning && d.Subject != nil && rangesContain(unknownTypes, *d.Subject) {
+			continue
+		}
+		result = append(result, d)
+	}
+	result = append(result, lints...)
+
+	result = append(result, lintUnusedVariables(program)...)
+	result = append(result, lintSecretOutputs(program)...)
+	return result, nil
+}
+
+// rangesContain returns true if any of the given ranges contains the subject.
+func rangesContain(ranges []hcl.Range, subject hcl.Range) bool {
+	for _, r := range ranges {
+		if r.Filename == subject.Filename && r.ContainsOffset(subject.Start.Byte) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintUnusedVariables reports the config and local variables that no other node refers to.
+func lintUnusedVariables(program *Program) hcl.Diagnostics {
+	used := map[Node]bool{}
+	for _, n := range program.Nodes {
+		for _, d := range n.getDependencies() {
+			used[d] = true
+		}
+	}
+
+	var diagnostics hcl.Diagnostics
+	for _, n := range program.Nodes {
+		if used[n] {
+			continue
+		}
+		switch n := n.(type) {
+		case *ConfigVariable:
+			diagnostics = append(diagnostics, lintf(LintUnusedConfig, hcl.DiagWarning, n.syntax.LabelRanges[0],
+				"config variable '%s' is not used", n.Name()))
+		case *LocalVariable:
+			diagnostics = append(diagnostics, lintf(LintUnusedLocal, hcl.DiagWarning, n.syntax.NameRange,
+				"local variable '%s' is not used", n.Name()))
+		}
+	}
+	return diagnostics
+}
+
+// secretSources finds the values within expressions that are known to be secret.
+type secretSources struct {
+	// locals caches the secret sources within the definitions of local variables.
+	locals map[*LocalVariable][]model.Expression
+}
+
+// find returns the expressions within x that produce secret values: calls to secret, references to secret resource
+// properties, and references to local variables whose values contain either. Secrets that are passed to unsecret are
+// not returned; instead, the calls to unsecret that expose them are returned separately.
+func (s *secretSources) find(x model.Expression) (sources, exposures []model.Expression) {
+	var candidates, unsecrets []model.Expression
+	_, diags := model.VisitExpression(x, func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+		switch x := x.(type) {
+		case *model.FunctionCallExpression:
+			switch x.Name {
+			case "secret":
+				candidates = append(candidates, x)
+			case "unsecret":
+				unsecrets = append(unsecrets, x)
+			}
+		case *model.ScopeTraversalExpression:
+			if len(x.Parts) == 0 {
+				break
+			}
+			switch root := x.Parts[0].(type) {
+			case *Resource:
+				if isSecretResourceProperty(root, x.Traversal) {
+					candidates = append(candidates, x)
+				}
+			case *LocalVariable:
+				if len(s.local(root)) != 0 {
+					candidates = append(candidates, x)
+				}
+			}
+		}
+		return x, nil
+	}, model.IdentityVisitor)
+	contract.Assertf(len(diags) == 0, "expected no diagnostics from VisitExpression")
+
+	exposed := map[model.Expression]bool{}
+	for _, source := range candidates {
+		masked := false
+		for _, call := range unsecrets {
+			if call.SyntaxNode().Range().Overlaps(source.SyntaxNode().Range()) {
+				masked, exposed[call] = true, true
+			}
+		}
+		if !masked {
+			sources = append(sources, source)
+		}
+	}
+	for _, call := range unsecrets {
+		if exposed[call] {
+			exposures = append(exposures, call)
+		}
+	}
+	return sources, exposures
+}
+
+// local returns the secret sources within the definition of the given local variable.
+func (s *secretSources) local(v *LocalVariable) []model.Expression {
+	if sources, ok := s.locals[v]; ok {
+		return sources
+	}
+	// Guard against cycles, which the binder reports separately.
+	s.locals[v] = nil
+	sources, _ := s.find(v.Definition.Value)
+	s.locals[v] = sources
+	return sources
+}
+
+// isSecretResourceProperty returns true if the given traversal of a resource refers to a property that its schema
+// marks as secret.
+func isSecretResourceProperty(r *Resource, traversal hcl.Traversal) bool {
+	if r.Schema == nil || len(traversal) < 2 {
+		return false
+	}
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return false
+	}
+	for _, p := range r.Schema.Properties {
+		if p.Name == attr.Name {
+			return p.Secret
+		}
+	}
+	return false
+}
+
+// lintSecretOutputs reports outputs whose values are computed from secrets but are not explicitly marked as secret,
+// and outputs that expose secrets by passing them to unsecret within a larger expression. An output whose value is
+// wrapped in secret or unsecret is explicitly marked, and is not reported.
+func lintSecretOutputs(program *Program) hcl.Diagnostics {
+	s := &secretSources{locals: map[*LocalVariable][]model.Expression{}}
+
+	var diagnostics hcl.Diagnostics
+	for _, n := range program.Nodes {
+		o, ok := n.(*OutputVariable)
+		if !ok || o.Value == nil {
+			continue
+		}
+		if call, ok := o.Value.(*model.FunctionCallExpression); ok && (call.Name == "secret" || call.Name == "unsecret") {
+			continue
+		}
+
+		sources, exposures := s.find(o.Value)
+		if len(exposures) != 0 {
+			d := lintf(LintSecretOutput, hcl.DiagWarning, o.syntax.LabelRanges[0],
+				"output '%s' exposes a secret value in plaintext", o.Name())
+			d.Context = rangePtr(exposures[0].SyntaxNode().Range())
+			diagnostics = append(diagnostics, d)
+		} else if len(sources) != 0 {
+			d := lintf(LintSecretOutput, hcl.DiagWarning, o.syntax.LabelRanges[0],
+				"output '%s' is computed from a secret value but is not marked as secret", o.Name())
+			d.Detail = d.Summary + "; wrap its value in secret(...) to make this explicit, or wrap the whole value " +
+				"in unsecret(...) if it is safe to expose"
+			d.Context = rangePtr(sources[0].SyntaxNode().Range())
+			diagnostics = append(diagnostics, d)
+		}
+	}
+	return diagnostics
+}
+
+func rangePtr(r hcl.Range) *hcl.Range {
+	return &r
+}
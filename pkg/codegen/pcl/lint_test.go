@@ -0,0 +1,144 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcl_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
+)
+
+// lintSource lints the given source and returns the rule and summary of each diagnostic.
+func lintSource(t *testing.T, source string) []string {
+	parser := syntax.NewParser()
+	err := parser.ParseFile(strings.NewReader(source), "main.pp")
+	require.NoError(t, err)
+	require.False(t, parser.Diagnostics.HasErrors(), "%v", parser.Diagnostics)
+
+	diags, err := pcl.Lint(parser.Files, pcl.Loader(schema.NewPluginLoader(utils.NewHost(testdataPath))))
+	require.NoError(t, err)
+
+	var result []string
+	for _, d := range diags {
+		severity := "warning"
+		if d.Severity == hcl.DiagError {
+			severity = "error"
+		}
+		result = append(result, severity+" "+string(pcl.DiagnosticLintRule(d))+": "+d.Summary)
+	}
+	return result
+}
+
+func TestLintUnusedVariables(t *testing.T) {
+	t.Parallel()
+
+	source := `
+config used string { }
+config unused string { }
+
+usedLocal = "${used}-pet"
+unusedLocal = 42
+
+resource pet "random:index/randomPet:RandomPet" {
+  prefix = usedLocal
+}
+`
+	assert.Equal(t, []string{
+		"warning unused-config: config variable 'unused' is not used",
+		"warning unused-local: local variable 'unusedLocal' is not used",
+	}, lintSource(t, source))
+}
+
+func TestLintUnknownResourceType(t *testing.T) {
+	t.Parallel()
+
+	source := `
+resource pet "random:index/randomPets:RandomPets" {
+  prefix = "pet"
+}
+
+resource other "not-a-package:index:Thing" { }
+`
+	assert.Equal(t, []string{
+		"error unknown-resource-type: unknown resource type 'random:index/randomPets:RandomPets'",
+		"error unknown-resource-type: unknown resource type 'not-a-package:index:Thing'",
+	}, lintSource(t, source))
+}
+
+func TestLintSecretOutputs(t *testing.T) {
+	t.Parallel()
+
+	source := `
+resource password "random:index/randomPassword:RandomPassword" {
+  length = 16
+}
+
+secretLocal = secret("hunter2")
+
+output passwordResult {
+  value = password.result
+}
+
+output interpolated {
+  value = "the password is ${secretLocal}"
+}
+
+output marked {
+  value = secret(password.result)
+}
+
+output exposed {
+  value = unsecret(password.result)
+}
+
+output length {
+  value = password.length
+}
+
+output partlyExposed {
+  value = "the password is ${unsecret(password.result)}"
+}
+
+output unsecretLength {
+  value = unsecret(password.length)
+}
+`
+	assert.Equal(t, []string{
+		"warning secret-output: output 'passwordResult' is computed from a secret value but is not marked as secret",
+		"warning secret-output: output 'interpolated' is computed from a secret value but is not marked as secret",
+		"warning secret-output: output 'partlyExposed' exposes a secret value in plaintext",
+	}, lintSource(t, source))
+}
+
+func TestLintBinderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	source := `
+output missing {
+  value = undefinedVariable
+}
+`
+	diags := lintSource(t, source)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0], "error : undefined variable undefinedVariable")
+}
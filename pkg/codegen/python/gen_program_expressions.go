@@ -207,6 +207,17 @@ var functionImports = map[string][]string{
 	"project":          {"pulumi"},
 	"cwd":              {"os"},
 	"mimeType":         {"mimetypes"},
+	"sha256":           {"hashlib"},
+	"sha512":           {"hashlib"},
+	"md5":              {"hashlib"},
+	"regexMatch":       {"re"},
+	"regexReplace":     {"re"},
+	"format":           {"re"},
+	"cidrHost":         {"ipaddress"},
+	"cidrSubnet":       {"ipaddress"},
+	"cidrNetmask":      {"ipaddress"},
+	"timestamp":        {"datetime"},
+	"timeAdd":          {"datetime", "re"},
 }
 
 func (g *generator) getFunctionImports(x *model.FunctionCallExpression) []string {
@@ -416,6 +427,71 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 		}
 	case "sha1":
 		g.Fgenf(w, "hashlib.sha1(%v.encode()).hexdigest()", expr.Args[0])
+	case "sha256":
+		g.Fgenf(w, "hashlib.sha256(%.16v.encode()).hexdigest()", expr.Args[0])
+	case "sha512":
+		g.Fgenf(w, "hashlib.sha512(%.16v.encode()).hexdigest()", expr.Args[0])
+	case "md5":
+		g.Fgenf(w, "hashlib.md5(%.16v.encode()).hexdigest()", expr.Args[0])
+	case "upper":
+		g.Fgenf(w, "%.16v.upper()", expr.Args[0])
+	case "lower":
+		g.Fgenf(w, "%.16v.lower()", expr.Args[0])
+	case "trimSpace":
+		g.Fgenf(w, "%.16v.strip()", expr.Args[0])
+	case "trimPrefix":
+		g.Fgenf(w, "(lambda value, prefix: value[len(prefix):] if value.startswith(prefix) else value)(%.v, %.v)",
+			expr.Args[0], expr.Args[1])
+	case "trimSuffix":
+		g.Fgenf(w,
+			"(lambda value, suffix: value[:-len(suffix)] if suffix and value.endswith(suffix) else value)(%.v, %.v)",
+			expr.Args[0], expr.Args[1])
+	case "startsWith":
+		g.Fgenf(w, "%.16v.startswith(%.v)", expr.Args[0], expr.Args[1])
+	case "endsWith":
+		g.Fgenf(w, "%.16v.endswith(%.v)", expr.Args[0], expr.Args[1])
+	case "replace":
+		g.Fgenf(w, "%.16v.replace(%.v, %.v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "regexMatch":
+		g.Fgenf(w, "bool(re.search(%.v, %.v))", expr.Args[0], expr.Args[1])
+	case "regexReplace":
+		// Assuming the existence of the following helper method
+		g.Fgenf(w, "regex_replace(%.v, %.v, %.v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "format":
+		// Assuming the existence of the following helper method
+		g.genCall(w, "format_string", expr.Args)
+	case "cidrHost":
+		g.Fgenf(w, "cidr_host(%.v, %.v)", expr.Args[0], expr.Args[1])
+	case "cidrSubnet":
+		g.Fgenf(w, "cidr_subnet(%.v, %.v, %.v)", expr.Args[0], expr.Args[1], expr.Args[2])
+	case "cidrNetmask":
+		g.Fgenf(w, "str(ipaddress.ip_network(%.v, strict=False).netmask)", expr.Args[0])
+	case "merge":
+		g.Fgen(w, "{")
+		for i, arg := range expr.Args {
+			if i > 0 {
+				g.Fgen(w, ", ")
+			}
+			g.Fgenf(w, "**%.16v", arg)
+		}
+		g.Fgen(w, "}")
+	case "flatten":
+		g.Fgenf(w, "[item for items in %.v for item in items]", expr.Args[0])
+	case "keys":
+		g.Fgenf(w, "sorted(%.v)", expr.Args[0])
+	case "values":
+		g.Fgenf(w, "[v for _, v in sorted(%.16v.items())]", expr.Args[0])
+	case "min", "max":
+		if len(expr.Args) == 1 {
+			g.Fgenf(w, "%v", expr.Args[0])
+		} else {
+			g.genCall(w, expr.Name, expr.Args)
+		}
+	case "timestamp":
+		g.Fgen(w, `datetime.datetime.now(datetime.timezone.utc).strftime("%Y-%m-%dT%H:%M:%SZ")`)
+	case "timeAdd":
+		// Assuming the existence of the following helper method
+		g.Fgenf(w, "time_add(%.v, %.v)", expr.Args[0], expr.Args[1])
 	case "project":
 		g.Fgen(w, "pulumi.get_project()")
 	case "stack":
@@ -431,6 +507,18 @@ func (g *generator) GenFunctionCallExpression(w io.Writer, expr *model.FunctionC
 	}
 }
 
+// genCall generates a call to the named function with the given arguments.
+func (g *generator) genCall(w io.Writer, name string, args []model.Expression) {
+	g.Fgenf(w, "%s(", name)
+	for i, arg := range args {
+		if i > 0 {
+			g.Fgen(w, ", ")
+		}
+		g.Fgenf(w, "%.v", arg)
+	}
+	g.Fgen(w, ")")
+}
+
 func (g *generator) GenIndexExpression(w io.Writer, expr *model.IndexExpression) {
 	g.Fgenf(w, "%.16v[%.v]", expr.Collection, expr.Key)
 }
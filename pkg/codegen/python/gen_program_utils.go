@@ -13,6 +13,30 @@ func getHelperMethodIfNeeded(functionName string, indent string) (string, bool)
 	fileData = open(path).read().encode()
 	hashedData = hashlib.sha256(fileData.encode()).digest()
 	return base64.b64encode(hashedData).decode()`, true
+	case "regexReplace":
+		return `def regex_replace(value, pattern, replacement):
+    return re.sub(pattern, re.sub(r"\$(\d+)", r"\\\1", replacement), value)`, true
+	case "format":
+		return `def format_string(spec, *args):
+    values = iter(args)
+    return re.sub(r"%[%sdv]", lambda verb: "%" if verb.group(0) == "%%" else str(next(values)), spec)`, true
+	case "cidrHost":
+		return `def cidr_host(prefix, hostnum):
+    return str(ipaddress.ip_network(prefix, strict=False)[int(hostnum)])`, true
+	case "cidrSubnet":
+		return `def cidr_subnet(prefix, newbits, netnum):
+    network = ipaddress.ip_network(prefix, strict=False)
+    length = network.prefixlen + int(newbits)
+    address = int(network.network_address) + (int(netnum) << (network.max_prefixlen - length))
+    return str(ipaddress.ip_network((address, length)))`, true
+	case "timeAdd":
+		return `def time_add(timestamp, duration):
+    units = {"h": 3600, "m": 60, "s": 1}
+    seconds = sum(float(n) * units[unit] for n, unit in re.findall(r"([\d.]+)([hms])", duration))
+    if duration.startswith("-"):
+        seconds = -seconds
+    time = datetime.datetime.fromisoformat(timestamp.replace("Z", "+00:00")) + datetime.timedelta(seconds=seconds)
+    return time.astimezone(datetime.timezone.utc).strftime("%Y-%m-%dT%H:%M:%SZ")`, true
 	case "notImplemented":
 		return fmt.Sprintf(`
 %sdef not_implemented(msg):
@@ -0,0 +1,288 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/blang/semver"
+	natomic "github.com/natefinch/atomic"
+	"github.com/segmentio/encoding/json"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// SchemaCache is an on-disk cache of package schemas that is shared across Pulumi invocations, so that providers do
+// not have to be launched just to get their schemas.
+//
+// The cache is content addressed: each distinct schema is stored once under blobs/, named by its SHA256 digest, and
+// each cached package is an entry under entries/ that points to the digest of its schema. Entries are keyed by the
+// name and version of the package.
+type SchemaCache struct {
+	dir string
+
+	hits   int64
+	misses int64
+}
+
+// SchemaCacheKey identifies a package in the schema cache.
+type SchemaCacheKey struct {
+	Name    string
+	Version semver.Version
+}
+
+func (k SchemaCacheKey) String() string {
+	return k.Name + "@" + k.Version.String()
+}
+
+// file returns the name of the entry file of the key.
+func (k SchemaCacheKey) file() string {
+	h := sha256.New()
+	// The fields are separated by NUL bytes, which cannot appear in package names or versions.
+	fmt.Fprintf(h, "%s\x00%s\x00", k.Name, k.Version)
+	return hex.EncodeToString(h.Sum(nil)) + ".json"
+}
+
+// SchemaCacheEntry is a package in the schema cache.
+type SchemaCacheEntry struct {
+	Name      string    `json:"name"`
+	Version   string    `json:"version"`
+	Digest    string    `json:"digest"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LastUsed is when the entry was last read from the cache, or created if it was never read.
+	LastUsed time.Time `json:"-"`
+
+	path string
+}
+
+// NewSchemaCache returns a schema cache that is stored in the given directory.
+func NewSchemaCache(dir string) *SchemaCache {
+	return &SchemaCache{dir: dir}
+}
+
+// DefaultSchemaCache returns the schema cache in the Pulumi home directory.
+func DefaultSchemaCache() (*SchemaCache, error) {
+	dir, err := workspace.GetPulumiPath(workspace.SchemaCacheDir)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchemaCache(dir), nil
+}
+
+// Dir returns the directory of the cache.
+func (c *SchemaCache) Dir() string {
+	return c.dir
+}
+
+func (c *SchemaCache) entriesDir() string {
+	return filepath.Join(c.dir, "entries")
+}
+
+func (c *SchemaCache) blobPath(digest string) string {
+	return filepath.Join(c.dir, "blobs", digest+".json")
+}
+
+// Get returns the cached schema of a package. Entries created before notBefore are ignored, which callers use to
+// invalidate schemas of plugins that have been reinstalled since.
+func (c *SchemaCache) Get(key SchemaCacheKey, notBefore time.Time) ([]byte, bool) {
+	schema, ok := c.get(key, notBefore)
+	if ok {
+		hits := atomic.AddInt64(&c.hits, 1)
+		logging.V(5).Infof("schema cache hit for %s (%d bytes; %d hits, %d misses)",
+			key, len(schema), hits, atomic.LoadInt64(&c.misses))
+	} else {
+		misses := atomic.AddInt64(&c.misses, 1)
+		logging.V(5).Infof("schema cache miss for %s (%d hits, %d misses)", key, atomic.LoadInt64(&c.hits), misses)
+	}
+	return schema, ok
+}
+
+func (c *SchemaCache) get(key SchemaCacheKey, notBefore time.Time) ([]byte, bool) {
+	path := filepath.Join(c.entriesDir(), key.file())
+	entry, err := c.readEntry(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logging.V(5).Infof("reading schema cache entry %s: %v", path, err)
+		}
+		return nil, false
+	}
+	if entry.Name != key.Name || entry.Version != key.Version.String() || entry.CreatedAt.Before(notBefore) {
+		return nil, false
+	}
+
+	schema, err := os.ReadFile(c.blobPath(entry.Digest))
+	if err != nil {
+		logging.V(5).Infof("reading schema cache blob %s: %v", entry.Digest, err)
+		return nil, false
+	}
+	// Check the digest, so that a corrupted blob is never used.
+	if sum := sha256.Sum256(schema); hex.EncodeToString(sum[:]) != entry.Digest {
+		logging.V(5).Infof("schema cache blob %s does not match its digest", entry.Digest)
+		return nil, false
+	}
+
+	// The modification time of the entry records when it was last used.
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		logging.V(5).Infof("recording use of schema cache entry %s: %v", path, err)
+	}
+	return schema, true
+}
+
+// Put adds the schema of a package to the cache, replacing any existing entry for the package.
+func (c *SchemaCache) Put(key SchemaCacheKey, schema []byte) error {
+	sum := sha256.Sum256(schema)
+	digest := hex.EncodeToString(sum[:])
+
+	if err := os.MkdirAll(filepath.Dir(c.blobPath(digest)), 0o700); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.entriesDir(), 0o700); err != nil {
+		return err
+	}
+
+	// Blobs are immutable, so a blob that already exists has the right content.
+	if _, err := os.Stat(c.blobPath(digest)); os.IsNotExist(err) {
+		if err := natomic.WriteFile(c.blobPath(digest), bytes.NewReader(schema)); err != nil {
+			return fmt.Errorf("write schema: %w", err)
+		}
+	}
+
+	entry, err := json.Marshal(SchemaCacheEntry{
+		Name:      key.Name,
+		Version:   key.Version.String(),
+		Digest:    digest,
+		Size:      int64(len(schema)),
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := natomic.WriteFile(filepath.Join(c.entriesDir(), key.file()), bytes.NewReader(entry)); err != nil {
+		return fmt.Errorf("write schema cache entry: %w", err)
+	}
+	logging.V(5).Infof("schema cache stored %s (%d bytes)", key, len(schema))
+	return nil
+}
+
+func (c *SchemaCache) readEntry(path string) (SchemaCacheEntry, error) {
+	var entry SchemaCacheEntry
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return entry, err
+	}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return entry, fmt.Errorf("read schema cache entry %s: %w", path, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return entry, err
+	}
+	entry.LastUsed, entry.path = info.ModTime(), path
+	return entry, nil
+}
+
+// List returns the entries of the cache, sorted by name and version.
+func (c *SchemaCache) List() ([]SchemaCacheEntry, error) {
+	files, err := os.ReadDir(c.entriesDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entries []SchemaCacheEntry
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		entry, err := c.readEntry(filepath.Join(c.entriesDir(), f.Name()))
+		if err != nil {
+			// Entries are written atomically, so an unreadable entry is left over from another version of Pulumi.
+			logging.V(5).Infof("skipping schema cache entry %s: %v", f.Name(), err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Name != entries[j].Name {
+			return entries[i].Name < entries[j].Name
+		}
+		vi, erri := semver.ParseTolerant(entries[i].Version)
+		vj, errj := semver.ParseTolerant(entries[j].Version)
+		if erri != nil || errj != nil || vi.EQ(vj) {
+			return entries[i].Version < entries[j].Version
+		}
+		return vi.LT(vj)
+	})
+	return entries, nil
+}
+
+// Remove removes entries from the cache, along with the schemas that are no longer used by any entry. It returns the
+// number of bytes freed.
+func (c *SchemaCache) Remove(entries []SchemaCacheEntry) (int64, error) {
+	var errs []error
+	for _, entry := range entries {
+		if err := os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	remaining, err := c.List()
+	if err != nil {
+		return 0, errors.Join(append(errs, err)...)
+	}
+	used := map[string]bool{}
+	for _, entry := range remaining {
+		used[entry.Digest] = true
+	}
+
+	blobs, err := os.ReadDir(filepath.Join(c.dir, "blobs"))
+	if err != nil && !os.IsNotExist(err) {
+		return 0, errors.Join(append(errs, err)...)
+	}
+	var freed int64
+	for _, blob := range blobs {
+		digest := strings.TrimSuffix(blob.Name(), ".json")
+		if used[digest] {
+			continue
+		}
+		info, err := blob.Info()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, "blobs", blob.Name())); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+			continue
+		}
+		freed += info.Size()
+	}
+	return freed, errors.Join(errs...)
+}
@@ -0,0 +1,268 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	codegenrpc "github.com/pulumi/pulumi/sdk/v3/proto/go/codegen"
+)
+
+func TestSchemaCache(t *testing.T) {
+	t.Parallel()
+
+	cache := NewSchemaCache(t.TempDir())
+	v1, v2 := semver.MustParse("1.0.0"), semver.MustParse("2.0.0")
+	schema := []byte(`{"name": "pkg"}`)
+
+	_, ok := cache.Get(SchemaCacheKey{Name: "pkg", Version: v1}, time.Time{})
+	assert.False(t, ok)
+
+	require.NoError(t, cache.Put(SchemaCacheKey{Name: "pkg", Version: v1}, schema))
+	require.NoError(t, cache.Put(SchemaCacheKey{Name: "pkg", Version: v2}, schema))
+	require.NoError(t, cache.Put(SchemaCacheKey{Name: "other", Version: v1}, []byte(`{"name": "other"}`)))
+
+	cached, ok := cache.Get(SchemaCacheKey{Name: "pkg", Version: v1}, time.Time{})
+	assert.True(t, ok)
+	assert.Equal(t, schema, cached)
+	cached, ok = cache.Get(SchemaCacheKey{Name: "other", Version: v1}, time.Time{})
+	assert.True(t, ok)
+	assert.Equal(t, []byte(`{"name": "other"}`), cached)
+
+	// Entries cached before the plugin was installed are ignored.
+	_, ok = cache.Get(SchemaCacheKey{Name: "pkg", Version: v1}, time.Now().Add(time.Hour))
+	assert.False(t, ok)
+	assert.Equal(t, int64(2), cache.hits)
+	assert.Equal(t, int64(2), cache.misses)
+
+	// Packages with the same schema share its blob.
+	blobs, err := os.ReadDir(filepath.Join(cache.Dir(), "blobs"))
+	require.NoError(t, err)
+	assert.Len(t, blobs, 2)
+
+	entries, err := cache.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "2.0.0", entries[2].Version)
+	assert.Equal(t, entries[1].Digest, entries[2].Digest)
+
+	// Removing one of the packages that share a schema keeps the schema.
+	freed, err := cache.Remove(entries[2:])
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), freed)
+	_, ok = cache.Get(SchemaCacheKey{Name: "pkg", Version: v1}, time.Time{})
+	assert.True(t, ok)
+
+	freed, err = cache.Remove(entries[:2])
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(schema)+len(`{"name": "other"}`)), freed)
+	entries, err = cache.List()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSchemaCacheIgnoresCorruptSchemas(t *testing.T) {
+	t.Parallel()
+
+	cache := NewSchemaCache(t.TempDir())
+	key := SchemaCacheKey{Name: "pkg", Version: semver.MustParse("1.0.0")}
+	require.NoError(t, cache.Put(key, []byte(`{"name": "pkg"}`)))
+
+	entries, err := cache.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.NoError(t, os.WriteFile(cache.blobPath(entries[0].Digest), []byte(`{"name": "pk`), 0o600))
+
+	_, ok := cache.Get(key, time.Time{})
+	assert.False(t, ok)
+}
+
+func TestPluginLoaderSchemaCacheIsOffForTestHosts(t *testing.T) {
+	t.Parallel()
+
+	loader := NewPluginLoader(deploytest.NewPluginHost(nil, nil, nil)).(*pluginLoader)
+	assert.Nil(t, loader.schemaCache)
+}
+
+// schemaCacheTestHost is a plugin host that has at most one installed plugin, and counts how often it is launched and
+// how often its schema is loaded.
+type schemaCacheTestHost struct {
+	plugin.Host
+
+	installed *workspace.PluginInfo
+	schema    string
+	launches  int
+	schemas   int
+}
+
+func (h *schemaCacheTestHost) ResolvePlugin(
+	kind workspace.PluginKind, name string, version *semver.Version,
+) (*workspace.PluginInfo, error) {
+	if h.installed == nil {
+		return nil, workspace.NewMissingError(kind, name, version, false)
+	}
+	return h.installed, nil
+}
+
+func (h *schemaCacheTestHost) Provider(pkg tokens.Package, version *semver.Version) (plugin.Provider, error) {
+	h.launches++
+	return &deploytest.Provider{
+		Version: semver.MustParse("1.0.0"),
+		GetSchemaF: func(int) ([]byte, error) {
+			h.schemas++
+			if h.schema != "" {
+				return []byte(h.schema), nil
+			}
+			return []byte(`{"name": "pkg", "version": "1.0.0"}`), nil
+		},
+	}, nil
+}
+
+func TestPluginLoaderUsesSchemaCache(t *testing.T) {
+	t.Setenv("PULUMI_DISABLE_AUTOMATIC_PLUGIN_ACQUISITION", "true")
+
+	version := semver.MustParse("1.0.0")
+	pluginDir := t.TempDir()
+	host := &schemaCacheTestHost{installed: &workspace.PluginInfo{
+		Name:       "pkg",
+		Kind:       workspace.ResourcePlugin,
+		Version:    &version,
+		Path:       pluginDir,
+		SchemaPath: filepath.Join(pluginDir, "schema-pkg.json"),
+	}}
+	cache := NewSchemaCache(t.TempDir())
+	newLoader := func() ReferenceLoader {
+		return &pluginLoader{host: host, entries: map[string]PackageReference{}, schemaCache: cache}
+	}
+
+	_, err := newLoader().LoadPackageReference("pkg", &version)
+	require.NoError(t, err)
+	assert.Equal(t, 1, host.launches)
+
+	// Without the schema next to the plugin, the schema comes from the cache.
+	require.NoError(t, os.Remove(host.installed.SchemaPath))
+	_, err = newLoader().LoadPackageReference("pkg", &version)
+	require.NoError(t, err)
+	assert.Equal(t, 1, host.launches)
+
+	// The plugin does not need to be installed to use its cached schema.
+	host.installed = nil
+	ref, err := newLoader().LoadPackageReference("pkg", &version)
+	require.NoError(t, err)
+	assert.Equal(t, "pkg", ref.Name())
+	assert.Equal(t, 1, host.launches)
+
+	_, err = newLoader().LoadPackageReference("pkg", ptr(semver.MustParse("2.0.0")))
+	var missing *workspace.MissingError
+	assert.ErrorAs(t, err, &missing)
+}
+
+func TestPluginLoaderCachesAmbientPluginSchemas(t *testing.T) {
+	t.Setenv("PULUMI_DISABLE_AUTOMATIC_PLUGIN_ACQUISITION", "true")
+
+	// Plugins found on $PATH have neither a version nor a schema file.
+	built := time.Now().Add(-time.Hour)
+	host := &schemaCacheTestHost{installed: &workspace.PluginInfo{
+		Name:       "pkg",
+		Kind:       workspace.ResourcePlugin,
+		Path:       t.TempDir(),
+		SchemaTime: built,
+	}}
+	cache := NewSchemaCache(t.TempDir())
+	newLoader := func() ReferenceLoader {
+		return &pluginLoader{host: host, entries: map[string]PackageReference{}, schemaCache: cache}
+	}
+
+	_, err := newLoader().LoadPackageReference("pkg", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, host.schemas)
+
+	// The plugin is started to find its version, but its schema comes from the cache.
+	_, err = newLoader().LoadPackageReference("pkg", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, host.launches)
+	assert.Equal(t, 1, host.schemas)
+
+	// If the version is requested, the plugin is not started at all.
+	_, err = newLoader().LoadPackageReference("pkg", ptr(semver.MustParse("1.0.0")))
+	require.NoError(t, err)
+	assert.Equal(t, 2, host.launches)
+	assert.Equal(t, 1, host.schemas)
+
+	// Rebuilding the plugin invalidates its cached schema.
+	host.installed.SchemaTime = time.Now().Add(time.Hour)
+	_, err = newLoader().LoadPackageReference("pkg", ptr(semver.MustParse("1.0.0")))
+	require.NoError(t, err)
+	assert.Equal(t, 2, host.schemas)
+}
+
+func TestLoaderServerServesSchemasAsLoaded(t *testing.T) {
+	t.Setenv("PULUMI_DISABLE_AUTOMATIC_PLUGIN_ACQUISITION", "true")
+
+	version := semver.MustParse("1.0.0")
+	pluginDir := t.TempDir()
+	host := &schemaCacheTestHost{
+		installed: &workspace.PluginInfo{
+			Name:       "pkg",
+			Kind:       workspace.ResourcePlugin,
+			Version:    &version,
+			Path:       pluginDir,
+			SchemaPath: filepath.Join(pluginDir, "schema-pkg.json"),
+		},
+		schema: `{"name":"pkg",  "version":"1.0.0"}`,
+	}
+	server := NewLoaderServer(&pluginLoader{
+		host: host, entries: map[string]PackageReference{}, schemaCache: NewSchemaCache(t.TempDir()),
+	})
+
+	// The schema is served as the plugin returned it, rather than bound and marshaled again.
+	resp, err := server.GetSchema(context.Background(), &codegenrpc.GetSchemaRequest{Package: "pkg"})
+	require.NoError(t, err)
+	assert.Equal(t, host.schema, string(resp.Schema))
+
+	resp, err = server.GetSchema(context.Background(), &codegenrpc.GetSchemaRequest{Package: "pkg", Version: "1.0.0"})
+	require.NoError(t, err)
+	assert.Equal(t, host.schema, string(resp.Schema))
+	assert.Equal(t, 1, host.schemas)
+
+	// A schema without a version is given the version of the plugin.
+	host.installed = &workspace.PluginInfo{
+		Name:       "pkg",
+		Kind:       workspace.ResourcePlugin,
+		Version:    ptr(semver.MustParse("2.0.0")),
+		Path:       pluginDir,
+		SchemaTime: time.Now(),
+	}
+	host.schema = `{"name":"pkg"}`
+	resp, err = server.GetSchema(context.Background(), &codegenrpc.GetSchemaRequest{Package: "pkg"})
+	require.NoError(t, err)
+	assert.Contains(t, string(resp.Schema), `"version":"2.0.0"`)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
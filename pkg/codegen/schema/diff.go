@@ -0,0 +1,590 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/cgstrings"
+)
+
+// The kinds of package members that a Change may apply to.
+const (
+	PackageChange  = "package"
+	ResourceChange = "resource"
+	FunctionChange = "function"
+	TypeChange     = "type"
+)
+
+// Change describes a single difference between two versions of a package's schema.
+type Change struct {
+	// Kind is the kind of the member that changed: "package", "resource", "function" or "type".
+	Kind string `json:"kind"`
+	// Token is the token of the resource, function or type that changed. It is empty for changes to the package.
+	Token string `json:"token,omitempty"`
+	// Property is the path of the property that changed within the member, e.g. "inputs.name", if any.
+	Property string `json:"property,omitempty"`
+	// Breaking is true if the change may break programs written against, or stacks deployed with, the old version.
+	Breaking bool `json:"breaking"`
+	// Message describes the change.
+	Message string `json:"message"`
+	// Impact describes how the change affects the SDK of each language, keyed by language name. Only languages that
+	// are affected in a way that isn't obvious from the message are listed.
+	Impact map[string]string `json:"impact,omitempty"`
+}
+
+// Diff returns the changes between the old and new versions of a package, sorted by kind, token and property.
+func Diff(oldPkg, newPkg *Package) []Change {
+	var d differ
+	if oldPkg.Name != newPkg.Name {
+		d.add(Change{
+			Kind:     PackageChange,
+			Breaking: true,
+			Message:  fmt.Sprintf("package renamed from %q to %q", oldPkg.Name, newPkg.Name),
+		})
+	}
+
+	d.diffResources(packageResources(oldPkg), packageResources(newPkg))
+	d.diffFunctions(oldPkg.Functions, newPkg.Functions)
+	d.diffTypes(oldPkg.Types, newPkg.Types)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		ci, cj := d.changes[i], d.changes[j]
+		if ci.Kind != cj.Kind {
+			return ci.Kind < cj.Kind
+		}
+		if ci.Token != cj.Token {
+			return ci.Token < cj.Token
+		}
+		return ci.Property < cj.Property
+	})
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(c Change) {
+	d.changes = append(d.changes, c)
+}
+
+func packageResources(pkg *Package) []*Resource {
+	resources := pkg.Resources
+	if pkg.Provider != nil {
+		resources = append([]*Resource{pkg.Provider}, resources...)
+	}
+	return resources
+}
+
+func (d *differ) diffResources(oldResources, newResources []*Resource) {
+	newByToken := make(map[string]*Resource, len(newResources))
+	for _, r := range newResources {
+		newByToken[r.Token] = r
+	}
+
+	// A new resource that aliases an old resource's token replaces it.
+	renamedTo := map[string]string{}
+	for _, r := range newResources {
+		for _, alias := range r.Aliases {
+			if alias.Type != nil {
+				renamedTo[*alias.Type] = r.Token
+			}
+		}
+	}
+
+	oldByToken := make(map[string]*Resource, len(oldResources))
+	for _, oldRes := range oldResources {
+		oldByToken[oldRes.Token] = oldRes
+
+		newRes, ok := newByToken[oldRes.Token]
+		if !ok {
+			message := "resource removed"
+			if to, ok := renamedTo[oldRes.Token]; ok && to != oldRes.Token {
+				message = fmt.Sprintf("resource renamed to %q; existing stacks are migrated by its alias", to)
+			}
+			d.add(Change{Kind: ResourceChange, Token: oldRes.Token, Breaking: true, Message: message})
+			continue
+		}
+
+		if oldRes.DeprecationMessage == "" && newRes.DeprecationMessage != "" {
+			d.add(Change{Kind: ResourceChange, Token: oldRes.Token, Message: "resource deprecated"})
+		}
+
+		name := tokenName(oldRes.Token)
+		d.diffProperties(ResourceChange, oldRes.Token, "inputs", oldRes.InputProperties, newRes.InputProperties,
+			inputUsage, goStruct{name + "Args", goInput})
+		d.diffProperties(ResourceChange, oldRes.Token, "outputs", oldRes.Properties, newRes.Properties,
+			outputUsage, goStruct{name, goOutput})
+	}
+
+	for _, newRes := range newResources {
+		if _, ok := oldByToken[newRes.Token]; !ok {
+			d.add(Change{Kind: ResourceChange, Token: newRes.Token, Message: "resource added"})
+		}
+	}
+}
+
+func (d *differ) diffFunctions(oldFunctions, newFunctions []*Function) {
+	newByToken := make(map[string]*Function, len(newFunctions))
+	for _, f := range newFunctions {
+		newByToken[f.Token] = f
+	}
+
+	oldByToken := make(map[string]*Function, len(oldFunctions))
+	for _, oldFn := range oldFunctions {
+		oldByToken[oldFn.Token] = oldFn
+
+		newFn, ok := newByToken[oldFn.Token]
+		if !ok {
+			d.add(Change{Kind: FunctionChange, Token: oldFn.Token, Breaking: true, Message: "function removed"})
+			continue
+		}
+
+		if oldFn.DeprecationMessage == "" && newFn.DeprecationMessage != "" {
+			d.add(Change{Kind: FunctionChange, Token: oldFn.Token, Message: "function deprecated"})
+		}
+		if oldFn.MultiArgumentInputs != newFn.MultiArgumentInputs {
+			d.add(Change{
+				Kind:     FunctionChange,
+				Token:    oldFn.Token,
+				Breaking: true,
+				Message:  "function inputs changed between a single argument and multiple arguments",
+			})
+		}
+
+		name := tokenName(oldFn.Token)
+		d.diffProperties(FunctionChange, oldFn.Token, "inputs",
+			objectProperties(oldFn.Inputs), objectProperties(newFn.Inputs),
+			inputUsage, goStruct{name + "Args", goPlain})
+
+		if oldFn.Outputs != nil && newFn.Outputs != nil {
+			d.diffProperties(FunctionChange, oldFn.Token, "outputs", oldFn.Outputs.Properties, newFn.Outputs.Properties,
+				outputUsage, goStruct{name + "Result", goPlain})
+			continue
+		}
+		if oldType, newType := diffTypeString(oldFn.ReturnType), diffTypeString(newFn.ReturnType); oldType != newType {
+			d.add(Change{
+				Kind:     FunctionChange,
+				Token:    oldFn.Token,
+				Breaking: true,
+				Message:  fmt.Sprintf("return type changed from %s to %s", describeType(oldType), describeType(newType)),
+			})
+		}
+	}
+
+	for _, newFn := range newFunctions {
+		if _, ok := oldByToken[newFn.Token]; !ok {
+			d.add(Change{Kind: FunctionChange, Token: newFn.Token, Message: "function added"})
+		}
+	}
+}
+
+// namedTypes returns the object and enum types in the given list, keyed by token. Only the plain shapes of object
+// types are returned.
+func namedTypes(types []Type) map[string]Type {
+	named := map[string]Type{}
+	for _, t := range types {
+		switch t := t.(type) {
+		case *ObjectType:
+			if t.IsPlainShape() {
+				named[t.Token] = t
+			}
+		case *EnumType:
+			named[t.Token] = t
+		}
+	}
+	return named
+}
+
+func (d *differ) diffTypes(oldTypes, newTypes []Type) {
+	oldByToken, newByToken := namedTypes(oldTypes), namedTypes(newTypes)
+
+	for _, token := range sortedKeys(oldByToken) {
+		oldType, newType := oldByToken[token], newByToken[token]
+		switch oldType := oldType.(type) {
+		case *ObjectType:
+			newObj, ok := newType.(*ObjectType)
+			if !ok {
+				d.add(Change{Kind: TypeChange, Token: token, Breaking: true, Message: removedTypeMessage("object", newType)})
+				continue
+			}
+			name := tokenName(token)
+			d.diffProperties(TypeChange, token, "properties", oldType.Properties, newObj.Properties,
+				inputUsage|outputUsage, goStruct{name + "Args", goInput}, goStruct{name, goPlain})
+		case *EnumType:
+			newEnum, ok := newType.(*EnumType)
+			if !ok {
+				d.add(Change{Kind: TypeChange, Token: token, Breaking: true, Message: removedTypeMessage("enum", newType)})
+				continue
+			}
+			d.diffEnum(oldType, newEnum)
+		}
+	}
+
+	for _, token := range sortedKeys(newByToken) {
+		if _, ok := oldByToken[token]; !ok {
+			d.add(Change{Kind: TypeChange, Token: token, Message: "type added"})
+		}
+	}
+}
+
+func removedTypeMessage(kind string, newType Type) string {
+	switch newType.(type) {
+	case *ObjectType:
+		return kind + " type changed to an object type"
+	case *EnumType:
+		return kind + " type changed to an enum type"
+	default:
+		return kind + " type removed"
+	}
+}
+
+func (d *differ) diffEnum(oldEnum, newEnum *EnumType) {
+	if oldType, newType := diffTypeString(oldEnum.ElementType), diffTypeString(newEnum.ElementType); oldType != newType {
+		d.add(Change{
+			Kind:     TypeChange,
+			Token:    oldEnum.Token,
+			Breaking: true,
+			Message:  fmt.Sprintf("enum type changed from %s to %s", describeType(oldType), describeType(newType)),
+		})
+	}
+
+	enumValues := func(t *EnumType) map[string]*Enum {
+		values := make(map[string]*Enum, len(t.Elements))
+		for _, e := range t.Elements {
+			values[fmt.Sprint(e.Value)] = e
+		}
+		return values
+	}
+	oldValues, newValues := enumValues(oldEnum), enumValues(newEnum)
+
+	for _, value := range sortedKeys(oldValues) {
+		newValue, ok := newValues[value]
+		switch {
+		case !ok:
+			d.add(Change{
+				Kind:     TypeChange,
+				Token:    oldEnum.Token,
+				Breaking: true,
+				Message:  fmt.Sprintf("enum value %q removed", value),
+			})
+		case oldValues[value].DeprecationMessage == "" && newValue.DeprecationMessage != "":
+			d.add(Change{Kind: TypeChange, Token: oldEnum.Token, Message: fmt.Sprintf("enum value %q deprecated", value)})
+		}
+	}
+	for _, value := range sortedKeys(newValues) {
+		if _, ok := oldValues[value]; !ok {
+			d.add(Change{Kind: TypeChange, Token: oldEnum.Token, Message: fmt.Sprintf("enum value %q added", value)})
+		}
+	}
+}
+
+// propertyUsage records whether a list of properties is passed to the provider, returned from it, or both.
+type propertyUsage int
+
+const (
+	inputUsage propertyUsage = 1 << iota
+	outputUsage
+)
+
+// goShape is the shape of the Go SDK type that holds a property.
+type goShape int
+
+const (
+	goPlain goShape = iota
+	goInput
+	goOutput
+)
+
+// goStruct is a Go SDK struct that holds a list of properties.
+type goStruct struct {
+	name  string
+	shape goShape
+}
+
+func objectProperties(t *ObjectType) []*Property {
+	if t == nil {
+		return nil
+	}
+	return t.Properties
+}
+
+func (d *differ) diffProperties(kind, token, section string, oldProps, newProps []*Property, usage propertyUsage,
+	structs ...goStruct,
+) {
+	newByName := make(map[string]*Property, len(newProps))
+	for _, p := range newProps {
+		newByName[p.Name] = p
+	}
+
+	oldByName := make(map[string]*Property, len(oldProps))
+	for _, oldProp := range oldProps {
+		oldByName[oldProp.Name] = oldProp
+		change := Change{Kind: kind, Token: token, Property: section + "." + oldProp.Name}
+
+		newProp, ok := newByName[oldProp.Name]
+		if !ok {
+			change.Breaking, change.Message = true, "property removed"
+			d.add(change)
+			continue
+		}
+
+		if oldType, newType := diffTypeString(oldProp.Type), diffTypeString(newProp.Type); oldType != newType {
+			change.Breaking = true
+			change.Message = fmt.Sprintf("type changed from %s to %s", describeType(oldType), describeType(newType))
+			change.Impact = goFieldImpact(oldProp, newProp, structs)
+			d.add(change)
+			continue
+		}
+
+		switch oldRequired, newRequired := oldProp.IsRequired(), newProp.IsRequired(); {
+		case !oldRequired && newRequired && usage&inputUsage != 0:
+			change.Breaking, change.Message = true, "property is now required"
+			change.Impact = requiredImpact(oldProp.Name, tokenName(token), usage)
+			d.add(change)
+		case !oldRequired && newRequired:
+			// Outputs that become required are compatible in every language but Go, whose field types change.
+			change.Message = "property is now always set"
+			change.Impact = goFieldImpact(oldProp, newProp, structs)
+			change.Breaking = change.Impact != nil
+			d.add(change)
+		case oldRequired && !newRequired && usage&outputUsage != 0:
+			change.Breaking, change.Message = true, "property is no longer always set"
+			change.Impact = optionalImpact(oldProp, newProp, tokenName(token), structs)
+			d.add(change)
+		case oldRequired && !newRequired:
+			change.Message = "property is no longer required"
+			change.Impact = goFieldImpact(oldProp, newProp, structs)
+			d.add(change)
+		}
+
+		if oldProp.DeprecationMessage == "" && newProp.DeprecationMessage != "" {
+			d.add(Change{Kind: kind, Token: token, Property: section + "." + oldProp.Name, Message: "property deprecated"})
+		}
+	}
+
+	for _, newProp := range newProps {
+		if _, ok := oldByName[newProp.Name]; ok {
+			continue
+		}
+		change := Change{Kind: kind, Token: token, Property: section + "." + newProp.Name, Message: "property added"}
+		if newProp.IsRequired() && usage&inputUsage != 0 {
+			change.Breaking, change.Message = true, "required property added"
+			change.Impact = requiredImpact(newProp.Name, tokenName(token), usage)
+		}
+		d.add(change)
+	}
+}
+
+// requiredImpact describes the effect of a property of the given member becoming required on each language.
+func requiredImpact(property, member string, usage propertyUsage) map[string]string {
+	args := member + "Args"
+	if usage&outputUsage != 0 {
+		// Object types use the same name for their input and output shapes in most languages.
+		args = member
+	}
+	return map[string]string{
+		"nodejs": fmt.Sprintf("`%s.%s` is no longer optional", args, property),
+		"python": fmt.Sprintf("the `%s` argument becomes required", property),
+		"go": fmt.Sprintf("`%s.%s` must be set, or the program fails at runtime",
+			member+"Args", cgstrings.UppercaseFirst(property)),
+		"dotnet": fmt.Sprintf("`%s.%s` must be set, or the program fails at runtime",
+			member+"Args", cgstrings.UppercaseFirst(property)),
+	}
+}
+
+// optionalImpact describes the effect of an output property of the given member becoming optional on each language.
+func optionalImpact(oldProp, newProp *Property, member string, structs []goStruct) map[string]string {
+	impact := map[string]string{
+		"nodejs": fmt.Sprintf("`%s.%s` may be `undefined`", member, oldProp.Name),
+		"dotnet": fmt.Sprintf("`%s.%s` becomes nullable", member, cgstrings.UppercaseFirst(oldProp.Name)),
+	}
+	for lang, desc := range goFieldImpact(oldProp, newProp, structs) {
+		impact[lang] = desc
+	}
+	return impact
+}
+
+// goFieldImpact describes how the types of the Go SDK struct fields that hold a property change.
+func goFieldImpact(oldProp, newProp *Property, structs []goStruct) map[string]string {
+	var fields []string
+	for _, s := range structs {
+		oldType, newType := goTypeName(oldProp.Type, s.shape), goTypeName(newProp.Type, s.shape)
+		if oldType != newType {
+			fields = append(fields, fmt.Sprintf("`%s.%s` changes type from `%s` to `%s`",
+				s.name, cgstrings.UppercaseFirst(oldProp.Name), oldType, newType))
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return map[string]string{"go": strings.Join(fields, "; ")}
+}
+
+// diffTypeString returns a string that identifies a type regardless of whether it is optional or accepts outputs,
+// which are compared separately.
+func diffTypeString(t Type) string {
+	switch t := t.(type) {
+	case nil:
+		return ""
+	case *InputType:
+		return diffTypeString(t.ElementType)
+	case *OptionalType:
+		return diffTypeString(t.ElementType)
+	case *ArrayType:
+		return "Array<" + diffTypeString(t.ElementType) + ">"
+	case *MapType:
+		return "Map<" + diffTypeString(t.ElementType) + ">"
+	case *ObjectType:
+		return t.Token
+	case *UnionType:
+		elements := make([]string, len(t.ElementTypes))
+		for i, e := range t.ElementTypes {
+			elements[i] = diffTypeString(e)
+		}
+		return "Union<" + strings.Join(elements, ", ") + ">"
+	default:
+		return t.String()
+	}
+}
+
+func describeType(t string) string {
+	if t == "" {
+		return "nothing"
+	}
+	return "`" + t + "`"
+}
+
+// tokenName returns the name of the member with the given token, as the SDKs name it.
+func tokenName(token string) string {
+	return cgstrings.UppercaseFirst(token[strings.LastIndex(token, ":")+1:])
+}
+
+// goTypeName approximates the name of the Go SDK type that holds a value of the given type. Language-specific
+// overrides from the schema are not taken into account, as the name is only used to describe changes.
+func goTypeName(t Type, shape goShape) string {
+	optional := false
+	for {
+		if input, ok := t.(*InputType); ok {
+			t = input.ElementType
+		} else if opt, ok := t.(*OptionalType); ok {
+			optional, t = true, opt.ElementType
+		} else {
+			break
+		}
+	}
+
+	ptr := false
+	switch t := t.(type) {
+	case *ObjectType, *EnumType:
+		ptr = optional
+	default:
+		ptr = optional && t != AnyType && t != JSONType && IsPrimitiveType(t)
+	}
+
+	if shape == goPlain {
+		if ptr {
+			return "*" + goPlainTypeName(t)
+		}
+		return goPlainTypeName(t)
+	}
+
+	name := goElementTypeName(t)
+	if ptr {
+		name += "Ptr"
+	}
+	if shape == goInput {
+		return name + "Input"
+	}
+	if name == "pulumi." {
+		return "pulumi.AnyOutput"
+	}
+	return name + "Output"
+}
+
+func goPlainTypeName(t Type) string {
+	switch t := t.(type) {
+	case *InputType:
+		return goPlainTypeName(t.ElementType)
+	case *OptionalType:
+		return goPlainTypeName(t.ElementType)
+	case *ArrayType:
+		return "[]" + goPlainTypeName(t.ElementType)
+	case *MapType:
+		return "map[string]" + goPlainTypeName(t.ElementType)
+	case *ObjectType:
+		return tokenName(t.Token)
+	case *EnumType:
+		return tokenName(t.Token)
+	case *ResourceType:
+		return "*" + tokenName(t.Token)
+	}
+	switch t {
+	case BoolType:
+		return "bool"
+	case IntType:
+		return "int"
+	case NumberType:
+		return "float64"
+	case StringType:
+		return "string"
+	case ArchiveType:
+		return "pulumi.Archive"
+	case AssetType:
+		return "pulumi.AssetOrArchive"
+	default:
+		return "interface{}"
+	}
+}
+
+// goElementTypeName returns the name of the Go SDK type that holds a value of the given type, without its Input or
+// Output suffix. Values of any type use the pulumi.Input and pulumi.AnyOutput types, so their name is "pulumi.".
+func goElementTypeName(t Type) string {
+	switch t := t.(type) {
+	case *InputType:
+		return goElementTypeName(t.ElementType)
+	case *OptionalType:
+		return goElementTypeName(t.ElementType)
+	case *ArrayType:
+		return goElementTypeName(t.ElementType) + "Array"
+	case *MapType:
+		return goElementTypeName(t.ElementType) + "Map"
+	case *ObjectType:
+		return tokenName(t.Token)
+	case *EnumType:
+		return tokenName(t.Token)
+	case *ResourceType:
+		return tokenName(t.Token)
+	}
+	switch t {
+	case BoolType:
+		return "pulumi.Bool"
+	case IntType:
+		return "pulumi.Int"
+	case NumberType:
+		return "pulumi.Float64"
+	case StringType:
+		return "pulumi.String"
+	case ArchiveType:
+		return "pulumi.Archive"
+	case AssetType:
+		return "pulumi.AssetOrArchive"
+	default:
+		return "pulumi."
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bindDiffSpec(t *testing.T, spec PackageSpec) *Package {
+	t.Helper()
+
+	pkg, diags, err := BindSpec(spec, nil)
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+	return pkg
+}
+
+func stringProp() PropertySpec {
+	return PropertySpec{TypeSpec: TypeSpec{Type: "string"}}
+}
+
+func TestDiffResources(t *testing.T) {
+	t.Parallel()
+
+	oldToken := "test:index:Old"
+	oldPkg := bindDiffSpec(t, PackageSpec{
+		Name:    "test",
+		Version: "1.0.0",
+		Resources: map[string]ResourceSpec{
+			"test:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  stringProp(),
+						"size": stringProp(),
+						"url":  stringProp(),
+					},
+					Required: []string{"arn", "size"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":    stringProp(),
+					"region": stringProp(),
+					"size":   stringProp(),
+				},
+			},
+			oldToken: {},
+		},
+	})
+	newPkg := bindDiffSpec(t, PackageSpec{
+		Name:    "test",
+		Version: "2.0.0",
+		Resources: map[string]ResourceSpec{
+			"test:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  stringProp(),
+						"size": {TypeSpec: TypeSpec{Type: "integer"}},
+						"tags": stringProp(),
+					},
+					Required: []string{"size"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":    stringProp(),
+					"name":   stringProp(),
+					"region": stringProp(),
+					"size":   {TypeSpec: TypeSpec{Type: "integer"}},
+				},
+				RequiredInputs: []string{"name", "region"},
+			},
+			"test:index:New": {
+				Aliases: []AliasSpec{{Type: &oldToken}},
+			},
+		},
+	})
+
+	changes := Diff(oldPkg, newPkg)
+	assert.Equal(t, []Change{
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "inputs.name",
+			Breaking: true,
+			Message:  "required property added",
+			Impact: map[string]string{
+				"nodejs": "`BucketArgs.name` is no longer optional",
+				"python": "the `name` argument becomes required",
+				"go":     "`BucketArgs.Name` must be set, or the program fails at runtime",
+				"dotnet": "`BucketArgs.Name` must be set, or the program fails at runtime",
+			},
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "inputs.region",
+			Breaking: true,
+			Message:  "property is now required",
+			Impact: map[string]string{
+				"nodejs": "`BucketArgs.region` is no longer optional",
+				"python": "the `region` argument becomes required",
+				"go":     "`BucketArgs.Region` must be set, or the program fails at runtime",
+				"dotnet": "`BucketArgs.Region` must be set, or the program fails at runtime",
+			},
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "inputs.size",
+			Breaking: true,
+			Message:  "type changed from `string` to `integer`",
+			Impact: map[string]string{
+				"go": "`BucketArgs.Size` changes type from `pulumi.StringPtrInput` to `pulumi.IntPtrInput`",
+			},
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "outputs.arn",
+			Breaking: true,
+			Message:  "property is no longer always set",
+			Impact: map[string]string{
+				"nodejs": "`Bucket.arn` may be `undefined`",
+				"dotnet": "`Bucket.Arn` becomes nullable",
+				"go":     "`Bucket.Arn` changes type from `pulumi.StringOutput` to `pulumi.StringPtrOutput`",
+			},
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "outputs.size",
+			Breaking: true,
+			Message:  "type changed from `string` to `integer`",
+			Impact: map[string]string{
+				"go": "`Bucket.Size` changes type from `pulumi.StringOutput` to `pulumi.IntOutput`",
+			},
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "outputs.tags",
+			Message:  "property added",
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Bucket",
+			Property: "outputs.url",
+			Breaking: true,
+			Message:  "property removed",
+		},
+		{
+			Kind:    ResourceChange,
+			Token:   "test:index:New",
+			Message: "resource added",
+		},
+		{
+			Kind:     ResourceChange,
+			Token:    "test:index:Old",
+			Breaking: true,
+			Message:  `resource renamed to "test:index:New"; existing stacks are migrated by its alias`,
+		},
+	}, changes)
+}
+
+func TestDiffFunctions(t *testing.T) {
+	t.Parallel()
+
+	oldPkg := bindDiffSpec(t, PackageSpec{
+		Name: "test",
+		Functions: map[string]FunctionSpec{
+			"test:index:getBucket": {
+				Inputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{"name": stringProp()},
+				},
+				Outputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{"arn": stringProp()},
+					Required:   []string{"arn"},
+				},
+			},
+			"test:index:getGone": {},
+		},
+	})
+	newPkg := bindDiffSpec(t, PackageSpec{
+		Name: "test",
+		Functions: map[string]FunctionSpec{
+			"test:index:getBucket": {
+				DeprecationMessage: "use getBuckets",
+				Inputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{"name": stringProp()},
+					Required:   []string{"name"},
+				},
+				Outputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{"arn": stringProp()},
+				},
+			},
+		},
+	})
+
+	changes := Diff(oldPkg, newPkg)
+	require.Len(t, changes, 4)
+
+	assert.Equal(t, Change{Kind: FunctionChange, Token: "test:index:getBucket", Message: "function deprecated"},
+		changes[0])
+	assert.Equal(t, "inputs.name", changes[1].Property)
+	assert.True(t, changes[1].Breaking)
+	assert.Equal(t, "outputs.arn", changes[2].Property)
+	assert.True(t, changes[2].Breaking)
+	assert.Equal(t, "`GetBucketResult.Arn` changes type from `string` to `*string`", changes[2].Impact["go"])
+	assert.Equal(t, Change{
+		Kind:     FunctionChange,
+		Token:    "test:index:getGone",
+		Breaking: true,
+		Message:  "function removed",
+	}, changes[3])
+}
+
+func TestDiffTypes(t *testing.T) {
+	t.Parallel()
+
+	oldPkg := bindDiffSpec(t, PackageSpec{
+		Name: "test",
+		Types: map[string]ComplexTypeSpec{
+			"test:index:Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []EnumValueSpec{{Value: "red"}, {Value: "green"}},
+			},
+			"test:index:Website": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"index": stringProp(),
+						"error": stringProp(),
+					},
+					Required: []string{"error"},
+				},
+			},
+		},
+	})
+	newPkg := bindDiffSpec(t, PackageSpec{
+		Name: "test",
+		Types: map[string]ComplexTypeSpec{
+			"test:index:Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []EnumValueSpec{{Value: "red"}, {Value: "blue"}},
+			},
+			"test:index:Website": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"index": stringProp(),
+						"error": stringProp(),
+					},
+				},
+			},
+		},
+	})
+
+	changes := Diff(oldPkg, newPkg)
+	assert.Equal(t, []Change{
+		{Kind: TypeChange, Token: "test:index:Color", Breaking: true, Message: `enum value "green" removed`},
+		{Kind: TypeChange, Token: "test:index:Color", Message: `enum value "blue" added`},
+		{
+			Kind:     TypeChange,
+			Token:    "test:index:Website",
+			Property: "properties.error",
+			Breaking: true,
+			Message:  "property is no longer always set",
+			Impact: map[string]string{
+				"nodejs": "`Website.error` may be `undefined`",
+				"dotnet": "`Website.Error` becomes nullable",
+				"go": "`WebsiteArgs.Error` changes type from `pulumi.StringInput` to `pulumi.StringPtrInput`; " +
+					"`Website.Error` changes type from `string` to `*string`",
+			},
+		},
+	}, changes)
+}
+
+func TestDiffUnchanged(t *testing.T) {
+	t.Parallel()
+
+	spec := PackageSpec{
+		Name: "test",
+		Resources: map[string]ResourceSpec{
+			"test:index:Bucket": {
+				InputProperties: map[string]PropertySpec{"acl": stringProp()},
+			},
+		},
+	}
+	assert.Empty(t, Diff(bindDiffSpec(t, spec), bindDiffSpec(t, spec)))
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
@@ -54,6 +55,9 @@ type pluginLoader struct {
 	host    plugin.Host
 	entries map[string]PackageReference
 
+	// schemaCache is the on-disk cache of schemas shared across Pulumi invocations, or nil if it is disabled.
+	schemaCache *SchemaCache
+
 	cacheOptions pluginLoaderCacheOptions
 }
 
@@ -69,18 +73,46 @@ type pluginLoaderCacheOptions struct {
 
 func NewPluginLoader(host plugin.Host) ReferenceLoader {
 	return &pluginLoader{
-		host:    host,
-		entries: map[string]PackageReference{},
+		host:        host,
+		entries:     map[string]PackageReference{},
+		schemaCache: defaultSchemaCache(host),
 	}
 }
 
 func newPluginLoaderWithOptions(host plugin.Host, cacheOptions pluginLoaderCacheOptions) ReferenceLoader {
-	return &pluginLoader{
+	l := &pluginLoader{
 		host:    host,
 		entries: map[string]PackageReference{},
 
 		cacheOptions: cacheOptions,
 	}
+	if !cacheOptions.disableFileCache {
+		l.schemaCache = defaultSchemaCache(host)
+	}
+	return l
+}
+
+// schemaCacheHost is implemented by plugin hosts that opt out of the on-disk schema cache, such as the hosts of unit
+// tests, whose fake plugins must neither be served schemas cached by real ones nor have theirs cached.
+type schemaCacheHost interface {
+	DisableSchemaCache() bool
+}
+
+// defaultSchemaCache returns the schema cache in the Pulumi home directory, or nil if the cache is disabled for the
+// host or the home directory cannot be determined.
+func defaultSchemaCache(host plugin.Host) *SchemaCache {
+	if env.DisableSchemaCache.Value() {
+		return nil
+	}
+	if h, ok := host.(schemaCacheHost); ok && h.DisableSchemaCache() {
+		return nil
+	}
+	cache, err := DefaultSchemaCache()
+	if err != nil {
+		logging.V(5).Infof("disabling schema cache: %v", err)
+		return nil
+	}
+	return cache
 }
 
 func (l *pluginLoader) getPackage(key string) (PackageReference, bool) {
@@ -185,6 +217,28 @@ func (l *pluginLoader) LoadPackageReference(pkg string, version *semver.Version)
 	return l.setPackage(key, p), nil
 }
 
+// schemaBytesLoader is implemented by loaders that can return the JSON schema of a package as it was loaded or cached,
+// without binding it.
+type schemaBytesLoader interface {
+	loadPackageSchemaBytes(pkg string, version *semver.Version) ([]byte, *semver.Version, error)
+}
+
+// loadPackageSchemaBytes returns the JSON schema of a package as it was loaded or cached, along with the version of
+// the package if it is known.
+func (l *pluginLoader) loadPackageSchemaBytes(pkg string, version *semver.Version) ([]byte, *semver.Version, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	schemaBytes, version, err := l.loadSchemaBytes(pkg, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if schemaIsEmpty(schemaBytes) {
+		return nil, nil, getSchemaNotImplemented{}
+	}
+	return schemaBytes, version, nil
+}
+
 func LoadPackageReference(loader Loader, pkg string, version *semver.Version) (PackageReference, error) {
 	var ref PackageReference
 	var err error
@@ -236,13 +290,22 @@ func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]b
 
 	pluginInfo, err := l.host.ResolvePlugin(workspace.ResourcePlugin, pkg, version)
 	if err != nil {
+		var missingError *workspace.MissingError
+		isMissing := errors.As(err, &missingError)
+
+		// The schema of a plugin that is not installed may still be cached, in which case the plugin is not needed.
+		if isMissing && version != nil && l.schemaCache != nil {
+			if schemaBytes, ok := l.schemaCache.Get(SchemaCacheKey{Name: pkg, Version: *version}, time.Time{}); ok {
+				return schemaBytes, version, nil
+			}
+		}
+
 		// Try and install the plugin if it was missing and try again, unless auto plugin installs are turned off.
 		if env.DisableAutomaticPluginAcquisition.Value() {
 			return nil, nil, err
 		}
 
-		var missingError *workspace.MissingError
-		if errors.As(err, &missingError) {
+		if isMissing {
 			spec := workspace.PluginSpec{
 				Kind:    workspace.ResourcePlugin,
 				Name:    pkg,
@@ -272,14 +335,38 @@ func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]b
 		version = pluginInfo.Version
 	}
 
+	// The schema file next to an installed plugin is checked first, as it can be mapped into memory. The on-disk
+	// schema cache covers the plugins that have no schema file, such as those found on $PATH, and plugins that are
+	// not installed.
 	if pluginInfo.SchemaPath != "" && version != nil {
 		schemaBytes, ok := l.loadCachedSchemaBytes(pkg, pluginInfo.SchemaPath, pluginInfo.SchemaTime)
 		if ok {
 			return schemaBytes, nil, nil
 		}
 	}
+	if schemaBytes, ok := l.getSchemaCache(pkg, version, pluginInfo); ok {
+		return schemaBytes, version, nil
+	}
+
+	provider, err := l.host.Provider(tokens.Package(pkg), version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error loading schema from plugin: %w", err)
+	}
+	contract.Assertf(provider != nil, "unexpected nil provider for %s@%v", pkg, version)
+
+	if version == nil {
+		info, _ := provider.GetPluginInfo() // nonfatal error
+		version = info.Version
+
+		// The version of a plugin found on $PATH is only known once it has been started, but its schema may still be
+		// cached.
+		if schemaBytes, ok := l.getSchemaCache(pkg, version, pluginInfo); ok {
+			return schemaBytes, version, nil
+		}
+	}
 
-	schemaBytes, provider, err := l.loadPluginSchemaBytes(pkg, version)
+	schemaFormatVersion := 0
+	schemaBytes, err := provider.GetSchema(schemaFormatVersion)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Error loading schema from plugin: %w", err)
 	}
@@ -291,14 +378,44 @@ func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]b
 		}
 	}
 
-	if version == nil {
-		info, _ := provider.GetPluginInfo() // nonfatal error
-		version = info.Version
+	if key, _, ok := l.schemaCacheKey(pkg, version, pluginInfo); ok && !schemaIsEmpty(schemaBytes) {
+		// A schema that cannot be cached is still usable, so failing to cache it is not an error.
+		if err := l.schemaCache.Put(key, schemaBytes); err != nil {
+			logging.V(5).Infof("caching schema of %s: %v", key, err)
+		}
 	}
 
 	return schemaBytes, version, nil
 }
 
+// schemaCacheKey returns the key of a plugin's schema in the on-disk schema cache, along with the time before which
+// cached schemas of the plugin are stale. Plugins are often rebuilt during development without changing their
+// version, so schemas that were cached before the plugin's binary was last modified are stale. The schemas of plugins
+// whose version or modification time is unknown are not cached.
+func (l *pluginLoader) schemaCacheKey(
+	pkg string, version *semver.Version, pluginInfo *workspace.PluginInfo,
+) (SchemaCacheKey, time.Time, bool) {
+	if l.schemaCache == nil || version == nil {
+		return SchemaCacheKey{}, time.Time{}, false
+	}
+	// A plugin without a schema file or a modification time could have been rebuilt since its schema was cached.
+	if pluginInfo.SchemaPath == "" && pluginInfo.SchemaTime.IsZero() {
+		return SchemaCacheKey{}, time.Time{}, false
+	}
+	return SchemaCacheKey{Name: pkg, Version: *version}, pluginInfo.SchemaTime, true
+}
+
+// getSchemaCache returns the schema of a plugin from the on-disk schema cache, if it is cached and not stale.
+func (l *pluginLoader) getSchemaCache(
+	pkg string, version *semver.Version, pluginInfo *workspace.PluginInfo,
+) ([]byte, bool) {
+	key, notBefore, ok := l.schemaCacheKey(pkg, version, pluginInfo)
+	if !ok {
+		return nil, false
+	}
+	return l.schemaCache.Get(key, notBefore)
+}
+
 func (l *pluginLoader) loadPluginSchemaBytes(pkg string, version *semver.Version) ([]byte, plugin.Provider, error) {
 	provider, err := l.host.Provider(tokens.Package(pkg), version)
 	if err != nil {
@@ -32,6 +32,9 @@ type loaderServer struct {
 	loader ReferenceLoader
 }
 
+// NewLoaderServer returns a server that serves schemas from the given loader. Language hosts load schemas through
+// the server, so they share the loader's caches. Schemas loaded by plugin loaders are served as they were loaded or
+// cached, without binding them and marshaling them again.
 func NewLoaderServer(loader ReferenceLoader) codegenrpc.LoaderServer {
 	return &loaderServer{loader: loader}
 }
@@ -52,6 +55,18 @@ func (m *loaderServer) GetSchema(ctx context.Context,
 		version = &v
 	}
 
+	data, ok, err := m.loadSchemaBytes(req.Package, version)
+	if err != nil {
+		logging.V(7).Infof("%s failed: %v", label, err)
+		return nil, err
+	}
+	if ok {
+		logging.V(7).Infof("%s success: data=#%d", label, len(data))
+		return &codegenrpc.GetSchemaResponse{
+			Schema: data,
+		}, nil
+	}
+
 	pkg, err := m.loader.LoadPackage(req.Package, version)
 	if err != nil {
 		logging.V(7).Infof("%s failed: %v", label, err)
@@ -65,7 +80,7 @@ func (m *loaderServer) GetSchema(ctx context.Context,
 		return nil, err
 	}
 
-	data, err := json.Marshal(spec)
+	data, err = json.Marshal(spec)
 	if err != nil {
 		logging.V(7).Infof("%s failed: %v", label, err)
 		return nil, err
@@ -77,6 +92,34 @@ func (m *loaderServer) GetSchema(ctx context.Context,
 	}, nil
 }
 
+// loadSchemaBytes returns the schema of a package as the loader loaded or cached it, if the loader supports this. The
+// loader amends schemas with the version of the package if they do not have one, or if theirs is older; such
+// schemas are not returned, as clients can only amend them with the version they requested.
+func (m *loaderServer) loadSchemaBytes(pkg string, version *semver.Version) ([]byte, bool, error) {
+	loader, ok := m.loader.(schemaBytesLoader)
+	if !ok || pkg == "pulumi" {
+		return nil, false, nil
+	}
+
+	data, loadedVersion, err := loader.loadPackageSchemaBytes(pkg, version)
+	if err != nil {
+		return nil, false, err
+	}
+	if version == nil && loadedVersion != nil {
+		var info struct {
+			Version string `json:"version"`
+		}
+		if _, err := json.Parse(data, &info, json.ZeroCopy); err != nil {
+			return nil, false, err
+		}
+		v, err := semver.Make(info.Version)
+		if err != nil || v.LT(*loadedVersion) {
+			return nil, false, nil
+		}
+	}
+	return data, true, nil
+}
+
 func LoaderRegistration(l codegenrpc.LoaderServer) func(*grpc.Server) {
 	return func(srv *grpc.Server) {
 		codegenrpc.RegisterLoaderServer(srv, l)
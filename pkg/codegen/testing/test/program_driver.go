@@ -325,6 +325,10 @@ var PulumiPulumiProgramTests = []ProgramTest{
 		Skip:        allProgLanguages.Except("nodejs"),
 		SkipCompile: allProgLanguages,
 	},
+	{
+		Directory:   "stdlib-functions",
+		Description: "Tests the standard library of intrinsic functions",
+	},
 	{
 		Directory:   "single-or-none",
 		Description: "Tests using the singleOrNone function",
@@ -214,7 +214,7 @@ func newDeployment(ctx *Context, info *deploymentContext, opts *deploymentOption
 			localPolicyPackPaths, dryRun, ctx.BackendClient)
 	} else {
 		_, defaultProviderInfo, pluginErr := installPlugins(cancelCtx, proj, pwd, main, target, plugctx,
-			false /*returnInstallErrors*/)
+			false /*returnInstallErrors*/, opts.FrozenPluginLock)
 		if pluginErr != nil {
 			return nil, pluginErr
 		}
@@ -319,6 +319,7 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions,
 			DisableResourceReferences: deployment.Options.DisableResourceReferences,
 			DisableOutputValues:       deployment.Options.DisableOutputValues,
 			GeneratePlan:              deployment.Options.UpdateOptions.GeneratePlan,
+			ReplacementGuard:          deployment.Options.UpdateOptions.ReplacementGuard,
 		}
 		newPlan, walkError = deployment.Deployment.Execute(ctx, opts, preview)
 		close(done)
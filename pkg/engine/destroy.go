@@ -55,6 +55,9 @@ func Destroy(
 		return nil, nil, err
 	}
 
+	// Destroying a stack deletes its resources intentionally, so the replacement guard does not apply.
+	opts.ReplacementGuard = nil
+
 	return update(ctx, info, &deploymentOptions{
 		UpdateOptions: opts,
 		SourceFunc:    newDestroySource,
@@ -77,6 +80,16 @@ func newDestroySource(
 		return nil, err
 	}
 
+	// Pick up the download URLs and checksums of any plugins recorded in the project's plugin lock.
+	lock, err := loadPluginLock(plugctx.Root)
+	if err != nil {
+		return nil, err
+	}
+	plugins, deviations := plugins.Pin(lock)
+	if err := checkPluginLockDeviations(plugctx.Diag, deviations, opts.FrozenPluginLock); err != nil {
+		return nil, err
+	}
+
 	// Like Update, if we're missing plugins, attempt to download the missing plugins.
 
 	if err := ensurePluginsAreInstalled(ctx, plugctx.Diag, plugins.Deduplicate(),
@@ -45,7 +45,8 @@ type Event struct {
 type EventPayload interface {
 	StdoutEventPayload | DiagEventPayload | PreludeEventPayload | SummaryEventPayload |
 		ResourcePreEventPayload | ResourceOutputsEventPayload | ResourceOperationFailedPayload |
-		PolicyViolationEventPayload | PolicyRemediationEventPayload | PolicyLoadEventPayload
+		PolicyViolationEventPayload | PolicyRemediationEventPayload | PolicyLoadEventPayload |
+		ResourceHookEventPayload
 }
 
 func NewCancelEvent() Event {
@@ -75,6 +76,8 @@ func NewEvent[T EventPayload](payload T) Event {
 		typ = PolicyRemediationEvent
 	case PolicyLoadEventPayload:
 		typ = PolicyLoadEvent
+	case ResourceHookEventPayload:
+		typ = ResourceHookEvent
 	default:
 		contract.Failf("unknown event type %v", typ)
 	}
@@ -99,6 +102,7 @@ const (
 	PolicyViolationEvent    EventType = "policy-violation"
 	PolicyRemediationEvent  EventType = "policy-remediation"
 	PolicyLoadEvent         EventType = "policy-load"
+	ResourceHookEvent       EventType = "resource-hook"
 )
 
 func (e Event) Payload() interface{} {
@@ -154,6 +158,15 @@ type PolicyRemediationEventPayload struct {
 // PolicyLoadEventPayload is the payload for an event with type `policy-load`.
 type PolicyLoadEventPayload struct{}
 
+// ResourceHookEventPayload is the payload for an event with type `resource-hook`.
+type ResourceHookEventPayload struct {
+	URN   resource.URN
+	Op    display.StepOp             // the operation of the step that the hook ran around.
+	Hook  string                     // the name of the hook.
+	Event resource.ResourceHookEvent // the event on which the hook ran.
+	Error string                     // the error that the hook failed with, if any.
+}
+
 type StdoutEventPayload struct {
 	Message string
 	Color   colors.Colorization
@@ -525,6 +538,21 @@ func (e *eventEmitter) policyRemediationEvent(urn resource.URN, t plugin.Remedia
 	}))
 }
 
+func (e *eventEmitter) resourceHookEvent(step deploy.Step, hook string, event resource.ResourceHookEvent, err error) {
+	contract.Requiref(e != nil, "e", "!= nil")
+
+	payload := ResourceHookEventPayload{
+		URN:   step.URN(),
+		Op:    step.Op(),
+		Hook:  hook,
+		Event: event,
+	}
+	if err != nil {
+		payload.Error = logging.FilterString(err.Error())
+	}
+	e.sendEvent(NewEvent(payload))
+}
+
 func (e *eventEmitter) PolicyLoadEvent() {
 	contract.Requiref(e != nil, "e", "!= nil")
 
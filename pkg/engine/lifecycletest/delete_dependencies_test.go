@@ -0,0 +1,208 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycletest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// deleteRecorder records the order in which a provider deletes resources.
+type deleteRecorder struct {
+	lock    sync.Mutex
+	deleted []string
+}
+
+func (r *deleteRecorder) reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deleted = nil
+}
+
+func (r *deleteRecorder) index(name string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, n := range r.deleted {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *deleteRecorder) loaders() []*deploytest.ProviderLoader {
+	return []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					if oldInputs.DeepEquals(newInputs) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					return plugin.DiffResult{
+						Changes:     plugin.DiffSome,
+						ReplaceKeys: []resource.PropertyKey{"foo"},
+					}, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, oldInputs, oldOutputs resource.PropertyMap,
+					timeout float64,
+				) (resource.Status, error) {
+					r.lock.Lock()
+					defer r.lock.Unlock()
+					r.deleted = append(r.deleted, urn.Name())
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+}
+
+// Test that resources declaring delete dependencies are deleted before those dependencies when destroying,
+// removing and replacing resources.
+func TestDeleteDependencies(t *testing.T) {
+	t.Parallel()
+
+	var recorder deleteRecorder
+
+	register := true
+	inputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if !register {
+			return nil
+		}
+
+		// "first" has no relationship to "second" other than having to be deleted before it. Registering "second"
+		// last means that without the constraint both would be deleted in the same antichain.
+		urnSecond, _, _, _, err := monitor.RegisterResource("pkgA:m:typA", "second", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		require.NoError(t, err)
+		_, _, _, _, err = monitor.RegisterResource("pkgA:m:typA", "first", true, deploytest.ResourceOptions{
+			Inputs:             inputs,
+			DeleteDependencies: []resource.URN{urnSecond},
+		})
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, recorder.loaders()...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+	project := p.GetProject()
+
+	assertOrder := func() {
+		first, second := recorder.index("first"), recorder.index("second")
+		require.NotEqual(t, -1, first)
+		require.NotEqual(t, -1, second)
+		assert.Less(t, first, second)
+	}
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	// The constraint is persisted in the state.
+	require.Len(t, snap.Resources, 3)
+	assert.Equal(t, "first", snap.Resources[2].URN.Name())
+	assert.Equal(t, []resource.URN{snap.Resources[1].URN}, snap.Resources[2].DeleteDependencies)
+
+	// Replace both resources. The replacements are created before the old resources are deleted, so those deletes
+	// are scheduled together.
+	inputs["foo"] = resource.NewStringProperty("baz")
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assertOrder()
+
+	// Remove both resources from the program.
+	recorder.reset()
+	register = false
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assertOrder()
+
+	// Destroy both resources.
+	recorder.reset()
+	register = true
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	recorder.reset()
+	_, err = TestOp(Destroy).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assertOrder()
+}
+
+// Test that delete dependencies that conflict with the dependencies of the resources are ignored with a warning.
+func TestDeleteDependenciesConflict(t *testing.T) {
+	t.Parallel()
+
+	var recorder deleteRecorder
+
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urnFirst := resource.NewURN("test", "test", "", "pkgA:m:typA", "first")
+		urnSecond, _, _, _, err := monitor.RegisterResource("pkgA:m:typA", "second", true, deploytest.ResourceOptions{
+			DeleteDependencies: []resource.URN{urnFirst},
+		})
+		require.NoError(t, err)
+
+		// "first" depends on "second", so it must be deleted first, which contradicts the constraint above.
+		_, _, _, _, err = monitor.RegisterResource("pkgA:m:typA", "first", true, deploytest.ResourceOptions{
+			Dependencies: []resource.URN{urnSecond},
+		})
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, recorder.loaders()...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+	project := p.GetProject()
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	warned := false
+	_, err = TestOp(Destroy).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			for _, e := range events {
+				if e.Type == DiagEvent {
+					payload := e.Payload().(DiagEventPayload)
+					if payload.Severity == diag.Warning && strings.Contains(payload.Message, "deletion ordering") {
+						warned = true
+					}
+				}
+			}
+			return err
+		})
+	require.NoError(t, err)
+	assert.True(t, warned)
+
+	// Dependencies win over the declared constraint.
+	assert.Less(t, recorder.index("first"), recorder.index("second"))
+}
@@ -639,6 +639,7 @@ func TestImportIgnoreChanges(t *testing.T) {
 
 	assert.Len(t, snap.Resources, 2)
 	assert.Equal(t, resource.NewStringProperty("bar"), snap.Resources[1].Outputs["foo"])
+	assert.Equal(t, []string{"foo"}, snap.Resources[1].IgnoreChanges)
 }
 
 func TestImportPlanExistingImport(t *testing.T) {
@@ -1128,3 +1129,101 @@ func TestImportRemoteComponent(t *testing.T) {
 	// Ensure it's marked as custom.
 	assert.True(t, custom.Custom, "expected custom resource to be marked as custom")
 }
+
+func TestImportInferDependencies(t *testing.T) {
+	t.Parallel()
+
+	// Each resource reads back the properties for its ID.
+	resources := map[resource.ID]plugin.ReadResult{
+		"vpc-1": {
+			Inputs:  resource.PropertyMap{"region": resource.NewStringProperty("west")},
+			Outputs: resource.PropertyMap{"region": resource.NewStringProperty("west")},
+		},
+		"subnet-1": {
+			Inputs: resource.PropertyMap{
+				"vpcId":  resource.NewStringProperty("vpc-1"),
+				"region": resource.NewStringProperty("west"),
+			},
+			Outputs: resource.PropertyMap{"vpcId": resource.NewStringProperty("vpc-1")},
+		},
+		"role-1": {
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{"arn": resource.NewStringProperty("arn:role-1")},
+		},
+		"role-2": {
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{"arn": resource.NewStringProperty("arn:role-1")},
+		},
+		"function-1": {
+			Inputs: resource.PropertyMap{
+				"roles": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("arn:role-1"),
+				}),
+				"subnet": resource.NewStringProperty("subnet-1"),
+			},
+			Outputs: resource.PropertyMap{},
+		},
+	}
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: diffImportResource,
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return resources[id], resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+	programF := deploytest.NewLanguageRuntimeF(nil)
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+	project := p.GetProject()
+
+	// Import the VPC first, so that the subnet's reference to it is to an existing resource.
+	snap, err := ImportOp([]deploy.Import{
+		{Type: "pkgA:m:typA", Name: "vpc", ID: "vpc-1"},
+	}).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	// Import the rest in an order that differs from their dependencies. The function's role matches both roles, so
+	// only the explicit dependency on role2 chooses between them.
+	snap, err = ImportOp([]deploy.Import{
+		{
+			Type: "pkgA:m:typA", Name: "function", ID: "function-1",
+			Dependencies: []resource.URN{p.NewURN("pkgA:m:typA", "role2", "")},
+		},
+		{Type: "pkgA:m:typA", Name: "subnet", ID: "subnet-1"},
+		{Type: "pkgA:m:typA", Name: "role1", ID: "role-1"},
+		{Type: "pkgA:m:typA", Name: "role2", ID: "role-2"},
+	}).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+
+	index := map[resource.URN]int{}
+	for i, r := range snap.Resources {
+		index[r.URN] = i
+	}
+	vpc := snap.Resources[index[p.NewURN("pkgA:m:typA", "vpc", "")]]
+	subnet := snap.Resources[index[p.NewURN("pkgA:m:typA", "subnet", "")]]
+	function := snap.Resources[index[p.NewURN("pkgA:m:typA", "function", "")]]
+
+	// The subnet refers to the VPC by ID, but only shares its region.
+	assert.Equal(t, []resource.URN{vpc.URN}, subnet.Dependencies)
+	assert.Equal(t, []resource.URN{p.NewURN("pkgA:m:typA", "role2", ""), subnet.URN}, function.Dependencies)
+	assert.Less(t, index[subnet.URN], index[function.URN])
+	assert.Less(t, index[p.NewURN("pkgA:m:typA", "role2", "")], index[function.URN])
+
+	// Without an explicit dependency the ambiguous role is not recorded.
+	snap, err = ImportOp([]deploy.Import{
+		{Type: "pkgA:m:typA", Name: "function2", ID: "function-1"},
+	}).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	function2 := snap.Resources[len(snap.Resources)-1]
+	assert.Equal(t, p.NewURN("pkgA:m:typA", "function2", ""), function2.URN)
+	assert.Equal(t, []resource.URN{subnet.URN}, function2.Dependencies)
+}
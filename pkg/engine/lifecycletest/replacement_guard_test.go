@@ -0,0 +1,231 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycletest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// guardDiagnostics returns the messages of the diagnostics of the given severity that mention the replacement guard.
+func guardDiagnostics(events []Event, severity diag.Severity) []string {
+	var messages []string
+	for _, e := range events {
+		if e.Type != DiagEvent {
+			continue
+		}
+		payload := e.Payload().(DiagEventPayload)
+		if payload.Severity == severity && strings.Contains(payload.Message, "replacementGuard") {
+			messages = append(messages, payload.Message)
+		}
+	}
+	return messages
+}
+
+// Test that the replacement of a guarded resource is reported by a preview, fails an update and succeeds once
+// allowed.
+func TestReplacementGuard(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					if oldInputs.DeepEquals(newInputs) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					return plugin.DiffResult{
+						Changes:     plugin.DiffSome,
+						ReplaceKeys: []resource.PropertyKey{"foo"},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	inputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	urnA := resource.NewURN("test", "test", "", "pkgA:m:typA", "resA")
+	p := &TestPlan{
+		Options: TestUpdateOptions{
+			HostF: hostF,
+			UpdateOptions: UpdateOptions{
+				ReplacementGuard: deploy.NewReplacementGuard(nil, []string{string(urnA)}, nil, nil),
+			},
+		},
+	}
+	project := p.GetProject()
+
+	// Creating a guarded resource is allowed.
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	id := snap.Resources[1].ID
+
+	// Previewing the replacement succeeds but warns about the guarded resource.
+	inputs["foo"] = resource.NewStringProperty("baz")
+	_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			warnings := guardDiagnostics(events, diag.Warning)
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], string(urnA))
+			return err
+		})
+	require.NoError(t, err)
+
+	// Updating fails without replacing the resource.
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			assert.NotEmpty(t, guardDiagnostics(events, diag.Error))
+			return err
+		})
+	assert.Error(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, id, snap.Resources[1].ID)
+
+	// Once allowed, the resource is replaced.
+	p.Options.ReplacementGuard = p.Options.ReplacementGuard.Allow(urnA)
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.NotEqual(t, id, snap.Resources[1].ID)
+}
+
+// Test that the deletion of a resource of a guarded type that is removed from the program fails an update, and that
+// destroying the stack is not guarded.
+func TestReplacementGuardDelete(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	register := true
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if !register {
+			return nil
+		}
+		_, _, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{
+			HostF: hostF,
+			UpdateOptions: UpdateOptions{
+				ReplacementGuard: deploy.NewReplacementGuard([]string{"pkgA:m:typA"}, nil, nil, nil),
+			},
+		},
+	}
+	project := p.GetProject()
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+
+	// Removing the resource from the program fails to delete it.
+	register = false
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Error(t, err)
+	require.Len(t, snap.Resources, 2)
+
+	// Destroying the stack deletes it.
+	snap, err = TestOp(Destroy).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Len(t, snap.Resources, 0)
+}
+
+// Test that resources are guarded by their tags, and that an update blocked on several guarded resources names the
+// operations that were blocked.
+func TestReplacementGuardTags(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	register := true
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if !register {
+			return nil
+		}
+		for name, tags := range map[string]resource.PropertyValue{
+			"resA": resource.NewObjectProperty(resource.PropertyMap{"protected": resource.NewStringProperty("yes")}),
+			"resB": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("protected")}),
+			"resC": resource.NewObjectProperty(resource.PropertyMap{"owner": resource.NewStringProperty("me")}),
+		} {
+			_, _, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, deploytest.ResourceOptions{
+				Inputs: resource.PropertyMap{"tags": tags},
+			})
+			require.NoError(t, err)
+		}
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{
+			HostF: hostF,
+			UpdateOptions: UpdateOptions{
+				ReplacementGuard: deploy.NewReplacementGuard(nil, nil, map[string]string{"protected": ""}, nil),
+			},
+		},
+	}
+	project := p.GetProject()
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 4)
+
+	// Removing the resources from the program fails to delete the tagged ones.
+	register = false
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+			errors := guardDiagnostics(events, diag.Error)
+			require.NotEmpty(t, errors)
+			assert.Contains(t, errors[0], "the delete of 2 resources is blocked by the project's replacementGuard")
+			return err
+		})
+	assert.Error(t, err)
+	require.Len(t, snap.Resources, 4)
+}
@@ -0,0 +1,387 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycletest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+func ResourceHookFunction(
+	f func(req *pulumirpc.ResourceHookRequest) error,
+) func([]byte) (proto.Message, error) {
+	return func(request []byte) (proto.Message, error) {
+		var req pulumirpc.ResourceHookRequest
+		if err := proto.Unmarshal(request, &req); err != nil {
+			return nil, fmt.Errorf("unmarshaling request: %w", err)
+		}
+		var response pulumirpc.ResourceHookResponse
+		if err := f(&req); err != nil {
+			response.Error = err.Error()
+		}
+		return &response, nil
+	}
+}
+
+// resourceHookEvents returns the resource hook events that the engine emitted.
+func resourceHookEvents(events []Event) []ResourceHookEventPayload {
+	var payloads []ResourceHookEventPayload
+	for _, e := range events {
+		if e.Type == ResourceHookEvent {
+			payloads = append(payloads, e.Payload().(ResourceHookEventPayload))
+		}
+	}
+	return payloads
+}
+
+// Test that the engine runs resource hooks around the operations on a resource.
+func TestResourceHooks(t *testing.T) {
+	t.Parallel()
+
+	replace := false
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+				) (plugin.DiffResult, error) {
+					if oldInputs.DeepEquals(newInputs) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					if replace {
+						return plugin.DiffResult{
+							Changes:             plugin.DiffSome,
+							ReplaceKeys:         []resource.PropertyKey{"foo"},
+							DeleteBeforeReplace: true,
+						}, nil
+					}
+					return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+				},
+			}, nil
+		}),
+	}
+
+	var lock sync.Mutex
+	var ran []string
+	inputs := resource.PropertyMap{"foo": resource.NewNumberProperty(1)}
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		callbacks, err := deploytest.NewCallbacksServer()
+		require.NoError(t, err)
+		defer func() { require.NoError(t, callbacks.Close()) }()
+
+		callback, err := callbacks.Allocate(ResourceHookFunction(func(req *pulumirpc.ResourceHookRequest) error {
+			lock.Lock()
+			defer lock.Unlock()
+			ran = append(ran, req.Event)
+
+			switch req.Event {
+			case "after_create", "after_update", "after_replace":
+				assert.NotEmpty(t, req.Id)
+				assert.NotNil(t, req.NewOutputs)
+			case "before_delete", "after_delete":
+				assert.NotEmpty(t, req.Id)
+				assert.NotNil(t, req.OldOutputs)
+				assert.Nil(t, req.NewInputs)
+			}
+			return nil
+		}))
+		require.NoError(t, err)
+
+		_, _, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+			Hooks: []*pulumirpc.ResourceHook{{
+				Name: "record",
+				Events: []string{
+					"before_create", "after_create", "before_update", "after_update",
+					"before_delete", "after_delete", "before_replace", "after_replace",
+				},
+				Callback: callback,
+			}},
+		})
+		require.NoError(t, err)
+		return nil
+	})
+	hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+	p := &TestPlan{
+		Options: TestUpdateOptions{HostF: hostF},
+	}
+	project := p.GetProject()
+
+	var hookEvents []ResourceHookEventPayload
+	validate := func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+		hookEvents = resourceHookEvents(events)
+		return err
+	}
+	run := func(snap *deploy.Snapshot) *deploy.Snapshot {
+		ran = nil
+		snap, err := TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, validate)
+		require.NoError(t, err)
+		return snap
+	}
+
+	// Create the resource.
+	snap := run(nil)
+	assert.Equal(t, []string{"before_create", "after_create"}, ran)
+	require.Len(t, hookEvents, 2)
+	assert.Equal(t, ResourceHookEventPayload{
+		URN:   p.NewURN("pkgA:m:typA", "resA", ""),
+		Op:    deploy.OpCreate,
+		Hook:  "record",
+		Event: resource.ResourceHookAfterCreate,
+	}, hookEvents[1])
+
+	// No hooks run if nothing changes.
+	snap = run(snap)
+	assert.Empty(t, ran)
+
+	// Update the resource.
+	inputs["foo"] = resource.NewNumberProperty(2)
+	snap = run(snap)
+	assert.Equal(t, []string{"before_update", "after_update"}, ran)
+
+	// Replace the resource, deleting the old resource before its replacement is created.
+	replace = true
+	inputs["foo"] = resource.NewNumberProperty(3)
+	snap = run(snap)
+	assert.Equal(t, []string{"before_delete", "after_delete", "before_replace", "after_replace"}, ran)
+
+	// Hooks are not run during previews.
+	ran = nil
+	inputs["foo"] = resource.NewNumberProperty(4)
+	_, err := TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Empty(t, ran)
+}
+
+// Test that a failing hook fails its step, unless the hook is marked to warn on failure.
+func TestResourceHookFailure(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		event         string
+		warnOnFailure bool
+		expectCreated bool
+		expectError   bool
+	}{
+		{name: "before", event: "before_create", expectError: true},
+		{name: "before warn", event: "before_create", warnOnFailure: true, expectCreated: true},
+		{name: "after", event: "after_create", expectCreated: true, expectError: true},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			created := false
+			loaders := []*deploytest.ProviderLoader{
+				deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+					return &deploytest.Provider{
+						CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+							preview bool,
+						) (resource.ID, resource.PropertyMap, resource.Status, error) {
+							created = true
+							return "created-id", news, resource.StatusOK, nil
+						},
+					}, nil
+				}),
+			}
+
+			programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+				callbacks, err := deploytest.NewCallbacksServer()
+				require.NoError(t, err)
+				defer func() { require.NoError(t, callbacks.Close()) }()
+
+				callback, err := callbacks.Allocate(ResourceHookFunction(func(*pulumirpc.ResourceHookRequest) error {
+					return errors.New("boom")
+				}))
+				require.NoError(t, err)
+
+				_, _, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+					Hooks: []*pulumirpc.ResourceHook{{
+						Name:          "fail",
+						Events:        []string{c.event},
+						Callback:      callback,
+						WarnOnFailure: c.warnOnFailure,
+					}},
+				})
+				if c.expectError && c.event == "before_create" {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+				return nil
+			})
+			hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+			p := &TestPlan{
+				Options: TestUpdateOptions{HostF: hostF},
+			}
+
+			var hookEvents []ResourceHookEventPayload
+			validate := func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+				hookEvents = resourceHookEvents(events)
+				return err
+			}
+			snap, err := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+				validate)
+			if c.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, c.expectCreated, created)
+
+			require.Len(t, hookEvents, 1)
+			assert.Equal(t, "boom", hookEvents[0].Error)
+
+			// A resource whose after hook failed has still been created, so it must be in the snapshot.
+			found := false
+			if snap != nil {
+				for _, r := range snap.Resources {
+					found = found || r.URN.Name() == "resA"
+				}
+			}
+			assert.Equal(t, c.expectCreated, found)
+		})
+	}
+}
+
+// Test that the delete hooks of a resource run when it is replaced create-before-delete, which deletes it after the
+// program has finished registering resources, and that no hooks run when the program no longer registers the resource
+// or it is destroyed.
+func TestResourceHooksOnDelete(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		op       TestOp   // the operation that deletes the resource.
+		register bool     // true if the program still registers the resource.
+		inputs   int      // the value of the resource's input if it is registered.
+		expected []string // the hooks that the operation runs.
+	}{
+		{name: "removed", op: TestOp(Update)},
+		{name: "destroy", op: TestOp(Destroy), register: true, inputs: 1},
+		{
+			name: "create-before-delete replace", op: TestOp(Update), register: true, inputs: 2,
+			expected: []string{"before_replace", "after_replace", "before_delete", "after_delete"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			deleted := 0
+			loaders := []*deploytest.ProviderLoader{
+				deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+					return &deploytest.Provider{
+						DiffF: func(urn resource.URN, id resource.ID,
+							oldInputs, oldOutputs, newInputs resource.PropertyMap, ignoreChanges []string,
+						) (plugin.DiffResult, error) {
+							if oldInputs.DeepEquals(newInputs) {
+								return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+							}
+							return plugin.DiffResult{
+								Changes:     plugin.DiffSome,
+								ReplaceKeys: []resource.PropertyKey{"foo"},
+							}, nil
+						},
+						DeleteF: func(resource.URN, resource.ID, resource.PropertyMap, resource.PropertyMap,
+							float64,
+						) (resource.Status, error) {
+							deleted++
+							return resource.StatusOK, nil
+						},
+					}, nil
+				}),
+			}
+
+			var lock sync.Mutex
+			var ran []string
+			register, inputs := true, 1
+			programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+				if !register {
+					return nil
+				}
+
+				callbacks, err := deploytest.NewCallbacksServer()
+				require.NoError(t, err)
+				defer func() { require.NoError(t, callbacks.Close()) }()
+
+				callback, err := callbacks.Allocate(ResourceHookFunction(func(req *pulumirpc.ResourceHookRequest) error {
+					lock.Lock()
+					defer lock.Unlock()
+					ran = append(ran, req.Event)
+					return nil
+				}))
+				require.NoError(t, err)
+
+				_, _, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+					Inputs: resource.PropertyMap{"foo": resource.NewNumberProperty(float64(inputs))},
+					Hooks: []*pulumirpc.ResourceHook{{
+						Name: "record",
+						Events: []string{
+							"before_create", "after_create", "before_update", "after_update",
+							"before_delete", "after_delete", "before_replace", "after_replace",
+						},
+						Callback: callback,
+					}},
+				})
+				require.NoError(t, err)
+
+				// Keep serving the hook until the deployment has finished.
+				return monitor.SignalAndWaitForShutdown()
+			})
+			hostF := deploytest.NewPluginHostF(nil, nil, programF, loaders...)
+
+			p := &TestPlan{
+				Options: TestUpdateOptions{HostF: hostF},
+			}
+			project := p.GetProject()
+
+			// Create the resource.
+			snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"before_create", "after_create"}, ran)
+
+			// Delete it.
+			ran, register, inputs = nil, c.register, c.inputs
+			_, err = c.op.Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted)
+			assert.Equal(t, c.expected, ran)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycletest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine" //nolint:revive
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Test that stack references record the outputs of the stacks that the program reads through them.
+func TestStackReferenceDependency(t *testing.T) {
+	t.Parallel()
+
+	reads := []string{"vpcId"}
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, mon *deploytest.ResourceMonitor) error {
+		urn, _, err := mon.ReadResource("pulumi:pulumi:StackReference", "other", "other", "",
+			resource.PropertyMap{"name": resource.NewStringProperty("org/proj/other")}, "", "", "")
+		require.NoError(t, err)
+		for _, name := range reads {
+			_, _, err = mon.Invoke("pulumi:pulumi:recordStackOutputRead", resource.PropertyMap{
+				"urn":  resource.NewStringProperty(string(urn)),
+				"name": resource.NewStringProperty(name),
+			}, "", "")
+			require.NoError(t, err)
+		}
+		return nil
+	})
+	p := &TestPlan{
+		BackendClient: &deploytest.BackendClient{
+			GetStackOutputsF: func(ctx context.Context, name string) (resource.PropertyMap, error) {
+				return resource.PropertyMap{
+					"vpcId":    resource.NewStringProperty("vpc-1"),
+					"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+					"unused":   resource.NewStringProperty("unused"),
+				}, nil
+			},
+		},
+		Options: TestUpdateOptions{HostF: deploytest.NewPluginHostF(nil, nil, programF)},
+	}
+	project := p.GetProject()
+
+	// Only the outputs that the program read are recorded.
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, &resource.StackDependency{
+		Stack:   "org/proj/other",
+		Outputs: []resource.PropertyKey{"vpcId"},
+	}, snap.Resources[1].StackDependency)
+
+	// Outputs read more than once are recorded once, and outputs no longer read are dropped.
+	reads = []string{"password", "vpcId", "password"}
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, &resource.StackDependency{
+		Stack:   "org/proj/other",
+		Outputs: []resource.PropertyKey{"password", "vpcId"},
+	}, snap.Resources[1].StackDependency)
+
+	reads = nil
+	snap, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, &resource.StackDependency{Stack: "org/proj/other"}, snap.Resources[1].StackDependency)
+}
+
+// Test that removing a stack output that is read by other stacks issues a warning.
+func TestRemovedDependentOutputWarning(t *testing.T) {
+	t.Parallel()
+
+	outputs := resource.PropertyMap{
+		"vpcId":  resource.NewStringProperty("vpc-1"),
+		"region": resource.NewStringProperty("us-west-2"),
+	}
+	programF := deploytest.NewLanguageRuntimeF(func(_ plugin.RunInfo, mon *deploytest.ResourceMonitor) error {
+		urn, _, _, _, err := mon.RegisterResource(resource.RootStackType, "test-test", false)
+		require.NoError(t, err)
+		return mon.RegisterResourceOutputs(urn, outputs)
+	})
+
+	var requested []string
+	p := &TestPlan{
+		BackendClient: &deploytest.BackendClient{
+			GetStackDependentsF: func(ctx context.Context, name string) (map[string][]resource.PropertyKey, error) {
+				requested = append(requested, name)
+				return map[string][]resource.PropertyKey{
+					"org/consumer/dev":  {"vpcId"},
+					"org/consumer/prod": {"region", "vpcId"},
+				}, nil
+			},
+		},
+		Options: TestUpdateOptions{HostF: deploytest.NewPluginHostF(nil, nil, programF)},
+	}
+	project := p.GetProject()
+
+	removedWarnings := func(events []Event) []string {
+		var warnings []string
+		for _, e := range events {
+			if e.Type == DiagEvent {
+				payload := e.Payload().(DiagEventPayload)
+				if payload.Severity == diag.Warning && strings.Contains(payload.Message, "is being removed") {
+					warnings = append(warnings, payload.Message)
+				}
+			}
+		}
+		return warnings
+	}
+
+	snap, err := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	require.NoError(t, err)
+	assert.Empty(t, requested)
+
+	// Removing an output that is read by other stacks warns, in both previews and updates.
+	delete(outputs, "vpcId")
+	for _, dryRun := range []bool{true, false} {
+		_, err = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, dryRun, p.BackendClient,
+			func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, err error) error {
+				warnings := removedWarnings(events)
+				require.Len(t, warnings, 1)
+				assert.Contains(t, warnings[0], `"vpcId"`)
+				assert.Contains(t, warnings[0], "org/consumer/dev, org/consumer/prod")
+				return err
+			})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"test", "test"}, requested)
+}
@@ -16,6 +16,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -108,6 +109,25 @@ func (p pluginSet) Values() []workspace.PluginSpec {
 	return plugins
 }
 
+// Pin returns a new set with every plugin pinned to the plugin recorded in the given plugin lock, see
+// workspace.PluginLock.Pin. Plugins that deviate from the lock are left unchanged and the deviations are returned.
+func (p pluginSet) Pin(lock *workspace.PluginLock) (pluginSet, []error) {
+	if lock == nil {
+		return p, nil
+	}
+
+	var deviations []error
+	newSet := newPluginSet()
+	for _, plug := range p.Values() {
+		pinned, err := lock.Pin(plug)
+		if err != nil {
+			deviations = append(deviations, err)
+		}
+		newSet.Add(pinned)
+	}
+	return newSet, deviations
+}
+
 // newPluginSet creates a new empty pluginSet.
 func newPluginSet(plugins ...workspace.PluginSpec) pluginSet {
 	var s pluginSet = make(map[string]workspace.PluginSpec, len(plugins))
@@ -183,6 +203,33 @@ func gatherPluginsFromSnapshot(plugctx *plugin.Context, target *deploy.Target) (
 	return set, nil
 }
 
+// loadPluginLock loads the plugin lock file for the project at the given root, if there is one.
+func loadPluginLock(root string) (*workspace.PluginLock, error) {
+	if root == "" {
+		return nil, nil
+	}
+	lock, err := workspace.LoadPluginLock(workspace.PluginLockPath(root))
+	if err != nil {
+		return nil, fmt.Errorf("loading plugin lock: %w", err)
+	}
+	return lock, nil
+}
+
+// checkPluginLockDeviations reports plugins that deviate from the project's plugin lock. If frozen or
+// PULUMI_FROZEN_PLUGIN_LOCK is set, the deviations are returned as an error, otherwise they are reported as warnings.
+func checkPluginLockDeviations(d diag.Sink, deviations []error, frozen bool) error {
+	if len(deviations) == 0 {
+		return nil
+	}
+	if frozen || env.FrozenPluginLock.Value() {
+		return fmt.Errorf("plugins deviate from %s: %w", workspace.PluginLockFile, errors.Join(deviations...))
+	}
+	for _, err := range deviations {
+		d.Warningf(diag.Message("", "%v; run `pulumi install` to update %s"), err, workspace.PluginLockFile)
+	}
+	return nil
+}
+
 // ensurePluginsAreInstalled inspects all plugins in the plugin set and, if any plugins are not currently installed,
 // uses the given backend client to install them. Installations are processed in parallel, though
 // ensurePluginsAreInstalled does not return until all installations are completed.
@@ -21,6 +21,7 @@ import (
 	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/testing/diagtest"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
@@ -214,3 +215,46 @@ func TestDefaultProviderPluginsSorting(t *testing.T) {
 		"foo": p2,
 	}, result)
 }
+
+func TestPluginSetPin(t *testing.T) {
+	t.Parallel()
+
+	lock := &workspace.PluginLock{}
+	lock.Record(workspace.PluginSpec{
+		Name:      "aws",
+		Kind:      workspace.ResourcePlugin,
+		Version:   mustMakeVersion("6.0.0"),
+		Checksums: map[string][]byte{"linux-amd64": {0x01}},
+	})
+
+	plugins := newPluginSet(
+		workspace.PluginSpec{Name: "aws", Kind: workspace.ResourcePlugin},
+		workspace.PluginSpec{Name: "gcp", Kind: workspace.ResourcePlugin, Version: mustMakeVersion("7.0.0")},
+		workspace.PluginSpec{Name: "pulumi", Kind: workspace.ResourcePlugin},
+	)
+
+	pinned, deviations := plugins.Pin(lock)
+	assert.Len(t, pinned, 3)
+	aws, ok := pinned["aws-6.0.0"]
+	assert.True(t, ok)
+	assert.Equal(t, map[string][]byte{"linux-amd64": {0x01}}, aws.Checksums)
+	assert.Contains(t, pinned, "gcp-7.0.0")
+	assert.Contains(t, pinned, "pulumi")
+
+	assert.Len(t, deviations, 1)
+	assert.ErrorContains(t, deviations[0], "resource plugin gcp-7.0.0 is not recorded in Pulumi.lock")
+
+	err := checkPluginLockDeviations(diagtest.LogSink(t), deviations, false /* frozen */)
+	assert.NoError(t, err)
+	err = checkPluginLockDeviations(diagtest.LogSink(t), deviations, true /* frozen */)
+	assert.ErrorContains(t, err, "plugins deviate from Pulumi.lock: resource plugin gcp-7.0.0 is not recorded")
+}
+
+func TestPluginSetPinNoLock(t *testing.T) {
+	t.Parallel()
+
+	plugins := newPluginSet(workspace.PluginSpec{Name: "aws", Kind: workspace.ResourcePlugin})
+	pinned, deviations := plugins.Pin(nil)
+	assert.Equal(t, plugins, pinned)
+	assert.Empty(t, deviations)
+}
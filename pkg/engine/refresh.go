@@ -80,6 +80,16 @@ func newRefreshSource(
 		return nil, err
 	}
 
+	// Pick up the download URLs and checksums of any plugins recorded in the project's plugin lock.
+	lock, err := loadPluginLock(plugctx.Root)
+	if err != nil {
+		return nil, err
+	}
+	plugins, deviations := plugins.Pin(lock)
+	if err := checkPluginLockDeviations(plugctx.Diag, deviations, opts.FrozenPluginLock); err != nil {
+		return nil, err
+	}
+
 	// Like Update, if we're missing plugins, attempt to download the missing plugins.
 	if err := ensurePluginsAreInstalled(ctx, plugctx.Diag, plugins.Deduplicate(),
 		plugctx.Host.GetProjectPlugins()); err != nil {
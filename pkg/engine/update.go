@@ -164,6 +164,12 @@ type UpdateOptions struct {
 
 	// Experimental is true if the engine is in experimental mode (i.e. PULUMI_EXPERIMENTAL was set)
 	Experimental bool
+
+	// The resources whose replacement or deletion must be explicitly allowed, if any. Destroy ignores this.
+	ReplacementGuard *deploy.ReplacementGuard
+
+	// true if the engine should fail instead of warning when plugins deviate from the project's Pulumi.lock.
+	FrozenPluginLock bool
 }
 
 // HasChanges returns true if there are any non-same changes in the resulting summary.
@@ -218,13 +224,14 @@ func Update(u UpdateInfo, ctx *Context, opts UpdateOptions, dryRun bool) (
 func RunInstallPlugins(
 	proj *workspace.Project, pwd, main string, target *deploy.Target, plugctx *plugin.Context,
 ) error {
-	_, _, err := installPlugins(context.Background(), proj, pwd, main, target, plugctx, true /*returnInstallErrors*/)
+	_, _, err := installPlugins(context.Background(), proj, pwd, main, target, plugctx,
+		true /*returnInstallErrors*/, false /*frozenPluginLock*/)
 	return err
 }
 
 func installPlugins(ctx context.Context,
 	proj *workspace.Project, pwd, main string, target *deploy.Target,
-	plugctx *plugin.Context, returnInstallErrors bool,
+	plugctx *plugin.Context, returnInstallErrors, frozenPluginLock bool,
 ) (pluginSet, map[tokens.Package]workspace.PluginSpec, error) {
 	// Before launching the source, ensure that we have all of the plugins that we need in order to proceed.
 	//
@@ -254,6 +261,18 @@ func installPlugins(ctx context.Context,
 		return nil, nil, err
 	}
 
+	// If the project has a plugin lock, pin the plugins the program asks for to the locked plugins. Plugins from the
+	// snapshot reflect what is already deployed, so they only pick up the recorded download URLs and checksums.
+	lock, err := loadPluginLock(plugctx.Root)
+	if err != nil {
+		return nil, nil, err
+	}
+	languagePlugins, deviations := languagePlugins.Pin(lock)
+	if err := checkPluginLockDeviations(plugctx.Diag, deviations, frozenPluginLock); err != nil {
+		return nil, nil, err
+	}
+	snapshotPlugins, _ = snapshotPlugins.Pin(lock)
+
 	allPlugins := languagePlugins.Union(snapshotPlugins)
 
 	// If there are any plugins that are not available, we can attempt to install them here.
@@ -431,7 +450,7 @@ func newUpdateSource(ctx context.Context,
 	//
 
 	allPlugins, defaultProviderVersions, err := installPlugins(ctx, proj, pwd, main, target,
-		plugctx, false /*returnInstallErrors*/)
+		plugctx, false /*returnInstallErrors*/, opts.FrozenPluginLock)
 	if err != nil {
 		return nil, err
 	}
@@ -665,6 +684,10 @@ func (acts *updateActions) OnResourceOutputs(step deploy.Step) error {
 	return acts.Context.SnapshotManager.RegisterResourceOutputs(step)
 }
 
+func (acts *updateActions) OnResourceHook(step deploy.Step, hook string, event resource.ResourceHookEvent, err error) {
+	acts.Opts.Events.resourceHookEvent(step, hook, event, err)
+}
+
 func (acts *updateActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) {
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
@@ -778,6 +801,10 @@ func (acts *previewActions) OnResourceOutputs(step deploy.Step) error {
 	return nil
 }
 
+func (acts *previewActions) OnResourceHook(step deploy.Step, hook string, event resource.ResourceHookEvent, err error) {
+	acts.Opts.Events.resourceHookEvent(step, hook, event, err)
+}
+
 func (acts *previewActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) {
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
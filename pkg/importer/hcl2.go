@@ -18,14 +18,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/archive"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/asset"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -40,25 +45,16 @@ var Null = &model.Variable{
 
 // GenerateHCL2Definition generates a Pulumi HCL2 definition for a given resource.
 func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names NameTable) (*model.Block, error) {
-	// TODO: pull the package version from the resource's provider
-	pkg, err := schema.LoadPackageReference(loader, string(state.Type.Package()), nil)
+	r, err := loadResourceSchema(loader, state.Type)
 	if err != nil {
 		return nil, err
 	}
 
-	r, ok, err := pkg.Resources().Get(string(state.Type))
-	if err != nil {
-		return nil, fmt.Errorf("loading resource '%v': %w", state.Type, err)
-	}
-	if !ok {
-		return nil, fmt.Errorf("unknown resource type '%v'", r)
-	}
-
 	var items []model.BodyItem
 	name := state.URN.Name()
-	// Check if _this_ urn is in the name table, if so we need to set logicalName and use the mapped name for
-	// the resource block.
-	if mappedName, ok := names[state.URN]; ok {
+	// Check if _this_ urn is mapped to a different name in the name table, if so we need to set logicalName and use
+	// the mapped name for the resource block.
+	if mappedName, ok := names[state.URN]; ok && mappedName != name {
 		items = append(items, &model.Attribute{
 			Name: "__logicalName",
 			Value: &model.TemplateExpression{
@@ -104,6 +100,34 @@ func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names N
 	}, nil
 }
 
+// loadResourceSchema loads the schema for the given resource type. Provider resources are described by their
+// package's provider schema.
+func loadResourceSchema(loader schema.Loader, typ tokens.Type) (*schema.Resource, error) {
+	pkgName := typ.Package()
+	if providers.IsProviderType(typ) {
+		pkgName = providers.GetProviderPackage(typ)
+	}
+
+	// TODO: pull the package version from the resource's provider
+	pkg, err := schema.LoadPackageReference(loader, string(pkgName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if providers.IsProviderType(typ) {
+		return pkg.Provider()
+	}
+
+	r, ok, err := pkg.Resources().Get(string(typ))
+	if err != nil {
+		return nil, fmt.Errorf("loading resource '%v': %w", typ, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type '%v'", typ)
+	}
+	return r, nil
+}
+
 func newVariableReference(name string) model.Expression {
 	return model.VariableReference(&model.Variable{
 		Name:         name,
@@ -169,9 +193,63 @@ func makeResourceOptions(state *resource.State, names NameTable) (*model.Block,
 			Value:  cty.True,
 		})
 	}
+	if state.RetainOnDelete {
+		resourceOptions = appendResourceOption(resourceOptions, "retainOnDelete", &model.LiteralValueExpression{
+			Tokens: syntax.NewLiteralValueTokens(cty.True),
+			Value:  cty.True,
+		})
+	}
+	if len(state.IgnoreChanges) != 0 {
+		paths := make([]model.Expression, len(state.IgnoreChanges))
+		for i, p := range state.IgnoreChanges {
+			x, err := newPropertyPathReference(p)
+			if err != nil {
+				return nil, err
+			}
+			paths[i] = x
+		}
+		resourceOptions = appendResourceOption(resourceOptions, "ignoreChanges", &model.TupleConsExpression{
+			Tokens:      syntax.NewTupleConsTokens(len(paths)),
+			Expressions: paths,
+		})
+	}
 	return resourceOptions, nil
 }
 
+// newPropertyPathReference converts a property path such as `tags["Name"]` or `rules[0].cidr` into the equivalent
+// property reference expression for use in an ignoreChanges option.
+func newPropertyPathReference(path string) (model.Expression, error) {
+	p, err := resource.ParsePropertyPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ignoreChanges path %q: %w", path, err)
+	}
+	root, ok := p[0].(string)
+	if !ok || !hclsyntax.ValidIdentifier(root) {
+		return nil, fmt.Errorf("invalid ignoreChanges path %q: must start with a property name", path)
+	}
+
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: root}}
+	for _, k := range p[1:] {
+		switch k := k.(type) {
+		case string:
+			if k == "*" {
+				return nil, fmt.Errorf("invalid ignoreChanges path %q: wildcards are not supported", path)
+			}
+			if hclsyntax.ValidIdentifier(k) {
+				traversal = append(traversal, hcl.TraverseAttr{Name: k})
+			} else {
+				traversal = append(traversal, hcl.TraverseIndex{Key: cty.StringVal(k)})
+			}
+		case int:
+			traversal = append(traversal, hcl.TraverseIndex{Key: cty.NumberIntVal(int64(k))})
+		}
+	}
+	return &model.ScopeTraversalExpression{
+		RootName:  root,
+		Traversal: traversal,
+	}, nil
+}
+
 // typeRank orders types by their simplicity.
 func typeRank(t schema.Type) int {
 	switch t {
@@ -603,7 +681,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 
 	switch {
 	case value.IsArchive():
-		return nil, errors.New("NYI: archives")
+		return generateArchive(value.ArchiveValue())
 	case value.IsArray():
 		elementType := schema.AnyType
 		if typ, ok := typ.(*schema.ArrayType); ok {
@@ -624,7 +702,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 			Expressions: exprs,
 		}, nil
 	case value.IsAsset():
-		return nil, errors.New("NYI: assets")
+		return generateAsset(value.AssetValue())
 	case value.IsBool():
 		return &model.LiteralValueExpression{
 			Value: cty.BoolVal(value.BoolValue()),
@@ -733,3 +811,78 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 		return nil, nil
 	}
 }
+
+// newStringCall returns a call to the named function with the given string argument.
+func newStringCall(name, arg string) model.Expression {
+	return &model.FunctionCallExpression{
+		Name: name,
+		Args: []model.Expression{&model.TemplateExpression{
+			Parts: []model.Expression{
+				&model.LiteralValueExpression{
+					Value: cty.StringVal(arg),
+				},
+			},
+		}},
+	}
+}
+
+// generateAsset generates a call to the asset function that creates the given asset.
+func generateAsset(a *asset.Asset) (model.Expression, error) {
+	switch {
+	case a.IsPath():
+		return newStringCall("fileAsset", a.Path), nil
+	case a.IsURI():
+		return newStringCall("remoteAsset", a.URI), nil
+	default:
+		return newStringCall("stringAsset", a.Text), nil
+	}
+}
+
+// generateArchive generates a call to the archive function that creates the given archive.
+func generateArchive(a *archive.Archive) (model.Expression, error) {
+	switch {
+	case a.IsPath():
+		return newStringCall("fileArchive", a.Path), nil
+	case a.IsURI():
+		return newStringCall("remoteArchive", a.URI), nil
+	case a.IsAssets():
+		keys := make([]string, 0, len(a.Assets))
+		for k := range a.Assets {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		items := slice.Prealloc[model.ObjectConsItem](len(keys))
+		for _, k := range keys {
+			var x model.Expression
+			var err error
+			switch v := a.Assets[k].(type) {
+			case *asset.Asset:
+				x, err = generateAsset(v)
+			case *archive.Archive:
+				x, err = generateArchive(v)
+			default:
+				err = fmt.Errorf("unexpected archive element %v", v)
+			}
+			if err != nil {
+				return nil, err
+			}
+			// Always quote the key, as asset names are usually paths.
+			items = append(items, model.ObjectConsItem{
+				Key: &model.LiteralValueExpression{
+					Value: cty.StringVal(fmt.Sprintf("%q", k)),
+				},
+				Value: x,
+			})
+		}
+		return &model.FunctionCallExpression{
+			Name: "assetArchive",
+			Args: []model.Expression{&model.ObjectConsExpression{
+				Tokens: syntax.NewObjectConsTokens(len(items)),
+				Items:  items,
+			}},
+		}, nil
+	default:
+		return nil, errors.New("archive has no contents")
+	}
+}
@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
@@ -34,6 +36,32 @@ type LanguageGenerator func(w io.Writer, p *pcl.Program) error
 // A NameTable maps URNs to language-specific variable names.
 type NameTable map[resource.URN]string
 
+// VariableName turns a logical name into a valid PCL identifier.
+func VariableName(logicalName string) string {
+	if hclsyntax.ValidIdentifier(logicalName) {
+		return logicalName
+	}
+
+	var b strings.Builder
+	for i, c := range logicalName {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+			b.WriteRune(c)
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(c)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "resource"
+	}
+	return b.String()
+}
+
 // A DiagnosticsError captures HCL2 diagnostics.
 type DiagnosticsError struct {
 	diagnostics         hcl.Diagnostics
@@ -79,8 +107,34 @@ func GenerateLanguageDefinitions(w io.Writer, loader schema.Loader, gen Language
 		contract.IgnoreError(err)
 	}
 
+	return generateLanguage(w, loader, gen, &hcl2Text)
+}
+
+// GenerateImportDefinitions generates definitions for the given imported resource states, which must be in dependency
+// order. Property values that hold the ID or an output of a resource that the imported resource depends on are replaced
+// with references to that resource, provided that the resource is named; existing holds the states of any named
+// resources that are not being imported. Dependencies on resources that are not named are dropped.
+func GenerateImportDefinitions(w io.Writer, loader schema.Loader, gen LanguageGenerator, states []*resource.State,
+	existing []*resource.State, names NameTable,
+) error {
+	all := make([]*resource.State, 0, len(existing)+len(states))
+	all = append(append(all, existing...), states...)
+	refs := newOutputReferenceTable(all, names)
+
+	return generateReferencingDefinitions(w, loader, gen, states, names, refs,
+		func(state *resource.State) func(resource.URN) bool {
+			deps := map[resource.URN]bool{}
+			for _, dep := range state.Dependencies {
+				deps[dep] = true
+			}
+			return func(urn resource.URN) bool { return deps[urn] }
+		})
+}
+
+// generateLanguage binds the given PCL text and passes the resulting program to the language generator.
+func generateLanguage(w io.Writer, loader schema.Loader, gen LanguageGenerator, hcl2Text io.Reader) error {
 	parser := syntax.NewParser()
-	if err := parser.ParseFile(&hcl2Text, "anonymous.pp"); err != nil {
+	if err := parser.ParseFile(hcl2Text, "anonymous.pp"); err != nil {
 		return err
 	}
 	if parser.Diagnostics.HasErrors() {
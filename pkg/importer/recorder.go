@@ -0,0 +1,830 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// placeholderPattern matches the placeholder values that a ProgramRecorder hands to a program in place of config
+// values and the outputs of resources and invokes.
+var placeholderPattern = regexp.MustCompile(`__pulumiRef(\d+)__`)
+
+type placeholderKind int
+
+const (
+	// configPlaceholder stands in for the value of a config variable.
+	configPlaceholder placeholderKind = iota
+	// resourcePlaceholder stands in for an attribute of a resource.
+	resourcePlaceholder
+	// invokePlaceholder stands in for an attribute of the result of an invoke.
+	invokePlaceholder
+	// computedPlaceholder stands in for a value that the program computed in a way that cannot be represented.
+	computedPlaceholder
+)
+
+// A placeholder describes the value that a placeholder stands in for.
+type placeholder struct {
+	kind placeholderKind
+	// index is the index of the config variable or invoke.
+	index int
+	// urn is the URN of the resource.
+	urn resource.URN
+	// attribute is the name of the resource or invoke attribute.
+	attribute string
+	// message describes a computed value.
+	message string
+}
+
+// A recordedResource is a resource registered by the program.
+type recordedResource struct {
+	state  *resource.State
+	remote bool
+	// propertyDependencies records the resources that each input property depends on.
+	propertyDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// A recordedInvoke is an invoke called by the program.
+type recordedInvoke struct {
+	token    tokens.ModuleMember
+	function *schema.Function
+	args     resource.PropertyMap
+}
+
+// A ProgramRecorder is a resource monitor that records the config, resources, invokes and stack outputs of a Pulumi
+// program during a preview, so that the program can be regenerated as PCL and from there in any language.
+//
+// The recorder hands the program placeholder strings in place of config values and the string outputs of resources
+// and invokes. Inputs that hold these placeholders, whole or as part of a larger string, are generated as references
+// to the config variables, resources and invokes that they came from. Values that the program computed from those
+// outputs in other ways cannot be represented in PCL: they are generated as calls to notImplemented and reported as
+// warnings, as are component resources that are implemented by the program itself.
+type ProgramRecorder struct {
+	pulumirpc.UnimplementedResourceMonitorServer
+
+	loader  schema.Loader
+	stack   tokens.QName
+	project tokens.PackageName
+
+	m            sync.Mutex
+	placeholders []placeholder
+	config       []config.Key
+	resources    []*recordedResource
+	components   map[resource.URN]bool
+	invokes      []*recordedInvoke
+	stackURN     resource.URN
+	outputs      resource.PropertyMap
+	diagnostics  hcl.Diagnostics
+}
+
+// NewProgramRecorder creates a new recorder for a program in the given stack and project. The loader is used to find
+// the schemas of the resources and invokes that the program uses.
+func NewProgramRecorder(loader schema.Loader, stack tokens.QName, project tokens.PackageName) *ProgramRecorder {
+	return &ProgramRecorder{
+		loader:     loader,
+		stack:      stack,
+		project:    project,
+		components: map[resource.URN]bool{},
+	}
+}
+
+// ConfigPlaceholder returns the value to pass to the program for the given string config variable. References to
+// the value are generated as references to the config variable.
+func (r *ProgramRecorder) ConfigPlaceholder(key config.Key) string {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.config = append(r.config, key)
+	return r.newPlaceholder(placeholder{kind: configPlaceholder, index: len(r.config) - 1})
+}
+
+// newPlaceholder registers the given placeholder and returns its value. The caller must hold the lock.
+func (r *ProgramRecorder) newPlaceholder(p placeholder) string {
+	r.placeholders = append(r.placeholders, p)
+	return fmt.Sprintf("__pulumiRef%d__", len(r.placeholders)-1)
+}
+
+// warnf records a warning about the program.
+func (r *ProgramRecorder) warnf(format string, args ...interface{}) {
+	r.diagnostics = append(r.diagnostics, &hcl.Diagnostic{
+		Severity: hcl.DiagWarning,
+		Summary:  fmt.Sprintf(format, args...),
+	})
+}
+
+// SupportsFeature reports the features that the recorder supports. Resource references and output values are not
+// supported, so that the SDKs send plain values that can be traced through their placeholders.
+func (r *ProgramRecorder) SupportsFeature(ctx context.Context,
+	req *pulumirpc.SupportsFeatureRequest,
+) (*pulumirpc.SupportsFeatureResponse, error) {
+	return &pulumirpc.SupportsFeatureResponse{HasSupport: req.GetId() == "secrets"}, nil
+}
+
+// RegisterResource records a resource and returns placeholders for its ID and string outputs.
+func (r *ProgramRecorder) RegisterResource(ctx context.Context,
+	req *pulumirpc.RegisterResourceRequest,
+) (*pulumirpc.RegisterResourceResponse, error) {
+	t := tokens.Type(req.GetType())
+	parent, err := resource.ParseOptionalURN(req.GetParent())
+	if err != nil {
+		return nil, fmt.Errorf("invalid parent URN: %w", err)
+	}
+	var parentType tokens.Type
+	if parent != "" && parent.QualifiedType() != resource.RootStackType {
+		parentType = parent.QualifiedType()
+	}
+	urn := resource.NewURN(r.stack, r.project, parentType, t, req.GetName())
+
+	label := fmt.Sprintf("ProgramRecorder.RegisterResource(%s,%s)", t, req.GetName())
+	props, err := plugin.UnmarshalProperties(req.GetObject(), plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	custom, remote := req.GetCustom(), req.GetRemote()
+	switch {
+	case t == resource.RootStackType:
+		r.stackURN = urn
+		return &pulumirpc.RegisterResourceResponse{Urn: string(urn)}, nil
+	case !custom && !remote:
+		r.components[urn] = true
+		r.warnf("component resource %q is implemented by the program and cannot be converted; "+
+			"its children are generated without a parent", req.GetName())
+		return &pulumirpc.RegisterResourceResponse{Urn: string(urn)}, nil
+	}
+
+	var dependencies []resource.URN
+	for _, dep := range req.GetDependencies() {
+		dependencies = append(dependencies, resource.URN(dep))
+	}
+	propertyDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, deps := range req.GetPropertyDependencies() {
+		for _, dep := range deps.GetUrns() {
+			propertyDependencies[resource.PropertyKey(k)] = append(propertyDependencies[resource.PropertyKey(k)],
+				resource.URN(dep))
+		}
+	}
+
+	r.resources = append(r.resources, &recordedResource{
+		state: &resource.State{
+			Type:           t,
+			URN:            urn,
+			Custom:         custom,
+			Parent:         parent,
+			Provider:       req.GetProvider(),
+			Inputs:         props,
+			Dependencies:   dependencies,
+			Protect:        req.GetProtect(),
+			RetainOnDelete: req.GetRetainOnDelete(),
+			IgnoreChanges:  req.GetIgnoreChanges(),
+		},
+		remote:               remote,
+		propertyDependencies: propertyDependencies,
+	})
+
+	var id string
+	if custom {
+		id = r.newPlaceholder(placeholder{kind: resourcePlaceholder, urn: urn, attribute: "id"})
+	}
+	outputs := props
+	if res, err := loadResourceSchema(r.loader, t); err != nil {
+		r.warnf("could not load the schema for resource %q: %v", req.GetName(), err)
+	} else {
+		outputs = r.outputPlaceholders(res.Properties, props, func(name string) placeholder {
+			return placeholder{kind: resourcePlaceholder, urn: urn, attribute: name}
+		})
+	}
+
+	obj, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+		KeepSecrets:  req.GetAcceptSecrets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.RegisterResourceResponse{Urn: string(urn), Id: id, Object: obj}, nil
+}
+
+// outputPlaceholders returns the outputs to hand to the program for the given output properties. Outputs take the
+// value of the corresponding input, if any; other string outputs are replaced with placeholders, and the rest are
+// omitted. The caller must hold the lock.
+func (r *ProgramRecorder) outputPlaceholders(properties []*schema.Property, inputs resource.PropertyMap,
+	newPlaceholder func(name string) placeholder,
+) resource.PropertyMap {
+	outputs := resource.PropertyMap{}
+	for _, p := range properties {
+		k := resource.PropertyKey(p.Name)
+		var v resource.PropertyValue
+		switch {
+		case inputs.HasValue(k):
+			v = inputs[k]
+		case codegen.UnwrapType(p.Type) == schema.StringType && hclsyntax.ValidIdentifier(p.Name):
+			v = resource.NewStringProperty(r.newPlaceholder(newPlaceholder(p.Name)))
+		default:
+			continue
+		}
+		if p.Secret && !v.IsSecret() {
+			v = resource.MakeSecret(v)
+		}
+		outputs[k] = v
+	}
+	return outputs
+}
+
+// ReadResource reports that reading existing resources cannot be converted, and returns the outputs of the resource as
+// if it had been registered.
+func (r *ProgramRecorder) ReadResource(ctx context.Context,
+	req *pulumirpc.ReadResourceRequest,
+) (*pulumirpc.ReadResourceResponse, error) {
+	t := tokens.Type(req.GetType())
+	urn := resource.NewURN(r.stack, r.project, "", t, req.GetName())
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.warnf("resource %q is read from an existing resource, which cannot be converted; references to it are not generated",
+		req.GetName())
+
+	outputs := resource.PropertyMap{}
+	if res, err := loadResourceSchema(r.loader, t); err == nil {
+		outputs = r.outputPlaceholders(res.Properties, nil, func(name string) placeholder {
+			return placeholder{kind: resourcePlaceholder, urn: urn, attribute: name}
+		})
+	}
+	obj, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{
+		Label:        fmt.Sprintf("ProgramRecorder.ReadResource(%s,%s)", t, req.GetName()),
+		KeepUnknowns: true,
+		KeepSecrets:  req.GetAcceptSecrets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.ReadResourceResponse{Urn: string(urn), Properties: obj}, nil
+}
+
+// Invoke records an invoke and returns placeholders for the string properties of its result.
+func (r *ProgramRecorder) Invoke(ctx context.Context,
+	req *pulumirpc.ResourceInvokeRequest,
+) (*pulumirpc.InvokeResponse, error) {
+	tok := tokens.ModuleMember(req.GetTok())
+	label := fmt.Sprintf("ProgramRecorder.Invoke(%s)", tok)
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	fn, err := r.loadFunctionSchema(tok)
+	if err != nil {
+		r.warnf("invoke %q cannot be converted: %v", tok, err)
+		return &pulumirpc.InvokeResponse{}, nil
+	}
+	r.invokes = append(r.invokes, &recordedInvoke{token: tok, function: fn, args: args})
+	index := len(r.invokes) - 1
+
+	var ret resource.PropertyMap
+	if fn.Outputs != nil {
+		ret = r.outputPlaceholders(fn.Outputs.Properties, nil, func(name string) placeholder {
+			return placeholder{kind: invokePlaceholder, index: index, attribute: name}
+		})
+	}
+	mret, err := plugin.MarshalProperties(ret, plugin.MarshalOptions{Label: label})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{Return: mret}, nil
+}
+
+// loadFunctionSchema loads the schema for the function with the given token.
+func (r *ProgramRecorder) loadFunctionSchema(tok tokens.ModuleMember) (*schema.Function, error) {
+	pkg, err := schema.LoadPackageReference(r.loader, string(tok.Package()), nil)
+	if err != nil {
+		return nil, err
+	}
+	fn, ok, err := pkg.Functions().Get(string(tok))
+	if err != nil {
+		return nil, fmt.Errorf("loading function '%v': %w", tok, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown function '%v'", tok)
+	}
+	return fn, nil
+}
+
+// RegisterResourceOutputs records the outputs of the stack. The outputs of component resources are ignored.
+func (r *ProgramRecorder) RegisterResourceOutputs(ctx context.Context,
+	req *pulumirpc.RegisterResourceOutputsRequest,
+) (*emptypb.Empty, error) {
+	outs, err := plugin.UnmarshalProperties(req.GetOutputs(), plugin.MarshalOptions{
+		Label:        fmt.Sprintf("ProgramRecorder.RegisterResourceOutputs(%s)", req.GetUrn()),
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if resource.URN(req.GetUrn()) == r.stackURN {
+		r.outputs = outs
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// programNames holds the names of the variables in the generated program.
+type programNames struct {
+	used      map[string]bool
+	config    []string
+	invokes   []string
+	resources NameTable
+	urns      map[string]resource.URN
+}
+
+// add returns a unique variable name for the given logical name.
+func (names *programNames) add(logicalName string) string {
+	base := VariableName(logicalName)
+	name := base
+	for i := 2; names.used[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	names.used[name] = true
+	return name
+}
+
+// GenerateProgram writes a canonically formatted PCL program that declares the recorded config, invokes, resources and
+// stack outputs. The returned diagnostics describe the parts of the program that could not be converted.
+func (r *ProgramRecorder) GenerateProgram(w io.Writer) (hcl.Diagnostics, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	diagnostics := append(hcl.Diagnostics{}, r.diagnostics...)
+	warnf := func(format string, args ...interface{}) {
+		diagnostics = append(diagnostics, &hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	names := &programNames{used: map[string]bool{}, resources: NameTable{}, urns: map[string]resource.URN{}}
+	for _, key := range r.config {
+		names.config = append(names.config, names.add(key.Name()))
+	}
+	for _, invoke := range r.invokes {
+		names.invokes = append(names.invokes, names.add(string(invoke.token.Name())+"Result"))
+	}
+	var resources []*recordedResource
+	for _, res := range r.resources {
+		if _, err := loadResourceSchema(r.loader, res.state.Type); err != nil {
+			warnf("resource %q cannot be converted: %v", res.state.URN.Name(), err)
+			continue
+		}
+		resources = append(resources, res)
+		names.resources[res.state.URN] = names.add(r.displayName(res.state.URN.Name(), names))
+		names.urns[string(res.state.URN)] = res.state.URN
+	}
+
+	var blocks []model.BodyItem
+	for i, key := range r.config {
+		block := &model.Block{
+			Tokens: syntax.NewBlockTokens("config", names.config[i], "string"),
+			Type:   "config",
+			Labels: []string{names.config[i], "string"},
+			Body:   &model.Body{},
+		}
+		if names.config[i] != key.Name() {
+			block.Body.Items = append(block.Body.Items, newLogicalNameAttribute(key.Name()))
+		}
+		blocks = append(blocks, block)
+	}
+
+	for i, invoke := range r.invokes {
+		var argsType schema.Type = schema.AnyType
+		if invoke.function.Inputs != nil {
+			argsType = invoke.function.Inputs
+		}
+		what := fmt.Sprintf("the arguments of invoke %q", invoke.token)
+		args, err := generateValue(argsType, r.replaceComputed(resource.NewObjectProperty(invoke.args), what))
+		if err != nil {
+			return nil, fmt.Errorf("generating %s: %w", what, err)
+		}
+		blocks = append(blocks, &model.Attribute{
+			Tokens: syntax.NewAttributeTokens(names.invokes[i]),
+			Name:   names.invokes[i],
+			Value: &model.FunctionCallExpression{
+				Name: "invoke",
+				Args: []model.Expression{
+					newStringLiteral(string(invoke.token)),
+					r.replacePlaceholders(args, what, names, &diagnostics),
+				},
+			},
+		})
+	}
+
+	for _, res := range resources {
+		block, err := r.generateResource(res, names, &diagnostics)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	for _, k := range r.outputs.StableKeys() {
+		name := names.add(string(k))
+		what := fmt.Sprintf("stack output %q", k)
+		value, err := generateValue(schema.AnyType, r.replaceComputed(r.outputs[k], what))
+		if err != nil {
+			return nil, fmt.Errorf("generating %s: %w", what, err)
+		}
+		block := &model.Block{
+			Tokens: syntax.NewBlockTokens("output", name),
+			Type:   "output",
+			Labels: []string{name},
+			Body:   &model.Body{},
+		}
+		if name != string(k) {
+			block.Body.Items = append(block.Body.Items, newLogicalNameAttribute(string(k)))
+		}
+		block.Body.Items = append(block.Body.Items, &model.Attribute{
+			Tokens: syntax.NewAttributeTokens("value"),
+			Name:   "value",
+			Value:  r.replacePlaceholders(value, what, names, &diagnostics),
+		})
+		blocks = append(blocks, block)
+	}
+
+	// Separate each item with a blank line; formatting removes any extra newlines.
+	var text bytes.Buffer
+	for _, block := range blocks {
+		_, err := fmt.Fprintf(&text, "%v\n\n", block)
+		contract.IgnoreError(err)
+	}
+	formatted, formatDiags := syntax.Format(text.Bytes(), "main.pp")
+	if formatDiags.HasErrors() {
+		return nil, fmt.Errorf("formatting the generated program: %w", formatDiags)
+	}
+	if _, err := w.Write(formatted); err != nil {
+		return nil, err
+	}
+	return diagnostics, nil
+}
+
+// generateResource generates the definition of a recorded resource.
+func (r *ProgramRecorder) generateResource(res *recordedResource, names *programNames,
+	diagnostics *hcl.Diagnostics,
+) (*model.Block, error) {
+	name := names.resources[res.state.URN]
+	s := *res.state
+
+	// Logical names cannot be computed in PCL, so any placeholders they contain are replaced by the names of the
+	// values that they stand in for.
+	if logicalName := r.displayName(s.URN.Name(), names); logicalName != s.URN.Name() {
+		*diagnostics = append(*diagnostics, &hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary: fmt.Sprintf("the name of resource %q is computed by the program; it is generated as %q",
+				name, logicalName),
+		})
+		var parentType tokens.Type
+		if i := strings.LastIndex(string(s.URN.QualifiedType()), resource.URNTypeDelimiter); i != -1 {
+			parentType = s.URN.QualifiedType()[:i]
+		}
+		s.URN = resource.NewURN(r.stack, r.project, parentType, s.Type, logicalName)
+		names.resources[s.URN] = name
+	}
+
+	if _, ok := names.resources[s.Parent]; !ok {
+		s.Parent = ""
+	}
+	if s.Provider != "" {
+		ref, err := providers.ParseReference(s.Provider)
+		if err != nil || providers.IsDefaultProvider(ref.URN()) {
+			s.Provider = ""
+		} else if _, ok := names.resources[ref.URN()]; !ok {
+			*diagnostics = append(*diagnostics, &hcl.Diagnostic{
+				Severity: hcl.DiagWarning,
+				Summary:  fmt.Sprintf("the provider of resource %q cannot be converted", name),
+			})
+			s.Provider = ""
+		}
+	}
+
+	referenced := map[resource.URN]bool{}
+	s.Inputs = resource.PropertyMap{}
+	for _, k := range res.state.Inputs.StableKeys() {
+		what := fmt.Sprintf("property %q of resource %q", k, name)
+		v := r.replaceComputed(res.state.Inputs[k], what)
+		s.Inputs[k] = v
+
+		refs := map[resource.URN]bool{}
+		r.collectReferences(v, names, refs)
+		for urn := range refs {
+			referenced[urn] = true
+		}
+		for _, dep := range res.propertyDependencies[k] {
+			if _, ok := names.resources[dep]; ok && !refs[dep] {
+				*diagnostics = append(*diagnostics, &hcl.Diagnostic{
+					Severity: hcl.DiagWarning,
+					Summary: fmt.Sprintf("%s is computed by the program from the outputs of resource %q; "+
+						"the value seen by the program is generated instead", what, names.resources[dep]),
+				})
+				break
+			}
+		}
+	}
+
+	s.Dependencies = nil
+	for _, dep := range res.state.Dependencies {
+		if _, ok := names.resources[dep]; ok && !referenced[dep] && dep != s.Parent {
+			s.Dependencies = append(s.Dependencies, dep)
+		}
+	}
+
+	block, err := GenerateHCL2Definition(r.loader, &s, names.resources)
+	if err != nil {
+		return nil, fmt.Errorf("generating resource %q: %w", name, err)
+	}
+	for _, item := range block.Body.Items {
+		if attr, ok := item.(*model.Attribute); ok && attr.Name != "__logicalName" {
+			what := fmt.Sprintf("property %q of resource %q", attr.Name, name)
+			attr.Value = r.replacePlaceholders(attr.Value, what, names, diagnostics)
+		}
+	}
+	return block, nil
+}
+
+// displayName replaces the placeholders in a logical name with the names of the values they stand in for.
+func (r *ProgramRecorder) displayName(logicalName string, names *programNames) string {
+	return placeholderPattern.ReplaceAllStringFunc(logicalName, func(s string) string {
+		p := r.placeholders[r.placeholderIndex(s)]
+		switch p.kind {
+		case configPlaceholder:
+			return r.config[p.index].Name()
+		case resourcePlaceholder:
+			return p.urn.Name() + "-" + p.attribute
+		case invokePlaceholder:
+			return string(r.invokes[p.index].token.Name()) + "-" + p.attribute
+		default:
+			return "computed"
+		}
+	})
+}
+
+// placeholderIndex returns the index of the placeholder matched by placeholderPattern.
+func (r *ProgramRecorder) placeholderIndex(match string) int {
+	i, err := strconv.Atoi(placeholderPattern.FindStringSubmatch(match)[1])
+	contract.AssertNoErrorf(err, "placeholder index must be a number")
+	return i
+}
+
+// replaceComputed replaces the unknown values within the given value with placeholders for computed values.
+func (r *ProgramRecorder) replaceComputed(v resource.PropertyValue, what string) resource.PropertyValue {
+	switch {
+	case v.IsComputed() || v.IsOutput():
+		message := what + " is computed by the program from values that are not known until the program runs"
+		return resource.NewStringProperty(r.newPlaceholder(placeholder{kind: computedPlaceholder, message: message}))
+	case v.IsSecret():
+		return resource.MakeSecret(r.replaceComputed(v.SecretValue().Element, what))
+	case v.IsArray():
+		arr := make([]resource.PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = r.replaceComputed(e, what)
+		}
+		return resource.NewArrayProperty(arr)
+	case v.IsObject():
+		obj := resource.PropertyMap{}
+		for k, e := range v.ObjectValue() {
+			obj[k] = r.replaceComputed(e, what)
+		}
+		return resource.NewObjectProperty(obj)
+	default:
+		return v
+	}
+}
+
+// collectReferences records the generated resources that the placeholders within the given value refer to.
+func (r *ProgramRecorder) collectReferences(v resource.PropertyValue, names *programNames,
+	referenced map[resource.URN]bool,
+) {
+	switch {
+	case v.IsString():
+		if urn, ok := names.urns[v.StringValue()]; ok {
+			referenced[urn] = true
+		}
+		for _, m := range placeholderPattern.FindAllString(v.StringValue(), -1) {
+			if p := r.placeholders[r.placeholderIndex(m)]; p.kind == resourcePlaceholder {
+				if _, ok := names.resources[p.urn]; ok {
+					referenced[p.urn] = true
+				}
+			}
+		}
+	case v.IsSecret():
+		r.collectReferences(v.SecretValue().Element, names, referenced)
+	case v.IsArray():
+		for _, e := range v.ArrayValue() {
+			r.collectReferences(e, names, referenced)
+		}
+	case v.IsObject():
+		for _, e := range v.ObjectValue() {
+			r.collectReferences(e, names, referenced)
+		}
+	}
+}
+
+// replacePlaceholders replaces the string literals within the given expression that hold placeholders with the
+// references that they stand for. Values that cannot be represented are replaced with calls to notImplemented and
+// reported as warnings.
+func (r *ProgramRecorder) replacePlaceholders(x model.Expression, what string, names *programNames,
+	diagnostics *hcl.Diagnostics,
+) model.Expression {
+	notImplemented := func(message string) model.Expression {
+		*diagnostics = append(*diagnostics, &hcl.Diagnostic{
+			Severity: hcl.DiagWarning,
+			Summary:  message,
+		})
+		return &model.FunctionCallExpression{
+			Name: "notImplemented",
+			Args: []model.Expression{newStringLiteral(message)},
+		}
+	}
+
+	replace := func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+		template, ok := x.(*model.TemplateExpression)
+		if !ok || len(template.Parts) != 1 {
+			return x, nil
+		}
+		lit, ok := template.Parts[0].(*model.LiteralValueExpression)
+		if !ok || !lit.Value.Type().Equals(cty.String) || lit.Value.IsNull() {
+			return x, nil
+		}
+		s := lit.Value.AsString()
+
+		if urn, ok := names.urns[s]; ok {
+			return newTraversal(names.resources[urn], "urn", false), nil
+		}
+
+		matches := placeholderPattern.FindAllStringSubmatchIndex(s, -1)
+		if len(matches) == 0 {
+			if strings.Contains(strings.ToLower(s), "__pulumiref") {
+				return notImplemented(what + " is computed by the program from the outputs of other resources"), nil
+			}
+			return x, nil
+		}
+
+		var parts []model.Expression
+		last := 0
+		for _, m := range matches {
+			if m[0] > last {
+				parts = append(parts, &model.LiteralValueExpression{Value: cty.StringVal(s[last:m[0]])})
+			}
+			last = m[1]
+
+			index, err := strconv.Atoi(s[m[2]:m[3]])
+			contract.AssertNoErrorf(err, "placeholder index must be a number")
+			p := r.placeholders[index]
+
+			switch p.kind {
+			case configPlaceholder:
+				parts = append(parts, newTraversal(names.config[p.index], "", true))
+			case invokePlaceholder:
+				parts = append(parts, newTraversal(names.invokes[p.index], p.attribute, true))
+			case resourcePlaceholder:
+				name, ok := names.resources[p.urn]
+				if !ok {
+					return notImplemented(fmt.Sprintf("%s refers to resource %q, which cannot be converted",
+						what, p.urn.Name())), nil
+				}
+				parts = append(parts, newTraversal(name, p.attribute, true))
+			default:
+				return notImplemented(p.message), nil
+			}
+		}
+		if last < len(s) {
+			parts = append(parts, &model.LiteralValueExpression{Value: cty.StringVal(s[last:])})
+		}
+
+		// A value that consists of a single reference is generated as the reference itself.
+		if len(parts) == 1 {
+			if traversal, ok := parts[0].(*model.ScopeTraversalExpression); ok {
+				return newTraversal(traversal.RootName, traversalAttribute(traversal), false), nil
+			}
+		}
+		return &model.TemplateExpression{Parts: parts}, nil
+	}
+
+	x, diags := model.VisitExpression(x, model.IdentityVisitor, replace)
+	contract.Assertf(len(diags) == 0, "unexpected diagnostics: %v", diags)
+	return x
+}
+
+// newTraversal returns a reference to the given attribute of the named variable, or to the variable itself if the
+// attribute is empty. References that are interpolated into a template are delimited accordingly.
+func newTraversal(name, attribute string, interpolated bool) *model.ScopeTraversalExpression {
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: name}}
+	if attribute != "" {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attribute})
+	}
+	x := &model.ScopeTraversalExpression{
+		RootName:  name,
+		Traversal: traversal,
+	}
+	if interpolated {
+		tokens := syntax.NewScopeTraversalTokens(traversal)
+		tokens.Root.LeadingTrivia = syntax.TriviaList{syntax.NewTemplateDelimiter(hclsyntax.TokenTemplateInterp)}
+		end := syntax.TriviaList{syntax.NewTemplateDelimiter(hclsyntax.TokenTemplateSeqEnd)}
+		if dot, ok := lastDotTraverser(tokens); ok {
+			dot.Index.TrailingTrivia = end
+		} else {
+			tokens.Root.TrailingTrivia = end
+		}
+		x.Tokens = tokens
+	}
+	return x
+}
+
+// lastDotTraverser returns the tokens of the last attribute in the given traversal, if any.
+func lastDotTraverser(tokens *syntax.ScopeTraversalTokens) (*syntax.DotTraverserTokens, bool) {
+	if len(tokens.Traversal) == 0 {
+		return nil, false
+	}
+	dot, ok := tokens.Traversal[len(tokens.Traversal)-1].(*syntax.DotTraverserTokens)
+	return dot, ok
+}
+
+// traversalAttribute returns the name of the attribute that the given reference refers to, if any.
+func traversalAttribute(x *model.ScopeTraversalExpression) string {
+	if len(x.Traversal) < 2 {
+		return ""
+	}
+	return x.Traversal[1].(hcl.TraverseAttr).Name
+}
+
+// newStringLiteral returns a string literal expression for the given value.
+func newStringLiteral(value string) *model.TemplateExpression {
+	return &model.TemplateExpression{
+		Parts: []model.Expression{
+			&model.LiteralValueExpression{
+				Value: cty.StringVal(value),
+			},
+		},
+	}
+}
+
+// newLogicalNameAttribute returns an attribute that sets the logical name of a config variable, resource or output.
+func newLogicalNameAttribute(logicalName string) *model.Attribute {
+	return &model.Attribute{
+		Tokens: syntax.NewAttributeTokens("__logicalName"),
+		Name:   "__logicalName",
+		Value:  newStringLiteral(logicalName),
+	}
+}
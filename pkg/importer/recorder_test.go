@@ -0,0 +1,214 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+func marshalRecorderProperties(t *testing.T, props resource.PropertyMap) *structpb.Struct {
+	s, err := plugin.MarshalProperties(props, plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+	require.NoError(t, err)
+	return s
+}
+
+func unmarshalRecorderProperties(t *testing.T, s *structpb.Struct) resource.PropertyMap {
+	props, err := plugin.UnmarshalProperties(s, plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+	require.NoError(t, err)
+	return props
+}
+
+func TestProgramRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	recorder := NewProgramRecorder(loader, "dev", "test")
+
+	algorithm := recorder.ConfigPlaceholder(config.MustMakeKey("test", "key-algorithm"))
+
+	stack, err := recorder.RegisterResource(ctx, &pulumirpc.RegisterResourceRequest{
+		Type: string(resource.RootStackType),
+		Name: "test-dev",
+	})
+	require.NoError(t, err)
+
+	key, err := recorder.RegisterResource(ctx, &pulumirpc.RegisterResourceRequest{
+		Type:   "tls:index/privateKey:PrivateKey",
+		Name:   "key",
+		Parent: stack.Urn,
+		Custom: true,
+		Object: marshalRecorderProperties(t, resource.PropertyMap{
+			"algorithm": resource.NewStringProperty(algorithm),
+		}),
+	})
+	require.NoError(t, err)
+	keyOutputs := unmarshalRecorderProperties(t, key.Object)
+	assert.Equal(t, algorithm, keyOutputs["algorithm"].StringValue())
+	privateKeyPem := keyOutputs["privateKeyPem"]
+	require.True(t, privateKeyPem.IsString())
+
+	invoke, err := recorder.Invoke(ctx, &pulumirpc.ResourceInvokeRequest{
+		Tok: "tls:index/getPublicKey:getPublicKey",
+		Args: marshalRecorderProperties(t, resource.PropertyMap{
+			"privateKeyPem": privateKeyPem,
+		}),
+	})
+	require.NoError(t, err)
+	fingerprint := unmarshalRecorderProperties(t, invoke.Return)["publicKeyFingerprintMd5"]
+	require.True(t, fingerprint.IsString())
+
+	pet, err := recorder.RegisterResource(ctx, &pulumirpc.RegisterResourceRequest{
+		Type:   "random:index/randomPet:RandomPet",
+		Name:   "pet",
+		Parent: stack.Urn,
+		Custom: true,
+		Object: marshalRecorderProperties(t, resource.PropertyMap{
+			"prefix": resource.NewStringProperty("pet-" + fingerprint.StringValue()),
+			"keepers": resource.NewObjectProperty(resource.PropertyMap{
+				"key":   resource.NewStringProperty(key.Id),
+				"other": resource.MakeComputed(resource.NewStringProperty("")),
+			}),
+		}),
+		Dependencies: []string{key.Urn},
+	})
+	require.NoError(t, err)
+
+	_, err = recorder.RegisterResourceOutputs(ctx, &pulumirpc.RegisterResourceOutputsRequest{
+		Urn: stack.Urn,
+		Outputs: marshalRecorderProperties(t, resource.PropertyMap{
+			"petName":       resource.NewStringProperty(pet.Id),
+			"public-key":    resource.NewStringProperty(keyOutputs["publicKeyPem"].StringValue()),
+			"privateKeyPem": resource.MakeSecret(privateKeyPem),
+		}),
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	diags, err := recorder.GenerateProgram(&buf)
+	require.NoError(t, err)
+	program := bindRecordedProgram(t, loader, buf.String())
+
+	configVars := program.ConfigVariables()
+	require.Len(t, configVars, 1)
+	assert.Equal(t, "key-algorithm", configVars[0].LogicalName())
+
+	expected := `config key-algorithm string {
+}
+
+getPublicKeyResult = invoke("tls:index/getPublicKey:getPublicKey", {
+  privateKeyPem = key.privateKeyPem
+})
+
+resource key "tls:index/privateKey:PrivateKey" {
+  algorithm = key-algorithm
+}
+
+resource pet "random:index/randomPet:RandomPet" {
+  keepers = {
+    "key" = key.id
+
+    "other" = notImplemented("property \"keepers\" of resource \"pet\" is computed by the program ` +
+		`from values that are not known until the program runs")
+  }
+  prefix = "pet-${getPublicKeyResult.publicKeyFingerprintMd5}"
+}
+
+output petName {
+  value = pet.id
+}
+
+output privateKeyPem {
+  value = secret(key.privateKeyPem)
+}
+
+output public-key {
+  value = key.publicKeyPem
+}
+`
+	assert.Equal(t, expected, buf.String())
+
+	// The dependency of the pet on the key is implied by its keepers, and the only warning is for the computed keeper.
+	assert.NotContains(t, buf.String(), "dependsOn")
+	require.Len(t, diags, 1)
+	assert.Equal(t, hcl.DiagWarning, diags[0].Severity)
+	assert.Contains(t, diags[0].Summary, `property "keepers" of resource "pet"`)
+}
+
+// bindRecordedProgram parses and binds the given PCL text, which must be free of errors.
+func bindRecordedProgram(t *testing.T, loader schema.Loader, text string) *pcl.Program {
+	parser := syntax.NewParser()
+	err := parser.ParseFile(strings.NewReader(text), "main.pp")
+	require.NoError(t, err)
+	require.False(t, parser.Diagnostics.HasErrors(), "%v", parser.Diagnostics)
+
+	program, diags, err := pcl.BindProgram(parser.Files, pcl.Loader(loader))
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), "%v", diags)
+	return program
+}
+
+func TestProgramRecorderComponents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+	recorder := NewProgramRecorder(loader, "dev", "test")
+
+	component, err := recorder.RegisterResource(ctx, &pulumirpc.RegisterResourceRequest{
+		Type: "my:index:Component",
+		Name: "component",
+	})
+	require.NoError(t, err)
+
+	_, err = recorder.RegisterResource(ctx, &pulumirpc.RegisterResourceRequest{
+		Type:   "random:index/randomPet:RandomPet",
+		Name:   "pet",
+		Parent: component.Urn,
+		Custom: true,
+		Object: marshalRecorderProperties(t, resource.PropertyMap{}),
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	diags, err := recorder.GenerateProgram(&buf)
+	require.NoError(t, err)
+
+	program := bindRecordedProgram(t, loader, buf.String())
+	require.Len(t, program.Nodes, 1)
+	pet, ok := program.Nodes[0].(*pcl.Resource)
+	require.True(t, ok)
+	assert.Equal(t, "pet", pet.Name())
+	assert.Nil(t, pet.Options)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Summary, `component resource "component"`)
+}
@@ -0,0 +1,208 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// A resourceReference refers to an attribute of a resource that is being generated.
+type resourceReference struct {
+	urn       resource.URN
+	attribute string
+	// output is true if the attribute is one of the resource's outputs rather than its ID or URN.
+	output bool
+}
+
+// A referenceTable maps string values to references to the resources that hold those values.
+type referenceTable map[string][]resourceReference
+
+func (refs referenceTable) add(value string, ref resourceReference) {
+	if value != "" {
+		refs[value] = append(refs[value], ref)
+	}
+}
+
+// newReferenceTable returns a table of the IDs and URNs of the named resources.
+func newReferenceTable(states []*resource.State, names NameTable) referenceTable {
+	refs := referenceTable{}
+	for _, state := range states {
+		if _, ok := names[state.URN]; !ok {
+			continue
+		}
+		refs.add(string(state.ID), resourceReference{urn: state.URN, attribute: "id"})
+		refs.add(string(state.URN), resourceReference{urn: state.URN, attribute: "urn"})
+	}
+	return refs
+}
+
+// newOutputReferenceTable returns a table of the IDs and top-level string outputs of the named resources.
+func newOutputReferenceTable(states []*resource.State, names NameTable) referenceTable {
+	refs := referenceTable{}
+	for _, state := range states {
+		if _, ok := names[state.URN]; !ok {
+			continue
+		}
+		refs.add(string(state.ID), resourceReference{urn: state.URN, attribute: "id"})
+		for _, k := range state.Outputs.StableKeys() {
+			if v := state.Outputs[k]; v.IsString() && hclsyntax.ValidIdentifier(string(k)) {
+				refs.add(v.StringValue(), resourceReference{urn: state.URN, attribute: string(k), output: true})
+			}
+		}
+	}
+	return refs
+}
+
+// lookup returns the reference for the given value of the given top-level property of the resource self, if the value
+// refers to exactly one resource that is allowed. Resources never refer to themselves, and an output with the same
+// name as the property is not a reference: resources that share a setting hold the same value under the same name.
+// References to a resource's ID are preferred over references to its outputs.
+func (refs referenceTable) lookup(
+	value, key string, self resource.URN, allowed func(resource.URN) bool,
+) (resourceReference, bool) {
+	var found *resourceReference
+	for _, ref := range refs[value] {
+		ref := ref
+		if ref.urn == self || ref.output && ref.attribute == key || allowed != nil && !allowed(ref.urn) {
+			continue
+		}
+		switch {
+		case found == nil:
+			found = &ref
+		case found.urn != ref.urn:
+			return resourceReference{}, false
+		case found.output && !ref.output:
+			found = &ref
+		}
+	}
+	if found == nil {
+		return resourceReference{}, false
+	}
+	return *found, true
+}
+
+// collect records the URNs of the resources referred to by the given value of the given top-level property.
+func (refs referenceTable) collect(v resource.PropertyValue, key string, self resource.URN,
+	allowed func(resource.URN) bool, referenced map[resource.URN]bool,
+) {
+	switch {
+	case v.IsString():
+		if ref, ok := refs.lookup(v.StringValue(), key, self, allowed); ok {
+			referenced[ref.urn] = true
+		}
+	case v.IsArray():
+		for _, e := range v.ArrayValue() {
+			refs.collect(e, key, self, allowed, referenced)
+		}
+	case v.IsObject():
+		for _, e := range v.ObjectValue() {
+			refs.collect(e, key, self, allowed, referenced)
+		}
+	case v.IsSecret():
+		refs.collect(v.SecretValue().Element, key, self, allowed, referenced)
+	}
+}
+
+// replace replaces a string literal within the given top-level property that refers to a generated resource with a
+// reference to the corresponding attribute of that resource.
+func (refs referenceTable) replace(x model.Expression, key string, self resource.URN,
+	allowed func(resource.URN) bool, names NameTable,
+) model.Expression {
+	template, ok := x.(*model.TemplateExpression)
+	if !ok || len(template.Parts) != 1 {
+		return x
+	}
+	lit, ok := template.Parts[0].(*model.LiteralValueExpression)
+	if !ok || !lit.Value.Type().Equals(cty.String) || lit.Value.IsNull() {
+		return x
+	}
+	ref, ok := refs.lookup(lit.Value.AsString(), key, self, allowed)
+	if !ok {
+		return x
+	}
+	name := names[ref.urn]
+	return &model.ScopeTraversalExpression{
+		RootName:  name,
+		Traversal: hcl.Traversal{hcl.TraverseRoot{Name: name}, hcl.TraverseAttr{Name: ref.attribute}},
+	}
+}
+
+// generateReferencingDefinitions generates a program for the given resource states in which property values that
+// refer to other named resources are replaced with references to those resources. The allowed function returns the
+// resources that each state may refer to, or nil if it may refer to any named resource. Only those dependencies that
+// are named and not already implied by such references are kept as explicit dependsOn options, and parents that are
+// not named are dropped.
+func generateReferencingDefinitions(w io.Writer, loader schema.Loader, gen LanguageGenerator,
+	states []*resource.State, names NameTable, refs referenceTable,
+	allowed func(state *resource.State) func(resource.URN) bool,
+) error {
+	var hcl2Text bytes.Buffer
+	for i, state := range states {
+		allow := allowed(state)
+
+		referenced := map[resource.URN]bool{}
+		for k, v := range state.Inputs {
+			refs.collect(v, string(k), state.URN, allow, referenced)
+		}
+
+		s := *state
+		if _, ok := names[s.Parent]; !ok {
+			s.Parent = ""
+		}
+		s.Dependencies = nil
+		for _, dep := range state.Dependencies {
+			if _, ok := names[dep]; ok && !referenced[dep] && dep != state.Parent {
+				s.Dependencies = append(s.Dependencies, dep)
+			}
+		}
+
+		block, err := GenerateHCL2Definition(loader, &s, names)
+		if err != nil {
+			return err
+		}
+		for _, item := range block.Body.Items {
+			attr, ok := item.(*model.Attribute)
+			if !ok || attr.Name == "__logicalName" {
+				continue
+			}
+			value, diags := model.VisitExpression(attr.Value, model.IdentityVisitor,
+				func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+					return refs.replace(x, attr.Name, state.URN, allow, names), nil
+				})
+			contract.Assertf(len(diags) == 0, "unexpected diagnostics: %v", diags)
+			attr.Value = value
+		}
+
+		pre := ""
+		if i > 0 {
+			pre = "\n"
+		}
+		_, err = fmt.Fprintf(&hcl2Text, "%s%v", pre, block)
+		contract.IgnoreError(err)
+	}
+
+	return generateLanguage(w, loader, gen, &hcl2Text)
+}
@@ -0,0 +1,160 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestReferenceTableLookup(t *testing.T) {
+	t.Parallel()
+
+	a := resource.URN("urn:pulumi:stack::project::pkg:index:Res::a")
+	b := resource.URN("urn:pulumi:stack::project::pkg:index:Res::b")
+	c := resource.URN("urn:pulumi:stack::project::pkg:index:Res::c")
+	refs := newOutputReferenceTable([]*resource.State{
+		{URN: a, ID: "id-a", Outputs: resource.PropertyMap{
+			"arn":    resource.NewStringProperty("arn-a"),
+			"region": resource.NewStringProperty("west"),
+			"name":   resource.NewStringProperty("id-a"),
+		}},
+		{URN: b, ID: "id-b", Outputs: resource.PropertyMap{
+			"region": resource.NewStringProperty("west"),
+			"arn":    resource.NewStringProperty("arn-shared"),
+		}},
+		{URN: c, ID: "id-c", Outputs: resource.PropertyMap{
+			"arn": resource.NewStringProperty("arn-shared"),
+		}},
+	}, NameTable{a: "a", b: "b", c: "c"})
+
+	// IDs are preferred over outputs that hold the same value.
+	ref, ok := refs.lookup("id-a", "vpcId", c, nil)
+	require.True(t, ok)
+	assert.Equal(t, resourceReference{urn: a, attribute: "id"}, ref)
+
+	ref, ok = refs.lookup("arn-a", "roleArn", c, nil)
+	require.True(t, ok)
+	assert.Equal(t, resourceReference{urn: a, attribute: "arn", output: true}, ref)
+
+	// Shared settings are not references, and resources do not refer to themselves.
+	_, ok = refs.lookup("west", "region", c, nil)
+	assert.False(t, ok)
+	_, ok = refs.lookup("id-a", "vpcId", a, nil)
+	assert.False(t, ok)
+
+	// Ambiguous values only refer to a resource if the others are not allowed.
+	_, ok = refs.lookup("arn-shared", "roleArn", a, nil)
+	assert.False(t, ok)
+	ref, ok = refs.lookup("arn-shared", "roleArn", a, func(urn resource.URN) bool { return urn == c })
+	require.True(t, ok)
+	assert.Equal(t, c, ref.urn)
+}
+
+func TestGenerateImportDefinitions(t *testing.T) {
+	t.Parallel()
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+
+	petURN := resource.NewURN("stack", "project", "", "random:index/randomPet:RandomPet", "pet")
+	stringURN := resource.NewURN("stack", "project", "", "random:index/randomString:RandomString", "str")
+	otherURN := resource.NewURN("stack", "project", "", "random:index/randomString:RandomString", "other")
+	unnamedURN := resource.NewURN("stack", "project", "", "random:index/randomPet:RandomPet", "unnamed")
+
+	existing := []*resource.State{{
+		Type:    petURN.Type(),
+		URN:     petURN,
+		Custom:  true,
+		ID:      "happy-cat",
+		Inputs:  resource.PropertyMap{"length": resource.NewNumberProperty(2)},
+		Outputs: resource.PropertyMap{"id": resource.NewStringProperty("happy-cat")},
+	}}
+	states := []*resource.State{
+		{
+			Type:   stringURN.Type(),
+			URN:    stringURN,
+			Custom: true,
+			ID:     "s3cr3t",
+			Inputs: resource.PropertyMap{
+				"length": resource.NewNumberProperty(8),
+				"keepers": resource.NewObjectProperty(resource.PropertyMap{
+					"pet": resource.NewStringProperty("happy-cat"),
+				}),
+			},
+			Outputs:      resource.PropertyMap{"result": resource.NewStringProperty("abcdefgh")},
+			Dependencies: []resource.URN{petURN, unnamedURN},
+		},
+		{
+			Type:   otherURN.Type(),
+			URN:    otherURN,
+			Custom: true,
+			ID:     "other",
+			Inputs: resource.PropertyMap{
+				"length": resource.NewNumberProperty(8),
+				"keepers": resource.NewObjectProperty(resource.PropertyMap{
+					"pet":    resource.NewStringProperty("happy-cat"),
+					"result": resource.NewStringProperty("abcdefgh"),
+				}),
+			},
+			Dependencies: []resource.URN{stringURN},
+		},
+	}
+	names := NameTable{petURN: "pet", stringURN: "str", otherURN: "other"}
+
+	var program *pcl.Program
+	err := GenerateImportDefinitions(io.Discard, loader, func(_ io.Writer, p *pcl.Program) error {
+		program = p
+		return nil
+	}, states, existing, names)
+	require.NoError(t, err)
+	require.Len(t, program.Nodes, 2)
+
+	keepers := func(r *pcl.Resource) map[string]string {
+		result := map[string]string{}
+		for _, attr := range r.Inputs {
+			if attr.Name != "keepers" {
+				continue
+			}
+			obj, ok := attr.Value.(*model.ObjectConsExpression)
+			require.True(t, ok)
+			for _, item := range obj.Items {
+				result[compact(item.Key)] = compact(item.Value)
+			}
+		}
+		return result
+	}
+
+	str, ok := program.Nodes[0].(*pcl.Resource)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{`"pet"`: "pet.id"}, keepers(str))
+	// The dependency on the pet is implied by the reference, and the unnamed resource is dropped.
+	if str.Options != nil {
+		assert.Nil(t, str.Options.DependsOn)
+	}
+
+	// Only resources that the imported resource depends on are referred to.
+	other, ok := program.Nodes[1].(*pcl.Resource)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{`"pet"`: `"happy-cat"`, `"result"`: "str.result"}, keepers(other))
+}
@@ -0,0 +1,35 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"io"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// GenerateStateDefinitions generates a program that recreates the given resource states, which must be in dependency
+// order. Unlike GenerateLanguageDefinitions, the resources are generated as a single program: property values that
+// hold the ID or URN of another generated resource are replaced with references to that resource, and only those
+// dependencies that are not already implied by such references are kept as explicit dependsOn options. Parents and
+// dependencies that are not present in the name table are dropped.
+func GenerateStateDefinitions(w io.Writer, loader schema.Loader, gen LanguageGenerator, states []*resource.State,
+	names NameTable,
+) error {
+	refs := newReferenceTable(states, names)
+	return generateReferencingDefinitions(w, loader, gen, states, names, refs,
+		func(*resource.State) func(resource.URN) bool { return nil })
+}
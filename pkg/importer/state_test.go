@@ -0,0 +1,120 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/pcl"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/utils"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// compact renders an expression without any whitespace.
+func compact(x model.Expression) string {
+	return strings.Join(strings.Fields(fmt.Sprintf("%v", x)), "")
+}
+
+func TestGenerateStateDefinitions(t *testing.T) {
+	t.Parallel()
+
+	loader := schema.NewPluginLoader(utils.NewHost(testdataPath))
+
+	providerURN := resource.NewURN("stack", "project", "", providers.MakeProviderType("random"), "explicit")
+	petURN := resource.NewURN("stack", "project", "", "random:index/randomPet:RandomPet", "pet")
+	stringURN := resource.NewURN("stack", "project", "", "random:index/randomString:RandomString", "str")
+	otherURN := resource.NewURN("stack", "project", "", "random:index/randomPet:RandomPet", "other")
+
+	providerRef, err := providers.NewReference(providerURN, "04da6b54-80e4-46f7-96ec-b56ff0331ba9")
+	require.NoError(t, err)
+
+	states := []*resource.State{
+		{
+			Type:   providerURN.Type(),
+			URN:    providerURN,
+			Custom: true,
+			ID:     "04da6b54-80e4-46f7-96ec-b56ff0331ba9",
+			Inputs: resource.PropertyMap{},
+		},
+		{
+			Type:     petURN.Type(),
+			URN:      petURN,
+			Custom:   true,
+			ID:       "happy-cat",
+			Inputs:   resource.PropertyMap{"length": resource.NewNumberProperty(2)},
+			Provider: providerRef.String(),
+		},
+		{
+			Type:   stringURN.Type(),
+			URN:    stringURN,
+			Custom: true,
+			ID:     "s3cr3t",
+			Inputs: resource.PropertyMap{
+				"length": resource.NewNumberProperty(8),
+				"keepers": resource.NewObjectProperty(resource.PropertyMap{
+					"pet": resource.NewStringProperty("happy-cat"),
+				}),
+			},
+			Provider:       providerRef.String(),
+			Dependencies:   []resource.URN{petURN, otherURN},
+			Protect:        true,
+			RetainOnDelete: true,
+			IgnoreChanges:  []string{"keepers.pet", `keepers["kubernetes.io/role"]`},
+		},
+	}
+	names := NameTable{providerURN: "explicit", petURN: "pet", stringURN: "str"}
+
+	var program *pcl.Program
+	err = GenerateStateDefinitions(io.Discard, loader, func(_ io.Writer, p *pcl.Program) error {
+		program = p
+		return nil
+	}, states, names)
+	require.NoError(t, err)
+	require.Len(t, program.Nodes, 3)
+
+	str, ok := program.Nodes[2].(*pcl.Resource)
+	require.True(t, ok)
+	assert.Equal(t, "str", str.Name())
+
+	var keepers *model.ObjectConsExpression
+	for _, attr := range str.Inputs {
+		if attr.Name == "keepers" {
+			keepers, ok = attr.Value.(*model.ObjectConsExpression)
+			require.True(t, ok)
+		}
+	}
+	require.NotNil(t, keepers)
+	require.Len(t, keepers.Items, 1)
+	pet, ok := keepers.Items[0].Value.(*model.ScopeTraversalExpression)
+	require.True(t, ok)
+	assert.Equal(t, "pet.id", compact(pet))
+
+	require.NotNil(t, str.Options)
+	assert.Equal(t, "explicit", compact(str.Options.Provider))
+	assert.NotNil(t, str.Options.Protect)
+	assert.NotNil(t, str.Options.RetainOnDelete)
+	assert.Equal(t, `[keepers.pet,keepers["kubernetes.io/role"]]`, compact(str.Options.IgnoreChanges))
+	// The dependency on the pet is implied by the keepers reference, and "other" is not being generated.
+	assert.Nil(t, str.Options.DependsOn)
+}
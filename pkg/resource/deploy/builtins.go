@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 
 	uuid "github.com/gofrs/uuid"
 
@@ -23,6 +24,10 @@ type builtinProvider struct {
 
 	backendClient BackendClient
 	resources     *resourceMap
+
+	stackOutputReadsLock sync.Mutex
+	// stackOutputReads holds the names of the outputs that the program read through each of its stack references.
+	stackOutputReads map[resource.URN]map[resource.PropertyKey]bool
 }
 
 func newBuiltinProvider(backendClient BackendClient, resources *resourceMap, d diag.Sink) *builtinProvider {
@@ -187,6 +192,10 @@ func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
 	}, resource.StatusOK, nil
 }
 
+func (p *builtinProvider) List(typ tokens.Type, pageToken string) (plugin.ListResult, error) {
+	return plugin.ListResult{}, plugin.ErrNotYetImplemented
+}
+
 func (p *builtinProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name string, parent resource.URN,
 	inputs resource.PropertyMap, options plugin.ConstructOptions,
 ) (plugin.ConstructResult, error) {
@@ -197,6 +206,7 @@ const (
 	readStackOutputs         = "pulumi:pulumi:readStackOutputs"
 	readStackResourceOutputs = "pulumi:pulumi:readStackResourceOutputs" //nolint:gosec // not a credential
 	getResource              = "pulumi:pulumi:getResource"
+	recordStackOutputRead    = "pulumi:pulumi:recordStackOutputRead"
 )
 
 func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
@@ -221,6 +231,11 @@ func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
 			return nil, nil, err
 		}
 		return outs, nil, nil
+	case recordStackOutputRead:
+		if err := p.recordStackOutputRead(args); err != nil {
+			return nil, nil, err
+		}
+		return resource.PropertyMap{}, nil, nil
 	default:
 		return nil, nil, fmt.Errorf("unrecognized function name: '%v'", tok)
 	}
@@ -282,6 +297,67 @@ func (p *builtinProvider) readStackReference(inputs resource.PropertyMap) (resou
 	}, nil
 }
 
+// newStackDependency returns the dependency of the given stack reference on the stack that it refers to, or nil if the
+// resource is not a stack reference. The outputs that the program reads through the reference are only known once
+// the program has run, so they are recorded later by recordStackOutputReads.
+func newStackDependency(state *resource.State) *resource.StackDependency {
+	if state.Type != stackReferenceType {
+		return nil
+	}
+	name, ok := state.Outputs["name"]
+	if !ok || !name.IsString() {
+		return nil
+	}
+	return &resource.StackDependency{Stack: name.StringValue()}
+}
+
+// recordStackOutputRead records that the program read the named output through the given stack reference. SDKs call
+// this as their stack references' outputs are read, e.g. by `getOutput`.
+func (p *builtinProvider) recordStackOutputRead(inputs resource.PropertyMap) error {
+	urn, ok := inputs["urn"]
+	contract.Assertf(ok, "missing required property 'urn'")
+	contract.Assertf(urn.IsString(), "expected 'urn' to be a string")
+	name, ok := inputs["name"]
+	contract.Assertf(ok, "missing required property 'name'")
+	contract.Assertf(name.IsString(), "expected 'name' to be a string")
+
+	ref := resource.URN(urn.StringValue())
+	if !ref.IsValid() || ref.Type() != stackReferenceType {
+		return fmt.Errorf("%v is not a stack reference", ref)
+	}
+
+	p.stackOutputReadsLock.Lock()
+	defer p.stackOutputReadsLock.Unlock()
+	if p.stackOutputReads == nil {
+		p.stackOutputReads = map[resource.URN]map[resource.PropertyKey]bool{}
+	}
+	reads, ok := p.stackOutputReads[ref]
+	if !ok {
+		reads = map[resource.PropertyKey]bool{}
+		p.stackOutputReads[ref] = reads
+	}
+	reads[resource.PropertyKey(name.StringValue())] = true
+	return nil
+}
+
+// stackOutputsRead returns the sorted names of the outputs that the program read through each of its stack
+// references.
+func (p *builtinProvider) stackOutputsRead() map[resource.URN][]resource.PropertyKey {
+	p.stackOutputReadsLock.Lock()
+	defer p.stackOutputReadsLock.Unlock()
+
+	result := make(map[resource.URN][]resource.PropertyKey, len(p.stackOutputReads))
+	for urn, reads := range p.stackOutputReads {
+		keys := make([]resource.PropertyKey, 0, len(reads))
+		for k := range reads {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		result[urn] = keys
+	}
+	return result
+}
+
 func (p *builtinProvider) readStackResourceOutputs(inputs resource.PropertyMap) (resource.PropertyMap, error) {
 	name, ok := inputs["stackName"]
 	contract.Assertf(ok, "missing required property 'stackName'")
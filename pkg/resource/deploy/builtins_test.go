@@ -223,6 +223,25 @@ func TestBuiltinProvider(t *testing.T) {
 			})
 		})
 	})
+	t.Run("Invoke recordStackOutputRead", func(t *testing.T) {
+		t.Parallel()
+		p := &builtinProvider{}
+		ref := resource.NewURN("stack", "project", "", stackReferenceType, "ref")
+		for _, name := range []string{"b", "a", "b"} {
+			_, _, err := p.Invoke(recordStackOutputRead, resource.PropertyMap{
+				"urn":  resource.NewStringProperty(string(ref)),
+				"name": resource.NewStringProperty(name),
+			})
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, map[resource.URN][]resource.PropertyKey{ref: {"a", "b"}}, p.stackOutputsRead())
+
+		_, _, err := p.Invoke(recordStackOutputRead, resource.PropertyMap{
+			"urn":  resource.NewStringProperty(string(resource.NewURN("stack", "project", "", "pkg:m:typ", "res"))),
+			"name": resource.NewStringProperty("a"),
+		})
+		assert.ErrorContains(t, err, "is not a stack reference")
+	})
 	t.Run("StreamInvoke (unimplemented)", func(t *testing.T) {
 		t.Parallel()
 		p := &builtinProvider{}
@@ -47,6 +47,10 @@ type BackendClient interface {
 	// `Propertymap` with members `type` (containing the Pulumi type ID for the resource) and
 	// `outputs` (containing the resource outputs themselves).
 	GetStackResourceOutputs(ctx context.Context, stackName string) (resource.PropertyMap, error)
+
+	// GetStackDependents returns the stacks that read the outputs of the named stack through stack references, mapped
+	// to the names of the outputs that each of them read.
+	GetStackDependents(ctx context.Context, name string) (map[string][]resource.PropertyKey, error)
 }
 
 // Options controls the deployment process.
@@ -63,6 +67,9 @@ type Options struct {
 	DisableResourceReferences bool       // true to disable resource reference support.
 	DisableOutputValues       bool       // true to disable output value support.
 	GeneratePlan              bool       // true to enable plan generation.
+
+	// ReplacementGuard optionally blocks replacements and deletes of resources that were not explicitly allowed.
+	ReplacementGuard *ReplacementGuard
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -193,6 +200,7 @@ type StepExecutorEvents interface {
 	OnResourceStepPre(step Step) (interface{}, error)
 	OnResourceStepPost(ctx interface{}, step Step, status resource.Status, err error) error
 	OnResourceOutputs(step Step) error
+	OnResourceHook(step Step, hook string, event resource.ResourceHookEvent, err error)
 }
 
 // PolicyEvents is an interface that can be used to hook policy events.
@@ -294,6 +302,8 @@ type Deployment struct {
 	source               Source                           // the source of new resources.
 	localPolicyPackPaths []string                         // the policy packs to run during this deployment's generation.
 	preview              bool                             // true if this deployment is to be previewed.
+	backendClient        BackendClient                    // the backend client used to read other stacks, if any.
+	builtins             *builtinProvider                 // the builtin provider for this deployment.
 	depGraph             *graph.DependencyGraph           // the dependency graph of the old snapshot.
 	providers            *providers.Registry              // the provider registry for this deployment.
 	goals                *goalMap                         // the set of resource goals generated by the deployment.
@@ -480,6 +490,8 @@ func NewDeployment(ctx *plugin.Context, target *Target, prev *Snapshot, plan *Pl
 		source:               source,
 		localPolicyPackPaths: localPolicyPackPaths,
 		preview:              preview,
+		backendClient:        backendClient,
+		builtins:             builtins,
 		depGraph:             depGraph,
 		providers:            reg,
 		goals:                newGoals,
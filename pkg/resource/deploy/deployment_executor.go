@@ -38,6 +38,8 @@ type deploymentExecutor struct {
 
 	stepGen  *stepGenerator // step generator owned by this deployment
 	stepExec *stepExecutor  // step executor owned by this deployment
+
+	blockedSteps []Step // steps that were blocked by the replacement guard
 }
 
 // checkTargets validates that all the targets passed in refer to existing resources.  Diagnostics
@@ -103,6 +105,45 @@ func (ex *deploymentExecutor) printPendingOperationsWarning() {
 	ex.deployment.Diag().Warningf(diag.RawMessage("" /*urn*/, warning))
 }
 
+// checkReplacementGuard records the given steps that are blocked by the replacement guard. During an update, blocked
+// steps are an error; during a preview they are reported once the preview completes.
+func (ex *deploymentExecutor) checkReplacementGuard(guard *ReplacementGuard, steps []Step) error {
+	var blocked []Step
+	for _, step := range steps {
+		var inputs resource.PropertyMap
+		if old := step.Old(); old != nil {
+			inputs = old.Inputs
+		}
+		if guard.Blocks(step.Op(), step.URN(), inputs) {
+			logging.V(7).Infof("Replacement guard blocked %v of '%v'", step.Op(), step.URN())
+			blocked = append(blocked, step)
+		}
+	}
+	if len(blocked) == 0 {
+		return nil
+	}
+
+	ex.blockedSteps = append(ex.blockedSteps, blocked...)
+	if ex.stepExec.preview {
+		return nil
+	}
+	return blockedStepsError(blocked)
+}
+
+// reportBlockedSteps issues a summary of the steps that were blocked by the replacement guard, if any.
+func (ex *deploymentExecutor) reportBlockedSteps(preview bool) {
+	if len(ex.blockedSteps) == 0 {
+		return
+	}
+
+	message := blockedStepsMessage(ex.blockedSteps, preview)
+	if preview {
+		ex.deployment.Diag().Warningf(diag.RawMessage("" /*urn*/, message))
+	} else {
+		ex.deployment.Diag().Errorf(diag.RawMessage("" /*urn*/, message))
+	}
+}
+
 // reportExecResult issues an appropriate diagnostic depending on went wrong.
 func (ex *deploymentExecutor) reportExecResult(message string, preview bool) {
 	kind := "update"
@@ -209,6 +250,7 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 	//     should bail.
 	//  3. The stepExecCancel cancel context gets canceled. This means some error occurred in the step executor
 	//     and we need to bail. This can also happen if the user hits Ctrl-C.
+	sourceDone := false
 	canceled, err := func() (bool, error) {
 		logging.V(4).Infof("deploymentExecutor.Execute(...): waiting for incoming events")
 		for {
@@ -228,6 +270,8 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 				}
 
 				if event.Event == nil {
+					sourceDone = true
+
 					// Check targets before performDeletes mutates the initial Snapshot.
 					targetErr := ex.checkTargets(opts.Targets)
 
@@ -268,6 +312,35 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 	ex.stepExec.WaitForCompletion()
 	logging.V(4).Infof("deploymentExecutor.Execute(...): step executor has completed")
 
+	// If the source has finished, close it now that all of the steps have completed. This lets a program that is waiting
+	// for the deployment to finish, so that it can serve the hooks of resources that are deleted after it has finished,
+	// exit. A source that has not finished is left running, as closing it would fail the program's outstanding requests.
+	if sourceDone {
+		if closeErr := src.Close(); closeErr != nil {
+			logging.V(4).Infof("deploymentExecutor.Execute(...): error closing source: %v", closeErr)
+			if !result.IsBail(closeErr) {
+				ex.reportError("", closeErr)
+			}
+			if err == nil {
+				err = result.BailError(closeErr)
+			}
+		}
+	}
+
+	ex.reportBlockedSteps(preview)
+	ex.stepExec.WarnOnRemovedDependentOutputs()
+
+	// Now that the program has exited, record the outputs that it read through its stack references.
+	if !canceled {
+		if rErr := ex.stepExec.RecordStackOutputReads(); rErr != nil {
+			logging.V(4).Infof("deploymentExecutor.Execute(...): error recording stack output reads: %v", rErr)
+			ex.reportError("", rErr)
+			if err == nil {
+				err = result.BailError(rErr)
+			}
+		}
+	}
+
 	// Check that we did operations for everything expected in the plan. We mutate ResourcePlan.Ops as we run
 	// so by the time we get here everything in the map should have an empty ops list (except for unneeded
 	// deletes). We skip this check if we already have an error, chances are if the deployment failed lots of
@@ -372,6 +445,10 @@ func (ex *deploymentExecutor) performDeletes(
 		return err
 	}
 
+	if err := ex.checkReplacementGuard(ex.stepGen.opts.ReplacementGuard, deleteSteps); err != nil {
+		return err
+	}
+
 	deletes := ex.stepGen.ScheduleDeletes(deleteSteps)
 
 	// ScheduleDeletes gives us a list of lists of steps. Each list of steps can safely be executed
@@ -414,6 +491,10 @@ func (ex *deploymentExecutor) handleSingleEvent(event SourceEvent) error {
 		return err
 	}
 
+	if err := ex.checkReplacementGuard(ex.stepGen.opts.ReplacementGuard, steps); err != nil {
+		return err
+	}
+
 	ex.stepExec.ExecuteSerial(steps)
 	return nil
 }
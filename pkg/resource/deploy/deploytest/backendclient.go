@@ -24,6 +24,7 @@ import (
 type BackendClient struct {
 	GetStackOutputsF         func(ctx context.Context, name string) (resource.PropertyMap, error)
 	GetStackResourceOutputsF func(ctx context.Context, name string) (resource.PropertyMap, error)
+	GetStackDependentsF      func(ctx context.Context, name string) (map[string][]resource.PropertyKey, error)
 }
 
 // GetStackOutputs returns the outputs (if any) for the named stack or an error if the stack cannot be found.
@@ -41,3 +42,14 @@ func (b *BackendClient) GetStackResourceOutputs(
 ) (resource.PropertyMap, error) {
 	return b.GetStackResourceOutputsF(ctx, name)
 }
+
+// GetStackDependents returns the stacks that read the outputs of the named stack through stack references, mapped
+// to the names of the outputs that each of them read. If no function is set, the stack has no dependents.
+func (b *BackendClient) GetStackDependents(
+	ctx context.Context, name string,
+) (map[string][]resource.PropertyKey, error) {
+	if b.GetStackDependentsF == nil {
+		return nil, nil
+	}
+	return b.GetStackDependentsF(ctx, name)
+}
@@ -462,6 +462,12 @@ func (host *pluginHost) EnsurePlugins(plugins []workspace.PluginSpec, kinds plug
 	return nil
 }
 
+// DisableSchemaCache keeps schema loaders from using the on-disk schema cache for the plugins of the host, which are
+// fakes whose schemas must not be mixed up with those of real plugins.
+func (host *pluginHost) DisableSchemaCache() bool {
+	return true
+}
+
 func (host *pluginHost) ResolvePlugin(
 	kind workspace.PluginKind, name string, version *semver.Version,
 ) (*workspace.PluginInfo, error) {
@@ -58,6 +58,7 @@ type Provider struct {
 		oldInputs, oldOutputs resource.PropertyMap, timeout float64) (resource.Status, error)
 	ReadF func(urn resource.URN, id resource.ID,
 		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
+	ListF func(typ tokens.Type, pageToken string) (plugin.ListResult, error)
 
 	ConstructF func(monitor *ResourceMonitor, typ, name string, parent resource.URN, inputs resource.PropertyMap,
 		info plugin.ConstructInfo, options plugin.ConstructOptions) (plugin.ConstructResult, error)
@@ -199,6 +200,13 @@ func (prov *Provider) Read(urn resource.URN, id resource.ID,
 	return prov.ReadF(urn, id, inputs, state)
 }
 
+func (prov *Provider) List(typ tokens.Type, pageToken string) (plugin.ListResult, error) {
+	if prov.ListF == nil {
+		return plugin.ListResult{}, plugin.ErrNotYetImplemented
+	}
+	return prov.ListF(typ, pageToken)
+}
+
 func (prov *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name string, parent resource.URN,
 	inputs resource.PropertyMap, options plugin.ConstructOptions,
 ) (plugin.ConstructResult, error) {
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -139,6 +140,7 @@ type ResourceOptions struct {
 	CustomTimeouts          *resource.CustomTimeouts
 	RetainOnDelete          bool
 	DeletedWith             resource.URN
+	DeleteDependencies      []resource.URN
 	SupportsPartialValues   *bool
 	Remote                  bool
 	Providers               map[string]string
@@ -151,6 +153,7 @@ type ResourceOptions struct {
 	GrpcRequestHeaders        map[string]string
 
 	Transforms []*pulumirpc.Callback
+	Hooks      []*pulumirpc.ResourceHook
 }
 
 func (rm *ResourceMonitor) unmarshalProperties(props *structpb.Struct) (resource.PropertyMap, error) {
@@ -193,6 +196,12 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		deps = append(deps, string(d))
 	}
 
+	// marshal delete dependencies
+	var deleteDeps []string
+	for _, d := range opts.DeleteDependencies {
+		deleteDeps = append(deleteDeps, string(d))
+	}
+
 	// marshal aliases
 	aliasStrings := []string{}
 	for _, a := range opts.AliasURNs {
@@ -272,6 +281,8 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		AliasSpecs:                 opts.AliasSpecs,
 		SourcePosition:             sourcePosition,
 		Transforms:                 opts.Transforms,
+		Hooks:                      opts.Hooks,
+		DeleteDependencies:         deleteDeps,
 	}
 
 	ctx := context.Background()
@@ -469,6 +480,11 @@ func (rm *ResourceMonitor) RegisterStackTransform(callback *pulumirpc.Callback)
 	return err
 }
 
+func (rm *ResourceMonitor) SignalAndWaitForShutdown() error {
+	_, err := rm.resmon.SignalAndWaitForShutdown(context.Background(), &emptypb.Empty{})
+	return err
+}
+
 func prepareTestTimeout(timeout float64) string {
 	if timeout == 0 {
 		return ""
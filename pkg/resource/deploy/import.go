@@ -43,6 +43,7 @@ type Import struct {
 	PluginChecksums   map[string][]byte // The provider checksums to use for the resource, if any.
 	Protect           bool              // Whether to mark the resource as protected after import
 	Properties        []string          // Which properties to include (Defaults to required properties)
+	Dependencies      []resource.URN    // Explicit dependencies of the resource, in addition to any that are inferred.
 
 	// True if this import should create an empty component resource. ID must not be set if this is used.
 	Component bool
@@ -324,6 +325,7 @@ func (i *importer) importResources(ctx context.Context) error {
 	// Create a step per resource to import and execute them in parallel batches which don't depend on each other.
 	// If there are duplicates, fail the import.
 	urns := map[resource.URN]struct{}{}
+	imports := map[resource.URN]Import{}
 	steps := slice.Prealloc[Step](len(i.deployment.imports))
 	for _, imp := range i.deployment.imports {
 		parent := imp.Parent
@@ -337,6 +339,7 @@ func (i *importer) importResources(ctx context.Context) error {
 			return fmt.Errorf("duplicate import '%v' of type '%v'", imp.Name, imp.Type)
 		}
 		urns[urn] = struct{}{}
+		imports[urn] = imp
 
 		// If the resource already exists and the ID matches the ID to import, then Same this resource. If the ID does
 		// not match, the step itself will issue an error.
@@ -391,7 +394,8 @@ func (i *importer) importResources(ctx context.Context) error {
 		// Create the new desired state. Note that the resource is protected. Provider might be "" at this point.
 		new := resource.NewState(
 			urn.Type(), urn, !imp.Component, false, imp.ID, resource.PropertyMap{}, nil, parent, imp.Protect,
-			false, nil, nil, provider, nil, false, nil, nil, nil, "", false, "", nil, nil, "")
+			false, append([]resource.URN(nil), imp.Dependencies...), nil, provider, nil, false, nil, nil, nil, "", false, "",
+			nil, nil, "")
 		// Set a dummy goal so the resource is tracked as managed.
 		i.deployment.goals.set(urn, &resource.Goal{})
 
@@ -421,6 +425,18 @@ func (i *importer) importResources(ctx context.Context) error {
 		}
 	}
 
+	for urn, imp := range imports {
+		for _, dep := range imp.Dependencies {
+			if _, ok := imports[dep]; !ok && i.deployment.olds[dep] == nil {
+				return fmt.Errorf("unknown dependency '%v' of resource '%v'", dep, urn)
+			}
+		}
+	}
+
+	// Read the resources to import up front so that the references between them and to existing resources can be
+	// recorded as dependencies, and the resources imported in dependency order.
+	i.inferDependencies(steps, imports)
+
 	// We've created all the steps above but we need to execute them in parallel batches which don't depend on each other
 	for len(urns) > 0 {
 		// Find all the steps that can be executed in parallel. `urns` is a map of every resource we still
@@ -432,11 +448,23 @@ func (i *importer) importResources(ctx context.Context) error {
 				continue
 			}
 
-			// If the step has no dependencies (we actually only need to look at parent), it can be executed in parallel
-			if _, ok := urns[step.New().Parent]; !ok {
+			// If the step's parent and dependencies have been imported, it can be executed in parallel
+			if _, ok := urns[step.New().Parent]; ok {
+				continue
+			}
+			ready := true
+			for _, dep := range step.New().Dependencies {
+				if _, ok := urns[dep]; ok {
+					ready = false
+				}
+			}
+			if ready {
 				parallelSteps = append(parallelSteps, step)
 			}
 		}
+		if len(parallelSteps) == 0 {
+			return errors.New("the resources to import have circular dependencies")
+		}
 
 		// Remove all the urns we're about to import
 		for _, step := range parallelSteps {
@@ -0,0 +1,257 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// A referenceIndex maps string values to the resources whose ID or outputs hold that value, along with the keys of
+// the outputs that hold it. The resource's ID is recorded under the empty key.
+type referenceIndex map[string]map[resource.URN]map[resource.PropertyKey]bool
+
+// add records the ID and the top-level string outputs of the given resource.
+func (idx referenceIndex) add(urn resource.URN, id resource.ID, outputs resource.PropertyMap) {
+	idx.addValue(string(id), urn, "")
+	for k, v := range outputs {
+		// Secret values are never matched, so that inferring a dependency does not reveal them.
+		if v.IsString() {
+			idx.addValue(v.StringValue(), urn, k)
+		}
+	}
+}
+
+func (idx referenceIndex) addValue(value string, urn resource.URN, key resource.PropertyKey) {
+	if value == "" {
+		return
+	}
+	if idx[value] == nil {
+		idx[value] = map[resource.URN]map[resource.PropertyKey]bool{}
+	}
+	if idx[value][urn] == nil {
+		idx[value][urn] = map[resource.PropertyKey]bool{}
+	}
+	idx[value][urn][key] = true
+}
+
+// lookup returns the resources other than self whose ID or outputs hold the given value of the given property, in
+// sorted order. An output with the same name as the property is not a match: resources that share a setting, such as
+// a region, hold the same value under the same name without referring to each other.
+func (idx referenceIndex) lookup(value string, key resource.PropertyKey, self resource.URN) []resource.URN {
+	var urns []resource.URN
+	for urn, keys := range idx[value] {
+		if urn == self {
+			continue
+		}
+		for k := range keys {
+			if k != key {
+				urns = append(urns, urn)
+				break
+			}
+		}
+	}
+	sort.Slice(urns, func(i, j int) bool { return urns[i] < urns[j] })
+	return urns
+}
+
+// walkStrings calls f with the path and value of each non-secret string within the given properties.
+func walkStrings(props resource.PropertyMap, f func(path resource.PropertyPath, value string)) {
+	var walk func(path resource.PropertyPath, v resource.PropertyValue)
+	walk = func(path resource.PropertyPath, v resource.PropertyValue) {
+		switch {
+		case v.IsString():
+			f(path, v.StringValue())
+		case v.IsArray():
+			for i, e := range v.ArrayValue() {
+				walk(append(append(resource.PropertyPath{}, path...), i), e)
+			}
+		case v.IsObject():
+			for _, k := range v.ObjectValue().StableKeys() {
+				walk(append(append(resource.PropertyPath{}, path...), string(k)), v.ObjectValue()[k])
+			}
+		}
+	}
+	for _, k := range props.StableKeys() {
+		walk(resource.PropertyPath{string(k)}, props[k])
+	}
+}
+
+// readImports reads each of the resources to import ahead of time, so that their properties are known before any of
+// them are imported. Resources that fail to read are read again, and their errors reported, by their import steps.
+func (i *importer) readImports(steps []*ImportStep) {
+	parallel := 1
+	if i.executor != nil {
+		parallel = i.executor.opts.DegreeOfParallelism()
+	}
+	if parallel > len(steps) {
+		parallel = len(steps)
+	}
+
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for _, step := range steps {
+		step := step
+		prov, err := getProvider(step, step.provider)
+		if err != nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			read, status, err := prov.Read(step.new.URN, step.new.ID, nil, nil)
+			if err != nil || read.Outputs == nil || read.Inputs == nil {
+				logging.V(7).Infof("could not read %v ahead of import: %v", step.new.URN, err)
+				return
+			}
+			step.read, step.readStatus = &read, status
+		}()
+	}
+	wg.Wait()
+}
+
+// inferDependencies reads the resources to import and records a dependency on another resource for each of their
+// input values that matches the ID or an output of exactly one other resource being imported or already in the stack.
+// Values that match more than one resource are reported rather than guessed at, unless the import's explicit
+// dependencies select one of the matches.
+func (i *importer) inferDependencies(steps []Step, imports map[resource.URN]Import) {
+	var importSteps []*ImportStep
+	for _, step := range steps {
+		if s, ok := step.(*ImportStep); ok && s.new.Custom {
+			importSteps = append(importSteps, s)
+		}
+	}
+	if len(importSteps) == 0 {
+		return
+	}
+	i.readImports(importSteps)
+
+	idx := referenceIndex{}
+	if prev := i.deployment.prev; prev != nil {
+		for _, r := range prev.Resources {
+			if !r.Delete && r.Custom && !providers.IsProviderType(r.Type) {
+				idx.add(r.URN, r.ID, r.Outputs)
+			}
+		}
+	}
+	for _, s := range importSteps {
+		if s.read != nil {
+			id := s.new.ID
+			if s.read.ID != "" {
+				id = s.read.ID
+			}
+			idx.add(s.new.URN, id, s.read.Outputs)
+		}
+	}
+
+	// edges tracks the parents and dependencies of the resources being imported, so that no cycles are introduced.
+	edges := map[resource.URN][]resource.URN{}
+	for _, step := range steps {
+		edges[step.New().URN] = append(edges[step.New().URN], step.New().Dependencies...)
+		if parent := step.New().Parent; parent != "" {
+			edges[step.New().URN] = append(edges[step.New().URN], parent)
+		}
+	}
+	var reaches func(from, to resource.URN, seen map[resource.URN]bool) bool
+	reaches = func(from, to resource.URN, seen map[resource.URN]bool) bool {
+		if from == to {
+			return true
+		}
+		if seen[from] {
+			return false
+		}
+		seen[from] = true
+		for _, next := range edges[from] {
+			if reaches(next, to, seen) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, s := range importSteps {
+		if s.read == nil {
+			continue
+		}
+
+		inputs := s.read.Inputs
+		if properties := imports[s.new.URN].Properties; len(properties) != 0 {
+			inputs = resource.PropertyMap{}
+			for _, p := range properties {
+				if v, ok := s.read.Inputs[resource.PropertyKey(p)]; ok {
+					inputs[resource.PropertyKey(p)] = v
+				}
+			}
+		}
+
+		explicit := map[resource.URN]bool{}
+		for _, dep := range s.new.Dependencies {
+			explicit[dep] = true
+		}
+		inferred := map[resource.URN]bool{}
+		walkStrings(inputs, func(path resource.PropertyPath, value string) {
+			matches := idx.lookup(value, resource.PropertyKey(path[0].(string)), s.new.URN)
+			if len(matches) > 1 {
+				var chosen []resource.URN
+				for _, urn := range matches {
+					if explicit[urn] {
+						chosen = append(chosen, urn)
+					}
+				}
+				if len(chosen) != 1 {
+					names := make([]string, len(matches))
+					for i, urn := range matches {
+						names[i] = string(urn)
+					}
+					i.deployment.Diag().Warningf(diag.Message(s.new.URN,
+						"the value of property '%v' matches more than one resource, so no dependency was "+
+							"recorded: %v; add a dependency on one of them to choose it"),
+						path, strings.Join(names, ", "))
+					return
+				}
+				matches = chosen
+			}
+			if len(matches) != 1 {
+				return
+			}
+
+			dep := matches[0]
+			if dep == s.new.Parent || explicit[dep] || inferred[dep] {
+				return
+			}
+			if reaches(dep, s.new.URN, map[resource.URN]bool{}) {
+				i.deployment.Diag().Warningf(diag.Message(s.new.URN,
+					"the value of property '%v' refers to %v, but recording that dependency would create a cycle"),
+					path, dep)
+				return
+			}
+			inferred[dep] = true
+			edges[s.new.URN] = append(edges[s.new.URN], dep)
+			s.new.Dependencies = append(s.new.Dependencies, dep)
+		})
+	}
+}
@@ -571,6 +571,10 @@ func (r *Registry) Read(urn resource.URN, id resource.ID,
 	return plugin.ReadResult{}, resource.StatusUnknown, errors.New("provider resources may not be read")
 }
 
+func (r *Registry) List(typ tokens.Type, pageToken string) (plugin.ListResult, error) {
+	return plugin.ListResult{}, errors.New("provider resources may not be listed")
+}
+
 func (r *Registry) Construct(info plugin.ConstructInfo, typ tokens.Type, name string, parent resource.URN,
 	inputs resource.PropertyMap, options plugin.ConstructOptions,
 ) (plugin.ConstructResult, error) {
@@ -0,0 +1,199 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// ReplacementGuard describes the resources whose replacement or deletion during an update must be explicitly
+// allowed. Unlike protect, which refuses every delete of a resource, a guarded resource may still be replaced or
+// deleted once the user has allowed it.
+type ReplacementGuard struct {
+	// Types are the resource types whose replacement or deletion is guarded.
+	Types []tokens.Type
+	// URNs are the URNs, or URN globs, of the resources whose replacement or deletion is guarded.
+	URNs UrnTargets
+	// Tags guard the resources whose `tags` input carries one of the given keys. A non-empty value must also match
+	// the value of the resource's tag.
+	Tags map[string]string
+	// Allowed are the URNs, or URN globs, of guarded resources whose replacement or deletion has been allowed.
+	Allowed UrnTargets
+}
+
+// NewReplacementGuard creates a replacement guard from the given resource types, guarded URNs or URN globs, guarded
+// tags, and allowed URNs or URN globs. It returns nil if no resources are guarded.
+func NewReplacementGuard(types, urns []string, tags map[string]string, allowed []string) *ReplacementGuard {
+	if len(types) == 0 && len(urns) == 0 && len(tags) == 0 {
+		return nil
+	}
+
+	guardTypes := make([]tokens.Type, len(types))
+	for i, t := range types {
+		guardTypes[i] = tokens.Type(t)
+	}
+	return &ReplacementGuard{
+		Types:   guardTypes,
+		URNs:    NewUrnTargets(urns),
+		Tags:    tags,
+		Allowed: NewUrnTargets(allowed),
+	}
+}
+
+// Guards returns true if the replacement or deletion of the given resource, whose current inputs are given, must be
+// explicitly allowed and has not been.
+func (g *ReplacementGuard) Guards(urn resource.URN, inputs resource.PropertyMap) bool {
+	if g == nil {
+		return false
+	}
+	if g.Allowed.IsConstrained() && g.Allowed.Contains(urn) {
+		return false
+	}
+	for _, t := range g.Types {
+		if urn.Type() == t {
+			return true
+		}
+	}
+	if g.URNs.IsConstrained() && g.URNs.Contains(urn) {
+		return true
+	}
+	return g.guardsTags(inputs)
+}
+
+// guardsTags returns true if the given inputs carry a `tags` input that matches one of the guard's tags. Tags may be
+// given as a map from keys to values, or as a list of keys, in which case only tags guarded regardless of their value
+// match. Unknown tags never match.
+func (g *ReplacementGuard) guardsTags(inputs resource.PropertyMap) bool {
+	if len(g.Tags) == 0 {
+		return false
+	}
+
+	tags, ok := inputs["tags"]
+	if !ok {
+		return false
+	}
+	if tags.IsSecret() {
+		tags = tags.SecretValue().Element
+	}
+	switch {
+	case tags.IsObject():
+		for key, want := range g.Tags {
+			v, ok := tags.ObjectValue()[resource.PropertyKey(key)]
+			if !ok {
+				continue
+			}
+			if v.IsSecret() {
+				v = v.SecretValue().Element
+			}
+			if want == "" || v.IsString() && v.StringValue() == want {
+				return true
+			}
+		}
+	case tags.IsArray():
+		for _, v := range tags.ArrayValue() {
+			if v.IsSecret() {
+				v = v.SecretValue().Element
+			}
+			if !v.IsString() {
+				continue
+			}
+			if want, ok := g.Tags[v.StringValue()]; ok && want == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Blocks returns true if an operation of the given kind on the given resource, whose current inputs are given, is
+// blocked by the guard. Only replacements and deletes of resources that are still part of the stack are guarded:
+// deleting the old copy of a resource that has already been replaced is not.
+func (g *ReplacementGuard) Blocks(op display.StepOp, urn resource.URN, inputs resource.PropertyMap) bool {
+	switch op {
+	case OpReplace, OpDelete:
+		return g.Guards(urn, inputs)
+	default:
+		return false
+	}
+}
+
+// Allow returns a copy of the guard that also allows the replacement or deletion of the given resources.
+func (g *ReplacementGuard) Allow(urns ...resource.URN) *ReplacementGuard {
+	if g == nil {
+		return nil
+	}
+
+	allowed := g.Allowed.Clone()
+	allowed.literals = append(allowed.literals, urns...)
+	return &ReplacementGuard{
+		Types:   g.Types,
+		URNs:    g.URNs,
+		Tags:    g.Tags,
+		Allowed: allowed,
+	}
+}
+
+// blockedStepsError returns the error that stops an update because of the given steps, which were blocked by a
+// replacement guard, naming each kind of operation that was blocked.
+func blockedStepsError(steps []Step) error {
+	if len(steps) == 1 {
+		return fmt.Errorf("the %v of this resource is blocked by the project's replacementGuard; "+
+			"pass --allow-replace %v to allow it", steps[0].Op(), steps[0].URN())
+	}
+
+	var ops []display.StepOp
+	counts := map[display.StepOp]int{}
+	for _, step := range steps {
+		if counts[step.Op()] == 0 {
+			ops = append(ops, step.Op())
+		}
+		counts[step.Op()]++
+	}
+	parts := make([]string, len(ops))
+	for i, op := range ops {
+		noun := "resources"
+		if counts[op] == 1 {
+			noun = "resource"
+		}
+		parts[i] = fmt.Sprintf("the %v of %d %s", op, counts[op], noun)
+	}
+	verb := "is"
+	if len(parts) > 1 {
+		verb = "are"
+	}
+	return fmt.Errorf("%s %s blocked by the project's replacementGuard", strings.Join(parts, " and "), verb)
+}
+
+// blockedStepsMessage returns a summary of the given steps that were blocked by a replacement guard.
+func blockedStepsMessage(steps []Step, preview bool) string {
+	var sb strings.Builder
+	if preview {
+		fmt.Fprintf(&sb, "this update would replace or delete %d resource(s) guarded by the project's "+
+			"replacementGuard:\n", len(steps))
+	} else {
+		fmt.Fprintf(&sb, "the update was stopped because it would replace or delete %d resource(s) guarded by "+
+			"the project's replacementGuard:\n", len(steps))
+	}
+	for _, step := range steps {
+		fmt.Fprintf(&sb, "    - %s %s\n", step.Op(), step.URN())
+	}
+	sb.WriteString("To proceed, allow each of these with --allow-replace <urn> or confirm them when prompted.")
+	return sb.String()
+}
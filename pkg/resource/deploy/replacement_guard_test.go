@@ -0,0 +1,119 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/slice"
+)
+
+func TestReplacementGuard(t *testing.T) {
+	t.Parallel()
+
+	urnA := resource.NewURN("test", "test", "", "pkgA:m:typA", "resA")
+	urnB := resource.NewURN("test", "test", "", "pkgA:m:typB", "resB")
+	urnC := resource.NewURN("test", "test", "", "pkgA:m:typB", "resC")
+
+	t.Run("no rules", func(t *testing.T) {
+		t.Parallel()
+
+		guard := NewReplacementGuard(nil, nil, nil, []string{string(urnA)})
+		assert.Nil(t, guard)
+		assert.False(t, guard.Guards(urnA, nil))
+		assert.Nil(t, guard.Allow(urnA))
+	})
+
+	t.Run("types and URNs", func(t *testing.T) {
+		t.Parallel()
+
+		guard := NewReplacementGuard([]string{"pkgA:m:typB"}, []string{"**resA"}, nil, nil)
+		assert.True(t, guard.Guards(urnA, nil))
+		assert.True(t, guard.Guards(urnB, nil))
+		assert.True(t, guard.Guards(urnC, nil))
+
+		assert.True(t, guard.Blocks(OpReplace, urnA, nil))
+		assert.True(t, guard.Blocks(OpDelete, urnB, nil))
+		assert.False(t, guard.Blocks(OpUpdate, urnA, nil))
+		assert.False(t, guard.Blocks(OpDeleteReplaced, urnA, nil))
+	})
+
+	t.Run("allowed", func(t *testing.T) {
+		t.Parallel()
+
+		guard := NewReplacementGuard([]string{"pkgA:m:typB"}, nil, nil, []string{"**resB"})
+		assert.False(t, guard.Guards(urnB, nil))
+		assert.True(t, guard.Guards(urnC, nil))
+
+		allowed := guard.Allow(urnC)
+		assert.False(t, allowed.Guards(urnC, nil))
+		// Allowing resources does not modify the original guard.
+		assert.True(t, guard.Guards(urnC, nil))
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		t.Parallel()
+
+		guard := NewReplacementGuard(nil, nil, map[string]string{"protected": "", "env": "prod"}, nil)
+		tags := func(v resource.PropertyValue) resource.PropertyMap {
+			return resource.PropertyMap{"tags": v}
+		}
+		object := func(key, value string) resource.PropertyValue {
+			return resource.NewObjectProperty(resource.PropertyMap{
+				resource.PropertyKey(key): resource.NewStringProperty(value),
+			})
+		}
+
+		assert.False(t, guard.Guards(urnA, nil))
+		assert.True(t, guard.Guards(urnA, tags(object("protected", "yes"))))
+		assert.True(t, guard.Guards(urnA, tags(object("env", "prod"))))
+		assert.False(t, guard.Guards(urnA, tags(object("env", "dev"))))
+		assert.False(t, guard.Guards(urnA, tags(object("owner", "me"))))
+		assert.True(t, guard.Guards(urnA, tags(resource.MakeSecret(object("protected", "")))))
+		assert.False(t, guard.Guards(urnA, tags(resource.MakeComputed(resource.NewStringProperty("")))))
+
+		// A list of tags only matches tags guarded regardless of their value.
+		list := func(keys ...string) resource.PropertyValue {
+			return resource.NewArrayProperty(slice.Map(keys, resource.NewStringProperty))
+		}
+		assert.True(t, guard.Guards(urnA, tags(list("protected"))))
+		assert.False(t, guard.Guards(urnA, tags(list("env"))))
+
+		assert.True(t, guard.Blocks(OpDelete, urnA, tags(list("protected"))))
+		assert.False(t, guard.Allow(urnA).Guards(urnA, tags(list("protected"))))
+	})
+}
+
+func TestBlockedStepsError(t *testing.T) {
+	t.Parallel()
+
+	state := func(name string) *resource.State {
+		return &resource.State{URN: resource.NewURN("test", "test", "", "pkgA:m:typA", name)}
+	}
+	replaceA := &ReplaceStep{old: state("resA"), new: state("resA")}
+	deleteB := &DeleteStep{old: state("resB")}
+	deleteC := &DeleteStep{old: state("resC")}
+
+	assert.EqualError(t, blockedStepsError([]Step{deleteB}),
+		"the delete of this resource is blocked by the project's replacementGuard; "+
+			"pass --allow-replace urn:pulumi:test::test::pkgA:m:typA::resB to allow it")
+	assert.EqualError(t, blockedStepsError([]Step{deleteB, deleteC}),
+		"the delete of 2 resources is blocked by the project's replacementGuard")
+	assert.EqualError(t, blockedStepsError([]Step{replaceA, deleteB, deleteC}),
+		"the replace of 1 resource and the delete of 2 resources are blocked by the project's replacementGuard")
+}
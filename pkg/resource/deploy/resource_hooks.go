@@ -0,0 +1,194 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pulumi/pulumi/pkg/v3/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// wrapResourceHook returns a resource hook that invokes the given callback.
+func (rm *resmon) wrapResourceHook(hook *pulumirpc.ResourceHook) (resource.ResourceHook, error) {
+	if hook.GetCallback().GetTarget() == "" {
+		return resource.ResourceHook{}, fmt.Errorf("resource hook '%v' must specify a callback target", hook.GetName())
+	}
+
+	events := make([]resource.ResourceHookEvent, len(hook.GetEvents()))
+	for i, e := range hook.GetEvents() {
+		event, err := resource.ParseResourceHookEvent(e)
+		if err != nil {
+			return resource.ResourceHook{}, fmt.Errorf("resource hook '%v': %w", hook.GetName(), err)
+		}
+		events[i] = event
+	}
+
+	client, err := rm.GetCallbacksClient(hook.Callback.Target)
+	if err != nil {
+		return resource.ResourceHook{}, err
+	}
+
+	token := hook.Callback.Token
+	run := func(ctx context.Context, args resource.ResourceHookArgs) error {
+		logging.V(5).Infof("ResourceHook: name=%v event=%v urn=%v", hook.GetName(), args.Event, args.URN)
+
+		mopts := plugin.MarshalOptions{
+			Label:         "ResourceHook",
+			KeepSecrets:   true,
+			KeepResources: true,
+		}
+		marshal := func(props resource.PropertyMap) (*structpb.Struct, error) {
+			if props == nil {
+				return nil, nil
+			}
+			return plugin.MarshalProperties(props, mopts)
+		}
+
+		req := &pulumirpc.ResourceHookRequest{
+			Urn:   string(args.URN),
+			Id:    string(args.ID),
+			Type:  string(args.URN.Type()),
+			Name:  args.URN.Name(),
+			Event: string(args.Event),
+		}
+		for _, p := range []struct {
+			props resource.PropertyMap
+			dest  **structpb.Struct
+		}{
+			{args.OldInputs, &req.OldInputs},
+			{args.OldOutputs, &req.OldOutputs},
+			{args.NewInputs, &req.NewInputs},
+			{args.NewOutputs, &req.NewOutputs},
+		} {
+			s, err := marshal(p.props)
+			if err != nil {
+				return fmt.Errorf("marshaling properties: %w", err)
+			}
+			*p.dest = s
+		}
+
+		request, err := proto.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("marshaling request: %w", err)
+		}
+		resp, err := client.Invoke(ctx, &pulumirpc.CallbackInvokeRequest{
+			Token:   token,
+			Request: request,
+		})
+		if err != nil {
+			logging.V(5).Infof("ResourceHook callback error: %v", err)
+			return err
+		}
+
+		var response pulumirpc.ResourceHookResponse
+		if err := proto.Unmarshal(resp.Response, &response); err != nil {
+			return fmt.Errorf("unmarshaling response: %w", err)
+		}
+		if response.Error != "" {
+			return errors.New(response.Error)
+		}
+		return nil
+	}
+
+	return resource.ResourceHook{
+		Name:          hook.GetName(),
+		Events:        events,
+		WarnOnFailure: hook.GetWarnOnFailure(),
+		Run:           run,
+	}, nil
+}
+
+// resourceHookEvents returns the events of the resource hooks that run before and after a step with the given
+// operation. Replace hooks run around the creation of a replacement resource, and delete hooks around the deletion of
+// the resource that it replaced.
+func resourceHookEvents(op display.StepOp) (resource.ResourceHookEvent, resource.ResourceHookEvent, bool) {
+	switch op {
+	case OpCreate:
+		return resource.ResourceHookBeforeCreate, resource.ResourceHookAfterCreate, true
+	case OpUpdate:
+		return resource.ResourceHookBeforeUpdate, resource.ResourceHookAfterUpdate, true
+	case OpDelete, OpDeleteReplaced:
+		return resource.ResourceHookBeforeDelete, resource.ResourceHookAfterDelete, true
+	case OpCreateReplacement:
+		return resource.ResourceHookBeforeReplace, resource.ResourceHookAfterReplace, true
+	default:
+		return "", "", false
+	}
+}
+
+// runResourceHooks runs the hooks of the step's resource that run before or after the step. Hooks are only run for
+// resources registered by the program, and are not run during previews. Programs that register hooks keep serving
+// them until the deployment has finished, so the hooks of resources that are deleted after the program has finished,
+// such as those replaced create-before-delete, can still be run. A failing hook fails the step unless it is marked to
+// warn on failure, in which case the failure is reported as a warning.
+func (se *stepExecutor) runResourceHooks(step Step, before bool) error {
+	if se.preview {
+		return nil
+	}
+	beforeEvent, afterEvent, ok := resourceHookEvents(step.Op())
+	if !ok {
+		return nil
+	}
+	goal, ok := se.deployment.goals.get(step.URN())
+	if !ok || len(goal.Hooks) == 0 {
+		return nil
+	}
+
+	event, args := afterEvent, resource.ResourceHookArgs{URN: step.URN()}
+	if before {
+		event = beforeEvent
+	}
+	args.Event = event
+	if old := step.Old(); old != nil {
+		args.ID, args.OldInputs, args.OldOutputs = old.ID, old.Inputs, old.Outputs
+	}
+	if new := step.New(); new != nil {
+		args.NewInputs = new.Inputs
+		if !before {
+			args.ID, args.NewOutputs = new.ID, new.Outputs
+		}
+	}
+
+	for _, hook := range goal.Hooks {
+		if !hook.RunsOn(event) {
+			continue
+		}
+
+		err := hook.Run(se.ctx, args)
+		if se.opts.Events != nil {
+			se.opts.Events.OnResourceHook(step, hook.Name, event, err)
+		}
+		if err == nil {
+			continue
+		}
+		if hook.WarnOnFailure {
+			se.deployment.Diag().Warningf(diag.Message(step.URN(), "the %v hook '%v' failed: %v"),
+				event, hook.Name, err)
+			continue
+		}
+		return fmt.Errorf("the %v hook '%v' failed: %w", event, hook.Name, err)
+	}
+	return nil
+}
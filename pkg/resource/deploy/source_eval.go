@@ -134,20 +134,22 @@ func (src *evalSource) Iterate(
 	regChan := make(chan *registerResourceEvent)
 	regOutChan := make(chan *registerResourceOutputsEvent)
 	regReadChan := make(chan *readResourceEvent)
+	shutdownChan := make(chan bool)
 	mon, err := newResourceMonitor(
-		src, providers, regChan, regOutChan, regReadChan, opts, config, configSecretKeys, tracingSpan)
+		src, providers, regChan, regOutChan, regReadChan, shutdownChan, opts, config, configSecretKeys, tracingSpan)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start resource monitor: %w", err)
 	}
 
 	// Create a new iterator with appropriate channels, and gear up to go!
 	iter := &evalSourceIterator{
-		mon:         mon,
-		src:         src,
-		regChan:     regChan,
-		regOutChan:  regOutChan,
-		regReadChan: regReadChan,
-		finChan:     make(chan error),
+		mon:          mon,
+		src:          src,
+		regChan:      regChan,
+		regOutChan:   regOutChan,
+		regReadChan:  regReadChan,
+		shutdownChan: shutdownChan,
+		finChan:      make(chan error),
 	}
 
 	// Now invoke Run in a goroutine.  All subsequent resource creation events will come in over the gRPC channel,
@@ -159,18 +161,27 @@ func (src *evalSource) Iterate(
 }
 
 type evalSourceIterator struct {
-	mon         SourceResourceMonitor              // the resource monitor, per iterator.
-	src         *evalSource                        // the owning eval source object.
-	regChan     chan *registerResourceEvent        // the channel that contains resource registrations.
-	regOutChan  chan *registerResourceOutputsEvent // the channel that contains resource completions.
-	regReadChan chan *readResourceEvent            // the channel that contains read resource requests.
-	finChan     chan error                         // the channel that communicates completion.
-	done        bool                               // set to true when the evaluation is done.
+	mon          SourceResourceMonitor              // the resource monitor, per iterator.
+	src          *evalSource                        // the owning eval source object.
+	regChan      chan *registerResourceEvent        // the channel that contains resource registrations.
+	regOutChan   chan *registerResourceOutputsEvent // the channel that contains resource completions.
+	regReadChan  chan *readResourceEvent            // the channel that contains read resource requests.
+	shutdownChan chan bool                          // the channel that is closed when the program has finished.
+	finChan      chan error                         // the channel that communicates completion.
+	done         bool                               // set to true when the evaluation is done.
+	waiting      bool                               // set to true when the program waits for the deployment to finish.
 }
 
 func (iter *evalSourceIterator) Close() error {
 	// Cancel the monitor and reclaim any associated resources.
-	return iter.mon.Cancel()
+	err := iter.mon.Cancel()
+
+	// If the program is waiting for the deployment to finish, canceling the monitor releases it. Wait for it to exit,
+	// and report any error that it exits with.
+	if iter.waiting {
+		err = errors.Join(err, <-iter.finChan)
+	}
+	return err
 }
 
 func (iter *evalSourceIterator) ResourceMonitor() SourceResourceMonitor {
@@ -200,6 +211,12 @@ func (iter *evalSourceIterator) Next() (SourceEvent, error) {
 		contract.Assertf(read != nil, "received a nil readResourceEvent")
 		logging.V(5).Infoln("EvalSourceIterator produced a read")
 		return read, nil
+	case <-iter.shutdownChan:
+		// The program has finished registering resources, and waits for the deployment to finish so that it can serve
+		// the hooks of resources that are deleted after it has finished. It is released when the iterator is closed.
+		logging.V(5).Infof("EvalSourceIterator ended with the program waiting for the deployment to finish")
+		iter.done, iter.waiting = true, true
+		return nil, nil
 	case err := <-iter.finChan:
 		// If we are finished, we can safely exit.  The contract with the language provider is that this implies
 		// that the language runtime has exited and so calling Close on the plugin is fine.
@@ -564,6 +581,8 @@ type resmon struct {
 	regChan                   chan *registerResourceEvent        // the channel to send resource registrations to.
 	regOutChan                chan *registerResourceOutputsEvent // the channel to send resource output registrations to.
 	regReadChan               chan *readResourceEvent            // the channel to send resource reads to.
+	shutdownChan              chan bool                          // the channel to close when the program has finished.
+	shutdownOnce              sync.Once                          // closes the shutdown channel once.
 	cancel                    chan bool                          // a channel that can cancel the server.
 	done                      <-chan error                       // a channel that resolves when the server completes.
 	disableResourceReferences bool                               // true if resource references are disabled.
@@ -581,8 +600,8 @@ var _ SourceResourceMonitor = (*resmon)(nil)
 
 // newResourceMonitor creates a new resource monitor RPC server.
 func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *registerResourceEvent,
-	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent, opts Options,
-	config map[config.Key]string, configSecretKeys []config.Key, tracingSpan opentracing.Span,
+	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent, shutdownChan chan bool,
+	opts Options, config map[config.Key]string, configSecretKeys []config.Key, tracingSpan opentracing.Span,
 ) (*resmon, error) {
 	// Create our cancellation channel.
 	cancel := make(chan bool)
@@ -608,6 +627,7 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 		regChan:                   regChan,
 		regOutChan:                regOutChan,
 		regReadChan:               regReadChan,
+		shutdownChan:              shutdownChan,
 		cancel:                    cancel,
 		disableResourceReferences: opts.DisableResourceReferences,
 		disableOutputValues:       opts.DisableOutputValues,
@@ -795,6 +815,10 @@ func (rm *resmon) SupportsFeature(ctx context.Context,
 		hasSupport = true
 	case "transforms":
 		hasSupport = true
+	case "resourceHooks":
+		hasSupport = true
+	case "deleteDependencies":
+		hasSupport = true
 	}
 
 	logging.V(5).Infof("ResourceMonitor.SupportsFeature(id: %s) = %t", req.Id, hasSupport)
@@ -1224,6 +1248,20 @@ func (rm *resmon) RegisterStackTransform(ctx context.Context, cb *pulumirpc.Call
 	return &emptypb.Empty{}, nil
 }
 
+// SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the monitor
+// is canceled once the deployment has finished. Programs that register resource hooks call this before they exit, so
+// that the hooks of resources that are deleted after the program has finished can still be run.
+func (rm *resmon) SignalAndWaitForShutdown(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	rm.shutdownOnce.Do(func() { close(rm.shutdownChan) })
+
+	select {
+	case <-rm.cancel:
+		return &emptypb.Empty{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // inheritFromParent returns a new goal that inherits from the given parent goal.
 // Currently only inherits DeletedWith from parent.
 func inheritFromParent(child resource.Goal, parent resource.Goal) *resource.Goal {
@@ -1715,6 +1753,10 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	if err != nil {
 		return nil, rpcerror.New(codes.InvalidArgument, fmt.Sprintf("invalid DeletedWith URN: %s", err))
 	}
+	deleteDependencies, err := slice.MapError(req.GetDeleteDependencies(), resource.ParseURN)
+	if err != nil {
+		return nil, rpcerror.New(codes.InvalidArgument, fmt.Sprintf("invalid delete dependency URN: %s", err))
+	}
 	customTimeouts := opts.CustomTimeouts
 
 	additionalSecretOutputs := opts.GetAdditionalSecretOutputs()
@@ -1837,6 +1879,11 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			additionalSecretKeys, parsedAliases, id, &timeouts, replaceOnChanges, retainOnDelete, deletedWith,
 			sourcePosition,
 		)
+		goal.Hooks, err = slice.MapError(req.GetHooks(), rm.wrapResourceHook)
+		if err != nil {
+			return nil, err
+		}
+		goal.DeleteDependencies = deleteDependencies
 
 		if goal.Parent != "" {
 			rm.resGoalsLock.Lock()
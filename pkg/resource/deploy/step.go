@@ -744,6 +744,7 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 			s.new.ID = result.ID
 		}
 	}
+	s.new.StackDependency = newStackDependency(s.new)
 
 	// If we were asked to replace an existing, non-External resource, pend the
 	// deletion here.
@@ -881,6 +882,9 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			&s.old.CustomTimeouts, s.old.ImportID, s.old.RetainOnDelete, s.old.DeletedWith, s.old.Created, s.old.Modified,
 			s.old.SourcePosition,
 		)
+		s.new.IgnoreChanges = s.old.IgnoreChanges
+		s.new.DeleteDependencies = s.old.DeleteDependencies
+		s.new.StackDependency = s.old.StackDependency
 		var inputsChange, outputsChange bool
 		if s.old != nil {
 			inputsChange = !refreshed.Inputs.DeepEquals(s.old.Inputs)
@@ -915,6 +919,8 @@ type ImportStep struct {
 	ignoreChanges []string                       // a list of property paths to ignore when updating.
 	randomSeed    []byte                         // the random seed to use for Check.
 	provider      plugin.Provider                // the optional provider to use.
+	read          *plugin.ReadResult             // the result of reading the resource ahead of time, if any.
+	readStatus    resource.Status                // the status of reading the resource ahead of time.
 }
 
 func NewImportStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State,
@@ -1030,7 +1036,11 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 		var read plugin.ReadResult
-		read, rst, err = prov.Read(s.new.URN, s.new.ID, nil, nil)
+		if s.read != nil {
+			read, rst = *s.read, s.readStatus
+		} else {
+			read, rst, err = prov.Read(s.new.URN, s.new.ID, nil, nil)
+		}
 		if err != nil {
 			if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
 				s.new.InitErrors = initErr.Reasons
@@ -1061,6 +1071,9 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		s.new.Parent, s.new.Protect, false, s.new.Dependencies, s.new.InitErrors, s.new.Provider,
 		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts, s.new.ImportID, s.new.RetainOnDelete,
 		s.new.DeletedWith, nil, nil, s.new.SourcePosition)
+	s.old.IgnoreChanges = s.new.IgnoreChanges
+	s.old.DeleteDependencies = s.new.DeleteDependencies
+	s.old.StackDependency = s.new.StackDependency
 
 	// Import takes a resource that Pulumi did not create and imports it into pulumi state.
 	now := time.Now().UTC()
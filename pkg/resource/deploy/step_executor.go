@@ -18,7 +18,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/promise"
@@ -34,6 +39,9 @@ const (
 
 	// Utility constant for easy debugging.
 	stepExecutorLogLevel = 4
+
+	// How long to wait to find the stacks that read the stack outputs that a deployment removes.
+	stackDependentsTimeout = 30 * time.Second
 )
 
 // StepApplyFailed is a sentinel error for errors that arise when step application fails.
@@ -111,6 +119,9 @@ type stepExecutor struct {
 	// async promise indicating an error seen by the step executor, if multiple errors are seen this will only
 	// record the first.
 	sawError promise.CompletionSource[struct{}]
+
+	// the lookup of the stacks that read any stack outputs removed by this deployment, if any.
+	removedOutputs atomic.Pointer[removedDependentOutputs]
 }
 
 //
@@ -206,6 +217,11 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 		}
 	}
 
+	// Look for other stacks that read any stack outputs that this removes.
+	if reg.New().Type == resource.RootStackType {
+		se.lookupRemovedDependentOutputs(urn, oldOuts, outs)
+	}
+
 	// If we're generating plans save these new outputs to the plan
 	if se.opts.GeneratePlan {
 		if resourcePlan, ok := se.deployment.newPlans.get(urn); ok {
@@ -239,6 +255,125 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 	return nil
 }
 
+// removedDependentOutputs is a lookup of the stacks that read the stack outputs that are being removed.
+type removedDependentOutputs struct {
+	urn     resource.URN           // the URN of the root stack resource.
+	removed []resource.PropertyKey // the names of the outputs that are being removed.
+
+	done       chan struct{}                     // closed once the lookup has completed.
+	dependents map[string][]resource.PropertyKey // the stacks that read this stack's outputs.
+	err        error                             // the error that the lookup failed with, if any.
+}
+
+// lookupRemovedDependentOutputs starts finding the stacks that read any of the stack's outputs that are being removed.
+// Finding them may require reading every stack in the backend, so it's done in the background rather than holding up
+// the registration of the stack's outputs; the results are reported by WarnOnRemovedDependentOutputs.
+func (se *stepExecutor) lookupRemovedDependentOutputs(urn resource.URN, oldOuts, newOuts resource.PropertyMap) {
+	var removed []resource.PropertyKey
+	for _, k := range oldOuts.StableKeys() {
+		if _, has := newOuts[k]; !has {
+			removed = append(removed, k)
+		}
+	}
+	if len(removed) == 0 || se.deployment.backendClient == nil {
+		return
+	}
+
+	target := se.deployment.target
+	name := target.Name.String()
+	if target.Organization != "" {
+		name = fmt.Sprintf("%s/%s/%s", target.Organization, se.deployment.source.Project(), target.Name)
+	}
+
+	lookup := &removedDependentOutputs{urn: urn, removed: removed, done: make(chan struct{})}
+	se.removedOutputs.Store(lookup)
+	go func() {
+		defer close(lookup.done)
+
+		ctx, cancel := context.WithTimeout(se.ctx, stackDependentsTimeout)
+		defer cancel()
+		lookup.dependents, lookup.err = se.deployment.backendClient.GetStackDependents(ctx, name)
+		if lookup.err != nil {
+			lookup.err = fmt.Errorf("getting the dependents of stack %v: %w", name, lookup.err)
+		}
+	}()
+}
+
+// WarnOnRemovedDependentOutputs issues a warning for each of the stack's outputs that is being removed but is read by
+// other stacks through stack references. Finding those stacks is best-effort: if it fails or doesn't complete in time,
+// no warnings are issued.
+func (se *stepExecutor) WarnOnRemovedDependentOutputs() {
+	lookup := se.removedOutputs.Load()
+	if lookup == nil {
+		return
+	}
+	select {
+	case <-lookup.done:
+	case <-time.After(stackDependentsTimeout):
+		logging.V(7).Infof("timed out getting the dependents of the stack")
+		return
+	}
+	if lookup.err != nil {
+		logging.V(7).Infof("failed to get the dependents of the stack: %v", lookup.err)
+		return
+	}
+
+	stacks := make([]string, 0, len(lookup.dependents))
+	for stack := range lookup.dependents {
+		stacks = append(stacks, stack)
+	}
+	sort.Strings(stacks)
+
+	for _, k := range lookup.removed {
+		var readers []string
+		for _, stack := range stacks {
+			if slices.Contains(lookup.dependents[stack], k) {
+				readers = append(readers, stack)
+			}
+		}
+		if len(readers) != 0 {
+			se.deployment.Diag().Warningf(diag.Message(lookup.urn,
+				"the stack output %q is being removed but is read by %s"), k, strings.Join(readers, ", "))
+		}
+	}
+}
+
+// RecordStackOutputReads records the outputs that the program read through each of its stack references in the
+// references' states, and saves them. Outputs may be read at any point while the program runs, so this must only be
+// called once it has exited.
+func (se *stepExecutor) RecordStackOutputReads() error {
+	if se.deployment.builtins == nil {
+		return nil
+	}
+
+	reads := se.deployment.builtins.stackOutputsRead()
+	urns := make([]resource.URN, 0, len(reads))
+	for urn := range reads {
+		urns = append(urns, urn)
+	}
+	sort.Slice(urns, func(i, j int) bool { return urns[i] < urns[j] })
+
+	for _, urn := range urns {
+		value, has := se.pendingNews.Load(urn)
+		if !has {
+			continue
+		}
+		step := value.(Step)
+		dep := step.New().StackDependency
+		if dep == nil {
+			continue
+		}
+		step.New().StackDependency = &resource.StackDependency{Stack: dep.Stack, Outputs: reads[urn]}
+
+		if e := se.opts.Events; e != nil && !se.preview {
+			if err := e.OnResourceOutputs(step); err != nil {
+				return fmt.Errorf("recording the outputs read by %v: %w", urn, err)
+			}
+		}
+	}
+	return nil
+}
+
 // Errored returns whether or not this step executor saw a step whose execution ended in failure.
 func (se *stepExecutor) Errored() error {
 	// See if the sawError promise has been rejected yet
@@ -339,8 +474,13 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 		}
 	}
 
-	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
-	status, stepComplete, err := step.Apply(se.preview)
+	var status resource.Status
+	var stepComplete func()
+	err := se.runResourceHooks(step, true /*before*/)
+	if err == nil {
+		se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
+		status, stepComplete, err = step.Apply(se.preview)
+	}
 
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
@@ -424,6 +564,13 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 		}
 	}
 
+	// Run the hooks that follow the step before it is retired, so that the program that registered them is still
+	// waiting on the step. The results of the step have already been saved, so a failing hook does not lose them.
+	var hookErr error
+	if err == nil {
+		hookErr = se.runResourceHooks(step, false /*before*/)
+	}
+
 	// Calling stepComplete allows steps that depend on this step to continue. OnResourceStepPost saved the results
 	// of the step in the snapshot, so we are ready to go.
 	if stepComplete != nil {
@@ -435,6 +582,12 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 		se.log(workerID, "step %v on %v failed with an error: %v", step.Op(), step.URN(), err)
 		return StepApplyFailed{err}
 	}
+	if hookErr != nil {
+		se.log(workerID, "step %v on %v failed with a hook error: %v", step.Op(), step.URN(), hookErr)
+		// The step itself succeeded, so OnResourceStepPost did not record the failure of the hook.
+		se.deployment.Diag().Errorf(diag.RawMessage(step.URN(), hookErr.Error()))
+		return StepApplyFailed{hookErr}
+	}
 
 	return nil
 }
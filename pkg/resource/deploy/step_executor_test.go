@@ -59,6 +59,7 @@ type mockEvents struct {
 	OnResourceStepPreF   func(step Step) (interface{}, error)
 	OnResourceStepPostF  func(ctx interface{}, step Step, status resource.Status, err error) error
 	OnResourceOutputsF   func(step Step) error
+	OnResourceHookF      func(step Step, hook string, event resource.ResourceHookEvent, err error)
 	OnPolicyViolationF   func(resource.URN, plugin.AnalyzeDiagnostic)
 	OnPolicyRemediationF func(resource.URN, plugin.Remediation, resource.PropertyMap, resource.PropertyMap)
 }
@@ -84,6 +85,14 @@ func (e *mockEvents) OnResourceOutputs(step Step) error {
 	panic("unimplemented")
 }
 
+func (e *mockEvents) OnResourceHook(step Step, hook string, event resource.ResourceHookEvent, err error) {
+	if e.OnResourceHookF != nil {
+		e.OnResourceHookF(step, hook, event, err)
+		return
+	}
+	panic("unimplemented")
+}
+
 func (e *mockEvents) OnPolicyViolation(resource.URN, plugin.AnalyzeDiagnostic) {
 	panic("unimplemented")
 }
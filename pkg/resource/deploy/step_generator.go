@@ -567,6 +567,8 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, err
 		goal.Dependencies, goal.InitErrors, goal.Provider, goal.PropertyDependencies, false,
 		goal.AdditionalSecretOutputs, aliasUrns, &goal.CustomTimeouts, "", goal.RetainOnDelete, goal.DeletedWith,
 		createdAt, modifiedAt, goal.SourcePosition)
+	new.IgnoreChanges = goal.IgnoreChanges
+	new.DeleteDependencies = goal.DeleteDependencies
 
 	// Mark the URN/resource as having been seen. So we can run analyzers on all resources seen, as well as
 	// lookup providers for calculating replacement of resources that use the provider.
@@ -1480,13 +1482,36 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 		stepMap[step.Res()] = step
 	}
 
+	// Resources may also declare that other resources must be deleted after them. These constraints are treated as
+	// extra outgoing edges, so we index the condemned resources by URN in order to look them up. Note that a URN may
+	// be condemned more than once if the old checkpoint contains pending deletes.
+	condemnedByURN := make(map[resource.URN][]*resource.State)
+	for res := range condemned.Iter() {
+		condemnedByURN[res.URN] = append(condemnedByURN[res.URN], res)
+	}
+	// condemnedDeleteDependencies returns the condemned resources that res must be deleted before, other than those
+	// whose ordering has been ignored because it conflicts with the dependencies of the condemned resources.
+	type deleteDependency struct{ res, dep *resource.State }
+	ignoredDeleteDependencies := make(map[deleteDependency]bool)
+	condemnedDeleteDependencies := func(res *resource.State) []*resource.State {
+		var deps []*resource.State
+		for _, urn := range res.DeleteDependencies {
+			for _, dep := range condemnedByURN[urn] {
+				if dep != res && condemned.Contains(dep) && !ignoredDeleteDependencies[deleteDependency{res, dep}] {
+					deps = append(deps, dep)
+				}
+			}
+		}
+		return deps
+	}
+
 	for !condemned.IsEmpty() {
 		var steps antichain
 		logging.V(7).Infof("Planner beginning schedule of new deletion antichain")
 		for res := range condemned.Iter() {
 			// Does res have any outgoing edges to resources that haven't already been removed from the graph?
 			condemnedDependencies := dg.DependenciesOf(res).Intersect(condemned)
-			if condemnedDependencies.IsEmpty() {
+			if condemnedDependencies.IsEmpty() && len(condemnedDeleteDependencies(res)) == 0 {
 				// If not, it's safe to delete res at this stage.
 				logging.V(7).Infof("Planner scheduling deletion of '%v'", res.URN)
 				steps = append(steps, stepMap[res])
@@ -1496,6 +1521,47 @@ func (sg *stepGenerator) ScheduleDeletes(deleteSteps []Step) []antichain {
 			// it can't be deleted this round.
 		}
 
+		// The dependency graph is acyclic, so if nothing could be scheduled this round then the declared deletion
+		// orderings form a cycle with the resources' dependencies. Dependencies win: we find one such cycle, warn
+		// about and ignore the declared orderings in it, and try again.
+		if len(steps) == 0 {
+			// Every condemned resource has an outgoing edge, so following them from any resource must eventually
+			// revisit one, closing a cycle. declared[i] records whether the edge leaving path[i] is a declared ordering.
+			var path []*resource.State
+			var declared []bool
+			seen := make(map[*resource.State]int)
+			res := condemned.ToSlice()[0]
+			for {
+				if _, ok := seen[res]; ok {
+					break
+				}
+				seen[res] = len(path)
+				path = append(path, res)
+				if deps := dg.DependenciesOf(res).Intersect(condemned); !deps.IsEmpty() {
+					res, declared = deps.ToSlice()[0], append(declared, false)
+				} else {
+					deps := condemnedDeleteDependencies(res)
+					contract.Assertf(len(deps) > 0, "condemned resource %v has no outgoing edges", res.URN)
+					res, declared = deps[0], append(declared, true)
+				}
+			}
+
+			cycle := append(slices.Clone(path[seen[res]:]), res)
+			broken := false
+			for i, res := range cycle[:len(cycle)-1] {
+				if declared[seen[res]] {
+					dep := cycle[i+1]
+					ignoredDeleteDependencies[deleteDependency{res, dep}] = true
+					sg.deployment.Diag().Warningf(diag.RawMessage(res.URN, fmt.Sprintf(
+						"the deletion ordering of this resource before %v conflicts with the dependencies of the "+
+							"resources being deleted and will be ignored", dep.URN)))
+					broken = true
+				}
+			}
+			contract.Assertf(broken, "cycle in the dependency graph of condemned resources")
+			continue
+		}
+
 		// For all reosurces that are to be deleted in this round, remove them from the graph.
 		for _, step := range steps {
 			condemned.Remove(step.Res())
@@ -21,10 +21,12 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v3/resource/graph"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIgnoreChanges(t *testing.T) {
@@ -667,6 +669,75 @@ func TestStepGenerator(t *testing.T) {
 			})
 			assert.Len(t, antichains, 3)
 		})
+		t.Run("DeleteDependencies", func(t *testing.T) {
+			t.Parallel()
+			a := &resource.State{URN: "a", DeleteDependencies: []resource.URN{"c"}}
+			b := &resource.State{URN: "b"}
+			c := &resource.State{URN: "c"}
+			sg := &stepGenerator{
+				urns: map[resource.URN]bool{},
+				opts: Options{
+					TrustDependencies: true,
+				},
+				deployment: &Deployment{
+					prev:     &Snapshot{},
+					olds:     map[resource.URN]*resource.State{},
+					depGraph: graph.NewDependencyGraph([]*resource.State{a, b, c}),
+				},
+			}
+			antichains := sg.ScheduleDeletes([]Step{
+				&DeleteStep{old: c},
+				&DeleteStep{old: b},
+				&DeleteStep{old: a},
+			})
+			urns := func(chain antichain) []resource.URN {
+				var urns []resource.URN
+				for _, step := range chain {
+					urns = append(urns, step.URN())
+				}
+				return urns
+			}
+			// a must be deleted before c, b is unconstrained.
+			require.Len(t, antichains, 2)
+			assert.Equal(t, []resource.URN{"a"}, urns(antichains[0]))
+			assert.ElementsMatch(t, []resource.URN{"b", "c"}, urns(antichains[1]))
+		})
+		t.Run("DeleteDependencies conflict", func(t *testing.T) {
+			t.Parallel()
+			// a declares that it must be deleted before both b and c, but b depends on a, so b must be deleted first.
+			a := &resource.State{URN: "a", DeleteDependencies: []resource.URN{"b", "c"}}
+			b := &resource.State{URN: "b", Dependencies: []resource.URN{"a"}}
+			c := &resource.State{URN: "c"}
+			sg := &stepGenerator{
+				urns: map[resource.URN]bool{},
+				opts: Options{
+					TrustDependencies: true,
+				},
+				deployment: &Deployment{
+					ctx:      &plugin.Context{Diag: &deploytest.NoopSink{}},
+					prev:     &Snapshot{},
+					olds:     map[resource.URN]*resource.State{},
+					depGraph: graph.NewDependencyGraph([]*resource.State{a, b, c}),
+				},
+			}
+			antichains := sg.ScheduleDeletes([]Step{
+				&DeleteStep{old: c},
+				&DeleteStep{old: b},
+				&DeleteStep{old: a},
+			})
+			urns := func(chain antichain) []resource.URN {
+				var urns []resource.URN
+				for _, step := range chain {
+					urns = append(urns, step.URN())
+				}
+				return urns
+			}
+			// Only the ordering of a before b is ignored: a is still deleted before c.
+			require.Len(t, antichains, 3)
+			assert.Equal(t, []resource.URN{"b"}, urns(antichains[0]))
+			assert.Equal(t, []resource.URN{"a"}, urns(antichains[1]))
+			assert.Equal(t, []resource.URN{"c"}, urns(antichains[2]))
+		})
 	})
 	t.Run("providerChanged", func(t *testing.T) {
 		t.Parallel()
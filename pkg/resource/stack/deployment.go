@@ -349,6 +349,8 @@ func SerializeResource(res *resource.State, enc config.Encrypter, showSecrets bo
 		ImportID:                res.ImportID,
 		RetainOnDelete:          res.RetainOnDelete,
 		DeletedWith:             res.DeletedWith,
+		IgnoreChanges:           res.IgnoreChanges,
+		DeleteDependencies:      res.DeleteDependencies,
 		Created:                 res.Created,
 		Modified:                res.Modified,
 		SourcePosition:          res.SourcePosition,
@@ -358,6 +360,14 @@ func SerializeResource(res *resource.State, enc config.Encrypter, showSecrets bo
 		v3Resource.CustomTimeouts = &res.CustomTimeouts
 	}
 
+	if dep := res.StackDependency; dep != nil {
+		outputs := make([]string, len(dep.Outputs))
+		for i, k := range dep.Outputs {
+			outputs[i] = string(k)
+		}
+		v3Resource.StackDependency = &apitype.StackDependencyV1{Stack: dep.Stack, Outputs: outputs}
+	}
+
 	return v3Resource, nil
 }
 
@@ -534,11 +544,21 @@ func DeserializeResource(res apitype.ResourceV3, dec config.Decrypter, enc confi
 		return nil, fmt.Errorf("resource '%s' has 'custom' false but non-empty ID", res.URN)
 	}
 
-	return resource.NewState(
+	state := resource.NewState(
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
 		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
 		res.PropertyDependencies, res.PendingReplacement, res.AdditionalSecretOutputs, res.Aliases, res.CustomTimeouts,
-		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition), nil
+		res.ImportID, res.RetainOnDelete, res.DeletedWith, res.Created, res.Modified, res.SourcePosition)
+	state.IgnoreChanges = res.IgnoreChanges
+	state.DeleteDependencies = res.DeleteDependencies
+	if dep := res.StackDependency; dep != nil {
+		outputs := make([]resource.PropertyKey, len(dep.Outputs))
+		for i, k := range dep.Outputs {
+			outputs[i] = resource.PropertyKey(k)
+		}
+		state.StackDependency = &resource.StackDependency{Stack: dep.Stack, Outputs: outputs}
+	}
+	return state, nil
 }
 
 // DeserializeOperation hydrates a pending resource/operation pair.
@@ -107,6 +107,9 @@ func TestDeploymentSerialization(t *testing.T) {
 		nil,
 		"",
 	)
+	res.IgnoreChanges = []string{"in-map.a"}
+	res.DeleteDependencies = []resource.URN{"foo:bar:qux"}
+	res.StackDependency = &resource.StackDependency{Stack: "org/proj/dev", Outputs: []resource.PropertyKey{"vpcId"}}
 
 	dep, err := SerializeResource(res, config.NopEncrypter, false /* showSecrets */)
 	assert.NoError(t, err)
@@ -119,6 +122,9 @@ func TestDeploymentSerialization(t *testing.T) {
 	assert.Equal(t, 2, len(dep.Dependencies))
 	assert.Equal(t, resource.URN("foo:bar:baz"), dep.Dependencies[0])
 	assert.Equal(t, resource.URN("foo:bar:boo"), dep.Dependencies[1])
+	assert.Equal(t, []string{"in-map.a"}, dep.IgnoreChanges)
+	assert.Equal(t, []resource.URN{"foo:bar:qux"}, dep.DeleteDependencies)
+	assert.Equal(t, &apitype.StackDependencyV1{Stack: "org/proj/dev", Outputs: []string{"vpcId"}}, dep.StackDependency)
 
 	// assert some things about the inputs:
 	assert.NotNil(t, dep.Inputs)
@@ -193,6 +199,13 @@ func TestDeploymentSerialization(t *testing.T) {
 	assert.Equal(t, float64(999.9), outmap["z"].(float64))
 	assert.NotNil(t, dep.Outputs["out-empty-map"])
 	assert.Equal(t, 0, len(dep.Outputs["out-empty-map"].(map[string]interface{})))
+
+	// assert that the resource round-trips:
+	actual, err := DeserializeResource(dep, config.NopDecrypter, config.NopEncrypter)
+	assert.NoError(t, err)
+	assert.Equal(t, res.IgnoreChanges, actual.IgnoreChanges)
+	assert.Equal(t, res.DeleteDependencies, actual.DeleteDependencies)
+	assert.Equal(t, res.StackDependency, actual.StackDependency)
 }
 
 func TestLoadTooNewDeployment(t *testing.T) {
@@ -0,0 +1,110 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob" // driver for azblob://
+	"gocloud.dev/blob/fileblob"
+	"gocloud.dev/blob/gcsblob" // driver for gs://
+	"gocloud.dev/blob/s3blob"  // driver for s3://
+
+	"github.com/pulumi/pulumi/pkg/v3/authhelpers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func init() {
+	// Local directories are supported by the SDK itself; this adds support for plugin mirrors in cloud buckets.
+	for _, scheme := range []string{s3blob.Scheme, gcsblob.Scheme, azureblob.Scheme} {
+		workspace.RegisterPluginMirrorScheme(scheme, openBlobPluginMirror)
+	}
+}
+
+// OpenPluginMirrorBucket opens the bucket for the plugin mirror at the given URL. The URL may be a local directory,
+// which is created if it does not exist, or a file://, s3://, gs:// or azblob:// URL. Any path in a bucket URL is
+// used as a prefix for all the files in the mirror.
+func OpenPluginMirrorBucket(ctx context.Context, rawURL string) (*blob.Bucket, error) {
+	var u *url.URL
+	if filepath.IsAbs(rawURL) {
+		u = &url.URL{Path: rawURL}
+	} else {
+		var err error
+		if u, err = url.Parse(rawURL); err != nil {
+			return nil, fmt.Errorf("invalid plugin mirror URL %q: %w", rawURL, err)
+		}
+	}
+
+	if u.Scheme == "" || u.Scheme == fileblob.Scheme {
+		// file://relative/path puts the first path segment in the host.
+		dir, err := filepath.Abs(filepath.FromSlash(u.Host + u.Path))
+		if err != nil {
+			return nil, err
+		}
+		return fileblob.OpenBucket(dir, &fileblob.Options{
+			CreateDir: true,
+			Metadata:  fileblob.MetadataDontWrite,
+		})
+	}
+
+	blobmux := blob.DefaultURLMux()
+
+	// for gcp we want to support additional credentials
+	// schemes on top of go-cloud's default credentials mux.
+	if u.Scheme == gcsblob.Scheme {
+		var err error
+		blobmux, err = authhelpers.GoogleCredentialsMux(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bucket, err := blobmux.OpenBucket(ctx, rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open bucket %s: %w", rawURL, err)
+	}
+
+	if prefix := strings.Trim(u.Path, "/"); prefix != "" {
+		bucket = blob.PrefixedBucket(bucket, prefix+"/")
+	}
+	return bucket, nil
+}
+
+// blobPluginMirror reads a plugin mirror from a cloud bucket.
+type blobPluginMirror struct {
+	bucket *blob.Bucket
+}
+
+func openBlobPluginMirror(u *url.URL) (workspace.PluginMirrorBucket, error) {
+	bucket, err := OpenPluginMirrorBucket(context.Background(), u.String())
+	if err != nil {
+		return nil, err
+	}
+	return &blobPluginMirror{bucket: bucket}, nil
+}
+
+func (mirror *blobPluginMirror) Open(key string) (io.ReadCloser, int64, error) {
+	r, err := mirror.bucket.NewReader(context.Background(), key, nil)
+	if err != nil {
+		return nil, -1, err
+	}
+	return r, r.Size(), nil
+}
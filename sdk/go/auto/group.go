@@ -0,0 +1,649 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optdestroy"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optup"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// GroupStack is a stack that is part of a StackGroup.
+type GroupStack struct {
+	// Name identifies the stack within the group.
+	Name string
+	// Stack is the stack to operate on.
+	Stack Stack
+	// DependsOn are the names of the stacks in the group that this stack depends on.
+	DependsOn []string
+}
+
+// StackGroup is a set of stacks that are operated on together in dependency order. Stacks that do not depend on each
+// other are operated on in parallel.
+type StackGroup struct {
+	names    []string
+	stacks   map[string]*Stack
+	deps     map[string][]string
+	parallel int
+
+	// reads maps each stack to the stacks that it reads outputs from, and the names of the outputs that it reads. A nil
+	// list means that the outputs read are unknown.
+	reads map[string]map[string][]string
+}
+
+// NewStackGroup creates a group from the given stacks. Stacks are operated on after the stacks that they depend on,
+// with at most parallel stacks being operated on at once. A parallel of zero or less means no limit.
+func NewStackGroup(stacks []GroupStack, parallel int) (*StackGroup, error) {
+	g := &StackGroup{
+		stacks:   map[string]*Stack{},
+		deps:     map[string][]string{},
+		parallel: parallel,
+		reads:    map[string]map[string][]string{},
+	}
+	for _, s := range stacks {
+		if s.Name == "" {
+			return nil, errors.New("stacks in a group must have a name")
+		}
+		if _, has := g.stacks[s.Name]; has {
+			return nil, fmt.Errorf("duplicate stack %q in group", s.Name)
+		}
+		stack := s.Stack
+		g.names = append(g.names, s.Name)
+		g.stacks[s.Name] = &stack
+	}
+	for _, s := range stacks {
+		for _, dep := range s.DependsOn {
+			if err := g.addDependency(s.Name, dep); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := g.checkCycles(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Stacks returns the names of the stacks in the group, ordered so that each stack comes after its dependencies.
+func (g *StackGroup) Stacks() []string {
+	visited := map[string]bool{}
+	var order []string
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		for _, dep := range g.deps[name] {
+			visit(dep)
+		}
+		order = append(order, name)
+	}
+	for _, name := range g.names {
+		visit(name)
+	}
+	return order
+}
+
+// Stack returns the named stack in the group.
+func (g *StackGroup) Stack(name string) (Stack, bool) {
+	s, ok := g.stacks[name]
+	if !ok {
+		return Stack{}, false
+	}
+	return *s, true
+}
+
+// DependsOn returns the names of the stacks in the group that the named stack depends on.
+func (g *StackGroup) DependsOn(name string) []string {
+	return g.deps[name]
+}
+
+func (g *StackGroup) addDependency(name, dep string) error {
+	if _, has := g.stacks[dep]; !has {
+		return fmt.Errorf("stack %q depends on unknown stack %q", name, dep)
+	}
+	if name == dep {
+		return fmt.Errorf("stack %q depends on itself", name)
+	}
+	for _, d := range g.deps[name] {
+		if d == dep {
+			return nil
+		}
+	}
+	g.deps[name] = append(g.deps[name], dep)
+	return nil
+}
+
+func (g *StackGroup) checkCycles() error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("stacks in the group have a dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range g.deps[name] {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, name := range g.names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DetectDependencies adds a dependency between each pair of stacks in the group where one stack reads the outputs of
+// the other through a stack reference, as recorded by the latest deployment of the reading stack.
+func (g *StackGroup) DetectDependencies(ctx context.Context) error {
+	ids := map[string]groupStackID{}
+	for _, name := range g.names {
+		s := g.stacks[name]
+		proj, err := s.Workspace().ProjectSettings(ctx)
+		if err != nil {
+			return fmt.Errorf("reading the project of stack %q: %w", name, err)
+		}
+		ids[name] = parseGroupStackID(s.Name(), proj.Name.String())
+	}
+
+	for _, name := range g.names {
+		deployment, err := g.stacks[name].Export(ctx)
+		if err != nil {
+			return fmt.Errorf("exporting stack %q: %w", name, err)
+		}
+		refs, err := readStackReferences(deployment)
+		if err != nil {
+			return fmt.Errorf("reading stack references of stack %q: %w", name, err)
+		}
+
+		for _, ref := range refs {
+			refID := parseGroupStackID(ref.Stack, ids[name].project)
+			for _, other := range g.names {
+				if other == name || !refID.matches(ids[other]) {
+					continue
+				}
+				if err := g.addDependency(name, other); err != nil {
+					return err
+				}
+				if g.reads[name] == nil {
+					g.reads[name] = map[string][]string{}
+				}
+				if outputs, has := g.reads[name][other]; !has || outputs != nil {
+					if ref.Outputs == nil {
+						g.reads[name][other] = nil
+					} else {
+						g.reads[name][other] = append(outputs, ref.Outputs...)
+					}
+				}
+			}
+		}
+	}
+	return g.checkCycles()
+}
+
+// readStackReferences returns the stack references recorded by the given deployment.
+func readStackReferences(deployment apitype.UntypedDeployment) ([]apitype.StackDependencyV1, error) {
+	if deployment.Deployment == nil {
+		return nil, nil
+	}
+	var d apitype.DeploymentV3
+	if err := json.Unmarshal(deployment.Deployment, &d); err != nil {
+		return nil, err
+	}
+
+	var refs []apitype.StackDependencyV1
+	for _, res := range d.Resources {
+		switch {
+		case res.Delete:
+			continue
+		case res.StackDependency != nil:
+			refs = append(refs, *res.StackDependency)
+		case res.Type == "pulumi:pulumi:StackReference":
+			// Older deployments don't record which outputs were read.
+			if name, ok := res.Inputs["name"].(string); ok {
+				refs = append(refs, apitype.StackDependencyV1{Stack: name})
+			}
+		}
+	}
+	return refs, nil
+}
+
+// groupStackID identifies a stack by its organization, project and name. The organization may be unknown.
+type groupStackID struct {
+	org, project, name string
+}
+
+// parseGroupStackID parses a stack name of the form [[<org>/]<project>/]<stack>, defaulting the project to the given
+// one.
+func parseGroupStackID(name, project string) groupStackID {
+	parts := strings.Split(name, "/")
+	id := groupStackID{project: project, name: parts[len(parts)-1]}
+	switch len(parts) {
+	case 2:
+		id.org = parts[0]
+	case 3:
+		id.org, id.project = parts[0], parts[1]
+	}
+	return id
+}
+
+func (id groupStackID) matches(other groupStackID) bool {
+	return id.name == other.name && id.project == other.project &&
+		(id.org == "" || other.org == "" || id.org == other.org)
+}
+
+// StackGroupDefinition describes a group of stacks, as read from a group definition file.
+type StackGroupDefinition struct {
+	// Parallel is the maximum number of stacks to operate on at once. Zero means no limit.
+	Parallel int `json:"parallel,omitempty" yaml:"parallel,omitempty"`
+	// Stacks are the stacks in the group.
+	Stacks []StackGroupDefinitionStack `json:"stacks" yaml:"stacks"`
+}
+
+// StackGroupDefinitionStack describes a stack in a group definition file.
+type StackGroupDefinitionStack struct {
+	// Name identifies the stack within the group. Defaults to the path of the stack's project.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Stack is the name of the stack, e.g. "myOrg/myProject/dev".
+	Stack string `json:"stack" yaml:"stack"`
+	// Path is the directory containing the stack's project, relative to the group definition file.
+	Path string `json:"path" yaml:"path"`
+	// DependsOn are the names of the stacks in the group that this stack depends on, in addition to those that it
+	// reads outputs from through stack references.
+	DependsOn []string `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"`
+}
+
+// LoadStackGroup loads the group of stacks described by the given group definition file. Each stack is selected in a
+// LocalWorkspace for its project directory, created with the given options, and dependencies between the stacks are
+// detected from their stack references.
+func LoadStackGroup(ctx context.Context, path string, opts ...LocalWorkspaceOption) (*StackGroup, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading group definition: %w", err)
+	}
+	var def StackGroupDefinition
+	if err := yaml.Unmarshal(b, &def); err != nil {
+		return nil, fmt.Errorf("parsing group definition %s: %w", path, err)
+	}
+
+	root := filepath.Dir(path)
+	stacks := make([]GroupStack, len(def.Stacks))
+	for i, s := range def.Stacks {
+		if s.Stack == "" || s.Path == "" {
+			return nil, fmt.Errorf("stack %d in group definition %s must have a stack and a path", i, path)
+		}
+		name := s.Name
+		if name == "" {
+			name = filepath.ToSlash(filepath.Clean(s.Path))
+		}
+		stack, err := SelectStackLocalSource(ctx, s.Stack, filepath.Join(root, s.Path), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("selecting stack %q: %w", name, err)
+		}
+		stacks[i] = GroupStack{Name: name, Stack: stack, DependsOn: s.DependsOn}
+	}
+
+	g, err := NewStackGroup(stacks, def.Parallel)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.DetectDependencies(ctx); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// GroupStackStatus is the outcome of an operation on a stack in a group.
+type GroupStackStatus string
+
+const (
+	// GroupStackSucceeded means the operation on the stack succeeded.
+	GroupStackSucceeded GroupStackStatus = "succeeded"
+	// GroupStackFailed means the operation on the stack failed.
+	GroupStackFailed GroupStackStatus = "failed"
+	// GroupStackSkipped means the stack was not operated on because a stack it depends on failed.
+	GroupStackSkipped GroupStackStatus = "skipped"
+)
+
+// GroupStackResult is the result of an operation on a stack in a group.
+type GroupStackResult struct {
+	// Name is the name of the stack in the group.
+	Name string
+	// Status is the outcome of the operation.
+	Status GroupStackStatus
+	// Err is the error that the operation failed with, if any.
+	Err error
+	// SkippedBecause is the name of the failed stack that caused this stack to be skipped, if any.
+	SkippedBecause string
+	// ResourceChanges counts the resource changes made, or that would be made by a preview, by operation.
+	ResourceChanges map[string]int
+	// ChangedOutputs are the names of the stack's outputs that a preview shows will change.
+	ChangedOutputs []string
+	// UpstreamChanges are the outputs of the stacks that this stack depends on that a preview shows will change, by
+	// stack name. Because the stack is previewed against the current outputs of those stacks, its own preview does
+	// not reflect these changes.
+	UpstreamChanges map[string][]string
+}
+
+// GroupResult is the result of an operation on a stack group.
+type GroupResult struct {
+	// Stacks are the results for each stack, in the order that the stacks were operated on.
+	Stacks []GroupStackResult
+}
+
+// Failed returns the names of the stacks that failed.
+func (r GroupResult) Failed() []string {
+	var failed []string
+	for _, s := range r.Stacks {
+		if s.Status == GroupStackFailed {
+			failed = append(failed, s.Name)
+		}
+	}
+	return failed
+}
+
+type groupOptions struct {
+	parallel        int
+	progressStreams func(name string) []io.Writer
+}
+
+// GroupOption configures an operation on a stack group.
+type GroupOption interface {
+	applyGroupOption(*groupOptions)
+}
+
+type groupOption func(*groupOptions)
+
+func (o groupOption) applyGroupOption(opts *groupOptions) {
+	o(opts)
+}
+
+// GroupParallel overrides the maximum number of stacks in the group to operate on at once. Zero or less means no
+// limit.
+func GroupParallel(n int) GroupOption {
+	return groupOption(func(opts *groupOptions) {
+		opts.parallel = n
+	})
+}
+
+// GroupProgressStreams sets a function that returns the writers to stream the progress and errors of the operation on
+// each stack to.
+func GroupProgressStreams(f func(name string) []io.Writer) GroupOption {
+	return groupOption(func(opts *groupOptions) {
+		opts.progressStreams = f
+	})
+}
+
+func (g *StackGroup) options(opts []GroupOption) *groupOptions {
+	o := &groupOptions{parallel: g.parallel}
+	for _, opt := range opts {
+		opt.applyGroupOption(o)
+	}
+	if o.progressStreams == nil {
+		o.progressStreams = func(string) []io.Writer { return nil }
+	}
+	return o
+}
+
+// Preview previews the stacks in the group in dependency order. The result records, for each stack, which of the
+// outputs of the stacks that it depends on will change.
+func (g *StackGroup) Preview(ctx context.Context, opts ...GroupOption) (GroupResult, error) {
+	o := g.options(opts)
+	result := g.run(ctx, false, o.parallel, func(ctx context.Context, name string) GroupStackResult {
+		outputs := make(chan events.EngineEvent)
+		changed := make(chan []string, 1)
+		go func() {
+			changed <- changedStackOutputs(outputs)
+		}()
+
+		streams := o.progressStreams(name)
+		res, err := g.stacks[name].Preview(ctx,
+			optpreview.EventStreams(outputs),
+			optpreview.ProgressStreams(streams...),
+			optpreview.ErrorProgressStreams(streams...))
+		if err != nil {
+			return GroupStackResult{Status: GroupStackFailed, Err: err}
+		}
+		changes := make(map[string]int, len(res.ChangeSummary))
+		for op, n := range res.ChangeSummary {
+			changes[string(op)] = n
+		}
+		return GroupStackResult{Status: GroupStackSucceeded, ResourceChanges: changes, ChangedOutputs: <-changed}
+	})
+
+	changedOutputs := map[string][]string{}
+	for _, s := range result.Stacks {
+		changedOutputs[s.Name] = s.ChangedOutputs
+	}
+	for i, s := range result.Stacks {
+		for _, dep := range g.deps[s.Name] {
+			changed := changedOutputs[dep]
+			if reads, has := g.reads[s.Name][dep]; has && reads != nil {
+				changed = intersectStrings(changed, reads)
+			}
+			if len(changed) != 0 {
+				if s.UpstreamChanges == nil {
+					s.UpstreamChanges = map[string][]string{}
+				}
+				s.UpstreamChanges[dep] = changed
+			}
+		}
+		result.Stacks[i] = s
+	}
+	return result, result.err("preview")
+}
+
+// Up updates the stacks in the group in dependency order. Stacks that depend on a stack that fails are not updated.
+func (g *StackGroup) Up(ctx context.Context, opts ...GroupOption) (GroupResult, error) {
+	o := g.options(opts)
+	result := g.run(ctx, false, o.parallel, func(ctx context.Context, name string) GroupStackResult {
+		streams := o.progressStreams(name)
+		res, err := g.stacks[name].Up(ctx, optup.ProgressStreams(streams...), optup.ErrorProgressStreams(streams...))
+		if err != nil {
+			return GroupStackResult{Status: GroupStackFailed, Err: err}
+		}
+		var changes map[string]int
+		if res.Summary.ResourceChanges != nil {
+			changes = *res.Summary.ResourceChanges
+		}
+		return GroupStackResult{Status: GroupStackSucceeded, ResourceChanges: changes}
+	})
+	return result, result.err("update")
+}
+
+// Destroy destroys the stacks in the group in reverse dependency order. Stacks that are depended on by a stack that
+// fails to be destroyed are not destroyed.
+func (g *StackGroup) Destroy(ctx context.Context, opts ...GroupOption) (GroupResult, error) {
+	o := g.options(opts)
+	result := g.run(ctx, true, o.parallel, func(ctx context.Context, name string) GroupStackResult {
+		streams := o.progressStreams(name)
+		res, err := g.stacks[name].Destroy(ctx,
+			optdestroy.ProgressStreams(streams...), optdestroy.ErrorProgressStreams(streams...))
+		if err != nil {
+			return GroupStackResult{Status: GroupStackFailed, Err: err}
+		}
+		var changes map[string]int
+		if res.Summary.ResourceChanges != nil {
+			changes = *res.Summary.ResourceChanges
+		}
+		return GroupStackResult{Status: GroupStackSucceeded, ResourceChanges: changes}
+	})
+	return result, result.err("destroy")
+}
+
+func (r GroupResult) err(op string) error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s failed for %d stack(s): %s", op, len(failed), strings.Join(failed, ", "))
+}
+
+// run runs the given operation on each stack in the group, after the stacks that it depends on or, if reverse is
+// true, after the stacks that depend on it. At most parallel operations run at once, or any number if parallel is
+// zero or less. Stacks that would run after a stack that fails are skipped.
+func (g *StackGroup) run(ctx context.Context, reverse bool, parallel int,
+	op func(ctx context.Context, name string) GroupStackResult,
+) GroupResult {
+	// before maps each stack to the stacks that must complete before it, and after is the inverse.
+	before, after := map[string][]string{}, map[string][]string{}
+	for _, name := range g.names {
+		for _, dep := range g.deps[name] {
+			if reverse {
+				before[dep] = append(before[dep], name)
+				after[name] = append(after[name], dep)
+			} else {
+				before[name] = append(before[name], dep)
+				after[dep] = append(after[dep], name)
+			}
+		}
+	}
+
+	waiting := map[string]int{}
+	var ready []string
+	for _, name := range g.names {
+		waiting[name] = len(before[name])
+		if waiting[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	var result GroupResult
+	done := map[string]bool{}
+	var skip func(name, because string)
+	skip = func(name, because string) {
+		if done[name] {
+			return
+		}
+		done[name] = true
+		result.Stacks = append(result.Stacks, GroupStackResult{
+			Name:           name,
+			Status:         GroupStackSkipped,
+			SkippedBecause: because,
+		})
+		for _, next := range after[name] {
+			skip(next, because)
+		}
+	}
+
+	completed := make(chan GroupStackResult)
+	running := 0
+	for len(ready) != 0 || running != 0 {
+		for len(ready) != 0 && (parallel <= 0 || running < parallel) {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+			go func() {
+				res := op(ctx, name)
+				res.Name = name
+				completed <- res
+			}()
+		}
+
+		res := <-completed
+		running--
+		done[res.Name] = true
+		result.Stacks = append(result.Stacks, res)
+
+		for _, next := range after[res.Name] {
+			if res.Status != GroupStackSucceeded {
+				skip(next, res.Name)
+				continue
+			}
+			waiting[next]--
+			if waiting[next] == 0 && !done[next] {
+				ready = append(ready, next)
+			}
+		}
+	}
+	return result
+}
+
+// changedStackOutputs returns the names of the outputs of the root stack resource that change in the given events.
+func changedStackOutputs(events <-chan events.EngineEvent) []string {
+	var changed []string
+	for e := range events {
+		if e.ResOutputsEvent == nil {
+			continue
+		}
+		md := e.ResOutputsEvent.Metadata
+		if md.Type != "pulumi:pulumi:Stack" {
+			continue
+		}
+
+		var olds, news map[string]interface{}
+		if md.Old != nil {
+			olds = md.Old.Outputs
+		}
+		if md.New != nil {
+			news = md.New.Outputs
+		}
+		changed = nil
+		for k, v := range olds {
+			if nv, has := news[k]; !has || !reflect.DeepEqual(v, nv) {
+				changed = append(changed, k)
+			}
+		}
+		for k := range news {
+			if _, has := olds[k]; !has {
+				changed = append(changed, k)
+			}
+		}
+		sort.Strings(changed)
+	}
+	return changed
+}
+
+// intersectStrings returns the elements of a that are also in b.
+func intersectStrings(a, b []string) []string {
+	var result []string
+	for _, s := range a {
+		for _, t := range b {
+			if s == t {
+				result = append(result, s)
+				break
+			}
+		}
+	}
+	return result
+}
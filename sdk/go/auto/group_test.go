@@ -0,0 +1,252 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// newTestStackGroup creates a group of stacks with the given dependencies, in the given order.
+func newTestStackGroup(t *testing.T, names []string, deps map[string][]string, parallel int) *StackGroup {
+	stacks := make([]GroupStack, len(names))
+	for i, name := range names {
+		stacks[i] = GroupStack{Name: name, DependsOn: deps[name]}
+	}
+	g, err := NewStackGroup(stacks, parallel)
+	require.NoError(t, err)
+	return g
+}
+
+func TestNewStackGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("order", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestStackGroup(t, []string{"app", "db", "network"}, map[string][]string{
+			"app": {"db", "network"},
+			"db":  {"network"},
+		}, 0)
+		assert.Equal(t, []string{"network", "db", "app"}, g.Stacks())
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewStackGroup([]GroupStack{{Name: "app", DependsOn: []string{"network"}}}, 0)
+		assert.ErrorContains(t, err, `stack "app" depends on unknown stack "network"`)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewStackGroup([]GroupStack{{Name: "app"}, {Name: "app"}}, 0)
+		assert.ErrorContains(t, err, `duplicate stack "app"`)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewStackGroup([]GroupStack{
+			{Name: "a", DependsOn: []string{"b"}},
+			{Name: "b", DependsOn: []string{"c"}},
+			{Name: "c", DependsOn: []string{"a"}},
+		}, 0)
+		assert.ErrorContains(t, err, "dependency cycle: a -> b -> c -> a")
+	})
+}
+
+func TestStackGroupRun(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"network", "db", "app", "monitoring"}
+	deps := map[string][]string{
+		"db":  {"network"},
+		"app": {"db"},
+	}
+
+	t.Run("dependency order", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestStackGroup(t, names, deps, 0)
+
+		var lock sync.Mutex
+		var order []string
+		result := g.run(context.Background(), false, 0, func(_ context.Context, name string) GroupStackResult {
+			lock.Lock()
+			defer lock.Unlock()
+			order = append(order, name)
+			return GroupStackResult{Status: GroupStackSucceeded}
+		})
+		assert.Empty(t, result.Failed())
+		assert.Len(t, result.Stacks, 4)
+		assert.Less(t, indexOf(order, "network"), indexOf(order, "db"))
+		assert.Less(t, indexOf(order, "db"), indexOf(order, "app"))
+	})
+
+	t.Run("reverse order", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestStackGroup(t, names, deps, 1)
+
+		var order []string
+		g.run(context.Background(), true, 1, func(_ context.Context, name string) GroupStackResult {
+			order = append(order, name)
+			return GroupStackResult{Status: GroupStackSucceeded}
+		})
+		assert.Less(t, indexOf(order, "app"), indexOf(order, "db"))
+		assert.Less(t, indexOf(order, "db"), indexOf(order, "network"))
+	})
+
+	t.Run("parallel limit", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestStackGroup(t, []string{"a", "b", "c", "d", "e"}, nil, 2)
+
+		var lock sync.Mutex
+		running, maxRunning := 0, 0
+		g.run(context.Background(), false, 2, func(_ context.Context, name string) GroupStackResult {
+			lock.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			lock.Unlock()
+
+			lock.Lock()
+			running--
+			lock.Unlock()
+			return GroupStackResult{Status: GroupStackSucceeded}
+		})
+		assert.LessOrEqual(t, maxRunning, 2)
+	})
+
+	t.Run("failure skips downstream stacks", func(t *testing.T) {
+		t.Parallel()
+
+		g := newTestStackGroup(t, names, deps, 0)
+
+		result := g.run(context.Background(), false, 0, func(_ context.Context, name string) GroupStackResult {
+			if name == "network" {
+				return GroupStackResult{Status: GroupStackFailed, Err: errors.New("boom")}
+			}
+			return GroupStackResult{Status: GroupStackSucceeded}
+		})
+		assert.Equal(t, []string{"network"}, result.Failed())
+
+		statuses := map[string]GroupStackResult{}
+		for _, s := range result.Stacks {
+			statuses[s.Name] = s
+		}
+		assert.Len(t, statuses, 4)
+		assert.Equal(t, GroupStackSkipped, statuses["db"].Status)
+		assert.Equal(t, "network", statuses["db"].SkippedBecause)
+		assert.Equal(t, GroupStackSkipped, statuses["app"].Status)
+		assert.Equal(t, "network", statuses["app"].SkippedBecause)
+		assert.Equal(t, GroupStackSucceeded, statuses["monitoring"].Status)
+
+		assert.EqualError(t, result.err("update"), "update failed for 1 stack(s): network")
+	})
+}
+
+func TestReadStackReferences(t *testing.T) {
+	t.Parallel()
+
+	deployment, err := json.Marshal(apitype.DeploymentV3{
+		Resources: []apitype.ResourceV3{
+			{
+				Type: "pulumi:pulumi:StackReference",
+				StackDependency: &apitype.StackDependencyV1{
+					Stack:   "org/network/dev",
+					Outputs: []string{"vpcId"},
+				},
+			},
+			{
+				Type:   "pulumi:pulumi:StackReference",
+				Inputs: map[string]interface{}{"name": "db"},
+			},
+			{
+				Type:   "pulumi:pulumi:StackReference",
+				Delete: true,
+				Inputs: map[string]interface{}{"name": "old"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	refs, err := readStackReferences(apitype.UntypedDeployment{Version: 3, Deployment: deployment})
+	require.NoError(t, err)
+	assert.Equal(t, []apitype.StackDependencyV1{
+		{Stack: "org/network/dev", Outputs: []string{"vpcId"}},
+		{Stack: "db"},
+	}, refs)
+}
+
+func TestGroupStackIDMatches(t *testing.T) {
+	t.Parallel()
+
+	network := parseGroupStackID("org/network/dev", "network")
+	assert.True(t, parseGroupStackID("org/network/dev", "app").matches(network))
+	assert.True(t, parseGroupStackID("org/dev", "network").matches(network))
+	assert.True(t, parseGroupStackID("dev", "network").matches(network))
+	assert.False(t, parseGroupStackID("dev", "app").matches(network))
+	assert.False(t, parseGroupStackID("other/network/dev", "app").matches(network))
+}
+
+func TestChangedStackOutputs(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan events.EngineEvent, 2)
+	ch <- events.EngineEvent{EngineEvent: apitype.EngineEvent{
+		ResOutputsEvent: &apitype.ResOutputsEvent{Metadata: apitype.StepEventMetadata{
+			Type: "pkgA:m:typA",
+			Old:  &apitype.StepEventStateMetadata{Outputs: map[string]interface{}{"a": "1"}},
+			New:  &apitype.StepEventStateMetadata{Outputs: map[string]interface{}{"a": "2"}},
+		}},
+	}}
+	ch <- events.EngineEvent{EngineEvent: apitype.EngineEvent{
+		ResOutputsEvent: &apitype.ResOutputsEvent{Metadata: apitype.StepEventMetadata{
+			Type: "pulumi:pulumi:Stack",
+			Old: &apitype.StepEventStateMetadata{Outputs: map[string]interface{}{
+				"same": "x", "changed": "1", "removed": "y",
+			}},
+			New: &apitype.StepEventStateMetadata{Outputs: map[string]interface{}{
+				"same": "x", "changed": "2", "added": "z",
+			}},
+		}},
+	}}
+	close(ch)
+
+	assert.Equal(t, []string{"added", "changed", "removed"}, changedStackOutputs(ch))
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 
@@ -51,6 +52,7 @@ type LocalWorkspace struct {
 	program                       pulumi.RunFunc
 	envvars                       map[string]string
 	secretsProvider               string
+	stackTTL                      time.Duration
 	repo                          *GitRepo
 	remote                        bool
 	remoteEnvVars                 map[string]EnvVarValue
@@ -543,6 +545,9 @@ func (l *LocalWorkspace) CreateStack(ctx context.Context, stackName string) erro
 	if l.secretsProvider != "" {
 		args = append(args, "--secrets-provider", l.secretsProvider)
 	}
+	if l.stackTTL > 0 {
+		args = append(args, "--ttl", l.stackTTL.String())
+	}
 	if l.remote {
 		args = append(args, "--no-select")
 	}
@@ -916,6 +921,8 @@ func NewLocalWorkspace(ctx context.Context, opts ...LocalWorkspaceOption) (Works
 		l.secretsProvider = lwOpts.SecretsProvider
 	}
 
+	l.stackTTL = lwOpts.StackTTL
+
 	// Environment values
 	if lwOpts.EnvVars != nil {
 		if err := setEnvVars(l, lwOpts.EnvVars); err != nil {
@@ -955,6 +962,8 @@ type localWorkspaceOptions struct {
 	Repo *GitRepo
 	// Secrets Provider to use with the current Stack
 	SecretsProvider string
+	// StackTTL is how long stacks created in the workspace live before `pulumi stack reap` destroys them.
+	StackTTL time.Duration
 	// EnvVars is a map of environment values scoped to the workspace.
 	// These values will be passed to all Workspace and Stack level commands.
 	EnvVars map[string]string
@@ -1090,6 +1099,15 @@ func SecretsProvider(secretsProvider string) LocalWorkspaceOption {
 	})
 }
 
+// StackTTL sets how long stacks created in the current workspace live. Each new
+// stack is tagged with its expiry, after which `pulumi stack reap` destroys and
+// removes it.
+func StackTTL(ttl time.Duration) LocalWorkspaceOption {
+	return localWorkspaceOption(func(lo *localWorkspaceOptions) {
+		lo.StackTTL = ttl
+	})
+}
+
 // EnvVars is a map of environment values scoped to the workspace.
 // These values will be passed to all Workspace and Stack level commands.
 func EnvVars(envvars map[string]string) LocalWorkspaceOption {
@@ -1823,6 +1823,45 @@ func TestTagFunctions(t *testing.T) {
 	assert.NoError(t, err, "failed to remove stack. Resources have leaked.")
 }
 
+func TestTagFunctionsDIY(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stackName := ptesting.RandomStackName()
+
+	pDir := filepath.Join(".", "test", "testproj")
+	s, err := UpsertStackLocalSource(ctx, stackName, pDir,
+		SecretsProvider("passphrase"),
+		EnvVars(map[string]string{
+			"PULUMI_BACKEND_URL":       "file://" + filepath.ToSlash(t.TempDir()),
+			"PULUMI_CONFIG_PASSPHRASE": "password",
+		}))
+	require.NoError(t, err, "failed to initialize stack")
+	ws := s.Workspace()
+
+	// -- the DIY backend records the project tags --
+	tags, err := ws.ListTags(ctx, stackName)
+	require.NoError(t, err, "failed to list tags")
+	assert.Equal(t, pName, tags["pulumi:project"])
+
+	// -- sets and gets tag values --
+	err = s.SetTag(ctx, "foo", "bar")
+	require.NoError(t, err, "set tag failed")
+	tag, err := s.GetTag(ctx, "foo")
+	require.NoError(t, err, "get tag failed")
+	assert.Equal(t, "bar", tag)
+
+	// -- removes tag value --
+	err = s.RemoveTag(ctx, "foo")
+	require.NoError(t, err, "remove tag failed")
+	tags, err = ws.ListTags(ctx, stackName)
+	require.NoError(t, err, "failed to list tags")
+	assert.NotContains(t, tags, "foo", "failed to remove tag")
+
+	err = ws.RemoveStack(ctx, stackName)
+	assert.NoError(t, err, "failed to remove stack")
+}
+
 //nolint:paralleltest // mutates environment variables
 func TestStructuredOutput(t *testing.T) {
 	ctx := context.Background()
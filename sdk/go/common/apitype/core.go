@@ -329,6 +329,13 @@ type ResourceV3 struct {
 	// If set, the providers Delete method will not be called for this resource
 	// if specified resource is being deleted as well.
 	DeletedWith resource.URN `json:"deletedWith,omitempty" yaml:"deletedWith,omitempty"`
+	// IgnoreChanges is the list of input properties whose changes are ignored when diffing the resource.
+	IgnoreChanges []string `json:"ignoreChanges,omitempty" yaml:"ignoreChanges,omitempty"`
+	// DeleteDependencies is a list of resources that must be deleted after this resource when both are being deleted.
+	// Unlike Dependencies, these only constrain the order of deletions.
+	DeleteDependencies []resource.URN `json:"deleteDependencies,omitempty" yaml:"deleteDependencies,omitempty"`
+	// StackDependency records the outputs of another stack that were read, if this resource is a stack reference.
+	StackDependency *StackDependencyV1 `json:"stackDependency,omitempty" yaml:"stackDependency,omitempty"`
 	// Created tracks when the remote resource was first added to state by pulumi. Checkpoints prior to early 2023 do not include this.
 	Created *time.Time `json:"created,omitempty" yaml:"created,omitempty"`
 	// Modified tracks when the resource state was last altered. Checkpoints prior to early 2023 do not include this.
@@ -337,6 +344,14 @@ type ResourceV3 struct {
 	SourcePosition string `json:"sourcePosition,omitempty" yaml:"sourcePosition,omitempty"`
 }
 
+// StackDependencyV1 records the outputs of another stack that were read by a stack reference.
+type StackDependencyV1 struct {
+	// Stack is the name of the referenced stack, as given to the stack reference.
+	Stack string `json:"stack" yaml:"stack"`
+	// Outputs are the names of the outputs of the referenced stack that were read.
+	Outputs []string `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
+
 // ManifestV1 captures meta-information about this checkpoint file, such as versions of binaries, etc.
 type ManifestV1 struct {
 	// Time of the update.
@@ -392,6 +407,9 @@ const (
 	ProjectDescriptionTag StackTagName = "pulumi:description"
 	// ProjectTemplateTag is a tag that represents the template that was used to create a project.
 	ProjectTemplateTag StackTagName = "pulumi:template"
+	// StackExpiresAtTag is a tag that represents the time, in RFC 3339 format, after which an ephemeral stack
+	// has expired and may be destroyed and removed by `pulumi stack reap`.
+	StackExpiresAtTag StackTagName = "pulumi:expiresAt"
 	// GitHubOwnerNameTag is a tag that represents the name of the owner on GitHub that this stack
 	// may be associated with (inferred by the CLI based on git remote info).
 	// TODO [pulumi/pulumi-service#2306] Once the UI is updated, we would no longer need the GitHub specific keys.
@@ -411,6 +429,16 @@ const (
 	// VCSRepositoryRootTag is a tag that represents the root directory of the repository on the cloud VCS that
 	// this stack may be associated with (pulled from git by the CLI)
 	VCSRepositoryRootTag StackTagName = "vcs:root"
+	// CISystemTag is a tag that represents the name of the CI system that this stack was last updated from
+	// (inferred by the CLI based on the environment).
+	CISystemTag StackTagName = "ci:system"
+	// CIBuildIDTag is a tag that represents the ID of the CI build that this stack was last updated from.
+	CIBuildIDTag StackTagName = "ci:buildId"
+	// CIBuildURLTag is a tag that represents the URL of the CI build that this stack was last updated from.
+	CIBuildURLTag StackTagName = "ci:buildUrl"
+	// CIPRNumberTag is a tag that represents the number of the pull request that the CI build that this stack was
+	// last updated from was run for.
+	CIPRNumberTag StackTagName = "ci:prNumber"
 )
 
 const (
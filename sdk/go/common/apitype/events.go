@@ -71,6 +71,15 @@ type PolicyRemediationEvent struct {
 	After                map[string]interface{} `json:"after,omitempty"`
 }
 
+// ResourceHookEvent is emitted whenever a resource hook has run.
+type ResourceHookEvent struct {
+	URN   string `json:"urn"`
+	Op    OpType `json:"op"`
+	Hook  string `json:"hook"`
+	Event string `json:"event"`
+	Error string `json:"error,omitempty"`
+}
+
 // PreludeEvent is emitted at the start of an update.
 type PreludeEvent struct {
 	// Config contains the keys and values for the update.
@@ -227,6 +236,7 @@ type EngineEvent struct {
 	PolicyEvent            *PolicyEvent            `json:"policyEvent,omitempty"`
 	PolicyRemediationEvent *PolicyRemediationEvent `json:"policyRemediationEvent,omitempty"`
 	PolicyLoadEvent        *PolicyLoadEvent        `json:"policyLoadEvent,omitempty"`
+	ResourceHookEvent      *ResourceHookEvent      `json:"resourceHookEvent,omitempty"`
 }
 
 // EngineEventBatch is a group of engine events.
@@ -65,6 +65,16 @@ var IgnoreAmbientPlugins = env.Bool("IGNORE_AMBIENT_PLUGINS",
 var DisableAutomaticPluginAcquisition = env.Bool("DISABLE_AUTOMATIC_PLUGIN_ACQUISITION",
 	"Disables the automatic installation of missing plugins.")
 
+var FrozenPluginLock = env.Bool("FROZEN_PLUGIN_LOCK",
+	"Fail instead of warning if the plugins a program uses deviate from those recorded in Pulumi.lock.")
+
+var PluginMirror = env.String("PLUGIN_MIRROR",
+	"The directory or bucket URL (file://, s3://, gs:// or azblob://) of a plugin mirror created by `pulumi plugin "+
+		"mirror`. When set, all plugins are downloaded from the mirror instead of their usual sources.")
+
+var DisableSchemaCache = env.Bool("DISABLE_SCHEMA_CACHE",
+	"Disables the on-disk cache of package schemas that is shared across Pulumi invocations.")
+
 var SkipConfirmations = env.Bool("SKIP_CONFIRMATIONS",
 	`Whether or not confirmation prompts should be skipped. This should be used by pass any requirement
 that a --yes parameter has been set for non-interactive scenarios.
@@ -40,6 +40,12 @@ type Context struct {
 	Pwd        string    // the working directory to spawn all plugins in.
 	Root       string    // the root directory of the context.
 
+	// PluginLimits are the resource limits applied to the plugin processes launched by this context.
+	PluginLimits []workspace.PluginLimits
+	// PluginLimitOverride, if set, overrides the corresponding PluginLimits for every plugin, and limits the plugins
+	// that PluginLimits doesn't.
+	PluginLimitOverride *workspace.PluginLimits
+
 	// If non-nil, configures custom gRPC client options. Receives pluginInfo which is a JSON-serializable bit of
 	// metadata describing the plugin.
 	DialOptions func(pluginInfo interface{}) []grpc.DialOption
@@ -113,6 +119,10 @@ func NewContextWithContext(
 		cancelLock:      &sync.Mutex{},
 		baseContext:     ctx,
 	}
+	if plugins != nil {
+		pctx.PluginLimits = plugins.Limits
+	}
+	pctx.PluginLimitOverride = getPluginLimitOverride()
 	if host == nil {
 		h, err := NewDefaultHost(pctx, runtimeOptions, disableProviderPreview, plugins, config)
 		if err != nil {
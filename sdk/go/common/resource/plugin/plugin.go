@@ -322,6 +322,11 @@ func execPlugin(ctx *Context, bin, prefix string, kind workspace.PluginKind,
 		verbose:         logging.Verbose,
 	})
 
+	// Record that the plugin is being used, so that unused plugins can be pruned from the plugin cache.
+	if err := workspace.RecordPluginUse(filepath.Dir(bin)); err != nil {
+		logging.V(9).Infof("execPlugin(): failed to record use of plugin '%v': %v", bin, err)
+	}
+
 	// Check to see if we have a binary we can invoke directly
 	if _, err := os.Stat(bin); os.IsNotExist(err) {
 		// If we don't have the expected binary, see if we have a "PulumiPlugin.yaml" or "PulumiPolicy.yaml"
@@ -372,10 +377,27 @@ func execPlugin(ctx *Context, bin, prefix string, kind workspace.PluginKind,
 		}, nil
 	}
 
+	limits, limitsErr := getPluginLimits(ctx, kind, bin)
+	if limitsErr != nil {
+		return nil, limitsErr
+	}
+	if limits != nil {
+		env = limits.filterEnv(env)
+		if limits.dir != "" {
+			pwd = limits.dir
+		}
+	}
+
 	cmd := exec.Command(bin, args...)
+	if limits != nil && limits.hasProcessLimits() {
+		var limitErr error
+		if cmd, limitErr = limitedCommand(ctx, bin, args, limits); limitErr != nil {
+			return nil, fmt.Errorf("applying plugin limits: %w", limitErr)
+		}
+	}
 	cmdutil.RegisterProcessGroup(cmd)
 	cmd.Dir = pwd
-	if len(env) > 0 {
+	if len(env) > 0 || limits != nil && limits.env != nil {
 		cmd.Env = env
 	}
 	in, _ := cmd.StdinPipe()
@@ -416,6 +438,10 @@ func execPlugin(ctx *Context, bin, prefix string, kind workspace.PluginKind,
 
 		return result.ErrorOrNil()
 	}
+	if limits != nil && limits.hasProcessLimits() {
+		// Watch for the plugin exiting on its own, so that we can report if it was killed for exceeding its limits.
+		kill = watchLimitedPlugin(ctx, bin, cmd.Process, limits, kill)
+	}
 
 	return &plugin{
 		Bin:    bin,
@@ -0,0 +1,209 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// pluginLimits are the parsed resource limits for a plugin process.
+type pluginLimits struct {
+	memory     uint64        // the maximum amount of memory in bytes, or 0 for no limit.
+	memoryText string        // the memory limit as it was configured, for diagnostics.
+	cpuTime    time.Duration // the maximum amount of CPU time, or 0 for no limit.
+	openFiles  uint64        // the maximum number of open files, or 0 for no limit.
+	env        []string      // the allow-list of environment variables, or nil to pass every variable.
+	dir        string        // the working directory, or "" to use the default.
+}
+
+var (
+	pluginLimitOverrideLock sync.Mutex
+	pluginLimitOverride     *workspace.PluginLimits
+)
+
+// SetPluginLimitOverride sets the limits, such as those given on the command line, that contexts created from now on
+// use to override the plugin limits configured by projects. Passing nil removes the override.
+func SetPluginLimitOverride(limits *workspace.PluginLimits) {
+	pluginLimitOverrideLock.Lock()
+	defer pluginLimitOverrideLock.Unlock()
+	pluginLimitOverride = limits
+}
+
+func getPluginLimitOverride() *workspace.PluginLimits {
+	pluginLimitOverrideLock.Lock()
+	defer pluginLimitOverrideLock.Unlock()
+	return pluginLimitOverride
+}
+
+// getPluginLimits returns the limits that apply to the given plugin binary, or nil if it is not limited.
+func getPluginLimits(ctx *Context, kind workspace.PluginKind, bin string) (*pluginLimits, error) {
+	if ctx == nil {
+		return nil, nil
+	}
+
+	name := strings.TrimSuffix(filepath.Base(bin), ".exe")
+	name = strings.TrimPrefix(name, fmt.Sprintf("pulumi-%s-", kind))
+	limits := workspace.FindPluginLimits(ctx.PluginLimits, kind, name)
+	if override := ctx.PluginLimitOverride; override != nil {
+		if limits == nil {
+			limits = override
+		} else {
+			overridden := limits.Override(*override)
+			limits = &overridden
+		}
+	}
+	if limits == nil {
+		return nil, nil
+	}
+
+	parsed, err := parsePluginLimits(*limits, ctx.Root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid limits for %s plugin %s: %w", kind, name, err)
+	}
+	return parsed, nil
+}
+
+func parsePluginLimits(limits workspace.PluginLimits, root string) (*pluginLimits, error) {
+	result := &pluginLimits{
+		memoryText: limits.Memory,
+		env:        limits.Env,
+		dir:        limits.WorkingDirectory,
+	}
+
+	if limits.Memory != "" {
+		memory, err := parseByteSize(limits.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("memory: %w", err)
+		}
+		result.memory = memory
+	}
+	if limits.CPUTime != "" {
+		cpuTime, err := time.ParseDuration(limits.CPUTime)
+		if err != nil {
+			return nil, fmt.Errorf("cpuTime: %w", err)
+		}
+		if cpuTime <= 0 {
+			return nil, fmt.Errorf("cpuTime: must be positive, was %v", cpuTime)
+		}
+		result.cpuTime = cpuTime
+	}
+	if limits.OpenFiles < 0 {
+		return nil, fmt.Errorf("openFiles: must be positive, was %d", limits.OpenFiles)
+	}
+	result.openFiles = uint64(limits.OpenFiles)
+
+	if result.dir != "" && !filepath.IsAbs(result.dir) && root != "" {
+		result.dir = filepath.Join(root, result.dir)
+	}
+	return result, nil
+}
+
+// byteSizeUnits are the units accepted by parseByteSize. Single letter units are binary, as in `ulimit`.
+var byteSizeUnits = map[string]uint64{
+	"":    1,
+	"b":   1,
+	"k":   1 << 10,
+	"kb":  1000,
+	"kib": 1 << 10,
+	"m":   1 << 20,
+	"mb":  1000 * 1000,
+	"mib": 1 << 20,
+	"g":   1 << 30,
+	"gb":  1000 * 1000 * 1000,
+	"gib": 1 << 30,
+}
+
+// parseByteSize parses a size such as "512MiB" or "2GB" into a number of bytes.
+func parseByteSize(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if i == -1 {
+		i = len(s)
+	}
+
+	value, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	unit, ok := byteSizeUnits[strings.ToLower(strings.TrimSpace(s[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q", s, s[i:])
+	}
+
+	size := value * float64(unit)
+	if size >= math.MaxUint64 {
+		return 0, fmt.Errorf("invalid size %q: too large", s)
+	}
+	return uint64(size), nil
+}
+
+// hasProcessLimits returns true if any limits that are enforced by the operating system are set.
+func (l *pluginLimits) hasProcessLimits() bool {
+	return l.memory != 0 || l.cpuTime != 0 || l.openFiles != 0
+}
+
+// pluginProtocolEnv are the variables that the engine uses to pass a plugin its configuration and the context it runs
+// in. They are always passed to plugins, whatever their allow-list. Entries ending in * match a prefix.
+var pluginProtocolEnv = []string{
+	"PULUMI_CONFIG",
+	"PULUMI_RUNTIME_*",
+	"PULUMI_ORGANIZATION",
+	"PULUMI_PROJECT",
+	"PULUMI_STACK",
+	"PULUMI_DRY_RUN",
+	"PULUMI_NODEJS_ORGANIZATION",
+	"PULUMI_NODEJS_PROJECT",
+	"PULUMI_NODEJS_STACK",
+	"PULUMI_NODEJS_DRY_RUN",
+}
+
+// filterEnv returns the given environment, or the current process's environment if it is empty, filtered down to
+// the allowed variables and the variables of the plugin protocol.
+func (l *pluginLimits) filterEnv(env []string) []string {
+	if l.env == nil {
+		return env
+	}
+	if len(env) == 0 {
+		env = os.Environ()
+	}
+
+	filtered := []string{}
+	for _, kv := range env {
+		name, _, _ := strings.Cut(kv, "=")
+		if matchesEnv(name, pluginProtocolEnv) || matchesEnv(name, l.env) {
+			filtered = append(filtered, kv)
+		}
+	}
+	return filtered
+}
+
+// matchesEnv returns true if the given variable name matches any of the given names or prefixes ending in *.
+func matchesEnv(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if name == pattern || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, pattern[:len(pattern)-1])) {
+			return true
+		}
+	}
+	return false
+}
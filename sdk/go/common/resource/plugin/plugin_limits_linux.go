@@ -0,0 +1,128 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// limitedCommand returns a command that runs the given plugin with its memory, CPU time and open file limits. The
+// limits are set by a shell that then execs the plugin, so that they are in place before the plugin starts running and
+// the plugin keeps the process ID of the command.
+func limitedCommand(_ *Context, bin string, args []string, limits *pluginLimits) (*exec.Cmd, error) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		return nil, err
+	}
+
+	var script strings.Builder
+	if limits.memory != 0 {
+		// RLIMIT_DATA covers the heap and private anonymous mappings, which is what "memory" means for plugins. Unlike
+		// RLIMIT_AS it doesn't count address space that is reserved but never used, which runtimes reserve generously.
+		fmt.Fprintf(&script, "ulimit -d %d && ", (limits.memory+1023)/1024)
+	}
+	if limits.cpuTime != 0 {
+		// The process receives SIGXCPU at the soft limit and SIGKILL at the hard limit.
+		seconds := uint64((limits.cpuTime + time.Second - 1) / time.Second)
+		fmt.Fprintf(&script, "ulimit -t %d && ulimit -S -t %d && ", seconds+1, seconds)
+	}
+	if limits.openFiles != 0 {
+		fmt.Fprintf(&script, "ulimit -n %d && ", limits.openFiles)
+	}
+	script.WriteString(`exec "$0" "$@"`)
+
+	return exec.Command(sh, append([]string{"-c", script.String(), bin}, args...)...), nil
+}
+
+// watchLimitedPlugin watches for a plugin with process limits exiting on its own, and reports if it was likely killed
+// for exceeding them. It returns a function that kills the plugin using the given kill function, unless it has already
+// exited.
+//
+// The plugin runs in its own process group, whose ID is the plugin's process ID. When the plugin exits, the process
+// group is killed before the plugin is reaped, so that the children that it leaves behind are killed while its
+// process ID can't be reused by an unrelated process.
+func watchLimitedPlugin(ctx *Context, bin string, proc *os.Process, limits *pluginLimits, kill func() error,
+) func() error {
+	var m sync.Mutex
+	var killed, exited bool
+	go func() {
+		// Wait for the plugin to exit without reaping it.
+		var info unix.Siginfo
+		err := unix.Waitid(unix.P_PID, proc.Pid, &info, unix.WEXITED|unix.WNOWAIT, nil)
+
+		m.Lock()
+		wasKilled := killed
+		if err == nil && !wasKilled {
+			contract.IgnoreError(cmdutil.KillChildren(proc.Pid))
+		}
+		state, waitErr := proc.Wait()
+		exited = true
+		m.Unlock()
+
+		if err != nil || waitErr != nil || wasKilled {
+			return
+		}
+		if msg := describeLimitExit(state, limits); msg != "" {
+			ctx.Diag.Errorf(diag.Message("", "plugin %s %s"), bin, msg)
+		}
+	}()
+
+	return func() error {
+		m.Lock()
+		defer m.Unlock()
+		if exited {
+			// The plugin and its process group have already been killed.
+			return nil
+		}
+		killed = true
+		return kill()
+	}
+}
+
+// describeLimitExit returns a description of the limit that a plugin process that exited on its own was likely killed
+// for exceeding, or "" if the way it exited doesn't suggest that it exceeded a limit.
+func describeLimitExit(state *os.ProcessState, limits *pluginLimits) string {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return ""
+	}
+
+	// The process receives SIGXCPU at the soft CPU time limit and SIGKILL at the hard limit.
+	cpuTime := state.UserTime() + state.SystemTime()
+	if limits.cpuTime != 0 &&
+		(status.Signal() == syscall.SIGXCPU || (status.Signal() == syscall.SIGKILL && cpuTime >= limits.cpuTime)) {
+		return fmt.Sprintf("was killed for exceeding its CPU time limit of %v", limits.cpuTime)
+	}
+
+	// The kernel's out-of-memory killer sends SIGKILL.
+	if limits.memory != 0 && status.Signal() == syscall.SIGKILL {
+		return "was killed; it may have exceeded its memory limit of " + limits.memoryText
+	}
+	return ""
+}
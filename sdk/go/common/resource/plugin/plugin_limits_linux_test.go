@@ -0,0 +1,123 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func TestLimitedCommand(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	// The limits are in place when the plugin starts, and the plugin gets its arguments unchanged.
+	limits := &pluginLimits{memory: 512 << 20, cpuTime: 90 * time.Second, openFiles: 32}
+	cmd, err := limitedCommand(nil, sh, []string{"-c", `ulimit -d; ulimit -S -t; ulimit -H -t; ulimit -n; echo "$0"`,
+		"a b"}, limits)
+	require.NoError(t, err)
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Equal(t, "524288\n90\n91\n32\na b\n", string(out))
+}
+
+func TestLimitedCommandCPUTime(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	limits := &pluginLimits{cpuTime: time.Second, openFiles: 32}
+	cmd, err := limitedCommand(nil, sh, []string{"-c", "while :; do :; done"}, limits)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+
+	state, err := cmd.Process.Wait()
+	require.NoError(t, err)
+	assert.Equal(t, "was killed for exceeding its CPU time limit of 1s", describeLimitExit(state, limits))
+}
+
+func TestDescribeLimitExitMemory(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	limits := &pluginLimits{memory: 512 << 20, memoryText: "512MiB"}
+	exit := func(script string) string {
+		cmd := exec.Command(sh, "-c", script)
+		require.NoError(t, cmd.Start())
+		state, err := cmd.Process.Wait()
+		require.NoError(t, err)
+		return describeLimitExit(state, limits)
+	}
+
+	// Only a SIGKILL, which is how the kernel's out-of-memory killer ends a process, suggests that the plugin exceeded
+	// its memory limit.
+	assert.Equal(t, "was killed; it may have exceeded its memory limit of 512MiB", exit("kill -KILL $$"))
+	assert.Equal(t, "", exit("kill -TERM $$"))
+	assert.Equal(t, "", exit("exit 1"))
+}
+
+func TestWatchLimitedPluginKillsProcessGroup(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	// The plugin exits on its own, leaving a child behind in its process group.
+	cmd := exec.Command(sh, "-c", `sleep 60 & echo $!`)
+	cmdutil.RegisterProcessGroup(cmd)
+	out, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+
+	kills := 0
+	kill := watchLimitedPlugin(&Context{}, sh, cmd.Process, &pluginLimits{cpuTime: time.Minute},
+		func() error { kills++; return nil })
+
+	var child int
+	_, err = fmt.Fscan(out, &child)
+	require.NoError(t, err)
+
+	// The child is killed once the plugin has exited, and killing the plugin afterwards does nothing.
+	assert.Eventually(t, func() bool {
+		stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", child))
+		return err != nil || strings.Contains(string(stat), ") Z ")
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.NoError(t, kill())
+	assert.Equal(t, 0, kills)
+}
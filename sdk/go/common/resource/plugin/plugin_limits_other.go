@@ -0,0 +1,44 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux
+
+package plugin
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+)
+
+var warnProcessLimitsOnce sync.Once
+
+// limitedCommand warns that memory, CPU time and open file limits are not enforced on this platform, and returns a
+// command that runs the plugin without them.
+func limitedCommand(ctx *Context, bin string, args []string, _ *pluginLimits) (*exec.Cmd, error) {
+	warnProcessLimitsOnce.Do(func() {
+		ctx.Diag.Warningf(diag.Message("",
+			"plugin memory, CPU time and open file limits are only enforced on Linux, not on %s"), runtime.GOOS)
+	})
+	return exec.Command(bin, args...), nil
+}
+
+// watchLimitedPlugin returns kill unchanged, as no limits are enforced on this platform that a plugin could be killed
+// for exceeding.
+func watchLimitedPlugin(_ *Context, _ string, _ *os.Process, _ *pluginLimits, kill func() error) func() error {
+	return kill
+}
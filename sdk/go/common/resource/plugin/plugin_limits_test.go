@@ -0,0 +1,125 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestParseByteSize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]uint64{
+		"1024":   1024,
+		"512MiB": 512 << 20,
+		"2GB":    2_000_000_000,
+		"1.5g":   3 << 29,
+		"64 k":   64 << 10,
+	}
+	for input, expected := range tests {
+		actual, err := parseByteSize(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, actual, input)
+	}
+
+	for _, input := range []string{"", "lots", "-1GB", "1TB"} {
+		_, err := parseByteSize(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestGetPluginLimits(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	ctx := &Context{
+		Root: root,
+		PluginLimits: []workspace.PluginLimits{
+			{Memory: "1GiB", WorkingDirectory: "plugins"},
+			{Name: "aws", Kind: workspace.ResourcePlugin, CPUTime: "30m", OpenFiles: 64},
+			{Name: "broken", Memory: "lots"},
+		},
+	}
+
+	limits, err := getPluginLimits(ctx, workspace.ResourcePlugin, filepath.Join("bin", "pulumi-resource-aws"))
+	require.NoError(t, err)
+	assert.Equal(t, &pluginLimits{cpuTime: 30 * time.Minute, openFiles: 64}, limits)
+
+	limits, err = getPluginLimits(ctx, workspace.ResourcePlugin, "pulumi-resource-gcp.exe")
+	require.NoError(t, err)
+	assert.Equal(t, &pluginLimits{memory: 1 << 30, memoryText: "1GiB", dir: filepath.Join(root, "plugins")}, limits)
+
+	_, err = getPluginLimits(ctx, workspace.ResourcePlugin, "pulumi-resource-broken")
+	assert.ErrorContains(t, err, `invalid limits for resource plugin broken: memory: invalid size "lots"`)
+
+	limits, err = getPluginLimits(&Context{}, workspace.ResourcePlugin, "pulumi-resource-aws")
+	require.NoError(t, err)
+	assert.Nil(t, limits)
+}
+
+func TestGetPluginLimitsOverride(t *testing.T) {
+	t.Parallel()
+
+	project := []workspace.PluginLimits{{Name: "aws", Memory: "1GiB", CPUTime: "10m"}}
+	ctx := &Context{
+		PluginLimits:        project,
+		PluginLimitOverride: &workspace.PluginLimits{Memory: "2GiB", OpenFiles: 128},
+	}
+
+	// The override takes precedence over the project's limits, and applies to plugins that the project doesn't limit.
+	limits, err := getPluginLimits(ctx, workspace.ResourcePlugin, "pulumi-resource-aws")
+	require.NoError(t, err)
+	assert.Equal(t, &pluginLimits{memory: 2 << 30, memoryText: "2GiB", cpuTime: 10 * time.Minute, openFiles: 128}, limits)
+
+	limits, err = getPluginLimits(ctx, workspace.ResourcePlugin, "pulumi-resource-gcp")
+	require.NoError(t, err)
+	assert.Equal(t, &pluginLimits{memory: 2 << 30, memoryText: "2GiB", openFiles: 128}, limits)
+
+	// The project's limits are left unchanged.
+	assert.Equal(t, []workspace.PluginLimits{{Name: "aws", Memory: "1GiB", CPUTime: "10m"}}, project)
+}
+
+func TestPluginLimitsFilterEnv(t *testing.T) {
+	t.Parallel()
+
+	env := []string{
+		"PATH=/bin", "AWS_REGION=us-west-2", "AWS_PROFILE=dev", "SECRET=hunter2",
+		"PULUMI_CONFIG={}", "PULUMI_RUNTIME_VIRTUALENV=venv", "PULUMI_ACCESS_TOKEN=pul-secret",
+	}
+
+	unfiltered := &pluginLimits{}
+	assert.Equal(t, env, unfiltered.filterEnv(env))
+
+	filtered := &pluginLimits{env: []string{"PATH", "AWS_*"}}
+	assert.Equal(t, []string{
+		"PATH=/bin", "AWS_REGION=us-west-2", "AWS_PROFILE=dev",
+		"PULUMI_CONFIG={}", "PULUMI_RUNTIME_VIRTUALENV=venv",
+	}, filtered.filterEnv(env))
+
+	// Other PULUMI_ variables, such as credentials, are only passed if they are allowed.
+	empty := &pluginLimits{env: []string{}}
+	assert.Equal(t, []string{"PULUMI_CONFIG={}", "PULUMI_RUNTIME_VIRTUALENV=venv"}, empty.filterEnv(env))
+
+	token := &pluginLimits{env: []string{"PULUMI_ACCESS_TOKEN"}}
+	assert.Equal(t, []string{"PULUMI_CONFIG={}", "PULUMI_RUNTIME_VIRTUALENV=venv", "PULUMI_ACCESS_TOKEN=pul-secret"},
+		token.filterEnv(env))
+}
@@ -68,6 +68,10 @@ type Provider interface {
 	// resource is missing (for instance, because it has been deleted), the resulting property map will be nil.
 	Read(urn resource.URN, id resource.ID,
 		inputs, state resource.PropertyMap) (ReadResult, resource.Status, error)
+	// List enumerates a page of the existing resources of the given type, so that they can be discovered and
+	// imported. pageToken is empty for the first page, and otherwise the NextPageToken of the previous page. Listing is
+	// optional; providers that do not support it return ErrNotYetImplemented.
+	List(typ tokens.Type, pageToken string) (ListResult, error)
 	// Update updates an existing resource with new values.
 	Update(urn resource.URN, id resource.ID,
 		oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
@@ -121,6 +125,21 @@ type GrpcProvider interface {
 	Attach(address string) error
 }
 
+// ListResult is a page of the existing resources that were found by a provider's List method.
+type ListResult struct {
+	Resources     []ListedResource // the resources in this page.
+	NextPageToken string           // the token of the next page, or empty if this is the last page.
+}
+
+// ListedResource describes an existing resource that was found by a provider's List method.
+type ListedResource struct {
+	ID         resource.ID          // the ID to import the resource with.
+	Name       string               // a suggested logical name for the resource, if any.
+	ParentType tokens.Type          // the type of the listed resource that should be this resource's parent, if any.
+	ParentID   resource.ID          // the ID of the listed resource that should be this resource's parent, if any.
+	Properties resource.PropertyMap // an optional summary of the resource's properties, to aid selection.
+}
+
 // CheckFailure indicates that a call to check failed; it contains the property and reason for the failure.
 type CheckFailure struct {
 	Property resource.PropertyKey // the property that failed checking.
@@ -1172,6 +1172,59 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 	}, resourceStatus, resourceError
 }
 
+// List enumerates a page of the existing resources of the given type.
+func (p *provider) List(typ tokens.Type, pageToken string) (ListResult, error) {
+	label := fmt.Sprintf("%s.List(%s)", p.label(), typ)
+	logging.V(7).Infof("%s executing (pageToken=%q)", label, pageToken)
+
+	// Ensure that the plugin is configured.
+	client := p.clientRaw
+	pcfg, err := p.configSource.Promise().Result(context.Background())
+	if err != nil {
+		return ListResult{}, err
+	}
+	if !pcfg.known {
+		return ListResult{}, fmt.Errorf("cannot list resources of type %v: the provider is not fully configured", typ)
+	}
+
+	resp, err := client.List(p.requestContext(), &pulumirpc.ListRequest{
+		Type:      string(typ),
+		PageToken: pageToken,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented", label)
+			return ListResult{}, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError)
+		return ListResult{}, rpcError
+	}
+
+	listed := make([]ListedResource, 0, len(resp.GetResources()))
+	for _, r := range resp.GetResources() {
+		props, err := UnmarshalProperties(r.GetProperties(), MarshalOptions{
+			Label:          label + ".properties",
+			RejectUnknowns: true,
+			KeepSecrets:    true,
+			KeepResources:  true,
+		})
+		if err != nil {
+			return ListResult{}, err
+		}
+		listed = append(listed, ListedResource{
+			ID:         resource.ID(r.GetId()),
+			Name:       r.GetName(),
+			ParentType: tokens.Type(r.GetParentType()),
+			ParentID:   resource.ID(r.GetParentId()),
+			Properties: props,
+		})
+	}
+
+	logging.V(7).Infof("%s success: #resources=%d", label, len(listed))
+	return ListResult{Resources: listed, NextPageToken: resp.GetNextPageToken()}, nil
+}
+
 // Update updates an existing resource with new values.
 func (p *provider) Update(urn resource.URN, id resource.ID,
 	oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64,
@@ -614,6 +614,7 @@ type stubClient struct {
 	ConstructF  func(*pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error)
 	ConfigureF  func(*pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error)
 	DeleteF     func(*pulumirpc.DeleteRequest) error
+	ListF       func(*pulumirpc.ListRequest) (*pulumirpc.ListResponse, error)
 }
 
 func (c *stubClient) DiffConfig(
@@ -661,6 +662,74 @@ func (c *stubClient) Delete(
 	return c.ResourceProviderClient.Delete(ctx, req, opts...)
 }
 
+func (c *stubClient) List(
+	ctx context.Context,
+	req *pulumirpc.ListRequest,
+	opts ...grpc.CallOption,
+) (*pulumirpc.ListResponse, error) {
+	if f := c.ListF; f != nil {
+		return f(req)
+	}
+	return c.ResourceProviderClient.List(ctx, req, opts...)
+}
+
+func TestProvider_List(t *testing.T) {
+	t.Parallel()
+
+	var pageTokens []string
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{}, nil
+		},
+		ListF: func(req *pulumirpc.ListRequest) (*pulumirpc.ListResponse, error) {
+			assert.Equal(t, "foo:index:Bar", req.Type)
+			pageTokens = append(pageTokens, req.PageToken)
+			if req.PageToken == "" {
+				return &pulumirpc.ListResponse{
+					Resources: []*pulumirpc.ListedResource{{
+						Id:   "a",
+						Name: "first",
+						Properties: &structpb.Struct{Fields: map[string]*structpb.Value{
+							"size": structpb.NewNumberValue(3),
+						}},
+					}},
+					NextPageToken: "page2",
+				}, nil
+			}
+			return &pulumirpc.ListResponse{
+				Resources: []*pulumirpc.ListedResource{{Id: "b", ParentType: "foo:index:Bar", ParentId: "a"}},
+			}, nil
+		},
+	}
+
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	listed, err := p.List("foo:index:Bar", "")
+	require.NoError(t, err)
+	assert.Equal(t, ListResult{
+		Resources: []ListedResource{
+			{ID: "a", Name: "first", Properties: resource.PropertyMap{"size": resource.NewNumberProperty(3)}},
+		},
+		NextPageToken: "page2",
+	}, listed)
+
+	listed, err = p.List("foo:index:Bar", listed.NextPageToken)
+	require.NoError(t, err)
+	assert.Equal(t, ListResult{
+		Resources: []ListedResource{
+			{ID: "b", ParentType: "foo:index:Bar", ParentID: "a", Properties: resource.PropertyMap{}},
+		},
+	}, listed)
+	assert.Equal(t, []string{"", "page2"}, pageTokens)
+
+	client.ListF = func(req *pulumirpc.ListRequest) (*pulumirpc.ListResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "List is not implemented")
+	}
+	_, err = p.List("foo:index:Bar", "")
+	assert.ErrorIs(t, err, ErrNotYetImplemented)
+}
+
 // Test for https://github.com/pulumi/pulumi/issues/14529, ensure a kubernetes DiffConfig error is ignored
 func TestKubernetesDiffError(t *testing.T) {
 	t.Parallel()
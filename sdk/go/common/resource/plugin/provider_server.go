@@ -17,6 +17,7 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
@@ -386,6 +387,32 @@ func (p *providerServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (
 	}, nil
 }
 
+func (p *providerServer) List(ctx context.Context, req *pulumirpc.ListRequest) (*pulumirpc.ListResponse, error) {
+	result, err := p.provider.List(tokens.Type(req.GetType()), req.GetPageToken())
+	if err != nil {
+		if errors.Is(err, ErrNotYetImplemented) {
+			return nil, status.Error(codes.Unimplemented, "List is not yet implemented")
+		}
+		return nil, err
+	}
+
+	resources := make([]*pulumirpc.ListedResource, len(result.Resources))
+	for i, r := range result.Resources {
+		props, err := MarshalProperties(r.Properties, p.marshalOptions("properties"))
+		if err != nil {
+			return nil, err
+		}
+		resources[i] = &pulumirpc.ListedResource{
+			Id:         string(r.ID),
+			Name:       r.Name,
+			ParentType: string(r.ParentType),
+			ParentId:   string(r.ParentID),
+			Properties: props,
+		}
+	}
+	return &pulumirpc.ListResponse{Resources: resources, NextPageToken: result.NextPageToken}, nil
+}
+
 func (p *providerServer) Update(ctx context.Context, req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
 	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
 
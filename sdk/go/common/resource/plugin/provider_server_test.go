@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Validate that Configure can read inputs from variables instead of args.
@@ -51,6 +54,8 @@ type stubProvider struct {
 	) (ReadResult, resource.Status, error)
 
 	ConfigureFunc func(resource.PropertyMap) error
+
+	ListFunc func(typ tokens.Type, pageToken string) (ListResult, error)
 }
 
 func (p *stubProvider) Configure(inputs resource.PropertyMap) error {
@@ -100,3 +105,68 @@ func TestProviderServer_Read_respects_ID(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, secret, resp.Id)
 }
+
+func (p *stubProvider) List(typ tokens.Type, pageToken string) (ListResult, error) {
+	if p.ListFunc != nil {
+		return p.ListFunc(typ, pageToken)
+	}
+	return p.Provider.List(typ, pageToken)
+}
+
+// List passes page tokens between the client and the provider, so that each page is listed once.
+func TestProviderServer_List_pages(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]ListResult{
+		"":  {Resources: []ListedResource{{ID: "a", Properties: resource.PropertyMap{}}}, NextPageToken: "b"},
+		"b": {Resources: []ListedResource{{ID: "b", Properties: resource.PropertyMap{}}}, NextPageToken: "c"},
+		"c": {Resources: []ListedResource{{ID: "c", Properties: resource.PropertyMap{}}}},
+	}
+	var pageTokens []string
+	provider := stubProvider{
+		ListFunc: func(typ tokens.Type, pageToken string) (ListResult, error) {
+			assert.Equal(t, tokens.Type("foo:index:Bar"), typ)
+			pageTokens = append(pageTokens, pageToken)
+			return pages[pageToken], nil
+		},
+	}
+	srv := NewProviderServer(&provider)
+
+	ctx := context.Background()
+	client := &stubClient{
+		ConfigureF: func(req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+			return &pulumirpc.ConfigureResponse{}, nil
+		},
+		ListF: func(req *pulumirpc.ListRequest) (*pulumirpc.ListResponse, error) {
+			return srv.List(ctx, req)
+		},
+	}
+	p := NewProviderWithClient(newTestContext(t), "foo", client, false /* disablePreview */)
+	require.NoError(t, p.Configure(resource.PropertyMap{}))
+
+	var listed []ListedResource
+	pageToken := ""
+	for {
+		page, err := p.List("foo:index:Bar", pageToken)
+		require.NoError(t, err)
+		listed = append(listed, page.Resources...)
+		if pageToken = page.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"", "b", "c"}, pageTokens)
+	assert.Equal(t, []ListedResource{
+		{ID: "a", Properties: resource.PropertyMap{}},
+		{ID: "b", Properties: resource.PropertyMap{}},
+		{ID: "c", Properties: resource.PropertyMap{}},
+	}, listed)
+}
+
+// Providers that don't implement List report it as unimplemented.
+func TestProviderServer_List_unimplemented(t *testing.T) {
+	t.Parallel()
+
+	srv := NewProviderServer(&UnimplementedProvider{})
+	_, err := srv.List(context.Background(), &pulumirpc.ListRequest{Type: "foo:index:Bar"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -70,6 +70,10 @@ func (p *UnimplementedProvider) Read(urn resource.URN, id resource.ID, inputs re
 	return ReadResult{}, resource.StatusUnknown, status.Error(codes.Unimplemented, "Read is not yet implemented")
 }
 
+func (p *UnimplementedProvider) List(typ tokens.Type, pageToken string) (ListResult, error) {
+	return ListResult{}, status.Error(codes.Unimplemented, "List is not yet implemented")
+}
+
 func (p *UnimplementedProvider) Update(urn resource.URN, id resource.ID, oldInputs, oldOutputs, newInputs resource.PropertyMap, timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 	return resource.PropertyMap{}, resource.StatusUnknown, status.Error(codes.Unimplemented, "Update is not yet implemented")
 }
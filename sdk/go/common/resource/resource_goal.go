@@ -43,7 +43,10 @@ type Goal struct {
 	// if set, the providers Delete method will not be called for this resource
 	// if specified resource is being deleted as well.
 	DeletedWith    URN
-	SourcePosition string // If set, the source location of the resource registration
+	SourcePosition string         // If set, the source location of the resource registration
+	Hooks          []ResourceHook // actions to run before or after operations on the resource.
+	// resources that must be deleted after this resource when both are being deleted.
+	DeleteDependencies []URN
 }
 
 // NewGoal allocates a new resource goal state.
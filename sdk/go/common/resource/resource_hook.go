@@ -0,0 +1,87 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResourceHookEvent identifies the point in a resource's lifecycle at which a resource hook runs.
+type ResourceHookEvent string
+
+const (
+	// ResourceHookBeforeCreate runs before the resource is created.
+	ResourceHookBeforeCreate ResourceHookEvent = "before_create"
+	// ResourceHookAfterCreate runs after the resource has been created.
+	ResourceHookAfterCreate ResourceHookEvent = "after_create"
+	// ResourceHookBeforeUpdate runs before the resource is updated.
+	ResourceHookBeforeUpdate ResourceHookEvent = "before_update"
+	// ResourceHookAfterUpdate runs after the resource has been updated.
+	ResourceHookAfterUpdate ResourceHookEvent = "after_update"
+	// ResourceHookBeforeDelete runs before the resource is deleted.
+	ResourceHookBeforeDelete ResourceHookEvent = "before_delete"
+	// ResourceHookAfterDelete runs after the resource has been deleted.
+	ResourceHookAfterDelete ResourceHookEvent = "after_delete"
+	// ResourceHookBeforeReplace runs before the replacement of the resource is created.
+	ResourceHookBeforeReplace ResourceHookEvent = "before_replace"
+	// ResourceHookAfterReplace runs after the replacement of the resource has been created.
+	ResourceHookAfterReplace ResourceHookEvent = "after_replace"
+)
+
+// ParseResourceHookEvent parses the name of a resource hook event.
+func ParseResourceHookEvent(s string) (ResourceHookEvent, error) {
+	switch e := ResourceHookEvent(s); e {
+	case ResourceHookBeforeCreate, ResourceHookAfterCreate,
+		ResourceHookBeforeUpdate, ResourceHookAfterUpdate,
+		ResourceHookBeforeDelete, ResourceHookAfterDelete,
+		ResourceHookBeforeReplace, ResourceHookAfterReplace:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unknown resource hook event '%v'", s)
+	}
+}
+
+// ResourceHookArgs are the arguments passed to a resource hook.
+type ResourceHookArgs struct {
+	URN        URN               // the URN of the resource.
+	ID         ID                // the ID of the resource, if it exists.
+	Event      ResourceHookEvent // the event on which the hook is running.
+	OldInputs  PropertyMap       // the old input properties of the resource, if any.
+	OldOutputs PropertyMap       // the old output properties of the resource, if any.
+	NewInputs  PropertyMap       // the new input properties of the resource, if any.
+	NewOutputs PropertyMap       // the new output properties of the resource, if any.
+}
+
+// ResourceHookFunc runs a resource hook, returning an error if the hook failed.
+type ResourceHookFunc func(ctx context.Context, args ResourceHookArgs) error
+
+// ResourceHook is an action that the engine runs before or after operations on a resource.
+type ResourceHook struct {
+	Name          string              // the name of the hook, used in the display.
+	Events        []ResourceHookEvent // the events on which the hook runs.
+	WarnOnFailure bool                // true if a failure of the hook is a warning rather than failing the operation.
+	Run           ResourceHookFunc    // the function that runs the hook.
+}
+
+// RunsOn returns true if the hook runs on the given event.
+func (h ResourceHook) RunsOn(event ResourceHookEvent) bool {
+	for _, e := range h.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
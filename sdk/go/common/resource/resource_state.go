@@ -51,6 +51,15 @@ type State struct {
 	Created                 *time.Time            // If set, the time when the state was initially added to the state file. (i.e. Create, Import)
 	Modified                *time.Time            // If set, the time when the state was last modified in the state file.
 	SourcePosition          string                // If set, the source location of the resource registration
+	IgnoreChanges           []string              // the set of input properties whose changes are ignored.
+	DeleteDependencies      []URN                 // resources that must be deleted after this one when both are deleted.
+	StackDependency         *StackDependency      // if set, the stack outputs that were read by this stack reference.
+}
+
+// StackDependency records the outputs of another stack that were read by a stack reference.
+type StackDependency struct {
+	Stack   string        // the name of the referenced stack, as given to the stack reference.
+	Outputs []PropertyKey // the names of the outputs of the referenced stack that were read.
 }
 
 func (s *State) GetAliasURNs() []URN {
@@ -42,6 +42,8 @@ const (
 	HistoryDir = "history"
 	// PluginDir is the name of the directory containing plugins.
 	PluginDir = "plugins"
+	// SchemaCacheDir is the name of the directory that holds the cache of package schemas.
+	SchemaCacheDir = "schemas"
 	// PolicyDir is the name of the directory that holds policy packs.
 	PolicyDir = "policies"
 	// StackDir is the name of the directory that holds stack information for projects.
@@ -0,0 +1,303 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// PluginLockFile is the name of the file, next to the project file, that records the exact plugins a project uses.
+const PluginLockFile = "Pulumi.lock"
+
+// PluginLockVersion is the current version of the plugin lock file format.
+const PluginLockVersion = 1
+
+// PluginLock records the exact set of plugins used by a project, so that every run of the project uses the same
+// plugin versions and artifacts regardless of what the language SDKs ask for or what is installed locally.
+type PluginLock struct {
+	// Version is the version of the lock file format.
+	Version int `json:"version" yaml:"version"`
+	// Plugins is the list of locked plugins, sorted by kind, name and version.
+	Plugins []LockedPlugin `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+}
+
+// LockedPlugin is a single plugin recorded in a plugin lock file.
+type LockedPlugin struct {
+	// Kind is the kind of the plugin (language, resource, etc).
+	Kind PluginKind `json:"kind" yaml:"kind"`
+	// Name is the simple name of the plugin.
+	Name string `json:"name" yaml:"name"`
+	// Version is the exact version of the plugin.
+	Version string `json:"version" yaml:"version"`
+	// DownloadURL is the server the plugin is downloaded from, if not one of the default sources.
+	DownloadURL string `json:"server,omitempty" yaml:"server,omitempty"`
+	// Checksums are the hex encoded SHA256 checksums of the plugin archive, keyed by "$os-$arch".
+	Checksums map[string]string `json:"checksums,omitempty" yaml:"checksums,omitempty"`
+}
+
+// Spec returns the PluginSpec for this locked plugin.
+func (p LockedPlugin) Spec() (PluginSpec, error) {
+	version, err := semver.ParseTolerant(p.Version)
+	if err != nil {
+		return PluginSpec{}, fmt.Errorf("invalid version %q for %s plugin %s: %w", p.Version, p.Kind, p.Name, err)
+	}
+
+	var checksums map[string][]byte
+	if len(p.Checksums) != 0 {
+		checksums = make(map[string][]byte, len(p.Checksums))
+		for platform, checksum := range p.Checksums {
+			b, err := hex.DecodeString(checksum)
+			if err != nil {
+				return PluginSpec{}, fmt.Errorf("invalid %s checksum for %s plugin %s: %w", platform, p.Kind, p.Name, err)
+			}
+			checksums[platform] = b
+		}
+	}
+
+	return PluginSpec{
+		Name:              p.Name,
+		Kind:              p.Kind,
+		Version:           &version,
+		PluginDownloadURL: p.DownloadURL,
+		Checksums:         checksums,
+	}, nil
+}
+
+// PluginLockPath returns the path of the plugin lock file for the project in the given directory.
+func PluginLockPath(projectDir string) string {
+	return filepath.Join(projectDir, PluginLockFile)
+}
+
+// LoadPluginLock reads the plugin lock file at the given path. If the file does not exist, nil is returned without an
+// error, to indicate that the project's plugins are not locked.
+func LoadPluginLock(path string) (*PluginLock, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var lock PluginLock
+	if err := encoding.YAML.Unmarshal(b, &lock); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if lock.Version > PluginLockVersion {
+		return nil, fmt.Errorf("%s has version %d, but this version of Pulumi only supports version %d",
+			path, lock.Version, PluginLockVersion)
+	}
+	for _, p := range lock.Plugins {
+		if _, err := p.Spec(); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+	}
+	return &lock, nil
+}
+
+// Save writes the plugin lock to the given path.
+func (l *PluginLock) Save(path string) error {
+	contract.Requiref(path != "", "path", "must not be empty")
+
+	l.Version = PluginLockVersion
+	l.sort()
+	b, err := encoding.YAML.Marshal(l)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec
+	return os.WriteFile(path, b, 0o644)
+}
+
+func (l *PluginLock) sort() {
+	sort.Slice(l.Plugins, func(i, j int) bool {
+		a, b := l.Plugins[i], l.Plugins[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		// Versions were validated when the lock was loaded or recorded.
+		av, _ := semver.ParseTolerant(a.Version)
+		bv, _ := semver.ParseTolerant(b.Version)
+		return av.LT(bv)
+	})
+}
+
+// Lookup returns all the locked versions of the given plugin, sorted from oldest to newest.
+func (l *PluginLock) Lookup(kind PluginKind, name string) []PluginSpec {
+	if l == nil {
+		return nil
+	}
+
+	var specs []PluginSpec
+	for _, p := range l.Plugins {
+		if p.Kind == kind && p.Name == name {
+			spec, err := p.Spec()
+			contract.AssertNoErrorf(err, "locked plugins are validated on load")
+			specs = append(specs, spec)
+		}
+	}
+	sort.Sort(SortedPluginSpec(specs))
+	return specs
+}
+
+// Record adds the given plugin to the lock, replacing any existing entry for the same kind, name and version. The
+// plugin must have a version. The checksums of the plugin are merged with those already recorded for other
+// platforms. Record returns true if the lock was changed.
+func (l *PluginLock) Record(spec PluginSpec) bool {
+	contract.Requiref(spec.Version != nil, "spec", "must have a version")
+
+	entry := LockedPlugin{
+		Kind:        spec.Kind,
+		Name:        spec.Name,
+		Version:     spec.Version.String(),
+		DownloadURL: spec.PluginDownloadURL,
+	}
+	for platform, checksum := range spec.Checksums {
+		if entry.Checksums == nil {
+			entry.Checksums = make(map[string]string, len(spec.Checksums))
+		}
+		entry.Checksums[platform] = hex.EncodeToString(checksum)
+	}
+
+	for i, existing := range l.Plugins {
+		if existing.Kind != entry.Kind || existing.Name != entry.Name || existing.Version != entry.Version {
+			continue
+		}
+
+		// Keep the checksums for other platforms, as long as the plugin still comes from the same place.
+		if existing.DownloadURL == entry.DownloadURL {
+			for platform, checksum := range existing.Checksums {
+				if _, has := entry.Checksums[platform]; !has {
+					if entry.Checksums == nil {
+						entry.Checksums = make(map[string]string, len(existing.Checksums))
+					}
+					entry.Checksums[platform] = checksum
+				}
+			}
+		}
+
+		if lockedPluginsEqual(existing, entry) {
+			return false
+		}
+		l.Plugins[i] = entry
+		return true
+	}
+
+	l.Plugins = append(l.Plugins, entry)
+	l.sort()
+	return true
+}
+
+func lockedPluginsEqual(a, b LockedPlugin) bool {
+	if a.Kind != b.Kind || a.Name != b.Name || a.Version != b.Version || a.DownloadURL != b.DownloadURL ||
+		len(a.Checksums) != len(b.Checksums) {
+		return false
+	}
+	for platform, checksum := range a.Checksums {
+		if b.Checksums[platform] != checksum {
+			return false
+		}
+	}
+	return true
+}
+
+// PluginLockError is returned when a plugin deviates from what is recorded in a plugin lock file.
+type PluginLockError struct {
+	Spec   PluginSpec
+	Reason string
+}
+
+func (err *PluginLockError) Error() string {
+	return fmt.Sprintf("%s plugin %s %s", err.Spec.Kind, err.Spec, err.Reason)
+}
+
+// IsPluginLockable returns true if the given plugin is recorded in plugin lock files. Plugins that are bundled with
+// Pulumi and the builtin "pulumi" provider are never locked, as their versions are determined by the CLI itself.
+func IsPluginLockable(kind PluginKind, name string) bool {
+	if kind == ResourcePlugin && name == "pulumi" {
+		return false
+	}
+	return !IsPluginBundled(kind, name)
+}
+
+// Pin returns the given plugin spec pinned to the plugin recorded in the lock. If the spec has no version, the newest
+// locked version is used. The download URL and checksums of the locked plugin are filled in so that downloads are
+// verified against the recorded checksums. If the plugin deviates from the lock, the spec is returned unchanged along
+// with a *PluginLockError. A nil lock pins nothing.
+func (l *PluginLock) Pin(spec PluginSpec) (PluginSpec, error) {
+	if l == nil || !IsPluginLockable(spec.Kind, spec.Name) {
+		return spec, nil
+	}
+
+	locked := l.Lookup(spec.Kind, spec.Name)
+	if len(locked) == 0 {
+		return spec, &PluginLockError{Spec: spec, Reason: "is not recorded in " + PluginLockFile}
+	}
+
+	var match *PluginSpec
+	if spec.Version == nil {
+		match = &locked[len(locked)-1]
+	} else {
+		for i := range locked {
+			if locked[i].Version.EQ(*spec.Version) {
+				match = &locked[i]
+				break
+			}
+		}
+	}
+	if match == nil {
+		versions := make([]string, len(locked))
+		for i, p := range locked {
+			versions[i] = p.Version.String()
+		}
+		return spec, &PluginLockError{
+			Spec: spec,
+			Reason: fmt.Sprintf("does not match the version(s) recorded in %s: %s",
+				PluginLockFile, strings.Join(versions, ", ")),
+		}
+	}
+
+	if spec.PluginDownloadURL != "" && spec.PluginDownloadURL != match.PluginDownloadURL {
+		return spec, &PluginLockError{
+			Spec: spec,
+			Reason: fmt.Sprintf("is downloaded from %q, but %s records %q",
+				spec.PluginDownloadURL, PluginLockFile, match.PluginDownloadURL),
+		}
+	}
+
+	pinned := spec
+	pinned.Version = match.Version
+	pinned.PluginDownloadURL = match.PluginDownloadURL
+	if len(match.Checksums) != 0 {
+		pinned.Checksums = match.Checksums
+	}
+	return pinned, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginLockRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	v1 := semver.MustParse("1.0.0")
+	v2 := semver.MustParse("2.0.0")
+
+	var lock PluginLock
+	assert.True(t, lock.Record(PluginSpec{Kind: ResourcePlugin, Name: "b", Version: &v2}))
+	assert.True(t, lock.Record(PluginSpec{
+		Kind:              ResourcePlugin,
+		Name:              "a",
+		Version:           &v1,
+		PluginDownloadURL: "https://example.com",
+		Checksums:         map[string][]byte{"linux-amd64": {0xde, 0xad}},
+	}))
+	// Recording the same plugin again is a no-op.
+	assert.False(t, lock.Record(PluginSpec{Kind: ResourcePlugin, Name: "b", Version: &v2}))
+	// Recording another platform's checksum merges it with the existing ones.
+	assert.True(t, lock.Record(PluginSpec{
+		Kind:              ResourcePlugin,
+		Name:              "a",
+		Version:           &v1,
+		PluginDownloadURL: "https://example.com",
+		Checksums:         map[string][]byte{"darwin-arm64": {0xbe, 0xef}},
+	}))
+
+	path := filepath.Join(t.TempDir(), PluginLockFile)
+	require.NoError(t, lock.Save(path))
+
+	loaded, err := LoadPluginLock(path)
+	require.NoError(t, err)
+	assert.Equal(t, PluginLockVersion, loaded.Version)
+	assert.Equal(t, []LockedPlugin{
+		{
+			Kind:        ResourcePlugin,
+			Name:        "a",
+			Version:     "1.0.0",
+			DownloadURL: "https://example.com",
+			Checksums:   map[string]string{"linux-amd64": "dead", "darwin-arm64": "beef"},
+		},
+		{Kind: ResourcePlugin, Name: "b", Version: "2.0.0"},
+	}, loaded.Plugins)
+}
+
+func TestLoadPluginLockMissing(t *testing.T) {
+	t.Parallel()
+
+	lock, err := LoadPluginLock(filepath.Join(t.TempDir(), PluginLockFile))
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+}
+
+func TestLoadPluginLockInvalid(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), PluginLockFile)
+	err := os.WriteFile(path, []byte("version: 1\nplugins:\n- kind: resource\n  name: a\n  version: nope\n"), 0o600)
+	require.NoError(t, err)
+
+	_, err = LoadPluginLock(path)
+	assert.ErrorContains(t, err, `invalid version "nope" for resource plugin a`)
+}
+
+func TestPluginLockPin(t *testing.T) {
+	t.Parallel()
+
+	v1 := semver.MustParse("1.0.0")
+	v2 := semver.MustParse("2.0.0")
+	v3 := semver.MustParse("3.0.0")
+
+	lock := &PluginLock{}
+	lock.Record(PluginSpec{Kind: ResourcePlugin, Name: "aws", Version: &v1})
+	lock.Record(PluginSpec{
+		Kind:      ResourcePlugin,
+		Name:      "aws",
+		Version:   &v2,
+		Checksums: map[string][]byte{"linux-amd64": {0x01}},
+	})
+
+	t.Run("unversioned uses newest", func(t *testing.T) {
+		t.Parallel()
+
+		pinned, err := lock.Pin(PluginSpec{Kind: ResourcePlugin, Name: "aws"})
+		require.NoError(t, err)
+		assert.Equal(t, &v2, pinned.Version)
+		assert.Equal(t, map[string][]byte{"linux-amd64": {0x01}}, pinned.Checksums)
+	})
+
+	t.Run("exact version", func(t *testing.T) {
+		t.Parallel()
+
+		pinned, err := lock.Pin(PluginSpec{Kind: ResourcePlugin, Name: "aws", Version: &v1})
+		require.NoError(t, err)
+		assert.Equal(t, &v1, pinned.Version)
+		assert.Nil(t, pinned.Checksums)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		spec := PluginSpec{Kind: ResourcePlugin, Name: "aws", Version: &v3}
+		pinned, err := lock.Pin(spec)
+		var lockErr *PluginLockError
+		require.ErrorAs(t, err, &lockErr)
+		assert.Equal(t, spec, pinned)
+		assert.ErrorContains(t, err,
+			"resource plugin aws-3.0.0 does not match the version(s) recorded in Pulumi.lock: 1.0.0, 2.0.0")
+	})
+
+	t.Run("download URL mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := lock.Pin(PluginSpec{
+			Kind: ResourcePlugin, Name: "aws", Version: &v1, PluginDownloadURL: "https://example.com",
+		})
+		assert.ErrorContains(t, err, `is downloaded from "https://example.com", but Pulumi.lock records ""`)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := lock.Pin(PluginSpec{Kind: ResourcePlugin, Name: "gcp"})
+		assert.ErrorContains(t, err, "resource plugin gcp is not recorded in Pulumi.lock")
+	})
+
+	t.Run("not lockable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := lock.Pin(PluginSpec{Kind: ResourcePlugin, Name: "pulumi"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil lock", func(t *testing.T) {
+		t.Parallel()
+
+		var nilLock *PluginLock
+		spec := PluginSpec{Kind: ResourcePlugin, Name: "gcp"}
+		pinned, err := nilLock.Pin(spec)
+		assert.NoError(t, err)
+		assert.Equal(t, spec, pinned)
+	})
+}
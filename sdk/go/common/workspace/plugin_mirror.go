@@ -0,0 +1,377 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/env"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// PluginMirrorIndexFile is the name of the index file at the root of a plugin mirror.
+const PluginMirrorIndexFile = "index.json"
+
+// PluginMirrorIndexVersion is the current version of the plugin mirror index format.
+const PluginMirrorIndexVersion = 1
+
+// PluginMirrorIndex lists the plugin archives stored in a plugin mirror.
+type PluginMirrorIndex struct {
+	// Version is the version of the index format.
+	Version int `json:"version"`
+	// Plugins is the list of plugin archives in the mirror, sorted by kind, name, version and platform.
+	Plugins []PluginMirrorEntry `json:"plugins"`
+}
+
+// PluginMirrorEntry is a single plugin archive stored in a plugin mirror.
+type PluginMirrorEntry struct {
+	// Kind is the kind of the plugin (language, resource, etc).
+	Kind PluginKind `json:"kind"`
+	// Name is the simple name of the plugin.
+	Name string `json:"name"`
+	// Version is the exact version of the plugin.
+	Version string `json:"version"`
+	// OS is the operating system the archive is built for.
+	OS string `json:"os"`
+	// Arch is the architecture the archive is built for.
+	Arch string `json:"arch"`
+	// File is the path of the archive, relative to the root of the mirror.
+	File string `json:"file"`
+	// SHA256 is the hex encoded SHA256 checksum of the archive.
+	SHA256 string `json:"sha256"`
+}
+
+// PluginMirrorFile returns the path, relative to the root of a mirror, that the archive for the given plugin and
+// platform is stored at.
+func PluginMirrorFile(kind PluginKind, name string, version semver.Version, opSy, arch string) string {
+	return path.Join(string(kind), name, standardAssetName(name, kind, version, opSy, arch))
+}
+
+// ReadPluginMirrorIndex reads and validates a plugin mirror index.
+func ReadPluginMirrorIndex(r io.Reader) (*PluginMirrorIndex, error) {
+	var index PluginMirrorIndex
+	if err := json.NewDecoder(r).Decode(&index); err != nil {
+		return nil, fmt.Errorf("reading plugin mirror index: %w", err)
+	}
+	if index.Version > PluginMirrorIndexVersion {
+		return nil, fmt.Errorf("plugin mirror index has version %d, but this version of Pulumi only supports version %d",
+			index.Version, PluginMirrorIndexVersion)
+	}
+	for _, e := range index.Plugins {
+		if _, err := semver.ParseTolerant(e.Version); err != nil {
+			return nil, fmt.Errorf("invalid version %q for %s plugin %s in plugin mirror index: %w",
+				e.Version, e.Kind, e.Name, err)
+		}
+		// Archives are always verified, so an entry without a valid checksum can never be downloaded.
+		if checksum, err := hex.DecodeString(e.SHA256); err != nil || len(checksum) != sha256.Size {
+			return nil, fmt.Errorf("invalid checksum for %s plugin %s-%s in plugin mirror index", e.Kind, e.Name, e.Version)
+		}
+		if !isLocalMirrorFile(e.File) {
+			return nil, fmt.Errorf("invalid file %q for %s plugin %s-%s in plugin mirror index: must be a relative path "+
+				"inside the mirror", e.File, e.Kind, e.Name, e.Version)
+		}
+	}
+	return &index, nil
+}
+
+// isLocalMirrorFile returns true if the given slash separated path is a relative path inside the mirror.
+func isLocalMirrorFile(file string) bool {
+	clean := path.Clean(file)
+	return file != "" && !path.IsAbs(clean) && !filepath.IsAbs(filepath.FromSlash(clean)) &&
+		clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
+// Write writes the index as JSON.
+func (index *PluginMirrorIndex) Write(w io.Writer) error {
+	index.Version = PluginMirrorIndexVersion
+	sort.Slice(index.Plugins, func(i, j int) bool {
+		a, b := index.Plugins[i], index.Plugins[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Version != b.Version {
+			// Versions were validated when the index was read or the entry added.
+			av, _ := semver.ParseTolerant(a.Version)
+			bv, _ := semver.ParseTolerant(b.Version)
+			return av.LT(bv)
+		}
+		if a.OS != b.OS {
+			return a.OS < b.OS
+		}
+		return a.Arch < b.Arch
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(index)
+}
+
+// Find returns the entry for the given plugin and platform, or nil if the mirror doesn't contain it.
+func (index *PluginMirrorIndex) Find(
+	kind PluginKind, name string, version semver.Version, opSy, arch string,
+) *PluginMirrorEntry {
+	for i, e := range index.Plugins {
+		if e.Kind != kind || e.Name != name || e.OS != opSy || e.Arch != arch {
+			continue
+		}
+		if v, err := semver.ParseTolerant(e.Version); err == nil && v.EQ(version) {
+			return &index.Plugins[i]
+		}
+	}
+	return nil
+}
+
+// Add adds the given entry to the index, replacing any existing entry for the same plugin and platform.
+func (index *PluginMirrorIndex) Add(entry PluginMirrorEntry) {
+	version, err := semver.ParseTolerant(entry.Version)
+	contract.Requiref(err == nil, "entry", "must have a valid version")
+
+	if existing := index.Find(entry.Kind, entry.Name, version, entry.OS, entry.Arch); existing != nil {
+		*existing = entry
+		return
+	}
+	index.Plugins = append(index.Plugins, entry)
+}
+
+// Latest returns the newest version of the given plugin in the mirror, or nil if the mirror doesn't contain it.
+func (index *PluginMirrorIndex) Latest(kind PluginKind, name string) *semver.Version {
+	var latest *semver.Version
+	for _, e := range index.Plugins {
+		if e.Kind != kind || e.Name != name {
+			continue
+		}
+		if v, err := semver.ParseTolerant(e.Version); err == nil && (latest == nil || v.GT(*latest)) {
+			latest = &v
+		}
+	}
+	return latest
+}
+
+// PluginMirrorBucket is the storage a plugin mirror is read from.
+type PluginMirrorBucket interface {
+	// Open opens the file at the given path, relative to the root of the mirror, and returns its size if known.
+	Open(key string) (io.ReadCloser, int64, error)
+}
+
+// PluginMirrorOpener opens the bucket for a plugin mirror URL.
+type PluginMirrorOpener func(u *url.URL) (PluginMirrorBucket, error)
+
+var (
+	pluginMirrorLock    sync.Mutex
+	pluginMirrorOpeners = map[string]PluginMirrorOpener{
+		"":     openFilePluginMirror,
+		"file": openFilePluginMirror,
+	}
+	// The plugin mirror configured by the current project, if any.
+	projectPluginMirror string
+	// The mirrors that have been opened, keyed by URL.
+	pluginMirrors = map[string]*pluginMirror{}
+)
+
+// RegisterPluginMirrorScheme registers an opener for plugin mirror URLs with the given scheme. Only local
+// directories are supported out of the box; this allows other storage, such as cloud buckets, to be plugged in.
+func RegisterPluginMirrorScheme(scheme string, opener PluginMirrorOpener) {
+	pluginMirrorLock.Lock()
+	defer pluginMirrorLock.Unlock()
+	pluginMirrorOpeners[scheme] = opener
+}
+
+// SetProjectPluginMirror sets the plugin mirror configured by the current project. Relative directories are resolved
+// against the given project directory. The PULUMI_PLUGIN_MIRROR environment variable takes precedence over this.
+func SetProjectPluginMirror(mirror, projectDir string) {
+	if mirror != "" && !strings.Contains(mirror, "://") && !filepath.IsAbs(mirror) {
+		mirror = filepath.Join(projectDir, mirror)
+	}
+
+	pluginMirrorLock.Lock()
+	defer pluginMirrorLock.Unlock()
+	projectPluginMirror = mirror
+}
+
+// GetPluginMirror returns the URL of the plugin mirror that plugins are downloaded from, or "" if plugins are
+// downloaded from their usual sources.
+func GetPluginMirror() string {
+	if mirror := env.PluginMirror.Value(); mirror != "" {
+		return mirror
+	}
+
+	pluginMirrorLock.Lock()
+	defer pluginMirrorLock.Unlock()
+	return projectPluginMirror
+}
+
+// pluginMirror is an opened plugin mirror. The index is read the first time it is needed.
+type pluginMirror struct {
+	url    string
+	bucket PluginMirrorBucket
+
+	once  sync.Once
+	index *PluginMirrorIndex
+	err   error
+}
+
+func getPluginMirror(rawURL string) (*pluginMirror, error) {
+	pluginMirrorLock.Lock()
+	defer pluginMirrorLock.Unlock()
+
+	if mirror, has := pluginMirrors[rawURL]; has {
+		return mirror, nil
+	}
+
+	u, err := parsePluginMirrorURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	opener, has := pluginMirrorOpeners[u.Scheme]
+	if !has {
+		return nil, fmt.Errorf("unsupported plugin mirror scheme: %s", u.Scheme)
+	}
+	bucket, err := opener(u)
+	if err != nil {
+		return nil, fmt.Errorf("opening plugin mirror %s: %w", rawURL, err)
+	}
+
+	mirror := &pluginMirror{url: rawURL, bucket: bucket}
+	pluginMirrors[rawURL] = mirror
+	return mirror, nil
+}
+
+func parsePluginMirrorURL(rawURL string) (*url.URL, error) {
+	// Windows paths such as C:\mirror would otherwise be parsed as having the scheme "c".
+	if filepath.IsAbs(rawURL) {
+		return &url.URL{Path: filepath.ToSlash(rawURL)}, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin mirror URL %q: %w", rawURL, err)
+	}
+	return u, nil
+}
+
+func (mirror *pluginMirror) getIndex() (*PluginMirrorIndex, error) {
+	mirror.once.Do(func() {
+		r, _, err := mirror.bucket.Open(PluginMirrorIndexFile)
+		if err != nil {
+			mirror.err = fmt.Errorf("reading plugin mirror index from %s: %w", mirror.url, err)
+			return
+		}
+		defer contract.IgnoreClose(r)
+		mirror.index, mirror.err = ReadPluginMirrorIndex(r)
+	})
+	return mirror.index, mirror.err
+}
+
+// filePluginMirror is a plugin mirror stored in a local directory.
+type filePluginMirror struct {
+	root string
+}
+
+func openFilePluginMirror(u *url.URL) (PluginMirrorBucket, error) {
+	// file://relative/path puts the first path segment in the host.
+	root := filepath.FromSlash(u.Host + u.Path)
+	if root == "" {
+		return nil, errors.New("missing directory")
+	}
+	return &filePluginMirror{root: root}, nil
+}
+
+func (mirror *filePluginMirror) Open(key string) (io.ReadCloser, int64, error) {
+	f, err := os.Open(filepath.Join(mirror.root, filepath.FromSlash(key)))
+	if err != nil {
+		return nil, -1, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		contract.IgnoreClose(f)
+		return nil, -1, err
+	}
+	return f, info.Size(), nil
+}
+
+// mirrorSource downloads plugins from a plugin mirror. Every archive is verified against the checksum recorded in
+// the mirror's index.
+type mirrorSource struct {
+	mirror *pluginMirror
+	name   string
+	kind   PluginKind
+}
+
+func newMirrorSource(rawURL string, name string, kind PluginKind) (*mirrorSource, error) {
+	mirror, err := getPluginMirror(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &mirrorSource{mirror: mirror, name: name, kind: kind}, nil
+}
+
+func (source *mirrorSource) GetLatestVersion(
+	getHTTPResponse func(*http.Request) (io.ReadCloser, int64, error),
+) (*semver.Version, error) {
+	index, err := source.mirror.getIndex()
+	if err != nil {
+		return nil, err
+	}
+	latest := index.Latest(source.kind, source.name)
+	if latest == nil {
+		return nil, fmt.Errorf("%s plugin %s not found in plugin mirror %s", source.kind, source.name, source.mirror.url)
+	}
+	return latest, nil
+}
+
+func (source *mirrorSource) Download(
+	version semver.Version, opSy string, arch string,
+	getHTTPResponse func(*http.Request) (io.ReadCloser, int64, error),
+) (io.ReadCloser, int64, error) {
+	index, err := source.mirror.getIndex()
+	if err != nil {
+		return nil, -1, err
+	}
+	entry := index.Find(source.kind, source.name, version, opSy, arch)
+	if entry == nil {
+		return nil, -1, fmt.Errorf("%s plugin %s-%s for %s-%s not found in plugin mirror %s",
+			source.kind, source.name, version, opSy, arch, source.mirror.url)
+	}
+
+	checksum, err := hex.DecodeString(entry.SHA256)
+	contract.AssertNoErrorf(err, "checksums are validated when the index is read")
+
+	response, length, err := source.mirror.bucket.Open(entry.File)
+	if err != nil {
+		return nil, -1, fmt.Errorf("reading %s from plugin mirror %s: %w", entry.File, source.mirror.url, err)
+	}
+	return &checksumReader{
+		checksum: checksum,
+		hasher:   sha256.New(),
+		io:       response,
+	}, length, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2016-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestPluginMirror writes a plugin mirror containing the given archives, keyed by version, for linux-amd64.
+func writeTestPluginMirror(t *testing.T, archives map[string]string) string {
+	dir := t.TempDir()
+
+	var index PluginMirrorIndex
+	for version, contents := range archives {
+		file := PluginMirrorFile(ResourcePlugin, "aws", semver.MustParse(version), "linux", "amd64")
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+		checksum := sha256.Sum256([]byte(contents))
+		index.Add(PluginMirrorEntry{
+			Kind:    ResourcePlugin,
+			Name:    "aws",
+			Version: version,
+			OS:      "linux",
+			Arch:    "amd64",
+			File:    file,
+			SHA256:  hex.EncodeToString(checksum[:]),
+		})
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, index.Write(&b))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, PluginMirrorIndexFile), b.Bytes(), 0o600))
+	return dir
+}
+
+func TestMirrorSource(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestPluginMirror(t, map[string]string{"1.0.0": "one", "2.0.0": "two"})
+	source, err := newMirrorSource("file://"+filepath.ToSlash(dir), "aws", ResourcePlugin)
+	require.NoError(t, err)
+
+	latest, err := source.GetLatestVersion(nil)
+	require.NoError(t, err)
+	assert.Equal(t, semver.MustParse("2.0.0"), *latest)
+
+	r, size, err := source.Download(semver.MustParse("1.0.0"), "linux", "amd64", nil)
+	require.NoError(t, err)
+	defer r.Close()
+	assert.Equal(t, int64(3), size)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(b))
+
+	_, _, err = source.Download(semver.MustParse("1.0.0"), "darwin", "arm64", nil)
+	assert.ErrorContains(t, err, "resource plugin aws-1.0.0 for darwin-arm64 not found in plugin mirror")
+
+	other, err := newMirrorSource(dir, "gcp", ResourcePlugin)
+	require.NoError(t, err)
+	_, err = other.GetLatestVersion(nil)
+	assert.ErrorContains(t, err, "resource plugin gcp not found in plugin mirror")
+}
+
+func TestMirrorSourceChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestPluginMirror(t, map[string]string{"1.0.0": "one"})
+	file := PluginMirrorFile(ResourcePlugin, "aws", semver.MustParse("1.0.0"), "linux", "amd64")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, filepath.FromSlash(file)), []byte("tampered"), 0o600))
+
+	source, err := newMirrorSource(dir, "aws", ResourcePlugin)
+	require.NoError(t, err)
+	r, _, err := source.Download(semver.MustParse("1.0.0"), "linux", "amd64", nil)
+	require.NoError(t, err)
+	defer r.Close()
+	_, err = io.ReadAll(r)
+	var checksumErr *checksumError
+	assert.ErrorAs(t, err, &checksumErr)
+}
+
+func TestReadPluginMirrorIndexInvalid(t *testing.T) {
+	t.Parallel()
+
+	checksum := strings.Repeat("00", sha256.Size)
+	tests := []struct {
+		name     string
+		entry    string
+		expected string
+	}{
+		{
+			name:     "version",
+			entry:    `{"kind":"resource","name":"aws","version":"nope","sha256":"` + checksum + `","file":"a.tar.gz"}`,
+			expected: `invalid version "nope" for resource plugin aws`,
+		},
+		{
+			name:     "checksum",
+			entry:    `{"kind":"resource","name":"aws","version":"1.0.0","sha256":"00","file":"a.tar.gz"}`,
+			expected: "invalid checksum for resource plugin aws-1.0.0",
+		},
+		{
+			name:     "file",
+			entry:    `{"kind":"resource","name":"aws","version":"1.0.0","sha256":"` + checksum + `","file":"../a.tar.gz"}`,
+			expected: `invalid file "../a.tar.gz" for resource plugin aws-1.0.0`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadPluginMirrorIndex(strings.NewReader(`{"version":1,"plugins":[` + tt.entry + `]}`))
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+//nolint:paralleltest // mutates the global plugin mirror configuration
+func TestGetPluginMirror(t *testing.T) {
+	t.Setenv("PULUMI_PLUGIN_MIRROR", "")
+	t.Cleanup(func() { SetProjectPluginMirror("", "") })
+
+	assert.Equal(t, "", GetPluginMirror())
+
+	SetProjectPluginMirror("mirror", filepath.Join("path", "to", "project"))
+	assert.Equal(t, filepath.Join("path", "to", "project", "mirror"), GetPluginMirror())
+
+	SetProjectPluginMirror("s3://bucket/mirror", "project")
+	assert.Equal(t, "s3://bucket/mirror", GetPluginMirror())
+
+	// The environment takes precedence over the project.
+	t.Setenv("PULUMI_PLUGIN_MIRROR", "file:///mirror")
+	assert.Equal(t, "file:///mirror", GetPluginMirror())
+
+	source, err := PluginSpec{Kind: ResourcePlugin, Name: "aws", PluginDownloadURL: "github://api.github.com/foo"}.
+		GetSource()
+	require.NoError(t, err)
+	assert.IsType(t, &mirrorSource{}, source)
+}
@@ -799,7 +799,7 @@ type PluginInfo struct {
 	InstallTime  time.Time       // the time the plugin was installed.
 	LastUsedTime time.Time       // the last time the plugin was used.
 	SchemaPath   string          // if set, used as the path for loading and caching the schema
-	SchemaTime   time.Time       // if set and newer than the file at SchemaPath, used to invalidate a cached schema
+	SchemaTime   time.Time       // if set, cached schemas older than this, including the file at SchemaPath, are stale
 }
 
 // Spec returns the PluginSpec for this PluginInfo
@@ -829,6 +829,51 @@ func (info *PluginInfo) Delete() error {
 	return nil
 }
 
+// DeleteWithLock removes the plugin from the cache like Delete, but first acquires the plugin's install lock so that
+// a plugin that is concurrently being installed is never removed from under the installer. The lock file itself is
+// left in place, as other processes may be waiting on it and removing it would let two of them hold the lock at once.
+func (info *PluginInfo) DeleteWithLock() error {
+	unlock, err := info.Spec().installLock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	dir := info.Path
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	contract.IgnoreError(os.Remove(dir + ".partial"))
+	return nil
+}
+
+// pluginLastUsedFile is the name of the file, inside an installed plugin's directory, whose modification time records
+// when the plugin was last loaded. File access times can't be relied on for this, as many file systems don't update
+// them.
+const pluginLastUsedFile = ".pulumi-last-used"
+
+// RecordPluginUse records that the plugin installed in the given directory has just been loaded. Plugins outside of
+// the plugin cache, such as ambient or project plugins, are ignored.
+func RecordPluginUse(dir string) error {
+	pluginDir, err := GetPluginDir()
+	if err != nil {
+		return err
+	}
+	if filepath.Dir(filepath.Clean(dir)) != filepath.Clean(pluginDir) {
+		return nil
+	}
+
+	path := filepath.Join(dir, pluginLastUsedFile)
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		return os.WriteFile(path, nil, 0o600)
+	}
+	return nil
+}
+
 // SetFileMetadata adds extra metadata from the given file, representing this plugin's directory.
 func (info *PluginInfo) SetFileMetadata(path string) error {
 	// Get the file info.
@@ -855,6 +900,9 @@ func (info *PluginInfo) SetFileMetadata(path string) error {
 	}
 
 	info.LastUsedTime = tinfo.AccessTime()
+	if used, err := os.Stat(filepath.Join(path, pluginLastUsedFile)); err == nil {
+		info.LastUsedTime = used.ModTime()
+	}
 
 	if info.Kind == ResourcePlugin {
 		var v string
@@ -869,7 +917,17 @@ func (info *PluginInfo) SetFileMetadata(path string) error {
 }
 
 func (spec PluginSpec) GetSource() (PluginSource, error) {
+	return spec.getSource(true /*useMirror*/)
+}
+
+func (spec PluginSpec) getSource(useMirror bool) (PluginSource, error) {
 	baseSource, err := func() (PluginSource, error) {
+		// If a plugin mirror is configured, every plugin is downloaded from it, regardless of where it would
+		// otherwise be downloaded from.
+		if mirror := GetPluginMirror(); useMirror && mirror != "" {
+			return newMirrorSource(mirror, spec.Name, spec.Kind)
+		}
+
 		// The plugin has a set URL use that.
 		if spec.PluginDownloadURL != "" {
 			// Support schematised URLS if the URL has a "schema" part we recognize
@@ -936,12 +994,28 @@ func (spec PluginSpec) Download() (io.ReadCloser, int64, error) {
 		return nil, -1, fmt.Errorf("unsupported plugin architecture: %s", runtime.GOARCH)
 	}
 
+	return spec.DownloadForPlatform(opSy, arch)
+}
+
+// DownloadForPlatform fetches an io.ReadCloser for this plugin built for the given OS and architecture, and also
+// returns the size of the response (if known).
+func (spec PluginSpec) DownloadForPlatform(opSy, arch string) (io.ReadCloser, int64, error) {
+	return spec.downloadForPlatform(opSy, arch, true /*useMirror*/)
+}
+
+// DownloadFromOrigin is like DownloadForPlatform, but ignores any configured plugin mirror and so always fetches the
+// plugin from where it is published. This is used to populate plugin mirrors.
+func (spec PluginSpec) DownloadFromOrigin(opSy, arch string) (io.ReadCloser, int64, error) {
+	return spec.downloadForPlatform(opSy, arch, false /*useMirror*/)
+}
+
+func (spec PluginSpec) downloadForPlatform(opSy, arch string, useMirror bool) (io.ReadCloser, int64, error) {
 	// The plugin version is necessary for the endpoint. If it's not present, return an error.
 	if spec.Version == nil {
 		return nil, -1, fmt.Errorf("unknown version for plugin %s", spec.Name)
 	}
 
-	source, err := spec.GetSource()
+	source, err := spec.getSource(useMirror)
 	if err != nil {
 		return nil, -1, err
 	}
@@ -1545,10 +1619,22 @@ func HasPluginGTE(spec PluginSpec) (bool, error) {
 		return true, nil
 	}
 
+	match, err := GetPluginGTE(spec)
+	return match != nil, err
+}
+
+// GetPluginGTE returns the installed plugin that HasPluginGTE accepts for the given plugin, with the version that was
+// resolved, or nil if there is none.
+func GetPluginGTE(spec PluginSpec) (*PluginSpec, error) {
+	// If an exact match, return it right away.
+	if spec.Version != nil && HasPlugin(spec) {
+		return &spec, nil
+	}
+
 	// Otherwise, load up the list of plugins and find one with the same name/type and >= version.
 	plugs, err := GetPlugins()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	// If we're not doing the legacy plugin behavior and we've been asked for a specific version, do the same plugin
@@ -1561,7 +1647,17 @@ func HasPluginGTE(spec PluginSpec) (bool, error) {
 	} else {
 		match = LegacySelectCompatiblePlugin(plugs, spec.Kind, spec.Name, spec.Version)
 	}
-	return match != nil, nil
+	if match == nil {
+		return nil, nil
+	}
+
+	resolved := spec
+	if match.Version != nil && (spec.Version == nil || !match.Version.EQ(*spec.Version)) {
+		// Checksums are only known for the version that was asked for.
+		resolved.Version = match.Version
+		resolved.Checksums = nil
+	}
+	return &resolved, nil
 }
 
 // GetPolicyDir returns the directory in which an organization's Policy Packs on the current machine are managed.
@@ -1842,11 +1938,17 @@ func getPluginInfoAndPath(
 		pluginPath = ambientPath
 	}
 	if pluginPath != "" {
-		return &PluginInfo{
+		info := &PluginInfo{
 			Kind: kind,
 			Name: name,
 			Path: filepath.Dir(pluginPath),
-		}, pluginPath, nil
+		}
+		// Ambient plugins are often rebuilt during development, so their cached schemas are invalidated when they
+		// change.
+		if stat, err := os.Stat(pluginPath); err == nil {
+			info.SchemaTime = stat.ModTime()
+		}
+		return info, pluginPath, nil
 	}
 
 	// Wasn't ambient, and wasn't bundled, so now check the plugin cache.
@@ -314,3 +314,32 @@ func TestGetPluginsSkipsPartial(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(plugins))
 }
+
+//nolint:paralleltest // changes the global PULUMI_HOME
+func TestGetPluginGTE(t *testing.T) {
+	t.Setenv("PULUMI_HOME", t.TempDir())
+
+	for _, version := range []string{"0.1.0", "0.2.0"} {
+		v := semver.MustParse(version)
+		plugin := PluginSpec{Name: "test", Kind: ResourcePlugin, Version: &v}
+		require.NoError(t, plugin.Install(prepareTestPluginTGZ(t, nil), false))
+	}
+
+	// An exact match resolves to itself.
+	v1 := semver.MustParse("0.1.0")
+	exact := PluginSpec{Name: "test", Kind: ResourcePlugin, Version: &v1, Checksums: map[string][]byte{"linux": {1}}}
+	match, err := GetPluginGTE(exact)
+	require.NoError(t, err)
+	assert.Equal(t, &exact, match)
+
+	// A plugin without a version resolves to the newest installed version.
+	match, err = GetPluginGTE(PluginSpec{Name: "test", Kind: ResourcePlugin})
+	require.NoError(t, err)
+	require.NotNil(t, match)
+	assert.Equal(t, "0.2.0", match.Version.String())
+
+	v3 := semver.MustParse("0.3.0")
+	match, err = GetPluginGTE(PluginSpec{Name: "test", Kind: ResourcePlugin, Version: &v3})
+	require.NoError(t, err)
+	assert.Nil(t, match)
+}
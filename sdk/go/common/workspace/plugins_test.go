@@ -1307,3 +1307,39 @@ func TestSymlinkPathPluginsDoNotWarn(t *testing.T) {
 	assert.Equal(t, ambientPath, path)
 	assert.Empty(t, stderr.String())
 }
+
+//nolint:paralleltest // changes the global PULUMI_HOME
+func TestRecordPluginUse(t *testing.T) {
+	t.Setenv("PULUMI_HOME", t.TempDir())
+
+	v1 := semver.MustParse("1.0.0")
+	spec := PluginSpec{Kind: ResourcePlugin, Name: "aws", Version: &v1}
+	dir, err := spec.DirPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+
+	// Plugins outside of the plugin cache are ignored.
+	outside := t.TempDir()
+	require.NoError(t, RecordPluginUse(outside))
+	assert.NoFileExists(t, filepath.Join(outside, pluginLastUsedFile))
+
+	require.NoError(t, RecordPluginUse(dir))
+	used := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, pluginLastUsedFile), used, used))
+
+	plugins, err := GetPluginsWithMetadata()
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	assert.True(t, plugins[0].LastUsedTime.Equal(used), "expected %v, got %v", used, plugins[0].LastUsedTime)
+
+	// Using the plugin again moves the last used time forward.
+	require.NoError(t, RecordPluginUse(dir))
+	plugins, err = GetPluginsWithMetadata()
+	require.NoError(t, err)
+	assert.True(t, plugins[0].LastUsedTime.After(used))
+
+	// Deleting the plugin under its install lock leaves the lock file in place.
+	require.NoError(t, plugins[0].DeleteWithLock())
+	assert.NoDirExists(t, dir)
+	assert.FileExists(t, dir+".lock")
+}
@@ -110,6 +110,18 @@ type ProjectOptions struct {
 	Refresh string `json:"refresh,omitempty" yaml:"refresh,omitempty"`
 }
 
+// ProjectReplacementGuard lists the resources whose replacement or deletion during an update must be explicitly
+// allowed, either with --allow-replace or by confirming it interactively.
+type ProjectReplacementGuard struct {
+	// Types are the resource types whose replacement or deletion is guarded.
+	Types []string `json:"types,omitempty" yaml:"types,omitempty"`
+	// URNs are the URNs, or URN globs, of the resources whose replacement or deletion is guarded.
+	URNs []string `json:"urns,omitempty" yaml:"urns,omitempty"`
+	// Tags guard the resources whose `tags` input carries one of the given keys. A non-empty value must also match
+	// the value of the resource's tag; an empty value guards the resource whatever the value of its tag.
+	Tags map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
 type PluginOptions struct {
 	Name    string `json:"name" yaml:"name"`
 	Version string `json:"version,omitempty" yaml:"version,omitempty"`
@@ -120,6 +132,70 @@ type Plugins struct {
 	Providers []PluginOptions `json:"providers,omitempty" yaml:"providers,omitempty"`
 	Languages []PluginOptions `json:"languages,omitempty" yaml:"languages,omitempty"`
 	Analyzers []PluginOptions `json:"analyzers,omitempty" yaml:"analyzers,omitempty"`
+	// Mirror is the directory or bucket URL of a plugin mirror to download all plugins from.
+	Mirror string `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+	// Limits are the resource limits applied to plugin processes.
+	Limits []PluginLimits `json:"limits,omitempty" yaml:"limits,omitempty"`
+}
+
+// PluginLimits are resource limits applied to plugin processes launched by Pulumi. Memory, CPU time and open file
+// limits are only enforced on Linux.
+type PluginLimits struct {
+	// Name restricts these limits to the plugin with the given name. If empty, the limits apply to every plugin that
+	// doesn't have limits of its own.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Kind restricts these limits to plugins of the given kind.
+	Kind PluginKind `json:"kind,omitempty" yaml:"kind,omitempty"`
+	// Memory is the maximum amount of memory the plugin may allocate, e.g. "2GiB".
+	Memory string `json:"memory,omitempty" yaml:"memory,omitempty"`
+	// CPUTime is the maximum amount of CPU time the plugin may use, e.g. "30m".
+	CPUTime string `json:"cpuTime,omitempty" yaml:"cpuTime,omitempty"`
+	// OpenFiles is the maximum number of files the plugin may have open at once.
+	OpenFiles int `json:"openFiles,omitempty" yaml:"openFiles,omitempty"`
+	// Env is the list of environment variables passed to the plugin. Entries ending in "*" match any variable with
+	// that prefix. The variables Pulumi configures the plugin with, such as PULUMI_CONFIG, are always passed. If empty,
+	// the plugin inherits every variable.
+	Env []string `json:"env,omitempty" yaml:"env,omitempty"`
+	// WorkingDirectory is the directory the plugin is started in, relative to the project directory.
+	WorkingDirectory string `json:"workingDirectory,omitempty" yaml:"workingDirectory,omitempty"`
+}
+
+// Override returns these limits with every limit that is set in the given limits replaced by it.
+func (l PluginLimits) Override(o PluginLimits) PluginLimits {
+	if o.Memory != "" {
+		l.Memory = o.Memory
+	}
+	if o.CPUTime != "" {
+		l.CPUTime = o.CPUTime
+	}
+	if o.OpenFiles != 0 {
+		l.OpenFiles = o.OpenFiles
+	}
+	if len(o.Env) != 0 {
+		l.Env = o.Env
+	}
+	if o.WorkingDirectory != "" {
+		l.WorkingDirectory = o.WorkingDirectory
+	}
+	return l
+}
+
+// FindPluginLimits returns the limits that apply to the given plugin, or nil if it is not limited. Limits for the
+// plugin's name take precedence over limits that apply to all plugins.
+func FindPluginLimits(limits []PluginLimits, kind PluginKind, name string) *PluginLimits {
+	var fallback *PluginLimits
+	for i, l := range limits {
+		if l.Kind != "" && l.Kind != kind {
+			continue
+		}
+		if l.Name == name {
+			return &limits[i]
+		}
+		if l.Name == "" && fallback == nil {
+			fallback = &limits[i]
+		}
+	}
+	return fallback
 }
 
 type ProjectConfigItemsType struct {
@@ -193,6 +269,9 @@ type Project struct {
 
 	Plugins *Plugins `json:"plugins,omitempty" yaml:"plugins,omitempty"`
 
+	// ReplacementGuard is an optional set of resources whose replacement or deletion must be explicitly allowed.
+	ReplacementGuard *ProjectReplacementGuard `json:"replacementGuard,omitempty" yaml:"replacementGuard,omitempty"`
+
 	// Handle additional keys, albeit in a way that will remove comments and trivia.
 	AdditionalKeys map[string]interface{} `yaml:",inline"`
 
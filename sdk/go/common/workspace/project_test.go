@@ -249,6 +249,65 @@ func loadProjectStackFromJSONText(t *testing.T, project *Project, content string
 	return LoadProjectStack(project, path)
 }
 
+func TestProjectLoadsPluginLimits(t *testing.T) {
+	t.Parallel()
+	projectContent := `
+name: test
+runtime: go
+plugins:
+  limits:
+    - memory: 2GiB
+      env: [PATH, AWS_*]
+    - name: aws
+      kind: resource
+      cpuTime: 30m
+      openFiles: 1024
+      workingDirectory: plugins
+`
+
+	project, err := loadProjectFromText(t, projectContent)
+	require.NoError(t, err)
+	require.NotNil(t, project.Plugins)
+	limits := project.Plugins.Limits
+	assert.Equal(t, []PluginLimits{
+		{Memory: "2GiB", Env: []string{"PATH", "AWS_*"}},
+		{Name: "aws", Kind: ResourcePlugin, CPUTime: "30m", OpenFiles: 1024, WorkingDirectory: "plugins"},
+	}, limits)
+
+	assert.Equal(t, &limits[1], FindPluginLimits(limits, ResourcePlugin, "aws"))
+	assert.Equal(t, &limits[0], FindPluginLimits(limits, ResourcePlugin, "gcp"))
+	assert.Equal(t, &limits[0], FindPluginLimits(limits, LanguagePlugin, "aws"))
+	assert.Nil(t, FindPluginLimits(limits[1:], ResourcePlugin, "gcp"))
+
+	_, err = loadProjectFromText(t, projectContent+"      disk: 1GB\n")
+	assert.ErrorContains(t, err, "disk")
+}
+
+func TestProjectLoadsReplacementGuard(t *testing.T) {
+	t.Parallel()
+	projectContent := `
+name: test
+runtime: go
+replacementGuard:
+  types: ["aws:rds/instance:Instance"]
+  urns: ["urn:pulumi:prod::test::**"]
+  tags:
+    protected: ""
+    environment: prod
+`
+
+	project, err := loadProjectFromText(t, projectContent)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectReplacementGuard{
+		Types: []string{"aws:rds/instance:Instance"},
+		URNs:  []string{"urn:pulumi:prod::test::**"},
+		Tags:  map[string]string{"protected": "", "environment": "prod"},
+	}, project.ReplacementGuard)
+
+	_, err = loadProjectFromText(t, projectContent+"  names: [db]\n")
+	assert.ErrorContains(t, err, "names")
+}
+
 func TestProjectLoadsConfigSchemas(t *testing.T) {
 	t.Parallel()
 	projectContent := `
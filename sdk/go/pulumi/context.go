@@ -43,6 +43,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -60,16 +61,19 @@ type contextState struct {
 	engineConn    *grpc.ClientConn
 	callbacksLock sync.Mutex
 	callbacks     *callbackServer
-
-	keepResources       bool       // true if resources should be marshaled as strongly-typed references.
-	keepOutputValues    bool       // true if outputs should be marshaled as strongly-type output values.
-	supportsDeletedWith bool       // true if deletedWith supported by pulumi
-	supportsAliasSpecs  bool       // true if full alias specification is supported by pulumi
-	supportsTransforms  bool       // true if remote transforms are supported by pulumi
-	rpcs                int        // the number of outstanding RPC requests.
-	rpcsDone            *sync.Cond // an event signaling completion of RPCs.
-	rpcsLock            sync.Mutex // a lock protecting the RPC count and event.
-	rpcError            error      // the first error (if any) encountered during an RPC.
+	hooks         bool // true if the program has registered resource hooks; protected by callbacksLock.
+
+	keepResources         bool       // true if resources should be marshaled as strongly-typed references.
+	keepOutputValues      bool       // true if outputs should be marshaled as strongly-type output values.
+	supportsDeletedWith   bool       // true if deletedWith supported by pulumi
+	supportsAliasSpecs    bool       // true if full alias specification is supported by pulumi
+	supportsTransforms    bool       // true if remote transforms are supported by pulumi
+	supportsResourceHooks bool       // true if resource hooks are supported by pulumi
+	supportsDeleteDeps    bool       // true if delete dependencies are supported by pulumi
+	rpcs                  int        // the number of outstanding RPC requests.
+	rpcsDone              *sync.Cond // an event signaling completion of RPCs.
+	rpcsLock              sync.Mutex // a lock protecting the RPC count and event.
+	rpcError              error      // the first error (if any) encountered during an RPC.
 
 	join workGroup // the waitgroup for non-RPC async work associated with this context
 }
@@ -162,18 +166,30 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 		return nil, err
 	}
 
+	supportsResourceHooks, err := supportsFeature("resourceHooks")
+	if err != nil {
+		return nil, err
+	}
+
+	supportsDeleteDeps, err := supportsFeature("deleteDependencies")
+	if err != nil {
+		return nil, err
+	}
+
 	contextState := &contextState{
-		info:                info,
-		exports:             make(map[string]Input),
-		monitorConn:         monitorConn,
-		monitor:             monitor,
-		engineConn:          engineConn,
-		engine:              engine,
-		keepResources:       keepResources,
-		keepOutputValues:    keepOutputValues,
-		supportsDeletedWith: supportsDeletedWith,
-		supportsAliasSpecs:  supportsAliasSpecs,
-		supportsTransforms:  supportsTransforms,
+		info:                  info,
+		exports:               make(map[string]Input),
+		monitorConn:           monitorConn,
+		monitor:               monitor,
+		engineConn:            engineConn,
+		engine:                engine,
+		keepResources:         keepResources,
+		keepOutputValues:      keepOutputValues,
+		supportsDeletedWith:   supportsDeletedWith,
+		supportsAliasSpecs:    supportsAliasSpecs,
+		supportsTransforms:    supportsTransforms,
+		supportsResourceHooks: supportsResourceHooks,
+		supportsDeleteDeps:    supportsDeleteDeps,
 	}
 	contextState.rpcsDone = sync.NewCond(&contextState.rpcsLock)
 	context := &Context{
@@ -225,6 +241,23 @@ func (ctx *Context) Close() error {
 	return nil
 }
 
+// waitForShutdown signals the engine that the program has finished registering resources. If the program has
+// registered resource hooks, it waits until the deployment has finished, so that the engine can still run the hooks of
+// resources that are deleted after the program has finished.
+func (ctx *Context) waitForShutdown() error {
+	ctx.state.callbacksLock.Lock()
+	hooks := ctx.state.hooks
+	ctx.state.callbacksLock.Unlock()
+	if !hooks {
+		return nil
+	}
+
+	if _, err := ctx.state.monitor.SignalAndWaitForShutdown(ctx.ctx, &emptypb.Empty{}); err != nil {
+		return fmt.Errorf("waiting for the deployment to finish: %w", err)
+	}
+	return nil
+}
+
 // wait waits for all asynchronous work associated with this context to drain. RPCs may not be queued once wait
 // returns.
 func (ctx *Context) wait() error {
@@ -494,6 +527,11 @@ func (ctx *Context) registerTransform(t XResourceTransform) (*pulumirpc.Callback
 		return rpcRes, nil
 	}
 
+	return ctx.registerCallback(callback)
+}
+
+// registerCallback starts up a callback server if not already running and registers the given function with it.
+func (ctx *Context) registerCallback(callback callbackFunction) (*pulumirpc.Callback, error) {
 	err := func() error {
 		ctx.state.callbacksLock.Lock()
 		defer ctx.state.callbacksLock.Unlock()
@@ -518,6 +556,80 @@ func (ctx *Context) registerTransform(t XResourceTransform) (*pulumirpc.Callback
 	return cb, nil
 }
 
+// registerResourceHook starts up a callback server if not already running and registers the given resource hook.
+func (ctx *Context) registerResourceHook(h XResourceHook) (*pulumirpc.ResourceHook, error) {
+	if !ctx.state.supportsResourceHooks {
+		return nil, fmt.Errorf("the Pulumi CLI does not support resource hooks. Please update the Pulumi CLI")
+	}
+	if h.Callback == nil {
+		return nil, fmt.Errorf("resource hook %q has no callback", h.Name)
+	}
+
+	unmarshal := func(s *structpb.Struct) (Map, error) {
+		if s == nil {
+			return nil, nil
+		}
+		properties, err := plugin.UnmarshalProperties(s, plugin.MarshalOptions{
+			KeepUnknowns:  true,
+			KeepSecrets:   true,
+			KeepResources: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return unmarshalPropertyMap(ctx, properties)
+	}
+
+	// Wrap the hook in a callback function.
+	callback := func(innerCtx context.Context, req []byte) (proto.Message, error) {
+		var rpcReq pulumirpc.ResourceHookRequest
+		if err := proto.Unmarshal(req, &rpcReq); err != nil {
+			return nil, fmt.Errorf("unmarshaling request: %w", err)
+		}
+
+		args := &XResourceHookArgs{
+			URN:   URN(rpcReq.Urn),
+			ID:    ID(rpcReq.Id),
+			Event: rpcReq.Event,
+		}
+		var err error
+		if args.OldInputs, err = unmarshal(rpcReq.OldInputs); err != nil {
+			return nil, fmt.Errorf("unmarshaling old inputs: %w", err)
+		}
+		if args.OldOutputs, err = unmarshal(rpcReq.OldOutputs); err != nil {
+			return nil, fmt.Errorf("unmarshaling old outputs: %w", err)
+		}
+		if args.NewInputs, err = unmarshal(rpcReq.NewInputs); err != nil {
+			return nil, fmt.Errorf("unmarshaling new inputs: %w", err)
+		}
+		if args.NewOutputs, err = unmarshal(rpcReq.NewOutputs); err != nil {
+			return nil, fmt.Errorf("unmarshaling new outputs: %w", err)
+		}
+
+		rpcRes := &pulumirpc.ResourceHookResponse{}
+		if err := h.Callback(innerCtx, args); err != nil {
+			rpcRes.Error = err.Error()
+		}
+		return rpcRes, nil
+	}
+
+	cb, err := ctx.registerCallback(callback)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.state.callbacksLock.Lock()
+	ctx.state.hooks = true
+	ctx.state.callbacksLock.Unlock()
+
+	return &pulumirpc.ResourceHook{
+		Name:          h.Name,
+		Events:        h.Events,
+		Callback:      cb,
+		WarnOnFailure: h.WarnOnFailure,
+	}, nil
+}
+
 // Invoke will invoke a provider's function, identified by its token tok. This function call is synchronous.
 //
 // args and result must be pointers to struct values fields and appropriately tagged and typed for use with Pulumi.
@@ -1120,6 +1232,10 @@ func (ctx *Context) registerResource(
 		}
 	}
 
+	if len(options.DeleteDependsOn) > 0 && !ctx.state.supportsDeleteDeps {
+		return errors.New("the Pulumi CLI does not support the DeleteDependsOn option. Please update the Pulumi CLI")
+	}
+
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err := ctx.beginRPC(); err != nil {
 		return err
@@ -1167,6 +1283,17 @@ func (ctx *Context) registerResource(
 			transforms = append(transforms, cb)
 		}
 
+		// Register the resource hooks
+		hooks := make([]*pulumirpc.ResourceHook, 0, len(options.XHooks))
+		for _, h := range options.XHooks {
+			var hook *pulumirpc.ResourceHook
+			hook, err = ctx.registerResourceHook(h)
+			if err != nil {
+				return
+			}
+			hooks = append(hooks, hook)
+		}
+
 		// Prepare the inputs for an impending operation.
 		inputs, err = ctx.prepareResourceInputs(resource, props, t, options, resState, remote, custom)
 		if err != nil {
@@ -1228,6 +1355,8 @@ func (ctx *Context) registerResource(
 				DeletedWith:             inputs.deletedWith,
 				SourcePosition:          sourcePosition,
 				Transforms:              transforms,
+				Hooks:                   hooks,
+				DeleteDependencies:      inputs.deleteDependencies,
 			})
 			if err != nil {
 				logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -1629,6 +1758,7 @@ type resourceInputs struct {
 	replaceOnChanges        []string
 	retainOnDelete          bool
 	deletedWith             string
+	deleteDependencies      []string
 }
 
 func (ctx *Context) resolveAliasParent(alias Alias, spec *pulumirpc.Alias_Spec) error {
@@ -1874,6 +2004,15 @@ func (ctx *Context) prepareResourceInputs(res Resource, props Input, t string, o
 		deletedWithURN = urn
 	}
 
+	deleteDependencies := make([]string, 0, len(opts.DeleteDependsOn))
+	for _, r := range opts.DeleteDependsOn {
+		urn, _, _, err := r.URN().awaitURN(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for DeleteDependsOn URN to resolve: %w", err)
+		}
+		deleteDependencies = append(deleteDependencies, string(urn))
+	}
+
 	return &resourceInputs{
 		parent:                  string(resOpts.parentURN),
 		deps:                    deps,
@@ -1894,6 +2033,7 @@ func (ctx *Context) prepareResourceInputs(res Resource, props Input, t string, o
 		replaceOnChanges:        resOpts.replaceOnChanges,
 		retainOnDelete:          opts.RetainOnDelete,
 		deletedWith:             string(deletedWithURN),
+		deleteDependencies:      deleteDependencies,
 	}, nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
@@ -105,6 +106,11 @@ func (m *mockMonitor) Invoke(ctx context.Context, in *pulumirpc.ResourceInvokeRe
 		return nil, err
 	}
 
+	// Reads of stack reference outputs are only of interest to the engine, so they aren't passed on to the mocks.
+	if in.GetTok() == "pulumi:pulumi:recordStackOutputRead" {
+		return &pulumirpc.InvokeResponse{Return: &structpb.Struct{}}, nil
+	}
+
 	if in.GetTok() == "pulumi:pulumi:getResource" {
 		urn := args["urn"].StringValue()
 		registeredResourceV, ok := m.resources.Load(urn)
@@ -268,6 +274,12 @@ func (m *mockMonitor) RegisterStackTransform(ctx context.Context, in *pulumirpc.
 	panic("not implemented")
 }
 
+func (m *mockMonitor) SignalAndWaitForShutdown(ctx context.Context, in *emptypb.Empty,
+	opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
 type mockEngine struct {
 	logger       *log.Logger
 	rootResource string
@@ -385,6 +385,12 @@ type ResourceOptions struct {
 	// Experimental.
 	XTransforms []XResourceTransform
 
+	// XHooks is a list of actions that the engine runs
+	// before or after operations on the resource.
+	//
+	// Experimental.
+	XHooks []XResourceHook
+
 	// URN is the URN of a previously-registered resource of this type.
 	URN string
 
@@ -405,6 +411,10 @@ type ResourceOptions struct {
 	// DeletedWith holds a container resource that, if deleted,
 	// also deletes this resource.
 	DeletedWith Resource
+
+	// DeleteDependsOn lists resources that must be deleted
+	// after this resource when both are being deleted.
+	DeleteDependsOn []Resource
 }
 
 // NewResourceOptions builds a preview of the effect of the provided options.
@@ -435,11 +445,13 @@ type resourceOptions struct {
 	ReplaceOnChanges        []string
 	Transformations         []ResourceTransformation
 	XTransforms             []XResourceTransform
+	XHooks                  []XResourceHook
 	URN                     string
 	Version                 string
 	PluginDownloadURL       string
 	RetainOnDelete          bool
 	DeletedWith             Resource
+	DeleteDependsOn         []Resource
 }
 
 func resourceOptionsSnapshot(ro *resourceOptions) *ResourceOptions {
@@ -491,11 +503,13 @@ func resourceOptionsSnapshot(ro *resourceOptions) *ResourceOptions {
 		ReplaceOnChanges:        ro.ReplaceOnChanges,
 		Transformations:         ro.Transformations,
 		XTransforms:             ro.XTransforms,
+		XHooks:                  ro.XHooks,
 		URN:                     ro.URN,
 		Version:                 ro.Version,
 		PluginDownloadURL:       ro.PluginDownloadURL,
 		RetainOnDelete:          ro.RetainOnDelete,
 		DeletedWith:             ro.DeletedWith,
+		DeleteDependsOn:         ro.DeleteDependsOn,
 	}
 }
 
@@ -864,6 +878,15 @@ func XTransforms(o []XResourceTransform) ResourceOption {
 	})
 }
 
+// XHooks is an optional list of hooks to run before or after operations on the resource.
+//
+// Experimental.
+func XHooks(o ...XResourceHook) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.XHooks = append(ro.XHooks, o...)
+	})
+}
+
 // URN_ is an optional URN of a previously-registered resource of this type to read from the engine.
 //
 //nolint:revive
@@ -915,3 +938,12 @@ func DeletedWith(r Resource) ResourceOption {
 		ro.DeletedWith = r
 	})
 }
+
+// DeleteDependsOn is an optional list of resources that must be deleted after this resource
+// when both are being deleted, e.g. by a destroy or because they were removed from the program.
+// Unlike DependsOn, this does not affect the order in which resources are created or updated.
+func DeleteDependsOn(o []Resource) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.DeleteDependsOn = append(ro.DeleteDependsOn, o...)
+	})
+}
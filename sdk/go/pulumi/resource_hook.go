@@ -0,0 +1,60 @@
+// Copyright 2024-2024, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import "context"
+
+// XResourceHookArgs is the argument bag passed to a resource hook.
+//
+// Experimental.
+type XResourceHookArgs struct {
+	// The URN of the resource the hook is running for.
+	URN URN
+	// The ID of the resource, if it has one.
+	ID ID
+	// The event that triggered the hook, e.g. "before_create".
+	Event string
+	// The inputs of the resource before the operation, if any.
+	OldInputs Map
+	// The outputs of the resource before the operation, if any.
+	OldOutputs Map
+	// The inputs of the resource after the operation, if any.
+	NewInputs Map
+	// The outputs of the resource after the operation. This is only set for "after" events.
+	NewOutputs Map
+}
+
+// XResourceHookFunc is the callback signature of a resource hook. Returning an error fails the
+// operation the hook is running for, unless the hook is marked to warn on failure.
+//
+// Experimental.
+type XResourceHookFunc func(context.Context, *XResourceHookArgs) error
+
+// XResourceHook is an action that the engine runs before or after operations on a resource.
+//
+// Experimental.
+type XResourceHook struct {
+	// The name of the hook, used in the display and in error messages.
+	Name string
+	// The events that the hook runs on: one or more of "before_create", "after_create", "before_update",
+	// "after_update", "before_delete", "after_delete", "before_replace" and "after_replace". Delete hooks run when
+	// the resource is deleted to be replaced; resources that the program no longer registers, or that are destroyed,
+	// are deleted without running their hooks.
+	Events []string
+	// If true, a failure of the hook is reported as a warning rather than failing the operation.
+	WarnOnFailure bool
+	// The function to run.
+	Callback XResourceHookFunc
+}
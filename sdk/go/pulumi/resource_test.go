@@ -534,6 +534,30 @@ func assertTransformations(t *testing.T, t1 []ResourceTransformation, t2 []Resou
 	}
 }
 
+func TestResourceOptionMergingXHooks(t *testing.T) {
+	t.Parallel()
+
+	// Hook arrays are always appended together
+	h1 := XResourceHook{Name: "h1", Events: []string{"before_create"}}
+	h2 := XResourceHook{Name: "h2", Events: []string{"after_create"}}
+	h3 := XResourceHook{Name: "h3", Events: []string{"before_delete"}}
+	names := func(hooks []XResourceHook) []string {
+		return slice.Map(hooks, func(h XResourceHook) string { return h.Name })
+	}
+
+	// two singleton options
+	opts := merge(XHooks(h1), XHooks(h2))
+	assert.Equal(t, []string{"h1", "h2"}, names(opts.XHooks))
+
+	// empty option
+	opts = merge(XHooks(), XHooks(h2))
+	assert.Equal(t, []string{"h2"}, names(opts.XHooks))
+
+	// multivalue arrays
+	opts = merge(XHooks(h1, h2), XHooks(h2, h3))
+	assert.Equal(t, []string{"h1", "h2", "h2", "h3"}, names(opts.XHooks))
+}
+
 func TestResourceOptionMergingReplaceOnChanges(t *testing.T) {
 	t.Parallel()
 
@@ -996,6 +1020,19 @@ func TestNewResourceOptions(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "DeleteDependsOn",
+			give: Composite(
+				DeleteDependsOn([]Resource{&testRes{foo: "foo"}}),
+				DeleteDependsOn([]Resource{&testRes{foo: "bar"}}),
+			),
+			want: ResourceOptions{
+				DeleteDependsOn: []Resource{
+					&testRes{foo: "foo"},
+					&testRes{foo: "bar"},
+				},
+			},
+		},
 		{
 			desc: "DependsOnInputs",
 			give: DependsOnInputs(
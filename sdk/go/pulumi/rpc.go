@@ -226,15 +226,24 @@ func marshalInputImpl(v interface{},
 
 			// If the element type of the input is not identical to the type of the destination and the destination is
 			// not the any type (i.e. interface{}), attempt to convert the input to an appropriately-typed output.
+			//
+			// Outputs whose elements are themselves inputs and cannot otherwise be marshaled, such as the
+			// pulumix.Output[*FooArgs] values produced by applying defaults to the arguments of generic SDKs, are
+			// awaited and their elements marshaled as inputs instead.
+			_, isOutput := input.(Output)
+			elementIsInput := false
 			if valueType != destType && destType != anyType {
 				if newOutput, ok := internal.CallToOutputMethod(context.TODO(), reflect.ValueOf(input), destType); ok {
 					// We were able to convert the input. Use the result as the new input value.
 					input, valueType = newOutput, destType
 				} else if !valueType.AssignableTo(destType) {
-					err := fmt.Errorf(
-						"cannot marshal an input of type %T with element type %v as a value of type %v",
-						input, valueType, destType)
-					return resource.PropertyValue{}, nil, err
+					if !isOutput || !valueType.Implements(inputType) {
+						err := fmt.Errorf(
+							"cannot marshal an input of type %T with element type %v as a value of type %v",
+							input, valueType, destType)
+						return resource.PropertyValue{}, nil, err
+					}
+					elementIsInput = true
 				}
 			}
 
@@ -253,10 +262,14 @@ func marshalInputImpl(v interface{},
 				// Get the underlying value, if known.
 				var element resource.PropertyValue
 				if known {
-					element, _, err = marshalInputImpl(ov, destType, await, true /*skipInputCheck*/)
+					var elementDeps []Resource
+					element, elementDeps, err = marshalInputImpl(ov, destType, await, !elementIsInput /*skipInputCheck*/)
 					if err != nil {
 						return resource.PropertyValue{}, nil, err
 					}
+					if elementIsInput {
+						outputDeps = append(outputDeps, elementDeps...)
+					}
 
 					// If it's known, not a secret, and has no deps, return the value itself.
 					if !secret && len(outputDeps) == 0 {
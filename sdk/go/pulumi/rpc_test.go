@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/blang/semver"
@@ -685,6 +686,135 @@ func TestMapInputMarshalling(t *testing.T) {
 	}
 }
 
+type nestedPlain struct {
+	Value string `pulumi:"value"`
+}
+
+type nestedArgs struct {
+	Value StringInput `pulumi:"value"`
+}
+
+func (nestedArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*nestedPlain)(nil)).Elem()
+}
+
+// nestedArgsPtrOutput is an output whose element is itself an input, like the pulumix.Output[*FooArgs] values that
+// generic SDKs produce when they apply defaults to their arguments.
+type nestedArgsPtrOutput struct{ *OutputState }
+
+func (nestedArgsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**nestedArgs)(nil)).Elem()
+}
+
+type outerPlain struct {
+	Nested *nestedPlain `pulumi:"nested"`
+}
+
+type outerArgs struct {
+	Nested Input `pulumi:"nested"`
+}
+
+func (outerArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*outerPlain)(nil)).Elem()
+}
+
+func TestOutputOfInputMarshalling(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(context.Background(), RunInfo{})
+	require.NoError(t, err)
+	outerURN := resource.NewURN("stack", "project", "", "test:index:custom", "outer")
+	innerURN := resource.NewURN("stack", "project", "", "test:index:custom", "inner")
+
+	// newArgsOutput returns an output of nested args that depends on the outer resource.
+	newArgsOutput := func(args *nestedArgs, known, secret bool) Input {
+		outer := newSimpleCustomResource(ctx, URN(outerURN), "id")
+		out := internal.NewOutput(nil, reflect.TypeOf(nestedArgsPtrOutput{}), outer)
+		internal.ResolveOutput(out, args, known, secret, resourcesToInternal(nil))
+		return out
+	}
+	// newInnerOutput returns a string output that depends on the inner resource.
+	newInnerOutput := func(value string) StringInput {
+		inner := newSimpleCustomResource(ctx, URN(innerURN), "id")
+		out := internal.NewOutput(nil, reflect.TypeOf(StringOutput{}), inner).(StringOutput)
+		internal.ResolveOutput(out, value, true, false, resourcesToInternal(nil))
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		nested  Input
+		known   bool
+		secret  bool
+		value   string
+		depUrns []URN
+	}{
+		{
+			name:    "known",
+			nested:  newArgsOutput(&nestedArgs{Value: String("hello")}, true, false),
+			known:   true,
+			value:   "hello",
+			depUrns: []URN{URN(outerURN)},
+		},
+		{
+			name:    "secret",
+			nested:  newArgsOutput(&nestedArgs{Value: String("hello")}, true, true),
+			known:   true,
+			secret:  true,
+			value:   "hello",
+			depUrns: []URN{URN(outerURN)},
+		},
+		{
+			name:    "unknown",
+			nested:  newArgsOutput(nil, false, false),
+			depUrns: []URN{URN(outerURN)},
+		},
+		{
+			// The dependencies of the outputs inside the element are dependencies of the whole value.
+			name:    "nested output",
+			nested:  newArgsOutput(&nestedArgs{Value: newInnerOutput("world")}, true, false),
+			known:   true,
+			value:   "world",
+			depUrns: []URN{URN(innerURN), URN(outerURN)},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, _, depUrns, err := marshalInputs(outerArgs{Nested: tt.nested})
+			require.NoError(t, err)
+			output := resolved["nested"].OutputValue()
+			assert.Equal(t, tt.known, output.Known)
+			assert.Equal(t, tt.secret, output.Secret)
+			if tt.known {
+				value := output.Element.ObjectValue()["value"]
+				if value.IsOutput() {
+					// Outputs inside the element keep their own dependencies.
+					assert.Equal(t, []resource.URN{innerURN}, value.OutputValue().Dependencies)
+					value = value.OutputValue().Element
+				}
+				assert.Equal(t, tt.value, value.StringValue())
+			}
+			sort.Slice(depUrns, func(i, j int) bool { return depUrns[i] < depUrns[j] })
+			assert.Equal(t, tt.depUrns, depUrns)
+		})
+	}
+}
+
+// TestOutputOfNonInputMarshalling checks that outputs whose elements are neither inputs nor of the destination type
+// are still rejected.
+func TestOutputOfNonInputMarshalling(t *testing.T) {
+	t.Parallel()
+
+	out := internal.NewOutput(nil, reflect.TypeOf(IntOutput{}))
+	internal.ResolveOutput(out, 42, true, false, resourcesToInternal(nil))
+
+	_, _, _, err := marshalInputs(outerArgs{Nested: out})
+	assert.ErrorContains(t, err, "cannot marshal an input of type pulumi.IntOutput")
+}
+
 func TestVersionedMap(t *testing.T) {
 	t.Parallel()
 
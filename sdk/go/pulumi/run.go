@@ -133,7 +133,12 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 	}
 
 	// Propagate the error from the body, if any.
-	return result
+	if result != nil {
+		return result
+	}
+
+	// Keep serving the resource hooks that the program registered until the deployment has finished.
+	return ctx.waitForShutdown()
 }
 
 // RunFunc executes the body of a Pulumi program.  It may register resources using the deployment context
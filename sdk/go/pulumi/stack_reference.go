@@ -3,8 +3,10 @@ package pulumi
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 )
 
 // StackReference manages a reference to a Pulumi stack.
@@ -19,17 +21,35 @@ type StackReference struct {
 	// ctx is a reference to the context used to create the stack reference. It must be
 	// valid and non-nil to call `GetOutput`.
 	ctx *Context
+
+	// recorded holds the names of the outputs whose reads have been recorded with the engine.
+	recorded sync.Map
+}
+
+// recordRead records with the engine that the program read the named output of the referenced stack, so that the
+// stack's dependents are known. Reads are only recorded once per output, and failures to record them are ignored, as
+// older engines do not support recording them.
+func (s *StackReference) recordRead(urn URN, name string) {
+	if _, loaded := s.recorded.LoadOrStore(name, true); loaded {
+		return
+	}
+	args := map[string]interface{}{"urn": string(urn), "name": name}
+	var result map[string]interface{}
+	if err := s.ctx.Invoke("pulumi:pulumi:recordStackOutputRead", args, &result); err != nil {
+		logging.V(9).Infof("failed to record read of stack reference output %q: %v", name, err)
+	}
 }
 
 // GetOutput returns a stack output keyed by the given name as an AnyOutput
 // If the given name is not present in the StackReference, Output<nil> is returned.
 func (s *StackReference) GetOutput(name StringInput) AnyOutput {
-	return All(name, s.rawOutputs).
+	return All(name, s.rawOutputs, s.URN()).
 		ApplyT(func(args []interface{}) (interface{}, error) {
-			n, stack := args[0].(string), args[1].(resource.PropertyMap)
+			n, stack, urn := args[0].(string), args[1].(resource.PropertyMap), args[2].(URN)
 			if !stack["outputs"].IsObject() {
 				return Any(nil), fmt.Errorf("failed to convert %T to object", stack)
 			}
+			s.recordRead(urn, n)
 			outs := stack["outputs"].ObjectValue()
 			v, ok := outs[resource.PropertyKey(n)]
 			if !ok {
@@ -107,6 +107,44 @@ func TestStackReference(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStackReferenceRecordsReads(t *testing.T) {
+	t.Parallel()
+
+	var ref *StackReference
+	err := RunErr(func(ctx *Context) error {
+		var err error
+		ref, err = NewStackReference(ctx, "stack", nil)
+		require.NoError(t, err)
+		for _, name := range []string{"foo", "bar", "foo"} {
+			_, _, _, _, err = await(ref.GetOutput(String(name)))
+			require.NoError(t, err)
+		}
+		_, _, _, _, err = await(ref.GetStringOutput(String("baz")))
+		require.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{
+		CallF: func(args MockCallArgs) (resource.PropertyMap, error) {
+			// Recording reads is handled by the mock monitor itself.
+			t.Errorf("unexpected call to %v", args.Token)
+			return nil, nil
+		},
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			return args.Inputs["name"].StringValue(), resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":    "stack",
+				"outputs": map[string]interface{}{"foo": "a", "bar": "b", "baz": "c"},
+			}), nil
+		},
+	}))
+	require.NoError(t, err)
+
+	var recorded []string
+	ref.recorded.Range(func(k, _ any) bool {
+		recorded = append(recorded, k.(string))
+		return true
+	})
+	assert.ElementsMatch(t, []string{"foo", "bar", "baz"}, recorded)
+}
+
 func TestStackReferenceSecrets(t *testing.T) {
 	t.Parallel()
 	var resName string
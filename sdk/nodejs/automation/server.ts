@@ -93,6 +93,7 @@ export class LanguageServer<T> implements grpc.UntypedServiceImplementation {
 
                 try {
                     await stack.runInPulumiStack(this.program);
+                    await settings.waitForShutdown();
                     await settings.disconnect();
                     process.off("uncaughtException", uncaughtHandler);
                     process.off("unhandledRejection", uncaughtHandler);
@@ -257,3 +257,9 @@ func (p *monitorProxy) RegisterStackTransform(
 ) (*emptypb.Empty, error) {
 	return p.target.RegisterStackTransform(ctx, req)
 }
+
+func (p *monitorProxy) SignalAndWaitForShutdown(
+	ctx context.Context, req *emptypb.Empty,
+) (*emptypb.Empty, error) {
+	return p.target.SignalAndWaitForShutdown(ctx, req)
+}
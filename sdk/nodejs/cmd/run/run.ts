@@ -488,6 +488,8 @@ ${defaultMessage}`,
 
     // Construct a `Stack` resource to represent the outputs of the program.
     const stackOutputs = await stack.runInPulumiStack(runProgram);
+    // Keep serving the resource hooks that the program registered until the deployment has finished.
+    await settings.waitForShutdown();
     await settings.disconnect();
     span.end();
     return stackOutputs;
@@ -264,6 +264,28 @@ function deserialize_pulumirpc_InvokeResponse(buffer_arg) {
   return pulumi_provider_pb.InvokeResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ListRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ListRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ListRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListRequest(buffer_arg) {
+  return pulumi_provider_pb.ListRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ListResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ListResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ListResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListResponse(buffer_arg) {
+  return pulumi_provider_pb.ListResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_PluginAttach(arg) {
   if (!(arg instanceof pulumi_plugin_pb.PluginAttach)) {
     throw new Error('Expected argument of type pulumirpc.PluginAttach');
@@ -473,6 +495,19 @@ read: {
     responseSerialize: serialize_pulumirpc_ReadResponse,
     responseDeserialize: deserialize_pulumirpc_ReadResponse,
   },
+  // List enumerates the existing resources of the given type, so that they can be discovered and imported. Listing
+// is optional: providers that do not support it should return UNIMPLEMENTED.
+list: {
+    path: '/pulumirpc.ResourceProvider/List',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ListRequest,
+    responseType: pulumi_provider_pb.ListResponse,
+    requestSerialize: serialize_pulumirpc_ListRequest,
+    requestDeserialize: deserialize_pulumirpc_ListRequest,
+    responseSerialize: serialize_pulumirpc_ListResponse,
+    responseDeserialize: deserialize_pulumirpc_ListResponse,
+  },
   // Update updates an existing resource with new values.
 update: {
     path: '/pulumirpc.ResourceProvider/Update',
@@ -1167,3 +1167,86 @@ export namespace GetMappingsResponse {
         providersList: Array<string>,
     }
 }
+
+export class ListRequest extends jspb.Message { 
+    getType(): string;
+    setType(value: string): ListRequest;
+    getPageToken(): string;
+    setPageToken(value: string): ListRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListRequest): ListRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListRequest;
+    static deserializeBinaryFromReader(message: ListRequest, reader: jspb.BinaryReader): ListRequest;
+}
+
+export namespace ListRequest {
+    export type AsObject = {
+        type: string,
+        pageToken: string,
+    }
+}
+
+export class ListResponse extends jspb.Message { 
+    clearResourcesList(): void;
+    getResourcesList(): Array<ListedResource>;
+    setResourcesList(value: Array<ListedResource>): ListResponse;
+    addResources(value?: ListedResource, index?: number): ListedResource;
+    getNextPageToken(): string;
+    setNextPageToken(value: string): ListResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListResponse): ListResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListResponse;
+    static deserializeBinaryFromReader(message: ListResponse, reader: jspb.BinaryReader): ListResponse;
+}
+
+export namespace ListResponse {
+    export type AsObject = {
+        resourcesList: Array<ListedResource.AsObject>,
+        nextPageToken: string,
+    }
+}
+
+export class ListedResource extends jspb.Message { 
+    getId(): string;
+    setId(value: string): ListedResource;
+    getName(): string;
+    setName(value: string): ListedResource;
+    getParentType(): string;
+    setParentType(value: string): ListedResource;
+    getParentId(): string;
+    setParentId(value: string): ListedResource;
+
+    hasProperties(): boolean;
+    clearProperties(): void;
+    getProperties(): google_protobuf_struct_pb.Struct | undefined;
+    setProperties(value?: google_protobuf_struct_pb.Struct): ListedResource;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListedResource.AsObject;
+    static toObject(includeInstance: boolean, msg: ListedResource): ListedResource.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListedResource, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListedResource;
+    static deserializeBinaryFromReader(message: ListedResource, reader: jspb.BinaryReader): ListedResource;
+}
+
+export namespace ListedResource {
+    export type AsObject = {
+        id: string,
+        name: string,
+        parentType: string,
+        parentId: string,
+        properties?: google_protobuf_struct_pb.Struct.AsObject,
+    }
+}
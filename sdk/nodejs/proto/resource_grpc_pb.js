@@ -259,6 +259,20 @@ var ResourceMonitorService = exports.ResourceMonitorService = {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the
+// deployment has finished so that the callbacks the program serves, such as the hooks of resources that are deleted
+// after the program has finished, remain available until then.
+signalAndWaitForShutdown: {
+    path: '/pulumirpc.ResourceMonitor/SignalAndWaitForShutdown',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
 };
 
 exports.ResourceMonitorClient = grpc.makeGenericClientConstructor(ResourceMonitorService);
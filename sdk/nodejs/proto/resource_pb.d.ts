@@ -238,6 +238,14 @@ export class RegisterResourceRequest extends jspb.Message {
     getTransformsList(): Array<pulumi_callback_pb.Callback>;
     setTransformsList(value: Array<pulumi_callback_pb.Callback>): RegisterResourceRequest;
     addTransforms(value?: pulumi_callback_pb.Callback, index?: number): pulumi_callback_pb.Callback;
+    clearHooksList(): void;
+    getHooksList(): Array<ResourceHook>;
+    setHooksList(value: Array<ResourceHook>): RegisterResourceRequest;
+    addHooks(value?: ResourceHook, index?: number): ResourceHook;
+    clearDeletedependenciesList(): void;
+    getDeletedependenciesList(): Array<string>;
+    setDeletedependenciesList(value: Array<string>): RegisterResourceRequest;
+    addDeletedependencies(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RegisterResourceRequest.AsObject;
@@ -285,6 +293,8 @@ export namespace RegisterResourceRequest {
         aliasspecs: boolean,
         sourceposition?: pulumi_source_pb.SourcePosition.AsObject,
         transformsList: Array<pulumi_callback_pb.Callback.AsObject>,
+        hooksList: Array<ResourceHook.AsObject>,
+        deletedependenciesList: Array<string>,
     }
 
 
@@ -705,3 +715,113 @@ export namespace TransformResponse {
         options?: TransformResourceOptions.AsObject,
     }
 }
+
+export class ResourceHook extends jspb.Message { 
+    getName(): string;
+    setName(value: string): ResourceHook;
+    clearEventsList(): void;
+    getEventsList(): Array<string>;
+    setEventsList(value: Array<string>): ResourceHook;
+    addEvents(value: string, index?: number): string;
+
+    hasCallback(): boolean;
+    clearCallback(): void;
+    getCallback(): pulumi_callback_pb.Callback | undefined;
+    setCallback(value?: pulumi_callback_pb.Callback): ResourceHook;
+    getWarnOnFailure(): boolean;
+    setWarnOnFailure(value: boolean): ResourceHook;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResourceHook.AsObject;
+    static toObject(includeInstance: boolean, msg: ResourceHook): ResourceHook.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResourceHook, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResourceHook;
+    static deserializeBinaryFromReader(message: ResourceHook, reader: jspb.BinaryReader): ResourceHook;
+}
+
+export namespace ResourceHook {
+    export type AsObject = {
+        name: string,
+        eventsList: Array<string>,
+        callback?: pulumi_callback_pb.Callback.AsObject,
+        warnOnFailure: boolean,
+    }
+}
+
+export class ResourceHookRequest extends jspb.Message { 
+    getUrn(): string;
+    setUrn(value: string): ResourceHookRequest;
+    getId(): string;
+    setId(value: string): ResourceHookRequest;
+    getType(): string;
+    setType(value: string): ResourceHookRequest;
+    getName(): string;
+    setName(value: string): ResourceHookRequest;
+    getEvent(): string;
+    setEvent(value: string): ResourceHookRequest;
+
+    hasOldInputs(): boolean;
+    clearOldInputs(): void;
+    getOldInputs(): google_protobuf_struct_pb.Struct | undefined;
+    setOldInputs(value?: google_protobuf_struct_pb.Struct): ResourceHookRequest;
+
+    hasOldOutputs(): boolean;
+    clearOldOutputs(): void;
+    getOldOutputs(): google_protobuf_struct_pb.Struct | undefined;
+    setOldOutputs(value?: google_protobuf_struct_pb.Struct): ResourceHookRequest;
+
+    hasNewInputs(): boolean;
+    clearNewInputs(): void;
+    getNewInputs(): google_protobuf_struct_pb.Struct | undefined;
+    setNewInputs(value?: google_protobuf_struct_pb.Struct): ResourceHookRequest;
+
+    hasNewOutputs(): boolean;
+    clearNewOutputs(): void;
+    getNewOutputs(): google_protobuf_struct_pb.Struct | undefined;
+    setNewOutputs(value?: google_protobuf_struct_pb.Struct): ResourceHookRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResourceHookRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ResourceHookRequest): ResourceHookRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResourceHookRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResourceHookRequest;
+    static deserializeBinaryFromReader(message: ResourceHookRequest, reader: jspb.BinaryReader): ResourceHookRequest;
+}
+
+export namespace ResourceHookRequest {
+    export type AsObject = {
+        urn: string,
+        id: string,
+        type: string,
+        name: string,
+        event: string,
+        oldInputs?: google_protobuf_struct_pb.Struct.AsObject,
+        oldOutputs?: google_protobuf_struct_pb.Struct.AsObject,
+        newInputs?: google_protobuf_struct_pb.Struct.AsObject,
+        newOutputs?: google_protobuf_struct_pb.Struct.AsObject,
+    }
+}
+
+export class ResourceHookResponse extends jspb.Message { 
+    getError(): string;
+    setError(value: string): ResourceHookResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResourceHookResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ResourceHookResponse): ResourceHookResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResourceHookResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResourceHookResponse;
+    static deserializeBinaryFromReader(message: ResourceHookResponse, reader: jspb.BinaryReader): ResourceHookResponse;
+}
+
+export namespace ResourceHookResponse {
+    export type AsObject = {
+        error: string,
+    }
+}
@@ -712,6 +712,13 @@ export interface ResourceOptions {
      */
     xTransforms?: ResourceTransform[];
 
+    /**
+     * Optional list of hooks that the engine runs before or after operations on this resource.
+     *
+     * This property is experimental.
+     */
+    xHooks?: ResourceHook[];
+
     /**
      * The URN of a previously-registered resource of this type to read from the engine.
      */
@@ -815,6 +822,77 @@ export interface ResourceTransformResult {
     opts: ResourceOptions;
 }
 
+/**
+ * ResourceHookArgs is the argument bag passed to a resource hook.
+ *
+ * This interface is experimental.
+ */
+export interface ResourceHookArgs {
+    /**
+     * The URN of the resource the hook is running for.
+     */
+    urn: URN;
+    /**
+     * The ID of the resource, if it has one.
+     */
+    id?: ID;
+    /**
+     * The event that triggered the hook, e.g. "before_create".
+     */
+    event: string;
+    /**
+     * The inputs of the resource before the operation, if any.
+     */
+    oldInputs?: Record<string, any>;
+    /**
+     * The outputs of the resource before the operation, if any.
+     */
+    oldOutputs?: Record<string, any>;
+    /**
+     * The inputs of the resource after the operation, if any.
+     */
+    newInputs?: Record<string, any>;
+    /**
+     * The outputs of the resource after the operation. This is only set for "after" events.
+     */
+    newOutputs?: Record<string, any>;
+}
+
+/**
+ * ResourceHookFunction is the callback signature of a resource hook.  Throwing an error fails the
+ * operation the hook is running for, unless the hook is marked to warn on failure.
+ *
+ * This type is experimental.
+ */
+export type ResourceHookFunction = (args: ResourceHookArgs) => Promise<void> | void;
+
+/**
+ * ResourceHook is an action that the engine runs before or after operations on a resource.
+ *
+ * This interface is experimental.
+ */
+export interface ResourceHook {
+    /**
+     * The name of the hook, used in the display and in error messages.
+     */
+    name: string;
+    /**
+     * The events that the hook runs on: one or more of "before_create", "after_create", "before_update",
+     * "after_update", "before_delete", "after_delete", "before_replace" and "after_replace".  Delete hooks run
+     * when the resource is deleted to be replaced; resources that the program no longer registers, or that are
+     * destroyed, are deleted without running their hooks.
+     */
+    events: string[];
+    /**
+     * If true, a failure of the hook is reported as a warning rather than failing the operation.
+     */
+    warnOnFailure?: boolean;
+    /**
+     * The function to run.
+     */
+    callback: ResourceHookFunction;
+}
+
 /**
  * ResourceTransformationArgs is the argument bag passed to a resource transformation.
  */
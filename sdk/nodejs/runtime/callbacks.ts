@@ -31,6 +31,8 @@ import {
     DependencyResource,
     ProviderResource,
     Resource,
+    ResourceHook,
+    ResourceHookArgs,
     ResourceOptions,
     ResourceTransform,
     ResourceTransformArgs,
@@ -49,6 +51,9 @@ type CallbackFunction = (args: Uint8Array) => Promise<jspb.Message>;
 export interface ICallbackServer {
     registerTransform(callback: ResourceTransform): Promise<callproto.Callback>;
     registerStackTransform(callback: ResourceTransform): void;
+    registerResourceHook(hook: ResourceHook): Promise<resproto.ResourceHook>;
+    // Returns true if any resource hooks have been registered.
+    hasResourceHooks(): boolean;
     shutdown(): void;
     // Wait for any pendind registerStackTransform calls to complete.
     awaitStackRegistrations(): Promise<void>;
@@ -60,6 +65,7 @@ export class CallbackServer implements ICallbackServer {
     private readonly _server: grpc.Server;
     private readonly _target: Promise<string>;
     private _pendingRegistrations: number = 0;
+    private _hasResourceHooks: boolean = false;
     private _awaitQueue: ((reason?: any) => void)[] = [];
 
     constructor(monitor: resrpc.IResourceMonitorClient) {
@@ -367,6 +373,50 @@ export class CallbackServer implements ICallbackServer {
         return req;
     }
 
+    async registerResourceHook(hook: ResourceHook): Promise<resproto.ResourceHook> {
+        const cb = async (bytes: Uint8Array): Promise<jspb.Message> => {
+            const request = resproto.ResourceHookRequest.deserializeBinary(bytes);
+
+            const deserialize = (props: gstruct.Struct | undefined) =>
+                props === undefined ? undefined : deserializeProperties(props);
+
+            const args: ResourceHookArgs = {
+                urn: request.getUrn(),
+                id: request.getId() !== "" ? request.getId() : undefined,
+                event: request.getEvent(),
+                oldInputs: deserialize(request.getOldInputs()),
+                oldOutputs: deserialize(request.getOldOutputs()),
+                newInputs: deserialize(request.getNewInputs()),
+                newOutputs: deserialize(request.getNewOutputs()),
+            };
+
+            const response = new resproto.ResourceHookResponse();
+            try {
+                await hook.callback(args);
+            } catch (e) {
+                response.setError(e instanceof Error ? e.message : `${e}`);
+            }
+            return response;
+        };
+        const uuid = randomUUID();
+        this._callbacks.set(uuid, cb);
+        this._hasResourceHooks = true;
+        const callback = new Callback();
+        callback.setToken(uuid);
+        callback.setTarget(await this._target);
+
+        const req = new resproto.ResourceHook();
+        req.setName(hook.name);
+        req.setEventsList(hook.events);
+        req.setCallback(callback);
+        req.setWarnOnFailure(hook.warnOnFailure || false);
+        return req;
+    }
+
+    hasResourceHooks(): boolean {
+        return this._hasResourceHooks;
+    }
+
     registerStackTransform(transform: ResourceTransform): void {
         this._pendingRegistrations++;
 
@@ -129,6 +129,15 @@ export class MockMonitor {
             const tok = req.getTok();
             const inputs = deserializeProperties(req.getArgs());
 
+            // Reads of stack reference outputs are only of interest to the engine, so they aren't passed on to the
+            // mocks.
+            if (tok === "pulumi:pulumi:recordStackOutputRead") {
+                const resp = new provproto.InvokeResponse();
+                resp.setReturn(structproto.Struct.fromJavaScript({}));
+                callback(null, resp);
+                return;
+            }
+
             if (tok === "pulumi:pulumi:getResource") {
                 const registeredResource = this.resources.get(inputs.urn);
                 if (!registeredResource) {
@@ -262,4 +271,5 @@ export async function setMocks(
     store.supportsDeletedWith = true;
     store.supportsAliasSpecs = true;
     store.supportsTransforms = false;
+    store.supportsResourceHooks = false;
 }
@@ -467,6 +467,22 @@ export function registerResource(
                 }
             }
 
+            const hooks: resproto.ResourceHook[] = [];
+            if (opts.xHooks !== undefined && opts.xHooks.length > 0) {
+                if (!getStore().supportsResourceHooks) {
+                    throw new Error("The Pulumi CLI does not support resource hooks. Please update the Pulumi CLI");
+                }
+
+                const callbackServer = getCallbacks();
+                if (callbackServer === undefined) {
+                    throw new Error("Callback server could not initialize");
+                }
+
+                for (const hook of opts.xHooks) {
+                    hooks.push(await callbackServer.registerResourceHook(hook));
+                }
+            }
+
             const req = new resproto.RegisterResourceRequest();
             req.setType(t);
             req.setName(name);
@@ -505,6 +521,7 @@ export function registerResource(
             req.setAliasspecs(true);
             req.setSourceposition(marshalSourcePosition(sourcePosition));
             req.setTransformsList(callbacks);
+            req.setHooksList(hooks);
 
             if (resop.deletedWithURN && !getStore().supportsDeletedWith) {
                 throw new Error(
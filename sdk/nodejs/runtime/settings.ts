@@ -14,6 +14,7 @@
 
 import * as grpc from "@grpc/grpc-js";
 import * as fs from "fs";
+import * as gempty from "google-protobuf/google/protobuf/empty_pb";
 import * as path from "path";
 import { ComponentResource } from "../resource";
 import { CallbackServer, ICallbackServer } from "./callbacks";
@@ -100,6 +101,7 @@ export function resetOptions(
     store.supportsDeletedWith = false;
     store.supportsAliasSpecs = false;
     store.supportsTransforms = false;
+    store.supportsResourceHooks = false;
     store.callbacks = undefined;
 }
 
@@ -190,6 +192,7 @@ export async function awaitFeatureSupport(): Promise<void> {
         store.supportsDeletedWith = await monitorSupportsFeature(monitorRef, "deletedWith");
         store.supportsAliasSpecs = await monitorSupportsFeature(monitorRef, "aliasSpecs");
         store.supportsTransforms = await monitorSupportsFeature(monitorRef, "transforms");
+        store.supportsResourceHooks = await monitorSupportsFeature(monitorRef, "resourceHooks");
     }
 }
 
@@ -453,6 +456,32 @@ export function waitForRPCs(disconnectFromServers = false): Promise<void> {
     return closeCallback();
 }
 
+/**
+ * waitForShutdown waits for the existing RPC queue to drain.  If the program has registered resource hooks, it then
+ * signals the engine that the program has finished and waits until the deployment has finished, so that the engine
+ * can still run the hooks of resources that are deleted after the program has finished.
+ *
+ * @internal
+ */
+export async function waitForShutdown(): Promise<void> {
+    await waitForRPCs();
+
+    const store = getStore();
+    const monitorRef = getMonitor();
+    if (monitorRef === undefined || store.callbacks === undefined || !store.callbacks.hasResourceHooks()) {
+        return;
+    }
+
+    return new Promise<void>((resolve, reject) => {
+        monitorRef.signalAndWaitForShutdown(new gempty.Empty(), (err: grpc.ServiceError | null) => {
+            if (err) {
+                return reject(new Error(`waiting for the deployment to finish: ${err.message}`));
+            }
+            return resolve();
+        });
+    });
+}
+
 /**
  * getMaximumListeners returns the configured number of process listeners available
  */
@@ -117,6 +117,12 @@ export interface Store {
      */
     supportsTransforms: boolean;
 
+    /**
+     * supportsResourceHooks returns a promise that when resolved tells you if the resource monitor we are
+     * connected to is able to run resource hooks, which are served by the callback service.
+     */
+    supportsResourceHooks: boolean;
+
     /**
      * callback service running for this deployment. This registers callbacks and forwards them to the engine.
      */
@@ -161,6 +167,7 @@ export class LocalStore implements Store {
     supportsDeletedWith = false;
     supportsAliasSpecs = false;
     supportsTransforms = false;
+    supportsResourceHooks = false;
 }
 
 /** Get the root stack resource for the current stack deployment
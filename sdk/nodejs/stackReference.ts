@@ -12,8 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+import * as log from "./log";
 import { all, Input, Output, output } from "./output";
 import { CustomResource, CustomResourceOptions } from "./resource";
+import { invoke } from "./runtime/invoke";
 
 /**
  * Manages a reference to a Pulumi stack. The referenced stack's outputs are available via the
@@ -35,6 +37,11 @@ export class StackReference extends CustomResource {
      */
     public readonly secretOutputNames!: Output<string[]>;
 
+    /**
+     * The names of the outputs whose reads have been recorded with the engine.
+     */
+    private readonly recordedReads = new Set<string>();
+
     /**
      * Create a StackReference resource with the given unique name, arguments, and options.
      *
@@ -70,7 +77,10 @@ export class StackReference extends CustomResource {
         // Note that this is subtly different from "apply" here. A default "apply" will set the secret bit if any
         // of the inputs are a secret, and this.outputs is always a secret if it contains any secrets. We do this dance
         // so we can ensure that the Output we return is not needlessly tainted as a secret.
-        const value = all([output(name), this.outputs]).apply(([n, os]) => os[n]);
+        const value = all([output(name), this.outputs]).apply(async ([n, os]) => {
+            await this.recordRead(n);
+            return os[n];
+        });
 
         // 'value' is an Output produced by our own `.apply` implementation.  So it's safe to
         // `.allResources!` on it.
@@ -89,7 +99,8 @@ export class StackReference extends CustomResource {
      * @param name The name of the stack output to fetch.
      */
     public requireOutput(name: Input<string>): Output<any> {
-        const value = all([output(this.name), output(name), this.outputs]).apply(([stackname, n, os]) => {
+        const value = all([output(this.name), output(name), this.outputs]).apply(async ([stackname, n, os]) => {
+            await this.recordRead(n);
             if (!os.hasOwnProperty(n)) {
                 throw new Error(`Required output '${n}' does not exist on stack '${stackname}'.`);
             }
@@ -159,6 +170,24 @@ export class StackReference extends CustomResource {
         return out;
     }
 
+    /**
+     * Records with the engine that the program read the named output of the referenced stack, so that the stack's
+     * dependents are known. Reads are only recorded once per output, and failures to record them are ignored, as
+     * older engines do not support recording them.
+     */
+    private async recordRead(name: string): Promise<void> {
+        if (this.recordedReads.has(name)) {
+            return;
+        }
+        this.recordedReads.add(name);
+        try {
+            const urn = await this.urn.promise();
+            await invoke("pulumi:pulumi:recordStackOutputRead", { urn, name });
+        } catch (err) {
+            log.debug(`failed to record read of stack reference output '${name}': ${err}`);
+        }
+    }
+
     private async readOutputValue(callerName: string, outputName: string, required: boolean): Promise<[any, boolean]> {
         const out = required ? this.requireOutput(outputName) : this.getOutput(outputName);
         return Promise.all([out.promise(), out.isSecret]);
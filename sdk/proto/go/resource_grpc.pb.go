@@ -31,6 +31,10 @@ type ResourceMonitorClient interface {
 	RegisterResource(ctx context.Context, in *RegisterResourceRequest, opts ...grpc.CallOption) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(ctx context.Context, in *RegisterResourceOutputsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RegisterStackTransform(ctx context.Context, in *Callback, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the
+	// deployment has finished so that the callbacks the program serves, such as the hooks of resources that are deleted
+	// after the program has finished, remain available until then.
+	SignalAndWaitForShutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type resourceMonitorClient struct {
@@ -136,6 +140,15 @@ func (c *resourceMonitorClient) RegisterStackTransform(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *resourceMonitorClient) SignalAndWaitForShutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/SignalAndWaitForShutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceMonitorServer is the server API for ResourceMonitor service.
 // All implementations must embed UnimplementedResourceMonitorServer
 // for forward compatibility
@@ -148,6 +161,10 @@ type ResourceMonitorServer interface {
 	RegisterResource(context.Context, *RegisterResourceRequest) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(context.Context, *RegisterResourceOutputsRequest) (*emptypb.Empty, error)
 	RegisterStackTransform(context.Context, *Callback) (*emptypb.Empty, error)
+	// SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the
+	// deployment has finished so that the callbacks the program serves, such as the hooks of resources that are deleted
+	// after the program has finished, remain available until then.
+	SignalAndWaitForShutdown(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	mustEmbedUnimplementedResourceMonitorServer()
 }
 
@@ -179,6 +196,9 @@ func (UnimplementedResourceMonitorServer) RegisterResourceOutputs(context.Contex
 func (UnimplementedResourceMonitorServer) RegisterStackTransform(context.Context, *Callback) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterStackTransform not implemented")
 }
+func (UnimplementedResourceMonitorServer) SignalAndWaitForShutdown(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalAndWaitForShutdown not implemented")
+}
 func (UnimplementedResourceMonitorServer) mustEmbedUnimplementedResourceMonitorServer() {}
 
 // UnsafeResourceMonitorServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +359,24 @@ func _ResourceMonitor_RegisterStackTransform_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceMonitor_SignalAndWaitForShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceMonitorServer).SignalAndWaitForShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceMonitor/SignalAndWaitForShutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceMonitorServer).SignalAndWaitForShutdown(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceMonitor_ServiceDesc is the grpc.ServiceDesc for ResourceMonitor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +412,10 @@ var ResourceMonitor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterStackTransform",
 			Handler:    _ResourceMonitor_RegisterStackTransform_Handler,
 		},
+		{
+			MethodName: "SignalAndWaitForShutdown",
+			Handler:    _ResourceMonitor_SignalAndWaitForShutdown_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
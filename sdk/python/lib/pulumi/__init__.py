@@ -67,6 +67,9 @@ from .resource import (
     ResourceTransform,
     ResourceTransformArgs,
     ResourceTransformResult,
+    ResourceHook,
+    ResourceHookArgs,
+    ResourceHookFunction,
 )
 
 from .output import (
@@ -142,6 +145,9 @@ __all__ = [
     "ResourceTransform",
     "ResourceTransformArgs",
     "ResourceTransformResult",
+    "ResourceHook",
+    "ResourceHookArgs",
+    "ResourceHookFunction",
     # output
     "Output",
     "Input",
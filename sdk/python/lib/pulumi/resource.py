@@ -28,6 +28,7 @@ from typing import (
     Callable,
     Tuple,
     TYPE_CHECKING,
+    Awaitable,
     cast,
 )
 from . import _types
@@ -329,6 +330,119 @@ this indicates that the resource will not be transformed.
 """
 
 
+class ResourceHookArgs:
+    """
+    ResourceHookArgs is the argument bag passed to a resource hook.
+
+    This is experimental.
+    """
+
+    urn: str
+    """
+    The URN of the resource the hook is running for.
+    """
+
+    id: Optional[str]
+    """
+    The ID of the resource, if it has one.
+    """
+
+    event: str
+    """
+    The event that triggered the hook, e.g. "before_create".
+    """
+
+    old_inputs: Optional[Mapping[str, Any]]
+    """
+    The inputs of the resource before the operation, if any.
+    """
+
+    old_outputs: Optional[Mapping[str, Any]]
+    """
+    The outputs of the resource before the operation, if any.
+    """
+
+    new_inputs: Optional[Mapping[str, Any]]
+    """
+    The inputs of the resource after the operation, if any.
+    """
+
+    new_outputs: Optional[Mapping[str, Any]]
+    """
+    The outputs of the resource after the operation. This is only set for "after" events.
+    """
+
+    def __init__(
+        self,
+        urn: str,
+        id: Optional[str],
+        event: str,
+        old_inputs: Optional[Mapping[str, Any]] = None,
+        old_outputs: Optional[Mapping[str, Any]] = None,
+        new_inputs: Optional[Mapping[str, Any]] = None,
+        new_outputs: Optional[Mapping[str, Any]] = None,
+    ) -> None:
+        self.urn = urn
+        self.id = id
+        self.event = event
+        self.old_inputs = old_inputs
+        self.old_outputs = old_outputs
+        self.new_inputs = new_inputs
+        self.new_outputs = new_outputs
+
+
+ResourceHookFunction = Callable[[ResourceHookArgs], Optional[Awaitable[None]]]
+"""
+ResourceHookFunction is the callback signature of a resource hook.  Raising an exception fails the
+operation the hook is running for, unless the hook is marked to warn on failure.
+
+This is experimental.
+"""
+
+
+class ResourceHook:
+    """
+    ResourceHook is an action that the engine runs before or after operations on a resource.
+
+    This is experimental.
+    """
+
+    name: str
+    """
+    The name of the hook, used in the display and in error messages.
+    """
+
+    events: List[str]
+    """
+    The events that the hook runs on: one or more of "before_create", "after_create", "before_update",
+    "after_update", "before_delete", "after_delete", "before_replace" and "after_replace".  Delete hooks run
+    when the resource is deleted to be replaced; resources that the program no longer registers, or that are
+    destroyed, are deleted without running their hooks.
+    """
+
+    callback: ResourceHookFunction
+    """
+    The function to run.
+    """
+
+    warn_on_failure: bool
+    """
+    If True, a failure of the hook is reported as a warning rather than failing the operation.
+    """
+
+    def __init__(
+        self,
+        name: str,
+        events: List[str],
+        callback: ResourceHookFunction,
+        warn_on_failure: bool = False,
+    ) -> None:
+        self.name = name
+        self.events = events
+        self.callback = callback
+        self.warn_on_failure = warn_on_failure
+
+
 class ResourceOptions:
     """
     ResourceOptions is a bag of optional settings that control a resource's behavior.
@@ -424,6 +538,13 @@ class ResourceOptions:
     This is experimental.
     """
 
+    x_hooks: Optional[List[ResourceHook]]
+    """
+    Optional list of hooks that the engine runs before or after operations on this resource.
+
+    This is experimental.
+    """
+
     id: Optional["Input[str]"]
     """
     An optional existing ID to load, rather than create.
@@ -482,6 +603,7 @@ class ResourceOptions:
         custom_timeouts: Optional["CustomTimeouts"] = None,
         transformations: Optional[List[ResourceTransformation]] = None,
         x_transforms: Optional[List[ResourceTransform]] = None,
+        x_hooks: Optional[List[ResourceHook]] = None,
         urn: Optional[str] = None,
         replace_on_changes: Optional[List[str]] = None,
         plugin_download_url: Optional[str] = None,
@@ -521,6 +643,8 @@ class ResourceOptions:
                to this resource during construction.
         :param Optional[List[ResourceTransform]] x_transforms: If provided, a list of transforms to apply
                to this resource during construction. This is experimental.
+        :param Optional[List[ResourceHook]] x_hooks: If provided, a list of hooks that the engine runs before or
+               after operations on this resource. This is experimental.
         :param Optional[str] urn: The URN of a previously-registered resource of this type to read from the engine.
         :param Optional[List[str]] replace_on_changes: Changes to any of these property paths will force a replacement.
                If this list includes `"*"`, changes to any properties will force a replacement.  Initialization errors
@@ -553,6 +677,7 @@ class ResourceOptions:
         self.import_ = import_
         self.transformations = transformations
         self.x_transforms = x_transforms
+        self.x_hooks = x_hooks
         self.urn = urn
         self.replace_on_changes = replace_on_changes
         self.depends_on = depends_on
@@ -696,6 +821,7 @@ class ResourceOptions:
             dest.transformations, source.transformations
         )
         dest.x_transforms = _merge_lists(dest.x_transforms, source.x_transforms)
+        dest.x_hooks = _merge_lists(dest.x_hooks, source.x_hooks)
 
         dest.parent = dest.parent if source.parent is None else source.parent
         dest.protect = dest.protect if source.protect is None else source.protect
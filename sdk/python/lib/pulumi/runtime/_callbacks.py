@@ -15,6 +15,7 @@
 from __future__ import annotations
 
 from concurrent import futures
+import inspect
 from typing import TYPE_CHECKING, Awaitable, Callable, Dict, List, Mapping, Union, cast
 import uuid
 
@@ -34,7 +35,7 @@ from .rpc import deserialize_properties, serialize_properties
 from .sync_await import _sync_await
 
 if TYPE_CHECKING:
-    from ..resource import Alias, ResourceOptions, ResourceTransform
+    from ..resource import Alias, ResourceHook, ResourceOptions, ResourceTransform
 
 
 # _MAX_RPC_MESSAGE_SIZE raises the gRPC Max Message size from `4194304` (4mb) to `419430400` (400mb)
@@ -54,12 +55,14 @@ class _CallbackServicer(callback_pb2_grpc.CallbacksServicer):
     _target: str
 
     _transforms: Dict[ResourceTransform, str]
+    _has_resource_hooks: bool
 
     def __init__(self, monitor: resource_pb2_grpc.ResourceMonitorStub):
         log.debug("Creating CallbackServicer")
         _CallbackServicer._servicers.append(self)
         self._callbacks = {}
         self._transforms = {}
+        self._has_resource_hooks = False
         self._monitor = monitor
         self._server = grpc.server(
             futures.ThreadPoolExecutor(
@@ -146,6 +149,60 @@ class _CallbackServicer(callback_pb2_grpc.CallbacksServicer):
             target=self._target,
         )
 
+    def register_resource_hook(self, hook: ResourceHook) -> resource_pb2.ResourceHook:
+        from ..resource import (  # pylint: disable=import-outside-toplevel
+            ResourceHookArgs,
+        )
+
+        def deserialize(request: resource_pb2.ResourceHookRequest, field: str):
+            return (
+                deserialize_properties(getattr(request, field))
+                if request.HasField(field)
+                else None
+            )
+
+        async def cb(s: bytes) -> Message:
+            request: resource_pb2.ResourceHookRequest = (
+                resource_pb2.ResourceHookRequest.FromString(s)
+            )
+
+            args = ResourceHookArgs(
+                urn=request.urn,
+                id=request.id or None,
+                event=request.event,
+                old_inputs=deserialize(request, "old_inputs"),
+                old_outputs=deserialize(request, "old_outputs"),
+                new_inputs=deserialize(request, "new_inputs"),
+                new_outputs=deserialize(request, "new_outputs"),
+            )
+
+            try:
+                result = hook.callback(args)
+                if inspect.isawaitable(result):
+                    await result
+            except Exception as e:  # pylint: disable=broad-except
+                return resource_pb2.ResourceHookResponse(error=str(e))
+            return resource_pb2.ResourceHookResponse()
+
+        token = str(uuid.uuid4())
+        self._callbacks[token] = cb
+        self._has_resource_hooks = True
+        return resource_pb2.ResourceHook(
+            name=hook.name,
+            events=hook.events,
+            callback=callback_pb2.Callback(
+                token=token,
+                target=self._target,
+            ),
+            warn_on_failure=hook.warn_on_failure,
+        )
+
+    def has_resource_hooks(self) -> bool:
+        """
+        Returns True if any resource hooks have been registered.
+        """
+        return self._has_resource_hooks
+
     def register_stack_transform(self, transform: ResourceTransform):
         callback = self.register_transform(transform)
         try:
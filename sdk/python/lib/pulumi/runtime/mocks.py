@@ -20,7 +20,7 @@ import logging
 from abc import ABC, abstractmethod
 from typing import TYPE_CHECKING, Dict, List, NamedTuple, Optional, Tuple
 
-from google.protobuf import empty_pb2
+from google.protobuf import empty_pb2, struct_pb2
 
 from ..runtime.proto import engine_pb2, provider_pb2, resource_pb2
 from ..runtime.stack import Stack, run_pulumi_func
@@ -162,6 +162,12 @@ class MockMonitor:
 
         args = rpc.deserialize_properties(request.args)
 
+        # Reads of stack reference outputs are only of interest to the engine, so they aren't passed on to the
+        # mocks.
+        if request.tok == "pulumi:pulumi:recordStackOutputRead":
+            fields = {"failures": None, "return": struct_pb2.Struct()}
+            return provider_pb2.InvokeResponse(**fields)
+
         if request.tok == "pulumi:pulumi:getResource":
             registered_resource = self.resources.get(args["urn"])
             if registered_resource is None:
@@ -1321,3 +1321,84 @@ class GetMappingsResponse(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["providers", b"providers"]) -> None: ...
 
 global___GetMappingsResponse = GetMappingsResponse
+
+@typing_extensions.final
+class ListRequest(google.protobuf.message.Message):
+    """ListRequest asks the provider to enumerate the existing resources of a type."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    TYPE_FIELD_NUMBER: builtins.int
+    PAGE_TOKEN_FIELD_NUMBER: builtins.int
+    type: builtins.str
+    """the type token of the resources to list."""
+    page_token: builtins.str
+    """the token returned by a previous call, if continuing a paginated listing."""
+    def __init__(
+        self,
+        *,
+        type: builtins.str = ...,
+        page_token: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["page_token", b"page_token", "type", b"type"]) -> None: ...
+
+global___ListRequest = ListRequest
+
+@typing_extensions.final
+class ListResponse(google.protobuf.message.Message):
+    """ListResponse returns one page of the existing resources of a type."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RESOURCES_FIELD_NUMBER: builtins.int
+    NEXT_PAGE_TOKEN_FIELD_NUMBER: builtins.int
+    @property
+    def resources(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ListedResource]:
+        """the resources that were found."""
+    next_page_token: builtins.str
+    """if non-empty, the token to pass to List to fetch the next page."""
+    def __init__(
+        self,
+        *,
+        resources: collections.abc.Iterable[global___ListedResource] | None = ...,
+        next_page_token: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["next_page_token", b"next_page_token", "resources", b"resources"]) -> None: ...
+
+global___ListResponse = ListResponse
+
+@typing_extensions.final
+class ListedResource(google.protobuf.message.Message):
+    """ListedResource describes an existing resource that can be imported."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ID_FIELD_NUMBER: builtins.int
+    NAME_FIELD_NUMBER: builtins.int
+    PARENT_TYPE_FIELD_NUMBER: builtins.int
+    PARENT_ID_FIELD_NUMBER: builtins.int
+    PROPERTIES_FIELD_NUMBER: builtins.int
+    id: builtins.str
+    """the ID to import the resource with."""
+    name: builtins.str
+    """a suggested logical name for the resource, if any."""
+    parent_type: builtins.str
+    """the type of the listed resource that should be this resource's parent."""
+    parent_id: builtins.str
+    """the ID of the listed resource that should be this resource's parent."""
+    @property
+    def properties(self) -> google.protobuf.struct_pb2.Struct:
+        """an optional summary of the resource's properties, to aid selection."""
+    def __init__(
+        self,
+        *,
+        id: builtins.str = ...,
+        name: builtins.str = ...,
+        parent_type: builtins.str = ...,
+        parent_id: builtins.str = ...,
+        properties: google.protobuf.struct_pb2.Struct | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["properties", b"properties"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["id", b"id", "name", b"name", "parent_id", b"parent_id", "parent_type", b"parent_type", "properties", b"properties"]) -> None: ...
+
+global___ListedResource = ListedResource
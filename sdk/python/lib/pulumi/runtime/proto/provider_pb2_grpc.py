@@ -73,6 +73,11 @@ class ResourceProviderStub(object):
                 request_serializer=pulumi_dot_provider__pb2.ReadRequest.SerializeToString,
                 response_deserializer=pulumi_dot_provider__pb2.ReadResponse.FromString,
                 )
+        self.List = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/List',
+                request_serializer=pulumi_dot_provider__pb2.ListRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ListResponse.FromString,
+                )
         self.Update = channel.unary_unary(
                 '/pulumirpc.ResourceProvider/Update',
                 request_serializer=pulumi_dot_provider__pb2.UpdateRequest.SerializeToString,
@@ -204,6 +209,14 @@ class ResourceProviderServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def List(self, request, context):
+        """List enumerates the existing resources of the given type, so that they can be discovered and imported. Listing
+        is optional: providers that do not support it should return UNIMPLEMENTED.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def Update(self, request, context):
         """Update updates an existing resource with new values.
         """
@@ -325,6 +338,11 @@ def add_ResourceProviderServicer_to_server(servicer, server):
                     request_deserializer=pulumi_dot_provider__pb2.ReadRequest.FromString,
                     response_serializer=pulumi_dot_provider__pb2.ReadResponse.SerializeToString,
             ),
+            'List': grpc.unary_unary_rpc_method_handler(
+                    servicer.List,
+                    request_deserializer=pulumi_dot_provider__pb2.ListRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ListResponse.SerializeToString,
+            ),
             'Update': grpc.unary_unary_rpc_method_handler(
                     servicer.Update,
                     request_deserializer=pulumi_dot_provider__pb2.UpdateRequest.FromString,
@@ -564,6 +582,23 @@ class ResourceProvider(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def List(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/List',
+            pulumi_dot_provider__pb2.ListRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ListResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def Update(request,
             target,
@@ -96,6 +96,13 @@ class ResourceProviderStub:
     """Read the current live state associated with a resource.  Enough state must be include in the inputs to uniquely
     identify the resource; this is typically just the resource ID, but may also include some properties.
     """
+    List: grpc.UnaryUnaryMultiCallable[
+        pulumi.provider_pb2.ListRequest,
+        pulumi.provider_pb2.ListResponse,
+    ]
+    """List enumerates the existing resources of the given type, so that they can be discovered and imported. Listing
+    is optional: providers that do not support it should return UNIMPLEMENTED.
+    """
     Update: grpc.UnaryUnaryMultiCallable[
         pulumi.provider_pb2.UpdateRequest,
         pulumi.provider_pb2.UpdateResponse,
@@ -241,6 +248,15 @@ class ResourceProviderServicer(metaclass=abc.ABCMeta):
         identify the resource; this is typically just the resource ID, but may also include some properties.
         """
     
+    def List(
+        self,
+        request: pulumi.provider_pb2.ListRequest,
+        context: grpc.ServicerContext,
+    ) -> pulumi.provider_pb2.ListResponse:
+        """List enumerates the existing resources of the given type, so that they can be discovered and imported. Listing
+        is optional: providers that do not support it should return UNIMPLEMENTED.
+        """
+    
     def Update(
         self,
         request: pulumi.provider_pb2.UpdateRequest,
@@ -320,6 +320,8 @@ class RegisterResourceRequest(google.protobuf.message.Message):
     ALIASSPECS_FIELD_NUMBER: builtins.int
     SOURCEPOSITION_FIELD_NUMBER: builtins.int
     TRANSFORMS_FIELD_NUMBER: builtins.int
+    HOOKS_FIELD_NUMBER: builtins.int
+    DELETEDEPENDENCIES_FIELD_NUMBER: builtins.int
     type: builtins.str
     """the type of the object allocated."""
     name: builtins.str
@@ -402,6 +404,12 @@ class RegisterResourceRequest(google.protobuf.message.Message):
     @property
     def transforms(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[pulumi.callback_pb2.Callback]:
         """a list of transforms to apply to the resource before registering it."""
+    @property
+    def hooks(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ResourceHook]:
+        """a list of hooks to run around operations on the resource."""
+    @property
+    def deleteDependencies(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """the resources that must be deleted after this one when both are deleted."""
     def __init__(
         self,
         *,
@@ -436,9 +444,11 @@ class RegisterResourceRequest(google.protobuf.message.Message):
         aliasSpecs: builtins.bool = ...,
         sourcePosition: pulumi.source_pb2.SourcePosition | None = ...,
         transforms: collections.abc.Iterable[pulumi.callback_pb2.Callback] | None = ...,
+        hooks: collections.abc.Iterable[global___ResourceHook] | None = ...,
+        deleteDependencies: collections.abc.Iterable[builtins.str] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["customTimeouts", b"customTimeouts", "object", b"object", "sourcePosition", b"sourcePosition"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "additionalSecretOutputs", b"additionalSecretOutputs", "aliasSpecs", b"aliasSpecs", "aliasURNs", b"aliasURNs", "aliases", b"aliases", "custom", b"custom", "customTimeouts", b"customTimeouts", "deleteBeforeReplace", b"deleteBeforeReplace", "deleteBeforeReplaceDefined", b"deleteBeforeReplaceDefined", "deletedWith", b"deletedWith", "dependencies", b"dependencies", "ignoreChanges", b"ignoreChanges", "importId", b"importId", "name", b"name", "object", b"object", "parent", b"parent", "pluginChecksums", b"pluginChecksums", "pluginDownloadURL", b"pluginDownloadURL", "propertyDependencies", b"propertyDependencies", "protect", b"protect", "provider", b"provider", "providers", b"providers", "remote", b"remote", "replaceOnChanges", b"replaceOnChanges", "retainOnDelete", b"retainOnDelete", "sourcePosition", b"sourcePosition", "supportsPartialValues", b"supportsPartialValues", "transforms", b"transforms", "type", b"type", "version", b"version"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["acceptResources", b"acceptResources", "acceptSecrets", b"acceptSecrets", "additionalSecretOutputs", b"additionalSecretOutputs", "aliasSpecs", b"aliasSpecs", "aliasURNs", b"aliasURNs", "aliases", b"aliases", "custom", b"custom", "customTimeouts", b"customTimeouts", "deleteBeforeReplace", b"deleteBeforeReplace", "deleteBeforeReplaceDefined", b"deleteBeforeReplaceDefined", "deleteDependencies", b"deleteDependencies", "deletedWith", b"deletedWith", "dependencies", b"dependencies", "hooks", b"hooks", "ignoreChanges", b"ignoreChanges", "importId", b"importId", "name", b"name", "object", b"object", "parent", b"parent", "pluginChecksums", b"pluginChecksums", "pluginDownloadURL", b"pluginDownloadURL", "propertyDependencies", b"propertyDependencies", "protect", b"protect", "provider", b"provider", "providers", b"providers", "remote", b"remote", "replaceOnChanges", b"replaceOnChanges", "retainOnDelete", b"retainOnDelete", "sourcePosition", b"sourcePosition", "supportsPartialValues", b"supportsPartialValues", "transforms", b"transforms", "type", b"type", "version", b"version"]) -> None: ...
 
 global___RegisterResourceRequest = RegisterResourceRequest
 
@@ -871,3 +881,109 @@ class TransformResponse(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["options", b"options", "properties", b"properties"]) -> None: ...
 
 global___TransformResponse = TransformResponse
+
+@typing_extensions.final
+class ResourceHook(google.protobuf.message.Message):
+    """ResourceHook is a callback that the engine runs before or after an operation on a resource."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NAME_FIELD_NUMBER: builtins.int
+    EVENTS_FIELD_NUMBER: builtins.int
+    CALLBACK_FIELD_NUMBER: builtins.int
+    WARN_ON_FAILURE_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    """the name of the hook, used in the display."""
+    @property
+    def events(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """the events on which the hook runs, e.g. "before_create" or "after_update"."""
+    @property
+    def callback(self) -> pulumi.callback_pb2.Callback:
+        """the callback that runs the hook."""
+    warn_on_failure: builtins.bool
+    """if true, a failure of the hook is reported as a warning rather than failing the operation."""
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        events: collections.abc.Iterable[builtins.str] | None = ...,
+        callback: pulumi.callback_pb2.Callback | None = ...,
+        warn_on_failure: builtins.bool = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["callback", b"callback"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["callback", b"callback", "events", b"events", "name", b"name", "warn_on_failure", b"warn_on_failure"]) -> None: ...
+
+global___ResourceHook = ResourceHook
+
+@typing_extensions.final
+class ResourceHookRequest(google.protobuf.message.Message):
+    """ResourceHookRequest is the request sent to the callback of a resource hook."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    URN_FIELD_NUMBER: builtins.int
+    ID_FIELD_NUMBER: builtins.int
+    TYPE_FIELD_NUMBER: builtins.int
+    NAME_FIELD_NUMBER: builtins.int
+    EVENT_FIELD_NUMBER: builtins.int
+    OLD_INPUTS_FIELD_NUMBER: builtins.int
+    OLD_OUTPUTS_FIELD_NUMBER: builtins.int
+    NEW_INPUTS_FIELD_NUMBER: builtins.int
+    NEW_OUTPUTS_FIELD_NUMBER: builtins.int
+    urn: builtins.str
+    """the URN of the resource."""
+    id: builtins.str
+    """the ID of the resource, if it exists."""
+    type: builtins.str
+    """the type of the resource."""
+    name: builtins.str
+    """the name of the resource."""
+    event: builtins.str
+    """the event on which the hook is running."""
+    @property
+    def old_inputs(self) -> google.protobuf.struct_pb2.Struct:
+        """the old input properties of the resource, if any."""
+    @property
+    def old_outputs(self) -> google.protobuf.struct_pb2.Struct:
+        """the old output properties of the resource, if any."""
+    @property
+    def new_inputs(self) -> google.protobuf.struct_pb2.Struct:
+        """the new input properties of the resource, if any."""
+    @property
+    def new_outputs(self) -> google.protobuf.struct_pb2.Struct:
+        """the new output properties of the resource, if any."""
+    def __init__(
+        self,
+        *,
+        urn: builtins.str = ...,
+        id: builtins.str = ...,
+        type: builtins.str = ...,
+        name: builtins.str = ...,
+        event: builtins.str = ...,
+        old_inputs: google.protobuf.struct_pb2.Struct | None = ...,
+        old_outputs: google.protobuf.struct_pb2.Struct | None = ...,
+        new_inputs: google.protobuf.struct_pb2.Struct | None = ...,
+        new_outputs: google.protobuf.struct_pb2.Struct | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["new_inputs", b"new_inputs", "new_outputs", b"new_outputs", "old_inputs", b"old_inputs", "old_outputs", b"old_outputs"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["event", b"event", "id", b"id", "name", b"name", "new_inputs", b"new_inputs", "new_outputs", b"new_outputs", "old_inputs", b"old_inputs", "old_outputs", b"old_outputs", "type", b"type", "urn", b"urn"]) -> None: ...
+
+global___ResourceHookRequest = ResourceHookRequest
+
+@typing_extensions.final
+class ResourceHookResponse(google.protobuf.message.Message):
+    """ResourceHookResponse is the response returned by the callback of a resource hook."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_FIELD_NUMBER: builtins.int
+    error: builtins.str
+    """if set, the hook failed with this error."""
+    def __init__(
+        self,
+        *,
+        error: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
+
+global___ResourceHookResponse = ResourceHookResponse
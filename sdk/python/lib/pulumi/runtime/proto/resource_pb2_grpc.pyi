@@ -61,6 +61,14 @@ class ResourceMonitorStub:
         pulumi.callback_pb2.Callback,
         google.protobuf.empty_pb2.Empty,
     ]
+    SignalAndWaitForShutdown: grpc.UnaryUnaryMultiCallable[
+        google.protobuf.empty_pb2.Empty,
+        google.protobuf.empty_pb2.Empty,
+    ]
+    """SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the
+    deployment has finished so that the callbacks the program serves, such as the hooks of resources that are deleted
+    after the program has finished, remain available until then.
+    """
 
 class ResourceMonitorServicer(metaclass=abc.ABCMeta):
     """ResourceMonitor is the interface a source uses to talk back to the planning monitor orchestrating the execution."""
@@ -113,5 +121,15 @@ class ResourceMonitorServicer(metaclass=abc.ABCMeta):
         request: pulumi.callback_pb2.Callback,
         context: grpc.ServicerContext,
     ) -> google.protobuf.empty_pb2.Empty: ...
+    
+    def SignalAndWaitForShutdown(
+        self,
+        request: google.protobuf.empty_pb2.Empty,
+        context: grpc.ServicerContext,
+    ) -> google.protobuf.empty_pb2.Empty:
+        """SignalAndWaitForShutdown signals that the program has finished registering resources, and waits until the
+        deployment has finished so that the callbacks the program serves, such as the hooks of resources that are deleted
+        after the program has finished, remain available until then.
+        """
 
 def add_ResourceMonitorServicer_to_server(servicer: ResourceMonitorServicer, server: typing.Union[grpc.Server, grpc.aio.Server]) -> None: ...
@@ -44,6 +44,7 @@ from .settings import (
     _get_callbacks,
     _get_rpc_manager,
     _sync_monitor_supports_transforms,
+    _sync_monitor_supports_resource_hooks,
     handle_grpc_error,
 )
 
@@ -921,6 +922,18 @@ def register_resource(
                 for transform in opts.x_transforms:
                     callbacks.append(callback_server.register_transform(transform))
 
+            hooks: List[resource_pb2.ResourceHook] = []
+            if opts.x_hooks:
+                if not _sync_monitor_supports_resource_hooks():
+                    raise Exception(
+                        "The Pulumi CLI does not support resource hooks. Please update the Pulumi CLI."
+                    )
+                callback_server = _get_callbacks()
+                if callback_server is None:
+                    raise Exception("Callback server not initialized")
+                for hook in opts.x_hooks:
+                    hooks.append(callback_server.register_resource_hook(hook))
+
             property_dependencies = {}
             for key, deps in resolver.property_dependencies.items():
                 property_dependencies[key] = (
@@ -990,6 +1003,7 @@ def register_resource(
                 deletedWith=resolver.deleted_with_urn or "",
                 sourcePosition=source_position,
                 transforms=callbacks,
+                hooks=hooks,
             )
 
             mock_urn = await create_urn(name, ty, resolver.parent_urn).future()
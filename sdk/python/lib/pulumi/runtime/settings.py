@@ -23,6 +23,7 @@ from contextvars import ContextVar
 from typing import TYPE_CHECKING, Any, Optional, Union
 
 import grpc
+from google.protobuf import empty_pb2
 
 from .. import log
 from .._utils import contextproperty
@@ -248,6 +249,26 @@ def _shutdown_callbacks():
     _CallbackServicer.shutdown()
 
 
+async def _wait_for_shutdown():
+    """
+    If the program has registered resource hooks, signals the engine that the program has finished and waits until
+    the deployment has finished, so that the engine can still run the hooks of resources that are deleted after the
+    program has finished.
+    """
+    callbacks = SETTINGS.callbacks
+    monitor = SETTINGS.monitor
+    if callbacks is None or not callbacks.has_resource_hooks() or monitor is None:
+        return
+
+    def do_rpc_call():
+        try:
+            monitor.SignalAndWaitForShutdown(empty_pb2.Empty())
+        except grpc.RpcError as exn:
+            handle_grpc_error(exn)
+
+    await asyncio.get_event_loop().run_in_executor(None, do_rpc_call)
+
+
 def get_root_resource() -> Optional["Resource"]:
     """
     Returns the implicit root stack resource for all resources created in this program.
@@ -324,6 +345,12 @@ def _sync_monitor_supports_transforms() -> bool:
     return SETTINGS.feature_support["transforms"]
 
 
+def _sync_monitor_supports_resource_hooks() -> bool:
+    if "resourceHooks" not in SETTINGS.feature_support:
+        return False
+    return SETTINGS.feature_support["resourceHooks"]
+
+
 def reset_options(
     project: Optional[str] = None,
     stack: Optional[str] = None,
@@ -376,4 +403,5 @@ async def _load_monitor_feature_support():
         monitor_supports_feature("deletedWith"),
         monitor_supports_feature("aliasSpecs"),
         monitor_supports_feature("transforms"),
+        monitor_supports_feature("resourceHooks"),
     )
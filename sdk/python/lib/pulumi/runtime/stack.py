@@ -33,6 +33,7 @@ from .settings import (
     _load_monitor_feature_support,
     _shutdown_callbacks,
     _sync_monitor_supports_transforms,
+    _wait_for_shutdown,
     get_project,
     get_root_resource,
     get_stack,
@@ -57,6 +58,9 @@ def _get_running_tasks() -> List[asyncio.Task]:
 async def run_pulumi_func(func: Callable[[], None]):
     try:
         func()
+        await wait_for_rpcs()
+        # Keep serving the resource hooks that the program registered until the deployment has finished.
+        await _wait_for_shutdown()
     finally:
         await wait_for_rpcs()
         _shutdown_callbacks()
@@ -12,11 +12,13 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 from asyncio import ensure_future
-from typing import Optional, Any, List, Callable
+from typing import Optional, Any, List, Callable, Set
 from copy import deepcopy
 
+from . import log
 from .output import Output, Input
 from .resource import CustomResource, ResourceOptions
+from .runtime.invoke import invoke_async
 
 
 class StackReferenceOutputDetails:
@@ -86,6 +88,8 @@ class StackReference(CustomResource):
         :param Optional[ResourceOptions] opts: An optional set of resource options for this resource.
         """
 
+        self.__recorded_reads: Set[str] = set()
+
         target_stack = stack_name if stack_name is not None else name
         opts = ResourceOptions.merge(opts, ResourceOptions(id=target_stack))
 
@@ -106,7 +110,9 @@ class StackReference(CustomResource):
 
         :param Input[str] name: The name of the stack output to fetch.
         """
-        value: Output[Any] = Output.all(Output.from_input(name), self.outputs).apply(lambda l: l[1].get(l[0]))  # type: ignore
+        value: Output[Any] = Output.all(Output.from_input(name), self.outputs).apply(
+            lambda l: self.__read_output(l[0], l[1], False)  # type: ignore
+        )
         is_secret = ensure_future(self.__is_secret_name(name))
 
         return Output(value.resources(), value.future(), value.is_known(), is_secret)
@@ -119,7 +125,9 @@ class StackReference(CustomResource):
         :param Input[str] name: The name of the stack output to fetch.
         """
 
-        value = Output.all(Output.from_input(name), self.outputs).apply(lambda l: l[1][l[0]])  # type: ignore
+        value = Output.all(Output.from_input(name), self.outputs).apply(
+            lambda l: self.__read_output(l[0], l[1], True)  # type: ignore
+        )
         is_secret = ensure_future(self.__is_secret_name(name))
 
         return Output(value.resources(), value.future(), value.is_known(), is_secret)
@@ -135,7 +143,7 @@ class StackReference(CustomResource):
         """
 
         is_secret = await ensure_future(self.__is_secret_name(name))
-        output_val = self.outputs.apply(lambda os: os[name])
+        output_val = self.outputs.apply(lambda os: self.__read_output(name, os, True))
         if not await output_val.is_known():
             return StackReferenceOutputDetails()
 
@@ -156,6 +164,27 @@ class StackReference(CustomResource):
 
         return "secret_output_names" if prop == "secretOutputNames" else prop
 
+    async def __read_output(self, name: str, outputs: dict, required: bool) -> Any:
+        await self.__record_read(name)
+        return outputs[name] if required else outputs.get(name)
+
+    async def __record_read(self, name: str) -> None:
+        """
+        Records with the engine that the program read the named output of the referenced stack, so that the stack's
+        dependents are known. Reads are only recorded once per output, and failures to record them are ignored, as
+        older engines do not support recording them.
+        """
+        if name in self.__recorded_reads:
+            return
+        self.__recorded_reads.add(name)
+        try:
+            urn = await self.urn.future()
+            await invoke_async(
+                "pulumi:pulumi:recordStackOutputRead", {"urn": urn, "name": name}
+            )
+        except Exception as e:
+            log.debug(f"failed to record read of stack reference output '{name}': {e}")
+
     async def __is_secret_name(self, name: Input[str]) -> bool:
         # If either the name or set of secret outputs is unknown, we can't do anything smart, so we
         # just copy the secretness from the entire outputs value.
@@ -9,12 +9,13 @@ import (
 
 	"array-of-enum-map/example/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type ExampleServer struct {
 	pulumi.CustomResourceState
 
-	MapArrayEnum AnnotationStoreSchemaValueTypeMapArrayOutput `pulumi:"mapArrayEnum"`
+	MapArrayEnum pulumix.ArrayOutput[map[string]AnnotationStoreSchemaValueType] `pulumi:"mapArrayEnum"`
 }
 
 // NewExampleServer registers a new resource with the given unique name, arguments, and options.
@@ -62,36 +63,17 @@ type exampleServerArgs struct {
 
 // The set of arguments for constructing a ExampleServer resource.
 type ExampleServerArgs struct {
-	MapArrayEnum AnnotationStoreSchemaValueTypeMapArrayInput
+	MapArrayEnum pulumix.Input[[]map[string]AnnotationStoreSchemaValueType]
 }
 
 func (ExampleServerArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*exampleServerArgs)(nil)).Elem()
 }
 
-type ExampleServerInput interface {
-	pulumi.Input
-
-	ToExampleServerOutput() ExampleServerOutput
-	ToExampleServerOutputWithContext(ctx context.Context) ExampleServerOutput
-}
-
-func (*ExampleServer) ElementType() reflect.Type {
-	return reflect.TypeOf((**ExampleServer)(nil)).Elem()
-}
-
-func (i *ExampleServer) ToExampleServerOutput() ExampleServerOutput {
-	return i.ToExampleServerOutputWithContext(context.Background())
-}
-
-func (i *ExampleServer) ToExampleServerOutputWithContext(ctx context.Context) ExampleServerOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ExampleServerOutput)
-}
-
 type ExampleServerOutput struct{ *pulumi.OutputState }
 
 func (ExampleServerOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ExampleServer)(nil)).Elem()
+	return reflect.TypeOf((*ExampleServer)(nil)).Elem()
 }
 
 func (o ExampleServerOutput) ToExampleServerOutput() ExampleServerOutput {
@@ -102,11 +84,20 @@ func (o ExampleServerOutput) ToExampleServerOutputWithContext(ctx context.Contex
 	return o
 }
 
-func (o ExampleServerOutput) MapArrayEnum() AnnotationStoreSchemaValueTypeMapArrayOutput {
-	return o.ApplyT(func(v *ExampleServer) AnnotationStoreSchemaValueTypeMapArrayOutput { return v.MapArrayEnum }).(AnnotationStoreSchemaValueTypeMapArrayOutput)
+func (o ExampleServerOutput) ToOutput(ctx context.Context) pulumix.Output[ExampleServer] {
+	return pulumix.Output[ExampleServer]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ExampleServerOutput) MapArrayEnum() pulumix.ArrayOutput[map[string]AnnotationStoreSchemaValueType] {
+	value := pulumix.Apply[ExampleServer](o, func(v ExampleServer) pulumix.ArrayOutput[map[string]AnnotationStoreSchemaValueType] {
+		return v.MapArrayEnum
+	})
+	unwrapped := pulumix.Flatten[[]map[string]AnnotationStoreSchemaValueType, pulumix.ArrayOutput[map[string]AnnotationStoreSchemaValueType]](value)
+	return pulumix.ArrayOutput[map[string]AnnotationStoreSchemaValueType]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ExampleServerInput)(nil)).Elem(), &ExampleServer{})
 	pulumi.RegisterOutputType(ExampleServerOutput{})
 }
@@ -4,61 +4,10 @@
 package example
 
 import (
-	"context"
-	"reflect"
-
 	"array-of-enum-map/example/internal"
-	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 var _ = internal.GetEnvOrDefault
 
-type AnnotationStoreSchemaValueTypeMapArray []AnnotationStoreSchemaValueTypeMapInput
-
-func (AnnotationStoreSchemaValueTypeMapArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]map[string]AnnotationStoreSchemaValueType)(nil)).Elem()
-}
-
-func (i AnnotationStoreSchemaValueTypeMapArray) ToAnnotationStoreSchemaValueTypeMapArrayOutput() AnnotationStoreSchemaValueTypeMapArrayOutput {
-	return i.ToAnnotationStoreSchemaValueTypeMapArrayOutputWithContext(context.Background())
-}
-
-func (i AnnotationStoreSchemaValueTypeMapArray) ToAnnotationStoreSchemaValueTypeMapArrayOutputWithContext(ctx context.Context) AnnotationStoreSchemaValueTypeMapArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(AnnotationStoreSchemaValueTypeMapArrayOutput)
-}
-
-// AnnotationStoreSchemaValueTypeMapArrayInput is an input type that accepts AnnotationStoreSchemaValueTypeMapArray and AnnotationStoreSchemaValueTypeMapArrayOutput values.
-// You can construct a concrete instance of `AnnotationStoreSchemaValueTypeMapArrayInput` via:
-//
-//	AnnotationStoreSchemaValueTypeMapArray{ AnnotationStoreSchemaValueTypeMap{ "key": AnnotationStoreSchemaValueTypeArgs{...} } }
-type AnnotationStoreSchemaValueTypeMapArrayInput interface {
-	pulumi.Input
-
-	ToAnnotationStoreSchemaValueTypeMapArrayOutput() AnnotationStoreSchemaValueTypeMapArrayOutput
-	ToAnnotationStoreSchemaValueTypeMapArrayOutputWithContext(context.Context) AnnotationStoreSchemaValueTypeMapArrayOutput
-}
-
-type AnnotationStoreSchemaValueTypeMapArrayOutput struct{ *pulumi.OutputState }
-
-func (AnnotationStoreSchemaValueTypeMapArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]map[string]AnnotationStoreSchemaValueType)(nil)).Elem()
-}
-
-func (o AnnotationStoreSchemaValueTypeMapArrayOutput) ToAnnotationStoreSchemaValueTypeMapArrayOutput() AnnotationStoreSchemaValueTypeMapArrayOutput {
-	return o
-}
-
-func (o AnnotationStoreSchemaValueTypeMapArrayOutput) ToAnnotationStoreSchemaValueTypeMapArrayOutputWithContext(ctx context.Context) AnnotationStoreSchemaValueTypeMapArrayOutput {
-	return o
-}
-
-func (o AnnotationStoreSchemaValueTypeMapArrayOutput) Index(i pulumi.IntInput) AnnotationStoreSchemaValueTypeMapOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) map[string]AnnotationStoreSchemaValueType {
-		return vs[0].([]map[string]AnnotationStoreSchemaValueType)[vs[1].(int)]
-	}).(AnnotationStoreSchemaValueTypeMapOutput)
-}
-
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*AnnotationStoreSchemaValueTypeMapArrayInput)(nil)).Elem(), AnnotationStoreSchemaValueTypeMapArray{})
-	pulumi.RegisterOutputType(AnnotationStoreSchemaValueTypeMapArrayOutput{})
 }
@@ -9,31 +9,21 @@ import (
 
 	"cyclic-types/example/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = internal.GetEnvOrDefault
 
 type AcyclicReferent struct {
-	Bar  IndirectCycleS `pulumi:"bar"`
-	Baz  IndirectCycleT `pulumi:"baz"`
-	Foo4 DirectCycle    `pulumi:"foo4"`
-}
-
-// AcyclicReferentInput is an input type that accepts AcyclicReferentArgs and AcyclicReferentOutput values.
-// You can construct a concrete instance of `AcyclicReferentInput` via:
-//
-//	AcyclicReferentArgs{...}
-type AcyclicReferentInput interface {
-	pulumi.Input
-
-	ToAcyclicReferentOutput() AcyclicReferentOutput
-	ToAcyclicReferentOutputWithContext(context.Context) AcyclicReferentOutput
+	Bar  *IndirectCycleS `pulumi:"bar"`
+	Baz  *IndirectCycleT `pulumi:"baz"`
+	Foo4 *DirectCycle    `pulumi:"foo4"`
 }
 
 type AcyclicReferentArgs struct {
-	Bar  IndirectCycleSInput `pulumi:"bar"`
-	Baz  IndirectCycleTInput `pulumi:"baz"`
-	Foo4 DirectCycleInput    `pulumi:"foo4"`
+	Bar  pulumix.Input[*IndirectCycleSArgs] `pulumi:"bar"`
+	Baz  pulumix.Input[*IndirectCycleTArgs] `pulumi:"baz"`
+	Foo4 pulumix.Input[*DirectCycleArgs]    `pulumi:"foo4"`
 }
 
 func (AcyclicReferentArgs) ElementType() reflect.Type {
@@ -48,6 +38,10 @@ func (i AcyclicReferentArgs) ToAcyclicReferentOutputWithContext(ctx context.Cont
 	return pulumi.ToOutputWithContext(ctx, i).(AcyclicReferentOutput)
 }
 
+func (i *AcyclicReferentArgs) ToOutput(ctx context.Context) pulumix.Output[*AcyclicReferentArgs] {
+	return pulumix.Val(i)
+}
+
 type AcyclicReferentOutput struct{ *pulumi.OutputState }
 
 func (AcyclicReferentOutput) ElementType() reflect.Type {
@@ -62,35 +56,33 @@ func (o AcyclicReferentOutput) ToAcyclicReferentOutputWithContext(ctx context.Co
 	return o
 }
 
-func (o AcyclicReferentOutput) Bar() IndirectCycleSOutput {
-	return o.ApplyT(func(v AcyclicReferent) IndirectCycleS { return v.Bar }).(IndirectCycleSOutput)
+func (o AcyclicReferentOutput) ToOutput(ctx context.Context) pulumix.Output[AcyclicReferent] {
+	return pulumix.Output[AcyclicReferent]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o AcyclicReferentOutput) Baz() IndirectCycleTOutput {
-	return o.ApplyT(func(v AcyclicReferent) IndirectCycleT { return v.Baz }).(IndirectCycleTOutput)
+func (o AcyclicReferentOutput) Bar() pulumix.GPtrOutput[IndirectCycleS, IndirectCycleSOutput] {
+	value := pulumix.Apply[AcyclicReferent](o, func(v AcyclicReferent) *IndirectCycleS { return v.Bar })
+	return pulumix.GPtrOutput[IndirectCycleS, IndirectCycleSOutput]{OutputState: value.OutputState}
 }
 
-func (o AcyclicReferentOutput) Foo4() DirectCycleOutput {
-	return o.ApplyT(func(v AcyclicReferent) DirectCycle { return v.Foo4 }).(DirectCycleOutput)
+func (o AcyclicReferentOutput) Baz() pulumix.GPtrOutput[IndirectCycleT, IndirectCycleTOutput] {
+	value := pulumix.Apply[AcyclicReferent](o, func(v AcyclicReferent) *IndirectCycleT { return v.Baz })
+	return pulumix.GPtrOutput[IndirectCycleT, IndirectCycleTOutput]{OutputState: value.OutputState}
 }
 
-type AcyclicS struct {
-	Foo5 string `pulumi:"foo5"`
+func (o AcyclicReferentOutput) Foo4() pulumix.GPtrOutput[DirectCycle, DirectCycleOutput] {
+	value := pulumix.Apply[AcyclicReferent](o, func(v AcyclicReferent) *DirectCycle { return v.Foo4 })
+	return pulumix.GPtrOutput[DirectCycle, DirectCycleOutput]{OutputState: value.OutputState}
 }
 
-// AcyclicSInput is an input type that accepts AcyclicSArgs and AcyclicSOutput values.
-// You can construct a concrete instance of `AcyclicSInput` via:
-//
-//	AcyclicSArgs{...}
-type AcyclicSInput interface {
-	pulumi.Input
-
-	ToAcyclicSOutput() AcyclicSOutput
-	ToAcyclicSOutputWithContext(context.Context) AcyclicSOutput
+type AcyclicS struct {
+	Foo5 string `pulumi:"foo5"`
 }
 
 type AcyclicSArgs struct {
-	Foo5 pulumi.StringInput `pulumi:"foo5"`
+	Foo5 pulumix.Input[string] `pulumi:"foo5"`
 }
 
 func (AcyclicSArgs) ElementType() reflect.Type {
@@ -105,6 +97,10 @@ func (i AcyclicSArgs) ToAcyclicSOutputWithContext(ctx context.Context) AcyclicSO
 	return pulumi.ToOutputWithContext(ctx, i).(AcyclicSOutput)
 }
 
+func (i *AcyclicSArgs) ToOutput(ctx context.Context) pulumix.Output[*AcyclicSArgs] {
+	return pulumix.Val(i)
+}
+
 type AcyclicSOutput struct{ *pulumi.OutputState }
 
 func (AcyclicSOutput) ElementType() reflect.Type {
@@ -119,27 +115,22 @@ func (o AcyclicSOutput) ToAcyclicSOutputWithContext(ctx context.Context) Acyclic
 	return o
 }
 
-func (o AcyclicSOutput) Foo5() pulumi.StringOutput {
-	return o.ApplyT(func(v AcyclicS) string { return v.Foo5 }).(pulumi.StringOutput)
+func (o AcyclicSOutput) ToOutput(ctx context.Context) pulumix.Output[AcyclicS] {
+	return pulumix.Output[AcyclicS]{
+		OutputState: o.OutputState,
+	}
 }
 
-type AcyclicT struct {
-	Foo6 AcyclicS `pulumi:"foo6"`
+func (o AcyclicSOutput) Foo5() pulumix.Output[string] {
+	return pulumix.Apply[AcyclicS](o, func(v AcyclicS) string { return v.Foo5 })
 }
 
-// AcyclicTInput is an input type that accepts AcyclicTArgs and AcyclicTOutput values.
-// You can construct a concrete instance of `AcyclicTInput` via:
-//
-//	AcyclicTArgs{...}
-type AcyclicTInput interface {
-	pulumi.Input
-
-	ToAcyclicTOutput() AcyclicTOutput
-	ToAcyclicTOutputWithContext(context.Context) AcyclicTOutput
+type AcyclicT struct {
+	Foo6 *AcyclicS `pulumi:"foo6"`
 }
 
 type AcyclicTArgs struct {
-	Foo6 AcyclicSInput `pulumi:"foo6"`
+	Foo6 pulumix.Input[*AcyclicSArgs] `pulumi:"foo6"`
 }
 
 func (AcyclicTArgs) ElementType() reflect.Type {
@@ -154,6 +145,10 @@ func (i AcyclicTArgs) ToAcyclicTOutputWithContext(ctx context.Context) AcyclicTO
 	return pulumi.ToOutputWithContext(ctx, i).(AcyclicTOutput)
 }
 
+func (i *AcyclicTArgs) ToOutput(ctx context.Context) pulumix.Output[*AcyclicTArgs] {
+	return pulumix.Val(i)
+}
+
 type AcyclicTOutput struct{ *pulumi.OutputState }
 
 func (AcyclicTOutput) ElementType() reflect.Type {
@@ -168,27 +163,23 @@ func (o AcyclicTOutput) ToAcyclicTOutputWithContext(ctx context.Context) Acyclic
 	return o
 }
 
-func (o AcyclicTOutput) Foo6() AcyclicSOutput {
-	return o.ApplyT(func(v AcyclicT) AcyclicS { return v.Foo6 }).(AcyclicSOutput)
+func (o AcyclicTOutput) ToOutput(ctx context.Context) pulumix.Output[AcyclicT] {
+	return pulumix.Output[AcyclicT]{
+		OutputState: o.OutputState,
+	}
 }
 
-type DirectCycle struct {
-	Foo *DirectCycle `pulumi:"foo"`
+func (o AcyclicTOutput) Foo6() pulumix.GPtrOutput[AcyclicS, AcyclicSOutput] {
+	value := pulumix.Apply[AcyclicT](o, func(v AcyclicT) *AcyclicS { return v.Foo6 })
+	return pulumix.GPtrOutput[AcyclicS, AcyclicSOutput]{OutputState: value.OutputState}
 }
 
-// DirectCycleInput is an input type that accepts DirectCycleArgs and DirectCycleOutput values.
-// You can construct a concrete instance of `DirectCycleInput` via:
-//
-//	DirectCycleArgs{...}
-type DirectCycleInput interface {
-	pulumi.Input
-
-	ToDirectCycleOutput() DirectCycleOutput
-	ToDirectCycleOutputWithContext(context.Context) DirectCycleOutput
+type DirectCycle struct {
+	Foo *DirectCycle `pulumi:"foo"`
 }
 
 type DirectCycleArgs struct {
-	Foo DirectCyclePtrInput `pulumi:"foo"`
+	Foo pulumix.Input[*DirectCycleArgs] `pulumi:"foo"`
 }
 
 func (DirectCycleArgs) ElementType() reflect.Type {
@@ -203,45 +194,8 @@ func (i DirectCycleArgs) ToDirectCycleOutputWithContext(ctx context.Context) Dir
 	return pulumi.ToOutputWithContext(ctx, i).(DirectCycleOutput)
 }
 
-func (i DirectCycleArgs) ToDirectCyclePtrOutput() DirectCyclePtrOutput {
-	return i.ToDirectCyclePtrOutputWithContext(context.Background())
-}
-
-func (i DirectCycleArgs) ToDirectCyclePtrOutputWithContext(ctx context.Context) DirectCyclePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(DirectCycleOutput).ToDirectCyclePtrOutputWithContext(ctx)
-}
-
-// DirectCyclePtrInput is an input type that accepts DirectCycleArgs, DirectCyclePtr and DirectCyclePtrOutput values.
-// You can construct a concrete instance of `DirectCyclePtrInput` via:
-//
-//	        DirectCycleArgs{...}
-//
-//	or:
-//
-//	        nil
-type DirectCyclePtrInput interface {
-	pulumi.Input
-
-	ToDirectCyclePtrOutput() DirectCyclePtrOutput
-	ToDirectCyclePtrOutputWithContext(context.Context) DirectCyclePtrOutput
-}
-
-type directCyclePtrType DirectCycleArgs
-
-func DirectCyclePtr(v *DirectCycleArgs) DirectCyclePtrInput {
-	return (*directCyclePtrType)(v)
-}
-
-func (*directCyclePtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**DirectCycle)(nil)).Elem()
-}
-
-func (i *directCyclePtrType) ToDirectCyclePtrOutput() DirectCyclePtrOutput {
-	return i.ToDirectCyclePtrOutputWithContext(context.Background())
-}
-
-func (i *directCyclePtrType) ToDirectCyclePtrOutputWithContext(ctx context.Context) DirectCyclePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(DirectCyclePtrOutput)
+func (i *DirectCycleArgs) ToOutput(ctx context.Context) pulumix.Output[*DirectCycleArgs] {
+	return pulumix.Val(i)
 }
 
 type DirectCycleOutput struct{ *pulumi.OutputState }
@@ -258,70 +212,23 @@ func (o DirectCycleOutput) ToDirectCycleOutputWithContext(ctx context.Context) D
 	return o
 }
 
-func (o DirectCycleOutput) ToDirectCyclePtrOutput() DirectCyclePtrOutput {
-	return o.ToDirectCyclePtrOutputWithContext(context.Background())
+func (o DirectCycleOutput) ToOutput(ctx context.Context) pulumix.Output[DirectCycle] {
+	return pulumix.Output[DirectCycle]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o DirectCycleOutput) ToDirectCyclePtrOutputWithContext(ctx context.Context) DirectCyclePtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v DirectCycle) *DirectCycle {
-		return &v
-	}).(DirectCyclePtrOutput)
-}
-
-func (o DirectCycleOutput) Foo() DirectCyclePtrOutput {
-	return o.ApplyT(func(v DirectCycle) *DirectCycle { return v.Foo }).(DirectCyclePtrOutput)
-}
-
-type DirectCyclePtrOutput struct{ *pulumi.OutputState }
-
-func (DirectCyclePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**DirectCycle)(nil)).Elem()
-}
-
-func (o DirectCyclePtrOutput) ToDirectCyclePtrOutput() DirectCyclePtrOutput {
-	return o
-}
-
-func (o DirectCyclePtrOutput) ToDirectCyclePtrOutputWithContext(ctx context.Context) DirectCyclePtrOutput {
-	return o
-}
-
-func (o DirectCyclePtrOutput) Elem() DirectCycleOutput {
-	return o.ApplyT(func(v *DirectCycle) DirectCycle {
-		if v != nil {
-			return *v
-		}
-		var ret DirectCycle
-		return ret
-	}).(DirectCycleOutput)
-}
-
-func (o DirectCyclePtrOutput) Foo() DirectCyclePtrOutput {
-	return o.ApplyT(func(v *DirectCycle) *DirectCycle {
-		if v == nil {
-			return nil
-		}
-		return v.Foo
-	}).(DirectCyclePtrOutput)
+func (o DirectCycleOutput) Foo() pulumix.GPtrOutput[DirectCycle, DirectCycleOutput] {
+	value := pulumix.Apply[DirectCycle](o, func(v DirectCycle) *DirectCycle { return v.Foo })
+	return pulumix.GPtrOutput[DirectCycle, DirectCycleOutput]{OutputState: value.OutputState}
 }
 
 type IndirectCycleS struct {
 	Foo2 *IndirectCycleT `pulumi:"foo2"`
 }
 
-// IndirectCycleSInput is an input type that accepts IndirectCycleSArgs and IndirectCycleSOutput values.
-// You can construct a concrete instance of `IndirectCycleSInput` via:
-//
-//	IndirectCycleSArgs{...}
-type IndirectCycleSInput interface {
-	pulumi.Input
-
-	ToIndirectCycleSOutput() IndirectCycleSOutput
-	ToIndirectCycleSOutputWithContext(context.Context) IndirectCycleSOutput
-}
-
 type IndirectCycleSArgs struct {
-	Foo2 IndirectCycleTPtrInput `pulumi:"foo2"`
+	Foo2 pulumix.Input[*IndirectCycleTArgs] `pulumi:"foo2"`
 }
 
 func (IndirectCycleSArgs) ElementType() reflect.Type {
@@ -336,45 +243,8 @@ func (i IndirectCycleSArgs) ToIndirectCycleSOutputWithContext(ctx context.Contex
 	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleSOutput)
 }
 
-func (i IndirectCycleSArgs) ToIndirectCycleSPtrOutput() IndirectCycleSPtrOutput {
-	return i.ToIndirectCycleSPtrOutputWithContext(context.Background())
-}
-
-func (i IndirectCycleSArgs) ToIndirectCycleSPtrOutputWithContext(ctx context.Context) IndirectCycleSPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleSOutput).ToIndirectCycleSPtrOutputWithContext(ctx)
-}
-
-// IndirectCycleSPtrInput is an input type that accepts IndirectCycleSArgs, IndirectCycleSPtr and IndirectCycleSPtrOutput values.
-// You can construct a concrete instance of `IndirectCycleSPtrInput` via:
-//
-//	        IndirectCycleSArgs{...}
-//
-//	or:
-//
-//	        nil
-type IndirectCycleSPtrInput interface {
-	pulumi.Input
-
-	ToIndirectCycleSPtrOutput() IndirectCycleSPtrOutput
-	ToIndirectCycleSPtrOutputWithContext(context.Context) IndirectCycleSPtrOutput
-}
-
-type indirectCycleSPtrType IndirectCycleSArgs
-
-func IndirectCycleSPtr(v *IndirectCycleSArgs) IndirectCycleSPtrInput {
-	return (*indirectCycleSPtrType)(v)
-}
-
-func (*indirectCycleSPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**IndirectCycleS)(nil)).Elem()
-}
-
-func (i *indirectCycleSPtrType) ToIndirectCycleSPtrOutput() IndirectCycleSPtrOutput {
-	return i.ToIndirectCycleSPtrOutputWithContext(context.Background())
-}
-
-func (i *indirectCycleSPtrType) ToIndirectCycleSPtrOutputWithContext(ctx context.Context) IndirectCycleSPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleSPtrOutput)
+func (i *IndirectCycleSArgs) ToOutput(ctx context.Context) pulumix.Output[*IndirectCycleSArgs] {
+	return pulumix.Val(i)
 }
 
 type IndirectCycleSOutput struct{ *pulumi.OutputState }
@@ -391,70 +261,23 @@ func (o IndirectCycleSOutput) ToIndirectCycleSOutputWithContext(ctx context.Cont
 	return o
 }
 
-func (o IndirectCycleSOutput) ToIndirectCycleSPtrOutput() IndirectCycleSPtrOutput {
-	return o.ToIndirectCycleSPtrOutputWithContext(context.Background())
-}
-
-func (o IndirectCycleSOutput) ToIndirectCycleSPtrOutputWithContext(ctx context.Context) IndirectCycleSPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v IndirectCycleS) *IndirectCycleS {
-		return &v
-	}).(IndirectCycleSPtrOutput)
+func (o IndirectCycleSOutput) ToOutput(ctx context.Context) pulumix.Output[IndirectCycleS] {
+	return pulumix.Output[IndirectCycleS]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o IndirectCycleSOutput) Foo2() IndirectCycleTPtrOutput {
-	return o.ApplyT(func(v IndirectCycleS) *IndirectCycleT { return v.Foo2 }).(IndirectCycleTPtrOutput)
-}
-
-type IndirectCycleSPtrOutput struct{ *pulumi.OutputState }
-
-func (IndirectCycleSPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**IndirectCycleS)(nil)).Elem()
-}
-
-func (o IndirectCycleSPtrOutput) ToIndirectCycleSPtrOutput() IndirectCycleSPtrOutput {
-	return o
-}
-
-func (o IndirectCycleSPtrOutput) ToIndirectCycleSPtrOutputWithContext(ctx context.Context) IndirectCycleSPtrOutput {
-	return o
-}
-
-func (o IndirectCycleSPtrOutput) Elem() IndirectCycleSOutput {
-	return o.ApplyT(func(v *IndirectCycleS) IndirectCycleS {
-		if v != nil {
-			return *v
-		}
-		var ret IndirectCycleS
-		return ret
-	}).(IndirectCycleSOutput)
-}
-
-func (o IndirectCycleSPtrOutput) Foo2() IndirectCycleTPtrOutput {
-	return o.ApplyT(func(v *IndirectCycleS) *IndirectCycleT {
-		if v == nil {
-			return nil
-		}
-		return v.Foo2
-	}).(IndirectCycleTPtrOutput)
+func (o IndirectCycleSOutput) Foo2() pulumix.GPtrOutput[IndirectCycleT, IndirectCycleTOutput] {
+	value := pulumix.Apply[IndirectCycleS](o, func(v IndirectCycleS) *IndirectCycleT { return v.Foo2 })
+	return pulumix.GPtrOutput[IndirectCycleT, IndirectCycleTOutput]{OutputState: value.OutputState}
 }
 
 type IndirectCycleT struct {
 	Foo3 *IndirectCycleS `pulumi:"foo3"`
 }
 
-// IndirectCycleTInput is an input type that accepts IndirectCycleTArgs and IndirectCycleTOutput values.
-// You can construct a concrete instance of `IndirectCycleTInput` via:
-//
-//	IndirectCycleTArgs{...}
-type IndirectCycleTInput interface {
-	pulumi.Input
-
-	ToIndirectCycleTOutput() IndirectCycleTOutput
-	ToIndirectCycleTOutputWithContext(context.Context) IndirectCycleTOutput
-}
-
 type IndirectCycleTArgs struct {
-	Foo3 IndirectCycleSPtrInput `pulumi:"foo3"`
+	Foo3 pulumix.Input[*IndirectCycleSArgs] `pulumi:"foo3"`
 }
 
 func (IndirectCycleTArgs) ElementType() reflect.Type {
@@ -469,45 +292,8 @@ func (i IndirectCycleTArgs) ToIndirectCycleTOutputWithContext(ctx context.Contex
 	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleTOutput)
 }
 
-func (i IndirectCycleTArgs) ToIndirectCycleTPtrOutput() IndirectCycleTPtrOutput {
-	return i.ToIndirectCycleTPtrOutputWithContext(context.Background())
-}
-
-func (i IndirectCycleTArgs) ToIndirectCycleTPtrOutputWithContext(ctx context.Context) IndirectCycleTPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleTOutput).ToIndirectCycleTPtrOutputWithContext(ctx)
-}
-
-// IndirectCycleTPtrInput is an input type that accepts IndirectCycleTArgs, IndirectCycleTPtr and IndirectCycleTPtrOutput values.
-// You can construct a concrete instance of `IndirectCycleTPtrInput` via:
-//
-//	        IndirectCycleTArgs{...}
-//
-//	or:
-//
-//	        nil
-type IndirectCycleTPtrInput interface {
-	pulumi.Input
-
-	ToIndirectCycleTPtrOutput() IndirectCycleTPtrOutput
-	ToIndirectCycleTPtrOutputWithContext(context.Context) IndirectCycleTPtrOutput
-}
-
-type indirectCycleTPtrType IndirectCycleTArgs
-
-func IndirectCycleTPtr(v *IndirectCycleTArgs) IndirectCycleTPtrInput {
-	return (*indirectCycleTPtrType)(v)
-}
-
-func (*indirectCycleTPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**IndirectCycleT)(nil)).Elem()
-}
-
-func (i *indirectCycleTPtrType) ToIndirectCycleTPtrOutput() IndirectCycleTPtrOutput {
-	return i.ToIndirectCycleTPtrOutputWithContext(context.Background())
-}
-
-func (i *indirectCycleTPtrType) ToIndirectCycleTPtrOutputWithContext(ctx context.Context) IndirectCycleTPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(IndirectCycleTPtrOutput)
+func (i *IndirectCycleTArgs) ToOutput(ctx context.Context) pulumix.Output[*IndirectCycleTArgs] {
+	return pulumix.Val(i)
 }
 
 type IndirectCycleTOutput struct{ *pulumi.OutputState }
@@ -524,70 +310,22 @@ func (o IndirectCycleTOutput) ToIndirectCycleTOutputWithContext(ctx context.Cont
 	return o
 }
 
-func (o IndirectCycleTOutput) ToIndirectCycleTPtrOutput() IndirectCycleTPtrOutput {
-	return o.ToIndirectCycleTPtrOutputWithContext(context.Background())
-}
-
-func (o IndirectCycleTOutput) ToIndirectCycleTPtrOutputWithContext(ctx context.Context) IndirectCycleTPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v IndirectCycleT) *IndirectCycleT {
-		return &v
-	}).(IndirectCycleTPtrOutput)
-}
-
-func (o IndirectCycleTOutput) Foo3() IndirectCycleSPtrOutput {
-	return o.ApplyT(func(v IndirectCycleT) *IndirectCycleS { return v.Foo3 }).(IndirectCycleSPtrOutput)
-}
-
-type IndirectCycleTPtrOutput struct{ *pulumi.OutputState }
-
-func (IndirectCycleTPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**IndirectCycleT)(nil)).Elem()
-}
-
-func (o IndirectCycleTPtrOutput) ToIndirectCycleTPtrOutput() IndirectCycleTPtrOutput {
-	return o
-}
-
-func (o IndirectCycleTPtrOutput) ToIndirectCycleTPtrOutputWithContext(ctx context.Context) IndirectCycleTPtrOutput {
-	return o
-}
-
-func (o IndirectCycleTPtrOutput) Elem() IndirectCycleTOutput {
-	return o.ApplyT(func(v *IndirectCycleT) IndirectCycleT {
-		if v != nil {
-			return *v
-		}
-		var ret IndirectCycleT
-		return ret
-	}).(IndirectCycleTOutput)
+func (o IndirectCycleTOutput) ToOutput(ctx context.Context) pulumix.Output[IndirectCycleT] {
+	return pulumix.Output[IndirectCycleT]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o IndirectCycleTPtrOutput) Foo3() IndirectCycleSPtrOutput {
-	return o.ApplyT(func(v *IndirectCycleT) *IndirectCycleS {
-		if v == nil {
-			return nil
-		}
-		return v.Foo3
-	}).(IndirectCycleSPtrOutput)
+func (o IndirectCycleTOutput) Foo3() pulumix.GPtrOutput[IndirectCycleS, IndirectCycleSOutput] {
+	value := pulumix.Apply[IndirectCycleT](o, func(v IndirectCycleT) *IndirectCycleS { return v.Foo3 })
+	return pulumix.GPtrOutput[IndirectCycleS, IndirectCycleSOutput]{OutputState: value.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*AcyclicReferentInput)(nil)).Elem(), AcyclicReferentArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*AcyclicSInput)(nil)).Elem(), AcyclicSArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*AcyclicTInput)(nil)).Elem(), AcyclicTArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*DirectCycleInput)(nil)).Elem(), DirectCycleArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*DirectCyclePtrInput)(nil)).Elem(), DirectCycleArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*IndirectCycleSInput)(nil)).Elem(), IndirectCycleSArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*IndirectCycleSPtrInput)(nil)).Elem(), IndirectCycleSArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*IndirectCycleTInput)(nil)).Elem(), IndirectCycleTArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*IndirectCycleTPtrInput)(nil)).Elem(), IndirectCycleTArgs{})
 	pulumi.RegisterOutputType(AcyclicReferentOutput{})
 	pulumi.RegisterOutputType(AcyclicSOutput{})
 	pulumi.RegisterOutputType(AcyclicTOutput{})
 	pulumi.RegisterOutputType(DirectCycleOutput{})
-	pulumi.RegisterOutputType(DirectCyclePtrOutput{})
 	pulumi.RegisterOutputType(IndirectCycleSOutput{})
-	pulumi.RegisterOutputType(IndirectCycleSPtrOutput{})
 	pulumi.RegisterOutputType(IndirectCycleTOutput{})
-	pulumi.RegisterOutputType(IndirectCycleTPtrOutput{})
 }
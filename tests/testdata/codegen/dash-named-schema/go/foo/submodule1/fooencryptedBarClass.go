@@ -9,6 +9,7 @@ import (
 
 	"dash-named-schema/foo/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type FOOEncryptedBarClass struct {
@@ -65,29 +66,10 @@ func (FOOEncryptedBarClassArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooencryptedBarClassArgs)(nil)).Elem()
 }
 
-type FOOEncryptedBarClassInput interface {
-	pulumi.Input
-
-	ToFOOEncryptedBarClassOutput() FOOEncryptedBarClassOutput
-	ToFOOEncryptedBarClassOutputWithContext(ctx context.Context) FOOEncryptedBarClassOutput
-}
-
-func (*FOOEncryptedBarClass) ElementType() reflect.Type {
-	return reflect.TypeOf((**FOOEncryptedBarClass)(nil)).Elem()
-}
-
-func (i *FOOEncryptedBarClass) ToFOOEncryptedBarClassOutput() FOOEncryptedBarClassOutput {
-	return i.ToFOOEncryptedBarClassOutputWithContext(context.Background())
-}
-
-func (i *FOOEncryptedBarClass) ToFOOEncryptedBarClassOutputWithContext(ctx context.Context) FOOEncryptedBarClassOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FOOEncryptedBarClassOutput)
-}
-
 type FOOEncryptedBarClassOutput struct{ *pulumi.OutputState }
 
 func (FOOEncryptedBarClassOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**FOOEncryptedBarClass)(nil)).Elem()
+	return reflect.TypeOf((*FOOEncryptedBarClass)(nil)).Elem()
 }
 
 func (o FOOEncryptedBarClassOutput) ToFOOEncryptedBarClassOutput() FOOEncryptedBarClassOutput {
@@ -98,7 +80,12 @@ func (o FOOEncryptedBarClassOutput) ToFOOEncryptedBarClassOutputWithContext(ctx
 	return o
 }
 
+func (o FOOEncryptedBarClassOutput) ToOutput(ctx context.Context) pulumix.Output[FOOEncryptedBarClass] {
+	return pulumix.Output[FOOEncryptedBarClass]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FOOEncryptedBarClassInput)(nil)).Elem(), &FOOEncryptedBarClass{})
 	pulumi.RegisterOutputType(FOOEncryptedBarClassOutput{})
 }
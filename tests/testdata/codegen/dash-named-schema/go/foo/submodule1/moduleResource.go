@@ -10,12 +10,13 @@ import (
 	"dash-named-schema/foo"
 	"dash-named-schema/foo/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type ModuleResource struct {
 	pulumi.CustomResourceState
 
-	Thing foo.TopLevelPtrOutput `pulumi:"thing"`
+	Thing pulumix.GPtrOutput[foo.TopLevel, foo.TopLevelOutput] `pulumi:"thing"`
 }
 
 // NewModuleResource registers a new resource with the given unique name, arguments, and options.
@@ -63,36 +64,17 @@ type moduleResourceArgs struct {
 
 // The set of arguments for constructing a ModuleResource resource.
 type ModuleResourceArgs struct {
-	Thing foo.TopLevelPtrInput
+	Thing pulumix.Input[*foo.TopLevelArgs]
 }
 
 func (ModuleResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*moduleResourceArgs)(nil)).Elem()
 }
 
-type ModuleResourceInput interface {
-	pulumi.Input
-
-	ToModuleResourceOutput() ModuleResourceOutput
-	ToModuleResourceOutputWithContext(ctx context.Context) ModuleResourceOutput
-}
-
-func (*ModuleResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**ModuleResource)(nil)).Elem()
-}
-
-func (i *ModuleResource) ToModuleResourceOutput() ModuleResourceOutput {
-	return i.ToModuleResourceOutputWithContext(context.Background())
-}
-
-func (i *ModuleResource) ToModuleResourceOutputWithContext(ctx context.Context) ModuleResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ModuleResourceOutput)
-}
-
 type ModuleResourceOutput struct{ *pulumi.OutputState }
 
 func (ModuleResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ModuleResource)(nil)).Elem()
+	return reflect.TypeOf((*ModuleResource)(nil)).Elem()
 }
 
 func (o ModuleResourceOutput) ToModuleResourceOutput() ModuleResourceOutput {
@@ -103,11 +85,18 @@ func (o ModuleResourceOutput) ToModuleResourceOutputWithContext(ctx context.Cont
 	return o
 }
 
-func (o ModuleResourceOutput) Thing() foo.TopLevelPtrOutput {
-	return o.ApplyT(func(v *ModuleResource) foo.TopLevelPtrOutput { return v.Thing }).(foo.TopLevelPtrOutput)
+func (o ModuleResourceOutput) ToOutput(ctx context.Context) pulumix.Output[ModuleResource] {
+	return pulumix.Output[ModuleResource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ModuleResourceOutput) Thing() pulumix.GPtrOutput[foo.TopLevel, foo.TopLevelOutput] {
+	value := pulumix.Apply[ModuleResource](o, func(v ModuleResource) pulumix.GPtrOutput[foo.TopLevel, foo.TopLevelOutput] { return v.Thing })
+	unwrapped := pulumix.Flatten[*foo.TopLevel, pulumix.GPtrOutput[foo.TopLevel, foo.TopLevelOutput]](value)
+	return pulumix.GPtrOutput[foo.TopLevel, foo.TopLevelOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ModuleResourceInput)(nil)).Elem(), &ModuleResource{})
 	pulumi.RegisterOutputType(ModuleResourceOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"dashed-import-schema/plant-provider/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = internal.GetEnvOrDefault
@@ -33,22 +34,11 @@ func (val *Container) Defaults() *Container {
 	return &tmp
 }
 
-// ContainerInput is an input type that accepts ContainerArgs and ContainerOutput values.
-// You can construct a concrete instance of `ContainerInput` via:
-//
-//	ContainerArgs{...}
-type ContainerInput interface {
-	pulumi.Input
-
-	ToContainerOutput() ContainerOutput
-	ToContainerOutputWithContext(context.Context) ContainerOutput
-}
-
 type ContainerArgs struct {
-	Brightness ContainerBrightnessPtrInput `pulumi:"brightness"`
-	Color      pulumi.StringPtrInput       `pulumi:"color"`
-	Material   pulumi.StringPtrInput       `pulumi:"material"`
-	Size       ContainerSizeInput          `pulumi:"size"`
+	Brightness pulumix.Input[*ContainerBrightness] `pulumi:"brightness"`
+	Color      pulumix.Input[*string]              `pulumi:"color"`
+	Material   pulumix.Input[*string]              `pulumi:"material"`
+	Size       pulumix.Input[ContainerSize]        `pulumi:"size"`
 }
 
 // Defaults sets the appropriate defaults for ContainerArgs
@@ -58,7 +48,7 @@ func (val *ContainerArgs) Defaults() *ContainerArgs {
 	}
 	tmp := *val
 	if tmp.Brightness == nil {
-		tmp.Brightness = ContainerBrightness(1.0)
+		tmp.Brightness = pulumix.Ptr(ContainerBrightness(1.0))
 	}
 	return &tmp
 }
@@ -74,45 +64,8 @@ func (i ContainerArgs) ToContainerOutputWithContext(ctx context.Context) Contain
 	return pulumi.ToOutputWithContext(ctx, i).(ContainerOutput)
 }
 
-func (i ContainerArgs) ToContainerPtrOutput() ContainerPtrOutput {
-	return i.ToContainerPtrOutputWithContext(context.Background())
-}
-
-func (i ContainerArgs) ToContainerPtrOutputWithContext(ctx context.Context) ContainerPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ContainerOutput).ToContainerPtrOutputWithContext(ctx)
-}
-
-// ContainerPtrInput is an input type that accepts ContainerArgs, ContainerPtr and ContainerPtrOutput values.
-// You can construct a concrete instance of `ContainerPtrInput` via:
-//
-//	        ContainerArgs{...}
-//
-//	or:
-//
-//	        nil
-type ContainerPtrInput interface {
-	pulumi.Input
-
-	ToContainerPtrOutput() ContainerPtrOutput
-	ToContainerPtrOutputWithContext(context.Context) ContainerPtrOutput
-}
-
-type containerPtrType ContainerArgs
-
-func ContainerPtr(v *ContainerArgs) ContainerPtrInput {
-	return (*containerPtrType)(v)
-}
-
-func (*containerPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Container)(nil)).Elem()
-}
-
-func (i *containerPtrType) ToContainerPtrOutput() ContainerPtrOutput {
-	return i.ToContainerPtrOutputWithContext(context.Background())
-}
-
-func (i *containerPtrType) ToContainerPtrOutputWithContext(ctx context.Context) ContainerPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ContainerPtrOutput)
+func (i *ContainerArgs) ToOutput(ctx context.Context) pulumix.Output[*ContainerArgs] {
+	return pulumix.Val(i)
 }
 
 type ContainerOutput struct{ *pulumi.OutputState }
@@ -129,95 +82,28 @@ func (o ContainerOutput) ToContainerOutputWithContext(ctx context.Context) Conta
 	return o
 }
 
-func (o ContainerOutput) ToContainerPtrOutput() ContainerPtrOutput {
-	return o.ToContainerPtrOutputWithContext(context.Background())
-}
-
-func (o ContainerOutput) ToContainerPtrOutputWithContext(ctx context.Context) ContainerPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Container) *Container {
-		return &v
-	}).(ContainerPtrOutput)
-}
-
-func (o ContainerOutput) Brightness() ContainerBrightnessPtrOutput {
-	return o.ApplyT(func(v Container) *ContainerBrightness { return v.Brightness }).(ContainerBrightnessPtrOutput)
-}
-
-func (o ContainerOutput) Color() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Container) *string { return v.Color }).(pulumi.StringPtrOutput)
-}
-
-func (o ContainerOutput) Material() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Container) *string { return v.Material }).(pulumi.StringPtrOutput)
-}
-
-func (o ContainerOutput) Size() ContainerSizeOutput {
-	return o.ApplyT(func(v Container) ContainerSize { return v.Size }).(ContainerSizeOutput)
-}
-
-type ContainerPtrOutput struct{ *pulumi.OutputState }
-
-func (ContainerPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Container)(nil)).Elem()
-}
-
-func (o ContainerPtrOutput) ToContainerPtrOutput() ContainerPtrOutput {
-	return o
-}
-
-func (o ContainerPtrOutput) ToContainerPtrOutputWithContext(ctx context.Context) ContainerPtrOutput {
-	return o
-}
-
-func (o ContainerPtrOutput) Elem() ContainerOutput {
-	return o.ApplyT(func(v *Container) Container {
-		if v != nil {
-			return *v
-		}
-		var ret Container
-		return ret
-	}).(ContainerOutput)
+func (o ContainerOutput) ToOutput(ctx context.Context) pulumix.Output[Container] {
+	return pulumix.Output[Container]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ContainerPtrOutput) Brightness() ContainerBrightnessPtrOutput {
-	return o.ApplyT(func(v *Container) *ContainerBrightness {
-		if v == nil {
-			return nil
-		}
-		return v.Brightness
-	}).(ContainerBrightnessPtrOutput)
+func (o ContainerOutput) Brightness() pulumix.Output[*ContainerBrightness] {
+	return pulumix.Apply[Container](o, func(v Container) *ContainerBrightness { return v.Brightness })
 }
 
-func (o ContainerPtrOutput) Color() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Container) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Color
-	}).(pulumi.StringPtrOutput)
+func (o ContainerOutput) Color() pulumix.Output[*string] {
+	return pulumix.Apply[Container](o, func(v Container) *string { return v.Color })
 }
 
-func (o ContainerPtrOutput) Material() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Container) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Material
-	}).(pulumi.StringPtrOutput)
+func (o ContainerOutput) Material() pulumix.Output[*string] {
+	return pulumix.Apply[Container](o, func(v Container) *string { return v.Material })
 }
 
-func (o ContainerPtrOutput) Size() ContainerSizePtrOutput {
-	return o.ApplyT(func(v *Container) *ContainerSize {
-		if v == nil {
-			return nil
-		}
-		return &v.Size
-	}).(ContainerSizePtrOutput)
+func (o ContainerOutput) Size() pulumix.Output[ContainerSize] {
+	return pulumix.Apply[Container](o, func(v Container) ContainerSize { return v.Size })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ContainerInput)(nil)).Elem(), ContainerArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ContainerPtrInput)(nil)).Elem(), ContainerArgs{})
 	pulumi.RegisterOutputType(ContainerOutput{})
-	pulumi.RegisterOutputType(ContainerPtrOutput{})
 }
@@ -10,6 +10,7 @@ import (
 	"dashed-import-schema/plant-provider/internal"
 	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type Nursery struct {
@@ -68,38 +69,19 @@ type nurseryArgs struct {
 // The set of arguments for constructing a Nursery resource.
 type NurseryArgs struct {
 	// The sizes of trees available
-	Sizes TreeSizeMapInput
+	Sizes pulumix.Input[map[string]TreeSize]
 	// The varieties available
-	Varieties RubberTreeVarietyArrayInput
+	Varieties pulumix.Input[[]RubberTreeVariety]
 }
 
 func (NurseryArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*nurseryArgs)(nil)).Elem()
 }
 
-type NurseryInput interface {
-	pulumi.Input
-
-	ToNurseryOutput() NurseryOutput
-	ToNurseryOutputWithContext(ctx context.Context) NurseryOutput
-}
-
-func (*Nursery) ElementType() reflect.Type {
-	return reflect.TypeOf((**Nursery)(nil)).Elem()
-}
-
-func (i *Nursery) ToNurseryOutput() NurseryOutput {
-	return i.ToNurseryOutputWithContext(context.Background())
-}
-
-func (i *Nursery) ToNurseryOutputWithContext(ctx context.Context) NurseryOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(NurseryOutput)
-}
-
 type NurseryOutput struct{ *pulumi.OutputState }
 
 func (NurseryOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Nursery)(nil)).Elem()
+	return reflect.TypeOf((*Nursery)(nil)).Elem()
 }
 
 func (o NurseryOutput) ToNurseryOutput() NurseryOutput {
@@ -110,7 +92,12 @@ func (o NurseryOutput) ToNurseryOutputWithContext(ctx context.Context) NurseryOu
 	return o
 }
 
+func (o NurseryOutput) ToOutput(ctx context.Context) pulumix.Output[Nursery] {
+	return pulumix.Output[Nursery]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*NurseryInput)(nil)).Elem(), &Nursery{})
 	pulumi.RegisterOutputType(NurseryOutput{})
 }
@@ -11,16 +11,17 @@ import (
 	"dashed-import-schema/plant-provider/internal"
 	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type RubberTree struct {
 	pulumi.CustomResourceState
 
-	Container plantprovider.ContainerPtrOutput `pulumi:"container"`
-	Diameter  DiameterOutput                   `pulumi:"diameter"`
-	Farm      pulumi.StringPtrOutput           `pulumi:"farm"`
-	Size      TreeSizePtrOutput                `pulumi:"size"`
-	Type      RubberTreeVarietyOutput          `pulumi:"type"`
+	Container pulumix.GPtrOutput[plantprovider.Container, plantprovider.ContainerOutput] `pulumi:"container"`
+	Diameter  pulumix.Output[Diameter]                                                   `pulumi:"diameter"`
+	Farm      pulumix.Output[*string]                                                    `pulumi:"farm"`
+	Size      pulumix.Output[*TreeSize]                                                  `pulumi:"size"`
+	Type      pulumix.Output[RubberTreeVariety]                                          `pulumi:"type"`
 }
 
 // NewRubberTree registers a new resource with the given unique name, arguments, and options.
@@ -31,19 +32,19 @@ func NewRubberTree(ctx *pulumi.Context,
 	}
 
 	if args.Container != nil {
-		args.Container = args.Container.ToContainerPtrOutput().ApplyT(func(v *plantprovider.Container) *plantprovider.Container { return v.Defaults() }).(plantprovider.ContainerPtrOutput)
+		args.Container = pulumix.Apply(args.Container, func(o *plantprovider.ContainerArgs) *plantprovider.ContainerArgs { return o.Defaults() })
 	}
 	if args.Diameter == nil {
-		args.Diameter = Diameter(6.0)
+		args.Diameter = pulumix.Val(Diameter(6.0))
 	}
 	if args.Farm == nil {
-		args.Farm = pulumi.StringPtr("(unknown)")
+		args.Farm = pulumix.Ptr("(unknown)")
 	}
 	if args.Size == nil {
-		args.Size = TreeSize("medium")
+		args.Size = pulumix.Ptr(TreeSize("medium"))
 	}
 	if args.Type == nil {
-		args.Type = RubberTreeVariety("Burgundy")
+		args.Type = pulumix.Val(RubberTreeVariety("Burgundy"))
 	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource RubberTree
@@ -72,7 +73,7 @@ type rubberTreeState struct {
 }
 
 type RubberTreeState struct {
-	Farm pulumi.StringPtrInput
+	Farm pulumix.Input[*string]
 }
 
 func (RubberTreeState) ElementType() reflect.Type {
@@ -89,40 +90,21 @@ type rubberTreeArgs struct {
 
 // The set of arguments for constructing a RubberTree resource.
 type RubberTreeArgs struct {
-	Container plantprovider.ContainerPtrInput
-	Diameter  DiameterInput
-	Farm      pulumi.StringPtrInput
-	Size      TreeSizePtrInput
-	Type      RubberTreeVarietyInput
+	Container pulumix.Input[*plantprovider.ContainerArgs]
+	Diameter  pulumix.Input[Diameter]
+	Farm      pulumix.Input[*string]
+	Size      pulumix.Input[*TreeSize]
+	Type      pulumix.Input[RubberTreeVariety]
 }
 
 func (RubberTreeArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*rubberTreeArgs)(nil)).Elem()
 }
 
-type RubberTreeInput interface {
-	pulumi.Input
-
-	ToRubberTreeOutput() RubberTreeOutput
-	ToRubberTreeOutputWithContext(ctx context.Context) RubberTreeOutput
-}
-
-func (*RubberTree) ElementType() reflect.Type {
-	return reflect.TypeOf((**RubberTree)(nil)).Elem()
-}
-
-func (i *RubberTree) ToRubberTreeOutput() RubberTreeOutput {
-	return i.ToRubberTreeOutputWithContext(context.Background())
-}
-
-func (i *RubberTree) ToRubberTreeOutputWithContext(ctx context.Context) RubberTreeOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(RubberTreeOutput)
-}
-
 type RubberTreeOutput struct{ *pulumi.OutputState }
 
 func (RubberTreeOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**RubberTree)(nil)).Elem()
+	return reflect.TypeOf((*RubberTree)(nil)).Elem()
 }
 
 func (o RubberTreeOutput) ToRubberTreeOutput() RubberTreeOutput {
@@ -133,27 +115,40 @@ func (o RubberTreeOutput) ToRubberTreeOutputWithContext(ctx context.Context) Rub
 	return o
 }
 
-func (o RubberTreeOutput) Container() plantprovider.ContainerPtrOutput {
-	return o.ApplyT(func(v *RubberTree) plantprovider.ContainerPtrOutput { return v.Container }).(plantprovider.ContainerPtrOutput)
+func (o RubberTreeOutput) ToOutput(ctx context.Context) pulumix.Output[RubberTree] {
+	return pulumix.Output[RubberTree]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o RubberTreeOutput) Container() pulumix.GPtrOutput[plantprovider.Container, plantprovider.ContainerOutput] {
+	value := pulumix.Apply[RubberTree](o, func(v RubberTree) pulumix.GPtrOutput[plantprovider.Container, plantprovider.ContainerOutput] {
+		return v.Container
+	})
+	unwrapped := pulumix.Flatten[*plantprovider.Container, pulumix.GPtrOutput[plantprovider.Container, plantprovider.ContainerOutput]](value)
+	return pulumix.GPtrOutput[plantprovider.Container, plantprovider.ContainerOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o RubberTreeOutput) Diameter() DiameterOutput {
-	return o.ApplyT(func(v *RubberTree) DiameterOutput { return v.Diameter }).(DiameterOutput)
+func (o RubberTreeOutput) Diameter() pulumix.Output[Diameter] {
+	value := pulumix.Apply[RubberTree](o, func(v RubberTree) pulumix.Output[Diameter] { return v.Diameter })
+	return pulumix.Flatten[Diameter, pulumix.Output[Diameter]](value)
 }
 
-func (o RubberTreeOutput) Farm() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *RubberTree) pulumi.StringPtrOutput { return v.Farm }).(pulumi.StringPtrOutput)
+func (o RubberTreeOutput) Farm() pulumix.Output[*string] {
+	value := pulumix.Apply[RubberTree](o, func(v RubberTree) pulumix.Output[*string] { return v.Farm })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
-func (o RubberTreeOutput) Size() TreeSizePtrOutput {
-	return o.ApplyT(func(v *RubberTree) TreeSizePtrOutput { return v.Size }).(TreeSizePtrOutput)
+func (o RubberTreeOutput) Size() pulumix.Output[*TreeSize] {
+	value := pulumix.Apply[RubberTree](o, func(v RubberTree) pulumix.Output[*TreeSize] { return v.Size })
+	return pulumix.Flatten[*TreeSize, pulumix.Output[*TreeSize]](value)
 }
 
-func (o RubberTreeOutput) Type() RubberTreeVarietyOutput {
-	return o.ApplyT(func(v *RubberTree) RubberTreeVarietyOutput { return v.Type }).(RubberTreeVarietyOutput)
+func (o RubberTreeOutput) Type() pulumix.Output[RubberTreeVariety] {
+	value := pulumix.Apply[RubberTree](o, func(v RubberTree) pulumix.Output[RubberTreeVariety] { return v.Type })
+	return pulumix.Flatten[RubberTreeVariety, pulumix.Output[RubberTreeVariety]](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*RubberTreeInput)(nil)).Elem(), &RubberTree{})
 	pulumi.RegisterOutputType(RubberTreeOutput{})
 }
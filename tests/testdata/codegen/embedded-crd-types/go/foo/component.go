@@ -11,13 +11,14 @@ import (
 	"embedded-crd-types/foo/internal"
 	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type Component struct {
 	pulumi.ResourceState
 
-	EniConfig v1alpha1.ENIConfigSpecMapOutput `pulumi:"eniConfig"`
-	Pod       *corev1.PodTypeOutput           `pulumi:"pod"`
+	EniConfig pulumix.GMapOutput[v1alpha1.ENIConfigSpec, v1alpha1.ENIConfigSpecOutput] `pulumi:"eniConfig"`
+	Pod       *corev1.PodType                                                          `pulumi:"pod"`
 }
 
 // NewComponent registers a new resource with the given unique name, arguments, and options.
@@ -43,37 +44,18 @@ type componentArgs struct {
 
 // The set of arguments for constructing a Component resource.
 type ComponentArgs struct {
-	EniConfig v1alpha1.ENIConfigSpecMapInput
-	Pod       *corev1.PodTypeInput
+	EniConfig pulumix.Input[map[string]*v1alpha1.ENIConfigSpecArgs]
+	Pod       pulumix.Input[*corev1.PodTypeArgs]
 }
 
 func (ComponentArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*componentArgs)(nil)).Elem()
 }
 
-type ComponentInput interface {
-	pulumi.Input
-
-	ToComponentOutput() ComponentOutput
-	ToComponentOutputWithContext(ctx context.Context) ComponentOutput
-}
-
-func (*Component) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
-}
-
-func (i *Component) ToComponentOutput() ComponentOutput {
-	return i.ToComponentOutputWithContext(context.Background())
-}
-
-func (i *Component) ToComponentOutputWithContext(ctx context.Context) ComponentOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ComponentOutput)
-}
-
 type ComponentOutput struct{ *pulumi.OutputState }
 
 func (ComponentOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
+	return reflect.TypeOf((*Component)(nil)).Elem()
 }
 
 func (o ComponentOutput) ToComponentOutput() ComponentOutput {
@@ -84,15 +66,25 @@ func (o ComponentOutput) ToComponentOutputWithContext(ctx context.Context) Compo
 	return o
 }
 
-func (o ComponentOutput) EniConfig() v1alpha1.ENIConfigSpecMapOutput {
-	return o.ApplyT(func(v *Component) v1alpha1.ENIConfigSpecMapOutput { return v.EniConfig }).(v1alpha1.ENIConfigSpecMapOutput)
+func (o ComponentOutput) ToOutput(ctx context.Context) pulumix.Output[Component] {
+	return pulumix.Output[Component]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ComponentOutput) EniConfig() pulumix.GMapOutput[v1alpha1.ENIConfigSpec, v1alpha1.ENIConfigSpecOutput] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.GMapOutput[v1alpha1.ENIConfigSpec, v1alpha1.ENIConfigSpecOutput] {
+		return v.EniConfig
+	})
+	unwrapped := pulumix.Flatten[map[string]v1alpha1.ENIConfigSpec, pulumix.GMapOutput[v1alpha1.ENIConfigSpec, v1alpha1.ENIConfigSpecOutput]](value)
+	return pulumix.GMapOutput[v1alpha1.ENIConfigSpec, v1alpha1.ENIConfigSpecOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ComponentOutput) Pod() *corev1.PodTypeOutput {
-	return o.ApplyT(func(v *Component) *corev1.PodTypeOutput { return v.Pod }).(*corev1.PodTypeOutput)
+func (o ComponentOutput) Pod() *corev1.PodType {
+	value := pulumix.Apply[Component](o, func(v Component) *corev1.PodType { return v.Pod })
+	return pulumix.Flatten[*corev1.PodType, *corev1.PodType](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ComponentInput)(nil)).Elem(), &Component{})
 	pulumi.RegisterOutputType(ComponentOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"embedded-crd-types/foo/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = internal.GetEnvOrDefault
@@ -24,20 +25,9 @@ type ENIConfigSpec struct {
 	Subnet         *string  `pulumi:"subnet"`
 }
 
-// ENIConfigSpecInput is an input type that accepts ENIConfigSpecArgs and ENIConfigSpecOutput values.
-// You can construct a concrete instance of `ENIConfigSpecInput` via:
-//
-//	ENIConfigSpecArgs{...}
-type ENIConfigSpecInput interface {
-	pulumi.Input
-
-	ToENIConfigSpecOutput() ENIConfigSpecOutput
-	ToENIConfigSpecOutputWithContext(context.Context) ENIConfigSpecOutput
-}
-
 type ENIConfigSpecArgs struct {
-	SecurityGroups pulumi.StringArrayInput `pulumi:"securityGroups"`
-	Subnet         pulumi.StringPtrInput   `pulumi:"subnet"`
+	SecurityGroups pulumix.Input[[]string] `pulumi:"securityGroups"`
+	Subnet         pulumix.Input[*string]  `pulumi:"subnet"`
 }
 
 func (ENIConfigSpecArgs) ElementType() reflect.Type {
@@ -52,29 +42,8 @@ func (i ENIConfigSpecArgs) ToENIConfigSpecOutputWithContext(ctx context.Context)
 	return pulumi.ToOutputWithContext(ctx, i).(ENIConfigSpecOutput)
 }
 
-// ENIConfigSpecMapInput is an input type that accepts ENIConfigSpecMap and ENIConfigSpecMapOutput values.
-// You can construct a concrete instance of `ENIConfigSpecMapInput` via:
-//
-//	ENIConfigSpecMap{ "key": ENIConfigSpecArgs{...} }
-type ENIConfigSpecMapInput interface {
-	pulumi.Input
-
-	ToENIConfigSpecMapOutput() ENIConfigSpecMapOutput
-	ToENIConfigSpecMapOutputWithContext(context.Context) ENIConfigSpecMapOutput
-}
-
-type ENIConfigSpecMap map[string]ENIConfigSpecInput
-
-func (ENIConfigSpecMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]ENIConfigSpec)(nil)).Elem()
-}
-
-func (i ENIConfigSpecMap) ToENIConfigSpecMapOutput() ENIConfigSpecMapOutput {
-	return i.ToENIConfigSpecMapOutputWithContext(context.Background())
-}
-
-func (i ENIConfigSpecMap) ToENIConfigSpecMapOutputWithContext(ctx context.Context) ENIConfigSpecMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ENIConfigSpecMapOutput)
+func (i *ENIConfigSpecArgs) ToOutput(ctx context.Context) pulumix.Output[*ENIConfigSpecArgs] {
+	return pulumix.Val(i)
 }
 
 type ENIConfigSpecOutput struct{ *pulumi.OutputState }
@@ -91,37 +60,21 @@ func (o ENIConfigSpecOutput) ToENIConfigSpecOutputWithContext(ctx context.Contex
 	return o
 }
 
-func (o ENIConfigSpecOutput) SecurityGroups() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v ENIConfigSpec) []string { return v.SecurityGroups }).(pulumi.StringArrayOutput)
-}
-
-func (o ENIConfigSpecOutput) Subnet() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ENIConfigSpec) *string { return v.Subnet }).(pulumi.StringPtrOutput)
-}
-
-type ENIConfigSpecMapOutput struct{ *pulumi.OutputState }
-
-func (ENIConfigSpecMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]ENIConfigSpec)(nil)).Elem()
+func (o ENIConfigSpecOutput) ToOutput(ctx context.Context) pulumix.Output[ENIConfigSpec] {
+	return pulumix.Output[ENIConfigSpec]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ENIConfigSpecMapOutput) ToENIConfigSpecMapOutput() ENIConfigSpecMapOutput {
-	return o
-}
-
-func (o ENIConfigSpecMapOutput) ToENIConfigSpecMapOutputWithContext(ctx context.Context) ENIConfigSpecMapOutput {
-	return o
+func (o ENIConfigSpecOutput) SecurityGroups() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ENIConfigSpec](o, func(v ENIConfigSpec) []string { return v.SecurityGroups })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
-func (o ENIConfigSpecMapOutput) MapIndex(k pulumi.StringInput) ENIConfigSpecOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ENIConfigSpec {
-		return vs[0].(map[string]ENIConfigSpec)[vs[1].(string)]
-	}).(ENIConfigSpecOutput)
+func (o ENIConfigSpecOutput) Subnet() pulumix.Output[*string] {
+	return pulumix.Apply[ENIConfigSpec](o, func(v ENIConfigSpec) *string { return v.Subnet })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ENIConfigSpecInput)(nil)).Elem(), ENIConfigSpecArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ENIConfigSpecMapInput)(nil)).Elem(), ENIConfigSpecMap{})
 	pulumi.RegisterOutputType(ENIConfigSpecOutput{})
-	pulumi.RegisterOutputType(ENIConfigSpecMapOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"functions-secrets/mypkg/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 func FuncWithSecrets(ctx *pulumi.Context, args *FuncWithSecretsArgs, opts ...pulumi.InvokeOption) (*FuncWithSecretsResult, error) {
@@ -34,25 +35,28 @@ type FuncWithSecretsResult struct {
 }
 
 func FuncWithSecretsOutput(ctx *pulumi.Context, args FuncWithSecretsOutputArgs, opts ...pulumi.InvokeOption) FuncWithSecretsResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (FuncWithSecretsResult, error) {
-			args := v.(FuncWithSecretsArgs)
-			r, err := FuncWithSecrets(ctx, &args, opts...)
-			var s FuncWithSecretsResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(FuncWithSecretsResultOutput)
+	outputResult := pulumix.ApplyErr[*FuncWithSecretsArgs](args.ToOutput(), func(plainArgs *FuncWithSecretsArgs) (*FuncWithSecretsResult, error) {
+		return FuncWithSecrets(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[FuncWithSecretsResultOutput, *FuncWithSecretsResult](outputResult)
 }
 
 type FuncWithSecretsOutputArgs struct {
-	CryptoKey pulumi.StringInput `pulumi:"cryptoKey"`
-	Plaintext pulumi.StringInput `pulumi:"plaintext"`
+	CryptoKey pulumix.Input[string] `pulumi:"cryptoKey"`
+	Plaintext pulumix.Input[string] `pulumi:"plaintext"`
 }
 
-func (FuncWithSecretsOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*FuncWithSecretsArgs)(nil)).Elem()
+func (args FuncWithSecretsOutputArgs) ToOutput() pulumix.Output[*FuncWithSecretsArgs] {
+	allArgs := pulumix.All(
+		args.CryptoKey.ToOutput(context.Background()).AsAny(),
+		args.Plaintext.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *FuncWithSecretsArgs {
+		return &FuncWithSecretsArgs{
+			CryptoKey: resolvedArgs[0].(string),
+			Plaintext: resolvedArgs[1].(string),
+		}
+	})
 }
 
 type FuncWithSecretsResultOutput struct{ *pulumi.OutputState }
@@ -61,30 +65,24 @@ func (FuncWithSecretsResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*FuncWithSecretsResult)(nil)).Elem()
 }
 
-func (o FuncWithSecretsResultOutput) ToFuncWithSecretsResultOutput() FuncWithSecretsResultOutput {
-	return o
-}
-
-func (o FuncWithSecretsResultOutput) ToFuncWithSecretsResultOutputWithContext(ctx context.Context) FuncWithSecretsResultOutput {
-	return o
-}
-
-func (o FuncWithSecretsResultOutput) Ciphertext() pulumi.StringOutput {
-	return o.ApplyT(func(v FuncWithSecretsResult) string { return v.Ciphertext }).(pulumi.StringOutput)
+func (o FuncWithSecretsResultOutput) ToOutput(context.Context) pulumix.Output[*FuncWithSecretsResult] {
+	return pulumix.Output[*FuncWithSecretsResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o FuncWithSecretsResultOutput) CryptoKey() pulumi.StringOutput {
-	return o.ApplyT(func(v FuncWithSecretsResult) string { return v.CryptoKey }).(pulumi.StringOutput)
+func (o FuncWithSecretsResultOutput) Ciphertext() pulumix.Output[string] {
+	return pulumix.Apply[*FuncWithSecretsResult](o, func(v *FuncWithSecretsResult) string { return v.Ciphertext })
 }
 
-func (o FuncWithSecretsResultOutput) Id() pulumi.StringOutput {
-	return o.ApplyT(func(v FuncWithSecretsResult) string { return v.Id }).(pulumi.StringOutput)
+func (o FuncWithSecretsResultOutput) CryptoKey() pulumix.Output[string] {
+	return pulumix.Apply[*FuncWithSecretsResult](o, func(v *FuncWithSecretsResult) string { return v.CryptoKey })
 }
 
-func (o FuncWithSecretsResultOutput) Plaintext() pulumi.StringOutput {
-	return o.ApplyT(func(v FuncWithSecretsResult) string { return v.Plaintext }).(pulumi.StringOutput)
+func (o FuncWithSecretsResultOutput) Id() pulumix.Output[string] {
+	return pulumix.Apply[*FuncWithSecretsResult](o, func(v *FuncWithSecretsResult) string { return v.Id })
 }
 
-func init() {
-	pulumi.RegisterOutputType(FuncWithSecretsResultOutput{})
+func (o FuncWithSecretsResultOutput) Plaintext() pulumix.Output[string] {
+	return pulumix.Apply[*FuncWithSecretsResult](o, func(v *FuncWithSecretsResult) string { return v.Plaintext })
 }
@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
 type ElementType struct {
 	pulumi.CustomResourceState
 
-	ElementType_ ElementTypeTypePtrOutput `pulumi:"elementType"`
+	ElementType_ pulumix.GPtrOutput[ElementTypeType, ElementTypeTypeOutput] `pulumi:"elementType"`
 }
 
 // NewElementType registers a new resource with the given unique name, arguments, and options.
@@ -67,79 +68,10 @@ func (ElementTypeArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*elementTypeArgs)(nil)).Elem()
 }
 
-type ElementTypeInput interface {
-	pulumi.Input
-
-	ToElementTypeOutput() ElementTypeOutput
-	ToElementTypeOutputWithContext(ctx context.Context) ElementTypeOutput
-}
-
-func (*ElementType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ElementType)(nil)).Elem()
-}
-
-func (i *ElementType) ToElementTypeOutput() ElementTypeOutput {
-	return i.ToElementTypeOutputWithContext(context.Background())
-}
-
-func (i *ElementType) ToElementTypeOutputWithContext(ctx context.Context) ElementTypeOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ElementTypeOutput)
-}
-
-// ElementTypeArrayInput is an input type that accepts ElementTypeArray and ElementTypeArrayOutput values.
-// You can construct a concrete instance of `ElementTypeArrayInput` via:
-//
-//	ElementTypeArray{ ElementTypeArgs{...} }
-type ElementTypeArrayInput interface {
-	pulumi.Input
-
-	ToElementTypeArrayOutput() ElementTypeArrayOutput
-	ToElementTypeArrayOutputWithContext(context.Context) ElementTypeArrayOutput
-}
-
-type ElementTypeArray []ElementTypeInput
-
-func (ElementTypeArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ElementType)(nil)).Elem()
-}
-
-func (i ElementTypeArray) ToElementTypeArrayOutput() ElementTypeArrayOutput {
-	return i.ToElementTypeArrayOutputWithContext(context.Background())
-}
-
-func (i ElementTypeArray) ToElementTypeArrayOutputWithContext(ctx context.Context) ElementTypeArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ElementTypeArrayOutput)
-}
-
-// ElementTypeMapInput is an input type that accepts ElementTypeMap and ElementTypeMapOutput values.
-// You can construct a concrete instance of `ElementTypeMapInput` via:
-//
-//	ElementTypeMap{ "key": ElementTypeArgs{...} }
-type ElementTypeMapInput interface {
-	pulumi.Input
-
-	ToElementTypeMapOutput() ElementTypeMapOutput
-	ToElementTypeMapOutputWithContext(context.Context) ElementTypeMapOutput
-}
-
-type ElementTypeMap map[string]ElementTypeInput
-
-func (ElementTypeMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ElementType)(nil)).Elem()
-}
-
-func (i ElementTypeMap) ToElementTypeMapOutput() ElementTypeMapOutput {
-	return i.ToElementTypeMapOutputWithContext(context.Background())
-}
-
-func (i ElementTypeMap) ToElementTypeMapOutputWithContext(ctx context.Context) ElementTypeMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ElementTypeMapOutput)
-}
-
 type ElementTypeOutput struct{ *pulumi.OutputState }
 
 func (ElementTypeOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ElementType)(nil)).Elem()
+	return reflect.TypeOf((*ElementType)(nil)).Elem()
 }
 
 func (o ElementTypeOutput) ToElementTypeOutput() ElementTypeOutput {
@@ -150,55 +82,18 @@ func (o ElementTypeOutput) ToElementTypeOutputWithContext(ctx context.Context) E
 	return o
 }
 
-func (o ElementTypeOutput) GetElementType_() ElementTypeTypePtrOutput {
-	return o.ApplyT(func(v *ElementType) ElementTypeTypePtrOutput { return v.ElementType_ }).(ElementTypeTypePtrOutput)
-}
-
-type ElementTypeArrayOutput struct{ *pulumi.OutputState }
-
-func (ElementTypeArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ElementType)(nil)).Elem()
-}
-
-func (o ElementTypeArrayOutput) ToElementTypeArrayOutput() ElementTypeArrayOutput {
-	return o
-}
-
-func (o ElementTypeArrayOutput) ToElementTypeArrayOutputWithContext(ctx context.Context) ElementTypeArrayOutput {
-	return o
-}
-
-func (o ElementTypeArrayOutput) Index(i pulumi.IntInput) ElementTypeOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ElementType {
-		return vs[0].([]*ElementType)[vs[1].(int)]
-	}).(ElementTypeOutput)
-}
-
-type ElementTypeMapOutput struct{ *pulumi.OutputState }
-
-func (ElementTypeMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ElementType)(nil)).Elem()
-}
-
-func (o ElementTypeMapOutput) ToElementTypeMapOutput() ElementTypeMapOutput {
-	return o
-}
-
-func (o ElementTypeMapOutput) ToElementTypeMapOutputWithContext(ctx context.Context) ElementTypeMapOutput {
-	return o
+func (o ElementTypeOutput) ToOutput(ctx context.Context) pulumix.Output[ElementType] {
+	return pulumix.Output[ElementType]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ElementTypeMapOutput) MapIndex(k pulumi.StringInput) ElementTypeOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ElementType {
-		return vs[0].(map[string]*ElementType)[vs[1].(string)]
-	}).(ElementTypeOutput)
+func (o ElementTypeOutput) GetElementType_() pulumix.GPtrOutput[ElementTypeType, ElementTypeTypeOutput] {
+	value := pulumix.Apply[ElementType](o, func(v ElementType) pulumix.GPtrOutput[ElementTypeType, ElementTypeTypeOutput] { return v.ElementType_ })
+	unwrapped := pulumix.Flatten[*ElementTypeType, pulumix.GPtrOutput[ElementTypeType, ElementTypeTypeOutput]](value)
+	return pulumix.GPtrOutput[ElementTypeType, ElementTypeTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ElementTypeInput)(nil)).Elem(), &ElementType{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ElementTypeArrayInput)(nil)).Elem(), ElementTypeArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ElementTypeMapInput)(nil)).Elem(), ElementTypeMap{})
 	pulumi.RegisterOutputType(ElementTypeOutput{})
-	pulumi.RegisterOutputType(ElementTypeArrayOutput{})
-	pulumi.RegisterOutputType(ElementTypeMapOutput{})
 }
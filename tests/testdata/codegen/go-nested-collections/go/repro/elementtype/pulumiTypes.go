@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
@@ -31,44 +32,16 @@ func (o ElementTypeTypeOutput) ToElementTypeTypeOutputWithContext(ctx context.Co
 	return o
 }
 
-func (o ElementTypeTypeOutput) GetElementType_() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ElementTypeType) *string { return v.ElementType_ }).(pulumi.StringPtrOutput)
+func (o ElementTypeTypeOutput) ToOutput(ctx context.Context) pulumix.Output[ElementTypeType] {
+	return pulumix.Output[ElementTypeType]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ElementTypeTypePtrOutput struct{ *pulumi.OutputState }
-
-func (ElementTypeTypePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ElementTypeType)(nil)).Elem()
-}
-
-func (o ElementTypeTypePtrOutput) ToElementTypeTypePtrOutput() ElementTypeTypePtrOutput {
-	return o
-}
-
-func (o ElementTypeTypePtrOutput) ToElementTypeTypePtrOutputWithContext(ctx context.Context) ElementTypeTypePtrOutput {
-	return o
-}
-
-func (o ElementTypeTypePtrOutput) Elem() ElementTypeTypeOutput {
-	return o.ApplyT(func(v *ElementTypeType) ElementTypeType {
-		if v != nil {
-			return *v
-		}
-		var ret ElementTypeType
-		return ret
-	}).(ElementTypeTypeOutput)
-}
-
-func (o ElementTypeTypePtrOutput) ElementTypeProp() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ElementTypeType) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ElementType_
-	}).(pulumi.StringPtrOutput)
+func (o ElementTypeTypeOutput) GetElementType_() pulumix.Output[*string] {
+	return pulumix.Apply[ElementTypeType](o, func(v ElementTypeType) *string { return v.ElementType_ })
 }
 
 func init() {
 	pulumi.RegisterOutputType(ElementTypeTypeOutput{})
-	pulumi.RegisterOutputType(ElementTypeTypePtrOutput{})
 }
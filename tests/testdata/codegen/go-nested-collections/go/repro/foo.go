@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
 type Foo struct {
 	pulumi.CustomResourceState
 
-	ConditionSets BarArrayArrayArrayOutput `pulumi:"conditionSets"`
+	ConditionSets pulumix.ArrayOutput[[][]Bar] `pulumi:"conditionSets"`
 }
 
 // NewFoo registers a new resource with the given unique name, arguments, and options.
@@ -67,79 +68,10 @@ func (FooArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooArgs)(nil)).Elem()
 }
 
-type FooInput interface {
-	pulumi.Input
-
-	ToFooOutput() FooOutput
-	ToFooOutputWithContext(ctx context.Context) FooOutput
-}
-
-func (*Foo) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
-}
-
-func (i *Foo) ToFooOutput() FooOutput {
-	return i.ToFooOutputWithContext(context.Background())
-}
-
-func (i *Foo) ToFooOutputWithContext(ctx context.Context) FooOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooOutput)
-}
-
-// FooArrayInput is an input type that accepts FooArray and FooArrayOutput values.
-// You can construct a concrete instance of `FooArrayInput` via:
-//
-//	FooArray{ FooArgs{...} }
-type FooArrayInput interface {
-	pulumi.Input
-
-	ToFooArrayOutput() FooArrayOutput
-	ToFooArrayOutputWithContext(context.Context) FooArrayOutput
-}
-
-type FooArray []FooInput
-
-func (FooArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Foo)(nil)).Elem()
-}
-
-func (i FooArray) ToFooArrayOutput() FooArrayOutput {
-	return i.ToFooArrayOutputWithContext(context.Background())
-}
-
-func (i FooArray) ToFooArrayOutputWithContext(ctx context.Context) FooArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooArrayOutput)
-}
-
-// FooMapInput is an input type that accepts FooMap and FooMapOutput values.
-// You can construct a concrete instance of `FooMapInput` via:
-//
-//	FooMap{ "key": FooArgs{...} }
-type FooMapInput interface {
-	pulumi.Input
-
-	ToFooMapOutput() FooMapOutput
-	ToFooMapOutputWithContext(context.Context) FooMapOutput
-}
-
-type FooMap map[string]FooInput
-
-func (FooMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Foo)(nil)).Elem()
-}
-
-func (i FooMap) ToFooMapOutput() FooMapOutput {
-	return i.ToFooMapOutputWithContext(context.Background())
-}
-
-func (i FooMap) ToFooMapOutputWithContext(ctx context.Context) FooMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooMapOutput)
-}
-
 type FooOutput struct{ *pulumi.OutputState }
 
 func (FooOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
+	return reflect.TypeOf((*Foo)(nil)).Elem()
 }
 
 func (o FooOutput) ToFooOutput() FooOutput {
@@ -150,55 +82,18 @@ func (o FooOutput) ToFooOutputWithContext(ctx context.Context) FooOutput {
 	return o
 }
 
-func (o FooOutput) ConditionSets() BarArrayArrayArrayOutput {
-	return o.ApplyT(func(v *Foo) BarArrayArrayArrayOutput { return v.ConditionSets }).(BarArrayArrayArrayOutput)
-}
-
-type FooArrayOutput struct{ *pulumi.OutputState }
-
-func (FooArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Foo)(nil)).Elem()
-}
-
-func (o FooArrayOutput) ToFooArrayOutput() FooArrayOutput {
-	return o
-}
-
-func (o FooArrayOutput) ToFooArrayOutputWithContext(ctx context.Context) FooArrayOutput {
-	return o
-}
-
-func (o FooArrayOutput) Index(i pulumi.IntInput) FooOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Foo {
-		return vs[0].([]*Foo)[vs[1].(int)]
-	}).(FooOutput)
-}
-
-type FooMapOutput struct{ *pulumi.OutputState }
-
-func (FooMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Foo)(nil)).Elem()
-}
-
-func (o FooMapOutput) ToFooMapOutput() FooMapOutput {
-	return o
-}
-
-func (o FooMapOutput) ToFooMapOutputWithContext(ctx context.Context) FooMapOutput {
-	return o
+func (o FooOutput) ToOutput(ctx context.Context) pulumix.Output[Foo] {
+	return pulumix.Output[Foo]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o FooMapOutput) MapIndex(k pulumi.StringInput) FooOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Foo {
-		return vs[0].(map[string]*Foo)[vs[1].(string)]
-	}).(FooOutput)
+func (o FooOutput) ConditionSets() pulumix.ArrayOutput[[][]Bar] {
+	value := pulumix.Apply[Foo](o, func(v Foo) pulumix.ArrayOutput[[][]Bar] { return v.ConditionSets })
+	unwrapped := pulumix.Flatten[[][][]Bar, pulumix.ArrayOutput[[][]Bar]](value)
+	return pulumix.ArrayOutput[[][]Bar]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FooInput)(nil)).Elem(), &Foo{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FooArrayInput)(nil)).Elem(), FooArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FooMapInput)(nil)).Elem(), FooMap{})
 	pulumi.RegisterOutputType(FooOutput{})
-	pulumi.RegisterOutputType(FooArrayOutput{})
-	pulumi.RegisterOutputType(FooMapOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
@@ -56,92 +57,23 @@ func (ConsumerState) ElementType() reflect.Type {
 
 type consumerArgs struct {
 	Typ  map[string]*SomeType `pulumi:"typ"`
-	TypM *SomeTypeMap         `pulumi:"typM"`
+	TypM *SomeTypeMapResource `pulumi:"typM"`
 }
 
 // The set of arguments for constructing a Consumer resource.
 type ConsumerArgs struct {
-	Typ  SomeTypeMapInput
-	TypM SomeTypeMapInput
+	Typ  pulumix.Input[map[string]SomeType]
+	TypM pulumix.Input[*SomeTypeMapResource]
 }
 
 func (ConsumerArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*consumerArgs)(nil)).Elem()
 }
 
-type ConsumerInput interface {
-	pulumi.Input
-
-	ToConsumerOutput() ConsumerOutput
-	ToConsumerOutputWithContext(ctx context.Context) ConsumerOutput
-}
-
-func (*Consumer) ElementType() reflect.Type {
-	return reflect.TypeOf((**Consumer)(nil)).Elem()
-}
-
-func (i *Consumer) ToConsumerOutput() ConsumerOutput {
-	return i.ToConsumerOutputWithContext(context.Background())
-}
-
-func (i *Consumer) ToConsumerOutputWithContext(ctx context.Context) ConsumerOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConsumerOutput)
-}
-
-// ConsumerArrayInput is an input type that accepts ConsumerArray and ConsumerArrayOutput values.
-// You can construct a concrete instance of `ConsumerArrayInput` via:
-//
-//	ConsumerArray{ ConsumerArgs{...} }
-type ConsumerArrayInput interface {
-	pulumi.Input
-
-	ToConsumerArrayOutput() ConsumerArrayOutput
-	ToConsumerArrayOutputWithContext(context.Context) ConsumerArrayOutput
-}
-
-type ConsumerArray []ConsumerInput
-
-func (ConsumerArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Consumer)(nil)).Elem()
-}
-
-func (i ConsumerArray) ToConsumerArrayOutput() ConsumerArrayOutput {
-	return i.ToConsumerArrayOutputWithContext(context.Background())
-}
-
-func (i ConsumerArray) ToConsumerArrayOutputWithContext(ctx context.Context) ConsumerArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConsumerArrayOutput)
-}
-
-// ConsumerMapInput is an input type that accepts ConsumerMap and ConsumerMapOutput values.
-// You can construct a concrete instance of `ConsumerMapInput` via:
-//
-//	ConsumerMap{ "key": ConsumerArgs{...} }
-type ConsumerMapInput interface {
-	pulumi.Input
-
-	ToConsumerMapOutput() ConsumerMapOutput
-	ToConsumerMapOutputWithContext(context.Context) ConsumerMapOutput
-}
-
-type ConsumerMap map[string]ConsumerInput
-
-func (ConsumerMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Consumer)(nil)).Elem()
-}
-
-func (i ConsumerMap) ToConsumerMapOutput() ConsumerMapOutput {
-	return i.ToConsumerMapOutputWithContext(context.Background())
-}
-
-func (i ConsumerMap) ToConsumerMapOutputWithContext(ctx context.Context) ConsumerMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConsumerMapOutput)
-}
-
 type ConsumerOutput struct{ *pulumi.OutputState }
 
 func (ConsumerOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Consumer)(nil)).Elem()
+	return reflect.TypeOf((*Consumer)(nil)).Elem()
 }
 
 func (o ConsumerOutput) ToConsumerOutput() ConsumerOutput {
@@ -152,51 +84,12 @@ func (o ConsumerOutput) ToConsumerOutputWithContext(ctx context.Context) Consume
 	return o
 }
 
-type ConsumerArrayOutput struct{ *pulumi.OutputState }
-
-func (ConsumerArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Consumer)(nil)).Elem()
-}
-
-func (o ConsumerArrayOutput) ToConsumerArrayOutput() ConsumerArrayOutput {
-	return o
-}
-
-func (o ConsumerArrayOutput) ToConsumerArrayOutputWithContext(ctx context.Context) ConsumerArrayOutput {
-	return o
-}
-
-func (o ConsumerArrayOutput) Index(i pulumi.IntInput) ConsumerOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Consumer {
-		return vs[0].([]*Consumer)[vs[1].(int)]
-	}).(ConsumerOutput)
-}
-
-type ConsumerMapOutput struct{ *pulumi.OutputState }
-
-func (ConsumerMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Consumer)(nil)).Elem()
-}
-
-func (o ConsumerMapOutput) ToConsumerMapOutput() ConsumerMapOutput {
-	return o
-}
-
-func (o ConsumerMapOutput) ToConsumerMapOutputWithContext(ctx context.Context) ConsumerMapOutput {
-	return o
-}
-
-func (o ConsumerMapOutput) MapIndex(k pulumi.StringInput) ConsumerOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Consumer {
-		return vs[0].(map[string]*Consumer)[vs[1].(string)]
-	}).(ConsumerOutput)
+func (o ConsumerOutput) ToOutput(ctx context.Context) pulumix.Output[Consumer] {
+	return pulumix.Output[Consumer]{
+		OutputState: o.OutputState,
+	}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConsumerInput)(nil)).Elem(), &Consumer{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConsumerArrayInput)(nil)).Elem(), ConsumerArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConsumerMapInput)(nil)).Elem(), ConsumerMap{})
 	pulumi.RegisterOutputType(ConsumerOutput{})
-	pulumi.RegisterOutputType(ConsumerArrayOutput{})
-	pulumi.RegisterOutputType(ConsumerMapOutput{})
 }
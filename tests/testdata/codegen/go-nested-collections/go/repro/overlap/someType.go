@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
@@ -65,79 +66,10 @@ func (SomeTypeArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*someTypeArgs)(nil)).Elem()
 }
 
-type SomeTypeInput interface {
-	pulumi.Input
-
-	ToSomeTypeOutput() SomeTypeOutput
-	ToSomeTypeOutputWithContext(ctx context.Context) SomeTypeOutput
-}
-
-func (*SomeType) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeType)(nil)).Elem()
-}
-
-func (i *SomeType) ToSomeTypeOutput() SomeTypeOutput {
-	return i.ToSomeTypeOutputWithContext(context.Background())
-}
-
-func (i *SomeType) ToSomeTypeOutputWithContext(ctx context.Context) SomeTypeOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeOutput)
-}
-
-// SomeTypeArrayInput is an input type that accepts SomeTypeArray and SomeTypeArrayOutput values.
-// You can construct a concrete instance of `SomeTypeArrayInput` via:
-//
-//	SomeTypeArray{ SomeTypeArgs{...} }
-type SomeTypeArrayInput interface {
-	pulumi.Input
-
-	ToSomeTypeArrayOutput() SomeTypeArrayOutput
-	ToSomeTypeArrayOutputWithContext(context.Context) SomeTypeArrayOutput
-}
-
-type SomeTypeArray []SomeTypeInput
-
-func (SomeTypeArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*SomeType)(nil)).Elem()
-}
-
-func (i SomeTypeArray) ToSomeTypeArrayOutput() SomeTypeArrayOutput {
-	return i.ToSomeTypeArrayOutputWithContext(context.Background())
-}
-
-func (i SomeTypeArray) ToSomeTypeArrayOutputWithContext(ctx context.Context) SomeTypeArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeArrayOutput)
-}
-
-// SomeTypeMapInput is an input type that accepts SomeTypeMap and SomeTypeMapOutput values.
-// You can construct a concrete instance of `SomeTypeMapInput` via:
-//
-//	SomeTypeMap{ "key": SomeTypeArgs{...} }
-type SomeTypeMapInput interface {
-	pulumi.Input
-
-	ToSomeTypeMapOutput() SomeTypeMapOutput
-	ToSomeTypeMapOutputWithContext(context.Context) SomeTypeMapOutput
-}
-
-type SomeTypeMap map[string]SomeTypeInput
-
-func (SomeTypeMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*SomeType)(nil)).Elem()
-}
-
-func (i SomeTypeMap) ToSomeTypeMapOutput() SomeTypeMapOutput {
-	return i.ToSomeTypeMapOutputWithContext(context.Background())
-}
-
-func (i SomeTypeMap) ToSomeTypeMapOutputWithContext(ctx context.Context) SomeTypeMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeMapOutput)
-}
-
 type SomeTypeOutput struct{ *pulumi.OutputState }
 
 func (SomeTypeOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeType)(nil)).Elem()
+	return reflect.TypeOf((*SomeType)(nil)).Elem()
 }
 
 func (o SomeTypeOutput) ToSomeTypeOutput() SomeTypeOutput {
@@ -148,51 +80,12 @@ func (o SomeTypeOutput) ToSomeTypeOutputWithContext(ctx context.Context) SomeTyp
 	return o
 }
 
-type SomeTypeArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeTypeArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*SomeType)(nil)).Elem()
-}
-
-func (o SomeTypeArrayOutput) ToSomeTypeArrayOutput() SomeTypeArrayOutput {
-	return o
-}
-
-func (o SomeTypeArrayOutput) ToSomeTypeArrayOutputWithContext(ctx context.Context) SomeTypeArrayOutput {
-	return o
-}
-
-func (o SomeTypeArrayOutput) Index(i pulumi.IntInput) SomeTypeOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SomeType {
-		return vs[0].([]*SomeType)[vs[1].(int)]
-	}).(SomeTypeOutput)
-}
-
-type SomeTypeMapOutput struct{ *pulumi.OutputState }
-
-func (SomeTypeMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*SomeType)(nil)).Elem()
-}
-
-func (o SomeTypeMapOutput) ToSomeTypeMapOutput() SomeTypeMapOutput {
-	return o
-}
-
-func (o SomeTypeMapOutput) ToSomeTypeMapOutputWithContext(ctx context.Context) SomeTypeMapOutput {
-	return o
-}
-
-func (o SomeTypeMapOutput) MapIndex(k pulumi.StringInput) SomeTypeOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SomeType {
-		return vs[0].(map[string]*SomeType)[vs[1].(string)]
-	}).(SomeTypeOutput)
+func (o SomeTypeOutput) ToOutput(ctx context.Context) pulumix.Output[SomeType] {
+	return pulumix.Output[SomeType]{
+		OutputState: o.OutputState,
+	}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeInput)(nil)).Elem(), &SomeType{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeArrayInput)(nil)).Elem(), SomeTypeArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeMapInput)(nil)).Elem(), SomeTypeMap{})
 	pulumi.RegisterOutputType(SomeTypeOutput{})
-	pulumi.RegisterOutputType(SomeTypeArrayOutput{})
-	pulumi.RegisterOutputType(SomeTypeMapOutput{})
 }
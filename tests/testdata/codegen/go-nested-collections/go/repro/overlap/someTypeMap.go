@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-nested-collections/repro/internal"
 )
 
@@ -65,79 +66,10 @@ func (SomeTypeMapResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*someTypeMapResourceArgs)(nil)).Elem()
 }
 
-type SomeTypeMapResourceInput interface {
-	pulumi.Input
-
-	ToSomeTypeMapResourceOutput() SomeTypeMapResourceOutput
-	ToSomeTypeMapResourceOutputWithContext(ctx context.Context) SomeTypeMapResourceOutput
-}
-
-func (*SomeTypeMapResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeTypeMapResource)(nil)).Elem()
-}
-
-func (i *SomeTypeMapResource) ToSomeTypeMapResourceOutput() SomeTypeMapResourceOutput {
-	return i.ToSomeTypeMapResourceOutputWithContext(context.Background())
-}
-
-func (i *SomeTypeMapResource) ToSomeTypeMapResourceOutputWithContext(ctx context.Context) SomeTypeMapResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeMapResourceOutput)
-}
-
-// SomeTypeMapResourceArrayInput is an input type that accepts SomeTypeMapResourceArray and SomeTypeMapResourceArrayOutput values.
-// You can construct a concrete instance of `SomeTypeMapResourceArrayInput` via:
-//
-//	SomeTypeMapResourceArray{ SomeTypeMapResourceArgs{...} }
-type SomeTypeMapResourceArrayInput interface {
-	pulumi.Input
-
-	ToSomeTypeMapResourceArrayOutput() SomeTypeMapResourceArrayOutput
-	ToSomeTypeMapResourceArrayOutputWithContext(context.Context) SomeTypeMapResourceArrayOutput
-}
-
-type SomeTypeMapResourceArray []SomeTypeMapResourceInput
-
-func (SomeTypeMapResourceArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*SomeTypeMapResource)(nil)).Elem()
-}
-
-func (i SomeTypeMapResourceArray) ToSomeTypeMapResourceArrayOutput() SomeTypeMapResourceArrayOutput {
-	return i.ToSomeTypeMapResourceArrayOutputWithContext(context.Background())
-}
-
-func (i SomeTypeMapResourceArray) ToSomeTypeMapResourceArrayOutputWithContext(ctx context.Context) SomeTypeMapResourceArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeMapResourceArrayOutput)
-}
-
-// SomeTypeMapResourceMapInput is an input type that accepts SomeTypeMapResourceMap and SomeTypeMapResourceMapOutput values.
-// You can construct a concrete instance of `SomeTypeMapResourceMapInput` via:
-//
-//	SomeTypeMapResourceMap{ "key": SomeTypeMapResourceArgs{...} }
-type SomeTypeMapResourceMapInput interface {
-	pulumi.Input
-
-	ToSomeTypeMapResourceMapOutput() SomeTypeMapResourceMapOutput
-	ToSomeTypeMapResourceMapOutputWithContext(context.Context) SomeTypeMapResourceMapOutput
-}
-
-type SomeTypeMapResourceMap map[string]SomeTypeMapResourceInput
-
-func (SomeTypeMapResourceMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*SomeTypeMapResource)(nil)).Elem()
-}
-
-func (i SomeTypeMapResourceMap) ToSomeTypeMapResourceMapOutput() SomeTypeMapResourceMapOutput {
-	return i.ToSomeTypeMapResourceMapOutputWithContext(context.Background())
-}
-
-func (i SomeTypeMapResourceMap) ToSomeTypeMapResourceMapOutputWithContext(ctx context.Context) SomeTypeMapResourceMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeTypeMapResourceMapOutput)
-}
-
 type SomeTypeMapResourceOutput struct{ *pulumi.OutputState }
 
 func (SomeTypeMapResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeTypeMapResource)(nil)).Elem()
+	return reflect.TypeOf((*SomeTypeMapResource)(nil)).Elem()
 }
 
 func (o SomeTypeMapResourceOutput) ToSomeTypeMapResourceOutput() SomeTypeMapResourceOutput {
@@ -148,51 +80,12 @@ func (o SomeTypeMapResourceOutput) ToSomeTypeMapResourceOutputWithContext(ctx co
 	return o
 }
 
-type SomeTypeMapResourceArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeTypeMapResourceArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*SomeTypeMapResource)(nil)).Elem()
-}
-
-func (o SomeTypeMapResourceArrayOutput) ToSomeTypeMapResourceArrayOutput() SomeTypeMapResourceArrayOutput {
-	return o
-}
-
-func (o SomeTypeMapResourceArrayOutput) ToSomeTypeMapResourceArrayOutputWithContext(ctx context.Context) SomeTypeMapResourceArrayOutput {
-	return o
-}
-
-func (o SomeTypeMapResourceArrayOutput) Index(i pulumi.IntInput) SomeTypeMapResourceOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SomeTypeMapResource {
-		return vs[0].([]*SomeTypeMapResource)[vs[1].(int)]
-	}).(SomeTypeMapResourceOutput)
-}
-
-type SomeTypeMapResourceMapOutput struct{ *pulumi.OutputState }
-
-func (SomeTypeMapResourceMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*SomeTypeMapResource)(nil)).Elem()
-}
-
-func (o SomeTypeMapResourceMapOutput) ToSomeTypeMapResourceMapOutput() SomeTypeMapResourceMapOutput {
-	return o
-}
-
-func (o SomeTypeMapResourceMapOutput) ToSomeTypeMapResourceMapOutputWithContext(ctx context.Context) SomeTypeMapResourceMapOutput {
-	return o
-}
-
-func (o SomeTypeMapResourceMapOutput) MapIndex(k pulumi.StringInput) SomeTypeMapResourceOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SomeTypeMapResource {
-		return vs[0].(map[string]*SomeTypeMapResource)[vs[1].(string)]
-	}).(SomeTypeMapResourceOutput)
+func (o SomeTypeMapResourceOutput) ToOutput(ctx context.Context) pulumix.Output[SomeTypeMapResource] {
+	return pulumix.Output[SomeTypeMapResource]{
+		OutputState: o.OutputState,
+	}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeMapResourceInput)(nil)).Elem(), &SomeTypeMapResource{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeMapResourceArrayInput)(nil)).Elem(), SomeTypeMapResourceArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeTypeMapResourceMapInput)(nil)).Elem(), SomeTypeMapResourceMap{})
 	pulumi.RegisterOutputType(SomeTypeMapResourceOutput{})
-	pulumi.RegisterOutputType(SomeTypeMapResourceArrayOutput{})
-	pulumi.RegisterOutputType(SomeTypeMapResourceMapOutput{})
 }
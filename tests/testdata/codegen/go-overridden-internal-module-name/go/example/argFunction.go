@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-overridden-internal-module-name/example/utilities"
 )
 
@@ -30,24 +31,25 @@ type ArgFunctionResult struct {
 }
 
 func ArgFunctionOutput(ctx *pulumi.Context, args ArgFunctionOutputArgs, opts ...pulumi.InvokeOption) ArgFunctionResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (ArgFunctionResult, error) {
-			args := v.(ArgFunctionArgs)
-			r, err := ArgFunction(ctx, &args, opts...)
-			var s ArgFunctionResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(ArgFunctionResultOutput)
+	outputResult := pulumix.ApplyErr[*ArgFunctionArgs](args.ToOutput(), func(plainArgs *ArgFunctionArgs) (*ArgFunctionResult, error) {
+		return ArgFunction(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[ArgFunctionResultOutput, *ArgFunctionResult](outputResult)
 }
 
 type ArgFunctionOutputArgs struct {
-	Arg1 ResourceInput `pulumi:"arg1"`
+	Arg1 pulumix.Input[*Resource] `pulumi:"arg1"`
 }
 
-func (ArgFunctionOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ArgFunctionArgs)(nil)).Elem()
+func (args ArgFunctionOutputArgs) ToOutput() pulumix.Output[*ArgFunctionArgs] {
+	allArgs := pulumix.All(
+		args.Arg1.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *ArgFunctionArgs {
+		return &ArgFunctionArgs{
+			Arg1: resolvedArgs[0].(*Resource),
+		}
+	})
 }
 
 type ArgFunctionResultOutput struct{ *pulumi.OutputState }
@@ -56,18 +58,15 @@ func (ArgFunctionResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ArgFunctionResult)(nil)).Elem()
 }
 
-func (o ArgFunctionResultOutput) ToArgFunctionResultOutput() ArgFunctionResultOutput {
-	return o
-}
-
-func (o ArgFunctionResultOutput) ToArgFunctionResultOutputWithContext(ctx context.Context) ArgFunctionResultOutput {
-	return o
-}
-
-func (o ArgFunctionResultOutput) Result() ResourceOutput {
-	return o.ApplyT(func(v ArgFunctionResult) *Resource { return v.Result }).(ResourceOutput)
+func (o ArgFunctionResultOutput) ToOutput(context.Context) pulumix.Output[*ArgFunctionResult] {
+	return pulumix.Output[*ArgFunctionResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-func init() {
-	pulumi.RegisterOutputType(ArgFunctionResultOutput{})
+func (o ArgFunctionResultOutput) Result() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[*ArgFunctionResult](o, func(v *ArgFunctionResult) *Resource { return v.Result })
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{
+		OutputState: value.OutputState,
+	}
 }
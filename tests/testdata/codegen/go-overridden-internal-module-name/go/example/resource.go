@@ -8,14 +8,15 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-overridden-internal-module-name/example/utilities"
 )
 
 type Resource struct {
 	pulumi.CustomResourceState
 
-	Bar pulumi.StringPtrOutput `pulumi:"bar"`
-	Baz pulumi.StringPtrOutput `pulumi:"baz"`
+	Bar pulumix.Output[*string] `pulumi:"bar"`
+	Baz pulumix.Output[*string] `pulumi:"baz"`
 }
 
 // NewResource registers a new resource with the given unique name, arguments, and options.
@@ -26,7 +27,8 @@ func NewResource(ctx *pulumi.Context,
 	}
 
 	if args.Bar != nil {
-		args.Bar = pulumi.ToSecret(args.Bar).(pulumi.StringPtrInput)
+		untypedSecretValue := pulumi.ToSecret(args.Bar.ToOutput(ctx.Context()).Untyped())
+		args.Bar = pulumix.MustConvertTyped[*string](untypedSecretValue)
 	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
 		"bar",
@@ -71,36 +73,17 @@ type resourceArgs struct {
 
 // The set of arguments for constructing a Resource resource.
 type ResourceArgs struct {
-	Bar pulumi.StringPtrInput
+	Bar pulumix.Input[*string]
 }
 
 func (ResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*resourceArgs)(nil)).Elem()
 }
 
-type ResourceInput interface {
-	pulumi.Input
-
-	ToResourceOutput() ResourceOutput
-	ToResourceOutputWithContext(ctx context.Context) ResourceOutput
-}
-
-func (*Resource) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
-}
-
-func (i *Resource) ToResourceOutput() ResourceOutput {
-	return i.ToResourceOutputWithContext(context.Background())
-}
-
-func (i *Resource) ToResourceOutputWithContext(ctx context.Context) ResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ResourceOutput)
-}
-
 type ResourceOutput struct{ *pulumi.OutputState }
 
 func (ResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
+	return reflect.TypeOf((*Resource)(nil)).Elem()
 }
 
 func (o ResourceOutput) ToResourceOutput() ResourceOutput {
@@ -111,15 +94,22 @@ func (o ResourceOutput) ToResourceOutputWithContext(ctx context.Context) Resourc
 	return o
 }
 
-func (o ResourceOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Resource) pulumi.StringPtrOutput { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ResourceOutput) ToOutput(ctx context.Context) pulumix.Output[Resource] {
+	return pulumix.Output[Resource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResourceOutput) Bar() pulumix.Output[*string] {
+	value := pulumix.Apply[Resource](o, func(v Resource) pulumix.Output[*string] { return v.Bar })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
-func (o ResourceOutput) Baz() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Resource) pulumi.StringPtrOutput { return v.Baz }).(pulumi.StringPtrOutput)
+func (o ResourceOutput) Baz() pulumix.Output[*string] {
+	value := pulumix.Apply[Resource](o, func(v Resource) pulumix.Output[*string] { return v.Baz })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ResourceInput)(nil)).Elem(), &Resource{})
 	pulumi.RegisterOutputType(ResourceOutput{})
 }
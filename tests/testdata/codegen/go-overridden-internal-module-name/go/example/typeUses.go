@@ -8,15 +8,16 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"go-overridden-internal-module-name/example/utilities"
 )
 
 type TypeUses struct {
 	pulumi.CustomResourceState
 
-	Bar SomeOtherObjectPtrOutput              `pulumi:"bar"`
-	Baz ObjectWithNodeOptionalInputsPtrOutput `pulumi:"baz"`
-	Foo ObjectPtrOutput                       `pulumi:"foo"`
+	Bar pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]                           `pulumi:"bar"`
+	Baz pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] `pulumi:"baz"`
+	Foo pulumix.GPtrOutput[Object, ObjectOutput]                                             `pulumi:"foo"`
 }
 
 // NewTypeUses registers a new resource with the given unique name, arguments, and options.
@@ -66,38 +67,19 @@ type typeUsesArgs struct {
 
 // The set of arguments for constructing a TypeUses resource.
 type TypeUsesArgs struct {
-	Bar SomeOtherObjectPtrInput
-	Baz ObjectWithNodeOptionalInputsPtrInput
-	Foo ObjectPtrInput
+	Bar pulumix.Input[*SomeOtherObjectArgs]
+	Baz pulumix.Input[*ObjectWithNodeOptionalInputsArgs]
+	Foo pulumix.Input[*ObjectArgs]
 }
 
 func (TypeUsesArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*typeUsesArgs)(nil)).Elem()
 }
 
-type TypeUsesInput interface {
-	pulumi.Input
-
-	ToTypeUsesOutput() TypeUsesOutput
-	ToTypeUsesOutputWithContext(ctx context.Context) TypeUsesOutput
-}
-
-func (*TypeUses) ElementType() reflect.Type {
-	return reflect.TypeOf((**TypeUses)(nil)).Elem()
-}
-
-func (i *TypeUses) ToTypeUsesOutput() TypeUsesOutput {
-	return i.ToTypeUsesOutputWithContext(context.Background())
-}
-
-func (i *TypeUses) ToTypeUsesOutputWithContext(ctx context.Context) TypeUsesOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypeUsesOutput)
-}
-
 type TypeUsesOutput struct{ *pulumi.OutputState }
 
 func (TypeUsesOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**TypeUses)(nil)).Elem()
+	return reflect.TypeOf((*TypeUses)(nil)).Elem()
 }
 
 func (o TypeUsesOutput) ToTypeUsesOutput() TypeUsesOutput {
@@ -108,19 +90,32 @@ func (o TypeUsesOutput) ToTypeUsesOutputWithContext(ctx context.Context) TypeUse
 	return o
 }
 
-func (o TypeUsesOutput) Bar() SomeOtherObjectPtrOutput {
-	return o.ApplyT(func(v *TypeUses) SomeOtherObjectPtrOutput { return v.Bar }).(SomeOtherObjectPtrOutput)
+func (o TypeUsesOutput) ToOutput(ctx context.Context) pulumix.Output[TypeUses] {
+	return pulumix.Output[TypeUses]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o TypeUsesOutput) Bar() pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput] { return v.Bar })
+	unwrapped := pulumix.Flatten[*SomeOtherObject, pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]](value)
+	return pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Baz() ObjectWithNodeOptionalInputsPtrOutput {
-	return o.ApplyT(func(v *TypeUses) ObjectWithNodeOptionalInputsPtrOutput { return v.Baz }).(ObjectWithNodeOptionalInputsPtrOutput)
+func (o TypeUsesOutput) Baz() pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] {
+		return v.Baz
+	})
+	unwrapped := pulumix.Flatten[*ObjectWithNodeOptionalInputs, pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput]](value)
+	return pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Foo() ObjectPtrOutput {
-	return o.ApplyT(func(v *TypeUses) ObjectPtrOutput { return v.Foo }).(ObjectPtrOutput)
+func (o TypeUsesOutput) Foo() pulumix.GPtrOutput[Object, ObjectOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[Object, ObjectOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Object, pulumix.GPtrOutput[Object, ObjectOutput]](value)
+	return pulumix.GPtrOutput[Object, ObjectOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*TypeUsesInput)(nil)).Elem(), &TypeUses{})
 	pulumi.RegisterOutputType(TypeUsesOutput{})
 }
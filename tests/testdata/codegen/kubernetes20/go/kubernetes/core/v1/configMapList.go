@@ -11,6 +11,7 @@ import (
 	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/meta/v1"
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 // A Kubernetes list resource.
@@ -18,13 +19,13 @@ type ConfigMapList struct {
 	pulumi.CustomResourceState
 
 	// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-	ApiVersion pulumi.StringOutput `pulumi:"apiVersion"`
+	ApiVersion pulumix.Output[string] `pulumi:"apiVersion"`
 	// Items is the list of ConfigMaps.
-	Items ConfigMapTypeArrayOutput `pulumi:"items"`
+	Items pulumix.GArrayOutput[ConfigMapType, ConfigMapTypeOutput] `pulumi:"items"`
 	// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind pulumi.StringOutput `pulumi:"kind"`
+	Kind pulumix.Output[string] `pulumi:"kind"`
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-	Metadata metav1.ListMetaOutput `pulumi:"metadata"`
+	Metadata pulumix.GPtrOutput[metav1.ListMeta, metav1.ListMetaOutput] `pulumi:"metadata"`
 }
 
 // NewConfigMapList registers a new resource with the given unique name, arguments, and options.
@@ -37,8 +38,8 @@ func NewConfigMapList(ctx *pulumi.Context,
 	if args.Items == nil {
 		return nil, errors.New("invalid value for required argument 'Items'")
 	}
-	args.ApiVersion = pulumi.StringPtr("v1")
-	args.Kind = pulumi.StringPtr("ConfigMapList")
+	args.ApiVersion = pulumix.Ptr("v1")
+	args.Kind = pulumix.Ptr("ConfigMapList")
 	opts = utilities.PkgResourceDefaultOpts(opts)
 	var resource ConfigMapList
 	err := ctx.RegisterResource("kubernetes:core/v1:ConfigMapList", name, args, &resource, opts...)
@@ -85,92 +86,23 @@ type configMapListArgs struct {
 // The set of arguments for constructing a ConfigMapList resource.
 type ConfigMapListArgs struct {
 	// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-	ApiVersion pulumi.StringPtrInput
+	ApiVersion pulumix.Input[*string]
 	// Items is the list of ConfigMaps.
-	Items ConfigMapTypeArrayInput
+	Items pulumix.Input[[]*ConfigMapTypeArgs]
 	// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind pulumi.StringPtrInput
+	Kind pulumix.Input[*string]
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-	Metadata metav1.ListMetaPtrInput
+	Metadata pulumix.Input[*metav1.ListMetaArgs]
 }
 
 func (ConfigMapListArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*configMapListArgs)(nil)).Elem()
 }
 
-type ConfigMapListInput interface {
-	pulumi.Input
-
-	ToConfigMapListOutput() ConfigMapListOutput
-	ToConfigMapListOutputWithContext(ctx context.Context) ConfigMapListOutput
-}
-
-func (*ConfigMapList) ElementType() reflect.Type {
-	return reflect.TypeOf((**ConfigMapList)(nil)).Elem()
-}
-
-func (i *ConfigMapList) ToConfigMapListOutput() ConfigMapListOutput {
-	return i.ToConfigMapListOutputWithContext(context.Background())
-}
-
-func (i *ConfigMapList) ToConfigMapListOutputWithContext(ctx context.Context) ConfigMapListOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapListOutput)
-}
-
-// ConfigMapListArrayInput is an input type that accepts ConfigMapListArray and ConfigMapListArrayOutput values.
-// You can construct a concrete instance of `ConfigMapListArrayInput` via:
-//
-//	ConfigMapListArray{ ConfigMapListArgs{...} }
-type ConfigMapListArrayInput interface {
-	pulumi.Input
-
-	ToConfigMapListArrayOutput() ConfigMapListArrayOutput
-	ToConfigMapListArrayOutputWithContext(context.Context) ConfigMapListArrayOutput
-}
-
-type ConfigMapListArray []ConfigMapListInput
-
-func (ConfigMapListArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ConfigMapList)(nil)).Elem()
-}
-
-func (i ConfigMapListArray) ToConfigMapListArrayOutput() ConfigMapListArrayOutput {
-	return i.ToConfigMapListArrayOutputWithContext(context.Background())
-}
-
-func (i ConfigMapListArray) ToConfigMapListArrayOutputWithContext(ctx context.Context) ConfigMapListArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapListArrayOutput)
-}
-
-// ConfigMapListMapInput is an input type that accepts ConfigMapListMap and ConfigMapListMapOutput values.
-// You can construct a concrete instance of `ConfigMapListMapInput` via:
-//
-//	ConfigMapListMap{ "key": ConfigMapListArgs{...} }
-type ConfigMapListMapInput interface {
-	pulumi.Input
-
-	ToConfigMapListMapOutput() ConfigMapListMapOutput
-	ToConfigMapListMapOutputWithContext(context.Context) ConfigMapListMapOutput
-}
-
-type ConfigMapListMap map[string]ConfigMapListInput
-
-func (ConfigMapListMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ConfigMapList)(nil)).Elem()
-}
-
-func (i ConfigMapListMap) ToConfigMapListMapOutput() ConfigMapListMapOutput {
-	return i.ToConfigMapListMapOutputWithContext(context.Background())
-}
-
-func (i ConfigMapListMap) ToConfigMapListMapOutputWithContext(ctx context.Context) ConfigMapListMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapListMapOutput)
-}
-
 type ConfigMapListOutput struct{ *pulumi.OutputState }
 
 func (ConfigMapListOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ConfigMapList)(nil)).Elem()
+	return reflect.TypeOf((*ConfigMapList)(nil)).Elem()
 }
 
 func (o ConfigMapListOutput) ToConfigMapListOutput() ConfigMapListOutput {
@@ -181,71 +113,38 @@ func (o ConfigMapListOutput) ToConfigMapListOutputWithContext(ctx context.Contex
 	return o
 }
 
+func (o ConfigMapListOutput) ToOutput(ctx context.Context) pulumix.Output[ConfigMapList] {
+	return pulumix.Output[ConfigMapList]{
+		OutputState: o.OutputState,
+	}
+}
+
 // APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (o ConfigMapListOutput) ApiVersion() pulumi.StringOutput {
-	return o.ApplyT(func(v *ConfigMapList) pulumi.StringOutput { return v.ApiVersion }).(pulumi.StringOutput)
+func (o ConfigMapListOutput) ApiVersion() pulumix.Output[string] {
+	value := pulumix.Apply[ConfigMapList](o, func(v ConfigMapList) pulumix.Output[string] { return v.ApiVersion })
+	return pulumix.Flatten[string, pulumix.Output[string]](value)
 }
 
 // Items is the list of ConfigMaps.
-func (o ConfigMapListOutput) Items() ConfigMapTypeArrayOutput {
-	return o.ApplyT(func(v *ConfigMapList) ConfigMapTypeArrayOutput { return v.Items }).(ConfigMapTypeArrayOutput)
+func (o ConfigMapListOutput) Items() pulumix.GArrayOutput[ConfigMapType, ConfigMapTypeOutput] {
+	value := pulumix.Apply[ConfigMapList](o, func(v ConfigMapList) pulumix.GArrayOutput[ConfigMapType, ConfigMapTypeOutput] { return v.Items })
+	unwrapped := pulumix.Flatten[[]ConfigMapType, pulumix.GArrayOutput[ConfigMapType, ConfigMapTypeOutput]](value)
+	return pulumix.GArrayOutput[ConfigMapType, ConfigMapTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
 // Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (o ConfigMapListOutput) Kind() pulumi.StringOutput {
-	return o.ApplyT(func(v *ConfigMapList) pulumi.StringOutput { return v.Kind }).(pulumi.StringOutput)
+func (o ConfigMapListOutput) Kind() pulumix.Output[string] {
+	value := pulumix.Apply[ConfigMapList](o, func(v ConfigMapList) pulumix.Output[string] { return v.Kind })
+	return pulumix.Flatten[string, pulumix.Output[string]](value)
 }
 
 // More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-func (o ConfigMapListOutput) Metadata() metav1.ListMetaOutput {
-	return o.ApplyT(func(v *ConfigMapList) metav1.ListMetaOutput { return v.Metadata }).(metav1.ListMetaOutput)
-}
-
-type ConfigMapListArrayOutput struct{ *pulumi.OutputState }
-
-func (ConfigMapListArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ConfigMapList)(nil)).Elem()
-}
-
-func (o ConfigMapListArrayOutput) ToConfigMapListArrayOutput() ConfigMapListArrayOutput {
-	return o
-}
-
-func (o ConfigMapListArrayOutput) ToConfigMapListArrayOutputWithContext(ctx context.Context) ConfigMapListArrayOutput {
-	return o
-}
-
-func (o ConfigMapListArrayOutput) Index(i pulumi.IntInput) ConfigMapListOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ConfigMapList {
-		return vs[0].([]*ConfigMapList)[vs[1].(int)]
-	}).(ConfigMapListOutput)
-}
-
-type ConfigMapListMapOutput struct{ *pulumi.OutputState }
-
-func (ConfigMapListMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ConfigMapList)(nil)).Elem()
-}
-
-func (o ConfigMapListMapOutput) ToConfigMapListMapOutput() ConfigMapListMapOutput {
-	return o
-}
-
-func (o ConfigMapListMapOutput) ToConfigMapListMapOutputWithContext(ctx context.Context) ConfigMapListMapOutput {
-	return o
-}
-
-func (o ConfigMapListMapOutput) MapIndex(k pulumi.StringInput) ConfigMapListOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ConfigMapList {
-		return vs[0].(map[string]*ConfigMapList)[vs[1].(string)]
-	}).(ConfigMapListOutput)
+func (o ConfigMapListOutput) Metadata() pulumix.GPtrOutput[metav1.ListMeta, metav1.ListMetaOutput] {
+	value := pulumix.Apply[ConfigMapList](o, func(v ConfigMapList) pulumix.GPtrOutput[metav1.ListMeta, metav1.ListMetaOutput] { return v.Metadata })
+	unwrapped := pulumix.Flatten[*metav1.ListMeta, pulumix.GPtrOutput[metav1.ListMeta, metav1.ListMetaOutput]](value)
+	return pulumix.GPtrOutput[metav1.ListMeta, metav1.ListMetaOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapListInput)(nil)).Elem(), &ConfigMapList{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapListArrayInput)(nil)).Elem(), ConfigMapListArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapListMapInput)(nil)).Elem(), ConfigMapListMap{})
 	pulumi.RegisterOutputType(ConfigMapListOutput{})
-	pulumi.RegisterOutputType(ConfigMapListArrayOutput{})
-	pulumi.RegisterOutputType(ConfigMapListMapOutput{})
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 // A non-overlay, non-component, non-Kubernetes resource.
@@ -17,11 +18,11 @@ type Release struct {
 	pulumi.CustomResourceState
 
 	// Chart name to be installed. A path may be used.
-	Chart pulumi.StringOutput `pulumi:"chart"`
+	Chart pulumix.Output[string] `pulumi:"chart"`
 	// List of assets (raw yaml files). Content is read and merged with values (with values taking precedence).
-	ValueYamlFiles pulumi.AssetOrArchiveArrayOutput `pulumi:"valueYamlFiles"`
+	ValueYamlFiles pulumix.ArrayOutput[pulumi.AssetOrArchive] `pulumi:"valueYamlFiles"`
 	// Custom values set for the release.
-	Values pulumi.MapOutput `pulumi:"values"`
+	Values pulumix.MapOutput[any] `pulumi:"values"`
 }
 
 // NewRelease registers a new resource with the given unique name, arguments, and options.
@@ -78,90 +79,21 @@ type releaseArgs struct {
 // The set of arguments for constructing a Release resource.
 type ReleaseArgs struct {
 	// Chart name to be installed. A path may be used.
-	Chart pulumi.StringInput
+	Chart pulumix.Input[string]
 	// List of assets (raw yaml files). Content is read and merged with values.
-	ValueYamlFiles pulumi.AssetOrArchiveArrayInput
+	ValueYamlFiles pulumix.Input[[]pulumi.AssetOrArchive]
 	// Custom values set for the release.
-	Values pulumi.MapInput
+	Values pulumix.Input[map[string]any]
 }
 
 func (ReleaseArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*releaseArgs)(nil)).Elem()
 }
 
-type ReleaseInput interface {
-	pulumi.Input
-
-	ToReleaseOutput() ReleaseOutput
-	ToReleaseOutputWithContext(ctx context.Context) ReleaseOutput
-}
-
-func (*Release) ElementType() reflect.Type {
-	return reflect.TypeOf((**Release)(nil)).Elem()
-}
-
-func (i *Release) ToReleaseOutput() ReleaseOutput {
-	return i.ToReleaseOutputWithContext(context.Background())
-}
-
-func (i *Release) ToReleaseOutputWithContext(ctx context.Context) ReleaseOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ReleaseOutput)
-}
-
-// ReleaseArrayInput is an input type that accepts ReleaseArray and ReleaseArrayOutput values.
-// You can construct a concrete instance of `ReleaseArrayInput` via:
-//
-//	ReleaseArray{ ReleaseArgs{...} }
-type ReleaseArrayInput interface {
-	pulumi.Input
-
-	ToReleaseArrayOutput() ReleaseArrayOutput
-	ToReleaseArrayOutputWithContext(context.Context) ReleaseArrayOutput
-}
-
-type ReleaseArray []ReleaseInput
-
-func (ReleaseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Release)(nil)).Elem()
-}
-
-func (i ReleaseArray) ToReleaseArrayOutput() ReleaseArrayOutput {
-	return i.ToReleaseArrayOutputWithContext(context.Background())
-}
-
-func (i ReleaseArray) ToReleaseArrayOutputWithContext(ctx context.Context) ReleaseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ReleaseArrayOutput)
-}
-
-// ReleaseMapInput is an input type that accepts ReleaseMap and ReleaseMapOutput values.
-// You can construct a concrete instance of `ReleaseMapInput` via:
-//
-//	ReleaseMap{ "key": ReleaseArgs{...} }
-type ReleaseMapInput interface {
-	pulumi.Input
-
-	ToReleaseMapOutput() ReleaseMapOutput
-	ToReleaseMapOutputWithContext(context.Context) ReleaseMapOutput
-}
-
-type ReleaseMap map[string]ReleaseInput
-
-func (ReleaseMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Release)(nil)).Elem()
-}
-
-func (i ReleaseMap) ToReleaseMapOutput() ReleaseMapOutput {
-	return i.ToReleaseMapOutputWithContext(context.Background())
-}
-
-func (i ReleaseMap) ToReleaseMapOutputWithContext(ctx context.Context) ReleaseMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ReleaseMapOutput)
-}
-
 type ReleaseOutput struct{ *pulumi.OutputState }
 
 func (ReleaseOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Release)(nil)).Elem()
+	return reflect.TypeOf((*Release)(nil)).Elem()
 }
 
 func (o ReleaseOutput) ToReleaseOutput() ReleaseOutput {
@@ -172,66 +104,32 @@ func (o ReleaseOutput) ToReleaseOutputWithContext(ctx context.Context) ReleaseOu
 	return o
 }
 
+func (o ReleaseOutput) ToOutput(ctx context.Context) pulumix.Output[Release] {
+	return pulumix.Output[Release]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Chart name to be installed. A path may be used.
-func (o ReleaseOutput) Chart() pulumi.StringOutput {
-	return o.ApplyT(func(v *Release) pulumi.StringOutput { return v.Chart }).(pulumi.StringOutput)
+func (o ReleaseOutput) Chart() pulumix.Output[string] {
+	value := pulumix.Apply[Release](o, func(v Release) pulumix.Output[string] { return v.Chart })
+	return pulumix.Flatten[string, pulumix.Output[string]](value)
 }
 
 // List of assets (raw yaml files). Content is read and merged with values (with values taking precedence).
-func (o ReleaseOutput) ValueYamlFiles() pulumi.AssetOrArchiveArrayOutput {
-	return o.ApplyT(func(v *Release) pulumi.AssetOrArchiveArrayOutput { return v.ValueYamlFiles }).(pulumi.AssetOrArchiveArrayOutput)
+func (o ReleaseOutput) ValueYamlFiles() pulumix.ArrayOutput[pulumi.AssetOrArchive] {
+	value := pulumix.Apply[Release](o, func(v Release) pulumix.ArrayOutput[pulumi.AssetOrArchive] { return v.ValueYamlFiles })
+	unwrapped := pulumix.Flatten[[]pulumi.AssetOrArchive, pulumix.ArrayOutput[pulumi.AssetOrArchive]](value)
+	return pulumix.ArrayOutput[pulumi.AssetOrArchive]{OutputState: unwrapped.OutputState}
 }
 
 // Custom values set for the release.
-func (o ReleaseOutput) Values() pulumi.MapOutput {
-	return o.ApplyT(func(v *Release) pulumi.MapOutput { return v.Values }).(pulumi.MapOutput)
-}
-
-type ReleaseArrayOutput struct{ *pulumi.OutputState }
-
-func (ReleaseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Release)(nil)).Elem()
-}
-
-func (o ReleaseArrayOutput) ToReleaseArrayOutput() ReleaseArrayOutput {
-	return o
-}
-
-func (o ReleaseArrayOutput) ToReleaseArrayOutputWithContext(ctx context.Context) ReleaseArrayOutput {
-	return o
-}
-
-func (o ReleaseArrayOutput) Index(i pulumi.IntInput) ReleaseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Release {
-		return vs[0].([]*Release)[vs[1].(int)]
-	}).(ReleaseOutput)
-}
-
-type ReleaseMapOutput struct{ *pulumi.OutputState }
-
-func (ReleaseMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Release)(nil)).Elem()
-}
-
-func (o ReleaseMapOutput) ToReleaseMapOutput() ReleaseMapOutput {
-	return o
-}
-
-func (o ReleaseMapOutput) ToReleaseMapOutputWithContext(ctx context.Context) ReleaseMapOutput {
-	return o
-}
-
-func (o ReleaseMapOutput) MapIndex(k pulumi.StringInput) ReleaseOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Release {
-		return vs[0].(map[string]*Release)[vs[1].(string)]
-	}).(ReleaseOutput)
+func (o ReleaseOutput) Values() pulumix.MapOutput[any] {
+	value := pulumix.Apply[Release](o, func(v Release) pulumix.MapOutput[any] { return v.Values })
+	unwrapped := pulumix.Flatten[map[string]interface{}, pulumix.MapOutput[any]](value)
+	return pulumix.MapOutput[any]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ReleaseInput)(nil)).Elem(), &Release{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ReleaseArrayInput)(nil)).Elem(), ReleaseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ReleaseMapInput)(nil)).Elem(), ReleaseMap{})
 	pulumi.RegisterOutputType(ReleaseOutput{})
-	pulumi.RegisterOutputType(ReleaseArrayOutput{})
-	pulumi.RegisterOutputType(ReleaseMapOutput{})
 }
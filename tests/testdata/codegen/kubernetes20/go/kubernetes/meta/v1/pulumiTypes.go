@@ -9,6 +9,7 @@ import (
 
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = utilities.GetEnvOrDefault
@@ -25,27 +26,16 @@ type ListMeta struct {
 	SelfLink *string `pulumi:"selfLink"`
 }
 
-// ListMetaInput is an input type that accepts ListMetaArgs and ListMetaOutput values.
-// You can construct a concrete instance of `ListMetaInput` via:
-//
-//	ListMetaArgs{...}
-type ListMetaInput interface {
-	pulumi.Input
-
-	ToListMetaOutput() ListMetaOutput
-	ToListMetaOutputWithContext(context.Context) ListMetaOutput
-}
-
 // ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
 type ListMetaArgs struct {
 	// continue may be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
-	Continue pulumi.StringPtrInput `pulumi:"continue"`
+	Continue pulumix.Input[*string] `pulumi:"continue"`
 	// remainingItemCount is the number of subsequent items in the list which are not included in this list response. If the list request contained label or field selectors, then the number of remaining items is unknown and the field will be left unset and omitted during serialization. If the list is complete (either because it is not chunking or because this is the last chunk), then there are no more remaining items and this field will be left unset and omitted during serialization. Servers older than v1.15 do not set this field. The intended use of the remainingItemCount is *estimating* the size of a collection. Clients should not rely on the remainingItemCount to be set or to be exact.
-	RemainingItemCount pulumi.IntPtrInput `pulumi:"remainingItemCount"`
+	RemainingItemCount pulumix.Input[*int] `pulumi:"remainingItemCount"`
 	// String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-	ResourceVersion pulumi.StringPtrInput `pulumi:"resourceVersion"`
+	ResourceVersion pulumix.Input[*string] `pulumi:"resourceVersion"`
 	// Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-	SelfLink pulumi.StringPtrInput `pulumi:"selfLink"`
+	SelfLink pulumix.Input[*string] `pulumi:"selfLink"`
 }
 
 func (ListMetaArgs) ElementType() reflect.Type {
@@ -60,45 +50,8 @@ func (i ListMetaArgs) ToListMetaOutputWithContext(ctx context.Context) ListMetaO
 	return pulumi.ToOutputWithContext(ctx, i).(ListMetaOutput)
 }
 
-func (i ListMetaArgs) ToListMetaPtrOutput() ListMetaPtrOutput {
-	return i.ToListMetaPtrOutputWithContext(context.Background())
-}
-
-func (i ListMetaArgs) ToListMetaPtrOutputWithContext(ctx context.Context) ListMetaPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ListMetaOutput).ToListMetaPtrOutputWithContext(ctx)
-}
-
-// ListMetaPtrInput is an input type that accepts ListMetaArgs, ListMetaPtr and ListMetaPtrOutput values.
-// You can construct a concrete instance of `ListMetaPtrInput` via:
-//
-//	        ListMetaArgs{...}
-//
-//	or:
-//
-//	        nil
-type ListMetaPtrInput interface {
-	pulumi.Input
-
-	ToListMetaPtrOutput() ListMetaPtrOutput
-	ToListMetaPtrOutputWithContext(context.Context) ListMetaPtrOutput
-}
-
-type listMetaPtrType ListMetaArgs
-
-func ListMetaPtr(v *ListMetaArgs) ListMetaPtrInput {
-	return (*listMetaPtrType)(v)
-}
-
-func (*listMetaPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ListMeta)(nil)).Elem()
-}
-
-func (i *listMetaPtrType) ToListMetaPtrOutput() ListMetaPtrOutput {
-	return i.ToListMetaPtrOutputWithContext(context.Background())
-}
-
-func (i *listMetaPtrType) ToListMetaPtrOutputWithContext(ctx context.Context) ListMetaPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ListMetaPtrOutput)
+func (i *ListMetaArgs) ToOutput(ctx context.Context) pulumix.Output[*ListMetaArgs] {
+	return pulumix.Val(i)
 }
 
 // ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
@@ -116,98 +69,30 @@ func (o ListMetaOutput) ToListMetaOutputWithContext(ctx context.Context) ListMet
 	return o
 }
 
-func (o ListMetaOutput) ToListMetaPtrOutput() ListMetaPtrOutput {
-	return o.ToListMetaPtrOutputWithContext(context.Background())
-}
-
-func (o ListMetaOutput) ToListMetaPtrOutputWithContext(ctx context.Context) ListMetaPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ListMeta) *ListMeta {
-		return &v
-	}).(ListMetaPtrOutput)
+func (o ListMetaOutput) ToOutput(ctx context.Context) pulumix.Output[ListMeta] {
+	return pulumix.Output[ListMeta]{
+		OutputState: o.OutputState,
+	}
 }
 
 // continue may be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
-func (o ListMetaOutput) Continue() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ListMeta) *string { return v.Continue }).(pulumi.StringPtrOutput)
+func (o ListMetaOutput) Continue() pulumix.Output[*string] {
+	return pulumix.Apply[ListMeta](o, func(v ListMeta) *string { return v.Continue })
 }
 
 // remainingItemCount is the number of subsequent items in the list which are not included in this list response. If the list request contained label or field selectors, then the number of remaining items is unknown and the field will be left unset and omitted during serialization. If the list is complete (either because it is not chunking or because this is the last chunk), then there are no more remaining items and this field will be left unset and omitted during serialization. Servers older than v1.15 do not set this field. The intended use of the remainingItemCount is *estimating* the size of a collection. Clients should not rely on the remainingItemCount to be set or to be exact.
-func (o ListMetaOutput) RemainingItemCount() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ListMeta) *int { return v.RemainingItemCount }).(pulumi.IntPtrOutput)
+func (o ListMetaOutput) RemainingItemCount() pulumix.Output[*int] {
+	return pulumix.Apply[ListMeta](o, func(v ListMeta) *int { return v.RemainingItemCount })
 }
 
 // String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-func (o ListMetaOutput) ResourceVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ListMeta) *string { return v.ResourceVersion }).(pulumi.StringPtrOutput)
+func (o ListMetaOutput) ResourceVersion() pulumix.Output[*string] {
+	return pulumix.Apply[ListMeta](o, func(v ListMeta) *string { return v.ResourceVersion })
 }
 
 // Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-func (o ListMetaOutput) SelfLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ListMeta) *string { return v.SelfLink }).(pulumi.StringPtrOutput)
-}
-
-type ListMetaPtrOutput struct{ *pulumi.OutputState }
-
-func (ListMetaPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ListMeta)(nil)).Elem()
-}
-
-func (o ListMetaPtrOutput) ToListMetaPtrOutput() ListMetaPtrOutput {
-	return o
-}
-
-func (o ListMetaPtrOutput) ToListMetaPtrOutputWithContext(ctx context.Context) ListMetaPtrOutput {
-	return o
-}
-
-func (o ListMetaPtrOutput) Elem() ListMetaOutput {
-	return o.ApplyT(func(v *ListMeta) ListMeta {
-		if v != nil {
-			return *v
-		}
-		var ret ListMeta
-		return ret
-	}).(ListMetaOutput)
-}
-
-// continue may be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
-func (o ListMetaPtrOutput) Continue() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ListMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Continue
-	}).(pulumi.StringPtrOutput)
-}
-
-// remainingItemCount is the number of subsequent items in the list which are not included in this list response. If the list request contained label or field selectors, then the number of remaining items is unknown and the field will be left unset and omitted during serialization. If the list is complete (either because it is not chunking or because this is the last chunk), then there are no more remaining items and this field will be left unset and omitted during serialization. Servers older than v1.15 do not set this field. The intended use of the remainingItemCount is *estimating* the size of a collection. Clients should not rely on the remainingItemCount to be set or to be exact.
-func (o ListMetaPtrOutput) RemainingItemCount() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ListMeta) *int {
-		if v == nil {
-			return nil
-		}
-		return v.RemainingItemCount
-	}).(pulumi.IntPtrOutput)
-}
-
-// String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-func (o ListMetaPtrOutput) ResourceVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ListMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ResourceVersion
-	}).(pulumi.StringPtrOutput)
-}
-
-// Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-func (o ListMetaPtrOutput) SelfLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ListMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.SelfLink
-	}).(pulumi.StringPtrOutput)
+func (o ListMetaOutput) SelfLink() pulumix.Output[*string] {
+	return pulumix.Apply[ListMeta](o, func(v ListMeta) *string { return v.SelfLink })
 }
 
 // ManagedFieldsEntry is a workflow-id, a FieldSet and the group version of the resource that the fieldset applies to.
@@ -217,7 +102,7 @@ type ManagedFieldsEntry struct {
 	// FieldsType is the discriminator for the different fields format and version. There is currently only one possible value: "FieldsV1"
 	FieldsType *string `pulumi:"fieldsType"`
 	// FieldsV1 holds the first JSON version format as described in the "FieldsV1" type.
-	FieldsV1 interface{} `pulumi:"fieldsV1"`
+	FieldsV1 *any `pulumi:"fieldsV1"`
 	// Manager is an identifier of the workflow managing these fields.
 	Manager *string `pulumi:"manager"`
 	// Operation is the type of operation which lead to this ManagedFieldsEntry being created. The only valid values for this field are 'Apply' and 'Update'.
@@ -228,33 +113,22 @@ type ManagedFieldsEntry struct {
 	Time *string `pulumi:"time"`
 }
 
-// ManagedFieldsEntryInput is an input type that accepts ManagedFieldsEntryArgs and ManagedFieldsEntryOutput values.
-// You can construct a concrete instance of `ManagedFieldsEntryInput` via:
-//
-//	ManagedFieldsEntryArgs{...}
-type ManagedFieldsEntryInput interface {
-	pulumi.Input
-
-	ToManagedFieldsEntryOutput() ManagedFieldsEntryOutput
-	ToManagedFieldsEntryOutputWithContext(context.Context) ManagedFieldsEntryOutput
-}
-
 // ManagedFieldsEntry is a workflow-id, a FieldSet and the group version of the resource that the fieldset applies to.
 type ManagedFieldsEntryArgs struct {
 	// APIVersion defines the version of this resource that this field set applies to. The format is "group/version" just like the top-level APIVersion field. It is necessary to track the version of a field set because it cannot be automatically converted.
-	ApiVersion pulumi.StringPtrInput `pulumi:"apiVersion"`
+	ApiVersion pulumix.Input[*string] `pulumi:"apiVersion"`
 	// FieldsType is the discriminator for the different fields format and version. There is currently only one possible value: "FieldsV1"
-	FieldsType pulumi.StringPtrInput `pulumi:"fieldsType"`
+	FieldsType pulumix.Input[*string] `pulumi:"fieldsType"`
 	// FieldsV1 holds the first JSON version format as described in the "FieldsV1" type.
-	FieldsV1 pulumi.Input `pulumi:"fieldsV1"`
+	FieldsV1 pulumix.Input[*any] `pulumi:"fieldsV1"`
 	// Manager is an identifier of the workflow managing these fields.
-	Manager pulumi.StringPtrInput `pulumi:"manager"`
+	Manager pulumix.Input[*string] `pulumi:"manager"`
 	// Operation is the type of operation which lead to this ManagedFieldsEntry being created. The only valid values for this field are 'Apply' and 'Update'.
-	Operation pulumi.StringPtrInput `pulumi:"operation"`
+	Operation pulumix.Input[*string] `pulumi:"operation"`
 	// Subresource is the name of the subresource used to update that object, or empty string if the object was updated through the main resource. The value of this field is used to distinguish between managers, even if they share the same name. For example, a status update will be distinct from a regular update using the same manager name. Note that the APIVersion field is not related to the Subresource field and it always corresponds to the version of the main resource.
-	Subresource pulumi.StringPtrInput `pulumi:"subresource"`
+	Subresource pulumix.Input[*string] `pulumi:"subresource"`
 	// Time is the timestamp of when the ManagedFields entry was added. The timestamp will also be updated if a field is added, the manager changes any of the owned fields value or removes a field. The timestamp does not update when a field is removed from the entry because another manager took it over.
-	Time pulumi.StringPtrInput `pulumi:"time"`
+	Time pulumix.Input[*string] `pulumi:"time"`
 }
 
 func (ManagedFieldsEntryArgs) ElementType() reflect.Type {
@@ -269,29 +143,8 @@ func (i ManagedFieldsEntryArgs) ToManagedFieldsEntryOutputWithContext(ctx contex
 	return pulumi.ToOutputWithContext(ctx, i).(ManagedFieldsEntryOutput)
 }
 
-// ManagedFieldsEntryArrayInput is an input type that accepts ManagedFieldsEntryArray and ManagedFieldsEntryArrayOutput values.
-// You can construct a concrete instance of `ManagedFieldsEntryArrayInput` via:
-//
-//	ManagedFieldsEntryArray{ ManagedFieldsEntryArgs{...} }
-type ManagedFieldsEntryArrayInput interface {
-	pulumi.Input
-
-	ToManagedFieldsEntryArrayOutput() ManagedFieldsEntryArrayOutput
-	ToManagedFieldsEntryArrayOutputWithContext(context.Context) ManagedFieldsEntryArrayOutput
-}
-
-type ManagedFieldsEntryArray []ManagedFieldsEntryInput
-
-func (ManagedFieldsEntryArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ManagedFieldsEntry)(nil)).Elem()
-}
-
-func (i ManagedFieldsEntryArray) ToManagedFieldsEntryArrayOutput() ManagedFieldsEntryArrayOutput {
-	return i.ToManagedFieldsEntryArrayOutputWithContext(context.Background())
-}
-
-func (i ManagedFieldsEntryArray) ToManagedFieldsEntryArrayOutputWithContext(ctx context.Context) ManagedFieldsEntryArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ManagedFieldsEntryArrayOutput)
+func (i *ManagedFieldsEntryArgs) ToOutput(ctx context.Context) pulumix.Output[*ManagedFieldsEntryArgs] {
+	return pulumix.Val(i)
 }
 
 // ManagedFieldsEntry is a workflow-id, a FieldSet and the group version of the resource that the fieldset applies to.
@@ -309,59 +162,45 @@ func (o ManagedFieldsEntryOutput) ToManagedFieldsEntryOutputWithContext(ctx cont
 	return o
 }
 
+func (o ManagedFieldsEntryOutput) ToOutput(ctx context.Context) pulumix.Output[ManagedFieldsEntry] {
+	return pulumix.Output[ManagedFieldsEntry]{
+		OutputState: o.OutputState,
+	}
+}
+
 // APIVersion defines the version of this resource that this field set applies to. The format is "group/version" just like the top-level APIVersion field. It is necessary to track the version of a field set because it cannot be automatically converted.
-func (o ManagedFieldsEntryOutput) ApiVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.ApiVersion }).(pulumi.StringPtrOutput)
+func (o ManagedFieldsEntryOutput) ApiVersion() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.ApiVersion })
 }
 
 // FieldsType is the discriminator for the different fields format and version. There is currently only one possible value: "FieldsV1"
-func (o ManagedFieldsEntryOutput) FieldsType() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.FieldsType }).(pulumi.StringPtrOutput)
+func (o ManagedFieldsEntryOutput) FieldsType() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.FieldsType })
 }
 
 // FieldsV1 holds the first JSON version format as described in the "FieldsV1" type.
-func (o ManagedFieldsEntryOutput) FieldsV1() pulumi.AnyOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) interface{} { return v.FieldsV1 }).(pulumi.AnyOutput)
+func (o ManagedFieldsEntryOutput) FieldsV1() pulumix.Output[any] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *any { return v.FieldsV1 })
 }
 
 // Manager is an identifier of the workflow managing these fields.
-func (o ManagedFieldsEntryOutput) Manager() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.Manager }).(pulumi.StringPtrOutput)
+func (o ManagedFieldsEntryOutput) Manager() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.Manager })
 }
 
 // Operation is the type of operation which lead to this ManagedFieldsEntry being created. The only valid values for this field are 'Apply' and 'Update'.
-func (o ManagedFieldsEntryOutput) Operation() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.Operation }).(pulumi.StringPtrOutput)
+func (o ManagedFieldsEntryOutput) Operation() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.Operation })
 }
 
 // Subresource is the name of the subresource used to update that object, or empty string if the object was updated through the main resource. The value of this field is used to distinguish between managers, even if they share the same name. For example, a status update will be distinct from a regular update using the same manager name. Note that the APIVersion field is not related to the Subresource field and it always corresponds to the version of the main resource.
-func (o ManagedFieldsEntryOutput) Subresource() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.Subresource }).(pulumi.StringPtrOutput)
+func (o ManagedFieldsEntryOutput) Subresource() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.Subresource })
 }
 
 // Time is the timestamp of when the ManagedFields entry was added. The timestamp will also be updated if a field is added, the manager changes any of the owned fields value or removes a field. The timestamp does not update when a field is removed from the entry because another manager took it over.
-func (o ManagedFieldsEntryOutput) Time() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ManagedFieldsEntry) *string { return v.Time }).(pulumi.StringPtrOutput)
-}
-
-type ManagedFieldsEntryArrayOutput struct{ *pulumi.OutputState }
-
-func (ManagedFieldsEntryArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ManagedFieldsEntry)(nil)).Elem()
-}
-
-func (o ManagedFieldsEntryArrayOutput) ToManagedFieldsEntryArrayOutput() ManagedFieldsEntryArrayOutput {
-	return o
-}
-
-func (o ManagedFieldsEntryArrayOutput) ToManagedFieldsEntryArrayOutputWithContext(ctx context.Context) ManagedFieldsEntryArrayOutput {
-	return o
-}
-
-func (o ManagedFieldsEntryArrayOutput) Index(i pulumi.IntInput) ManagedFieldsEntryOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ManagedFieldsEntry {
-		return vs[0].([]ManagedFieldsEntry)[vs[1].(int)]
-	}).(ManagedFieldsEntryOutput)
+func (o ManagedFieldsEntryOutput) Time() pulumix.Output[*string] {
+	return pulumix.Apply[ManagedFieldsEntry](o, func(v ManagedFieldsEntry) *string { return v.Time })
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
@@ -393,7 +232,7 @@ type ObjectMeta struct {
 	// Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels
 	Labels map[string]string `pulumi:"labels"`
 	// ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow. This is mostly for internal housekeeping, and users typically shouldn't need to set or understand this field. A workflow can be the user's name, a controller's name, or the name of a specific apply path like "ci-cd". The set of fields is always in the version that the workflow used when modifying the object.
-	ManagedFields []ManagedFieldsEntry `pulumi:"managedFields"`
+	ManagedFields []*ManagedFieldsEntry `pulumi:"managedFields"`
 	// Name must be unique within a namespace. Is required when creating resources, although some resources may allow a client to request the generation of an appropriate name automatically. Name is primarily intended for creation idempotence and configuration definition. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
 	Name *string `pulumi:"name"`
 	// Namespace defines the space within which each name must be unique. An empty namespace is equivalent to the "default" namespace, but "default" is the canonical representation. Not all objects are required to be scoped to a namespace - the value of this field for those objects will be empty.
@@ -401,7 +240,7 @@ type ObjectMeta struct {
 	// Must be a DNS_LABEL. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces
 	Namespace *string `pulumi:"namespace"`
 	// List of objects depended by this object. If ALL objects in the list have been deleted, this object will be garbage collected. If this object is managed by a controller, then an entry in this list will point to this controller, with the controller field set to true. There cannot be more than one managing controller.
-	OwnerReferences []OwnerReference `pulumi:"ownerReferences"`
+	OwnerReferences []*OwnerReference `pulumi:"ownerReferences"`
 	// An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed. May be used for optimistic concurrency, change detection, and the watch operation on a resource or set of resources. Clients must treat these values as opaque and passed unmodified back to the server. They may only be valid for a particular resource or set of resources.
 	//
 	// Populated by the system. Read-only. Value must be treated as opaque by clients and . More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
@@ -414,65 +253,54 @@ type ObjectMeta struct {
 	Uid *string `pulumi:"uid"`
 }
 
-// ObjectMetaInput is an input type that accepts ObjectMetaArgs and ObjectMetaOutput values.
-// You can construct a concrete instance of `ObjectMetaInput` via:
-//
-//	ObjectMetaArgs{...}
-type ObjectMetaInput interface {
-	pulumi.Input
-
-	ToObjectMetaOutput() ObjectMetaOutput
-	ToObjectMetaOutputWithContext(context.Context) ObjectMetaOutput
-}
-
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
 type ObjectMetaArgs struct {
 	// Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations
-	Annotations pulumi.StringMapInput `pulumi:"annotations"`
+	Annotations pulumix.Input[map[string]string] `pulumi:"annotations"`
 	// The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.
-	ClusterName pulumi.StringPtrInput `pulumi:"clusterName"`
+	ClusterName pulumix.Input[*string] `pulumi:"clusterName"`
 	// CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.
 	//
 	// Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-	CreationTimestamp pulumi.StringPtrInput `pulumi:"creationTimestamp"`
+	CreationTimestamp pulumix.Input[*string] `pulumi:"creationTimestamp"`
 	// Number of seconds allowed for this object to gracefully terminate before it will be removed from the system. Only set when deletionTimestamp is also set. May only be shortened. Read-only.
-	DeletionGracePeriodSeconds pulumi.IntPtrInput `pulumi:"deletionGracePeriodSeconds"`
+	DeletionGracePeriodSeconds pulumix.Input[*int] `pulumi:"deletionGracePeriodSeconds"`
 	// DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This field is set by the server when a graceful deletion is requested by the user, and is not directly settable by a client. The resource is expected to be deleted (no longer visible from resource lists, and not reachable by name) after the time in this field, once the finalizers list is empty. As long as the finalizers list contains items, deletion is blocked. Once the deletionTimestamp is set, this value may not be unset or be set further into the future, although it may be shortened or the resource may be deleted prior to this time. For example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react by sending a graceful termination signal to the containers in the pod. After that 30 seconds, the Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup, remove the pod from the API. In the presence of network partitions, this object may still exist after this timestamp, until an administrator or automated process can determine the resource is fully terminated. If not set, graceful deletion of the object has not been requested.
 	//
 	// Populated by the system when a graceful deletion is requested. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-	DeletionTimestamp pulumi.StringPtrInput `pulumi:"deletionTimestamp"`
+	DeletionTimestamp pulumix.Input[*string] `pulumi:"deletionTimestamp"`
 	// Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. If the deletionTimestamp of the object is non-nil, entries in this list can only be removed. Finalizers may be processed and removed in any order.  Order is NOT enforced because it introduces significant risk of stuck finalizers. finalizers is a shared field, any actor with permission can reorder it. If the finalizer list is processed in order, then this can lead to a situation in which the component responsible for the first finalizer in the list is waiting for a signal (field value, external system, or other) produced by a component responsible for a finalizer later in the list, resulting in a deadlock. Without enforced ordering finalizers are free to order amongst themselves and are not vulnerable to ordering changes in the list.
-	Finalizers pulumi.StringArrayInput `pulumi:"finalizers"`
+	Finalizers pulumix.Input[[]string] `pulumi:"finalizers"`
 	// GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed. This value will also be combined with a unique suffix. The provided value has the same validation rules as the Name field, and may be truncated by the length of the suffix required to make the value unique on the server.
 	//
 	// If this field is specified and the generated name exists, the server will return a 409.
 	//
 	// Applied only if Name is not specified. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency
-	GenerateName pulumi.StringPtrInput `pulumi:"generateName"`
+	GenerateName pulumix.Input[*string] `pulumi:"generateName"`
 	// A sequence number representing a specific generation of the desired state. Populated by the system. Read-only.
-	Generation pulumi.IntPtrInput `pulumi:"generation"`
+	Generation pulumix.Input[*int] `pulumi:"generation"`
 	// Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels
-	Labels pulumi.StringMapInput `pulumi:"labels"`
+	Labels pulumix.Input[map[string]string] `pulumi:"labels"`
 	// ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow. This is mostly for internal housekeeping, and users typically shouldn't need to set or understand this field. A workflow can be the user's name, a controller's name, or the name of a specific apply path like "ci-cd". The set of fields is always in the version that the workflow used when modifying the object.
-	ManagedFields ManagedFieldsEntryArrayInput `pulumi:"managedFields"`
+	ManagedFields pulumix.Input[[]*ManagedFieldsEntryArgs] `pulumi:"managedFields"`
 	// Name must be unique within a namespace. Is required when creating resources, although some resources may allow a client to request the generation of an appropriate name automatically. Name is primarily intended for creation idempotence and configuration definition. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-	Name pulumi.StringPtrInput `pulumi:"name"`
+	Name pulumix.Input[*string] `pulumi:"name"`
 	// Namespace defines the space within which each name must be unique. An empty namespace is equivalent to the "default" namespace, but "default" is the canonical representation. Not all objects are required to be scoped to a namespace - the value of this field for those objects will be empty.
 	//
 	// Must be a DNS_LABEL. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces
-	Namespace pulumi.StringPtrInput `pulumi:"namespace"`
+	Namespace pulumix.Input[*string] `pulumi:"namespace"`
 	// List of objects depended by this object. If ALL objects in the list have been deleted, this object will be garbage collected. If this object is managed by a controller, then an entry in this list will point to this controller, with the controller field set to true. There cannot be more than one managing controller.
-	OwnerReferences OwnerReferenceArrayInput `pulumi:"ownerReferences"`
+	OwnerReferences pulumix.Input[[]*OwnerReferenceArgs] `pulumi:"ownerReferences"`
 	// An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed. May be used for optimistic concurrency, change detection, and the watch operation on a resource or set of resources. Clients must treat these values as opaque and passed unmodified back to the server. They may only be valid for a particular resource or set of resources.
 	//
 	// Populated by the system. Read-only. Value must be treated as opaque by clients and . More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-	ResourceVersion pulumi.StringPtrInput `pulumi:"resourceVersion"`
+	ResourceVersion pulumix.Input[*string] `pulumi:"resourceVersion"`
 	// Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-	SelfLink pulumi.StringPtrInput `pulumi:"selfLink"`
+	SelfLink pulumix.Input[*string] `pulumi:"selfLink"`
 	// UID is the unique in time and space value for this object. It is typically generated by the server on successful creation of a resource and is not allowed to change on PUT operations.
 	//
 	// Populated by the system. Read-only. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-	Uid pulumi.StringPtrInput `pulumi:"uid"`
+	Uid pulumix.Input[*string] `pulumi:"uid"`
 }
 
 func (ObjectMetaArgs) ElementType() reflect.Type {
@@ -487,45 +315,8 @@ func (i ObjectMetaArgs) ToObjectMetaOutputWithContext(ctx context.Context) Objec
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectMetaOutput)
 }
 
-func (i ObjectMetaArgs) ToObjectMetaPtrOutput() ObjectMetaPtrOutput {
-	return i.ToObjectMetaPtrOutputWithContext(context.Background())
-}
-
-func (i ObjectMetaArgs) ToObjectMetaPtrOutputWithContext(ctx context.Context) ObjectMetaPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectMetaOutput).ToObjectMetaPtrOutputWithContext(ctx)
-}
-
-// ObjectMetaPtrInput is an input type that accepts ObjectMetaArgs, ObjectMetaPtr and ObjectMetaPtrOutput values.
-// You can construct a concrete instance of `ObjectMetaPtrInput` via:
-//
-//	        ObjectMetaArgs{...}
-//
-//	or:
-//
-//	        nil
-type ObjectMetaPtrInput interface {
-	pulumi.Input
-
-	ToObjectMetaPtrOutput() ObjectMetaPtrOutput
-	ToObjectMetaPtrOutputWithContext(context.Context) ObjectMetaPtrOutput
-}
-
-type objectMetaPtrType ObjectMetaArgs
-
-func ObjectMetaPtr(v *ObjectMetaArgs) ObjectMetaPtrInput {
-	return (*objectMetaPtrType)(v)
-}
-
-func (*objectMetaPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ObjectMeta)(nil)).Elem()
-}
-
-func (i *objectMetaPtrType) ToObjectMetaPtrOutput() ObjectMetaPtrOutput {
-	return i.ToObjectMetaPtrOutputWithContext(context.Background())
-}
-
-func (i *objectMetaPtrType) ToObjectMetaPtrOutputWithContext(ctx context.Context) ObjectMetaPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectMetaPtrOutput)
+func (i *ObjectMetaArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectMetaArgs] {
+	return pulumix.Val(i)
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
@@ -543,196 +334,46 @@ func (o ObjectMetaOutput) ToObjectMetaOutputWithContext(ctx context.Context) Obj
 	return o
 }
 
-func (o ObjectMetaOutput) ToObjectMetaPtrOutput() ObjectMetaPtrOutput {
-	return o.ToObjectMetaPtrOutputWithContext(context.Background())
-}
-
-func (o ObjectMetaOutput) ToObjectMetaPtrOutputWithContext(ctx context.Context) ObjectMetaPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ObjectMeta) *ObjectMeta {
-		return &v
-	}).(ObjectMetaPtrOutput)
-}
-
-// Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations
-func (o ObjectMetaOutput) Annotations() pulumi.StringMapOutput {
-	return o.ApplyT(func(v ObjectMeta) map[string]string { return v.Annotations }).(pulumi.StringMapOutput)
-}
-
-// The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.
-func (o ObjectMetaOutput) ClusterName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.ClusterName }).(pulumi.StringPtrOutput)
-}
-
-// CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.
-//
-// Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-func (o ObjectMetaOutput) CreationTimestamp() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.CreationTimestamp }).(pulumi.StringPtrOutput)
-}
-
-// Number of seconds allowed for this object to gracefully terminate before it will be removed from the system. Only set when deletionTimestamp is also set. May only be shortened. Read-only.
-func (o ObjectMetaOutput) DeletionGracePeriodSeconds() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *int { return v.DeletionGracePeriodSeconds }).(pulumi.IntPtrOutput)
-}
-
-// DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This field is set by the server when a graceful deletion is requested by the user, and is not directly settable by a client. The resource is expected to be deleted (no longer visible from resource lists, and not reachable by name) after the time in this field, once the finalizers list is empty. As long as the finalizers list contains items, deletion is blocked. Once the deletionTimestamp is set, this value may not be unset or be set further into the future, although it may be shortened or the resource may be deleted prior to this time. For example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react by sending a graceful termination signal to the containers in the pod. After that 30 seconds, the Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup, remove the pod from the API. In the presence of network partitions, this object may still exist after this timestamp, until an administrator or automated process can determine the resource is fully terminated. If not set, graceful deletion of the object has not been requested.
-//
-// Populated by the system when a graceful deletion is requested. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-func (o ObjectMetaOutput) DeletionTimestamp() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.DeletionTimestamp }).(pulumi.StringPtrOutput)
-}
-
-// Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. If the deletionTimestamp of the object is non-nil, entries in this list can only be removed. Finalizers may be processed and removed in any order.  Order is NOT enforced because it introduces significant risk of stuck finalizers. finalizers is a shared field, any actor with permission can reorder it. If the finalizer list is processed in order, then this can lead to a situation in which the component responsible for the first finalizer in the list is waiting for a signal (field value, external system, or other) produced by a component responsible for a finalizer later in the list, resulting in a deadlock. Without enforced ordering finalizers are free to order amongst themselves and are not vulnerable to ordering changes in the list.
-func (o ObjectMetaOutput) Finalizers() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v ObjectMeta) []string { return v.Finalizers }).(pulumi.StringArrayOutput)
-}
-
-// GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed. This value will also be combined with a unique suffix. The provided value has the same validation rules as the Name field, and may be truncated by the length of the suffix required to make the value unique on the server.
-//
-// If this field is specified and the generated name exists, the server will return a 409.
-//
-// Applied only if Name is not specified. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency
-func (o ObjectMetaOutput) GenerateName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.GenerateName }).(pulumi.StringPtrOutput)
-}
-
-// A sequence number representing a specific generation of the desired state. Populated by the system. Read-only.
-func (o ObjectMetaOutput) Generation() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *int { return v.Generation }).(pulumi.IntPtrOutput)
-}
-
-// Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels
-func (o ObjectMetaOutput) Labels() pulumi.StringMapOutput {
-	return o.ApplyT(func(v ObjectMeta) map[string]string { return v.Labels }).(pulumi.StringMapOutput)
-}
-
-// ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow. This is mostly for internal housekeeping, and users typically shouldn't need to set or understand this field. A workflow can be the user's name, a controller's name, or the name of a specific apply path like "ci-cd". The set of fields is always in the version that the workflow used when modifying the object.
-func (o ObjectMetaOutput) ManagedFields() ManagedFieldsEntryArrayOutput {
-	return o.ApplyT(func(v ObjectMeta) []ManagedFieldsEntry { return v.ManagedFields }).(ManagedFieldsEntryArrayOutput)
-}
-
-// Name must be unique within a namespace. Is required when creating resources, although some resources may allow a client to request the generation of an appropriate name automatically. Name is primarily intended for creation idempotence and configuration definition. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-func (o ObjectMetaOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.Name }).(pulumi.StringPtrOutput)
-}
-
-// Namespace defines the space within which each name must be unique. An empty namespace is equivalent to the "default" namespace, but "default" is the canonical representation. Not all objects are required to be scoped to a namespace - the value of this field for those objects will be empty.
-//
-// Must be a DNS_LABEL. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces
-func (o ObjectMetaOutput) Namespace() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.Namespace }).(pulumi.StringPtrOutput)
-}
-
-// List of objects depended by this object. If ALL objects in the list have been deleted, this object will be garbage collected. If this object is managed by a controller, then an entry in this list will point to this controller, with the controller field set to true. There cannot be more than one managing controller.
-func (o ObjectMetaOutput) OwnerReferences() OwnerReferenceArrayOutput {
-	return o.ApplyT(func(v ObjectMeta) []OwnerReference { return v.OwnerReferences }).(OwnerReferenceArrayOutput)
-}
-
-// An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed. May be used for optimistic concurrency, change detection, and the watch operation on a resource or set of resources. Clients must treat these values as opaque and passed unmodified back to the server. They may only be valid for a particular resource or set of resources.
-//
-// Populated by the system. Read-only. Value must be treated as opaque by clients and . More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-func (o ObjectMetaOutput) ResourceVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.ResourceVersion }).(pulumi.StringPtrOutput)
-}
-
-// Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-func (o ObjectMetaOutput) SelfLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.SelfLink }).(pulumi.StringPtrOutput)
-}
-
-// UID is the unique in time and space value for this object. It is typically generated by the server on successful creation of a resource and is not allowed to change on PUT operations.
-//
-// Populated by the system. Read-only. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-func (o ObjectMetaOutput) Uid() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectMeta) *string { return v.Uid }).(pulumi.StringPtrOutput)
-}
-
-type ObjectMetaPtrOutput struct{ *pulumi.OutputState }
-
-func (ObjectMetaPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ObjectMeta)(nil)).Elem()
-}
-
-func (o ObjectMetaPtrOutput) ToObjectMetaPtrOutput() ObjectMetaPtrOutput {
-	return o
-}
-
-func (o ObjectMetaPtrOutput) ToObjectMetaPtrOutputWithContext(ctx context.Context) ObjectMetaPtrOutput {
-	return o
-}
-
-func (o ObjectMetaPtrOutput) Elem() ObjectMetaOutput {
-	return o.ApplyT(func(v *ObjectMeta) ObjectMeta {
-		if v != nil {
-			return *v
-		}
-		var ret ObjectMeta
-		return ret
-	}).(ObjectMetaOutput)
+func (o ObjectMetaOutput) ToOutput(ctx context.Context) pulumix.Output[ObjectMeta] {
+	return pulumix.Output[ObjectMeta]{
+		OutputState: o.OutputState,
+	}
 }
 
 // Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations
-func (o ObjectMetaPtrOutput) Annotations() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *ObjectMeta) map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.Annotations
-	}).(pulumi.StringMapOutput)
+func (o ObjectMetaOutput) Annotations() pulumix.MapOutput[string] {
+	value := pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) map[string]string { return v.Annotations })
+	return pulumix.MapOutput[string]{OutputState: value.OutputState}
 }
 
 // The name of the cluster which the object belongs to. This is used to distinguish resources with same name and namespace in different clusters. This field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.
-func (o ObjectMetaPtrOutput) ClusterName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ClusterName
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) ClusterName() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.ClusterName })
 }
 
 // CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC.
 //
 // Populated by the system. Read-only. Null for lists. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-func (o ObjectMetaPtrOutput) CreationTimestamp() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.CreationTimestamp
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) CreationTimestamp() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.CreationTimestamp })
 }
 
 // Number of seconds allowed for this object to gracefully terminate before it will be removed from the system. Only set when deletionTimestamp is also set. May only be shortened. Read-only.
-func (o ObjectMetaPtrOutput) DeletionGracePeriodSeconds() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *int {
-		if v == nil {
-			return nil
-		}
-		return v.DeletionGracePeriodSeconds
-	}).(pulumi.IntPtrOutput)
+func (o ObjectMetaOutput) DeletionGracePeriodSeconds() pulumix.Output[*int] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *int { return v.DeletionGracePeriodSeconds })
 }
 
 // DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This field is set by the server when a graceful deletion is requested by the user, and is not directly settable by a client. The resource is expected to be deleted (no longer visible from resource lists, and not reachable by name) after the time in this field, once the finalizers list is empty. As long as the finalizers list contains items, deletion is blocked. Once the deletionTimestamp is set, this value may not be unset or be set further into the future, although it may be shortened or the resource may be deleted prior to this time. For example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react by sending a graceful termination signal to the containers in the pod. After that 30 seconds, the Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup, remove the pod from the API. In the presence of network partitions, this object may still exist after this timestamp, until an administrator or automated process can determine the resource is fully terminated. If not set, graceful deletion of the object has not been requested.
 //
 // Populated by the system when a graceful deletion is requested. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
-func (o ObjectMetaPtrOutput) DeletionTimestamp() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.DeletionTimestamp
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) DeletionTimestamp() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.DeletionTimestamp })
 }
 
 // Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. If the deletionTimestamp of the object is non-nil, entries in this list can only be removed. Finalizers may be processed and removed in any order.  Order is NOT enforced because it introduces significant risk of stuck finalizers. finalizers is a shared field, any actor with permission can reorder it. If the finalizer list is processed in order, then this can lead to a situation in which the component responsible for the first finalizer in the list is waiting for a signal (field value, external system, or other) produced by a component responsible for a finalizer later in the list, resulting in a deadlock. Without enforced ordering finalizers are free to order amongst themselves and are not vulnerable to ordering changes in the list.
-func (o ObjectMetaPtrOutput) Finalizers() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *ObjectMeta) []string {
-		if v == nil {
-			return nil
-		}
-		return v.Finalizers
-	}).(pulumi.StringArrayOutput)
+func (o ObjectMetaOutput) Finalizers() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) []string { return v.Finalizers })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
 // GenerateName is an optional prefix, used by the server, to generate a unique name ONLY IF the Name field has not been provided. If this field is used, the name returned to the client will be different than the name passed. This value will also be combined with a unique suffix. The provided value has the same validation rules as the Name field, and may be truncated by the length of the suffix required to make the value unique on the server.
@@ -740,109 +381,62 @@ func (o ObjectMetaPtrOutput) Finalizers() pulumi.StringArrayOutput {
 // If this field is specified and the generated name exists, the server will return a 409.
 //
 // Applied only if Name is not specified. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency
-func (o ObjectMetaPtrOutput) GenerateName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.GenerateName
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) GenerateName() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.GenerateName })
 }
 
 // A sequence number representing a specific generation of the desired state. Populated by the system. Read-only.
-func (o ObjectMetaPtrOutput) Generation() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *int {
-		if v == nil {
-			return nil
-		}
-		return v.Generation
-	}).(pulumi.IntPtrOutput)
+func (o ObjectMetaOutput) Generation() pulumix.Output[*int] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *int { return v.Generation })
 }
 
 // Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels
-func (o ObjectMetaPtrOutput) Labels() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *ObjectMeta) map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.Labels
-	}).(pulumi.StringMapOutput)
+func (o ObjectMetaOutput) Labels() pulumix.MapOutput[string] {
+	value := pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) map[string]string { return v.Labels })
+	return pulumix.MapOutput[string]{OutputState: value.OutputState}
 }
 
 // ManagedFields maps workflow-id and version to the set of fields that are managed by that workflow. This is mostly for internal housekeeping, and users typically shouldn't need to set or understand this field. A workflow can be the user's name, a controller's name, or the name of a specific apply path like "ci-cd". The set of fields is always in the version that the workflow used when modifying the object.
-func (o ObjectMetaPtrOutput) ManagedFields() ManagedFieldsEntryArrayOutput {
-	return o.ApplyT(func(v *ObjectMeta) []ManagedFieldsEntry {
-		if v == nil {
-			return nil
-		}
-		return v.ManagedFields
-	}).(ManagedFieldsEntryArrayOutput)
+func (o ObjectMetaOutput) ManagedFields() pulumix.GArrayOutput[ManagedFieldsEntry, ManagedFieldsEntryOutput] {
+	value := pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) []*ManagedFieldsEntry { return v.ManagedFields })
+	return pulumix.GArrayOutput[ManagedFieldsEntry, ManagedFieldsEntryOutput]{OutputState: value.OutputState}
 }
 
 // Name must be unique within a namespace. Is required when creating resources, although some resources may allow a client to request the generation of an appropriate name automatically. Name is primarily intended for creation idempotence and configuration definition. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-func (o ObjectMetaPtrOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Name
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.Name })
 }
 
 // Namespace defines the space within which each name must be unique. An empty namespace is equivalent to the "default" namespace, but "default" is the canonical representation. Not all objects are required to be scoped to a namespace - the value of this field for those objects will be empty.
 //
 // Must be a DNS_LABEL. Cannot be updated. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces
-func (o ObjectMetaPtrOutput) Namespace() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Namespace
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) Namespace() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.Namespace })
 }
 
 // List of objects depended by this object. If ALL objects in the list have been deleted, this object will be garbage collected. If this object is managed by a controller, then an entry in this list will point to this controller, with the controller field set to true. There cannot be more than one managing controller.
-func (o ObjectMetaPtrOutput) OwnerReferences() OwnerReferenceArrayOutput {
-	return o.ApplyT(func(v *ObjectMeta) []OwnerReference {
-		if v == nil {
-			return nil
-		}
-		return v.OwnerReferences
-	}).(OwnerReferenceArrayOutput)
+func (o ObjectMetaOutput) OwnerReferences() pulumix.GArrayOutput[OwnerReference, OwnerReferenceOutput] {
+	value := pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) []*OwnerReference { return v.OwnerReferences })
+	return pulumix.GArrayOutput[OwnerReference, OwnerReferenceOutput]{OutputState: value.OutputState}
 }
 
 // An opaque value that represents the internal version of this object that can be used by clients to determine when objects have changed. May be used for optimistic concurrency, change detection, and the watch operation on a resource or set of resources. Clients must treat these values as opaque and passed unmodified back to the server. They may only be valid for a particular resource or set of resources.
 //
 // Populated by the system. Read-only. Value must be treated as opaque by clients and . More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-func (o ObjectMetaPtrOutput) ResourceVersion() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.ResourceVersion
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) ResourceVersion() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.ResourceVersion })
 }
 
 // Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
-func (o ObjectMetaPtrOutput) SelfLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.SelfLink
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) SelfLink() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.SelfLink })
 }
 
 // UID is the unique in time and space value for this object. It is typically generated by the server on successful creation of a resource and is not allowed to change on PUT operations.
 //
 // Populated by the system. Read-only. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-func (o ObjectMetaPtrOutput) Uid() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectMeta) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Uid
-	}).(pulumi.StringPtrOutput)
+func (o ObjectMetaOutput) Uid() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectMeta](o, func(v ObjectMeta) *string { return v.Uid })
 }
 
 // OwnerReference contains enough information to let you identify an owning object. An owning object must be in the same namespace as the dependent, or be cluster-scoped, so there is no namespace field.
@@ -861,31 +455,20 @@ type OwnerReference struct {
 	Uid string `pulumi:"uid"`
 }
 
-// OwnerReferenceInput is an input type that accepts OwnerReferenceArgs and OwnerReferenceOutput values.
-// You can construct a concrete instance of `OwnerReferenceInput` via:
-//
-//	OwnerReferenceArgs{...}
-type OwnerReferenceInput interface {
-	pulumi.Input
-
-	ToOwnerReferenceOutput() OwnerReferenceOutput
-	ToOwnerReferenceOutputWithContext(context.Context) OwnerReferenceOutput
-}
-
 // OwnerReference contains enough information to let you identify an owning object. An owning object must be in the same namespace as the dependent, or be cluster-scoped, so there is no namespace field.
 type OwnerReferenceArgs struct {
 	// API version of the referent.
-	ApiVersion pulumi.StringInput `pulumi:"apiVersion"`
+	ApiVersion pulumix.Input[string] `pulumi:"apiVersion"`
 	// If true, AND if the owner has the "foregroundDeletion" finalizer, then the owner cannot be deleted from the key-value store until this reference is removed. See https://kubernetes.io/docs/concepts/architecture/garbage-collection/#foreground-deletion for how the garbage collector interacts with this field and enforces the foreground deletion. Defaults to false. To set this field, a user needs "delete" permission of the owner, otherwise 422 (Unprocessable Entity) will be returned.
-	BlockOwnerDeletion pulumi.BoolPtrInput `pulumi:"blockOwnerDeletion"`
+	BlockOwnerDeletion pulumix.Input[*bool] `pulumi:"blockOwnerDeletion"`
 	// If true, this reference points to the managing controller.
-	Controller pulumi.BoolPtrInput `pulumi:"controller"`
+	Controller pulumix.Input[*bool] `pulumi:"controller"`
 	// Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind pulumi.StringInput `pulumi:"kind"`
+	Kind pulumix.Input[string] `pulumi:"kind"`
 	// Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-	Name pulumi.StringInput `pulumi:"name"`
+	Name pulumix.Input[string] `pulumi:"name"`
 	// UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-	Uid pulumi.StringInput `pulumi:"uid"`
+	Uid pulumix.Input[string] `pulumi:"uid"`
 }
 
 func (OwnerReferenceArgs) ElementType() reflect.Type {
@@ -900,29 +483,8 @@ func (i OwnerReferenceArgs) ToOwnerReferenceOutputWithContext(ctx context.Contex
 	return pulumi.ToOutputWithContext(ctx, i).(OwnerReferenceOutput)
 }
 
-// OwnerReferenceArrayInput is an input type that accepts OwnerReferenceArray and OwnerReferenceArrayOutput values.
-// You can construct a concrete instance of `OwnerReferenceArrayInput` via:
-//
-//	OwnerReferenceArray{ OwnerReferenceArgs{...} }
-type OwnerReferenceArrayInput interface {
-	pulumi.Input
-
-	ToOwnerReferenceArrayOutput() OwnerReferenceArrayOutput
-	ToOwnerReferenceArrayOutputWithContext(context.Context) OwnerReferenceArrayOutput
-}
-
-type OwnerReferenceArray []OwnerReferenceInput
-
-func (OwnerReferenceArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]OwnerReference)(nil)).Elem()
-}
-
-func (i OwnerReferenceArray) ToOwnerReferenceArrayOutput() OwnerReferenceArrayOutput {
-	return i.ToOwnerReferenceArrayOutputWithContext(context.Background())
-}
-
-func (i OwnerReferenceArray) ToOwnerReferenceArrayOutputWithContext(ctx context.Context) OwnerReferenceArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(OwnerReferenceArrayOutput)
+func (i *OwnerReferenceArgs) ToOutput(ctx context.Context) pulumix.Output[*OwnerReferenceArgs] {
+	return pulumix.Val(i)
 }
 
 // OwnerReference contains enough information to let you identify an owning object. An owning object must be in the same namespace as the dependent, or be cluster-scoped, so there is no namespace field.
@@ -940,71 +502,45 @@ func (o OwnerReferenceOutput) ToOwnerReferenceOutputWithContext(ctx context.Cont
 	return o
 }
 
+func (o OwnerReferenceOutput) ToOutput(ctx context.Context) pulumix.Output[OwnerReference] {
+	return pulumix.Output[OwnerReference]{
+		OutputState: o.OutputState,
+	}
+}
+
 // API version of the referent.
-func (o OwnerReferenceOutput) ApiVersion() pulumi.StringOutput {
-	return o.ApplyT(func(v OwnerReference) string { return v.ApiVersion }).(pulumi.StringOutput)
+func (o OwnerReferenceOutput) ApiVersion() pulumix.Output[string] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) string { return v.ApiVersion })
 }
 
 // If true, AND if the owner has the "foregroundDeletion" finalizer, then the owner cannot be deleted from the key-value store until this reference is removed. See https://kubernetes.io/docs/concepts/architecture/garbage-collection/#foreground-deletion for how the garbage collector interacts with this field and enforces the foreground deletion. Defaults to false. To set this field, a user needs "delete" permission of the owner, otherwise 422 (Unprocessable Entity) will be returned.
-func (o OwnerReferenceOutput) BlockOwnerDeletion() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v OwnerReference) *bool { return v.BlockOwnerDeletion }).(pulumi.BoolPtrOutput)
+func (o OwnerReferenceOutput) BlockOwnerDeletion() pulumix.Output[*bool] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) *bool { return v.BlockOwnerDeletion })
 }
 
 // If true, this reference points to the managing controller.
-func (o OwnerReferenceOutput) Controller() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v OwnerReference) *bool { return v.Controller }).(pulumi.BoolPtrOutput)
+func (o OwnerReferenceOutput) Controller() pulumix.Output[*bool] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) *bool { return v.Controller })
 }
 
 // Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (o OwnerReferenceOutput) Kind() pulumi.StringOutput {
-	return o.ApplyT(func(v OwnerReference) string { return v.Kind }).(pulumi.StringOutput)
+func (o OwnerReferenceOutput) Kind() pulumix.Output[string] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) string { return v.Kind })
 }
 
 // Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-func (o OwnerReferenceOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v OwnerReference) string { return v.Name }).(pulumi.StringOutput)
+func (o OwnerReferenceOutput) Name() pulumix.Output[string] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) string { return v.Name })
 }
 
 // UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-func (o OwnerReferenceOutput) Uid() pulumi.StringOutput {
-	return o.ApplyT(func(v OwnerReference) string { return v.Uid }).(pulumi.StringOutput)
-}
-
-type OwnerReferenceArrayOutput struct{ *pulumi.OutputState }
-
-func (OwnerReferenceArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]OwnerReference)(nil)).Elem()
-}
-
-func (o OwnerReferenceArrayOutput) ToOwnerReferenceArrayOutput() OwnerReferenceArrayOutput {
-	return o
-}
-
-func (o OwnerReferenceArrayOutput) ToOwnerReferenceArrayOutputWithContext(ctx context.Context) OwnerReferenceArrayOutput {
-	return o
-}
-
-func (o OwnerReferenceArrayOutput) Index(i pulumi.IntInput) OwnerReferenceOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OwnerReference {
-		return vs[0].([]OwnerReference)[vs[1].(int)]
-	}).(OwnerReferenceOutput)
+func (o OwnerReferenceOutput) Uid() pulumix.Output[string] {
+	return pulumix.Apply[OwnerReference](o, func(v OwnerReference) string { return v.Uid })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ListMetaInput)(nil)).Elem(), ListMetaArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ListMetaPtrInput)(nil)).Elem(), ListMetaArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ManagedFieldsEntryInput)(nil)).Elem(), ManagedFieldsEntryArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ManagedFieldsEntryArrayInput)(nil)).Elem(), ManagedFieldsEntryArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectMetaInput)(nil)).Elem(), ObjectMetaArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectMetaPtrInput)(nil)).Elem(), ObjectMetaArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*OwnerReferenceInput)(nil)).Elem(), OwnerReferenceArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*OwnerReferenceArrayInput)(nil)).Elem(), OwnerReferenceArray{})
 	pulumi.RegisterOutputType(ListMetaOutput{})
-	pulumi.RegisterOutputType(ListMetaPtrOutput{})
 	pulumi.RegisterOutputType(ManagedFieldsEntryOutput{})
-	pulumi.RegisterOutputType(ManagedFieldsEntryArrayOutput{})
 	pulumi.RegisterOutputType(ObjectMetaOutput{})
-	pulumi.RegisterOutputType(ObjectMetaPtrOutput{})
 	pulumi.RegisterOutputType(OwnerReferenceOutput{})
-	pulumi.RegisterOutputType(OwnerReferenceArrayOutput{})
 }
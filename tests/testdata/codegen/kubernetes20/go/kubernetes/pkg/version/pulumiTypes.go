@@ -9,6 +9,7 @@ import (
 
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = utilities.GetEnvOrDefault
@@ -26,28 +27,17 @@ type Info struct {
 	Platform     string `pulumi:"platform"`
 }
 
-// InfoInput is an input type that accepts InfoArgs and InfoOutput values.
-// You can construct a concrete instance of `InfoInput` via:
-//
-//	InfoArgs{...}
-type InfoInput interface {
-	pulumi.Input
-
-	ToInfoOutput() InfoOutput
-	ToInfoOutputWithContext(context.Context) InfoOutput
-}
-
 // Info contains versioning information. how we'll want to distribute that information.
 type InfoArgs struct {
-	BuildDate    pulumi.StringInput `pulumi:"buildDate"`
-	Compiler     pulumi.StringInput `pulumi:"compiler"`
-	GitCommit    pulumi.StringInput `pulumi:"gitCommit"`
-	GitTreeState pulumi.StringInput `pulumi:"gitTreeState"`
-	GitVersion   pulumi.StringInput `pulumi:"gitVersion"`
-	GoVersion    pulumi.StringInput `pulumi:"goVersion"`
-	Major        pulumi.StringInput `pulumi:"major"`
-	Minor        pulumi.StringInput `pulumi:"minor"`
-	Platform     pulumi.StringInput `pulumi:"platform"`
+	BuildDate    pulumix.Input[string] `pulumi:"buildDate"`
+	Compiler     pulumix.Input[string] `pulumi:"compiler"`
+	GitCommit    pulumix.Input[string] `pulumi:"gitCommit"`
+	GitTreeState pulumix.Input[string] `pulumi:"gitTreeState"`
+	GitVersion   pulumix.Input[string] `pulumi:"gitVersion"`
+	GoVersion    pulumix.Input[string] `pulumi:"goVersion"`
+	Major        pulumix.Input[string] `pulumi:"major"`
+	Minor        pulumix.Input[string] `pulumi:"minor"`
+	Platform     pulumix.Input[string] `pulumi:"platform"`
 }
 
 func (InfoArgs) ElementType() reflect.Type {
@@ -62,6 +52,10 @@ func (i InfoArgs) ToInfoOutputWithContext(ctx context.Context) InfoOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(InfoOutput)
 }
 
+func (i *InfoArgs) ToOutput(ctx context.Context) pulumix.Output[*InfoArgs] {
+	return pulumix.Val(i)
+}
+
 // Info contains versioning information. how we'll want to distribute that information.
 type InfoOutput struct{ *pulumi.OutputState }
 
@@ -77,43 +71,48 @@ func (o InfoOutput) ToInfoOutputWithContext(ctx context.Context) InfoOutput {
 	return o
 }
 
-func (o InfoOutput) BuildDate() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.BuildDate }).(pulumi.StringOutput)
+func (o InfoOutput) ToOutput(ctx context.Context) pulumix.Output[Info] {
+	return pulumix.Output[Info]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o InfoOutput) BuildDate() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.BuildDate })
 }
 
-func (o InfoOutput) Compiler() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.Compiler }).(pulumi.StringOutput)
+func (o InfoOutput) Compiler() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.Compiler })
 }
 
-func (o InfoOutput) GitCommit() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.GitCommit }).(pulumi.StringOutput)
+func (o InfoOutput) GitCommit() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.GitCommit })
 }
 
-func (o InfoOutput) GitTreeState() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.GitTreeState }).(pulumi.StringOutput)
+func (o InfoOutput) GitTreeState() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.GitTreeState })
 }
 
-func (o InfoOutput) GitVersion() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.GitVersion }).(pulumi.StringOutput)
+func (o InfoOutput) GitVersion() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.GitVersion })
 }
 
-func (o InfoOutput) GoVersion() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.GoVersion }).(pulumi.StringOutput)
+func (o InfoOutput) GoVersion() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.GoVersion })
 }
 
-func (o InfoOutput) Major() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.Major }).(pulumi.StringOutput)
+func (o InfoOutput) Major() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.Major })
 }
 
-func (o InfoOutput) Minor() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.Minor }).(pulumi.StringOutput)
+func (o InfoOutput) Minor() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.Minor })
 }
 
-func (o InfoOutput) Platform() pulumi.StringOutput {
-	return o.ApplyT(func(v Info) string { return v.Platform }).(pulumi.StringOutput)
+func (o InfoOutput) Platform() pulumix.Output[string] {
+	return pulumix.Apply[Info](o, func(v Info) string { return v.Platform })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*InfoInput)(nil)).Elem(), InfoArgs{})
 	pulumi.RegisterOutputType(InfoOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 // The provider type for the kubernetes package.
@@ -24,11 +25,11 @@ func NewProvider(ctx *pulumi.Context,
 	}
 
 	if args.KubeClientSettings != nil {
-		args.KubeClientSettings = args.KubeClientSettings.ToKubeClientSettingsPtrOutput().ApplyT(func(v *KubeClientSettings) *KubeClientSettings { return v.Defaults() }).(KubeClientSettingsPtrOutput)
+		args.KubeClientSettings = pulumix.Apply(args.KubeClientSettings, func(o *KubeClientSettingsArgs) *KubeClientSettingsArgs { return o.Defaults() })
 	}
 	if args.Kubeconfig == nil {
 		if d := utilities.GetEnvOrDefault(nil, nil, "KUBECONFIG"); d != nil {
-			args.Kubeconfig = pulumi.StringPtr(d.(string))
+			args.Kubeconfig = pulumix.Ptr(d.(string))
 		}
 	}
 	opts = utilities.PkgResourceDefaultOpts(opts)
@@ -57,45 +58,26 @@ type providerArgs struct {
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
 	// Options for tuning the Kubernetes client used by a Provider.
-	KubeClientSettings KubeClientSettingsPtrInput
+	KubeClientSettings pulumix.Input[*KubeClientSettingsArgs]
 	// The contents of a kubeconfig file or the path to a kubeconfig file.
-	Kubeconfig pulumi.StringPtrInput
+	Kubeconfig pulumix.Input[*string]
 	// If present, the default namespace to use. This flag is ignored for cluster-scoped resources.
 	//
 	// A namespace can be specified in multiple places, and the precedence is as follows:
 	// 1. `.metadata.namespace` set on the resource.
 	// 2. This `namespace` parameter.
 	// 3. `namespace` set for the active context in the kubeconfig.
-	Namespace pulumi.StringPtrInput
+	Namespace pulumix.Input[*string]
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*providerArgs)(nil)).Elem()
 }
 
-type ProviderInput interface {
-	pulumi.Input
-
-	ToProviderOutput() ProviderOutput
-	ToProviderOutputWithContext(ctx context.Context) ProviderOutput
-}
-
-func (*Provider) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
-}
-
-func (i *Provider) ToProviderOutput() ProviderOutput {
-	return i.ToProviderOutputWithContext(context.Background())
-}
-
-func (i *Provider) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProviderOutput)
-}
-
 type ProviderOutput struct{ *pulumi.OutputState }
 
 func (ProviderOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
+	return reflect.TypeOf((*Provider)(nil)).Elem()
 }
 
 func (o ProviderOutput) ToProviderOutput() ProviderOutput {
@@ -106,7 +88,12 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+func (o ProviderOutput) ToOutput(ctx context.Context) pulumix.Output[Provider] {
+	return pulumix.Output[Provider]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/utilities"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 var _ = utilities.GetEnvOrDefault
@@ -34,21 +35,10 @@ func (val *KubeClientSettings) Defaults() *KubeClientSettings {
 	return &tmp
 }
 
-// KubeClientSettingsInput is an input type that accepts KubeClientSettingsArgs and KubeClientSettingsOutput values.
-// You can construct a concrete instance of `KubeClientSettingsInput` via:
-//
-//	KubeClientSettingsArgs{...}
-type KubeClientSettingsInput interface {
-	pulumi.Input
-
-	ToKubeClientSettingsOutput() KubeClientSettingsOutput
-	ToKubeClientSettingsOutputWithContext(context.Context) KubeClientSettingsOutput
-}
-
 // Options for tuning the Kubernetes client used by a Provider.
 type KubeClientSettingsArgs struct {
 	// Maximum time in seconds to wait before cancelling a HTTP request to the Kubernetes server. Default value is 32.
-	Timeout pulumi.IntPtrInput `pulumi:"timeout"`
+	Timeout pulumix.Input[*int] `pulumi:"timeout"`
 }
 
 // Defaults sets the appropriate defaults for KubeClientSettingsArgs
@@ -59,7 +49,7 @@ func (val *KubeClientSettingsArgs) Defaults() *KubeClientSettingsArgs {
 	tmp := *val
 	if tmp.Timeout == nil {
 		if d := utilities.GetEnvOrDefault(nil, utilities.ParseEnvInt, "PULUMI_K8S_CLIENT_TIMEOUT"); d != nil {
-			tmp.Timeout = pulumi.IntPtr(d.(int))
+			tmp.Timeout = pulumix.Ptr(d.(int))
 		}
 	}
 	return &tmp
@@ -76,45 +66,8 @@ func (i KubeClientSettingsArgs) ToKubeClientSettingsOutputWithContext(ctx contex
 	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsOutput)
 }
 
-func (i KubeClientSettingsArgs) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return i.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i KubeClientSettingsArgs) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsOutput).ToKubeClientSettingsPtrOutputWithContext(ctx)
-}
-
-// KubeClientSettingsPtrInput is an input type that accepts KubeClientSettingsArgs, KubeClientSettingsPtr and KubeClientSettingsPtrOutput values.
-// You can construct a concrete instance of `KubeClientSettingsPtrInput` via:
-//
-//	        KubeClientSettingsArgs{...}
-//
-//	or:
-//
-//	        nil
-type KubeClientSettingsPtrInput interface {
-	pulumi.Input
-
-	ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput
-	ToKubeClientSettingsPtrOutputWithContext(context.Context) KubeClientSettingsPtrOutput
-}
-
-type kubeClientSettingsPtrType KubeClientSettingsArgs
-
-func KubeClientSettingsPtr(v *KubeClientSettingsArgs) KubeClientSettingsPtrInput {
-	return (*kubeClientSettingsPtrType)(v)
-}
-
-func (*kubeClientSettingsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeClientSettings)(nil)).Elem()
-}
-
-func (i *kubeClientSettingsPtrType) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return i.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i *kubeClientSettingsPtrType) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsPtrOutput)
+func (i *KubeClientSettingsArgs) ToOutput(ctx context.Context) pulumix.Output[*KubeClientSettingsArgs] {
+	return pulumix.Val(i)
 }
 
 // Options for tuning the Kubernetes client used by a Provider.
@@ -132,58 +85,17 @@ func (o KubeClientSettingsOutput) ToKubeClientSettingsOutputWithContext(ctx cont
 	return o
 }
 
-func (o KubeClientSettingsOutput) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return o.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (o KubeClientSettingsOutput) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeClientSettings) *KubeClientSettings {
-		return &v
-	}).(KubeClientSettingsPtrOutput)
-}
-
-// Maximum time in seconds to wait before cancelling a HTTP request to the Kubernetes server. Default value is 32.
-func (o KubeClientSettingsOutput) Timeout() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v KubeClientSettings) *int { return v.Timeout }).(pulumi.IntPtrOutput)
-}
-
-type KubeClientSettingsPtrOutput struct{ *pulumi.OutputState }
-
-func (KubeClientSettingsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeClientSettings)(nil)).Elem()
-}
-
-func (o KubeClientSettingsPtrOutput) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return o
-}
-
-func (o KubeClientSettingsPtrOutput) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return o
-}
-
-func (o KubeClientSettingsPtrOutput) Elem() KubeClientSettingsOutput {
-	return o.ApplyT(func(v *KubeClientSettings) KubeClientSettings {
-		if v != nil {
-			return *v
-		}
-		var ret KubeClientSettings
-		return ret
-	}).(KubeClientSettingsOutput)
+func (o KubeClientSettingsOutput) ToOutput(ctx context.Context) pulumix.Output[KubeClientSettings] {
+	return pulumix.Output[KubeClientSettings]{
+		OutputState: o.OutputState,
+	}
 }
 
 // Maximum time in seconds to wait before cancelling a HTTP request to the Kubernetes server. Default value is 32.
-func (o KubeClientSettingsPtrOutput) Timeout() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *KubeClientSettings) *int {
-		if v == nil {
-			return nil
-		}
-		return v.Timeout
-	}).(pulumi.IntPtrOutput)
+func (o KubeClientSettingsOutput) Timeout() pulumix.Output[*int] {
+	return pulumix.Apply[KubeClientSettings](o, func(v KubeClientSettings) *int { return v.Timeout })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*KubeClientSettingsInput)(nil)).Elem(), KubeClientSettingsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*KubeClientSettingsPtrInput)(nil)).Elem(), KubeClientSettingsArgs{})
 	pulumi.RegisterOutputType(KubeClientSettingsOutput{})
-	pulumi.RegisterOutputType(KubeClientSettingsPtrOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"legacy-names/legacy_names/http_module"
 	"legacy-names/legacy_names/internal"
 )
@@ -15,9 +16,9 @@ import (
 type Example_resource struct {
 	pulumi.CustomResourceState
 
-	URL       pulumi.StringPtrOutput   `pulumi:"URL"`
-	Good_URLs pulumi.StringArrayOutput `pulumi:"good_URLs"`
-	Map_enum  Enum_XYZMapArrayOutput   `pulumi:"map_enum"`
+	URL       pulumix.Output[*string]                  `pulumi:"URL"`
+	Good_URLs pulumix.ArrayOutput[string]              `pulumi:"good_URLs"`
+	Map_enum  pulumix.ArrayOutput[map[string]Enum_XYZ] `pulumi:"map_enum"`
 }
 
 // NewExample_resource registers a new resource with the given unique name, arguments, and options.
@@ -66,37 +67,18 @@ type example_resourceArgs struct {
 
 // The set of arguments for constructing a Example_resource resource.
 type Example_resourceArgs struct {
-	Map_enum     Enum_XYZMapArrayInput
-	Request_HTTP http_module.RequestPtrInput
+	Map_enum     pulumix.Input[[]map[string]Enum_XYZ]
+	Request_HTTP pulumix.Input[*http_module.RequestArgs]
 }
 
 func (Example_resourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*example_resourceArgs)(nil)).Elem()
 }
 
-type Example_resourceInput interface {
-	pulumi.Input
-
-	ToExample_resourceOutput() Example_resourceOutput
-	ToExample_resourceOutputWithContext(ctx context.Context) Example_resourceOutput
-}
-
-func (*Example_resource) ElementType() reflect.Type {
-	return reflect.TypeOf((**Example_resource)(nil)).Elem()
-}
-
-func (i *Example_resource) ToExample_resourceOutput() Example_resourceOutput {
-	return i.ToExample_resourceOutputWithContext(context.Background())
-}
-
-func (i *Example_resource) ToExample_resourceOutputWithContext(ctx context.Context) Example_resourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(Example_resourceOutput)
-}
-
 type Example_resourceOutput struct{ *pulumi.OutputState }
 
 func (Example_resourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Example_resource)(nil)).Elem()
+	return reflect.TypeOf((*Example_resource)(nil)).Elem()
 }
 
 func (o Example_resourceOutput) ToExample_resourceOutput() Example_resourceOutput {
@@ -107,19 +89,29 @@ func (o Example_resourceOutput) ToExample_resourceOutputWithContext(ctx context.
 	return o
 }
 
-func (o Example_resourceOutput) URL() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Example_resource) pulumi.StringPtrOutput { return v.URL }).(pulumi.StringPtrOutput)
+func (o Example_resourceOutput) ToOutput(ctx context.Context) pulumix.Output[Example_resource] {
+	return pulumix.Output[Example_resource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o Example_resourceOutput) URL() pulumix.Output[*string] {
+	value := pulumix.Apply[Example_resource](o, func(v Example_resource) pulumix.Output[*string] { return v.URL })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
-func (o Example_resourceOutput) Good_URLs() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v *Example_resource) pulumi.StringArrayOutput { return v.Good_URLs }).(pulumi.StringArrayOutput)
+func (o Example_resourceOutput) Good_URLs() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[Example_resource](o, func(v Example_resource) pulumix.ArrayOutput[string] { return v.Good_URLs })
+	unwrapped := pulumix.Flatten[[]string, pulumix.ArrayOutput[string]](value)
+	return pulumix.ArrayOutput[string]{OutputState: unwrapped.OutputState}
 }
 
-func (o Example_resourceOutput) Map_enum() Enum_XYZMapArrayOutput {
-	return o.ApplyT(func(v *Example_resource) Enum_XYZMapArrayOutput { return v.Map_enum }).(Enum_XYZMapArrayOutput)
+func (o Example_resourceOutput) Map_enum() pulumix.ArrayOutput[map[string]Enum_XYZ] {
+	value := pulumix.Apply[Example_resource](o, func(v Example_resource) pulumix.ArrayOutput[map[string]Enum_XYZ] { return v.Map_enum })
+	unwrapped := pulumix.Flatten[[]map[string]Enum_XYZ, pulumix.ArrayOutput[map[string]Enum_XYZ]](value)
+	return pulumix.ArrayOutput[map[string]Enum_XYZ]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*Example_resourceInput)(nil)).Elem(), &Example_resource{})
 	pulumi.RegisterOutputType(Example_resourceOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"legacy-names/legacy_names/internal"
 )
 
@@ -18,20 +19,9 @@ type Request struct {
 	Content_body *string `pulumi:"content_body"`
 }
 
-// RequestInput is an input type that accepts RequestArgs and RequestOutput values.
-// You can construct a concrete instance of `RequestInput` via:
-//
-//	RequestArgs{...}
-type RequestInput interface {
-	pulumi.Input
-
-	ToRequestOutput() RequestOutput
-	ToRequestOutputWithContext(context.Context) RequestOutput
-}
-
 type RequestArgs struct {
-	URL          pulumi.StringPtrInput `pulumi:"URL"`
-	Content_body pulumi.StringPtrInput `pulumi:"content_body"`
+	URL          pulumix.Input[*string] `pulumi:"URL"`
+	Content_body pulumix.Input[*string] `pulumi:"content_body"`
 }
 
 func (RequestArgs) ElementType() reflect.Type {
@@ -46,45 +36,8 @@ func (i RequestArgs) ToRequestOutputWithContext(ctx context.Context) RequestOutp
 	return pulumi.ToOutputWithContext(ctx, i).(RequestOutput)
 }
 
-func (i RequestArgs) ToRequestPtrOutput() RequestPtrOutput {
-	return i.ToRequestPtrOutputWithContext(context.Background())
-}
-
-func (i RequestArgs) ToRequestPtrOutputWithContext(ctx context.Context) RequestPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(RequestOutput).ToRequestPtrOutputWithContext(ctx)
-}
-
-// RequestPtrInput is an input type that accepts RequestArgs, RequestPtr and RequestPtrOutput values.
-// You can construct a concrete instance of `RequestPtrInput` via:
-//
-//	        RequestArgs{...}
-//
-//	or:
-//
-//	        nil
-type RequestPtrInput interface {
-	pulumi.Input
-
-	ToRequestPtrOutput() RequestPtrOutput
-	ToRequestPtrOutputWithContext(context.Context) RequestPtrOutput
-}
-
-type requestPtrType RequestArgs
-
-func RequestPtr(v *RequestArgs) RequestPtrInput {
-	return (*requestPtrType)(v)
-}
-
-func (*requestPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Request)(nil)).Elem()
-}
-
-func (i *requestPtrType) ToRequestPtrOutput() RequestPtrOutput {
-	return i.ToRequestPtrOutputWithContext(context.Background())
-}
-
-func (i *requestPtrType) ToRequestPtrOutputWithContext(ctx context.Context) RequestPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(RequestPtrOutput)
+func (i *RequestArgs) ToOutput(ctx context.Context) pulumix.Output[*RequestArgs] {
+	return pulumix.Val(i)
 }
 
 type RequestOutput struct{ *pulumi.OutputState }
@@ -101,69 +54,20 @@ func (o RequestOutput) ToRequestOutputWithContext(ctx context.Context) RequestOu
 	return o
 }
 
-func (o RequestOutput) ToRequestPtrOutput() RequestPtrOutput {
-	return o.ToRequestPtrOutputWithContext(context.Background())
-}
-
-func (o RequestOutput) ToRequestPtrOutputWithContext(ctx context.Context) RequestPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Request) *Request {
-		return &v
-	}).(RequestPtrOutput)
-}
-
-func (o RequestOutput) URL() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Request) *string { return v.URL }).(pulumi.StringPtrOutput)
-}
-
-func (o RequestOutput) Content_body() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Request) *string { return v.Content_body }).(pulumi.StringPtrOutput)
-}
-
-type RequestPtrOutput struct{ *pulumi.OutputState }
-
-func (RequestPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Request)(nil)).Elem()
-}
-
-func (o RequestPtrOutput) ToRequestPtrOutput() RequestPtrOutput {
-	return o
-}
-
-func (o RequestPtrOutput) ToRequestPtrOutputWithContext(ctx context.Context) RequestPtrOutput {
-	return o
-}
-
-func (o RequestPtrOutput) Elem() RequestOutput {
-	return o.ApplyT(func(v *Request) Request {
-		if v != nil {
-			return *v
-		}
-		var ret Request
-		return ret
-	}).(RequestOutput)
+func (o RequestOutput) ToOutput(ctx context.Context) pulumix.Output[Request] {
+	return pulumix.Output[Request]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o RequestPtrOutput) URL() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Request) *string {
-		if v == nil {
-			return nil
-		}
-		return v.URL
-	}).(pulumi.StringPtrOutput)
+func (o RequestOutput) URL() pulumix.Output[*string] {
+	return pulumix.Apply[Request](o, func(v Request) *string { return v.URL })
 }
 
-func (o RequestPtrOutput) Content_body() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Request) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Content_body
-	}).(pulumi.StringPtrOutput)
+func (o RequestOutput) Content_body() pulumix.Output[*string] {
+	return pulumix.Apply[Request](o, func(v Request) *string { return v.Content_body })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*RequestInput)(nil)).Elem(), RequestArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*RequestPtrInput)(nil)).Elem(), RequestArgs{})
 	pulumi.RegisterOutputType(RequestOutput{})
-	pulumi.RegisterOutputType(RequestPtrOutput{})
 }
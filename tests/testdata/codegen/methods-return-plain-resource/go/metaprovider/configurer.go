@@ -10,6 +10,7 @@ import (
 	"github.com/pulumi/pulumi-tls/sdk/v4/go/tls"
 	"github.com/pulumi/pulumi/pkg/codegen/testing/test/testdata/methods-return-plain-resource/go/metaprovider/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type Configurer struct {
@@ -38,7 +39,7 @@ type configurerArgs struct {
 
 // The set of arguments for constructing a Configurer resource.
 type ConfigurerArgs struct {
-	TlsProxy pulumi.StringPtrInput
+	TlsProxy pulumix.Input[*string]
 }
 
 func (ConfigurerArgs) ElementType() reflect.Type {
@@ -60,8 +61,9 @@ func (ConfigurerMeaningOfLifeResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ConfigurerMeaningOfLifeResult)(nil)).Elem()
 }
 
-func (o ConfigurerMeaningOfLifeResultOutput) Res() pulumi.IntOutput {
-	return o.ApplyT(func(v ConfigurerMeaningOfLifeResult) int { return v.Res }).(pulumi.IntOutput)
+func (o ConfigurerMeaningOfLifeResultOutput) Res() pulumix.Output[int] {
+	value := pulumix.Apply[ConfigurerMeaningOfLifeResult](pulumix.Output[ConfigurerMeaningOfLifeResult]{OutputState: o.OutputState}, func(v ConfigurerMeaningOfLifeResult) int { return v.Res })
+	return value
 }
 
 func (r *Configurer) ObjectMix(ctx *pulumi.Context) (o ConfigurerObjectMixResult, e error) {
@@ -80,12 +82,14 @@ func (ConfigurerObjectMixResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ConfigurerObjectMixResult)(nil)).Elem()
 }
 
-func (o ConfigurerObjectMixResultOutput) MeaningOfLife() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ConfigurerObjectMixResult) *int { return v.MeaningOfLife }).(pulumi.IntPtrOutput)
+func (o ConfigurerObjectMixResultOutput) MeaningOfLife() pulumix.Output[*int] {
+	value := pulumix.Apply[ConfigurerObjectMixResult](pulumix.Output[ConfigurerObjectMixResult]{OutputState: o.OutputState}, func(v ConfigurerObjectMixResult) *int { return v.MeaningOfLife })
+	return value
 }
 
-func (o ConfigurerObjectMixResultOutput) Provider() tls.ProviderOutput {
-	return o.ApplyT(func(v ConfigurerObjectMixResult) *tls.Provider { return v.Provider }).(tls.ProviderOutput)
+func (o ConfigurerObjectMixResultOutput) Provider() pulumix.GPtrOutput[tls.Provider, tls.ProviderOutput] {
+	value := pulumix.Apply[ConfigurerObjectMixResult](pulumix.Output[ConfigurerObjectMixResult]{OutputState: o.OutputState}, func(v ConfigurerObjectMixResult) *tls.Provider { return v.Provider })
+	return pulumix.GPtrOutput[tls.Provider, tls.ProviderOutput]{OutputState: value.OutputState}
 }
 
 func (r *Configurer) TlsProvider(ctx *pulumi.Context) (o *tls.Provider, e error) {
@@ -103,33 +107,15 @@ func (ConfigurerTlsProviderResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ConfigurerTlsProviderResult)(nil)).Elem()
 }
 
-func (o ConfigurerTlsProviderResultOutput) Res() tls.ProviderOutput {
-	return o.ApplyT(func(v ConfigurerTlsProviderResult) *tls.Provider { return v.Res }).(tls.ProviderOutput)
-}
-
-type ConfigurerInput interface {
-	pulumi.Input
-
-	ToConfigurerOutput() ConfigurerOutput
-	ToConfigurerOutputWithContext(ctx context.Context) ConfigurerOutput
-}
-
-func (*Configurer) ElementType() reflect.Type {
-	return reflect.TypeOf((**Configurer)(nil)).Elem()
-}
-
-func (i *Configurer) ToConfigurerOutput() ConfigurerOutput {
-	return i.ToConfigurerOutputWithContext(context.Background())
-}
-
-func (i *Configurer) ToConfigurerOutputWithContext(ctx context.Context) ConfigurerOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigurerOutput)
+func (o ConfigurerTlsProviderResultOutput) Res() pulumix.GPtrOutput[tls.Provider, tls.ProviderOutput] {
+	value := pulumix.Apply[ConfigurerTlsProviderResult](pulumix.Output[ConfigurerTlsProviderResult]{OutputState: o.OutputState}, func(v ConfigurerTlsProviderResult) tls.Provider { return v.Res })
+	return pulumix.GPtrOutput[tls.Provider, tls.ProviderOutput]{OutputState: value.OutputState}
 }
 
 type ConfigurerOutput struct{ *pulumi.OutputState }
 
 func (ConfigurerOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Configurer)(nil)).Elem()
+	return reflect.TypeOf((*Configurer)(nil)).Elem()
 }
 
 func (o ConfigurerOutput) ToConfigurerOutput() ConfigurerOutput {
@@ -140,8 +126,13 @@ func (o ConfigurerOutput) ToConfigurerOutputWithContext(ctx context.Context) Con
 	return o
 }
 
+func (o ConfigurerOutput) ToOutput(ctx context.Context) pulumix.Output[Configurer] {
+	return pulumix.Output[Configurer]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigurerInput)(nil)).Elem(), &Configurer{})
 	pulumi.RegisterOutputType(ConfigurerOutput{})
 	pulumi.RegisterOutputType(ConfigurerObjectMixResultOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"naming-collisions/example/internal"
 )
 
@@ -65,29 +66,10 @@ func (MainComponentArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*mainComponentArgs)(nil)).Elem()
 }
 
-type MainComponentInput interface {
-	pulumi.Input
-
-	ToMainComponentOutput() MainComponentOutput
-	ToMainComponentOutputWithContext(ctx context.Context) MainComponentOutput
-}
-
-func (*MainComponent) ElementType() reflect.Type {
-	return reflect.TypeOf((**MainComponent)(nil)).Elem()
-}
-
-func (i *MainComponent) ToMainComponentOutput() MainComponentOutput {
-	return i.ToMainComponentOutputWithContext(context.Background())
-}
-
-func (i *MainComponent) ToMainComponentOutputWithContext(ctx context.Context) MainComponentOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(MainComponentOutput)
-}
-
 type MainComponentOutput struct{ *pulumi.OutputState }
 
 func (MainComponentOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**MainComponent)(nil)).Elem()
+	return reflect.TypeOf((*MainComponent)(nil)).Elem()
 }
 
 func (o MainComponentOutput) ToMainComponentOutput() MainComponentOutput {
@@ -98,7 +80,12 @@ func (o MainComponentOutput) ToMainComponentOutputWithContext(ctx context.Contex
 	return o
 }
 
+func (o MainComponentOutput) ToOutput(ctx context.Context) pulumix.Output[MainComponent] {
+	return pulumix.Output[MainComponent]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*MainComponentInput)(nil)).Elem(), &MainComponent{})
 	pulumi.RegisterOutputType(MainComponentOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"naming-collisions/example"
 	"naming-collisions/example/internal"
 )
@@ -62,37 +63,18 @@ type componentArgs struct {
 
 // The set of arguments for constructing a Component resource.
 type ComponentArgs struct {
-	Local Component2Input
-	Main  example.MainComponentInput
+	Local pulumix.Input[*Component2]
+	Main  pulumix.Input[*example.MainComponent]
 }
 
 func (ComponentArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*componentArgs)(nil)).Elem()
 }
 
-type ComponentInput interface {
-	pulumi.Input
-
-	ToComponentOutput() ComponentOutput
-	ToComponentOutputWithContext(ctx context.Context) ComponentOutput
-}
-
-func (*Component) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
-}
-
-func (i *Component) ToComponentOutput() ComponentOutput {
-	return i.ToComponentOutputWithContext(context.Background())
-}
-
-func (i *Component) ToComponentOutputWithContext(ctx context.Context) ComponentOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ComponentOutput)
-}
-
 type ComponentOutput struct{ *pulumi.OutputState }
 
 func (ComponentOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
+	return reflect.TypeOf((*Component)(nil)).Elem()
 }
 
 func (o ComponentOutput) ToComponentOutput() ComponentOutput {
@@ -103,7 +85,12 @@ func (o ComponentOutput) ToComponentOutputWithContext(ctx context.Context) Compo
 	return o
 }
 
+func (o ComponentOutput) ToOutput(ctx context.Context) pulumix.Output[Component] {
+	return pulumix.Output[Component]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ComponentInput)(nil)).Elem(), &Component{})
 	pulumi.RegisterOutputType(ComponentOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"naming-collisions/example/internal"
 )
 
@@ -65,29 +66,10 @@ func (Component2Args) ElementType() reflect.Type {
 	return reflect.TypeOf((*component2Args)(nil)).Elem()
 }
 
-type Component2Input interface {
-	pulumi.Input
-
-	ToComponent2Output() Component2Output
-	ToComponent2OutputWithContext(ctx context.Context) Component2Output
-}
-
-func (*Component2) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component2)(nil)).Elem()
-}
-
-func (i *Component2) ToComponent2Output() Component2Output {
-	return i.ToComponent2OutputWithContext(context.Background())
-}
-
-func (i *Component2) ToComponent2OutputWithContext(ctx context.Context) Component2Output {
-	return pulumi.ToOutputWithContext(ctx, i).(Component2Output)
-}
-
 type Component2Output struct{ *pulumi.OutputState }
 
 func (Component2Output) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component2)(nil)).Elem()
+	return reflect.TypeOf((*Component2)(nil)).Elem()
 }
 
 func (o Component2Output) ToComponent2Output() Component2Output {
@@ -98,7 +80,12 @@ func (o Component2Output) ToComponent2OutputWithContext(ctx context.Context) Com
 	return o
 }
 
+func (o Component2Output) ToOutput(ctx context.Context) pulumix.Output[Component2] {
+	return pulumix.Output[Component2]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*Component2Input)(nil)).Elem(), &Component2{})
 	pulumi.RegisterOutputType(Component2Output{})
 }
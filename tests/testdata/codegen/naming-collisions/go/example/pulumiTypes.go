@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"naming-collisions/example/internal"
 )
 
@@ -17,19 +18,8 @@ type Object struct {
 	Bar *string `pulumi:"bar"`
 }
 
-// ObjectInput is an input type that accepts ObjectArgs and ObjectOutput values.
-// You can construct a concrete instance of `ObjectInput` via:
-//
-//	ObjectArgs{...}
-type ObjectInput interface {
-	pulumi.Input
-
-	ToObjectOutput() ObjectOutput
-	ToObjectOutputWithContext(context.Context) ObjectOutput
-}
-
 type ObjectArgs struct {
-	Bar pulumi.StringPtrInput `pulumi:"bar"`
+	Bar pulumix.Input[*string] `pulumi:"bar"`
 }
 
 func (ObjectArgs) ElementType() reflect.Type {
@@ -44,6 +34,10 @@ func (i ObjectArgs) ToObjectOutputWithContext(ctx context.Context) ObjectOutput
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectOutput)
 }
 
+func (i *ObjectArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectArgs] {
+	return pulumix.Val(i)
+}
+
 type ObjectOutput struct{ *pulumi.OutputState }
 
 func (ObjectOutput) ElementType() reflect.Type {
@@ -58,27 +52,22 @@ func (o ObjectOutput) ToObjectOutputWithContext(ctx context.Context) ObjectOutpu
 	return o
 }
 
-func (o ObjectOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Object) *string { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ObjectOutput) ToOutput(ctx context.Context) pulumix.Output[Object] {
+	return pulumix.Output[Object]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ObjectInputType struct {
-	Bar *string `pulumi:"bar"`
+func (o ObjectOutput) Bar() pulumix.Output[*string] {
+	return pulumix.Apply[Object](o, func(v Object) *string { return v.Bar })
 }
 
-// ObjectInputTypeInput is an input type that accepts ObjectInputTypeArgs and ObjectInputTypeOutput values.
-// You can construct a concrete instance of `ObjectInputTypeInput` via:
-//
-//	ObjectInputTypeArgs{...}
-type ObjectInputTypeInput interface {
-	pulumi.Input
-
-	ToObjectInputTypeOutput() ObjectInputTypeOutput
-	ToObjectInputTypeOutputWithContext(context.Context) ObjectInputTypeOutput
+type ObjectInputType struct {
+	Bar *string `pulumi:"bar"`
 }
 
 type ObjectInputTypeArgs struct {
-	Bar pulumi.StringPtrInput `pulumi:"bar"`
+	Bar pulumix.Input[*string] `pulumi:"bar"`
 }
 
 func (ObjectInputTypeArgs) ElementType() reflect.Type {
@@ -93,6 +82,10 @@ func (i ObjectInputTypeArgs) ToObjectInputTypeOutputWithContext(ctx context.Cont
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectInputTypeOutput)
 }
 
+func (i *ObjectInputTypeArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectInputTypeArgs] {
+	return pulumix.Val(i)
+}
+
 type ObjectInputTypeOutput struct{ *pulumi.OutputState }
 
 func (ObjectInputTypeOutput) ElementType() reflect.Type {
@@ -107,27 +100,22 @@ func (o ObjectInputTypeOutput) ToObjectInputTypeOutputWithContext(ctx context.Co
 	return o
 }
 
-func (o ObjectInputTypeOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ObjectInputType) *string { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ObjectInputTypeOutput) ToOutput(ctx context.Context) pulumix.Output[ObjectInputType] {
+	return pulumix.Output[ObjectInputType]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ResourceType struct {
-	Name *string `pulumi:"name"`
+func (o ObjectInputTypeOutput) Bar() pulumix.Output[*string] {
+	return pulumix.Apply[ObjectInputType](o, func(v ObjectInputType) *string { return v.Bar })
 }
 
-// ResourceTypeInput is an input type that accepts ResourceTypeArgs and ResourceTypeOutput values.
-// You can construct a concrete instance of `ResourceTypeInput` via:
-//
-//	ResourceTypeArgs{...}
-type ResourceTypeInput interface {
-	pulumi.Input
-
-	ToResourceTypeOutput() ResourceTypeOutput
-	ToResourceTypeOutputWithContext(context.Context) ResourceTypeOutput
+type ResourceType struct {
+	Name *string `pulumi:"name"`
 }
 
 type ResourceTypeArgs struct {
-	Name pulumi.StringPtrInput `pulumi:"name"`
+	Name pulumix.Input[*string] `pulumi:"name"`
 }
 
 func (ResourceTypeArgs) ElementType() reflect.Type {
@@ -142,6 +130,10 @@ func (i ResourceTypeArgs) ToResourceTypeOutputWithContext(ctx context.Context) R
 	return pulumi.ToOutputWithContext(ctx, i).(ResourceTypeOutput)
 }
 
+func (i *ResourceTypeArgs) ToOutput(ctx context.Context) pulumix.Output[*ResourceTypeArgs] {
+	return pulumix.Val(i)
+}
+
 type ResourceTypeOutput struct{ *pulumi.OutputState }
 
 func (ResourceTypeOutput) ElementType() reflect.Type {
@@ -156,14 +148,17 @@ func (o ResourceTypeOutput) ToResourceTypeOutputWithContext(ctx context.Context)
 	return o
 }
 
-func (o ResourceTypeOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ResourceType) *string { return v.Name }).(pulumi.StringPtrOutput)
+func (o ResourceTypeOutput) ToOutput(ctx context.Context) pulumix.Output[ResourceType] {
+	return pulumix.Output[ResourceType]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResourceTypeOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[ResourceType](o, func(v ResourceType) *string { return v.Name })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectInput)(nil)).Elem(), ObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectInputTypeInput)(nil)).Elem(), ObjectInputTypeArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ResourceTypeInput)(nil)).Elem(), ResourceTypeArgs{})
 	pulumi.RegisterOutputType(ObjectOutput{})
 	pulumi.RegisterOutputType(ObjectInputTypeOutput{})
 	pulumi.RegisterOutputType(ResourceTypeOutput{})
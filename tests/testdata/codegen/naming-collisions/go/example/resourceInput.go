@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"naming-collisions/example/internal"
 )
 
 type ResourceInputResource struct {
 	pulumi.CustomResourceState
 
-	Bar pulumi.StringPtrOutput `pulumi:"bar"`
+	Bar pulumix.Output[*string] `pulumi:"bar"`
 }
 
 // NewResourceInputResource registers a new resource with the given unique name, arguments, and options.
@@ -67,29 +68,10 @@ func (ResourceInputResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*resourceInputResourceArgs)(nil)).Elem()
 }
 
-type ResourceInputResourceInput interface {
-	pulumi.Input
-
-	ToResourceInputResourceOutput() ResourceInputResourceOutput
-	ToResourceInputResourceOutputWithContext(ctx context.Context) ResourceInputResourceOutput
-}
-
-func (*ResourceInputResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**ResourceInputResource)(nil)).Elem()
-}
-
-func (i *ResourceInputResource) ToResourceInputResourceOutput() ResourceInputResourceOutput {
-	return i.ToResourceInputResourceOutputWithContext(context.Background())
-}
-
-func (i *ResourceInputResource) ToResourceInputResourceOutputWithContext(ctx context.Context) ResourceInputResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ResourceInputResourceOutput)
-}
-
 type ResourceInputResourceOutput struct{ *pulumi.OutputState }
 
 func (ResourceInputResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ResourceInputResource)(nil)).Elem()
+	return reflect.TypeOf((*ResourceInputResource)(nil)).Elem()
 }
 
 func (o ResourceInputResourceOutput) ToResourceInputResourceOutput() ResourceInputResourceOutput {
@@ -100,11 +82,17 @@ func (o ResourceInputResourceOutput) ToResourceInputResourceOutputWithContext(ct
 	return o
 }
 
-func (o ResourceInputResourceOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ResourceInputResource) pulumi.StringPtrOutput { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ResourceInputResourceOutput) ToOutput(ctx context.Context) pulumix.Output[ResourceInputResource] {
+	return pulumix.Output[ResourceInputResource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResourceInputResourceOutput) Bar() pulumix.Output[*string] {
+	value := pulumix.Apply[ResourceInputResource](o, func(v ResourceInputResource) pulumix.Output[*string] { return v.Bar })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ResourceInputResourceInput)(nil)).Elem(), &ResourceInputResource{})
 	pulumi.RegisterOutputType(ResourceInputResourceOutput{})
 }
@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"nested-module-thirdparty/foo/internal"
 )
 
 type Resource struct {
 	pulumi.CustomResourceState
 
-	Baz pulumi.StringPtrOutput `pulumi:"baz"`
+	Baz pulumix.Output[*string] `pulumi:"baz"`
 }
 
 // NewResource registers a new resource with the given unique name, arguments, and options.
@@ -25,7 +26,8 @@ func NewResource(ctx *pulumi.Context,
 	}
 
 	if args.Baz != nil {
-		args.Baz = pulumi.ToSecret(args.Baz).(pulumi.StringPtrInput)
+		untypedSecretValue := pulumi.ToSecret(args.Baz.ToOutput(ctx.Context()).Untyped())
+		args.Baz = pulumix.MustConvertTyped[*string](untypedSecretValue)
 	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
 		"baz",
@@ -69,36 +71,17 @@ type resourceArgs struct {
 
 // The set of arguments for constructing a Resource resource.
 type ResourceArgs struct {
-	Baz pulumi.StringPtrInput
+	Baz pulumix.Input[*string]
 }
 
 func (ResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*resourceArgs)(nil)).Elem()
 }
 
-type ResourceInput interface {
-	pulumi.Input
-
-	ToResourceOutput() ResourceOutput
-	ToResourceOutputWithContext(ctx context.Context) ResourceOutput
-}
-
-func (*Resource) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
-}
-
-func (i *Resource) ToResourceOutput() ResourceOutput {
-	return i.ToResourceOutputWithContext(context.Background())
-}
-
-func (i *Resource) ToResourceOutputWithContext(ctx context.Context) ResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ResourceOutput)
-}
-
 type ResourceOutput struct{ *pulumi.OutputState }
 
 func (ResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
+	return reflect.TypeOf((*Resource)(nil)).Elem()
 }
 
 func (o ResourceOutput) ToResourceOutput() ResourceOutput {
@@ -109,11 +92,17 @@ func (o ResourceOutput) ToResourceOutputWithContext(ctx context.Context) Resourc
 	return o
 }
 
-func (o ResourceOutput) Baz() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Resource) pulumi.StringPtrOutput { return v.Baz }).(pulumi.StringPtrOutput)
+func (o ResourceOutput) ToOutput(ctx context.Context) pulumix.Output[Resource] {
+	return pulumix.Output[Resource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResourceOutput) Baz() pulumix.Output[*string] {
+	value := pulumix.Apply[Resource](o, func(v Resource) pulumix.Output[*string] { return v.Baz })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ResourceInput)(nil)).Elem(), &Resource{})
 	pulumi.RegisterOutputType(ResourceOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -41,56 +42,55 @@ type ListConfigurationsResult struct {
 }
 
 func ListConfigurationsOutput(ctx *pulumi.Context, args ListConfigurationsOutputArgs, opts ...pulumi.InvokeOption) ListConfigurationsResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (ListConfigurationsResult, error) {
-			args := v.(ListConfigurationsArgs)
-			r, err := ListConfigurations(ctx, &args, opts...)
-			var s ListConfigurationsResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(ListConfigurationsResultOutput)
+	outputResult := pulumix.ApplyErr[*ListConfigurationsArgs](args.ToOutput(), func(plainArgs *ListConfigurationsArgs) (*ListConfigurationsResult, error) {
+		return ListConfigurations(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[ListConfigurationsResultOutput, *ListConfigurationsResult](outputResult)
 }
 
 type ListConfigurationsOutputArgs struct {
 	// Holds details about product hierarchy information and filterable property.
-	ConfigurationFilters ConfigurationFiltersArrayInput `pulumi:"configurationFilters"`
+	ConfigurationFilters pulumix.Input[[]*ConfigurationFiltersArgs] `pulumi:"configurationFilters"`
 	// Customer subscription properties. Clients can display available products to unregistered customers by explicitly passing subscription details
-	CustomerSubscriptionDetails CustomerSubscriptionDetailsPtrInput `pulumi:"customerSubscriptionDetails"`
+	CustomerSubscriptionDetails pulumix.Input[*CustomerSubscriptionDetailsArgs] `pulumi:"customerSubscriptionDetails"`
 	// $skipToken is supported on list of configurations, which provides the next page in the list of configurations.
-	SkipToken pulumi.StringPtrInput `pulumi:"skipToken"`
+	SkipToken pulumix.Input[*string] `pulumi:"skipToken"`
 }
 
-func (ListConfigurationsOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ListConfigurationsArgs)(nil)).Elem()
+func (args ListConfigurationsOutputArgs) ToOutput() pulumix.Output[*ListConfigurationsArgs] {
+	allArgs := pulumix.All(
+		args.ConfigurationFilters.ToOutput(context.Background()).AsAny(),
+		args.CustomerSubscriptionDetails.ToOutput(context.Background()).AsAny(),
+		args.SkipToken.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *ListConfigurationsArgs {
+		return &ListConfigurationsArgs{
+			ConfigurationFilters:        resolvedArgs[0].([]ConfigurationFilters),
+			CustomerSubscriptionDetails: resolvedArgs[1].(*CustomerSubscriptionDetails),
+			SkipToken:                   resolvedArgs[2].(*string),
+		}
+	})
 }
 
-// The list of configurations.
 type ListConfigurationsResultOutput struct{ *pulumi.OutputState }
 
 func (ListConfigurationsResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ListConfigurationsResult)(nil)).Elem()
 }
 
-func (o ListConfigurationsResultOutput) ToListConfigurationsResultOutput() ListConfigurationsResultOutput {
-	return o
-}
-
-func (o ListConfigurationsResultOutput) ToListConfigurationsResultOutputWithContext(ctx context.Context) ListConfigurationsResultOutput {
-	return o
-}
-
-// Link for the next set of configurations.
-func (o ListConfigurationsResultOutput) NextLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ListConfigurationsResult) *string { return v.NextLink }).(pulumi.StringPtrOutput)
+func (o ListConfigurationsResultOutput) ToOutput(context.Context) pulumix.Output[*ListConfigurationsResult] {
+	return pulumix.Output[*ListConfigurationsResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-// List of configurations.
-func (o ListConfigurationsResultOutput) Value() ConfigurationResponseArrayOutput {
-	return o.ApplyT(func(v ListConfigurationsResult) []ConfigurationResponse { return v.Value }).(ConfigurationResponseArrayOutput)
+func (o ListConfigurationsResultOutput) NextLink() pulumix.Output[*string] {
+	return pulumix.Apply[*ListConfigurationsResult](o, func(v *ListConfigurationsResult) *string { return v.NextLink })
 }
 
-func init() {
-	pulumi.RegisterOutputType(ListConfigurationsResultOutput{})
+func (o ListConfigurationsResultOutput) Value() pulumix.GArrayOutput[ConfigurationResponse, ConfigurationResponseOutput] {
+	value := pulumix.Apply[*ListConfigurationsResult](o, func(v *ListConfigurationsResult) []ConfigurationResponse { return v.Value })
+	return pulumix.GArrayOutput[ConfigurationResponse, ConfigurationResponseOutput]{
+		OutputState: value.OutputState,
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -43,58 +44,59 @@ type ListProductFamiliesResult struct {
 }
 
 func ListProductFamiliesOutput(ctx *pulumi.Context, args ListProductFamiliesOutputArgs, opts ...pulumi.InvokeOption) ListProductFamiliesResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (ListProductFamiliesResult, error) {
-			args := v.(ListProductFamiliesArgs)
-			r, err := ListProductFamilies(ctx, &args, opts...)
-			var s ListProductFamiliesResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(ListProductFamiliesResultOutput)
+	outputResult := pulumix.ApplyErr[*ListProductFamiliesArgs](args.ToOutput(), func(plainArgs *ListProductFamiliesArgs) (*ListProductFamiliesResult, error) {
+		return ListProductFamilies(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[ListProductFamiliesResultOutput, *ListProductFamiliesResult](outputResult)
 }
 
 type ListProductFamiliesOutputArgs struct {
 	// Customer subscription properties. Clients can display available products to unregistered customers by explicitly passing subscription details
-	CustomerSubscriptionDetails CustomerSubscriptionDetailsPtrInput `pulumi:"customerSubscriptionDetails"`
+	CustomerSubscriptionDetails pulumix.Input[*CustomerSubscriptionDetailsArgs] `pulumi:"customerSubscriptionDetails"`
 	// $expand is supported on configurations parameter for product, which provides details on the configurations for the product.
-	Expand pulumi.StringPtrInput `pulumi:"expand"`
+	Expand pulumix.Input[*string] `pulumi:"expand"`
 	// Dictionary of filterable properties on product family.
-	FilterableProperties FilterablePropertyArrayMapInput `pulumi:"filterableProperties"`
+	FilterableProperties pulumix.Input[map[string][]*FilterablePropertyArgs] `pulumi:"filterableProperties"`
 	// $skipToken is supported on list of product families, which provides the next page in the list of product families.
-	SkipToken pulumi.StringPtrInput `pulumi:"skipToken"`
+	SkipToken pulumix.Input[*string] `pulumi:"skipToken"`
 }
 
-func (ListProductFamiliesOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*ListProductFamiliesArgs)(nil)).Elem()
+func (args ListProductFamiliesOutputArgs) ToOutput() pulumix.Output[*ListProductFamiliesArgs] {
+	allArgs := pulumix.All(
+		args.CustomerSubscriptionDetails.ToOutput(context.Background()).AsAny(),
+		args.Expand.ToOutput(context.Background()).AsAny(),
+		args.FilterableProperties.ToOutput(context.Background()).AsAny(),
+		args.SkipToken.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *ListProductFamiliesArgs {
+		return &ListProductFamiliesArgs{
+			CustomerSubscriptionDetails: resolvedArgs[0].(*CustomerSubscriptionDetails),
+			Expand:                      resolvedArgs[1].(*string),
+			FilterableProperties:        resolvedArgs[2].(map[string][]FilterableProperty),
+			SkipToken:                   resolvedArgs[3].(*string),
+		}
+	})
 }
 
-// The list of product families.
 type ListProductFamiliesResultOutput struct{ *pulumi.OutputState }
 
 func (ListProductFamiliesResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*ListProductFamiliesResult)(nil)).Elem()
 }
 
-func (o ListProductFamiliesResultOutput) ToListProductFamiliesResultOutput() ListProductFamiliesResultOutput {
-	return o
-}
-
-func (o ListProductFamiliesResultOutput) ToListProductFamiliesResultOutputWithContext(ctx context.Context) ListProductFamiliesResultOutput {
-	return o
-}
-
-// Link for the next set of product families.
-func (o ListProductFamiliesResultOutput) NextLink() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ListProductFamiliesResult) *string { return v.NextLink }).(pulumi.StringPtrOutput)
+func (o ListProductFamiliesResultOutput) ToOutput(context.Context) pulumix.Output[*ListProductFamiliesResult] {
+	return pulumix.Output[*ListProductFamiliesResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-// List of product families.
-func (o ListProductFamiliesResultOutput) Value() ProductFamilyResponseArrayOutput {
-	return o.ApplyT(func(v ListProductFamiliesResult) []ProductFamilyResponse { return v.Value }).(ProductFamilyResponseArrayOutput)
+func (o ListProductFamiliesResultOutput) NextLink() pulumix.Output[*string] {
+	return pulumix.Apply[*ListProductFamiliesResult](o, func(v *ListProductFamiliesResult) *string { return v.NextLink })
 }
 
-func init() {
-	pulumi.RegisterOutputType(ListProductFamiliesResultOutput{})
+func (o ListProductFamiliesResultOutput) Value() pulumix.GArrayOutput[ProductFamilyResponse, ProductFamilyResponseOutput] {
+	value := pulumix.Apply[*ListProductFamiliesResult](o, func(v *ListProductFamiliesResult) []ProductFamilyResponse { return v.Value })
+	return pulumix.GArrayOutput[ProductFamilyResponse, ProductFamilyResponseOutput]{
+		OutputState: value.OutputState,
+	}
 }
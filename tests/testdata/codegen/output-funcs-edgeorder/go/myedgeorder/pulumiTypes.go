@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -23,25 +24,14 @@ type AvailabilityInformationResponse struct {
 	DisabledReasonMessage string `pulumi:"disabledReasonMessage"`
 }
 
-// AvailabilityInformationResponseInput is an input type that accepts AvailabilityInformationResponseArgs and AvailabilityInformationResponseOutput values.
-// You can construct a concrete instance of `AvailabilityInformationResponseInput` via:
-//
-//	AvailabilityInformationResponseArgs{...}
-type AvailabilityInformationResponseInput interface {
-	pulumi.Input
-
-	ToAvailabilityInformationResponseOutput() AvailabilityInformationResponseOutput
-	ToAvailabilityInformationResponseOutputWithContext(context.Context) AvailabilityInformationResponseOutput
-}
-
 // Availability information of a product system.
 type AvailabilityInformationResponseArgs struct {
 	// Current availability stage of the product. Availability stage
-	AvailabilityStage pulumi.StringInput `pulumi:"availabilityStage"`
+	AvailabilityStage pulumix.Input[string] `pulumi:"availabilityStage"`
 	// Reason why the product is disabled.
-	DisabledReason pulumi.StringInput `pulumi:"disabledReason"`
+	DisabledReason pulumix.Input[string] `pulumi:"disabledReason"`
 	// Message for why the product is disabled.
-	DisabledReasonMessage pulumi.StringInput `pulumi:"disabledReasonMessage"`
+	DisabledReasonMessage pulumix.Input[string] `pulumi:"disabledReasonMessage"`
 }
 
 func (AvailabilityInformationResponseArgs) ElementType() reflect.Type {
@@ -56,6 +46,10 @@ func (i AvailabilityInformationResponseArgs) ToAvailabilityInformationResponseOu
 	return pulumi.ToOutputWithContext(ctx, i).(AvailabilityInformationResponseOutput)
 }
 
+func (i *AvailabilityInformationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*AvailabilityInformationResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Availability information of a product system.
 type AvailabilityInformationResponseOutput struct{ *pulumi.OutputState }
 
@@ -71,19 +65,25 @@ func (o AvailabilityInformationResponseOutput) ToAvailabilityInformationResponse
 	return o
 }
 
+func (o AvailabilityInformationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[AvailabilityInformationResponse] {
+	return pulumix.Output[AvailabilityInformationResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Current availability stage of the product. Availability stage
-func (o AvailabilityInformationResponseOutput) AvailabilityStage() pulumi.StringOutput {
-	return o.ApplyT(func(v AvailabilityInformationResponse) string { return v.AvailabilityStage }).(pulumi.StringOutput)
+func (o AvailabilityInformationResponseOutput) AvailabilityStage() pulumix.Output[string] {
+	return pulumix.Apply[AvailabilityInformationResponse](o, func(v AvailabilityInformationResponse) string { return v.AvailabilityStage })
 }
 
 // Reason why the product is disabled.
-func (o AvailabilityInformationResponseOutput) DisabledReason() pulumi.StringOutput {
-	return o.ApplyT(func(v AvailabilityInformationResponse) string { return v.DisabledReason }).(pulumi.StringOutput)
+func (o AvailabilityInformationResponseOutput) DisabledReason() pulumix.Output[string] {
+	return pulumix.Apply[AvailabilityInformationResponse](o, func(v AvailabilityInformationResponse) string { return v.DisabledReason })
 }
 
 // Message for why the product is disabled.
-func (o AvailabilityInformationResponseOutput) DisabledReasonMessage() pulumi.StringOutput {
-	return o.ApplyT(func(v AvailabilityInformationResponse) string { return v.DisabledReasonMessage }).(pulumi.StringOutput)
+func (o AvailabilityInformationResponseOutput) DisabledReasonMessage() pulumix.Output[string] {
+	return pulumix.Apply[AvailabilityInformationResponse](o, func(v AvailabilityInformationResponse) string { return v.DisabledReasonMessage })
 }
 
 // Holds billing meter details for each type of billing
@@ -91,34 +91,23 @@ type BillingMeterDetailsResponse struct {
 	// Frequency of recurrence
 	Frequency string `pulumi:"frequency"`
 	// Represents MeterDetails
-	MeterDetails interface{} `pulumi:"meterDetails"`
+	MeterDetails any `pulumi:"meterDetails"`
 	// Represents Metering type (eg one-time or recurrent)
 	MeteringType string `pulumi:"meteringType"`
 	// Represents Billing type name
 	Name string `pulumi:"name"`
 }
 
-// BillingMeterDetailsResponseInput is an input type that accepts BillingMeterDetailsResponseArgs and BillingMeterDetailsResponseOutput values.
-// You can construct a concrete instance of `BillingMeterDetailsResponseInput` via:
-//
-//	BillingMeterDetailsResponseArgs{...}
-type BillingMeterDetailsResponseInput interface {
-	pulumi.Input
-
-	ToBillingMeterDetailsResponseOutput() BillingMeterDetailsResponseOutput
-	ToBillingMeterDetailsResponseOutputWithContext(context.Context) BillingMeterDetailsResponseOutput
-}
-
 // Holds billing meter details for each type of billing
 type BillingMeterDetailsResponseArgs struct {
 	// Frequency of recurrence
-	Frequency pulumi.StringInput `pulumi:"frequency"`
+	Frequency pulumix.Input[string] `pulumi:"frequency"`
 	// Represents MeterDetails
-	MeterDetails pulumi.Input `pulumi:"meterDetails"`
+	MeterDetails pulumix.Input[any] `pulumi:"meterDetails"`
 	// Represents Metering type (eg one-time or recurrent)
-	MeteringType pulumi.StringInput `pulumi:"meteringType"`
+	MeteringType pulumix.Input[string] `pulumi:"meteringType"`
 	// Represents Billing type name
-	Name pulumi.StringInput `pulumi:"name"`
+	Name pulumix.Input[string] `pulumi:"name"`
 }
 
 func (BillingMeterDetailsResponseArgs) ElementType() reflect.Type {
@@ -133,29 +122,8 @@ func (i BillingMeterDetailsResponseArgs) ToBillingMeterDetailsResponseOutputWith
 	return pulumi.ToOutputWithContext(ctx, i).(BillingMeterDetailsResponseOutput)
 }
 
-// BillingMeterDetailsResponseArrayInput is an input type that accepts BillingMeterDetailsResponseArray and BillingMeterDetailsResponseArrayOutput values.
-// You can construct a concrete instance of `BillingMeterDetailsResponseArrayInput` via:
-//
-//	BillingMeterDetailsResponseArray{ BillingMeterDetailsResponseArgs{...} }
-type BillingMeterDetailsResponseArrayInput interface {
-	pulumi.Input
-
-	ToBillingMeterDetailsResponseArrayOutput() BillingMeterDetailsResponseArrayOutput
-	ToBillingMeterDetailsResponseArrayOutputWithContext(context.Context) BillingMeterDetailsResponseArrayOutput
-}
-
-type BillingMeterDetailsResponseArray []BillingMeterDetailsResponseInput
-
-func (BillingMeterDetailsResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]BillingMeterDetailsResponse)(nil)).Elem()
-}
-
-func (i BillingMeterDetailsResponseArray) ToBillingMeterDetailsResponseArrayOutput() BillingMeterDetailsResponseArrayOutput {
-	return i.ToBillingMeterDetailsResponseArrayOutputWithContext(context.Background())
-}
-
-func (i BillingMeterDetailsResponseArray) ToBillingMeterDetailsResponseArrayOutputWithContext(ctx context.Context) BillingMeterDetailsResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(BillingMeterDetailsResponseArrayOutput)
+func (i *BillingMeterDetailsResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*BillingMeterDetailsResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Holds billing meter details for each type of billing
@@ -173,71 +141,46 @@ func (o BillingMeterDetailsResponseOutput) ToBillingMeterDetailsResponseOutputWi
 	return o
 }
 
+func (o BillingMeterDetailsResponseOutput) ToOutput(ctx context.Context) pulumix.Output[BillingMeterDetailsResponse] {
+	return pulumix.Output[BillingMeterDetailsResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Frequency of recurrence
-func (o BillingMeterDetailsResponseOutput) Frequency() pulumi.StringOutput {
-	return o.ApplyT(func(v BillingMeterDetailsResponse) string { return v.Frequency }).(pulumi.StringOutput)
+func (o BillingMeterDetailsResponseOutput) Frequency() pulumix.Output[string] {
+	return pulumix.Apply[BillingMeterDetailsResponse](o, func(v BillingMeterDetailsResponse) string { return v.Frequency })
 }
 
 // Represents MeterDetails
-func (o BillingMeterDetailsResponseOutput) MeterDetails() pulumi.AnyOutput {
-	return o.ApplyT(func(v BillingMeterDetailsResponse) interface{} { return v.MeterDetails }).(pulumi.AnyOutput)
+func (o BillingMeterDetailsResponseOutput) MeterDetails() pulumix.Output[interface{}] {
+	return pulumix.Apply[BillingMeterDetailsResponse](o, func(v BillingMeterDetailsResponse) any { return v.MeterDetails })
 }
 
 // Represents Metering type (eg one-time or recurrent)
-func (o BillingMeterDetailsResponseOutput) MeteringType() pulumi.StringOutput {
-	return o.ApplyT(func(v BillingMeterDetailsResponse) string { return v.MeteringType }).(pulumi.StringOutput)
+func (o BillingMeterDetailsResponseOutput) MeteringType() pulumix.Output[string] {
+	return pulumix.Apply[BillingMeterDetailsResponse](o, func(v BillingMeterDetailsResponse) string { return v.MeteringType })
 }
 
 // Represents Billing type name
-func (o BillingMeterDetailsResponseOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v BillingMeterDetailsResponse) string { return v.Name }).(pulumi.StringOutput)
-}
-
-type BillingMeterDetailsResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (BillingMeterDetailsResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]BillingMeterDetailsResponse)(nil)).Elem()
-}
-
-func (o BillingMeterDetailsResponseArrayOutput) ToBillingMeterDetailsResponseArrayOutput() BillingMeterDetailsResponseArrayOutput {
-	return o
-}
-
-func (o BillingMeterDetailsResponseArrayOutput) ToBillingMeterDetailsResponseArrayOutputWithContext(ctx context.Context) BillingMeterDetailsResponseArrayOutput {
-	return o
-}
-
-func (o BillingMeterDetailsResponseArrayOutput) Index(i pulumi.IntInput) BillingMeterDetailsResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BillingMeterDetailsResponse {
-		return vs[0].([]BillingMeterDetailsResponse)[vs[1].(int)]
-	}).(BillingMeterDetailsResponseOutput)
+func (o BillingMeterDetailsResponseOutput) Name() pulumix.Output[string] {
+	return pulumix.Apply[BillingMeterDetailsResponse](o, func(v BillingMeterDetailsResponse) string { return v.Name })
 }
 
 // Configuration filters
 type ConfigurationFilters struct {
 	// Filters specific to product
-	FilterableProperty []FilterableProperty `pulumi:"filterableProperty"`
+	FilterableProperty []*FilterableProperty `pulumi:"filterableProperty"`
 	// Product hierarchy information
-	HierarchyInformation HierarchyInformation `pulumi:"hierarchyInformation"`
-}
-
-// ConfigurationFiltersInput is an input type that accepts ConfigurationFiltersArgs and ConfigurationFiltersOutput values.
-// You can construct a concrete instance of `ConfigurationFiltersInput` via:
-//
-//	ConfigurationFiltersArgs{...}
-type ConfigurationFiltersInput interface {
-	pulumi.Input
-
-	ToConfigurationFiltersOutput() ConfigurationFiltersOutput
-	ToConfigurationFiltersOutputWithContext(context.Context) ConfigurationFiltersOutput
+	HierarchyInformation *HierarchyInformation `pulumi:"hierarchyInformation"`
 }
 
 // Configuration filters
 type ConfigurationFiltersArgs struct {
 	// Filters specific to product
-	FilterableProperty FilterablePropertyArrayInput `pulumi:"filterableProperty"`
+	FilterableProperty pulumix.Input[[]*FilterablePropertyArgs] `pulumi:"filterableProperty"`
 	// Product hierarchy information
-	HierarchyInformation HierarchyInformationInput `pulumi:"hierarchyInformation"`
+	HierarchyInformation pulumix.Input[*HierarchyInformationArgs] `pulumi:"hierarchyInformation"`
 }
 
 func (ConfigurationFiltersArgs) ElementType() reflect.Type {
@@ -252,29 +195,8 @@ func (i ConfigurationFiltersArgs) ToConfigurationFiltersOutputWithContext(ctx co
 	return pulumi.ToOutputWithContext(ctx, i).(ConfigurationFiltersOutput)
 }
 
-// ConfigurationFiltersArrayInput is an input type that accepts ConfigurationFiltersArray and ConfigurationFiltersArrayOutput values.
-// You can construct a concrete instance of `ConfigurationFiltersArrayInput` via:
-//
-//	ConfigurationFiltersArray{ ConfigurationFiltersArgs{...} }
-type ConfigurationFiltersArrayInput interface {
-	pulumi.Input
-
-	ToConfigurationFiltersArrayOutput() ConfigurationFiltersArrayOutput
-	ToConfigurationFiltersArrayOutputWithContext(context.Context) ConfigurationFiltersArrayOutput
-}
-
-type ConfigurationFiltersArray []ConfigurationFiltersInput
-
-func (ConfigurationFiltersArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigurationFilters)(nil)).Elem()
-}
-
-func (i ConfigurationFiltersArray) ToConfigurationFiltersArrayOutput() ConfigurationFiltersArrayOutput {
-	return i.ToConfigurationFiltersArrayOutputWithContext(context.Background())
-}
-
-func (i ConfigurationFiltersArray) ToConfigurationFiltersArrayOutputWithContext(ctx context.Context) ConfigurationFiltersArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigurationFiltersArrayOutput)
+func (i *ConfigurationFiltersArgs) ToOutput(ctx context.Context) pulumix.Output[*ConfigurationFiltersArgs] {
+	return pulumix.Val(i)
 }
 
 // Configuration filters
@@ -292,89 +214,66 @@ func (o ConfigurationFiltersOutput) ToConfigurationFiltersOutputWithContext(ctx
 	return o
 }
 
-// Filters specific to product
-func (o ConfigurationFiltersOutput) FilterableProperty() FilterablePropertyArrayOutput {
-	return o.ApplyT(func(v ConfigurationFilters) []FilterableProperty { return v.FilterableProperty }).(FilterablePropertyArrayOutput)
-}
-
-// Product hierarchy information
-func (o ConfigurationFiltersOutput) HierarchyInformation() HierarchyInformationOutput {
-	return o.ApplyT(func(v ConfigurationFilters) HierarchyInformation { return v.HierarchyInformation }).(HierarchyInformationOutput)
-}
-
-type ConfigurationFiltersArrayOutput struct{ *pulumi.OutputState }
-
-func (ConfigurationFiltersArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigurationFilters)(nil)).Elem()
-}
-
-func (o ConfigurationFiltersArrayOutput) ToConfigurationFiltersArrayOutput() ConfigurationFiltersArrayOutput {
-	return o
+func (o ConfigurationFiltersOutput) ToOutput(ctx context.Context) pulumix.Output[ConfigurationFilters] {
+	return pulumix.Output[ConfigurationFilters]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ConfigurationFiltersArrayOutput) ToConfigurationFiltersArrayOutputWithContext(ctx context.Context) ConfigurationFiltersArrayOutput {
-	return o
+// Filters specific to product
+func (o ConfigurationFiltersOutput) FilterableProperty() pulumix.GArrayOutput[FilterableProperty, FilterablePropertyOutput] {
+	value := pulumix.Apply[ConfigurationFilters](o, func(v ConfigurationFilters) []*FilterableProperty { return v.FilterableProperty })
+	return pulumix.GArrayOutput[FilterableProperty, FilterablePropertyOutput]{OutputState: value.OutputState}
 }
 
-func (o ConfigurationFiltersArrayOutput) Index(i pulumi.IntInput) ConfigurationFiltersOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ConfigurationFilters {
-		return vs[0].([]ConfigurationFilters)[vs[1].(int)]
-	}).(ConfigurationFiltersOutput)
+// Product hierarchy information
+func (o ConfigurationFiltersOutput) HierarchyInformation() pulumix.GPtrOutput[HierarchyInformation, HierarchyInformationOutput] {
+	value := pulumix.Apply[ConfigurationFilters](o, func(v ConfigurationFilters) *HierarchyInformation { return v.HierarchyInformation })
+	return pulumix.GPtrOutput[HierarchyInformation, HierarchyInformationOutput]{OutputState: value.OutputState}
 }
 
 // Configuration object.
 type ConfigurationResponse struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponse `pulumi:"availabilityInformation"`
+	AvailabilityInformation *AvailabilityInformationResponse `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponse `pulumi:"costInformation"`
+	CostInformation *CostInformationResponse `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponse `pulumi:"description"`
+	Description *DescriptionResponse `pulumi:"description"`
 	// Dimensions of the configuration
-	Dimensions DimensionsResponse `pulumi:"dimensions"`
+	Dimensions *DimensionsResponse `pulumi:"dimensions"`
 	// Display Name for the product system.
 	DisplayName string `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties []FilterablePropertyResponse `pulumi:"filterableProperties"`
+	FilterableProperties []*FilterablePropertyResponse `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponse `pulumi:"hierarchyInformation"`
+	HierarchyInformation *HierarchyInformationResponse `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation []ImageInformationResponse `pulumi:"imageInformation"`
+	ImageInformation []*ImageInformationResponse `pulumi:"imageInformation"`
 	// Specifications of the configuration
-	Specifications []SpecificationResponse `pulumi:"specifications"`
-}
-
-// ConfigurationResponseInput is an input type that accepts ConfigurationResponseArgs and ConfigurationResponseOutput values.
-// You can construct a concrete instance of `ConfigurationResponseInput` via:
-//
-//	ConfigurationResponseArgs{...}
-type ConfigurationResponseInput interface {
-	pulumi.Input
-
-	ToConfigurationResponseOutput() ConfigurationResponseOutput
-	ToConfigurationResponseOutputWithContext(context.Context) ConfigurationResponseOutput
+	Specifications []*SpecificationResponse `pulumi:"specifications"`
 }
 
 // Configuration object.
 type ConfigurationResponseArgs struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponseInput `pulumi:"availabilityInformation"`
+	AvailabilityInformation pulumix.Input[*AvailabilityInformationResponseArgs] `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponseInput `pulumi:"costInformation"`
+	CostInformation pulumix.Input[*CostInformationResponseArgs] `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponseInput `pulumi:"description"`
+	Description pulumix.Input[*DescriptionResponseArgs] `pulumi:"description"`
 	// Dimensions of the configuration
-	Dimensions DimensionsResponseInput `pulumi:"dimensions"`
+	Dimensions pulumix.Input[*DimensionsResponseArgs] `pulumi:"dimensions"`
 	// Display Name for the product system.
-	DisplayName pulumi.StringInput `pulumi:"displayName"`
+	DisplayName pulumix.Input[string] `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties FilterablePropertyResponseArrayInput `pulumi:"filterableProperties"`
+	FilterableProperties pulumix.Input[[]*FilterablePropertyResponseArgs] `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponseInput `pulumi:"hierarchyInformation"`
+	HierarchyInformation pulumix.Input[*HierarchyInformationResponseArgs] `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation ImageInformationResponseArrayInput `pulumi:"imageInformation"`
+	ImageInformation pulumix.Input[[]*ImageInformationResponseArgs] `pulumi:"imageInformation"`
 	// Specifications of the configuration
-	Specifications SpecificationResponseArrayInput `pulumi:"specifications"`
+	Specifications pulumix.Input[[]*SpecificationResponseArgs] `pulumi:"specifications"`
 }
 
 func (ConfigurationResponseArgs) ElementType() reflect.Type {
@@ -389,29 +288,8 @@ func (i ConfigurationResponseArgs) ToConfigurationResponseOutputWithContext(ctx
 	return pulumi.ToOutputWithContext(ctx, i).(ConfigurationResponseOutput)
 }
 
-// ConfigurationResponseArrayInput is an input type that accepts ConfigurationResponseArray and ConfigurationResponseArrayOutput values.
-// You can construct a concrete instance of `ConfigurationResponseArrayInput` via:
-//
-//	ConfigurationResponseArray{ ConfigurationResponseArgs{...} }
-type ConfigurationResponseArrayInput interface {
-	pulumi.Input
-
-	ToConfigurationResponseArrayOutput() ConfigurationResponseArrayOutput
-	ToConfigurationResponseArrayOutputWithContext(context.Context) ConfigurationResponseArrayOutput
-}
-
-type ConfigurationResponseArray []ConfigurationResponseInput
-
-func (ConfigurationResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigurationResponse)(nil)).Elem()
-}
-
-func (i ConfigurationResponseArray) ToConfigurationResponseArrayOutput() ConfigurationResponseArrayOutput {
-	return i.ToConfigurationResponseArrayOutputWithContext(context.Background())
-}
-
-func (i ConfigurationResponseArray) ToConfigurationResponseArrayOutputWithContext(ctx context.Context) ConfigurationResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigurationResponseArrayOutput)
+func (i *ConfigurationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*ConfigurationResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Configuration object.
@@ -429,69 +307,63 @@ func (o ConfigurationResponseOutput) ToConfigurationResponseOutputWithContext(ct
 	return o
 }
 
+func (o ConfigurationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[ConfigurationResponse] {
+	return pulumix.Output[ConfigurationResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Availability information of the product system.
-func (o ConfigurationResponseOutput) AvailabilityInformation() AvailabilityInformationResponseOutput {
-	return o.ApplyT(func(v ConfigurationResponse) AvailabilityInformationResponse { return v.AvailabilityInformation }).(AvailabilityInformationResponseOutput)
+func (o ConfigurationResponseOutput) AvailabilityInformation() pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) *AvailabilityInformationResponse { return v.AvailabilityInformation })
+	return pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Cost information for the product system.
-func (o ConfigurationResponseOutput) CostInformation() CostInformationResponseOutput {
-	return o.ApplyT(func(v ConfigurationResponse) CostInformationResponse { return v.CostInformation }).(CostInformationResponseOutput)
+func (o ConfigurationResponseOutput) CostInformation() pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) *CostInformationResponse { return v.CostInformation })
+	return pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Description related to the product system.
-func (o ConfigurationResponseOutput) Description() DescriptionResponseOutput {
-	return o.ApplyT(func(v ConfigurationResponse) DescriptionResponse { return v.Description }).(DescriptionResponseOutput)
+func (o ConfigurationResponseOutput) Description() pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) *DescriptionResponse { return v.Description })
+	return pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput]{OutputState: value.OutputState}
 }
 
 // Dimensions of the configuration
-func (o ConfigurationResponseOutput) Dimensions() DimensionsResponseOutput {
-	return o.ApplyT(func(v ConfigurationResponse) DimensionsResponse { return v.Dimensions }).(DimensionsResponseOutput)
+func (o ConfigurationResponseOutput) Dimensions() pulumix.GPtrOutput[DimensionsResponse, DimensionsResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) *DimensionsResponse { return v.Dimensions })
+	return pulumix.GPtrOutput[DimensionsResponse, DimensionsResponseOutput]{OutputState: value.OutputState}
 }
 
 // Display Name for the product system.
-func (o ConfigurationResponseOutput) DisplayName() pulumi.StringOutput {
-	return o.ApplyT(func(v ConfigurationResponse) string { return v.DisplayName }).(pulumi.StringOutput)
+func (o ConfigurationResponseOutput) DisplayName() pulumix.Output[string] {
+	return pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) string { return v.DisplayName })
 }
 
 // list of filters supported for a product
-func (o ConfigurationResponseOutput) FilterableProperties() FilterablePropertyResponseArrayOutput {
-	return o.ApplyT(func(v ConfigurationResponse) []FilterablePropertyResponse { return v.FilterableProperties }).(FilterablePropertyResponseArrayOutput)
+func (o ConfigurationResponseOutput) FilterableProperties() pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) []*FilterablePropertyResponse { return v.FilterableProperties })
+	return pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput]{OutputState: value.OutputState}
 }
 
 // Hierarchy information of a product.
-func (o ConfigurationResponseOutput) HierarchyInformation() HierarchyInformationResponseOutput {
-	return o.ApplyT(func(v ConfigurationResponse) HierarchyInformationResponse { return v.HierarchyInformation }).(HierarchyInformationResponseOutput)
+func (o ConfigurationResponseOutput) HierarchyInformation() pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) *HierarchyInformationResponse { return v.HierarchyInformation })
+	return pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Image information for the product system.
-func (o ConfigurationResponseOutput) ImageInformation() ImageInformationResponseArrayOutput {
-	return o.ApplyT(func(v ConfigurationResponse) []ImageInformationResponse { return v.ImageInformation }).(ImageInformationResponseArrayOutput)
+func (o ConfigurationResponseOutput) ImageInformation() pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) []*ImageInformationResponse { return v.ImageInformation })
+	return pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Specifications of the configuration
-func (o ConfigurationResponseOutput) Specifications() SpecificationResponseArrayOutput {
-	return o.ApplyT(func(v ConfigurationResponse) []SpecificationResponse { return v.Specifications }).(SpecificationResponseArrayOutput)
-}
-
-type ConfigurationResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (ConfigurationResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigurationResponse)(nil)).Elem()
-}
-
-func (o ConfigurationResponseArrayOutput) ToConfigurationResponseArrayOutput() ConfigurationResponseArrayOutput {
-	return o
-}
-
-func (o ConfigurationResponseArrayOutput) ToConfigurationResponseArrayOutputWithContext(ctx context.Context) ConfigurationResponseArrayOutput {
-	return o
-}
-
-func (o ConfigurationResponseArrayOutput) Index(i pulumi.IntInput) ConfigurationResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ConfigurationResponse {
-		return vs[0].([]ConfigurationResponse)[vs[1].(int)]
-	}).(ConfigurationResponseOutput)
+func (o ConfigurationResponseOutput) Specifications() pulumix.GArrayOutput[SpecificationResponse, SpecificationResponseOutput] {
+	value := pulumix.Apply[ConfigurationResponse](o, func(v ConfigurationResponse) []*SpecificationResponse { return v.Specifications })
+	return pulumix.GArrayOutput[SpecificationResponse, SpecificationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Cost information for the product system
@@ -499,26 +371,15 @@ type CostInformationResponse struct {
 	// Default url to display billing information
 	BillingInfoUrl string `pulumi:"billingInfoUrl"`
 	// Details on the various billing aspects for the product system.
-	BillingMeterDetails []BillingMeterDetailsResponse `pulumi:"billingMeterDetails"`
-}
-
-// CostInformationResponseInput is an input type that accepts CostInformationResponseArgs and CostInformationResponseOutput values.
-// You can construct a concrete instance of `CostInformationResponseInput` via:
-//
-//	CostInformationResponseArgs{...}
-type CostInformationResponseInput interface {
-	pulumi.Input
-
-	ToCostInformationResponseOutput() CostInformationResponseOutput
-	ToCostInformationResponseOutputWithContext(context.Context) CostInformationResponseOutput
+	BillingMeterDetails []*BillingMeterDetailsResponse `pulumi:"billingMeterDetails"`
 }
 
 // Cost information for the product system
 type CostInformationResponseArgs struct {
 	// Default url to display billing information
-	BillingInfoUrl pulumi.StringInput `pulumi:"billingInfoUrl"`
+	BillingInfoUrl pulumix.Input[string] `pulumi:"billingInfoUrl"`
 	// Details on the various billing aspects for the product system.
-	BillingMeterDetails BillingMeterDetailsResponseArrayInput `pulumi:"billingMeterDetails"`
+	BillingMeterDetails pulumix.Input[[]*BillingMeterDetailsResponseArgs] `pulumi:"billingMeterDetails"`
 }
 
 func (CostInformationResponseArgs) ElementType() reflect.Type {
@@ -533,6 +394,10 @@ func (i CostInformationResponseArgs) ToCostInformationResponseOutputWithContext(
 	return pulumi.ToOutputWithContext(ctx, i).(CostInformationResponseOutput)
 }
 
+func (i *CostInformationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*CostInformationResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Cost information for the product system
 type CostInformationResponseOutput struct{ *pulumi.OutputState }
 
@@ -548,14 +413,21 @@ func (o CostInformationResponseOutput) ToCostInformationResponseOutputWithContex
 	return o
 }
 
+func (o CostInformationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[CostInformationResponse] {
+	return pulumix.Output[CostInformationResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Default url to display billing information
-func (o CostInformationResponseOutput) BillingInfoUrl() pulumi.StringOutput {
-	return o.ApplyT(func(v CostInformationResponse) string { return v.BillingInfoUrl }).(pulumi.StringOutput)
+func (o CostInformationResponseOutput) BillingInfoUrl() pulumix.Output[string] {
+	return pulumix.Apply[CostInformationResponse](o, func(v CostInformationResponse) string { return v.BillingInfoUrl })
 }
 
 // Details on the various billing aspects for the product system.
-func (o CostInformationResponseOutput) BillingMeterDetails() BillingMeterDetailsResponseArrayOutput {
-	return o.ApplyT(func(v CostInformationResponse) []BillingMeterDetailsResponse { return v.BillingMeterDetails }).(BillingMeterDetailsResponseArrayOutput)
+func (o CostInformationResponseOutput) BillingMeterDetails() pulumix.GArrayOutput[BillingMeterDetailsResponse, BillingMeterDetailsResponseOutput] {
+	value := pulumix.Apply[CostInformationResponse](o, func(v CostInformationResponse) []*BillingMeterDetailsResponse { return v.BillingMeterDetails })
+	return pulumix.GArrayOutput[BillingMeterDetailsResponse, BillingMeterDetailsResponseOutput]{OutputState: value.OutputState}
 }
 
 // Holds Customer subscription details. Clients can display available products to unregistered customers by explicitly passing subscription details
@@ -565,28 +437,17 @@ type CustomerSubscriptionDetails struct {
 	// Quota ID of a subscription
 	QuotaId string `pulumi:"quotaId"`
 	// List of registered feature flags for subscription
-	RegisteredFeatures []CustomerSubscriptionRegisteredFeatures `pulumi:"registeredFeatures"`
-}
-
-// CustomerSubscriptionDetailsInput is an input type that accepts CustomerSubscriptionDetailsArgs and CustomerSubscriptionDetailsOutput values.
-// You can construct a concrete instance of `CustomerSubscriptionDetailsInput` via:
-//
-//	CustomerSubscriptionDetailsArgs{...}
-type CustomerSubscriptionDetailsInput interface {
-	pulumi.Input
-
-	ToCustomerSubscriptionDetailsOutput() CustomerSubscriptionDetailsOutput
-	ToCustomerSubscriptionDetailsOutputWithContext(context.Context) CustomerSubscriptionDetailsOutput
+	RegisteredFeatures []*CustomerSubscriptionRegisteredFeatures `pulumi:"registeredFeatures"`
 }
 
 // Holds Customer subscription details. Clients can display available products to unregistered customers by explicitly passing subscription details
 type CustomerSubscriptionDetailsArgs struct {
 	// Location placement Id of a subscription
-	LocationPlacementId pulumi.StringPtrInput `pulumi:"locationPlacementId"`
+	LocationPlacementId pulumix.Input[*string] `pulumi:"locationPlacementId"`
 	// Quota ID of a subscription
-	QuotaId pulumi.StringInput `pulumi:"quotaId"`
+	QuotaId pulumix.Input[string] `pulumi:"quotaId"`
 	// List of registered feature flags for subscription
-	RegisteredFeatures CustomerSubscriptionRegisteredFeaturesArrayInput `pulumi:"registeredFeatures"`
+	RegisteredFeatures pulumix.Input[[]*CustomerSubscriptionRegisteredFeaturesArgs] `pulumi:"registeredFeatures"`
 }
 
 func (CustomerSubscriptionDetailsArgs) ElementType() reflect.Type {
@@ -601,45 +462,8 @@ func (i CustomerSubscriptionDetailsArgs) ToCustomerSubscriptionDetailsOutputWith
 	return pulumi.ToOutputWithContext(ctx, i).(CustomerSubscriptionDetailsOutput)
 }
 
-func (i CustomerSubscriptionDetailsArgs) ToCustomerSubscriptionDetailsPtrOutput() CustomerSubscriptionDetailsPtrOutput {
-	return i.ToCustomerSubscriptionDetailsPtrOutputWithContext(context.Background())
-}
-
-func (i CustomerSubscriptionDetailsArgs) ToCustomerSubscriptionDetailsPtrOutputWithContext(ctx context.Context) CustomerSubscriptionDetailsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomerSubscriptionDetailsOutput).ToCustomerSubscriptionDetailsPtrOutputWithContext(ctx)
-}
-
-// CustomerSubscriptionDetailsPtrInput is an input type that accepts CustomerSubscriptionDetailsArgs, CustomerSubscriptionDetailsPtr and CustomerSubscriptionDetailsPtrOutput values.
-// You can construct a concrete instance of `CustomerSubscriptionDetailsPtrInput` via:
-//
-//	        CustomerSubscriptionDetailsArgs{...}
-//
-//	or:
-//
-//	        nil
-type CustomerSubscriptionDetailsPtrInput interface {
-	pulumi.Input
-
-	ToCustomerSubscriptionDetailsPtrOutput() CustomerSubscriptionDetailsPtrOutput
-	ToCustomerSubscriptionDetailsPtrOutputWithContext(context.Context) CustomerSubscriptionDetailsPtrOutput
-}
-
-type customerSubscriptionDetailsPtrType CustomerSubscriptionDetailsArgs
-
-func CustomerSubscriptionDetailsPtr(v *CustomerSubscriptionDetailsArgs) CustomerSubscriptionDetailsPtrInput {
-	return (*customerSubscriptionDetailsPtrType)(v)
-}
-
-func (*customerSubscriptionDetailsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**CustomerSubscriptionDetails)(nil)).Elem()
-}
-
-func (i *customerSubscriptionDetailsPtrType) ToCustomerSubscriptionDetailsPtrOutput() CustomerSubscriptionDetailsPtrOutput {
-	return i.ToCustomerSubscriptionDetailsPtrOutputWithContext(context.Background())
-}
-
-func (i *customerSubscriptionDetailsPtrType) ToCustomerSubscriptionDetailsPtrOutputWithContext(ctx context.Context) CustomerSubscriptionDetailsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomerSubscriptionDetailsPtrOutput)
+func (i *CustomerSubscriptionDetailsArgs) ToOutput(ctx context.Context) pulumix.Output[*CustomerSubscriptionDetailsArgs] {
+	return pulumix.Val(i)
 }
 
 // Holds Customer subscription details. Clients can display available products to unregistered customers by explicitly passing subscription details
@@ -657,85 +481,28 @@ func (o CustomerSubscriptionDetailsOutput) ToCustomerSubscriptionDetailsOutputWi
 	return o
 }
 
-func (o CustomerSubscriptionDetailsOutput) ToCustomerSubscriptionDetailsPtrOutput() CustomerSubscriptionDetailsPtrOutput {
-	return o.ToCustomerSubscriptionDetailsPtrOutputWithContext(context.Background())
-}
-
-func (o CustomerSubscriptionDetailsOutput) ToCustomerSubscriptionDetailsPtrOutputWithContext(ctx context.Context) CustomerSubscriptionDetailsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v CustomerSubscriptionDetails) *CustomerSubscriptionDetails {
-		return &v
-	}).(CustomerSubscriptionDetailsPtrOutput)
-}
-
-// Location placement Id of a subscription
-func (o CustomerSubscriptionDetailsOutput) LocationPlacementId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CustomerSubscriptionDetails) *string { return v.LocationPlacementId }).(pulumi.StringPtrOutput)
-}
-
-// Quota ID of a subscription
-func (o CustomerSubscriptionDetailsOutput) QuotaId() pulumi.StringOutput {
-	return o.ApplyT(func(v CustomerSubscriptionDetails) string { return v.QuotaId }).(pulumi.StringOutput)
-}
-
-// List of registered feature flags for subscription
-func (o CustomerSubscriptionDetailsOutput) RegisteredFeatures() CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return o.ApplyT(func(v CustomerSubscriptionDetails) []CustomerSubscriptionRegisteredFeatures {
-		return v.RegisteredFeatures
-	}).(CustomerSubscriptionRegisteredFeaturesArrayOutput)
-}
-
-type CustomerSubscriptionDetailsPtrOutput struct{ *pulumi.OutputState }
-
-func (CustomerSubscriptionDetailsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**CustomerSubscriptionDetails)(nil)).Elem()
-}
-
-func (o CustomerSubscriptionDetailsPtrOutput) ToCustomerSubscriptionDetailsPtrOutput() CustomerSubscriptionDetailsPtrOutput {
-	return o
-}
-
-func (o CustomerSubscriptionDetailsPtrOutput) ToCustomerSubscriptionDetailsPtrOutputWithContext(ctx context.Context) CustomerSubscriptionDetailsPtrOutput {
-	return o
-}
-
-func (o CustomerSubscriptionDetailsPtrOutput) Elem() CustomerSubscriptionDetailsOutput {
-	return o.ApplyT(func(v *CustomerSubscriptionDetails) CustomerSubscriptionDetails {
-		if v != nil {
-			return *v
-		}
-		var ret CustomerSubscriptionDetails
-		return ret
-	}).(CustomerSubscriptionDetailsOutput)
+func (o CustomerSubscriptionDetailsOutput) ToOutput(ctx context.Context) pulumix.Output[CustomerSubscriptionDetails] {
+	return pulumix.Output[CustomerSubscriptionDetails]{
+		OutputState: o.OutputState,
+	}
 }
 
 // Location placement Id of a subscription
-func (o CustomerSubscriptionDetailsPtrOutput) LocationPlacementId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CustomerSubscriptionDetails) *string {
-		if v == nil {
-			return nil
-		}
-		return v.LocationPlacementId
-	}).(pulumi.StringPtrOutput)
+func (o CustomerSubscriptionDetailsOutput) LocationPlacementId() pulumix.Output[*string] {
+	return pulumix.Apply[CustomerSubscriptionDetails](o, func(v CustomerSubscriptionDetails) *string { return v.LocationPlacementId })
 }
 
 // Quota ID of a subscription
-func (o CustomerSubscriptionDetailsPtrOutput) QuotaId() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CustomerSubscriptionDetails) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.QuotaId
-	}).(pulumi.StringPtrOutput)
+func (o CustomerSubscriptionDetailsOutput) QuotaId() pulumix.Output[string] {
+	return pulumix.Apply[CustomerSubscriptionDetails](o, func(v CustomerSubscriptionDetails) string { return v.QuotaId })
 }
 
 // List of registered feature flags for subscription
-func (o CustomerSubscriptionDetailsPtrOutput) RegisteredFeatures() CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return o.ApplyT(func(v *CustomerSubscriptionDetails) []CustomerSubscriptionRegisteredFeatures {
-		if v == nil {
-			return nil
-		}
+func (o CustomerSubscriptionDetailsOutput) RegisteredFeatures() pulumix.GArrayOutput[CustomerSubscriptionRegisteredFeatures, CustomerSubscriptionRegisteredFeaturesOutput] {
+	value := pulumix.Apply[CustomerSubscriptionDetails](o, func(v CustomerSubscriptionDetails) []*CustomerSubscriptionRegisteredFeatures {
 		return v.RegisteredFeatures
-	}).(CustomerSubscriptionRegisteredFeaturesArrayOutput)
+	})
+	return pulumix.GArrayOutput[CustomerSubscriptionRegisteredFeatures, CustomerSubscriptionRegisteredFeaturesOutput]{OutputState: value.OutputState}
 }
 
 // Represents subscription registered features
@@ -746,23 +513,12 @@ type CustomerSubscriptionRegisteredFeatures struct {
 	State *string `pulumi:"state"`
 }
 
-// CustomerSubscriptionRegisteredFeaturesInput is an input type that accepts CustomerSubscriptionRegisteredFeaturesArgs and CustomerSubscriptionRegisteredFeaturesOutput values.
-// You can construct a concrete instance of `CustomerSubscriptionRegisteredFeaturesInput` via:
-//
-//	CustomerSubscriptionRegisteredFeaturesArgs{...}
-type CustomerSubscriptionRegisteredFeaturesInput interface {
-	pulumi.Input
-
-	ToCustomerSubscriptionRegisteredFeaturesOutput() CustomerSubscriptionRegisteredFeaturesOutput
-	ToCustomerSubscriptionRegisteredFeaturesOutputWithContext(context.Context) CustomerSubscriptionRegisteredFeaturesOutput
-}
-
 // Represents subscription registered features
 type CustomerSubscriptionRegisteredFeaturesArgs struct {
 	// Name of subscription registered feature
-	Name pulumi.StringPtrInput `pulumi:"name"`
+	Name pulumix.Input[*string] `pulumi:"name"`
 	// State of subscription registered feature
-	State pulumi.StringPtrInput `pulumi:"state"`
+	State pulumix.Input[*string] `pulumi:"state"`
 }
 
 func (CustomerSubscriptionRegisteredFeaturesArgs) ElementType() reflect.Type {
@@ -777,29 +533,8 @@ func (i CustomerSubscriptionRegisteredFeaturesArgs) ToCustomerSubscriptionRegist
 	return pulumi.ToOutputWithContext(ctx, i).(CustomerSubscriptionRegisteredFeaturesOutput)
 }
 
-// CustomerSubscriptionRegisteredFeaturesArrayInput is an input type that accepts CustomerSubscriptionRegisteredFeaturesArray and CustomerSubscriptionRegisteredFeaturesArrayOutput values.
-// You can construct a concrete instance of `CustomerSubscriptionRegisteredFeaturesArrayInput` via:
-//
-//	CustomerSubscriptionRegisteredFeaturesArray{ CustomerSubscriptionRegisteredFeaturesArgs{...} }
-type CustomerSubscriptionRegisteredFeaturesArrayInput interface {
-	pulumi.Input
-
-	ToCustomerSubscriptionRegisteredFeaturesArrayOutput() CustomerSubscriptionRegisteredFeaturesArrayOutput
-	ToCustomerSubscriptionRegisteredFeaturesArrayOutputWithContext(context.Context) CustomerSubscriptionRegisteredFeaturesArrayOutput
-}
-
-type CustomerSubscriptionRegisteredFeaturesArray []CustomerSubscriptionRegisteredFeaturesInput
-
-func (CustomerSubscriptionRegisteredFeaturesArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]CustomerSubscriptionRegisteredFeatures)(nil)).Elem()
-}
-
-func (i CustomerSubscriptionRegisteredFeaturesArray) ToCustomerSubscriptionRegisteredFeaturesArrayOutput() CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return i.ToCustomerSubscriptionRegisteredFeaturesArrayOutputWithContext(context.Background())
-}
-
-func (i CustomerSubscriptionRegisteredFeaturesArray) ToCustomerSubscriptionRegisteredFeaturesArrayOutputWithContext(ctx context.Context) CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CustomerSubscriptionRegisteredFeaturesArrayOutput)
+func (i *CustomerSubscriptionRegisteredFeaturesArgs) ToOutput(ctx context.Context) pulumix.Output[*CustomerSubscriptionRegisteredFeaturesArgs] {
+	return pulumix.Val(i)
 }
 
 // Represents subscription registered features
@@ -817,34 +552,20 @@ func (o CustomerSubscriptionRegisteredFeaturesOutput) ToCustomerSubscriptionRegi
 	return o
 }
 
-// Name of subscription registered feature
-func (o CustomerSubscriptionRegisteredFeaturesOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CustomerSubscriptionRegisteredFeatures) *string { return v.Name }).(pulumi.StringPtrOutput)
-}
-
-// State of subscription registered feature
-func (o CustomerSubscriptionRegisteredFeaturesOutput) State() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CustomerSubscriptionRegisteredFeatures) *string { return v.State }).(pulumi.StringPtrOutput)
+func (o CustomerSubscriptionRegisteredFeaturesOutput) ToOutput(ctx context.Context) pulumix.Output[CustomerSubscriptionRegisteredFeatures] {
+	return pulumix.Output[CustomerSubscriptionRegisteredFeatures]{
+		OutputState: o.OutputState,
+	}
 }
 
-type CustomerSubscriptionRegisteredFeaturesArrayOutput struct{ *pulumi.OutputState }
-
-func (CustomerSubscriptionRegisteredFeaturesArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]CustomerSubscriptionRegisteredFeatures)(nil)).Elem()
-}
-
-func (o CustomerSubscriptionRegisteredFeaturesArrayOutput) ToCustomerSubscriptionRegisteredFeaturesArrayOutput() CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return o
-}
-
-func (o CustomerSubscriptionRegisteredFeaturesArrayOutput) ToCustomerSubscriptionRegisteredFeaturesArrayOutputWithContext(ctx context.Context) CustomerSubscriptionRegisteredFeaturesArrayOutput {
-	return o
+// Name of subscription registered feature
+func (o CustomerSubscriptionRegisteredFeaturesOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[CustomerSubscriptionRegisteredFeatures](o, func(v CustomerSubscriptionRegisteredFeatures) *string { return v.Name })
 }
 
-func (o CustomerSubscriptionRegisteredFeaturesArrayOutput) Index(i pulumi.IntInput) CustomerSubscriptionRegisteredFeaturesOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CustomerSubscriptionRegisteredFeatures {
-		return vs[0].([]CustomerSubscriptionRegisteredFeatures)[vs[1].(int)]
-	}).(CustomerSubscriptionRegisteredFeaturesOutput)
+// State of subscription registered feature
+func (o CustomerSubscriptionRegisteredFeaturesOutput) State() pulumix.Output[*string] {
+	return pulumix.Apply[CustomerSubscriptionRegisteredFeatures](o, func(v CustomerSubscriptionRegisteredFeatures) *string { return v.State })
 }
 
 // Description related properties of a product system.
@@ -856,38 +577,27 @@ type DescriptionResponse struct {
 	// Keywords for the product system.
 	Keywords []string `pulumi:"keywords"`
 	// Links for the product system.
-	Links []LinkResponse `pulumi:"links"`
+	Links []*LinkResponse `pulumi:"links"`
 	// Long description of the product system.
 	LongDescription string `pulumi:"longDescription"`
 	// Short description of the product system.
 	ShortDescription string `pulumi:"shortDescription"`
 }
 
-// DescriptionResponseInput is an input type that accepts DescriptionResponseArgs and DescriptionResponseOutput values.
-// You can construct a concrete instance of `DescriptionResponseInput` via:
-//
-//	DescriptionResponseArgs{...}
-type DescriptionResponseInput interface {
-	pulumi.Input
-
-	ToDescriptionResponseOutput() DescriptionResponseOutput
-	ToDescriptionResponseOutputWithContext(context.Context) DescriptionResponseOutput
-}
-
 // Description related properties of a product system.
 type DescriptionResponseArgs struct {
 	// Attributes for the product system.
-	Attributes pulumi.StringArrayInput `pulumi:"attributes"`
+	Attributes pulumix.Input[[]string] `pulumi:"attributes"`
 	// Type of description.
-	DescriptionType pulumi.StringInput `pulumi:"descriptionType"`
+	DescriptionType pulumix.Input[string] `pulumi:"descriptionType"`
 	// Keywords for the product system.
-	Keywords pulumi.StringArrayInput `pulumi:"keywords"`
+	Keywords pulumix.Input[[]string] `pulumi:"keywords"`
 	// Links for the product system.
-	Links LinkResponseArrayInput `pulumi:"links"`
+	Links pulumix.Input[[]*LinkResponseArgs] `pulumi:"links"`
 	// Long description of the product system.
-	LongDescription pulumi.StringInput `pulumi:"longDescription"`
+	LongDescription pulumix.Input[string] `pulumi:"longDescription"`
 	// Short description of the product system.
-	ShortDescription pulumi.StringInput `pulumi:"shortDescription"`
+	ShortDescription pulumix.Input[string] `pulumi:"shortDescription"`
 }
 
 func (DescriptionResponseArgs) ElementType() reflect.Type {
@@ -902,6 +612,10 @@ func (i DescriptionResponseArgs) ToDescriptionResponseOutputWithContext(ctx cont
 	return pulumi.ToOutputWithContext(ctx, i).(DescriptionResponseOutput)
 }
 
+func (i *DescriptionResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*DescriptionResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Description related properties of a product system.
 type DescriptionResponseOutput struct{ *pulumi.OutputState }
 
@@ -917,34 +631,43 @@ func (o DescriptionResponseOutput) ToDescriptionResponseOutputWithContext(ctx co
 	return o
 }
 
+func (o DescriptionResponseOutput) ToOutput(ctx context.Context) pulumix.Output[DescriptionResponse] {
+	return pulumix.Output[DescriptionResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Attributes for the product system.
-func (o DescriptionResponseOutput) Attributes() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v DescriptionResponse) []string { return v.Attributes }).(pulumi.StringArrayOutput)
+func (o DescriptionResponseOutput) Attributes() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) []string { return v.Attributes })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
 // Type of description.
-func (o DescriptionResponseOutput) DescriptionType() pulumi.StringOutput {
-	return o.ApplyT(func(v DescriptionResponse) string { return v.DescriptionType }).(pulumi.StringOutput)
+func (o DescriptionResponseOutput) DescriptionType() pulumix.Output[string] {
+	return pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) string { return v.DescriptionType })
 }
 
 // Keywords for the product system.
-func (o DescriptionResponseOutput) Keywords() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v DescriptionResponse) []string { return v.Keywords }).(pulumi.StringArrayOutput)
+func (o DescriptionResponseOutput) Keywords() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) []string { return v.Keywords })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
 // Links for the product system.
-func (o DescriptionResponseOutput) Links() LinkResponseArrayOutput {
-	return o.ApplyT(func(v DescriptionResponse) []LinkResponse { return v.Links }).(LinkResponseArrayOutput)
+func (o DescriptionResponseOutput) Links() pulumix.GArrayOutput[LinkResponse, LinkResponseOutput] {
+	value := pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) []*LinkResponse { return v.Links })
+	return pulumix.GArrayOutput[LinkResponse, LinkResponseOutput]{OutputState: value.OutputState}
 }
 
 // Long description of the product system.
-func (o DescriptionResponseOutput) LongDescription() pulumi.StringOutput {
-	return o.ApplyT(func(v DescriptionResponse) string { return v.LongDescription }).(pulumi.StringOutput)
+func (o DescriptionResponseOutput) LongDescription() pulumix.Output[string] {
+	return pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) string { return v.LongDescription })
 }
 
 // Short description of the product system.
-func (o DescriptionResponseOutput) ShortDescription() pulumi.StringOutput {
-	return o.ApplyT(func(v DescriptionResponse) string { return v.ShortDescription }).(pulumi.StringOutput)
+func (o DescriptionResponseOutput) ShortDescription() pulumix.Output[string] {
+	return pulumix.Apply[DescriptionResponse](o, func(v DescriptionResponse) string { return v.ShortDescription })
 }
 
 // Dimensions of a configuration.
@@ -965,33 +688,22 @@ type DimensionsResponse struct {
 	Width float64 `pulumi:"width"`
 }
 
-// DimensionsResponseInput is an input type that accepts DimensionsResponseArgs and DimensionsResponseOutput values.
-// You can construct a concrete instance of `DimensionsResponseInput` via:
-//
-//	DimensionsResponseArgs{...}
-type DimensionsResponseInput interface {
-	pulumi.Input
-
-	ToDimensionsResponseOutput() DimensionsResponseOutput
-	ToDimensionsResponseOutputWithContext(context.Context) DimensionsResponseOutput
-}
-
 // Dimensions of a configuration.
 type DimensionsResponseArgs struct {
 	// Depth of the device.
-	Depth pulumi.Float64Input `pulumi:"depth"`
+	Depth pulumix.Input[float64] `pulumi:"depth"`
 	// Height of the device.
-	Height pulumi.Float64Input `pulumi:"height"`
+	Height pulumix.Input[float64] `pulumi:"height"`
 	// Length of the device.
-	Length pulumi.Float64Input `pulumi:"length"`
+	Length pulumix.Input[float64] `pulumi:"length"`
 	// Unit for the dimensions of length, height and width.
-	LengthHeightUnit pulumi.StringInput `pulumi:"lengthHeightUnit"`
+	LengthHeightUnit pulumix.Input[string] `pulumi:"lengthHeightUnit"`
 	// Weight of the device.
-	Weight pulumi.Float64Input `pulumi:"weight"`
+	Weight pulumix.Input[float64] `pulumi:"weight"`
 	// Unit for the dimensions of weight.
-	WeightUnit pulumi.StringInput `pulumi:"weightUnit"`
+	WeightUnit pulumix.Input[string] `pulumi:"weightUnit"`
 	// Width of the device.
-	Width pulumi.Float64Input `pulumi:"width"`
+	Width pulumix.Input[float64] `pulumi:"width"`
 }
 
 func (DimensionsResponseArgs) ElementType() reflect.Type {
@@ -1006,6 +718,10 @@ func (i DimensionsResponseArgs) ToDimensionsResponseOutputWithContext(ctx contex
 	return pulumi.ToOutputWithContext(ctx, i).(DimensionsResponseOutput)
 }
 
+func (i *DimensionsResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*DimensionsResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Dimensions of a configuration.
 type DimensionsResponseOutput struct{ *pulumi.OutputState }
 
@@ -1021,39 +737,45 @@ func (o DimensionsResponseOutput) ToDimensionsResponseOutputWithContext(ctx cont
 	return o
 }
 
+func (o DimensionsResponseOutput) ToOutput(ctx context.Context) pulumix.Output[DimensionsResponse] {
+	return pulumix.Output[DimensionsResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Depth of the device.
-func (o DimensionsResponseOutput) Depth() pulumi.Float64Output {
-	return o.ApplyT(func(v DimensionsResponse) float64 { return v.Depth }).(pulumi.Float64Output)
+func (o DimensionsResponseOutput) Depth() pulumix.Output[float64] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) float64 { return v.Depth })
 }
 
 // Height of the device.
-func (o DimensionsResponseOutput) Height() pulumi.Float64Output {
-	return o.ApplyT(func(v DimensionsResponse) float64 { return v.Height }).(pulumi.Float64Output)
+func (o DimensionsResponseOutput) Height() pulumix.Output[float64] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) float64 { return v.Height })
 }
 
 // Length of the device.
-func (o DimensionsResponseOutput) Length() pulumi.Float64Output {
-	return o.ApplyT(func(v DimensionsResponse) float64 { return v.Length }).(pulumi.Float64Output)
+func (o DimensionsResponseOutput) Length() pulumix.Output[float64] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) float64 { return v.Length })
 }
 
 // Unit for the dimensions of length, height and width.
-func (o DimensionsResponseOutput) LengthHeightUnit() pulumi.StringOutput {
-	return o.ApplyT(func(v DimensionsResponse) string { return v.LengthHeightUnit }).(pulumi.StringOutput)
+func (o DimensionsResponseOutput) LengthHeightUnit() pulumix.Output[string] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) string { return v.LengthHeightUnit })
 }
 
 // Weight of the device.
-func (o DimensionsResponseOutput) Weight() pulumi.Float64Output {
-	return o.ApplyT(func(v DimensionsResponse) float64 { return v.Weight }).(pulumi.Float64Output)
+func (o DimensionsResponseOutput) Weight() pulumix.Output[float64] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) float64 { return v.Weight })
 }
 
 // Unit for the dimensions of weight.
-func (o DimensionsResponseOutput) WeightUnit() pulumi.StringOutput {
-	return o.ApplyT(func(v DimensionsResponse) string { return v.WeightUnit }).(pulumi.StringOutput)
+func (o DimensionsResponseOutput) WeightUnit() pulumix.Output[string] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) string { return v.WeightUnit })
 }
 
 // Width of the device.
-func (o DimensionsResponseOutput) Width() pulumi.Float64Output {
-	return o.ApplyT(func(v DimensionsResponse) float64 { return v.Width }).(pulumi.Float64Output)
+func (o DimensionsResponseOutput) Width() pulumix.Output[float64] {
+	return pulumix.Apply[DimensionsResponse](o, func(v DimensionsResponse) float64 { return v.Width })
 }
 
 // Different types of filters supported and its values.
@@ -1064,23 +786,12 @@ type FilterableProperty struct {
 	Type string `pulumi:"type"`
 }
 
-// FilterablePropertyInput is an input type that accepts FilterablePropertyArgs and FilterablePropertyOutput values.
-// You can construct a concrete instance of `FilterablePropertyInput` via:
-//
-//	FilterablePropertyArgs{...}
-type FilterablePropertyInput interface {
-	pulumi.Input
-
-	ToFilterablePropertyOutput() FilterablePropertyOutput
-	ToFilterablePropertyOutputWithContext(context.Context) FilterablePropertyOutput
-}
-
 // Different types of filters supported and its values.
 type FilterablePropertyArgs struct {
 	// Values to be filtered.
-	SupportedValues pulumi.StringArrayInput `pulumi:"supportedValues"`
+	SupportedValues pulumix.Input[[]string] `pulumi:"supportedValues"`
 	// Type of product filter.
-	Type pulumi.StringInput `pulumi:"type"`
+	Type pulumix.Input[string] `pulumi:"type"`
 }
 
 func (FilterablePropertyArgs) ElementType() reflect.Type {
@@ -1095,29 +806,8 @@ func (i FilterablePropertyArgs) ToFilterablePropertyOutputWithContext(ctx contex
 	return pulumi.ToOutputWithContext(ctx, i).(FilterablePropertyOutput)
 }
 
-// FilterablePropertyArrayInput is an input type that accepts FilterablePropertyArray and FilterablePropertyArrayOutput values.
-// You can construct a concrete instance of `FilterablePropertyArrayInput` via:
-//
-//	FilterablePropertyArray{ FilterablePropertyArgs{...} }
-type FilterablePropertyArrayInput interface {
-	pulumi.Input
-
-	ToFilterablePropertyArrayOutput() FilterablePropertyArrayOutput
-	ToFilterablePropertyArrayOutputWithContext(context.Context) FilterablePropertyArrayOutput
-}
-
-type FilterablePropertyArray []FilterablePropertyInput
-
-func (FilterablePropertyArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]FilterableProperty)(nil)).Elem()
-}
-
-func (i FilterablePropertyArray) ToFilterablePropertyArrayOutput() FilterablePropertyArrayOutput {
-	return i.ToFilterablePropertyArrayOutputWithContext(context.Background())
-}
-
-func (i FilterablePropertyArray) ToFilterablePropertyArrayOutputWithContext(ctx context.Context) FilterablePropertyArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FilterablePropertyArrayOutput)
+func (i *FilterablePropertyArgs) ToOutput(ctx context.Context) pulumix.Output[*FilterablePropertyArgs] {
+	return pulumix.Val(i)
 }
 
 // Different types of filters supported and its values.
@@ -1135,34 +825,21 @@ func (o FilterablePropertyOutput) ToFilterablePropertyOutputWithContext(ctx cont
 	return o
 }
 
-// Values to be filtered.
-func (o FilterablePropertyOutput) SupportedValues() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v FilterableProperty) []string { return v.SupportedValues }).(pulumi.StringArrayOutput)
-}
-
-// Type of product filter.
-func (o FilterablePropertyOutput) Type() pulumi.StringOutput {
-	return o.ApplyT(func(v FilterableProperty) string { return v.Type }).(pulumi.StringOutput)
-}
-
-type FilterablePropertyArrayOutput struct{ *pulumi.OutputState }
-
-func (FilterablePropertyArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]FilterableProperty)(nil)).Elem()
-}
-
-func (o FilterablePropertyArrayOutput) ToFilterablePropertyArrayOutput() FilterablePropertyArrayOutput {
-	return o
+func (o FilterablePropertyOutput) ToOutput(ctx context.Context) pulumix.Output[FilterableProperty] {
+	return pulumix.Output[FilterableProperty]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o FilterablePropertyArrayOutput) ToFilterablePropertyArrayOutputWithContext(ctx context.Context) FilterablePropertyArrayOutput {
-	return o
+// Values to be filtered.
+func (o FilterablePropertyOutput) SupportedValues() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[FilterableProperty](o, func(v FilterableProperty) []string { return v.SupportedValues })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
-func (o FilterablePropertyArrayOutput) Index(i pulumi.IntInput) FilterablePropertyOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FilterableProperty {
-		return vs[0].([]FilterableProperty)[vs[1].(int)]
-	}).(FilterablePropertyOutput)
+// Type of product filter.
+func (o FilterablePropertyOutput) Type() pulumix.Output[string] {
+	return pulumix.Apply[FilterableProperty](o, func(v FilterableProperty) string { return v.Type })
 }
 
 // Different types of filters supported and its values.
@@ -1173,23 +850,12 @@ type FilterablePropertyResponse struct {
 	Type string `pulumi:"type"`
 }
 
-// FilterablePropertyResponseInput is an input type that accepts FilterablePropertyResponseArgs and FilterablePropertyResponseOutput values.
-// You can construct a concrete instance of `FilterablePropertyResponseInput` via:
-//
-//	FilterablePropertyResponseArgs{...}
-type FilterablePropertyResponseInput interface {
-	pulumi.Input
-
-	ToFilterablePropertyResponseOutput() FilterablePropertyResponseOutput
-	ToFilterablePropertyResponseOutputWithContext(context.Context) FilterablePropertyResponseOutput
-}
-
 // Different types of filters supported and its values.
 type FilterablePropertyResponseArgs struct {
 	// Values to be filtered.
-	SupportedValues pulumi.StringArrayInput `pulumi:"supportedValues"`
+	SupportedValues pulumix.Input[[]string] `pulumi:"supportedValues"`
 	// Type of product filter.
-	Type pulumi.StringInput `pulumi:"type"`
+	Type pulumix.Input[string] `pulumi:"type"`
 }
 
 func (FilterablePropertyResponseArgs) ElementType() reflect.Type {
@@ -1204,29 +870,8 @@ func (i FilterablePropertyResponseArgs) ToFilterablePropertyResponseOutputWithCo
 	return pulumi.ToOutputWithContext(ctx, i).(FilterablePropertyResponseOutput)
 }
 
-// FilterablePropertyResponseArrayInput is an input type that accepts FilterablePropertyResponseArray and FilterablePropertyResponseArrayOutput values.
-// You can construct a concrete instance of `FilterablePropertyResponseArrayInput` via:
-//
-//	FilterablePropertyResponseArray{ FilterablePropertyResponseArgs{...} }
-type FilterablePropertyResponseArrayInput interface {
-	pulumi.Input
-
-	ToFilterablePropertyResponseArrayOutput() FilterablePropertyResponseArrayOutput
-	ToFilterablePropertyResponseArrayOutputWithContext(context.Context) FilterablePropertyResponseArrayOutput
-}
-
-type FilterablePropertyResponseArray []FilterablePropertyResponseInput
-
-func (FilterablePropertyResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]FilterablePropertyResponse)(nil)).Elem()
-}
-
-func (i FilterablePropertyResponseArray) ToFilterablePropertyResponseArrayOutput() FilterablePropertyResponseArrayOutput {
-	return i.ToFilterablePropertyResponseArrayOutputWithContext(context.Background())
-}
-
-func (i FilterablePropertyResponseArray) ToFilterablePropertyResponseArrayOutputWithContext(ctx context.Context) FilterablePropertyResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FilterablePropertyResponseArrayOutput)
+func (i *FilterablePropertyResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*FilterablePropertyResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Different types of filters supported and its values.
@@ -1244,34 +889,21 @@ func (o FilterablePropertyResponseOutput) ToFilterablePropertyResponseOutputWith
 	return o
 }
 
-// Values to be filtered.
-func (o FilterablePropertyResponseOutput) SupportedValues() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v FilterablePropertyResponse) []string { return v.SupportedValues }).(pulumi.StringArrayOutput)
-}
-
-// Type of product filter.
-func (o FilterablePropertyResponseOutput) Type() pulumi.StringOutput {
-	return o.ApplyT(func(v FilterablePropertyResponse) string { return v.Type }).(pulumi.StringOutput)
+func (o FilterablePropertyResponseOutput) ToOutput(ctx context.Context) pulumix.Output[FilterablePropertyResponse] {
+	return pulumix.Output[FilterablePropertyResponse]{
+		OutputState: o.OutputState,
+	}
 }
 
-type FilterablePropertyResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (FilterablePropertyResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]FilterablePropertyResponse)(nil)).Elem()
-}
-
-func (o FilterablePropertyResponseArrayOutput) ToFilterablePropertyResponseArrayOutput() FilterablePropertyResponseArrayOutput {
-	return o
-}
-
-func (o FilterablePropertyResponseArrayOutput) ToFilterablePropertyResponseArrayOutputWithContext(ctx context.Context) FilterablePropertyResponseArrayOutput {
-	return o
+// Values to be filtered.
+func (o FilterablePropertyResponseOutput) SupportedValues() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[FilterablePropertyResponse](o, func(v FilterablePropertyResponse) []string { return v.SupportedValues })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
-func (o FilterablePropertyResponseArrayOutput) Index(i pulumi.IntInput) FilterablePropertyResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FilterablePropertyResponse {
-		return vs[0].([]FilterablePropertyResponse)[vs[1].(int)]
-	}).(FilterablePropertyResponseOutput)
+// Type of product filter.
+func (o FilterablePropertyResponseOutput) Type() pulumix.Output[string] {
+	return pulumix.Apply[FilterablePropertyResponse](o, func(v FilterablePropertyResponse) string { return v.Type })
 }
 
 // Holds details about product hierarchy information
@@ -1286,27 +918,16 @@ type HierarchyInformation struct {
 	ProductName *string `pulumi:"productName"`
 }
 
-// HierarchyInformationInput is an input type that accepts HierarchyInformationArgs and HierarchyInformationOutput values.
-// You can construct a concrete instance of `HierarchyInformationInput` via:
-//
-//	HierarchyInformationArgs{...}
-type HierarchyInformationInput interface {
-	pulumi.Input
-
-	ToHierarchyInformationOutput() HierarchyInformationOutput
-	ToHierarchyInformationOutputWithContext(context.Context) HierarchyInformationOutput
-}
-
 // Holds details about product hierarchy information
 type HierarchyInformationArgs struct {
 	// Represents configuration name that uniquely identifies configuration
-	ConfigurationName pulumi.StringPtrInput `pulumi:"configurationName"`
+	ConfigurationName pulumix.Input[*string] `pulumi:"configurationName"`
 	// Represents product family name that uniquely identifies product family
-	ProductFamilyName pulumi.StringPtrInput `pulumi:"productFamilyName"`
+	ProductFamilyName pulumix.Input[*string] `pulumi:"productFamilyName"`
 	// Represents product line name that uniquely identifies product line
-	ProductLineName pulumi.StringPtrInput `pulumi:"productLineName"`
+	ProductLineName pulumix.Input[*string] `pulumi:"productLineName"`
 	// Represents product name that uniquely identifies product
-	ProductName pulumi.StringPtrInput `pulumi:"productName"`
+	ProductName pulumix.Input[*string] `pulumi:"productName"`
 }
 
 func (HierarchyInformationArgs) ElementType() reflect.Type {
@@ -1321,6 +942,10 @@ func (i HierarchyInformationArgs) ToHierarchyInformationOutputWithContext(ctx co
 	return pulumi.ToOutputWithContext(ctx, i).(HierarchyInformationOutput)
 }
 
+func (i *HierarchyInformationArgs) ToOutput(ctx context.Context) pulumix.Output[*HierarchyInformationArgs] {
+	return pulumix.Val(i)
+}
+
 // Holds details about product hierarchy information
 type HierarchyInformationOutput struct{ *pulumi.OutputState }
 
@@ -1336,24 +961,30 @@ func (o HierarchyInformationOutput) ToHierarchyInformationOutputWithContext(ctx
 	return o
 }
 
+func (o HierarchyInformationOutput) ToOutput(ctx context.Context) pulumix.Output[HierarchyInformation] {
+	return pulumix.Output[HierarchyInformation]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Represents configuration name that uniquely identifies configuration
-func (o HierarchyInformationOutput) ConfigurationName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformation) *string { return v.ConfigurationName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationOutput) ConfigurationName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformation](o, func(v HierarchyInformation) *string { return v.ConfigurationName })
 }
 
 // Represents product family name that uniquely identifies product family
-func (o HierarchyInformationOutput) ProductFamilyName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformation) *string { return v.ProductFamilyName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationOutput) ProductFamilyName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformation](o, func(v HierarchyInformation) *string { return v.ProductFamilyName })
 }
 
 // Represents product line name that uniquely identifies product line
-func (o HierarchyInformationOutput) ProductLineName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformation) *string { return v.ProductLineName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationOutput) ProductLineName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformation](o, func(v HierarchyInformation) *string { return v.ProductLineName })
 }
 
 // Represents product name that uniquely identifies product
-func (o HierarchyInformationOutput) ProductName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformation) *string { return v.ProductName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationOutput) ProductName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformation](o, func(v HierarchyInformation) *string { return v.ProductName })
 }
 
 // Holds details about product hierarchy information
@@ -1368,27 +999,16 @@ type HierarchyInformationResponse struct {
 	ProductName *string `pulumi:"productName"`
 }
 
-// HierarchyInformationResponseInput is an input type that accepts HierarchyInformationResponseArgs and HierarchyInformationResponseOutput values.
-// You can construct a concrete instance of `HierarchyInformationResponseInput` via:
-//
-//	HierarchyInformationResponseArgs{...}
-type HierarchyInformationResponseInput interface {
-	pulumi.Input
-
-	ToHierarchyInformationResponseOutput() HierarchyInformationResponseOutput
-	ToHierarchyInformationResponseOutputWithContext(context.Context) HierarchyInformationResponseOutput
-}
-
 // Holds details about product hierarchy information
 type HierarchyInformationResponseArgs struct {
 	// Represents configuration name that uniquely identifies configuration
-	ConfigurationName pulumi.StringPtrInput `pulumi:"configurationName"`
+	ConfigurationName pulumix.Input[*string] `pulumi:"configurationName"`
 	// Represents product family name that uniquely identifies product family
-	ProductFamilyName pulumi.StringPtrInput `pulumi:"productFamilyName"`
+	ProductFamilyName pulumix.Input[*string] `pulumi:"productFamilyName"`
 	// Represents product line name that uniquely identifies product line
-	ProductLineName pulumi.StringPtrInput `pulumi:"productLineName"`
+	ProductLineName pulumix.Input[*string] `pulumi:"productLineName"`
 	// Represents product name that uniquely identifies product
-	ProductName pulumi.StringPtrInput `pulumi:"productName"`
+	ProductName pulumix.Input[*string] `pulumi:"productName"`
 }
 
 func (HierarchyInformationResponseArgs) ElementType() reflect.Type {
@@ -1403,6 +1023,10 @@ func (i HierarchyInformationResponseArgs) ToHierarchyInformationResponseOutputWi
 	return pulumi.ToOutputWithContext(ctx, i).(HierarchyInformationResponseOutput)
 }
 
+func (i *HierarchyInformationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*HierarchyInformationResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Holds details about product hierarchy information
 type HierarchyInformationResponseOutput struct{ *pulumi.OutputState }
 
@@ -1418,24 +1042,30 @@ func (o HierarchyInformationResponseOutput) ToHierarchyInformationResponseOutput
 	return o
 }
 
+func (o HierarchyInformationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[HierarchyInformationResponse] {
+	return pulumix.Output[HierarchyInformationResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Represents configuration name that uniquely identifies configuration
-func (o HierarchyInformationResponseOutput) ConfigurationName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformationResponse) *string { return v.ConfigurationName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationResponseOutput) ConfigurationName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformationResponse](o, func(v HierarchyInformationResponse) *string { return v.ConfigurationName })
 }
 
 // Represents product family name that uniquely identifies product family
-func (o HierarchyInformationResponseOutput) ProductFamilyName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformationResponse) *string { return v.ProductFamilyName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationResponseOutput) ProductFamilyName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformationResponse](o, func(v HierarchyInformationResponse) *string { return v.ProductFamilyName })
 }
 
 // Represents product line name that uniquely identifies product line
-func (o HierarchyInformationResponseOutput) ProductLineName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformationResponse) *string { return v.ProductLineName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationResponseOutput) ProductLineName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformationResponse](o, func(v HierarchyInformationResponse) *string { return v.ProductLineName })
 }
 
 // Represents product name that uniquely identifies product
-func (o HierarchyInformationResponseOutput) ProductName() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HierarchyInformationResponse) *string { return v.ProductName }).(pulumi.StringPtrOutput)
+func (o HierarchyInformationResponseOutput) ProductName() pulumix.Output[*string] {
+	return pulumix.Apply[HierarchyInformationResponse](o, func(v HierarchyInformationResponse) *string { return v.ProductName })
 }
 
 // Image for the product
@@ -1446,23 +1076,12 @@ type ImageInformationResponse struct {
 	ImageUrl string `pulumi:"imageUrl"`
 }
 
-// ImageInformationResponseInput is an input type that accepts ImageInformationResponseArgs and ImageInformationResponseOutput values.
-// You can construct a concrete instance of `ImageInformationResponseInput` via:
-//
-//	ImageInformationResponseArgs{...}
-type ImageInformationResponseInput interface {
-	pulumi.Input
-
-	ToImageInformationResponseOutput() ImageInformationResponseOutput
-	ToImageInformationResponseOutputWithContext(context.Context) ImageInformationResponseOutput
-}
-
 // Image for the product
 type ImageInformationResponseArgs struct {
 	// Type of the image
-	ImageType pulumi.StringInput `pulumi:"imageType"`
+	ImageType pulumix.Input[string] `pulumi:"imageType"`
 	// Url of the image
-	ImageUrl pulumi.StringInput `pulumi:"imageUrl"`
+	ImageUrl pulumix.Input[string] `pulumi:"imageUrl"`
 }
 
 func (ImageInformationResponseArgs) ElementType() reflect.Type {
@@ -1477,29 +1096,8 @@ func (i ImageInformationResponseArgs) ToImageInformationResponseOutputWithContex
 	return pulumi.ToOutputWithContext(ctx, i).(ImageInformationResponseOutput)
 }
 
-// ImageInformationResponseArrayInput is an input type that accepts ImageInformationResponseArray and ImageInformationResponseArrayOutput values.
-// You can construct a concrete instance of `ImageInformationResponseArrayInput` via:
-//
-//	ImageInformationResponseArray{ ImageInformationResponseArgs{...} }
-type ImageInformationResponseArrayInput interface {
-	pulumi.Input
-
-	ToImageInformationResponseArrayOutput() ImageInformationResponseArrayOutput
-	ToImageInformationResponseArrayOutputWithContext(context.Context) ImageInformationResponseArrayOutput
-}
-
-type ImageInformationResponseArray []ImageInformationResponseInput
-
-func (ImageInformationResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ImageInformationResponse)(nil)).Elem()
-}
-
-func (i ImageInformationResponseArray) ToImageInformationResponseArrayOutput() ImageInformationResponseArrayOutput {
-	return i.ToImageInformationResponseArrayOutputWithContext(context.Background())
-}
-
-func (i ImageInformationResponseArray) ToImageInformationResponseArrayOutputWithContext(ctx context.Context) ImageInformationResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ImageInformationResponseArrayOutput)
+func (i *ImageInformationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*ImageInformationResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Image for the product
@@ -1517,34 +1115,20 @@ func (o ImageInformationResponseOutput) ToImageInformationResponseOutputWithCont
 	return o
 }
 
-// Type of the image
-func (o ImageInformationResponseOutput) ImageType() pulumi.StringOutput {
-	return o.ApplyT(func(v ImageInformationResponse) string { return v.ImageType }).(pulumi.StringOutput)
-}
-
-// Url of the image
-func (o ImageInformationResponseOutput) ImageUrl() pulumi.StringOutput {
-	return o.ApplyT(func(v ImageInformationResponse) string { return v.ImageUrl }).(pulumi.StringOutput)
+func (o ImageInformationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[ImageInformationResponse] {
+	return pulumix.Output[ImageInformationResponse]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ImageInformationResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (ImageInformationResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ImageInformationResponse)(nil)).Elem()
-}
-
-func (o ImageInformationResponseArrayOutput) ToImageInformationResponseArrayOutput() ImageInformationResponseArrayOutput {
-	return o
-}
-
-func (o ImageInformationResponseArrayOutput) ToImageInformationResponseArrayOutputWithContext(ctx context.Context) ImageInformationResponseArrayOutput {
-	return o
+// Type of the image
+func (o ImageInformationResponseOutput) ImageType() pulumix.Output[string] {
+	return pulumix.Apply[ImageInformationResponse](o, func(v ImageInformationResponse) string { return v.ImageType })
 }
 
-func (o ImageInformationResponseArrayOutput) Index(i pulumi.IntInput) ImageInformationResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ImageInformationResponse {
-		return vs[0].([]ImageInformationResponse)[vs[1].(int)]
-	}).(ImageInformationResponseOutput)
+// Url of the image
+func (o ImageInformationResponseOutput) ImageUrl() pulumix.Output[string] {
+	return pulumix.Apply[ImageInformationResponse](o, func(v ImageInformationResponse) string { return v.ImageUrl })
 }
 
 // Returns link related to the product
@@ -1555,23 +1139,12 @@ type LinkResponse struct {
 	LinkUrl string `pulumi:"linkUrl"`
 }
 
-// LinkResponseInput is an input type that accepts LinkResponseArgs and LinkResponseOutput values.
-// You can construct a concrete instance of `LinkResponseInput` via:
-//
-//	LinkResponseArgs{...}
-type LinkResponseInput interface {
-	pulumi.Input
-
-	ToLinkResponseOutput() LinkResponseOutput
-	ToLinkResponseOutputWithContext(context.Context) LinkResponseOutput
-}
-
 // Returns link related to the product
 type LinkResponseArgs struct {
 	// Type of link
-	LinkType pulumi.StringInput `pulumi:"linkType"`
+	LinkType pulumix.Input[string] `pulumi:"linkType"`
 	// Url of the link
-	LinkUrl pulumi.StringInput `pulumi:"linkUrl"`
+	LinkUrl pulumix.Input[string] `pulumi:"linkUrl"`
 }
 
 func (LinkResponseArgs) ElementType() reflect.Type {
@@ -1586,29 +1159,8 @@ func (i LinkResponseArgs) ToLinkResponseOutputWithContext(ctx context.Context) L
 	return pulumi.ToOutputWithContext(ctx, i).(LinkResponseOutput)
 }
 
-// LinkResponseArrayInput is an input type that accepts LinkResponseArray and LinkResponseArrayOutput values.
-// You can construct a concrete instance of `LinkResponseArrayInput` via:
-//
-//	LinkResponseArray{ LinkResponseArgs{...} }
-type LinkResponseArrayInput interface {
-	pulumi.Input
-
-	ToLinkResponseArrayOutput() LinkResponseArrayOutput
-	ToLinkResponseArrayOutputWithContext(context.Context) LinkResponseArrayOutput
-}
-
-type LinkResponseArray []LinkResponseInput
-
-func (LinkResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]LinkResponse)(nil)).Elem()
-}
-
-func (i LinkResponseArray) ToLinkResponseArrayOutput() LinkResponseArrayOutput {
-	return i.ToLinkResponseArrayOutputWithContext(context.Background())
-}
-
-func (i LinkResponseArray) ToLinkResponseArrayOutputWithContext(ctx context.Context) LinkResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(LinkResponseArrayOutput)
+func (i *LinkResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*LinkResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Returns link related to the product
@@ -1626,34 +1178,20 @@ func (o LinkResponseOutput) ToLinkResponseOutputWithContext(ctx context.Context)
 	return o
 }
 
-// Type of link
-func (o LinkResponseOutput) LinkType() pulumi.StringOutput {
-	return o.ApplyT(func(v LinkResponse) string { return v.LinkType }).(pulumi.StringOutput)
-}
-
-// Url of the link
-func (o LinkResponseOutput) LinkUrl() pulumi.StringOutput {
-	return o.ApplyT(func(v LinkResponse) string { return v.LinkUrl }).(pulumi.StringOutput)
+func (o LinkResponseOutput) ToOutput(ctx context.Context) pulumix.Output[LinkResponse] {
+	return pulumix.Output[LinkResponse]{
+		OutputState: o.OutputState,
+	}
 }
 
-type LinkResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (LinkResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]LinkResponse)(nil)).Elem()
-}
-
-func (o LinkResponseArrayOutput) ToLinkResponseArrayOutput() LinkResponseArrayOutput {
-	return o
-}
-
-func (o LinkResponseArrayOutput) ToLinkResponseArrayOutputWithContext(ctx context.Context) LinkResponseArrayOutput {
-	return o
+// Type of link
+func (o LinkResponseOutput) LinkType() pulumix.Output[string] {
+	return pulumix.Apply[LinkResponse](o, func(v LinkResponse) string { return v.LinkType })
 }
 
-func (o LinkResponseArrayOutput) Index(i pulumi.IntInput) LinkResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LinkResponse {
-		return vs[0].([]LinkResponse)[vs[1].(int)]
-	}).(LinkResponseOutput)
+// Url of the link
+func (o LinkResponseOutput) LinkUrl() pulumix.Output[string] {
+	return pulumix.Apply[LinkResponse](o, func(v LinkResponse) string { return v.LinkUrl })
 }
 
 // Billing type PAV2 meter details
@@ -1669,28 +1207,17 @@ type Pav2MeterDetailsResponse struct {
 	Multiplier float64 `pulumi:"multiplier"`
 }
 
-// Pav2MeterDetailsResponseInput is an input type that accepts Pav2MeterDetailsResponseArgs and Pav2MeterDetailsResponseOutput values.
-// You can construct a concrete instance of `Pav2MeterDetailsResponseInput` via:
-//
-//	Pav2MeterDetailsResponseArgs{...}
-type Pav2MeterDetailsResponseInput interface {
-	pulumi.Input
-
-	ToPav2MeterDetailsResponseOutput() Pav2MeterDetailsResponseOutput
-	ToPav2MeterDetailsResponseOutputWithContext(context.Context) Pav2MeterDetailsResponseOutput
-}
-
 // Billing type PAV2 meter details
 type Pav2MeterDetailsResponseArgs struct {
 	// Represents billing type.
 	// Expected value is 'Pav2'.
-	BillingType pulumi.StringInput `pulumi:"billingType"`
+	BillingType pulumix.Input[string] `pulumi:"billingType"`
 	// Charging type.
-	ChargingType pulumi.StringInput `pulumi:"chargingType"`
+	ChargingType pulumix.Input[string] `pulumi:"chargingType"`
 	// Validation status of requested data center and transport.
-	MeterGuid pulumi.StringInput `pulumi:"meterGuid"`
+	MeterGuid pulumix.Input[string] `pulumi:"meterGuid"`
 	// Billing unit applicable for Pav2 billing
-	Multiplier pulumi.Float64Input `pulumi:"multiplier"`
+	Multiplier pulumix.Input[float64] `pulumi:"multiplier"`
 }
 
 func (Pav2MeterDetailsResponseArgs) ElementType() reflect.Type {
@@ -1705,6 +1232,10 @@ func (i Pav2MeterDetailsResponseArgs) ToPav2MeterDetailsResponseOutputWithContex
 	return pulumi.ToOutputWithContext(ctx, i).(Pav2MeterDetailsResponseOutput)
 }
 
+func (i *Pav2MeterDetailsResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*Pav2MeterDetailsResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Billing type PAV2 meter details
 type Pav2MeterDetailsResponseOutput struct{ *pulumi.OutputState }
 
@@ -1720,76 +1251,71 @@ func (o Pav2MeterDetailsResponseOutput) ToPav2MeterDetailsResponseOutputWithCont
 	return o
 }
 
+func (o Pav2MeterDetailsResponseOutput) ToOutput(ctx context.Context) pulumix.Output[Pav2MeterDetailsResponse] {
+	return pulumix.Output[Pav2MeterDetailsResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Represents billing type.
 // Expected value is 'Pav2'.
-func (o Pav2MeterDetailsResponseOutput) BillingType() pulumi.StringOutput {
-	return o.ApplyT(func(v Pav2MeterDetailsResponse) string { return v.BillingType }).(pulumi.StringOutput)
+func (o Pav2MeterDetailsResponseOutput) BillingType() pulumix.Output[string] {
+	return pulumix.Apply[Pav2MeterDetailsResponse](o, func(v Pav2MeterDetailsResponse) string { return v.BillingType })
 }
 
 // Charging type.
-func (o Pav2MeterDetailsResponseOutput) ChargingType() pulumi.StringOutput {
-	return o.ApplyT(func(v Pav2MeterDetailsResponse) string { return v.ChargingType }).(pulumi.StringOutput)
+func (o Pav2MeterDetailsResponseOutput) ChargingType() pulumix.Output[string] {
+	return pulumix.Apply[Pav2MeterDetailsResponse](o, func(v Pav2MeterDetailsResponse) string { return v.ChargingType })
 }
 
 // Validation status of requested data center and transport.
-func (o Pav2MeterDetailsResponseOutput) MeterGuid() pulumi.StringOutput {
-	return o.ApplyT(func(v Pav2MeterDetailsResponse) string { return v.MeterGuid }).(pulumi.StringOutput)
+func (o Pav2MeterDetailsResponseOutput) MeterGuid() pulumix.Output[string] {
+	return pulumix.Apply[Pav2MeterDetailsResponse](o, func(v Pav2MeterDetailsResponse) string { return v.MeterGuid })
 }
 
 // Billing unit applicable for Pav2 billing
-func (o Pav2MeterDetailsResponseOutput) Multiplier() pulumi.Float64Output {
-	return o.ApplyT(func(v Pav2MeterDetailsResponse) float64 { return v.Multiplier }).(pulumi.Float64Output)
+func (o Pav2MeterDetailsResponseOutput) Multiplier() pulumix.Output[float64] {
+	return pulumix.Apply[Pav2MeterDetailsResponse](o, func(v Pav2MeterDetailsResponse) float64 { return v.Multiplier })
 }
 
 // Product Family
 type ProductFamilyResponse struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponse `pulumi:"availabilityInformation"`
+	AvailabilityInformation *AvailabilityInformationResponse `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponse `pulumi:"costInformation"`
+	CostInformation *CostInformationResponse `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponse `pulumi:"description"`
+	Description *DescriptionResponse `pulumi:"description"`
 	// Display Name for the product system.
 	DisplayName string `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties []FilterablePropertyResponse `pulumi:"filterableProperties"`
+	FilterableProperties []*FilterablePropertyResponse `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponse `pulumi:"hierarchyInformation"`
+	HierarchyInformation *HierarchyInformationResponse `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation []ImageInformationResponse `pulumi:"imageInformation"`
+	ImageInformation []*ImageInformationResponse `pulumi:"imageInformation"`
 	// List of product lines supported in the product family
-	ProductLines []ProductLineResponse `pulumi:"productLines"`
-}
-
-// ProductFamilyResponseInput is an input type that accepts ProductFamilyResponseArgs and ProductFamilyResponseOutput values.
-// You can construct a concrete instance of `ProductFamilyResponseInput` via:
-//
-//	ProductFamilyResponseArgs{...}
-type ProductFamilyResponseInput interface {
-	pulumi.Input
-
-	ToProductFamilyResponseOutput() ProductFamilyResponseOutput
-	ToProductFamilyResponseOutputWithContext(context.Context) ProductFamilyResponseOutput
+	ProductLines []*ProductLineResponse `pulumi:"productLines"`
 }
 
 // Product Family
 type ProductFamilyResponseArgs struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponseInput `pulumi:"availabilityInformation"`
+	AvailabilityInformation pulumix.Input[*AvailabilityInformationResponseArgs] `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponseInput `pulumi:"costInformation"`
+	CostInformation pulumix.Input[*CostInformationResponseArgs] `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponseInput `pulumi:"description"`
+	Description pulumix.Input[*DescriptionResponseArgs] `pulumi:"description"`
 	// Display Name for the product system.
-	DisplayName pulumi.StringInput `pulumi:"displayName"`
+	DisplayName pulumix.Input[string] `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties FilterablePropertyResponseArrayInput `pulumi:"filterableProperties"`
+	FilterableProperties pulumix.Input[[]*FilterablePropertyResponseArgs] `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponseInput `pulumi:"hierarchyInformation"`
+	HierarchyInformation pulumix.Input[*HierarchyInformationResponseArgs] `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation ImageInformationResponseArrayInput `pulumi:"imageInformation"`
+	ImageInformation pulumix.Input[[]*ImageInformationResponseArgs] `pulumi:"imageInformation"`
 	// List of product lines supported in the product family
-	ProductLines ProductLineResponseArrayInput `pulumi:"productLines"`
+	ProductLines pulumix.Input[[]*ProductLineResponseArgs] `pulumi:"productLines"`
 }
 
 func (ProductFamilyResponseArgs) ElementType() reflect.Type {
@@ -1804,29 +1330,8 @@ func (i ProductFamilyResponseArgs) ToProductFamilyResponseOutputWithContext(ctx
 	return pulumi.ToOutputWithContext(ctx, i).(ProductFamilyResponseOutput)
 }
 
-// ProductFamilyResponseArrayInput is an input type that accepts ProductFamilyResponseArray and ProductFamilyResponseArrayOutput values.
-// You can construct a concrete instance of `ProductFamilyResponseArrayInput` via:
-//
-//	ProductFamilyResponseArray{ ProductFamilyResponseArgs{...} }
-type ProductFamilyResponseArrayInput interface {
-	pulumi.Input
-
-	ToProductFamilyResponseArrayOutput() ProductFamilyResponseArrayOutput
-	ToProductFamilyResponseArrayOutputWithContext(context.Context) ProductFamilyResponseArrayOutput
-}
-
-type ProductFamilyResponseArray []ProductFamilyResponseInput
-
-func (ProductFamilyResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductFamilyResponse)(nil)).Elem()
-}
-
-func (i ProductFamilyResponseArray) ToProductFamilyResponseArrayOutput() ProductFamilyResponseArrayOutput {
-	return i.ToProductFamilyResponseArrayOutputWithContext(context.Background())
-}
-
-func (i ProductFamilyResponseArray) ToProductFamilyResponseArrayOutputWithContext(ctx context.Context) ProductFamilyResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProductFamilyResponseArrayOutput)
+func (i *ProductFamilyResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*ProductFamilyResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Product Family
@@ -1844,115 +1349,97 @@ func (o ProductFamilyResponseOutput) ToProductFamilyResponseOutputWithContext(ct
 	return o
 }
 
+func (o ProductFamilyResponseOutput) ToOutput(ctx context.Context) pulumix.Output[ProductFamilyResponse] {
+	return pulumix.Output[ProductFamilyResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Availability information of the product system.
-func (o ProductFamilyResponseOutput) AvailabilityInformation() AvailabilityInformationResponseOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) AvailabilityInformationResponse { return v.AvailabilityInformation }).(AvailabilityInformationResponseOutput)
+func (o ProductFamilyResponseOutput) AvailabilityInformation() pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) *AvailabilityInformationResponse { return v.AvailabilityInformation })
+	return pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Cost information for the product system.
-func (o ProductFamilyResponseOutput) CostInformation() CostInformationResponseOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) CostInformationResponse { return v.CostInformation }).(CostInformationResponseOutput)
+func (o ProductFamilyResponseOutput) CostInformation() pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) *CostInformationResponse { return v.CostInformation })
+	return pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Description related to the product system.
-func (o ProductFamilyResponseOutput) Description() DescriptionResponseOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) DescriptionResponse { return v.Description }).(DescriptionResponseOutput)
+func (o ProductFamilyResponseOutput) Description() pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) *DescriptionResponse { return v.Description })
+	return pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput]{OutputState: value.OutputState}
 }
 
 // Display Name for the product system.
-func (o ProductFamilyResponseOutput) DisplayName() pulumi.StringOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) string { return v.DisplayName }).(pulumi.StringOutput)
+func (o ProductFamilyResponseOutput) DisplayName() pulumix.Output[string] {
+	return pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) string { return v.DisplayName })
 }
 
 // list of filters supported for a product
-func (o ProductFamilyResponseOutput) FilterableProperties() FilterablePropertyResponseArrayOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) []FilterablePropertyResponse { return v.FilterableProperties }).(FilterablePropertyResponseArrayOutput)
+func (o ProductFamilyResponseOutput) FilterableProperties() pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) []*FilterablePropertyResponse { return v.FilterableProperties })
+	return pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput]{OutputState: value.OutputState}
 }
 
 // Hierarchy information of a product.
-func (o ProductFamilyResponseOutput) HierarchyInformation() HierarchyInformationResponseOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) HierarchyInformationResponse { return v.HierarchyInformation }).(HierarchyInformationResponseOutput)
+func (o ProductFamilyResponseOutput) HierarchyInformation() pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) *HierarchyInformationResponse { return v.HierarchyInformation })
+	return pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Image information for the product system.
-func (o ProductFamilyResponseOutput) ImageInformation() ImageInformationResponseArrayOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) []ImageInformationResponse { return v.ImageInformation }).(ImageInformationResponseArrayOutput)
+func (o ProductFamilyResponseOutput) ImageInformation() pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) []*ImageInformationResponse { return v.ImageInformation })
+	return pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // List of product lines supported in the product family
-func (o ProductFamilyResponseOutput) ProductLines() ProductLineResponseArrayOutput {
-	return o.ApplyT(func(v ProductFamilyResponse) []ProductLineResponse { return v.ProductLines }).(ProductLineResponseArrayOutput)
-}
-
-type ProductFamilyResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (ProductFamilyResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductFamilyResponse)(nil)).Elem()
-}
-
-func (o ProductFamilyResponseArrayOutput) ToProductFamilyResponseArrayOutput() ProductFamilyResponseArrayOutput {
-	return o
-}
-
-func (o ProductFamilyResponseArrayOutput) ToProductFamilyResponseArrayOutputWithContext(ctx context.Context) ProductFamilyResponseArrayOutput {
-	return o
-}
-
-func (o ProductFamilyResponseArrayOutput) Index(i pulumi.IntInput) ProductFamilyResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ProductFamilyResponse {
-		return vs[0].([]ProductFamilyResponse)[vs[1].(int)]
-	}).(ProductFamilyResponseOutput)
+func (o ProductFamilyResponseOutput) ProductLines() pulumix.GArrayOutput[ProductLineResponse, ProductLineResponseOutput] {
+	value := pulumix.Apply[ProductFamilyResponse](o, func(v ProductFamilyResponse) []*ProductLineResponse { return v.ProductLines })
+	return pulumix.GArrayOutput[ProductLineResponse, ProductLineResponseOutput]{OutputState: value.OutputState}
 }
 
 // Product line
 type ProductLineResponse struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponse `pulumi:"availabilityInformation"`
+	AvailabilityInformation *AvailabilityInformationResponse `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponse `pulumi:"costInformation"`
+	CostInformation *CostInformationResponse `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponse `pulumi:"description"`
+	Description *DescriptionResponse `pulumi:"description"`
 	// Display Name for the product system.
 	DisplayName string `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties []FilterablePropertyResponse `pulumi:"filterableProperties"`
+	FilterableProperties []*FilterablePropertyResponse `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponse `pulumi:"hierarchyInformation"`
+	HierarchyInformation *HierarchyInformationResponse `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation []ImageInformationResponse `pulumi:"imageInformation"`
+	ImageInformation []*ImageInformationResponse `pulumi:"imageInformation"`
 	// List of products in the product line
-	Products []ProductResponse `pulumi:"products"`
-}
-
-// ProductLineResponseInput is an input type that accepts ProductLineResponseArgs and ProductLineResponseOutput values.
-// You can construct a concrete instance of `ProductLineResponseInput` via:
-//
-//	ProductLineResponseArgs{...}
-type ProductLineResponseInput interface {
-	pulumi.Input
-
-	ToProductLineResponseOutput() ProductLineResponseOutput
-	ToProductLineResponseOutputWithContext(context.Context) ProductLineResponseOutput
+	Products []*ProductResponse `pulumi:"products"`
 }
 
 // Product line
 type ProductLineResponseArgs struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponseInput `pulumi:"availabilityInformation"`
+	AvailabilityInformation pulumix.Input[*AvailabilityInformationResponseArgs] `pulumi:"availabilityInformation"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponseInput `pulumi:"costInformation"`
+	CostInformation pulumix.Input[*CostInformationResponseArgs] `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponseInput `pulumi:"description"`
+	Description pulumix.Input[*DescriptionResponseArgs] `pulumi:"description"`
 	// Display Name for the product system.
-	DisplayName pulumi.StringInput `pulumi:"displayName"`
+	DisplayName pulumix.Input[string] `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties FilterablePropertyResponseArrayInput `pulumi:"filterableProperties"`
+	FilterableProperties pulumix.Input[[]*FilterablePropertyResponseArgs] `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponseInput `pulumi:"hierarchyInformation"`
+	HierarchyInformation pulumix.Input[*HierarchyInformationResponseArgs] `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation ImageInformationResponseArrayInput `pulumi:"imageInformation"`
+	ImageInformation pulumix.Input[[]*ImageInformationResponseArgs] `pulumi:"imageInformation"`
 	// List of products in the product line
-	Products ProductResponseArrayInput `pulumi:"products"`
+	Products pulumix.Input[[]*ProductResponseArgs] `pulumi:"products"`
 }
 
 func (ProductLineResponseArgs) ElementType() reflect.Type {
@@ -1967,29 +1454,8 @@ func (i ProductLineResponseArgs) ToProductLineResponseOutputWithContext(ctx cont
 	return pulumi.ToOutputWithContext(ctx, i).(ProductLineResponseOutput)
 }
 
-// ProductLineResponseArrayInput is an input type that accepts ProductLineResponseArray and ProductLineResponseArrayOutput values.
-// You can construct a concrete instance of `ProductLineResponseArrayInput` via:
-//
-//	ProductLineResponseArray{ ProductLineResponseArgs{...} }
-type ProductLineResponseArrayInput interface {
-	pulumi.Input
-
-	ToProductLineResponseArrayOutput() ProductLineResponseArrayOutput
-	ToProductLineResponseArrayOutputWithContext(context.Context) ProductLineResponseArrayOutput
-}
-
-type ProductLineResponseArray []ProductLineResponseInput
-
-func (ProductLineResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductLineResponse)(nil)).Elem()
-}
-
-func (i ProductLineResponseArray) ToProductLineResponseArrayOutput() ProductLineResponseArrayOutput {
-	return i.ToProductLineResponseArrayOutputWithContext(context.Background())
-}
-
-func (i ProductLineResponseArray) ToProductLineResponseArrayOutputWithContext(ctx context.Context) ProductLineResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProductLineResponseArrayOutput)
+func (i *ProductLineResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*ProductLineResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Product line
@@ -2007,115 +1473,97 @@ func (o ProductLineResponseOutput) ToProductLineResponseOutputWithContext(ctx co
 	return o
 }
 
+func (o ProductLineResponseOutput) ToOutput(ctx context.Context) pulumix.Output[ProductLineResponse] {
+	return pulumix.Output[ProductLineResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Availability information of the product system.
-func (o ProductLineResponseOutput) AvailabilityInformation() AvailabilityInformationResponseOutput {
-	return o.ApplyT(func(v ProductLineResponse) AvailabilityInformationResponse { return v.AvailabilityInformation }).(AvailabilityInformationResponseOutput)
+func (o ProductLineResponseOutput) AvailabilityInformation() pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) *AvailabilityInformationResponse { return v.AvailabilityInformation })
+	return pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Cost information for the product system.
-func (o ProductLineResponseOutput) CostInformation() CostInformationResponseOutput {
-	return o.ApplyT(func(v ProductLineResponse) CostInformationResponse { return v.CostInformation }).(CostInformationResponseOutput)
+func (o ProductLineResponseOutput) CostInformation() pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) *CostInformationResponse { return v.CostInformation })
+	return pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Description related to the product system.
-func (o ProductLineResponseOutput) Description() DescriptionResponseOutput {
-	return o.ApplyT(func(v ProductLineResponse) DescriptionResponse { return v.Description }).(DescriptionResponseOutput)
+func (o ProductLineResponseOutput) Description() pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) *DescriptionResponse { return v.Description })
+	return pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput]{OutputState: value.OutputState}
 }
 
 // Display Name for the product system.
-func (o ProductLineResponseOutput) DisplayName() pulumi.StringOutput {
-	return o.ApplyT(func(v ProductLineResponse) string { return v.DisplayName }).(pulumi.StringOutput)
+func (o ProductLineResponseOutput) DisplayName() pulumix.Output[string] {
+	return pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) string { return v.DisplayName })
 }
 
 // list of filters supported for a product
-func (o ProductLineResponseOutput) FilterableProperties() FilterablePropertyResponseArrayOutput {
-	return o.ApplyT(func(v ProductLineResponse) []FilterablePropertyResponse { return v.FilterableProperties }).(FilterablePropertyResponseArrayOutput)
+func (o ProductLineResponseOutput) FilterableProperties() pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) []*FilterablePropertyResponse { return v.FilterableProperties })
+	return pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput]{OutputState: value.OutputState}
 }
 
 // Hierarchy information of a product.
-func (o ProductLineResponseOutput) HierarchyInformation() HierarchyInformationResponseOutput {
-	return o.ApplyT(func(v ProductLineResponse) HierarchyInformationResponse { return v.HierarchyInformation }).(HierarchyInformationResponseOutput)
+func (o ProductLineResponseOutput) HierarchyInformation() pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) *HierarchyInformationResponse { return v.HierarchyInformation })
+	return pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Image information for the product system.
-func (o ProductLineResponseOutput) ImageInformation() ImageInformationResponseArrayOutput {
-	return o.ApplyT(func(v ProductLineResponse) []ImageInformationResponse { return v.ImageInformation }).(ImageInformationResponseArrayOutput)
+func (o ProductLineResponseOutput) ImageInformation() pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) []*ImageInformationResponse { return v.ImageInformation })
+	return pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // List of products in the product line
-func (o ProductLineResponseOutput) Products() ProductResponseArrayOutput {
-	return o.ApplyT(func(v ProductLineResponse) []ProductResponse { return v.Products }).(ProductResponseArrayOutput)
-}
-
-type ProductLineResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (ProductLineResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductLineResponse)(nil)).Elem()
-}
-
-func (o ProductLineResponseArrayOutput) ToProductLineResponseArrayOutput() ProductLineResponseArrayOutput {
-	return o
-}
-
-func (o ProductLineResponseArrayOutput) ToProductLineResponseArrayOutputWithContext(ctx context.Context) ProductLineResponseArrayOutput {
-	return o
-}
-
-func (o ProductLineResponseArrayOutput) Index(i pulumi.IntInput) ProductLineResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ProductLineResponse {
-		return vs[0].([]ProductLineResponse)[vs[1].(int)]
-	}).(ProductLineResponseOutput)
+func (o ProductLineResponseOutput) Products() pulumix.GArrayOutput[ProductResponse, ProductResponseOutput] {
+	value := pulumix.Apply[ProductLineResponse](o, func(v ProductLineResponse) []*ProductResponse { return v.Products })
+	return pulumix.GArrayOutput[ProductResponse, ProductResponseOutput]{OutputState: value.OutputState}
 }
 
 // List of Products
 type ProductResponse struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponse `pulumi:"availabilityInformation"`
+	AvailabilityInformation *AvailabilityInformationResponse `pulumi:"availabilityInformation"`
 	// List of configurations for the product
-	Configurations []ConfigurationResponse `pulumi:"configurations"`
+	Configurations []*ConfigurationResponse `pulumi:"configurations"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponse `pulumi:"costInformation"`
+	CostInformation *CostInformationResponse `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponse `pulumi:"description"`
+	Description *DescriptionResponse `pulumi:"description"`
 	// Display Name for the product system.
 	DisplayName string `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties []FilterablePropertyResponse `pulumi:"filterableProperties"`
+	FilterableProperties []*FilterablePropertyResponse `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponse `pulumi:"hierarchyInformation"`
+	HierarchyInformation *HierarchyInformationResponse `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation []ImageInformationResponse `pulumi:"imageInformation"`
-}
-
-// ProductResponseInput is an input type that accepts ProductResponseArgs and ProductResponseOutput values.
-// You can construct a concrete instance of `ProductResponseInput` via:
-//
-//	ProductResponseArgs{...}
-type ProductResponseInput interface {
-	pulumi.Input
-
-	ToProductResponseOutput() ProductResponseOutput
-	ToProductResponseOutputWithContext(context.Context) ProductResponseOutput
+	ImageInformation []*ImageInformationResponse `pulumi:"imageInformation"`
 }
 
 // List of Products
 type ProductResponseArgs struct {
 	// Availability information of the product system.
-	AvailabilityInformation AvailabilityInformationResponseInput `pulumi:"availabilityInformation"`
+	AvailabilityInformation pulumix.Input[*AvailabilityInformationResponseArgs] `pulumi:"availabilityInformation"`
 	// List of configurations for the product
-	Configurations ConfigurationResponseArrayInput `pulumi:"configurations"`
+	Configurations pulumix.Input[[]*ConfigurationResponseArgs] `pulumi:"configurations"`
 	// Cost information for the product system.
-	CostInformation CostInformationResponseInput `pulumi:"costInformation"`
+	CostInformation pulumix.Input[*CostInformationResponseArgs] `pulumi:"costInformation"`
 	// Description related to the product system.
-	Description DescriptionResponseInput `pulumi:"description"`
+	Description pulumix.Input[*DescriptionResponseArgs] `pulumi:"description"`
 	// Display Name for the product system.
-	DisplayName pulumi.StringInput `pulumi:"displayName"`
+	DisplayName pulumix.Input[string] `pulumi:"displayName"`
 	// list of filters supported for a product
-	FilterableProperties FilterablePropertyResponseArrayInput `pulumi:"filterableProperties"`
+	FilterableProperties pulumix.Input[[]*FilterablePropertyResponseArgs] `pulumi:"filterableProperties"`
 	// Hierarchy information of a product.
-	HierarchyInformation HierarchyInformationResponseInput `pulumi:"hierarchyInformation"`
+	HierarchyInformation pulumix.Input[*HierarchyInformationResponseArgs] `pulumi:"hierarchyInformation"`
 	// Image information for the product system.
-	ImageInformation ImageInformationResponseArrayInput `pulumi:"imageInformation"`
+	ImageInformation pulumix.Input[[]*ImageInformationResponseArgs] `pulumi:"imageInformation"`
 }
 
 func (ProductResponseArgs) ElementType() reflect.Type {
@@ -2130,29 +1578,8 @@ func (i ProductResponseArgs) ToProductResponseOutputWithContext(ctx context.Cont
 	return pulumi.ToOutputWithContext(ctx, i).(ProductResponseOutput)
 }
 
-// ProductResponseArrayInput is an input type that accepts ProductResponseArray and ProductResponseArrayOutput values.
-// You can construct a concrete instance of `ProductResponseArrayInput` via:
-//
-//	ProductResponseArray{ ProductResponseArgs{...} }
-type ProductResponseArrayInput interface {
-	pulumi.Input
-
-	ToProductResponseArrayOutput() ProductResponseArrayOutput
-	ToProductResponseArrayOutputWithContext(context.Context) ProductResponseArrayOutput
-}
-
-type ProductResponseArray []ProductResponseInput
-
-func (ProductResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductResponse)(nil)).Elem()
-}
-
-func (i ProductResponseArray) ToProductResponseArrayOutput() ProductResponseArrayOutput {
-	return i.ToProductResponseArrayOutputWithContext(context.Background())
-}
-
-func (i ProductResponseArray) ToProductResponseArrayOutputWithContext(ctx context.Context) ProductResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProductResponseArrayOutput)
+func (i *ProductResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*ProductResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // List of Products
@@ -2170,64 +1597,57 @@ func (o ProductResponseOutput) ToProductResponseOutputWithContext(ctx context.Co
 	return o
 }
 
+func (o ProductResponseOutput) ToOutput(ctx context.Context) pulumix.Output[ProductResponse] {
+	return pulumix.Output[ProductResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Availability information of the product system.
-func (o ProductResponseOutput) AvailabilityInformation() AvailabilityInformationResponseOutput {
-	return o.ApplyT(func(v ProductResponse) AvailabilityInformationResponse { return v.AvailabilityInformation }).(AvailabilityInformationResponseOutput)
+func (o ProductResponseOutput) AvailabilityInformation() pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) *AvailabilityInformationResponse { return v.AvailabilityInformation })
+	return pulumix.GPtrOutput[AvailabilityInformationResponse, AvailabilityInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // List of configurations for the product
-func (o ProductResponseOutput) Configurations() ConfigurationResponseArrayOutput {
-	return o.ApplyT(func(v ProductResponse) []ConfigurationResponse { return v.Configurations }).(ConfigurationResponseArrayOutput)
+func (o ProductResponseOutput) Configurations() pulumix.GArrayOutput[ConfigurationResponse, ConfigurationResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) []*ConfigurationResponse { return v.Configurations })
+	return pulumix.GArrayOutput[ConfigurationResponse, ConfigurationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Cost information for the product system.
-func (o ProductResponseOutput) CostInformation() CostInformationResponseOutput {
-	return o.ApplyT(func(v ProductResponse) CostInformationResponse { return v.CostInformation }).(CostInformationResponseOutput)
+func (o ProductResponseOutput) CostInformation() pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) *CostInformationResponse { return v.CostInformation })
+	return pulumix.GPtrOutput[CostInformationResponse, CostInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Description related to the product system.
-func (o ProductResponseOutput) Description() DescriptionResponseOutput {
-	return o.ApplyT(func(v ProductResponse) DescriptionResponse { return v.Description }).(DescriptionResponseOutput)
+func (o ProductResponseOutput) Description() pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) *DescriptionResponse { return v.Description })
+	return pulumix.GPtrOutput[DescriptionResponse, DescriptionResponseOutput]{OutputState: value.OutputState}
 }
 
 // Display Name for the product system.
-func (o ProductResponseOutput) DisplayName() pulumi.StringOutput {
-	return o.ApplyT(func(v ProductResponse) string { return v.DisplayName }).(pulumi.StringOutput)
+func (o ProductResponseOutput) DisplayName() pulumix.Output[string] {
+	return pulumix.Apply[ProductResponse](o, func(v ProductResponse) string { return v.DisplayName })
 }
 
 // list of filters supported for a product
-func (o ProductResponseOutput) FilterableProperties() FilterablePropertyResponseArrayOutput {
-	return o.ApplyT(func(v ProductResponse) []FilterablePropertyResponse { return v.FilterableProperties }).(FilterablePropertyResponseArrayOutput)
+func (o ProductResponseOutput) FilterableProperties() pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) []*FilterablePropertyResponse { return v.FilterableProperties })
+	return pulumix.GArrayOutput[FilterablePropertyResponse, FilterablePropertyResponseOutput]{OutputState: value.OutputState}
 }
 
 // Hierarchy information of a product.
-func (o ProductResponseOutput) HierarchyInformation() HierarchyInformationResponseOutput {
-	return o.ApplyT(func(v ProductResponse) HierarchyInformationResponse { return v.HierarchyInformation }).(HierarchyInformationResponseOutput)
+func (o ProductResponseOutput) HierarchyInformation() pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) *HierarchyInformationResponse { return v.HierarchyInformation })
+	return pulumix.GPtrOutput[HierarchyInformationResponse, HierarchyInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Image information for the product system.
-func (o ProductResponseOutput) ImageInformation() ImageInformationResponseArrayOutput {
-	return o.ApplyT(func(v ProductResponse) []ImageInformationResponse { return v.ImageInformation }).(ImageInformationResponseArrayOutput)
-}
-
-type ProductResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (ProductResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ProductResponse)(nil)).Elem()
-}
-
-func (o ProductResponseArrayOutput) ToProductResponseArrayOutput() ProductResponseArrayOutput {
-	return o
-}
-
-func (o ProductResponseArrayOutput) ToProductResponseArrayOutputWithContext(ctx context.Context) ProductResponseArrayOutput {
-	return o
-}
-
-func (o ProductResponseArrayOutput) Index(i pulumi.IntInput) ProductResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ProductResponse {
-		return vs[0].([]ProductResponse)[vs[1].(int)]
-	}).(ProductResponseOutput)
+func (o ProductResponseOutput) ImageInformation() pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput] {
+	value := pulumix.Apply[ProductResponse](o, func(v ProductResponse) []*ImageInformationResponse { return v.ImageInformation })
+	return pulumix.GArrayOutput[ImageInformationResponse, ImageInformationResponseOutput]{OutputState: value.OutputState}
 }
 
 // Billing type Purchase meter details
@@ -2247,32 +1667,21 @@ type PurchaseMeterDetailsResponse struct {
 	TermId string `pulumi:"termId"`
 }
 
-// PurchaseMeterDetailsResponseInput is an input type that accepts PurchaseMeterDetailsResponseArgs and PurchaseMeterDetailsResponseOutput values.
-// You can construct a concrete instance of `PurchaseMeterDetailsResponseInput` via:
-//
-//	PurchaseMeterDetailsResponseArgs{...}
-type PurchaseMeterDetailsResponseInput interface {
-	pulumi.Input
-
-	ToPurchaseMeterDetailsResponseOutput() PurchaseMeterDetailsResponseOutput
-	ToPurchaseMeterDetailsResponseOutputWithContext(context.Context) PurchaseMeterDetailsResponseOutput
-}
-
 // Billing type Purchase meter details
 type PurchaseMeterDetailsResponseArgs struct {
 	// Represents billing type.
 	// Expected value is 'Purchase'.
-	BillingType pulumi.StringInput `pulumi:"billingType"`
+	BillingType pulumix.Input[string] `pulumi:"billingType"`
 	// Charging type.
-	ChargingType pulumi.StringInput `pulumi:"chargingType"`
+	ChargingType pulumix.Input[string] `pulumi:"chargingType"`
 	// Billing unit applicable for Pav2 billing
-	Multiplier pulumi.Float64Input `pulumi:"multiplier"`
+	Multiplier pulumix.Input[float64] `pulumi:"multiplier"`
 	// Product Id
-	ProductId pulumi.StringInput `pulumi:"productId"`
+	ProductId pulumix.Input[string] `pulumi:"productId"`
 	// Sku Id
-	SkuId pulumi.StringInput `pulumi:"skuId"`
+	SkuId pulumix.Input[string] `pulumi:"skuId"`
 	// Term Id
-	TermId pulumi.StringInput `pulumi:"termId"`
+	TermId pulumix.Input[string] `pulumi:"termId"`
 }
 
 func (PurchaseMeterDetailsResponseArgs) ElementType() reflect.Type {
@@ -2287,6 +1696,10 @@ func (i PurchaseMeterDetailsResponseArgs) ToPurchaseMeterDetailsResponseOutputWi
 	return pulumi.ToOutputWithContext(ctx, i).(PurchaseMeterDetailsResponseOutput)
 }
 
+func (i *PurchaseMeterDetailsResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*PurchaseMeterDetailsResponseArgs] {
+	return pulumix.Val(i)
+}
+
 // Billing type Purchase meter details
 type PurchaseMeterDetailsResponseOutput struct{ *pulumi.OutputState }
 
@@ -2302,35 +1715,41 @@ func (o PurchaseMeterDetailsResponseOutput) ToPurchaseMeterDetailsResponseOutput
 	return o
 }
 
+func (o PurchaseMeterDetailsResponseOutput) ToOutput(ctx context.Context) pulumix.Output[PurchaseMeterDetailsResponse] {
+	return pulumix.Output[PurchaseMeterDetailsResponse]{
+		OutputState: o.OutputState,
+	}
+}
+
 // Represents billing type.
 // Expected value is 'Purchase'.
-func (o PurchaseMeterDetailsResponseOutput) BillingType() pulumi.StringOutput {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) string { return v.BillingType }).(pulumi.StringOutput)
+func (o PurchaseMeterDetailsResponseOutput) BillingType() pulumix.Output[string] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) string { return v.BillingType })
 }
 
 // Charging type.
-func (o PurchaseMeterDetailsResponseOutput) ChargingType() pulumi.StringOutput {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) string { return v.ChargingType }).(pulumi.StringOutput)
+func (o PurchaseMeterDetailsResponseOutput) ChargingType() pulumix.Output[string] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) string { return v.ChargingType })
 }
 
 // Billing unit applicable for Pav2 billing
-func (o PurchaseMeterDetailsResponseOutput) Multiplier() pulumi.Float64Output {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) float64 { return v.Multiplier }).(pulumi.Float64Output)
+func (o PurchaseMeterDetailsResponseOutput) Multiplier() pulumix.Output[float64] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) float64 { return v.Multiplier })
 }
 
 // Product Id
-func (o PurchaseMeterDetailsResponseOutput) ProductId() pulumi.StringOutput {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) string { return v.ProductId }).(pulumi.StringOutput)
+func (o PurchaseMeterDetailsResponseOutput) ProductId() pulumix.Output[string] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) string { return v.ProductId })
 }
 
 // Sku Id
-func (o PurchaseMeterDetailsResponseOutput) SkuId() pulumi.StringOutput {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) string { return v.SkuId }).(pulumi.StringOutput)
+func (o PurchaseMeterDetailsResponseOutput) SkuId() pulumix.Output[string] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) string { return v.SkuId })
 }
 
 // Term Id
-func (o PurchaseMeterDetailsResponseOutput) TermId() pulumi.StringOutput {
-	return o.ApplyT(func(v PurchaseMeterDetailsResponse) string { return v.TermId }).(pulumi.StringOutput)
+func (o PurchaseMeterDetailsResponseOutput) TermId() pulumix.Output[string] {
+	return pulumix.Apply[PurchaseMeterDetailsResponse](o, func(v PurchaseMeterDetailsResponse) string { return v.TermId })
 }
 
 // Specifications of the configurations
@@ -2341,23 +1760,12 @@ type SpecificationResponse struct {
 	Value string `pulumi:"value"`
 }
 
-// SpecificationResponseInput is an input type that accepts SpecificationResponseArgs and SpecificationResponseOutput values.
-// You can construct a concrete instance of `SpecificationResponseInput` via:
-//
-//	SpecificationResponseArgs{...}
-type SpecificationResponseInput interface {
-	pulumi.Input
-
-	ToSpecificationResponseOutput() SpecificationResponseOutput
-	ToSpecificationResponseOutputWithContext(context.Context) SpecificationResponseOutput
-}
-
 // Specifications of the configurations
 type SpecificationResponseArgs struct {
 	// Name of the specification
-	Name pulumi.StringInput `pulumi:"name"`
+	Name pulumix.Input[string] `pulumi:"name"`
 	// Value of the specification
-	Value pulumi.StringInput `pulumi:"value"`
+	Value pulumix.Input[string] `pulumi:"value"`
 }
 
 func (SpecificationResponseArgs) ElementType() reflect.Type {
@@ -2372,29 +1780,8 @@ func (i SpecificationResponseArgs) ToSpecificationResponseOutputWithContext(ctx
 	return pulumi.ToOutputWithContext(ctx, i).(SpecificationResponseOutput)
 }
 
-// SpecificationResponseArrayInput is an input type that accepts SpecificationResponseArray and SpecificationResponseArrayOutput values.
-// You can construct a concrete instance of `SpecificationResponseArrayInput` via:
-//
-//	SpecificationResponseArray{ SpecificationResponseArgs{...} }
-type SpecificationResponseArrayInput interface {
-	pulumi.Input
-
-	ToSpecificationResponseArrayOutput() SpecificationResponseArrayOutput
-	ToSpecificationResponseArrayOutputWithContext(context.Context) SpecificationResponseArrayOutput
-}
-
-type SpecificationResponseArray []SpecificationResponseInput
-
-func (SpecificationResponseArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SpecificationResponse)(nil)).Elem()
-}
-
-func (i SpecificationResponseArray) ToSpecificationResponseArrayOutput() SpecificationResponseArrayOutput {
-	return i.ToSpecificationResponseArrayOutputWithContext(context.Background())
-}
-
-func (i SpecificationResponseArray) ToSpecificationResponseArrayOutputWithContext(ctx context.Context) SpecificationResponseArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SpecificationResponseArrayOutput)
+func (i *SpecificationResponseArgs) ToOutput(ctx context.Context) pulumix.Output[*SpecificationResponseArgs] {
+	return pulumix.Val(i)
 }
 
 // Specifications of the configurations
@@ -2412,150 +1799,42 @@ func (o SpecificationResponseOutput) ToSpecificationResponseOutputWithContext(ct
 	return o
 }
 
-// Name of the specification
-func (o SpecificationResponseOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v SpecificationResponse) string { return v.Name }).(pulumi.StringOutput)
-}
-
-// Value of the specification
-func (o SpecificationResponseOutput) Value() pulumi.StringOutput {
-	return o.ApplyT(func(v SpecificationResponse) string { return v.Value }).(pulumi.StringOutput)
-}
-
-type SpecificationResponseArrayOutput struct{ *pulumi.OutputState }
-
-func (SpecificationResponseArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SpecificationResponse)(nil)).Elem()
-}
-
-func (o SpecificationResponseArrayOutput) ToSpecificationResponseArrayOutput() SpecificationResponseArrayOutput {
-	return o
-}
-
-func (o SpecificationResponseArrayOutput) ToSpecificationResponseArrayOutputWithContext(ctx context.Context) SpecificationResponseArrayOutput {
-	return o
-}
-
-func (o SpecificationResponseArrayOutput) Index(i pulumi.IntInput) SpecificationResponseOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SpecificationResponse {
-		return vs[0].([]SpecificationResponse)[vs[1].(int)]
-	}).(SpecificationResponseOutput)
-}
-
-type FilterablePropertyArrayMap map[string]FilterablePropertyArrayInput
-
-func (FilterablePropertyArrayMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]FilterableProperty)(nil)).Elem()
-}
-
-func (i FilterablePropertyArrayMap) ToFilterablePropertyArrayMapOutput() FilterablePropertyArrayMapOutput {
-	return i.ToFilterablePropertyArrayMapOutputWithContext(context.Background())
-}
-
-func (i FilterablePropertyArrayMap) ToFilterablePropertyArrayMapOutputWithContext(ctx context.Context) FilterablePropertyArrayMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FilterablePropertyArrayMapOutput)
+func (o SpecificationResponseOutput) ToOutput(ctx context.Context) pulumix.Output[SpecificationResponse] {
+	return pulumix.Output[SpecificationResponse]{
+		OutputState: o.OutputState,
+	}
 }
 
-// FilterablePropertyArrayMapInput is an input type that accepts FilterablePropertyArrayMap and FilterablePropertyArrayMapOutput values.
-// You can construct a concrete instance of `FilterablePropertyArrayMapInput` via:
-//
-//	FilterablePropertyArrayMap{ "key": FilterablePropertyArray{ FilterablePropertyArgs{...} } }
-type FilterablePropertyArrayMapInput interface {
-	pulumi.Input
-
-	ToFilterablePropertyArrayMapOutput() FilterablePropertyArrayMapOutput
-	ToFilterablePropertyArrayMapOutputWithContext(context.Context) FilterablePropertyArrayMapOutput
-}
-
-type FilterablePropertyArrayMapOutput struct{ *pulumi.OutputState }
-
-func (FilterablePropertyArrayMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]FilterableProperty)(nil)).Elem()
-}
-
-func (o FilterablePropertyArrayMapOutput) ToFilterablePropertyArrayMapOutput() FilterablePropertyArrayMapOutput {
-	return o
-}
-
-func (o FilterablePropertyArrayMapOutput) ToFilterablePropertyArrayMapOutputWithContext(ctx context.Context) FilterablePropertyArrayMapOutput {
-	return o
+// Name of the specification
+func (o SpecificationResponseOutput) Name() pulumix.Output[string] {
+	return pulumix.Apply[SpecificationResponse](o, func(v SpecificationResponse) string { return v.Name })
 }
 
-func (o FilterablePropertyArrayMapOutput) MapIndex(k pulumi.StringInput) FilterablePropertyArrayOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) []FilterableProperty {
-		return vs[0].(map[string][]FilterableProperty)[vs[1].(string)]
-	}).(FilterablePropertyArrayOutput)
+// Value of the specification
+func (o SpecificationResponseOutput) Value() pulumix.Output[string] {
+	return pulumix.Apply[SpecificationResponse](o, func(v SpecificationResponse) string { return v.Value })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*AvailabilityInformationResponseInput)(nil)).Elem(), AvailabilityInformationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*BillingMeterDetailsResponseInput)(nil)).Elem(), BillingMeterDetailsResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*BillingMeterDetailsResponseArrayInput)(nil)).Elem(), BillingMeterDetailsResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigurationFiltersInput)(nil)).Elem(), ConfigurationFiltersArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigurationFiltersArrayInput)(nil)).Elem(), ConfigurationFiltersArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigurationResponseInput)(nil)).Elem(), ConfigurationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigurationResponseArrayInput)(nil)).Elem(), ConfigurationResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*CostInformationResponseInput)(nil)).Elem(), CostInformationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*CustomerSubscriptionDetailsInput)(nil)).Elem(), CustomerSubscriptionDetailsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*CustomerSubscriptionDetailsPtrInput)(nil)).Elem(), CustomerSubscriptionDetailsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*CustomerSubscriptionRegisteredFeaturesInput)(nil)).Elem(), CustomerSubscriptionRegisteredFeaturesArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*CustomerSubscriptionRegisteredFeaturesArrayInput)(nil)).Elem(), CustomerSubscriptionRegisteredFeaturesArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*DescriptionResponseInput)(nil)).Elem(), DescriptionResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*DimensionsResponseInput)(nil)).Elem(), DimensionsResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FilterablePropertyInput)(nil)).Elem(), FilterablePropertyArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FilterablePropertyArrayInput)(nil)).Elem(), FilterablePropertyArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FilterablePropertyResponseInput)(nil)).Elem(), FilterablePropertyResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FilterablePropertyResponseArrayInput)(nil)).Elem(), FilterablePropertyResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*HierarchyInformationInput)(nil)).Elem(), HierarchyInformationArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*HierarchyInformationResponseInput)(nil)).Elem(), HierarchyInformationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ImageInformationResponseInput)(nil)).Elem(), ImageInformationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ImageInformationResponseArrayInput)(nil)).Elem(), ImageInformationResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*LinkResponseInput)(nil)).Elem(), LinkResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*LinkResponseArrayInput)(nil)).Elem(), LinkResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*Pav2MeterDetailsResponseInput)(nil)).Elem(), Pav2MeterDetailsResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductFamilyResponseInput)(nil)).Elem(), ProductFamilyResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductFamilyResponseArrayInput)(nil)).Elem(), ProductFamilyResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductLineResponseInput)(nil)).Elem(), ProductLineResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductLineResponseArrayInput)(nil)).Elem(), ProductLineResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductResponseInput)(nil)).Elem(), ProductResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ProductResponseArrayInput)(nil)).Elem(), ProductResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*PurchaseMeterDetailsResponseInput)(nil)).Elem(), PurchaseMeterDetailsResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SpecificationResponseInput)(nil)).Elem(), SpecificationResponseArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SpecificationResponseArrayInput)(nil)).Elem(), SpecificationResponseArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*FilterablePropertyArrayMapInput)(nil)).Elem(), FilterablePropertyArrayMap{})
 	pulumi.RegisterOutputType(AvailabilityInformationResponseOutput{})
 	pulumi.RegisterOutputType(BillingMeterDetailsResponseOutput{})
-	pulumi.RegisterOutputType(BillingMeterDetailsResponseArrayOutput{})
 	pulumi.RegisterOutputType(ConfigurationFiltersOutput{})
-	pulumi.RegisterOutputType(ConfigurationFiltersArrayOutput{})
 	pulumi.RegisterOutputType(ConfigurationResponseOutput{})
-	pulumi.RegisterOutputType(ConfigurationResponseArrayOutput{})
 	pulumi.RegisterOutputType(CostInformationResponseOutput{})
 	pulumi.RegisterOutputType(CustomerSubscriptionDetailsOutput{})
-	pulumi.RegisterOutputType(CustomerSubscriptionDetailsPtrOutput{})
 	pulumi.RegisterOutputType(CustomerSubscriptionRegisteredFeaturesOutput{})
-	pulumi.RegisterOutputType(CustomerSubscriptionRegisteredFeaturesArrayOutput{})
 	pulumi.RegisterOutputType(DescriptionResponseOutput{})
 	pulumi.RegisterOutputType(DimensionsResponseOutput{})
 	pulumi.RegisterOutputType(FilterablePropertyOutput{})
-	pulumi.RegisterOutputType(FilterablePropertyArrayOutput{})
 	pulumi.RegisterOutputType(FilterablePropertyResponseOutput{})
-	pulumi.RegisterOutputType(FilterablePropertyResponseArrayOutput{})
 	pulumi.RegisterOutputType(HierarchyInformationOutput{})
 	pulumi.RegisterOutputType(HierarchyInformationResponseOutput{})
 	pulumi.RegisterOutputType(ImageInformationResponseOutput{})
-	pulumi.RegisterOutputType(ImageInformationResponseArrayOutput{})
 	pulumi.RegisterOutputType(LinkResponseOutput{})
-	pulumi.RegisterOutputType(LinkResponseArrayOutput{})
 	pulumi.RegisterOutputType(Pav2MeterDetailsResponseOutput{})
 	pulumi.RegisterOutputType(ProductFamilyResponseOutput{})
-	pulumi.RegisterOutputType(ProductFamilyResponseArrayOutput{})
 	pulumi.RegisterOutputType(ProductLineResponseOutput{})
-	pulumi.RegisterOutputType(ProductLineResponseArrayOutput{})
 	pulumi.RegisterOutputType(ProductResponseOutput{})
-	pulumi.RegisterOutputType(ProductResponseArrayOutput{})
 	pulumi.RegisterOutputType(PurchaseMeterDetailsResponseOutput{})
 	pulumi.RegisterOutputType(SpecificationResponseOutput{})
-	pulumi.RegisterOutputType(SpecificationResponseArrayOutput{})
-	pulumi.RegisterOutputType(FilterablePropertyArrayMapOutput{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"plain-object-disable-defaults/example/internal"
 )
 
@@ -17,7 +18,7 @@ type Foo struct {
 	pulumi.CustomResourceState
 
 	// A test for plain types
-	DefaultKubeClientSettings KubeClientSettingsPtrOutput `pulumi:"defaultKubeClientSettings"`
+	DefaultKubeClientSettings pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput] `pulumi:"defaultKubeClientSettings"`
 }
 
 // NewFoo registers a new resource with the given unique name, arguments, and options.
@@ -76,40 +77,21 @@ type fooArgs struct {
 type FooArgs struct {
 	Argument *string
 	// Options for tuning the Kubernetes client used by a Provider.
-	BackupKubeClientSettings KubeClientSettingsInput
+	BackupKubeClientSettings pulumix.Input[*KubeClientSettingsArgs]
 	// Options for tuning the Kubernetes client used by a Provider.
-	KubeClientSettings KubeClientSettingsPtrInput
+	KubeClientSettings pulumix.Input[*KubeClientSettingsArgs]
 	// describing things
-	Settings LayeredTypePtrInput
+	Settings pulumix.Input[*LayeredTypeArgs]
 }
 
 func (FooArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooArgs)(nil)).Elem()
 }
 
-type FooInput interface {
-	pulumi.Input
-
-	ToFooOutput() FooOutput
-	ToFooOutputWithContext(ctx context.Context) FooOutput
-}
-
-func (*Foo) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
-}
-
-func (i *Foo) ToFooOutput() FooOutput {
-	return i.ToFooOutputWithContext(context.Background())
-}
-
-func (i *Foo) ToFooOutputWithContext(ctx context.Context) FooOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooOutput)
-}
-
 type FooOutput struct{ *pulumi.OutputState }
 
 func (FooOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
+	return reflect.TypeOf((*Foo)(nil)).Elem()
 }
 
 func (o FooOutput) ToFooOutput() FooOutput {
@@ -120,12 +102,21 @@ func (o FooOutput) ToFooOutputWithContext(ctx context.Context) FooOutput {
 	return o
 }
 
+func (o FooOutput) ToOutput(ctx context.Context) pulumix.Output[Foo] {
+	return pulumix.Output[Foo]{
+		OutputState: o.OutputState,
+	}
+}
+
 // A test for plain types
-func (o FooOutput) DefaultKubeClientSettings() KubeClientSettingsPtrOutput {
-	return o.ApplyT(func(v *Foo) KubeClientSettingsPtrOutput { return v.DefaultKubeClientSettings }).(KubeClientSettingsPtrOutput)
+func (o FooOutput) DefaultKubeClientSettings() pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput] {
+	value := pulumix.Apply[Foo](o, func(v Foo) pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput] {
+		return v.DefaultKubeClientSettings
+	})
+	unwrapped := pulumix.Flatten[*KubeClientSettings, pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput]](value)
+	return pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FooInput)(nil)).Elem(), &Foo{})
 	pulumi.RegisterOutputType(FooOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"plain-object-disable-defaults/example/internal"
 )
 
@@ -18,20 +19,9 @@ type Typ struct {
 	Val *string `pulumi:"val"`
 }
 
-// TypInput is an input type that accepts TypArgs and TypOutput values.
-// You can construct a concrete instance of `TypInput` via:
-//
-//	TypArgs{...}
-type TypInput interface {
-	pulumi.Input
-
-	ToTypOutput() TypOutput
-	ToTypOutputWithContext(context.Context) TypOutput
-}
-
 // A test for namespaces (mod 1)
 type TypArgs struct {
-	Val pulumi.StringPtrInput `pulumi:"val"`
+	Val pulumix.Input[*string] `pulumi:"val"`
 }
 
 func (TypArgs) ElementType() reflect.Type {
@@ -46,45 +36,8 @@ func (i TypArgs) ToTypOutputWithContext(ctx context.Context) TypOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(TypOutput)
 }
 
-func (i TypArgs) ToTypPtrOutput() TypPtrOutput {
-	return i.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (i TypArgs) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypOutput).ToTypPtrOutputWithContext(ctx)
-}
-
-// TypPtrInput is an input type that accepts TypArgs, TypPtr and TypPtrOutput values.
-// You can construct a concrete instance of `TypPtrInput` via:
-//
-//	        TypArgs{...}
-//
-//	or:
-//
-//	        nil
-type TypPtrInput interface {
-	pulumi.Input
-
-	ToTypPtrOutput() TypPtrOutput
-	ToTypPtrOutputWithContext(context.Context) TypPtrOutput
-}
-
-type typPtrType TypArgs
-
-func TypPtr(v *TypArgs) TypPtrInput {
-	return (*typPtrType)(v)
-}
-
-func (*typPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Typ)(nil)).Elem()
-}
-
-func (i *typPtrType) ToTypPtrOutput() TypPtrOutput {
-	return i.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (i *typPtrType) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypPtrOutput)
+func (i *TypArgs) ToOutput(ctx context.Context) pulumix.Output[*TypArgs] {
+	return pulumix.Val(i)
 }
 
 // A test for namespaces (mod 1)
@@ -102,56 +55,16 @@ func (o TypOutput) ToTypOutputWithContext(ctx context.Context) TypOutput {
 	return o
 }
 
-func (o TypOutput) ToTypPtrOutput() TypPtrOutput {
-	return o.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (o TypOutput) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Typ) *Typ {
-		return &v
-	}).(TypPtrOutput)
-}
-
-func (o TypOutput) Val() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Typ) *string { return v.Val }).(pulumi.StringPtrOutput)
-}
-
-type TypPtrOutput struct{ *pulumi.OutputState }
-
-func (TypPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Typ)(nil)).Elem()
-}
-
-func (o TypPtrOutput) ToTypPtrOutput() TypPtrOutput {
-	return o
-}
-
-func (o TypPtrOutput) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return o
-}
-
-func (o TypPtrOutput) Elem() TypOutput {
-	return o.ApplyT(func(v *Typ) Typ {
-		if v != nil {
-			return *v
-		}
-		var ret Typ
-		return ret
-	}).(TypOutput)
+func (o TypOutput) ToOutput(ctx context.Context) pulumix.Output[Typ] {
+	return pulumix.Output[Typ]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o TypPtrOutput) Val() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Typ) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Val
-	}).(pulumi.StringPtrOutput)
+func (o TypOutput) Val() pulumix.Output[*string] {
+	return pulumix.Apply[Typ](o, func(v Typ) *string { return v.Val })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*TypInput)(nil)).Elem(), TypArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*TypPtrInput)(nil)).Elem(), TypArgs{})
 	pulumi.RegisterOutputType(TypOutput{})
-	pulumi.RegisterOutputType(TypPtrOutput{})
 }
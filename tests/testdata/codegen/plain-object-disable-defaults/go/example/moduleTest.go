@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"plain-object-disable-defaults/example/internal"
 	"plain-object-disable-defaults/example/mod1"
 )
@@ -62,37 +63,18 @@ type moduleTestArgs struct {
 
 // The set of arguments for constructing a ModuleTest resource.
 type ModuleTestArgs struct {
-	Mod1 mod1.TypPtrInput
-	Val  TypPtrInput
+	Mod1 pulumix.Input[*mod1.TypArgs]
+	Val  pulumix.Input[*TypArgs]
 }
 
 func (ModuleTestArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*moduleTestArgs)(nil)).Elem()
 }
 
-type ModuleTestInput interface {
-	pulumi.Input
-
-	ToModuleTestOutput() ModuleTestOutput
-	ToModuleTestOutputWithContext(ctx context.Context) ModuleTestOutput
-}
-
-func (*ModuleTest) ElementType() reflect.Type {
-	return reflect.TypeOf((**ModuleTest)(nil)).Elem()
-}
-
-func (i *ModuleTest) ToModuleTestOutput() ModuleTestOutput {
-	return i.ToModuleTestOutputWithContext(context.Background())
-}
-
-func (i *ModuleTest) ToModuleTestOutputWithContext(ctx context.Context) ModuleTestOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ModuleTestOutput)
-}
-
 type ModuleTestOutput struct{ *pulumi.OutputState }
 
 func (ModuleTestOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ModuleTest)(nil)).Elem()
+	return reflect.TypeOf((*ModuleTest)(nil)).Elem()
 }
 
 func (o ModuleTestOutput) ToModuleTestOutput() ModuleTestOutput {
@@ -103,7 +85,12 @@ func (o ModuleTestOutput) ToModuleTestOutputWithContext(ctx context.Context) Mod
 	return o
 }
 
+func (o ModuleTestOutput) ToOutput(ctx context.Context) pulumix.Output[ModuleTest] {
+	return pulumix.Output[ModuleTest]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ModuleTestInput)(nil)).Elem(), &ModuleTest{})
 	pulumi.RegisterOutputType(ModuleTestOutput{})
 }
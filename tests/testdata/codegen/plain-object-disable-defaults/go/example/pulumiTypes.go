@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"plain-object-disable-defaults/example/internal"
 	"plain-object-disable-defaults/example/mod1"
 	"plain-object-disable-defaults/example/mod2"
@@ -25,25 +26,14 @@ type HelmReleaseSettings struct {
 	RequiredArg string `pulumi:"requiredArg"`
 }
 
-// HelmReleaseSettingsInput is an input type that accepts HelmReleaseSettingsArgs and HelmReleaseSettingsOutput values.
-// You can construct a concrete instance of `HelmReleaseSettingsInput` via:
-//
-//	HelmReleaseSettingsArgs{...}
-type HelmReleaseSettingsInput interface {
-	pulumi.Input
-
-	ToHelmReleaseSettingsOutput() HelmReleaseSettingsOutput
-	ToHelmReleaseSettingsOutputWithContext(context.Context) HelmReleaseSettingsOutput
-}
-
 // BETA FEATURE - Options to configure the Helm Release resource.
 type HelmReleaseSettingsArgs struct {
 	// The backend storage driver for Helm. Values are: configmap, secret, memory, sql.
-	Driver pulumi.StringPtrInput `pulumi:"driver"`
+	Driver pulumix.Input[*string] `pulumi:"driver"`
 	// The path to the helm plugins directory.
-	PluginsPath pulumi.StringPtrInput `pulumi:"pluginsPath"`
+	PluginsPath pulumix.Input[*string] `pulumi:"pluginsPath"`
 	// to test required args
-	RequiredArg pulumi.StringInput `pulumi:"requiredArg"`
+	RequiredArg pulumix.Input[string] `pulumi:"requiredArg"`
 }
 
 func (HelmReleaseSettingsArgs) ElementType() reflect.Type {
@@ -58,45 +48,8 @@ func (i HelmReleaseSettingsArgs) ToHelmReleaseSettingsOutputWithContext(ctx cont
 	return pulumi.ToOutputWithContext(ctx, i).(HelmReleaseSettingsOutput)
 }
 
-func (i HelmReleaseSettingsArgs) ToHelmReleaseSettingsPtrOutput() HelmReleaseSettingsPtrOutput {
-	return i.ToHelmReleaseSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i HelmReleaseSettingsArgs) ToHelmReleaseSettingsPtrOutputWithContext(ctx context.Context) HelmReleaseSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(HelmReleaseSettingsOutput).ToHelmReleaseSettingsPtrOutputWithContext(ctx)
-}
-
-// HelmReleaseSettingsPtrInput is an input type that accepts HelmReleaseSettingsArgs, HelmReleaseSettingsPtr and HelmReleaseSettingsPtrOutput values.
-// You can construct a concrete instance of `HelmReleaseSettingsPtrInput` via:
-//
-//	        HelmReleaseSettingsArgs{...}
-//
-//	or:
-//
-//	        nil
-type HelmReleaseSettingsPtrInput interface {
-	pulumi.Input
-
-	ToHelmReleaseSettingsPtrOutput() HelmReleaseSettingsPtrOutput
-	ToHelmReleaseSettingsPtrOutputWithContext(context.Context) HelmReleaseSettingsPtrOutput
-}
-
-type helmReleaseSettingsPtrType HelmReleaseSettingsArgs
-
-func HelmReleaseSettingsPtr(v *HelmReleaseSettingsArgs) HelmReleaseSettingsPtrInput {
-	return (*helmReleaseSettingsPtrType)(v)
-}
-
-func (*helmReleaseSettingsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**HelmReleaseSettings)(nil)).Elem()
-}
-
-func (i *helmReleaseSettingsPtrType) ToHelmReleaseSettingsPtrOutput() HelmReleaseSettingsPtrOutput {
-	return i.ToHelmReleaseSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i *helmReleaseSettingsPtrType) ToHelmReleaseSettingsPtrOutputWithContext(ctx context.Context) HelmReleaseSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(HelmReleaseSettingsPtrOutput)
+func (i *HelmReleaseSettingsArgs) ToOutput(ctx context.Context) pulumix.Output[*HelmReleaseSettingsArgs] {
+	return pulumix.Val(i)
 }
 
 // BETA FEATURE - Options to configure the Helm Release resource.
@@ -114,83 +67,25 @@ func (o HelmReleaseSettingsOutput) ToHelmReleaseSettingsOutputWithContext(ctx co
 	return o
 }
 
-func (o HelmReleaseSettingsOutput) ToHelmReleaseSettingsPtrOutput() HelmReleaseSettingsPtrOutput {
-	return o.ToHelmReleaseSettingsPtrOutputWithContext(context.Background())
-}
-
-func (o HelmReleaseSettingsOutput) ToHelmReleaseSettingsPtrOutputWithContext(ctx context.Context) HelmReleaseSettingsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v HelmReleaseSettings) *HelmReleaseSettings {
-		return &v
-	}).(HelmReleaseSettingsPtrOutput)
-}
-
-// The backend storage driver for Helm. Values are: configmap, secret, memory, sql.
-func (o HelmReleaseSettingsOutput) Driver() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HelmReleaseSettings) *string { return v.Driver }).(pulumi.StringPtrOutput)
-}
-
-// The path to the helm plugins directory.
-func (o HelmReleaseSettingsOutput) PluginsPath() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v HelmReleaseSettings) *string { return v.PluginsPath }).(pulumi.StringPtrOutput)
-}
-
-// to test required args
-func (o HelmReleaseSettingsOutput) RequiredArg() pulumi.StringOutput {
-	return o.ApplyT(func(v HelmReleaseSettings) string { return v.RequiredArg }).(pulumi.StringOutput)
-}
-
-type HelmReleaseSettingsPtrOutput struct{ *pulumi.OutputState }
-
-func (HelmReleaseSettingsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**HelmReleaseSettings)(nil)).Elem()
-}
-
-func (o HelmReleaseSettingsPtrOutput) ToHelmReleaseSettingsPtrOutput() HelmReleaseSettingsPtrOutput {
-	return o
-}
-
-func (o HelmReleaseSettingsPtrOutput) ToHelmReleaseSettingsPtrOutputWithContext(ctx context.Context) HelmReleaseSettingsPtrOutput {
-	return o
-}
-
-func (o HelmReleaseSettingsPtrOutput) Elem() HelmReleaseSettingsOutput {
-	return o.ApplyT(func(v *HelmReleaseSettings) HelmReleaseSettings {
-		if v != nil {
-			return *v
-		}
-		var ret HelmReleaseSettings
-		return ret
-	}).(HelmReleaseSettingsOutput)
+func (o HelmReleaseSettingsOutput) ToOutput(ctx context.Context) pulumix.Output[HelmReleaseSettings] {
+	return pulumix.Output[HelmReleaseSettings]{
+		OutputState: o.OutputState,
+	}
 }
 
 // The backend storage driver for Helm. Values are: configmap, secret, memory, sql.
-func (o HelmReleaseSettingsPtrOutput) Driver() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *HelmReleaseSettings) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Driver
-	}).(pulumi.StringPtrOutput)
+func (o HelmReleaseSettingsOutput) Driver() pulumix.Output[*string] {
+	return pulumix.Apply[HelmReleaseSettings](o, func(v HelmReleaseSettings) *string { return v.Driver })
 }
 
 // The path to the helm plugins directory.
-func (o HelmReleaseSettingsPtrOutput) PluginsPath() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *HelmReleaseSettings) *string {
-		if v == nil {
-			return nil
-		}
-		return v.PluginsPath
-	}).(pulumi.StringPtrOutput)
+func (o HelmReleaseSettingsOutput) PluginsPath() pulumix.Output[*string] {
+	return pulumix.Apply[HelmReleaseSettings](o, func(v HelmReleaseSettings) *string { return v.PluginsPath })
 }
 
 // to test required args
-func (o HelmReleaseSettingsPtrOutput) RequiredArg() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *HelmReleaseSettings) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.RequiredArg
-	}).(pulumi.StringPtrOutput)
+func (o HelmReleaseSettingsOutput) RequiredArg() pulumix.Output[string] {
+	return pulumix.Apply[HelmReleaseSettings](o, func(v HelmReleaseSettings) string { return v.RequiredArg })
 }
 
 // Options for tuning the Kubernetes client used by a Provider.
@@ -202,24 +97,13 @@ type KubeClientSettings struct {
 	RecTest *KubeClientSettings `pulumi:"recTest"`
 }
 
-// KubeClientSettingsInput is an input type that accepts KubeClientSettingsArgs and KubeClientSettingsOutput values.
-// You can construct a concrete instance of `KubeClientSettingsInput` via:
-//
-//	KubeClientSettingsArgs{...}
-type KubeClientSettingsInput interface {
-	pulumi.Input
-
-	ToKubeClientSettingsOutput() KubeClientSettingsOutput
-	ToKubeClientSettingsOutputWithContext(context.Context) KubeClientSettingsOutput
-}
-
 // Options for tuning the Kubernetes client used by a Provider.
 type KubeClientSettingsArgs struct {
 	// Maximum burst for throttle. Default value is 10.
-	Burst pulumi.IntPtrInput `pulumi:"burst"`
+	Burst pulumix.Input[*int] `pulumi:"burst"`
 	// Maximum queries per second (QPS) to the API server from this client. Default value is 5.
-	Qps     pulumi.Float64PtrInput     `pulumi:"qps"`
-	RecTest KubeClientSettingsPtrInput `pulumi:"recTest"`
+	Qps     pulumix.Input[*float64]                `pulumi:"qps"`
+	RecTest pulumix.Input[*KubeClientSettingsArgs] `pulumi:"recTest"`
 }
 
 func (KubeClientSettingsArgs) ElementType() reflect.Type {
@@ -234,45 +118,8 @@ func (i KubeClientSettingsArgs) ToKubeClientSettingsOutputWithContext(ctx contex
 	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsOutput)
 }
 
-func (i KubeClientSettingsArgs) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return i.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i KubeClientSettingsArgs) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsOutput).ToKubeClientSettingsPtrOutputWithContext(ctx)
-}
-
-// KubeClientSettingsPtrInput is an input type that accepts KubeClientSettingsArgs, KubeClientSettingsPtr and KubeClientSettingsPtrOutput values.
-// You can construct a concrete instance of `KubeClientSettingsPtrInput` via:
-//
-//	        KubeClientSettingsArgs{...}
-//
-//	or:
-//
-//	        nil
-type KubeClientSettingsPtrInput interface {
-	pulumi.Input
-
-	ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput
-	ToKubeClientSettingsPtrOutputWithContext(context.Context) KubeClientSettingsPtrOutput
-}
-
-type kubeClientSettingsPtrType KubeClientSettingsArgs
-
-func KubeClientSettingsPtr(v *KubeClientSettingsArgs) KubeClientSettingsPtrInput {
-	return (*kubeClientSettingsPtrType)(v)
-}
-
-func (*kubeClientSettingsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeClientSettings)(nil)).Elem()
-}
-
-func (i *kubeClientSettingsPtrType) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return i.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (i *kubeClientSettingsPtrType) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(KubeClientSettingsPtrOutput)
+func (i *KubeClientSettingsArgs) ToOutput(ctx context.Context) pulumix.Output[*KubeClientSettingsArgs] {
+	return pulumix.Val(i)
 }
 
 // Options for tuning the Kubernetes client used by a Provider.
@@ -290,88 +137,32 @@ func (o KubeClientSettingsOutput) ToKubeClientSettingsOutputWithContext(ctx cont
 	return o
 }
 
-func (o KubeClientSettingsOutput) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return o.ToKubeClientSettingsPtrOutputWithContext(context.Background())
-}
-
-func (o KubeClientSettingsOutput) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubeClientSettings) *KubeClientSettings {
-		return &v
-	}).(KubeClientSettingsPtrOutput)
-}
-
-// Maximum burst for throttle. Default value is 10.
-func (o KubeClientSettingsOutput) Burst() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v KubeClientSettings) *int { return v.Burst }).(pulumi.IntPtrOutput)
-}
-
-// Maximum queries per second (QPS) to the API server from this client. Default value is 5.
-func (o KubeClientSettingsOutput) Qps() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v KubeClientSettings) *float64 { return v.Qps }).(pulumi.Float64PtrOutput)
-}
-
-func (o KubeClientSettingsOutput) RecTest() KubeClientSettingsPtrOutput {
-	return o.ApplyT(func(v KubeClientSettings) *KubeClientSettings { return v.RecTest }).(KubeClientSettingsPtrOutput)
-}
-
-type KubeClientSettingsPtrOutput struct{ *pulumi.OutputState }
-
-func (KubeClientSettingsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**KubeClientSettings)(nil)).Elem()
-}
-
-func (o KubeClientSettingsPtrOutput) ToKubeClientSettingsPtrOutput() KubeClientSettingsPtrOutput {
-	return o
-}
-
-func (o KubeClientSettingsPtrOutput) ToKubeClientSettingsPtrOutputWithContext(ctx context.Context) KubeClientSettingsPtrOutput {
-	return o
-}
-
-func (o KubeClientSettingsPtrOutput) Elem() KubeClientSettingsOutput {
-	return o.ApplyT(func(v *KubeClientSettings) KubeClientSettings {
-		if v != nil {
-			return *v
-		}
-		var ret KubeClientSettings
-		return ret
-	}).(KubeClientSettingsOutput)
+func (o KubeClientSettingsOutput) ToOutput(ctx context.Context) pulumix.Output[KubeClientSettings] {
+	return pulumix.Output[KubeClientSettings]{
+		OutputState: o.OutputState,
+	}
 }
 
 // Maximum burst for throttle. Default value is 10.
-func (o KubeClientSettingsPtrOutput) Burst() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *KubeClientSettings) *int {
-		if v == nil {
-			return nil
-		}
-		return v.Burst
-	}).(pulumi.IntPtrOutput)
+func (o KubeClientSettingsOutput) Burst() pulumix.Output[*int] {
+	return pulumix.Apply[KubeClientSettings](o, func(v KubeClientSettings) *int { return v.Burst })
 }
 
 // Maximum queries per second (QPS) to the API server from this client. Default value is 5.
-func (o KubeClientSettingsPtrOutput) Qps() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v *KubeClientSettings) *float64 {
-		if v == nil {
-			return nil
-		}
-		return v.Qps
-	}).(pulumi.Float64PtrOutput)
+func (o KubeClientSettingsOutput) Qps() pulumix.Output[*float64] {
+	return pulumix.Apply[KubeClientSettings](o, func(v KubeClientSettings) *float64 { return v.Qps })
 }
 
-func (o KubeClientSettingsPtrOutput) RecTest() KubeClientSettingsPtrOutput {
-	return o.ApplyT(func(v *KubeClientSettings) *KubeClientSettings {
-		if v == nil {
-			return nil
-		}
-		return v.RecTest
-	}).(KubeClientSettingsPtrOutput)
+func (o KubeClientSettingsOutput) RecTest() pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput] {
+	value := pulumix.Apply[KubeClientSettings](o, func(v KubeClientSettings) *KubeClientSettings { return v.RecTest })
+	return pulumix.GPtrOutput[KubeClientSettings, KubeClientSettingsOutput]{OutputState: value.OutputState}
 }
 
 // Make sure that defaults propagate through types
 type LayeredType struct {
 	// The answer to the question
-	Answer *float64            `pulumi:"answer"`
-	Other  HelmReleaseSettings `pulumi:"other"`
+	Answer *float64             `pulumi:"answer"`
+	Other  *HelmReleaseSettings `pulumi:"other"`
 	// Test how plain types interact
 	PlainOther *HelmReleaseSettings `pulumi:"plainOther"`
 	// The question already answered
@@ -381,29 +172,18 @@ type LayeredType struct {
 	Thinker string `pulumi:"thinker"`
 }
 
-// LayeredTypeInput is an input type that accepts LayeredTypeArgs and LayeredTypeOutput values.
-// You can construct a concrete instance of `LayeredTypeInput` via:
-//
-//	LayeredTypeArgs{...}
-type LayeredTypeInput interface {
-	pulumi.Input
-
-	ToLayeredTypeOutput() LayeredTypeOutput
-	ToLayeredTypeOutputWithContext(context.Context) LayeredTypeOutput
-}
-
 // Make sure that defaults propagate through types
 type LayeredTypeArgs struct {
 	// The answer to the question
-	Answer pulumi.Float64PtrInput   `pulumi:"answer"`
-	Other  HelmReleaseSettingsInput `pulumi:"other"`
+	Answer pulumix.Input[*float64]                 `pulumi:"answer"`
+	Other  pulumix.Input[*HelmReleaseSettingsArgs] `pulumi:"other"`
 	// Test how plain types interact
 	PlainOther *HelmReleaseSettingsArgs `pulumi:"plainOther"`
 	// The question already answered
-	Question  pulumi.StringPtrInput `pulumi:"question"`
-	Recursive LayeredTypePtrInput   `pulumi:"recursive"`
+	Question  pulumix.Input[*string]          `pulumi:"question"`
+	Recursive pulumix.Input[*LayeredTypeArgs] `pulumi:"recursive"`
 	// To ask and answer
-	Thinker pulumi.StringInput `pulumi:"thinker"`
+	Thinker pulumix.Input[string] `pulumi:"thinker"`
 }
 
 func (LayeredTypeArgs) ElementType() reflect.Type {
@@ -418,45 +198,8 @@ func (i LayeredTypeArgs) ToLayeredTypeOutputWithContext(ctx context.Context) Lay
 	return pulumi.ToOutputWithContext(ctx, i).(LayeredTypeOutput)
 }
 
-func (i LayeredTypeArgs) ToLayeredTypePtrOutput() LayeredTypePtrOutput {
-	return i.ToLayeredTypePtrOutputWithContext(context.Background())
-}
-
-func (i LayeredTypeArgs) ToLayeredTypePtrOutputWithContext(ctx context.Context) LayeredTypePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(LayeredTypeOutput).ToLayeredTypePtrOutputWithContext(ctx)
-}
-
-// LayeredTypePtrInput is an input type that accepts LayeredTypeArgs, LayeredTypePtr and LayeredTypePtrOutput values.
-// You can construct a concrete instance of `LayeredTypePtrInput` via:
-//
-//	        LayeredTypeArgs{...}
-//
-//	or:
-//
-//	        nil
-type LayeredTypePtrInput interface {
-	pulumi.Input
-
-	ToLayeredTypePtrOutput() LayeredTypePtrOutput
-	ToLayeredTypePtrOutputWithContext(context.Context) LayeredTypePtrOutput
-}
-
-type layeredTypePtrType LayeredTypeArgs
-
-func LayeredTypePtr(v *LayeredTypeArgs) LayeredTypePtrInput {
-	return (*layeredTypePtrType)(v)
-}
-
-func (*layeredTypePtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**LayeredType)(nil)).Elem()
-}
-
-func (i *layeredTypePtrType) ToLayeredTypePtrOutput() LayeredTypePtrOutput {
-	return i.ToLayeredTypePtrOutputWithContext(context.Background())
-}
-
-func (i *layeredTypePtrType) ToLayeredTypePtrOutputWithContext(ctx context.Context) LayeredTypePtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(LayeredTypePtrOutput)
+func (i *LayeredTypeArgs) ToOutput(ctx context.Context) pulumix.Output[*LayeredTypeArgs] {
+	return pulumix.Val(i)
 }
 
 // Make sure that defaults propagate through types
@@ -474,124 +217,41 @@ func (o LayeredTypeOutput) ToLayeredTypeOutputWithContext(ctx context.Context) L
 	return o
 }
 
-func (o LayeredTypeOutput) ToLayeredTypePtrOutput() LayeredTypePtrOutput {
-	return o.ToLayeredTypePtrOutputWithContext(context.Background())
-}
-
-func (o LayeredTypeOutput) ToLayeredTypePtrOutputWithContext(ctx context.Context) LayeredTypePtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v LayeredType) *LayeredType {
-		return &v
-	}).(LayeredTypePtrOutput)
+func (o LayeredTypeOutput) ToOutput(ctx context.Context) pulumix.Output[LayeredType] {
+	return pulumix.Output[LayeredType]{
+		OutputState: o.OutputState,
+	}
 }
 
 // The answer to the question
-func (o LayeredTypeOutput) Answer() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v LayeredType) *float64 { return v.Answer }).(pulumi.Float64PtrOutput)
+func (o LayeredTypeOutput) Answer() pulumix.Output[*float64] {
+	return pulumix.Apply[LayeredType](o, func(v LayeredType) *float64 { return v.Answer })
 }
 
-func (o LayeredTypeOutput) Other() HelmReleaseSettingsOutput {
-	return o.ApplyT(func(v LayeredType) HelmReleaseSettings { return v.Other }).(HelmReleaseSettingsOutput)
+func (o LayeredTypeOutput) Other() pulumix.GPtrOutput[HelmReleaseSettings, HelmReleaseSettingsOutput] {
+	value := pulumix.Apply[LayeredType](o, func(v LayeredType) *HelmReleaseSettings { return v.Other })
+	return pulumix.GPtrOutput[HelmReleaseSettings, HelmReleaseSettingsOutput]{OutputState: value.OutputState}
 }
 
 // Test how plain types interact
-func (o LayeredTypeOutput) PlainOther() HelmReleaseSettingsPtrOutput {
-	return o.ApplyT(func(v LayeredType) *HelmReleaseSettings { return v.PlainOther }).(HelmReleaseSettingsPtrOutput)
+func (o LayeredTypeOutput) PlainOther() pulumix.GPtrOutput[HelmReleaseSettings, HelmReleaseSettingsOutput] {
+	value := pulumix.Apply[LayeredType](o, func(v LayeredType) *HelmReleaseSettings { return v.PlainOther })
+	return pulumix.GPtrOutput[HelmReleaseSettings, HelmReleaseSettingsOutput]{OutputState: value.OutputState}
 }
 
 // The question already answered
-func (o LayeredTypeOutput) Question() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v LayeredType) *string { return v.Question }).(pulumi.StringPtrOutput)
+func (o LayeredTypeOutput) Question() pulumix.Output[*string] {
+	return pulumix.Apply[LayeredType](o, func(v LayeredType) *string { return v.Question })
 }
 
-func (o LayeredTypeOutput) Recursive() LayeredTypePtrOutput {
-	return o.ApplyT(func(v LayeredType) *LayeredType { return v.Recursive }).(LayeredTypePtrOutput)
+func (o LayeredTypeOutput) Recursive() pulumix.GPtrOutput[LayeredType, LayeredTypeOutput] {
+	value := pulumix.Apply[LayeredType](o, func(v LayeredType) *LayeredType { return v.Recursive })
+	return pulumix.GPtrOutput[LayeredType, LayeredTypeOutput]{OutputState: value.OutputState}
 }
 
 // To ask and answer
-func (o LayeredTypeOutput) Thinker() pulumi.StringOutput {
-	return o.ApplyT(func(v LayeredType) string { return v.Thinker }).(pulumi.StringOutput)
-}
-
-type LayeredTypePtrOutput struct{ *pulumi.OutputState }
-
-func (LayeredTypePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**LayeredType)(nil)).Elem()
-}
-
-func (o LayeredTypePtrOutput) ToLayeredTypePtrOutput() LayeredTypePtrOutput {
-	return o
-}
-
-func (o LayeredTypePtrOutput) ToLayeredTypePtrOutputWithContext(ctx context.Context) LayeredTypePtrOutput {
-	return o
-}
-
-func (o LayeredTypePtrOutput) Elem() LayeredTypeOutput {
-	return o.ApplyT(func(v *LayeredType) LayeredType {
-		if v != nil {
-			return *v
-		}
-		var ret LayeredType
-		return ret
-	}).(LayeredTypeOutput)
-}
-
-// The answer to the question
-func (o LayeredTypePtrOutput) Answer() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v *LayeredType) *float64 {
-		if v == nil {
-			return nil
-		}
-		return v.Answer
-	}).(pulumi.Float64PtrOutput)
-}
-
-func (o LayeredTypePtrOutput) Other() HelmReleaseSettingsPtrOutput {
-	return o.ApplyT(func(v *LayeredType) *HelmReleaseSettings {
-		if v == nil {
-			return nil
-		}
-		return &v.Other
-	}).(HelmReleaseSettingsPtrOutput)
-}
-
-// Test how plain types interact
-func (o LayeredTypePtrOutput) PlainOther() HelmReleaseSettingsPtrOutput {
-	return o.ApplyT(func(v *LayeredType) *HelmReleaseSettings {
-		if v == nil {
-			return nil
-		}
-		return v.PlainOther
-	}).(HelmReleaseSettingsPtrOutput)
-}
-
-// The question already answered
-func (o LayeredTypePtrOutput) Question() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *LayeredType) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Question
-	}).(pulumi.StringPtrOutput)
-}
-
-func (o LayeredTypePtrOutput) Recursive() LayeredTypePtrOutput {
-	return o.ApplyT(func(v *LayeredType) *LayeredType {
-		if v == nil {
-			return nil
-		}
-		return v.Recursive
-	}).(LayeredTypePtrOutput)
-}
-
-// To ask and answer
-func (o LayeredTypePtrOutput) Thinker() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *LayeredType) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.Thinker
-	}).(pulumi.StringPtrOutput)
+func (o LayeredTypeOutput) Thinker() pulumix.Output[string] {
+	return pulumix.Apply[LayeredType](o, func(v LayeredType) string { return v.Thinker })
 }
 
 // A test for namespaces (mod main)
@@ -601,22 +261,11 @@ type Typ struct {
 	Val  *string   `pulumi:"val"`
 }
 
-// TypInput is an input type that accepts TypArgs and TypOutput values.
-// You can construct a concrete instance of `TypInput` via:
-//
-//	TypArgs{...}
-type TypInput interface {
-	pulumi.Input
-
-	ToTypOutput() TypOutput
-	ToTypOutputWithContext(context.Context) TypOutput
-}
-
 // A test for namespaces (mod main)
 type TypArgs struct {
-	Mod1 mod1.TypPtrInput      `pulumi:"mod1"`
-	Mod2 mod2.TypPtrInput      `pulumi:"mod2"`
-	Val  pulumi.StringPtrInput `pulumi:"val"`
+	Mod1 pulumix.Input[*mod1.TypArgs] `pulumi:"mod1"`
+	Mod2 pulumix.Input[*mod2.TypArgs] `pulumi:"mod2"`
+	Val  pulumix.Input[*string]       `pulumi:"val"`
 }
 
 func (TypArgs) ElementType() reflect.Type {
@@ -631,45 +280,8 @@ func (i TypArgs) ToTypOutputWithContext(ctx context.Context) TypOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(TypOutput)
 }
 
-func (i TypArgs) ToTypPtrOutput() TypPtrOutput {
-	return i.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (i TypArgs) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypOutput).ToTypPtrOutputWithContext(ctx)
-}
-
-// TypPtrInput is an input type that accepts TypArgs, TypPtr and TypPtrOutput values.
-// You can construct a concrete instance of `TypPtrInput` via:
-//
-//	        TypArgs{...}
-//
-//	or:
-//
-//	        nil
-type TypPtrInput interface {
-	pulumi.Input
-
-	ToTypPtrOutput() TypPtrOutput
-	ToTypPtrOutputWithContext(context.Context) TypPtrOutput
-}
-
-type typPtrType TypArgs
-
-func TypPtr(v *TypArgs) TypPtrInput {
-	return (*typPtrType)(v)
-}
-
-func (*typPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Typ)(nil)).Elem()
-}
-
-func (i *typPtrType) ToTypPtrOutput() TypPtrOutput {
-	return i.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (i *typPtrType) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypPtrOutput)
+func (i *TypArgs) ToOutput(ctx context.Context) pulumix.Output[*TypArgs] {
+	return pulumix.Val(i)
 }
 
 // A test for namespaces (mod main)
@@ -687,94 +299,29 @@ func (o TypOutput) ToTypOutputWithContext(ctx context.Context) TypOutput {
 	return o
 }
 
-func (o TypOutput) ToTypPtrOutput() TypPtrOutput {
-	return o.ToTypPtrOutputWithContext(context.Background())
-}
-
-func (o TypOutput) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Typ) *Typ {
-		return &v
-	}).(TypPtrOutput)
-}
-
-func (o TypOutput) Mod1() mod1.TypPtrOutput {
-	return o.ApplyT(func(v Typ) *mod1.Typ { return v.Mod1 }).(mod1.TypPtrOutput)
-}
-
-func (o TypOutput) Mod2() mod2.TypPtrOutput {
-	return o.ApplyT(func(v Typ) *mod2.Typ { return v.Mod2 }).(mod2.TypPtrOutput)
-}
-
-func (o TypOutput) Val() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Typ) *string { return v.Val }).(pulumi.StringPtrOutput)
-}
-
-type TypPtrOutput struct{ *pulumi.OutputState }
-
-func (TypPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Typ)(nil)).Elem()
-}
-
-func (o TypPtrOutput) ToTypPtrOutput() TypPtrOutput {
-	return o
-}
-
-func (o TypPtrOutput) ToTypPtrOutputWithContext(ctx context.Context) TypPtrOutput {
-	return o
-}
-
-func (o TypPtrOutput) Elem() TypOutput {
-	return o.ApplyT(func(v *Typ) Typ {
-		if v != nil {
-			return *v
-		}
-		var ret Typ
-		return ret
-	}).(TypOutput)
+func (o TypOutput) ToOutput(ctx context.Context) pulumix.Output[Typ] {
+	return pulumix.Output[Typ]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o TypPtrOutput) Mod1() mod1.TypPtrOutput {
-	return o.ApplyT(func(v *Typ) *mod1.Typ {
-		if v == nil {
-			return nil
-		}
-		return v.Mod1
-	}).(mod1.TypPtrOutput)
+func (o TypOutput) Mod1() pulumix.GPtrOutput[mod1.Typ, mod1.TypOutput] {
+	value := pulumix.Apply[Typ](o, func(v Typ) *mod1.Typ { return v.Mod1 })
+	return pulumix.GPtrOutput[mod1.Typ, mod1.TypOutput]{OutputState: value.OutputState}
 }
 
-func (o TypPtrOutput) Mod2() mod2.TypPtrOutput {
-	return o.ApplyT(func(v *Typ) *mod2.Typ {
-		if v == nil {
-			return nil
-		}
-		return v.Mod2
-	}).(mod2.TypPtrOutput)
+func (o TypOutput) Mod2() pulumix.GPtrOutput[mod2.Typ, mod2.TypOutput] {
+	value := pulumix.Apply[Typ](o, func(v Typ) *mod2.Typ { return v.Mod2 })
+	return pulumix.GPtrOutput[mod2.Typ, mod2.TypOutput]{OutputState: value.OutputState}
 }
 
-func (o TypPtrOutput) Val() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Typ) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Val
-	}).(pulumi.StringPtrOutput)
+func (o TypOutput) Val() pulumix.Output[*string] {
+	return pulumix.Apply[Typ](o, func(v Typ) *string { return v.Val })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*HelmReleaseSettingsInput)(nil)).Elem(), HelmReleaseSettingsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*HelmReleaseSettingsPtrInput)(nil)).Elem(), HelmReleaseSettingsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*KubeClientSettingsInput)(nil)).Elem(), KubeClientSettingsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*KubeClientSettingsPtrInput)(nil)).Elem(), KubeClientSettingsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*LayeredTypeInput)(nil)).Elem(), LayeredTypeArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*LayeredTypePtrInput)(nil)).Elem(), LayeredTypeArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*TypInput)(nil)).Elem(), TypArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*TypPtrInput)(nil)).Elem(), TypArgs{})
 	pulumi.RegisterOutputType(HelmReleaseSettingsOutput{})
-	pulumi.RegisterOutputType(HelmReleaseSettingsPtrOutput{})
 	pulumi.RegisterOutputType(KubeClientSettingsOutput{})
-	pulumi.RegisterOutputType(KubeClientSettingsPtrOutput{})
 	pulumi.RegisterOutputType(LayeredTypeOutput{})
-	pulumi.RegisterOutputType(LayeredTypePtrOutput{})
 	pulumi.RegisterOutputType(TypOutput{})
-	pulumi.RegisterOutputType(TypPtrOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -18,20 +19,9 @@ type Sandwich struct {
 	Veggies []string `pulumi:"veggies"`
 }
 
-// SandwichInput is an input type that accepts SandwichArgs and SandwichOutput values.
-// You can construct a concrete instance of `SandwichInput` via:
-//
-//	SandwichArgs{...}
-type SandwichInput interface {
-	pulumi.Input
-
-	ToSandwichOutput() SandwichOutput
-	ToSandwichOutputWithContext(context.Context) SandwichOutput
-}
-
 type SandwichArgs struct {
-	Bread   pulumi.StringPtrInput   `pulumi:"bread"`
-	Veggies pulumi.StringArrayInput `pulumi:"veggies"`
+	Bread   pulumix.Input[*string]  `pulumi:"bread"`
+	Veggies pulumix.Input[[]string] `pulumi:"veggies"`
 }
 
 func (SandwichArgs) ElementType() reflect.Type {
@@ -46,29 +36,8 @@ func (i SandwichArgs) ToSandwichOutputWithContext(ctx context.Context) SandwichO
 	return pulumi.ToOutputWithContext(ctx, i).(SandwichOutput)
 }
 
-// SandwichArrayInput is an input type that accepts SandwichArray and SandwichArrayOutput values.
-// You can construct a concrete instance of `SandwichArrayInput` via:
-//
-//	SandwichArray{ SandwichArgs{...} }
-type SandwichArrayInput interface {
-	pulumi.Input
-
-	ToSandwichArrayOutput() SandwichArrayOutput
-	ToSandwichArrayOutputWithContext(context.Context) SandwichArrayOutput
-}
-
-type SandwichArray []SandwichInput
-
-func (SandwichArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]Sandwich)(nil)).Elem()
-}
-
-func (i SandwichArray) ToSandwichArrayOutput() SandwichArrayOutput {
-	return i.ToSandwichArrayOutputWithContext(context.Background())
-}
-
-func (i SandwichArray) ToSandwichArrayOutputWithContext(ctx context.Context) SandwichArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SandwichArrayOutput)
+func (i *SandwichArgs) ToOutput(ctx context.Context) pulumix.Output[*SandwichArgs] {
+	return pulumix.Val(i)
 }
 
 type SandwichOutput struct{ *pulumi.OutputState }
@@ -85,37 +54,21 @@ func (o SandwichOutput) ToSandwichOutputWithContext(ctx context.Context) Sandwic
 	return o
 }
 
-func (o SandwichOutput) Bread() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Sandwich) *string { return v.Bread }).(pulumi.StringPtrOutput)
-}
-
-func (o SandwichOutput) Veggies() pulumi.StringArrayOutput {
-	return o.ApplyT(func(v Sandwich) []string { return v.Veggies }).(pulumi.StringArrayOutput)
-}
-
-type SandwichArrayOutput struct{ *pulumi.OutputState }
-
-func (SandwichArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]Sandwich)(nil)).Elem()
+func (o SandwichOutput) ToOutput(ctx context.Context) pulumix.Output[Sandwich] {
+	return pulumix.Output[Sandwich]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o SandwichArrayOutput) ToSandwichArrayOutput() SandwichArrayOutput {
-	return o
-}
-
-func (o SandwichArrayOutput) ToSandwichArrayOutputWithContext(ctx context.Context) SandwichArrayOutput {
-	return o
+func (o SandwichOutput) Bread() pulumix.Output[*string] {
+	return pulumix.Apply[Sandwich](o, func(v Sandwich) *string { return v.Bread })
 }
 
-func (o SandwichArrayOutput) Index(i pulumi.IntInput) SandwichOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Sandwich {
-		return vs[0].([]Sandwich)[vs[1].(int)]
-	}).(SandwichOutput)
+func (o SandwichOutput) Veggies() pulumix.ArrayOutput[string] {
+	value := pulumix.Apply[Sandwich](o, func(v Sandwich) []string { return v.Veggies })
+	return pulumix.ArrayOutput[string]{OutputState: value.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*SandwichInput)(nil)).Elem(), SandwichArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SandwichArrayInput)(nil)).Elem(), SandwichArray{})
 	pulumi.RegisterOutputType(SandwichOutput{})
-	pulumi.RegisterOutputType(SandwichArrayOutput{})
 }
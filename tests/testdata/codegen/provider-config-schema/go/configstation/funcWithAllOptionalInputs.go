@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -34,27 +35,30 @@ type FuncWithAllOptionalInputsResult struct {
 }
 
 func FuncWithAllOptionalInputsOutput(ctx *pulumi.Context, args FuncWithAllOptionalInputsOutputArgs, opts ...pulumi.InvokeOption) FuncWithAllOptionalInputsResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (FuncWithAllOptionalInputsResult, error) {
-			args := v.(FuncWithAllOptionalInputsArgs)
-			r, err := FuncWithAllOptionalInputs(ctx, &args, opts...)
-			var s FuncWithAllOptionalInputsResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(FuncWithAllOptionalInputsResultOutput)
+	outputResult := pulumix.ApplyErr[*FuncWithAllOptionalInputsArgs](args.ToOutput(), func(plainArgs *FuncWithAllOptionalInputsArgs) (*FuncWithAllOptionalInputsResult, error) {
+		return FuncWithAllOptionalInputs(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[FuncWithAllOptionalInputsResultOutput, *FuncWithAllOptionalInputsResult](outputResult)
 }
 
 type FuncWithAllOptionalInputsOutputArgs struct {
 	// Property A
-	A pulumi.StringPtrInput `pulumi:"a"`
+	A pulumix.Input[*string] `pulumi:"a"`
 	// Property B
-	B pulumi.StringPtrInput `pulumi:"b"`
+	B pulumix.Input[*string] `pulumi:"b"`
 }
 
-func (FuncWithAllOptionalInputsOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*FuncWithAllOptionalInputsArgs)(nil)).Elem()
+func (args FuncWithAllOptionalInputsOutputArgs) ToOutput() pulumix.Output[*FuncWithAllOptionalInputsArgs] {
+	allArgs := pulumix.All(
+		args.A.ToOutput(context.Background()).AsAny(),
+		args.B.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *FuncWithAllOptionalInputsArgs {
+		return &FuncWithAllOptionalInputsArgs{
+			A: resolvedArgs[0].(*string),
+			B: resolvedArgs[1].(*string),
+		}
+	})
 }
 
 type FuncWithAllOptionalInputsResultOutput struct{ *pulumi.OutputState }
@@ -63,18 +67,12 @@ func (FuncWithAllOptionalInputsResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*FuncWithAllOptionalInputsResult)(nil)).Elem()
 }
 
-func (o FuncWithAllOptionalInputsResultOutput) ToFuncWithAllOptionalInputsResultOutput() FuncWithAllOptionalInputsResultOutput {
-	return o
-}
-
-func (o FuncWithAllOptionalInputsResultOutput) ToFuncWithAllOptionalInputsResultOutputWithContext(ctx context.Context) FuncWithAllOptionalInputsResultOutput {
-	return o
-}
-
-func (o FuncWithAllOptionalInputsResultOutput) R() pulumi.StringOutput {
-	return o.ApplyT(func(v FuncWithAllOptionalInputsResult) string { return v.R }).(pulumi.StringOutput)
+func (o FuncWithAllOptionalInputsResultOutput) ToOutput(context.Context) pulumix.Output[*FuncWithAllOptionalInputsResult] {
+	return pulumix.Output[*FuncWithAllOptionalInputsResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-func init() {
-	pulumi.RegisterOutputType(FuncWithAllOptionalInputsResultOutput{})
+func (o FuncWithAllOptionalInputsResultOutput) R() pulumix.Output[string] {
+	return pulumix.Apply[*FuncWithAllOptionalInputsResult](o, func(v *FuncWithAllOptionalInputsResult) string { return v.R })
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"internal"
 )
 
@@ -18,20 +19,9 @@ type Child struct {
 	Name *string `pulumi:"name"`
 }
 
-// ChildInput is an input type that accepts ChildArgs and ChildOutput values.
-// You can construct a concrete instance of `ChildInput` via:
-//
-//	ChildArgs{...}
-type ChildInput interface {
-	pulumi.Input
-
-	ToChildOutput() ChildOutput
-	ToChildOutputWithContext(context.Context) ChildOutput
-}
-
 type ChildArgs struct {
-	Age  pulumi.IntPtrInput    `pulumi:"age"`
-	Name pulumi.StringPtrInput `pulumi:"name"`
+	Age  pulumix.Input[*int]    `pulumi:"age"`
+	Name pulumix.Input[*string] `pulumi:"name"`
 }
 
 func (ChildArgs) ElementType() reflect.Type {
@@ -46,6 +36,10 @@ func (i ChildArgs) ToChildOutputWithContext(ctx context.Context) ChildOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(ChildOutput)
 }
 
+func (i *ChildArgs) ToOutput(ctx context.Context) pulumix.Output[*ChildArgs] {
+	return pulumix.Val(i)
+}
+
 type ChildOutput struct{ *pulumi.OutputState }
 
 func (ChildOutput) ElementType() reflect.Type {
@@ -60,15 +54,20 @@ func (o ChildOutput) ToChildOutputWithContext(ctx context.Context) ChildOutput {
 	return o
 }
 
-func (o ChildOutput) Age() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v Child) *int { return v.Age }).(pulumi.IntPtrOutput)
+func (o ChildOutput) ToOutput(ctx context.Context) pulumix.Output[Child] {
+	return pulumix.Output[Child]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ChildOutput) Age() pulumix.Output[*int] {
+	return pulumix.Apply[Child](o, func(v Child) *int { return v.Age })
 }
 
-func (o ChildOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Child) *string { return v.Name }).(pulumi.StringPtrOutput)
+func (o ChildOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[Child](o, func(v Child) *string { return v.Name })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ChildInput)(nil)).Elem(), ChildArgs{})
 	pulumi.RegisterOutputType(ChildOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-8403/mongodbatlas/internal"
 )
 
@@ -29,23 +30,21 @@ type LookupCustomDbRolesResult struct {
 }
 
 func LookupCustomDbRolesOutput(ctx *pulumi.Context, args LookupCustomDbRolesOutputArgs, opts ...pulumi.InvokeOption) LookupCustomDbRolesResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (LookupCustomDbRolesResult, error) {
-			args := v.(LookupCustomDbRolesArgs)
-			r, err := LookupCustomDbRoles(ctx, &args, opts...)
-			var s LookupCustomDbRolesResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(LookupCustomDbRolesResultOutput)
+	outputResult := pulumix.ApplyErr[*LookupCustomDbRolesArgs](args.ToOutput(), func(plainArgs *LookupCustomDbRolesArgs) (*LookupCustomDbRolesResult, error) {
+		return LookupCustomDbRoles(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[LookupCustomDbRolesResultOutput, *LookupCustomDbRolesResult](outputResult)
 }
 
 type LookupCustomDbRolesOutputArgs struct {
 }
 
-func (LookupCustomDbRolesOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*LookupCustomDbRolesArgs)(nil)).Elem()
+func (args LookupCustomDbRolesOutputArgs) ToOutput() pulumix.Output[*LookupCustomDbRolesArgs] {
+	allArgs := pulumix.All()
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *LookupCustomDbRolesArgs {
+		return &LookupCustomDbRolesArgs{}
+	})
 }
 
 type LookupCustomDbRolesResultOutput struct{ *pulumi.OutputState }
@@ -54,18 +53,15 @@ func (LookupCustomDbRolesResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*LookupCustomDbRolesResult)(nil)).Elem()
 }
 
-func (o LookupCustomDbRolesResultOutput) ToLookupCustomDbRolesResultOutput() LookupCustomDbRolesResultOutput {
-	return o
-}
-
-func (o LookupCustomDbRolesResultOutput) ToLookupCustomDbRolesResultOutputWithContext(ctx context.Context) LookupCustomDbRolesResultOutput {
-	return o
-}
-
-func (o LookupCustomDbRolesResultOutput) Result() GetCustomDbRolesResultPtrOutput {
-	return o.ApplyT(func(v LookupCustomDbRolesResult) *GetCustomDbRolesResult { return v.Result }).(GetCustomDbRolesResultPtrOutput)
+func (o LookupCustomDbRolesResultOutput) ToOutput(context.Context) pulumix.Output[*LookupCustomDbRolesResult] {
+	return pulumix.Output[*LookupCustomDbRolesResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-func init() {
-	pulumi.RegisterOutputType(LookupCustomDbRolesResultOutput{})
+func (o LookupCustomDbRolesResultOutput) Result() pulumix.GPtrOutput[GetCustomDbRolesResult, GetCustomDbRolesResultOutput] {
+	value := pulumix.Apply[*LookupCustomDbRolesResult](o, func(v *LookupCustomDbRolesResult) *GetCustomDbRolesResult { return v.Result })
+	return pulumix.GPtrOutput[GetCustomDbRolesResult, GetCustomDbRolesResultOutput]{
+		OutputState: value.OutputState,
+	}
 }
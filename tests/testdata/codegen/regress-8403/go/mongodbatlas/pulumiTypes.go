@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-8403/mongodbatlas/internal"
 )
 
@@ -16,17 +17,6 @@ var _ = internal.GetEnvOrDefault
 type GetCustomDbRolesResult struct {
 }
 
-// GetCustomDbRolesResultInput is an input type that accepts GetCustomDbRolesResultArgs and GetCustomDbRolesResultOutput values.
-// You can construct a concrete instance of `GetCustomDbRolesResultInput` via:
-//
-//	GetCustomDbRolesResultArgs{...}
-type GetCustomDbRolesResultInput interface {
-	pulumi.Input
-
-	ToGetCustomDbRolesResultOutput() GetCustomDbRolesResultOutput
-	ToGetCustomDbRolesResultOutputWithContext(context.Context) GetCustomDbRolesResultOutput
-}
-
 type GetCustomDbRolesResultArgs struct {
 }
 
@@ -42,45 +32,8 @@ func (i GetCustomDbRolesResultArgs) ToGetCustomDbRolesResultOutputWithContext(ct
 	return pulumi.ToOutputWithContext(ctx, i).(GetCustomDbRolesResultOutput)
 }
 
-func (i GetCustomDbRolesResultArgs) ToGetCustomDbRolesResultPtrOutput() GetCustomDbRolesResultPtrOutput {
-	return i.ToGetCustomDbRolesResultPtrOutputWithContext(context.Background())
-}
-
-func (i GetCustomDbRolesResultArgs) ToGetCustomDbRolesResultPtrOutputWithContext(ctx context.Context) GetCustomDbRolesResultPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(GetCustomDbRolesResultOutput).ToGetCustomDbRolesResultPtrOutputWithContext(ctx)
-}
-
-// GetCustomDbRolesResultPtrInput is an input type that accepts GetCustomDbRolesResultArgs, GetCustomDbRolesResultPtr and GetCustomDbRolesResultPtrOutput values.
-// You can construct a concrete instance of `GetCustomDbRolesResultPtrInput` via:
-//
-//	        GetCustomDbRolesResultArgs{...}
-//
-//	or:
-//
-//	        nil
-type GetCustomDbRolesResultPtrInput interface {
-	pulumi.Input
-
-	ToGetCustomDbRolesResultPtrOutput() GetCustomDbRolesResultPtrOutput
-	ToGetCustomDbRolesResultPtrOutputWithContext(context.Context) GetCustomDbRolesResultPtrOutput
-}
-
-type getCustomDbRolesResultPtrType GetCustomDbRolesResultArgs
-
-func GetCustomDbRolesResultPtr(v *GetCustomDbRolesResultArgs) GetCustomDbRolesResultPtrInput {
-	return (*getCustomDbRolesResultPtrType)(v)
-}
-
-func (*getCustomDbRolesResultPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**GetCustomDbRolesResult)(nil)).Elem()
-}
-
-func (i *getCustomDbRolesResultPtrType) ToGetCustomDbRolesResultPtrOutput() GetCustomDbRolesResultPtrOutput {
-	return i.ToGetCustomDbRolesResultPtrOutputWithContext(context.Background())
-}
-
-func (i *getCustomDbRolesResultPtrType) ToGetCustomDbRolesResultPtrOutputWithContext(ctx context.Context) GetCustomDbRolesResultPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(GetCustomDbRolesResultPtrOutput)
+func (i *GetCustomDbRolesResultArgs) ToOutput(ctx context.Context) pulumix.Output[*GetCustomDbRolesResultArgs] {
+	return pulumix.Val(i)
 }
 
 type GetCustomDbRolesResultOutput struct{ *pulumi.OutputState }
@@ -97,43 +50,12 @@ func (o GetCustomDbRolesResultOutput) ToGetCustomDbRolesResultOutputWithContext(
 	return o
 }
 
-func (o GetCustomDbRolesResultOutput) ToGetCustomDbRolesResultPtrOutput() GetCustomDbRolesResultPtrOutput {
-	return o.ToGetCustomDbRolesResultPtrOutputWithContext(context.Background())
-}
-
-func (o GetCustomDbRolesResultOutput) ToGetCustomDbRolesResultPtrOutputWithContext(ctx context.Context) GetCustomDbRolesResultPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v GetCustomDbRolesResult) *GetCustomDbRolesResult {
-		return &v
-	}).(GetCustomDbRolesResultPtrOutput)
-}
-
-type GetCustomDbRolesResultPtrOutput struct{ *pulumi.OutputState }
-
-func (GetCustomDbRolesResultPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**GetCustomDbRolesResult)(nil)).Elem()
-}
-
-func (o GetCustomDbRolesResultPtrOutput) ToGetCustomDbRolesResultPtrOutput() GetCustomDbRolesResultPtrOutput {
-	return o
-}
-
-func (o GetCustomDbRolesResultPtrOutput) ToGetCustomDbRolesResultPtrOutputWithContext(ctx context.Context) GetCustomDbRolesResultPtrOutput {
-	return o
-}
-
-func (o GetCustomDbRolesResultPtrOutput) Elem() GetCustomDbRolesResultOutput {
-	return o.ApplyT(func(v *GetCustomDbRolesResult) GetCustomDbRolesResult {
-		if v != nil {
-			return *v
-		}
-		var ret GetCustomDbRolesResult
-		return ret
-	}).(GetCustomDbRolesResultOutput)
+func (o GetCustomDbRolesResultOutput) ToOutput(ctx context.Context) pulumix.Output[GetCustomDbRolesResult] {
+	return pulumix.Output[GetCustomDbRolesResult]{
+		OutputState: o.OutputState,
+	}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*GetCustomDbRolesResultInput)(nil)).Elem(), GetCustomDbRolesResultArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*GetCustomDbRolesResultPtrInput)(nil)).Elem(), GetCustomDbRolesResultArgs{})
 	pulumi.RegisterOutputType(GetCustomDbRolesResultOutput{})
-	pulumi.RegisterOutputType(GetCustomDbRolesResultPtrOutput{})
 }
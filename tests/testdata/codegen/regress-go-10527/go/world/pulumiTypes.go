@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-10527/world/internal"
 )
 
@@ -17,19 +18,8 @@ type World struct {
 	Name *string `pulumi:"name"`
 }
 
-// WorldInput is an input type that accepts WorldArgs and WorldOutput values.
-// You can construct a concrete instance of `WorldInput` via:
-//
-//	WorldArgs{...}
-type WorldInput interface {
-	pulumi.Input
-
-	ToWorldOutput() WorldOutput
-	ToWorldOutputWithContext(context.Context) WorldOutput
-}
-
 type WorldArgs struct {
-	Name pulumi.StringPtrInput `pulumi:"name"`
+	Name pulumix.Input[*string] `pulumi:"name"`
 }
 
 func (WorldArgs) ElementType() reflect.Type {
@@ -44,6 +34,10 @@ func (i WorldArgs) ToWorldOutputWithContext(ctx context.Context) WorldOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(WorldOutput)
 }
 
+func (i *WorldArgs) ToOutput(ctx context.Context) pulumix.Output[*WorldArgs] {
+	return pulumix.Val(i)
+}
+
 type WorldOutput struct{ *pulumi.OutputState }
 
 func (WorldOutput) ElementType() reflect.Type {
@@ -58,12 +52,16 @@ func (o WorldOutput) ToWorldOutputWithContext(ctx context.Context) WorldOutput {
 	return o
 }
 
-func (o WorldOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v World) *string { return v.Name }).(pulumi.StringPtrOutput)
+func (o WorldOutput) ToOutput(ctx context.Context) pulumix.Output[World] {
+	return pulumix.Output[World]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o WorldOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[World](o, func(v World) *string { return v.Name })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*WorldInput)(nil)).Elem(), WorldArgs{})
 	pulumi.RegisterOutputType(WorldOutput{})
-	pulumi.RegisterOutputType(WorldMapOutput{})
 }
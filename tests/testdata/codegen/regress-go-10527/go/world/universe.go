@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-10527/world/internal"
 )
 
@@ -60,36 +61,17 @@ type universeArgs struct {
 
 // The set of arguments for constructing a Universe resource.
 type UniverseArgs struct {
-	Worlds WorldMapInput
+	Worlds pulumix.Input[map[string]*WorldArgs]
 }
 
 func (UniverseArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*universeArgs)(nil)).Elem()
 }
 
-type UniverseInput interface {
-	pulumi.Input
-
-	ToUniverseOutput() UniverseOutput
-	ToUniverseOutputWithContext(ctx context.Context) UniverseOutput
-}
-
-func (*Universe) ElementType() reflect.Type {
-	return reflect.TypeOf((**Universe)(nil)).Elem()
-}
-
-func (i *Universe) ToUniverseOutput() UniverseOutput {
-	return i.ToUniverseOutputWithContext(context.Background())
-}
-
-func (i *Universe) ToUniverseOutputWithContext(ctx context.Context) UniverseOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(UniverseOutput)
-}
-
 type UniverseOutput struct{ *pulumi.OutputState }
 
 func (UniverseOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Universe)(nil)).Elem()
+	return reflect.TypeOf((*Universe)(nil)).Elem()
 }
 
 func (o UniverseOutput) ToUniverseOutput() UniverseOutput {
@@ -100,7 +82,12 @@ func (o UniverseOutput) ToUniverseOutputWithContext(ctx context.Context) Univers
 	return o
 }
 
+func (o UniverseOutput) ToOutput(ctx context.Context) pulumix.Output[Universe] {
+	return pulumix.Output[Universe]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*UniverseInput)(nil)).Elem(), &Universe{})
 	pulumi.RegisterOutputType(UniverseOutput{})
 }
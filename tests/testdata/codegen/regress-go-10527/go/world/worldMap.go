@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-10527/world/internal"
 )
 
 type WorldMap struct {
 	pulumi.CustomResourceState
 
-	Name pulumi.StringPtrOutput `pulumi:"name"`
+	Name pulumix.Output[*string] `pulumi:"name"`
 }
 
 // NewWorldMap registers a new resource with the given unique name, arguments, and options.
@@ -67,29 +68,10 @@ func (WorldMapArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*worldMapArgs)(nil)).Elem()
 }
 
-type WorldMapInput interface {
-	pulumi.Input
-
-	ToWorldMapOutput() WorldMapOutput
-	ToWorldMapOutputWithContext(ctx context.Context) WorldMapOutput
-}
-
-func (*WorldMap) ElementType() reflect.Type {
-	return reflect.TypeOf((**WorldMap)(nil)).Elem()
-}
-
-func (i *WorldMap) ToWorldMapOutput() WorldMapOutput {
-	return i.ToWorldMapOutputWithContext(context.Background())
-}
-
-func (i *WorldMap) ToWorldMapOutputWithContext(ctx context.Context) WorldMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(WorldMapOutput)
-}
-
 type WorldMapOutput struct{ *pulumi.OutputState }
 
 func (WorldMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**WorldMap)(nil)).Elem()
+	return reflect.TypeOf((*WorldMap)(nil)).Elem()
 }
 
 func (o WorldMapOutput) ToWorldMapOutput() WorldMapOutput {
@@ -100,11 +82,17 @@ func (o WorldMapOutput) ToWorldMapOutputWithContext(ctx context.Context) WorldMa
 	return o
 }
 
-func (o WorldMapOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *WorldMap) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+func (o WorldMapOutput) ToOutput(ctx context.Context) pulumix.Output[WorldMap] {
+	return pulumix.Output[WorldMap]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o WorldMapOutput) Name() pulumix.Output[*string] {
+	value := pulumix.Apply[WorldMap](o, func(v WorldMap) pulumix.Output[*string] { return v.Name })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*WorldMapInput)(nil)).Elem(), &WorldMap{})
 	pulumi.RegisterOutputType(WorldMapOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-12971/example/internal"
 )
 
@@ -24,17 +25,17 @@ func NewProvider(ctx *pulumi.Context,
 
 	if args.Name == nil {
 		if d := internal.GetEnvOrDefault(nil, nil, "WORLD_NAME"); d != nil {
-			args.Name = pulumi.StringPtr(d.(string))
+			args.Name = pulumix.Ptr(d.(string))
 		}
 	}
 	if args.Populated == nil {
 		if d := internal.GetEnvOrDefault(nil, internal.ParseEnvBool, "WORLD_POPULATED"); d != nil {
-			args.Populated = pulumi.BoolPtr(d.(bool))
+			args.Populated = pulumix.Ptr(d.(bool))
 		}
 	}
 	if args.RadiusKm == nil {
 		if d := internal.GetEnvOrDefault(nil, internal.ParseEnvFloat, "WORLD_RADIUS_KM"); d != nil {
-			args.RadiusKm = pulumi.Float64Ptr(d.(float64))
+			args.RadiusKm = pulumix.Ptr(d.(float64))
 		}
 	}
 	opts = internal.PkgResourceDefaultOpts(opts)
@@ -54,38 +55,19 @@ type providerArgs struct {
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
-	Name      pulumi.StringPtrInput
-	Populated pulumi.BoolPtrInput
-	RadiusKm  pulumi.Float64PtrInput
+	Name      pulumix.Input[*string]
+	Populated pulumix.Input[*bool]
+	RadiusKm  pulumix.Input[*float64]
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*providerArgs)(nil)).Elem()
 }
 
-type ProviderInput interface {
-	pulumi.Input
-
-	ToProviderOutput() ProviderOutput
-	ToProviderOutputWithContext(ctx context.Context) ProviderOutput
-}
-
-func (*Provider) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
-}
-
-func (i *Provider) ToProviderOutput() ProviderOutput {
-	return i.ToProviderOutputWithContext(context.Background())
-}
-
-func (i *Provider) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProviderOutput)
-}
-
 type ProviderOutput struct{ *pulumi.OutputState }
 
 func (ProviderOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
+	return reflect.TypeOf((*Provider)(nil)).Elem()
 }
 
 func (o ProviderOutput) ToProviderOutput() ProviderOutput {
@@ -96,7 +78,12 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+func (o ProviderOutput) ToOutput(ctx context.Context) pulumix.Output[Provider] {
+	return pulumix.Output[Provider]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-12971/example/internal"
 )
 
@@ -46,21 +47,10 @@ func (val *World) Defaults() *World {
 	return &tmp
 }
 
-// WorldInput is an input type that accepts WorldArgs and WorldOutput values.
-// You can construct a concrete instance of `WorldInput` via:
-//
-//	WorldArgs{...}
-type WorldInput interface {
-	pulumi.Input
-
-	ToWorldOutput() WorldOutput
-	ToWorldOutputWithContext(context.Context) WorldOutput
-}
-
 type WorldArgs struct {
-	Name      pulumi.StringPtrInput  `pulumi:"name"`
-	Populated pulumi.BoolPtrInput    `pulumi:"populated"`
-	RadiusKm  pulumi.Float64PtrInput `pulumi:"radiusKm"`
+	Name      pulumix.Input[*string]  `pulumi:"name"`
+	Populated pulumix.Input[*bool]    `pulumi:"populated"`
+	RadiusKm  pulumix.Input[*float64] `pulumi:"radiusKm"`
 }
 
 // Defaults sets the appropriate defaults for WorldArgs
@@ -71,17 +61,17 @@ func (val *WorldArgs) Defaults() *WorldArgs {
 	tmp := *val
 	if tmp.Name == nil {
 		if d := internal.GetEnvOrDefault(nil, nil, "WORLD_NAME"); d != nil {
-			tmp.Name = pulumi.StringPtr(d.(string))
+			tmp.Name = pulumix.Ptr(d.(string))
 		}
 	}
 	if tmp.Populated == nil {
 		if d := internal.GetEnvOrDefault(nil, internal.ParseEnvBool, "WORLD_POPULATED"); d != nil {
-			tmp.Populated = pulumi.BoolPtr(d.(bool))
+			tmp.Populated = pulumix.Ptr(d.(bool))
 		}
 	}
 	if tmp.RadiusKm == nil {
 		if d := internal.GetEnvOrDefault(nil, internal.ParseEnvFloat, "WORLD_RADIUS_KM"); d != nil {
-			tmp.RadiusKm = pulumi.Float64Ptr(d.(float64))
+			tmp.RadiusKm = pulumix.Ptr(d.(float64))
 		}
 	}
 	return &tmp
@@ -98,6 +88,10 @@ func (i WorldArgs) ToWorldOutputWithContext(ctx context.Context) WorldOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(WorldOutput)
 }
 
+func (i *WorldArgs) ToOutput(ctx context.Context) pulumix.Output[*WorldArgs] {
+	return pulumix.Val(i)
+}
+
 type WorldOutput struct{ *pulumi.OutputState }
 
 func (WorldOutput) ElementType() reflect.Type {
@@ -112,19 +106,24 @@ func (o WorldOutput) ToWorldOutputWithContext(ctx context.Context) WorldOutput {
 	return o
 }
 
-func (o WorldOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v World) *string { return v.Name }).(pulumi.StringPtrOutput)
+func (o WorldOutput) ToOutput(ctx context.Context) pulumix.Output[World] {
+	return pulumix.Output[World]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o WorldOutput) Name() pulumix.Output[*string] {
+	return pulumix.Apply[World](o, func(v World) *string { return v.Name })
 }
 
-func (o WorldOutput) Populated() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v World) *bool { return v.Populated }).(pulumi.BoolPtrOutput)
+func (o WorldOutput) Populated() pulumix.Output[*bool] {
+	return pulumix.Apply[World](o, func(v World) *bool { return v.Populated })
 }
 
-func (o WorldOutput) RadiusKm() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v World) *float64 { return v.RadiusKm }).(pulumi.Float64PtrOutput)
+func (o WorldOutput) RadiusKm() pulumix.Output[*float64] {
+	return pulumix.Apply[World](o, func(v World) *float64 { return v.RadiusKm })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*WorldInput)(nil)).Elem(), WorldArgs{})
 	pulumi.RegisterOutputType(WorldOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-15478/nestedTypes/internal"
 )
 
@@ -32,57 +33,22 @@ func (o NestedTypeOutput) ToNestedTypeOutputWithContext(ctx context.Context) Nes
 	return o
 }
 
-func (o NestedTypeOutput) Data() pulumi.StringArrayArrayArrayOutput {
-	return o.ApplyT(func(v NestedType) [][][]string { return v.Data }).(pulumi.StringArrayArrayArrayOutput)
+func (o NestedTypeOutput) ToOutput(ctx context.Context) pulumix.Output[NestedType] {
+	return pulumix.Output[NestedType]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o NestedTypeOutput) NestedMaps() pulumi.StringMapMapMapOutput {
-	return o.ApplyT(func(v NestedType) map[string]map[string]map[string]string { return v.NestedMaps }).(pulumi.StringMapMapMapOutput)
+func (o NestedTypeOutput) Data() pulumix.ArrayOutput[[][]string] {
+	value := pulumix.Apply[NestedType](o, func(v NestedType) [][][]string { return v.Data })
+	return pulumix.ArrayOutput[[][]string]{OutputState: value.OutputState}
 }
 
-type NestedTypePtrOutput struct{ *pulumi.OutputState }
-
-func (NestedTypePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**NestedType)(nil)).Elem()
-}
-
-func (o NestedTypePtrOutput) ToNestedTypePtrOutput() NestedTypePtrOutput {
-	return o
-}
-
-func (o NestedTypePtrOutput) ToNestedTypePtrOutputWithContext(ctx context.Context) NestedTypePtrOutput {
-	return o
-}
-
-func (o NestedTypePtrOutput) Elem() NestedTypeOutput {
-	return o.ApplyT(func(v *NestedType) NestedType {
-		if v != nil {
-			return *v
-		}
-		var ret NestedType
-		return ret
-	}).(NestedTypeOutput)
-}
-
-func (o NestedTypePtrOutput) Data() pulumi.StringArrayArrayArrayOutput {
-	return o.ApplyT(func(v *NestedType) [][][]string {
-		if v == nil {
-			return nil
-		}
-		return v.Data
-	}).(pulumi.StringArrayArrayArrayOutput)
-}
-
-func (o NestedTypePtrOutput) NestedMaps() pulumi.StringMapMapMapOutput {
-	return o.ApplyT(func(v *NestedType) map[string]map[string]map[string]string {
-		if v == nil {
-			return nil
-		}
-		return v.NestedMaps
-	}).(pulumi.StringMapMapMapOutput)
+func (o NestedTypeOutput) NestedMaps() pulumix.MapOutput[map[string]map[string]string] {
+	value := pulumix.Apply[NestedType](o, func(v NestedType) map[string]map[string]map[string]string { return v.NestedMaps })
+	return pulumix.MapOutput[map[string]map[string]string]{OutputState: value.OutputState}
 }
 
 func init() {
 	pulumi.RegisterOutputType(NestedTypeOutput{})
-	pulumi.RegisterOutputType(NestedTypePtrOutput{})
 }
@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-15478/nestedTypes/internal"
 )
 
 type Resource struct {
 	pulumi.CustomResourceState
 
-	Nested NestedTypePtrOutput `pulumi:"nested"`
+	Nested pulumix.GPtrOutput[NestedType, NestedTypeOutput] `pulumi:"nested"`
 }
 
 // NewResource registers a new resource with the given unique name, arguments, and options.
@@ -67,29 +68,10 @@ func (ResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*resourceArgs)(nil)).Elem()
 }
 
-type ResourceInput interface {
-	pulumi.Input
-
-	ToResourceOutput() ResourceOutput
-	ToResourceOutputWithContext(ctx context.Context) ResourceOutput
-}
-
-func (*Resource) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
-}
-
-func (i *Resource) ToResourceOutput() ResourceOutput {
-	return i.ToResourceOutputWithContext(context.Background())
-}
-
-func (i *Resource) ToResourceOutputWithContext(ctx context.Context) ResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ResourceOutput)
-}
-
 type ResourceOutput struct{ *pulumi.OutputState }
 
 func (ResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Resource)(nil)).Elem()
+	return reflect.TypeOf((*Resource)(nil)).Elem()
 }
 
 func (o ResourceOutput) ToResourceOutput() ResourceOutput {
@@ -100,11 +82,18 @@ func (o ResourceOutput) ToResourceOutputWithContext(ctx context.Context) Resourc
 	return o
 }
 
-func (o ResourceOutput) Nested() NestedTypePtrOutput {
-	return o.ApplyT(func(v *Resource) NestedTypePtrOutput { return v.Nested }).(NestedTypePtrOutput)
+func (o ResourceOutput) ToOutput(ctx context.Context) pulumix.Output[Resource] {
+	return pulumix.Output[Resource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResourceOutput) Nested() pulumix.GPtrOutput[NestedType, NestedTypeOutput] {
+	value := pulumix.Apply[Resource](o, func(v Resource) pulumix.GPtrOutput[NestedType, NestedTypeOutput] { return v.Nested })
+	unwrapped := pulumix.Flatten[*NestedType, pulumix.GPtrOutput[NestedType, NestedTypeOutput]](value)
+	return pulumix.GPtrOutput[NestedType, NestedTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ResourceInput)(nil)).Elem(), &Resource{})
 	pulumi.RegisterOutputType(ResourceOutput{})
 }
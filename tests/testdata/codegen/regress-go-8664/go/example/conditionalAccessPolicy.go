@@ -9,13 +9,14 @@ import (
 
 	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-go-8664/example/internal"
 )
 
 type ConditionalAccessPolicy struct {
 	pulumi.CustomResourceState
 
-	Conditions ConditionalAccessPolicyConditionsOutput `pulumi:"conditions"`
+	Conditions pulumix.GPtrOutput[ConditionalAccessPolicyConditions, ConditionalAccessPolicyConditionsOutput] `pulumi:"conditions"`
 }
 
 // NewConditionalAccessPolicy registers a new resource with the given unique name, arguments, and options.
@@ -55,7 +56,7 @@ type conditionalAccessPolicyState struct {
 }
 
 type ConditionalAccessPolicyState struct {
-	Conditions ConditionalAccessPolicyConditionsPtrInput
+	Conditions pulumix.Input[*ConditionalAccessPolicyConditionsArgs]
 }
 
 func (ConditionalAccessPolicyState) ElementType() reflect.Type {
@@ -68,36 +69,17 @@ type conditionalAccessPolicyArgs struct {
 
 // The set of arguments for constructing a ConditionalAccessPolicy resource.
 type ConditionalAccessPolicyArgs struct {
-	Conditions ConditionalAccessPolicyConditionsInput
+	Conditions pulumix.Input[any]
 }
 
 func (ConditionalAccessPolicyArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*conditionalAccessPolicyArgs)(nil)).Elem()
 }
 
-type ConditionalAccessPolicyInput interface {
-	pulumi.Input
-
-	ToConditionalAccessPolicyOutput() ConditionalAccessPolicyOutput
-	ToConditionalAccessPolicyOutputWithContext(ctx context.Context) ConditionalAccessPolicyOutput
-}
-
-func (*ConditionalAccessPolicy) ElementType() reflect.Type {
-	return reflect.TypeOf((**ConditionalAccessPolicy)(nil)).Elem()
-}
-
-func (i *ConditionalAccessPolicy) ToConditionalAccessPolicyOutput() ConditionalAccessPolicyOutput {
-	return i.ToConditionalAccessPolicyOutputWithContext(context.Background())
-}
-
-func (i *ConditionalAccessPolicy) ToConditionalAccessPolicyOutputWithContext(ctx context.Context) ConditionalAccessPolicyOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConditionalAccessPolicyOutput)
-}
-
 type ConditionalAccessPolicyOutput struct{ *pulumi.OutputState }
 
 func (ConditionalAccessPolicyOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ConditionalAccessPolicy)(nil)).Elem()
+	return reflect.TypeOf((*ConditionalAccessPolicy)(nil)).Elem()
 }
 
 func (o ConditionalAccessPolicyOutput) ToConditionalAccessPolicyOutput() ConditionalAccessPolicyOutput {
@@ -108,11 +90,20 @@ func (o ConditionalAccessPolicyOutput) ToConditionalAccessPolicyOutputWithContex
 	return o
 }
 
-func (o ConditionalAccessPolicyOutput) Conditions() ConditionalAccessPolicyConditionsOutput {
-	return o.ApplyT(func(v *ConditionalAccessPolicy) ConditionalAccessPolicyConditionsOutput { return v.Conditions }).(ConditionalAccessPolicyConditionsOutput)
+func (o ConditionalAccessPolicyOutput) ToOutput(ctx context.Context) pulumix.Output[ConditionalAccessPolicy] {
+	return pulumix.Output[ConditionalAccessPolicy]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ConditionalAccessPolicyOutput) Conditions() pulumix.GPtrOutput[ConditionalAccessPolicyConditions, ConditionalAccessPolicyConditionsOutput] {
+	value := pulumix.Apply[ConditionalAccessPolicy](o, func(v ConditionalAccessPolicy) pulumix.GPtrOutput[ConditionalAccessPolicyConditions, ConditionalAccessPolicyConditionsOutput] {
+		return v.Conditions
+	})
+	unwrapped := pulumix.Flatten[*ConditionalAccessPolicyConditions, pulumix.GPtrOutput[ConditionalAccessPolicyConditions, ConditionalAccessPolicyConditionsOutput]](value)
+	return pulumix.GPtrOutput[ConditionalAccessPolicyConditions, ConditionalAccessPolicyConditionsOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConditionalAccessPolicyInput)(nil)).Elem(), &ConditionalAccessPolicy{})
 	pulumi.RegisterOutputType(ConditionalAccessPolicyOutput{})
 }
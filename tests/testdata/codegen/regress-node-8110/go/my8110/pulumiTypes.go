@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"regress-node-8110/my8110/internal"
 )
 
@@ -17,19 +18,8 @@ type MyObj struct {
 	A *string `pulumi:"a"`
 }
 
-// MyObjInput is an input type that accepts MyObjArgs and MyObjOutput values.
-// You can construct a concrete instance of `MyObjInput` via:
-//
-//	MyObjArgs{...}
-type MyObjInput interface {
-	pulumi.Input
-
-	ToMyObjOutput() MyObjOutput
-	ToMyObjOutputWithContext(context.Context) MyObjOutput
-}
-
 type MyObjArgs struct {
-	A pulumi.StringPtrInput `pulumi:"a"`
+	A pulumix.Input[*string] `pulumi:"a"`
 }
 
 func (MyObjArgs) ElementType() reflect.Type {
@@ -44,6 +34,10 @@ func (i MyObjArgs) ToMyObjOutputWithContext(ctx context.Context) MyObjOutput {
 	return pulumi.ToOutputWithContext(ctx, i).(MyObjOutput)
 }
 
+func (i *MyObjArgs) ToOutput(ctx context.Context) pulumix.Output[*MyObjArgs] {
+	return pulumix.Val(i)
+}
+
 type MyObjOutput struct{ *pulumi.OutputState }
 
 func (MyObjOutput) ElementType() reflect.Type {
@@ -58,11 +52,16 @@ func (o MyObjOutput) ToMyObjOutputWithContext(ctx context.Context) MyObjOutput {
 	return o
 }
 
-func (o MyObjOutput) A() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v MyObj) *string { return v.A }).(pulumi.StringPtrOutput)
+func (o MyObjOutput) ToOutput(ctx context.Context) pulumix.Output[MyObj] {
+	return pulumix.Output[MyObj]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o MyObjOutput) A() pulumix.Output[*string] {
+	return pulumix.Apply[MyObj](o, func(v MyObj) *string { return v.A })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*MyObjInput)(nil)).Elem(), MyObjArgs{})
 	pulumi.RegisterOutputType(MyObjOutput{})
 }
@@ -8,17 +8,18 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"replace-on-change/example/internal"
 )
 
 type Cat struct {
 	pulumi.CustomResourceState
 
-	Foes    ToyMapOutput           `pulumi:"foes"`
-	Friends ToyArrayOutput         `pulumi:"friends"`
-	Name    pulumi.StringPtrOutput `pulumi:"name"`
-	Other   GodOutput              `pulumi:"other"`
-	Toy     ToyPtrOutput           `pulumi:"toy"`
+	Foes    pulumix.GMapOutput[Toy, ToyOutput]   `pulumi:"foes"`
+	Friends pulumix.GArrayOutput[Toy, ToyOutput] `pulumi:"friends"`
+	Name    pulumix.Output[*string]              `pulumi:"name"`
+	Other   pulumix.GPtrOutput[God, GodOutput]   `pulumi:"other"`
+	Toy     pulumix.GPtrOutput[Toy, ToyOutput]   `pulumi:"toy"`
 }
 
 // NewCat registers a new resource with the given unique name, arguments, and options.
@@ -84,79 +85,10 @@ func (CatArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*catArgs)(nil)).Elem()
 }
 
-type CatInput interface {
-	pulumi.Input
-
-	ToCatOutput() CatOutput
-	ToCatOutputWithContext(ctx context.Context) CatOutput
-}
-
-func (*Cat) ElementType() reflect.Type {
-	return reflect.TypeOf((**Cat)(nil)).Elem()
-}
-
-func (i *Cat) ToCatOutput() CatOutput {
-	return i.ToCatOutputWithContext(context.Background())
-}
-
-func (i *Cat) ToCatOutputWithContext(ctx context.Context) CatOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CatOutput)
-}
-
-// CatArrayInput is an input type that accepts CatArray and CatArrayOutput values.
-// You can construct a concrete instance of `CatArrayInput` via:
-//
-//	CatArray{ CatArgs{...} }
-type CatArrayInput interface {
-	pulumi.Input
-
-	ToCatArrayOutput() CatArrayOutput
-	ToCatArrayOutputWithContext(context.Context) CatArrayOutput
-}
-
-type CatArray []CatInput
-
-func (CatArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Cat)(nil)).Elem()
-}
-
-func (i CatArray) ToCatArrayOutput() CatArrayOutput {
-	return i.ToCatArrayOutputWithContext(context.Background())
-}
-
-func (i CatArray) ToCatArrayOutputWithContext(ctx context.Context) CatArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CatArrayOutput)
-}
-
-// CatMapInput is an input type that accepts CatMap and CatMapOutput values.
-// You can construct a concrete instance of `CatMapInput` via:
-//
-//	CatMap{ "key": CatArgs{...} }
-type CatMapInput interface {
-	pulumi.Input
-
-	ToCatMapOutput() CatMapOutput
-	ToCatMapOutputWithContext(context.Context) CatMapOutput
-}
-
-type CatMap map[string]CatInput
-
-func (CatMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Cat)(nil)).Elem()
-}
-
-func (i CatMap) ToCatMapOutput() CatMapOutput {
-	return i.ToCatMapOutputWithContext(context.Background())
-}
-
-func (i CatMap) ToCatMapOutputWithContext(ctx context.Context) CatMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(CatMapOutput)
-}
-
 type CatOutput struct{ *pulumi.OutputState }
 
 func (CatOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Cat)(nil)).Elem()
+	return reflect.TypeOf((*Cat)(nil)).Elem()
 }
 
 func (o CatOutput) ToCatOutput() CatOutput {
@@ -167,68 +99,41 @@ func (o CatOutput) ToCatOutputWithContext(ctx context.Context) CatOutput {
 	return o
 }
 
-func (o CatOutput) Foes() ToyMapOutput {
-	return o.ApplyT(func(v *Cat) ToyMapOutput { return v.Foes }).(ToyMapOutput)
-}
-
-func (o CatOutput) Friends() ToyArrayOutput {
-	return o.ApplyT(func(v *Cat) ToyArrayOutput { return v.Friends }).(ToyArrayOutput)
-}
-
-func (o CatOutput) Name() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Cat) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
-}
-
-func (o CatOutput) Other() GodOutput {
-	return o.ApplyT(func(v *Cat) GodOutput { return v.Other }).(GodOutput)
-}
-
-func (o CatOutput) Toy() ToyPtrOutput {
-	return o.ApplyT(func(v *Cat) ToyPtrOutput { return v.Toy }).(ToyPtrOutput)
-}
-
-type CatArrayOutput struct{ *pulumi.OutputState }
-
-func (CatArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*Cat)(nil)).Elem()
-}
-
-func (o CatArrayOutput) ToCatArrayOutput() CatArrayOutput {
-	return o
-}
-
-func (o CatArrayOutput) ToCatArrayOutputWithContext(ctx context.Context) CatArrayOutput {
-	return o
+func (o CatOutput) ToOutput(ctx context.Context) pulumix.Output[Cat] {
+	return pulumix.Output[Cat]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o CatArrayOutput) Index(i pulumi.IntInput) CatOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Cat {
-		return vs[0].([]*Cat)[vs[1].(int)]
-	}).(CatOutput)
+func (o CatOutput) Foes() pulumix.GMapOutput[Toy, ToyOutput] {
+	value := pulumix.Apply[Cat](o, func(v Cat) pulumix.GMapOutput[Toy, ToyOutput] { return v.Foes })
+	unwrapped := pulumix.Flatten[map[string]Toy, pulumix.GMapOutput[Toy, ToyOutput]](value)
+	return pulumix.GMapOutput[Toy, ToyOutput]{OutputState: unwrapped.OutputState}
 }
 
-type CatMapOutput struct{ *pulumi.OutputState }
-
-func (CatMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*Cat)(nil)).Elem()
+func (o CatOutput) Friends() pulumix.GArrayOutput[Toy, ToyOutput] {
+	value := pulumix.Apply[Cat](o, func(v Cat) pulumix.GArrayOutput[Toy, ToyOutput] { return v.Friends })
+	unwrapped := pulumix.Flatten[[]Toy, pulumix.GArrayOutput[Toy, ToyOutput]](value)
+	return pulumix.GArrayOutput[Toy, ToyOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o CatMapOutput) ToCatMapOutput() CatMapOutput {
-	return o
+func (o CatOutput) Name() pulumix.Output[*string] {
+	value := pulumix.Apply[Cat](o, func(v Cat) pulumix.Output[*string] { return v.Name })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
-func (o CatMapOutput) ToCatMapOutputWithContext(ctx context.Context) CatMapOutput {
-	return o
+func (o CatOutput) Other() pulumix.GPtrOutput[God, GodOutput] {
+	value := pulumix.Apply[Cat](o, func(v Cat) pulumix.GPtrOutput[God, GodOutput] { return v.Other })
+	unwrapped := pulumix.Flatten[*God, pulumix.GPtrOutput[God, GodOutput]](value)
+	return pulumix.GPtrOutput[God, GodOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o CatMapOutput) MapIndex(k pulumi.StringInput) CatOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Cat {
-		return vs[0].(map[string]*Cat)[vs[1].(string)]
-	}).(CatOutput)
+func (o CatOutput) Toy() pulumix.GPtrOutput[Toy, ToyOutput] {
+	value := pulumix.Apply[Cat](o, func(v Cat) pulumix.GPtrOutput[Toy, ToyOutput] { return v.Toy })
+	unwrapped := pulumix.Flatten[*Toy, pulumix.GPtrOutput[Toy, ToyOutput]](value)
+	return pulumix.GPtrOutput[Toy, ToyOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
 	pulumi.RegisterOutputType(CatOutput{})
-	pulumi.RegisterOutputType(CatArrayOutput{})
-	pulumi.RegisterOutputType(CatMapOutput{})
 }
@@ -8,16 +8,17 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"replace-on-change/example/internal"
 )
 
 type ToyStore struct {
 	pulumi.CustomResourceState
 
-	Chew   ChewPtrOutput  `pulumi:"chew"`
-	Laser  LaserPtrOutput `pulumi:"laser"`
-	Stuff  ToyArrayOutput `pulumi:"stuff"`
-	Wanted ToyArrayOutput `pulumi:"wanted"`
+	Chew   pulumix.GPtrOutput[Chew, ChewOutput]   `pulumi:"chew"`
+	Laser  pulumix.GPtrOutput[Laser, LaserOutput] `pulumi:"laser"`
+	Stuff  pulumix.GArrayOutput[Toy, ToyOutput]   `pulumi:"stuff"`
+	Wanted pulumix.GArrayOutput[Toy, ToyOutput]   `pulumi:"wanted"`
 }
 
 // NewToyStore registers a new resource with the given unique name, arguments, and options.
@@ -78,79 +79,10 @@ func (ToyStoreArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*toyStoreArgs)(nil)).Elem()
 }
 
-type ToyStoreInput interface {
-	pulumi.Input
-
-	ToToyStoreOutput() ToyStoreOutput
-	ToToyStoreOutputWithContext(ctx context.Context) ToyStoreOutput
-}
-
-func (*ToyStore) ElementType() reflect.Type {
-	return reflect.TypeOf((**ToyStore)(nil)).Elem()
-}
-
-func (i *ToyStore) ToToyStoreOutput() ToyStoreOutput {
-	return i.ToToyStoreOutputWithContext(context.Background())
-}
-
-func (i *ToyStore) ToToyStoreOutputWithContext(ctx context.Context) ToyStoreOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ToyStoreOutput)
-}
-
-// ToyStoreArrayInput is an input type that accepts ToyStoreArray and ToyStoreArrayOutput values.
-// You can construct a concrete instance of `ToyStoreArrayInput` via:
-//
-//	ToyStoreArray{ ToyStoreArgs{...} }
-type ToyStoreArrayInput interface {
-	pulumi.Input
-
-	ToToyStoreArrayOutput() ToyStoreArrayOutput
-	ToToyStoreArrayOutputWithContext(context.Context) ToyStoreArrayOutput
-}
-
-type ToyStoreArray []ToyStoreInput
-
-func (ToyStoreArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ToyStore)(nil)).Elem()
-}
-
-func (i ToyStoreArray) ToToyStoreArrayOutput() ToyStoreArrayOutput {
-	return i.ToToyStoreArrayOutputWithContext(context.Background())
-}
-
-func (i ToyStoreArray) ToToyStoreArrayOutputWithContext(ctx context.Context) ToyStoreArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ToyStoreArrayOutput)
-}
-
-// ToyStoreMapInput is an input type that accepts ToyStoreMap and ToyStoreMapOutput values.
-// You can construct a concrete instance of `ToyStoreMapInput` via:
-//
-//	ToyStoreMap{ "key": ToyStoreArgs{...} }
-type ToyStoreMapInput interface {
-	pulumi.Input
-
-	ToToyStoreMapOutput() ToyStoreMapOutput
-	ToToyStoreMapOutputWithContext(context.Context) ToyStoreMapOutput
-}
-
-type ToyStoreMap map[string]ToyStoreInput
-
-func (ToyStoreMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ToyStore)(nil)).Elem()
-}
-
-func (i ToyStoreMap) ToToyStoreMapOutput() ToyStoreMapOutput {
-	return i.ToToyStoreMapOutputWithContext(context.Background())
-}
-
-func (i ToyStoreMap) ToToyStoreMapOutputWithContext(ctx context.Context) ToyStoreMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ToyStoreMapOutput)
-}
-
 type ToyStoreOutput struct{ *pulumi.OutputState }
 
 func (ToyStoreOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ToyStore)(nil)).Elem()
+	return reflect.TypeOf((*ToyStore)(nil)).Elem()
 }
 
 func (o ToyStoreOutput) ToToyStoreOutput() ToyStoreOutput {
@@ -161,64 +93,36 @@ func (o ToyStoreOutput) ToToyStoreOutputWithContext(ctx context.Context) ToyStor
 	return o
 }
 
-func (o ToyStoreOutput) Chew() ChewPtrOutput {
-	return o.ApplyT(func(v *ToyStore) ChewPtrOutput { return v.Chew }).(ChewPtrOutput)
-}
-
-func (o ToyStoreOutput) Laser() LaserPtrOutput {
-	return o.ApplyT(func(v *ToyStore) LaserPtrOutput { return v.Laser }).(LaserPtrOutput)
-}
-
-func (o ToyStoreOutput) Stuff() ToyArrayOutput {
-	return o.ApplyT(func(v *ToyStore) ToyArrayOutput { return v.Stuff }).(ToyArrayOutput)
-}
-
-func (o ToyStoreOutput) Wanted() ToyArrayOutput {
-	return o.ApplyT(func(v *ToyStore) ToyArrayOutput { return v.Wanted }).(ToyArrayOutput)
-}
-
-type ToyStoreArrayOutput struct{ *pulumi.OutputState }
-
-func (ToyStoreArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]*ToyStore)(nil)).Elem()
-}
-
-func (o ToyStoreArrayOutput) ToToyStoreArrayOutput() ToyStoreArrayOutput {
-	return o
-}
-
-func (o ToyStoreArrayOutput) ToToyStoreArrayOutputWithContext(ctx context.Context) ToyStoreArrayOutput {
-	return o
-}
-
-func (o ToyStoreArrayOutput) Index(i pulumi.IntInput) ToyStoreOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ToyStore {
-		return vs[0].([]*ToyStore)[vs[1].(int)]
-	}).(ToyStoreOutput)
+func (o ToyStoreOutput) ToOutput(ctx context.Context) pulumix.Output[ToyStore] {
+	return pulumix.Output[ToyStore]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ToyStoreMapOutput struct{ *pulumi.OutputState }
-
-func (ToyStoreMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string]*ToyStore)(nil)).Elem()
+func (o ToyStoreOutput) Chew() pulumix.GPtrOutput[Chew, ChewOutput] {
+	value := pulumix.Apply[ToyStore](o, func(v ToyStore) pulumix.GPtrOutput[Chew, ChewOutput] { return v.Chew })
+	unwrapped := pulumix.Flatten[*Chew, pulumix.GPtrOutput[Chew, ChewOutput]](value)
+	return pulumix.GPtrOutput[Chew, ChewOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ToyStoreMapOutput) ToToyStoreMapOutput() ToyStoreMapOutput {
-	return o
+func (o ToyStoreOutput) Laser() pulumix.GPtrOutput[Laser, LaserOutput] {
+	value := pulumix.Apply[ToyStore](o, func(v ToyStore) pulumix.GPtrOutput[Laser, LaserOutput] { return v.Laser })
+	unwrapped := pulumix.Flatten[*Laser, pulumix.GPtrOutput[Laser, LaserOutput]](value)
+	return pulumix.GPtrOutput[Laser, LaserOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ToyStoreMapOutput) ToToyStoreMapOutputWithContext(ctx context.Context) ToyStoreMapOutput {
-	return o
+func (o ToyStoreOutput) Stuff() pulumix.GArrayOutput[Toy, ToyOutput] {
+	value := pulumix.Apply[ToyStore](o, func(v ToyStore) pulumix.GArrayOutput[Toy, ToyOutput] { return v.Stuff })
+	unwrapped := pulumix.Flatten[[]Toy, pulumix.GArrayOutput[Toy, ToyOutput]](value)
+	return pulumix.GArrayOutput[Toy, ToyOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ToyStoreMapOutput) MapIndex(k pulumi.StringInput) ToyStoreOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ToyStore {
-		return vs[0].(map[string]*ToyStore)[vs[1].(string)]
-	}).(ToyStoreOutput)
+func (o ToyStoreOutput) Wanted() pulumix.GArrayOutput[Toy, ToyOutput] {
+	value := pulumix.Apply[ToyStore](o, func(v ToyStore) pulumix.GArrayOutput[Toy, ToyOutput] { return v.Wanted })
+	unwrapped := pulumix.Flatten[[]Toy, pulumix.GArrayOutput[Toy, ToyOutput]](value)
+	return pulumix.GArrayOutput[Toy, ToyOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
 	pulumi.RegisterOutputType(ToyStoreOutput{})
-	pulumi.RegisterOutputType(ToyStoreArrayOutput{})
-	pulumi.RegisterOutputType(ToyStoreMapOutput{})
 }
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
+
+	"simple-enum-schema-go-generics-only/plant"
+	tree "simple-enum-schema-go-generics-only/plant/tree/v1"
+)
+
+// The compatibility shims let programs written against the legacy variant of the SDK refer to its Input, Output and
+// Ptr types, and pass enum values directly as inputs.
+func TestCompatibilityShims(t *testing.T) {
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		var size plant.ContainerSizeInput = plant.ContainerSizeContainerSizeFourInch
+		var container plant.ContainerPtrInput = &plant.ContainerArgs{
+			Color:    pulumix.Ptr("red"),
+			Material: pulumix.Ptr("ceramic"),
+			Size:     size,
+		}
+		rubberTree, err := tree.NewRubberTree(ctx, "blah", &tree.RubberTreeArgs{
+			Container: container,
+			Farm:      pulumix.Ptr(string(tree.Farm_Farm_Plants_R_Us)),
+			Size:      tree.TreeSizePtr("small"),
+			Type:      tree.RubberTreeVarietyRubberTreeVarietyRuby,
+		})
+		require.NoError(t, err)
+
+		var containerOutput plant.ContainerPtrOutput = rubberTree.Container
+		var typeOutput tree.RubberTreeVarietyOutput = rubberTree.Type
+		var sizeOutput tree.TreeSizePtrOutput = rubberTree.Size
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(
+			rubberTree.URN(),
+			containerOutput.Elem().Material(),
+			containerOutput.Elem().Size(),
+			typeOutput,
+			sizeOutput,
+		).ApplyT(func(all []interface{}) error {
+			urn := all[0].(pulumi.URN)
+			assert.Equal(t, "ceramic", *all[1].(*string), "unexpected material on resource: %v", urn)
+			assert.Equal(t, plant.ContainerSizeContainerSizeFourInch, all[2].(plant.ContainerSize),
+				"unexpected container size on resource: %v", urn)
+			assert.Equal(t, tree.RubberTreeVarietyRubberTreeVarietyRuby, all[3].(tree.RubberTreeVariety),
+				"unexpected type on resource: %v", urn)
+			assert.Equal(t, tree.TreeSizeTreeSizeSmall, *all[4].(*tree.TreeSize), "unexpected size on resource: %v", urn)
+			wg.Done()
+			return nil
+		})
+		wg.Wait()
+
+		nursery, err := tree.NewNursery(ctx, "nursery", &tree.NurseryArgs{
+			Varieties: pulumix.Val([]tree.RubberTreeVariety{tree.RubberTreeVarietyRubberTreeVarietyBurgundy}),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, nursery)
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks(0))))
+}
+
+type mocks int
+
+func (mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return args.Name + "_id", args.Inputs, nil
+}
+
+func (mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return args.Args, nil
+}
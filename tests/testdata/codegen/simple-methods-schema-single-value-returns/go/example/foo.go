@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-methods-schema-single-value-returns/example/internal"
 )
 
@@ -42,23 +43,23 @@ func (FooArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooArgs)(nil)).Elem()
 }
 
-func (r *Foo) GetKubeconfig(ctx *pulumi.Context, args *FooGetKubeconfigArgs) (pulumi.StringOutput, error) {
+func (r *Foo) GetKubeconfig(ctx *pulumi.Context, args *FooGetKubeconfigArgs) (pulumix.Output[string], error) {
 	out, err := ctx.Call("example::Foo/getKubeconfig", args, fooGetKubeconfigResultOutput{}, r)
 	if err != nil {
-		return pulumi.StringOutput{}, err
+		return pulumix.Output[string]{}, err
 	}
 	return out.(fooGetKubeconfigResultOutput).Kubeconfig(), nil
 }
 
 type fooGetKubeconfigArgs struct {
-	ProfileName *string `pulumi:"profileName"`
-	RoleArn     *string `pulumi:"roleArn"`
+	ProfileName pulumix.Input[*string] `pulumi:"profileName"`
+	RoleArn     pulumix.Input[*string] `pulumi:"roleArn"`
 }
 
 // The set of arguments for the GetKubeconfig method of the Foo resource.
 type FooGetKubeconfigArgs struct {
-	ProfileName pulumi.StringPtrInput
-	RoleArn     pulumi.StringPtrInput
+	ProfileName pulumix.Input[*string]
+	RoleArn     pulumix.Input[*string]
 }
 
 func (FooGetKubeconfigArgs) ElementType() reflect.Type {
@@ -75,33 +76,15 @@ func (fooGetKubeconfigResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooGetKubeconfigResult)(nil)).Elem()
 }
 
-func (o fooGetKubeconfigResultOutput) Kubeconfig() pulumi.StringOutput {
-	return o.ApplyT(func(v fooGetKubeconfigResult) string { return v.Kubeconfig }).(pulumi.StringOutput)
-}
-
-type FooInput interface {
-	pulumi.Input
-
-	ToFooOutput() FooOutput
-	ToFooOutputWithContext(ctx context.Context) FooOutput
-}
-
-func (*Foo) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
-}
-
-func (i *Foo) ToFooOutput() FooOutput {
-	return i.ToFooOutputWithContext(context.Background())
-}
-
-func (i *Foo) ToFooOutputWithContext(ctx context.Context) FooOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooOutput)
+func (o fooGetKubeconfigResultOutput) Kubeconfig() pulumix.Output[string] {
+	value := pulumix.Apply[fooGetKubeconfigResult](pulumix.Output[fooGetKubeconfigResult]{OutputState: o.OutputState}, func(v fooGetKubeconfigResult) string { return v.Kubeconfig })
+	return value
 }
 
 type FooOutput struct{ *pulumi.OutputState }
 
 func (FooOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
+	return reflect.TypeOf((*Foo)(nil)).Elem()
 }
 
 func (o FooOutput) ToFooOutput() FooOutput {
@@ -112,8 +95,13 @@ func (o FooOutput) ToFooOutputWithContext(ctx context.Context) FooOutput {
 	return o
 }
 
+func (o FooOutput) ToOutput(ctx context.Context) pulumix.Output[Foo] {
+	return pulumix.Output[Foo]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FooInput)(nil)).Elem(), &Foo{})
 	pulumi.RegisterOutputType(FooOutput{})
 	pulumi.RegisterOutputType(fooGetKubeconfigResultOutput{})
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/test/testdata/simple-methods-schema/go/example/internal"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/testing/test/testdata/simple-methods-schema/go/example/nested"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 )
 
 type Foo struct {
@@ -54,34 +55,34 @@ func (r *Foo) Bar(ctx *pulumi.Context, args *FooBarArgs) (FooBarResultOutput, er
 }
 
 type fooBarArgs struct {
-	Baz                 *nested.Baz       `pulumi:"baz"`
-	BazPlain            *nested.Baz       `pulumi:"bazPlain"`
-	BazRequired         nested.Baz        `pulumi:"bazRequired"`
-	BoolValue           *bool             `pulumi:"boolValue"`
-	BoolValuePlain      *bool             `pulumi:"boolValuePlain"`
-	BoolValueRequired   bool              `pulumi:"boolValueRequired"`
-	Name                *random.RandomPet `pulumi:"name"`
-	NamePlain           *random.RandomPet `pulumi:"namePlain"`
-	NameRequired        *random.RandomPet `pulumi:"nameRequired"`
-	StringValue         *string           `pulumi:"stringValue"`
-	StringValuePlain    *string           `pulumi:"stringValuePlain"`
-	StringValueRequired string            `pulumi:"stringValueRequired"`
+	Baz                 pulumix.Input[*nested.Baz]       `pulumi:"baz"`
+	BazPlain            pulumix.Input[*nested.Baz]       `pulumi:"bazPlain"`
+	BazRequired         pulumix.Input[*nested.Baz]       `pulumi:"bazRequired"`
+	BoolValue           pulumix.Input[*bool]             `pulumi:"boolValue"`
+	BoolValuePlain      pulumix.Input[*bool]             `pulumi:"boolValuePlain"`
+	BoolValueRequired   pulumix.Input[bool]              `pulumi:"boolValueRequired"`
+	Name                pulumix.Input[*random.RandomPet] `pulumi:"name"`
+	NamePlain           pulumix.Input[*random.RandomPet] `pulumi:"namePlain"`
+	NameRequired        pulumix.Input[random.RandomPet]  `pulumi:"nameRequired"`
+	StringValue         pulumix.Input[*string]           `pulumi:"stringValue"`
+	StringValuePlain    pulumix.Input[*string]           `pulumi:"stringValuePlain"`
+	StringValueRequired pulumix.Input[string]            `pulumi:"stringValueRequired"`
 }
 
 // The set of arguments for the Bar method of the Foo resource.
 type FooBarArgs struct {
-	Baz                 nested.BazPtrInput
-	BazPlain            *nested.BazArgs
-	BazRequired         nested.BazInput
-	BoolValue           pulumi.BoolPtrInput
-	BoolValuePlain      *bool
-	BoolValueRequired   pulumi.BoolInput
-	Name                random.RandomPetInput
-	NamePlain           *random.RandomPet
-	NameRequired        random.RandomPetInput
-	StringValue         pulumi.StringPtrInput
-	StringValuePlain    *string
-	StringValueRequired pulumi.StringInput
+	Baz                 pulumix.Input[*nested.Baz]
+	BazPlain            pulumix.Input[*nested.Baz]
+	BazRequired         pulumix.Input[*nested.Baz]
+	BoolValue           pulumix.Input[*bool]
+	BoolValuePlain      pulumix.Input[*bool]
+	BoolValueRequired   pulumix.Input[bool]
+	Name                pulumix.Input[*random.RandomPet]
+	NamePlain           pulumix.Input[*random.RandomPet]
+	NameRequired        pulumix.Input[random.RandomPet]
+	StringValue         pulumix.Input[*string]
+	StringValuePlain    pulumix.Input[*string]
+	StringValueRequired pulumix.Input[string]
 }
 
 func (FooBarArgs) ElementType() reflect.Type {
@@ -98,8 +99,9 @@ func (FooBarResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*FooBarResult)(nil)).Elem()
 }
 
-func (o FooBarResultOutput) SomeValue() pulumi.StringOutput {
-	return o.ApplyT(func(v FooBarResult) string { return v.SomeValue }).(pulumi.StringOutput)
+func (o FooBarResultOutput) SomeValue() pulumix.Output[string] {
+	value := pulumix.Apply[FooBarResult](pulumix.Output[FooBarResult]{OutputState: o.OutputState}, func(v FooBarResult) string { return v.SomeValue })
+	return value
 }
 
 func (r *Foo) Baz(ctx *pulumi.Context) error {
@@ -117,12 +119,12 @@ func (r *Foo) GenerateKubeconfig(ctx *pulumi.Context, args *FooGenerateKubeconfi
 }
 
 type fooGenerateKubeconfigArgs struct {
-	BoolValue bool `pulumi:"boolValue"`
+	BoolValue pulumix.Input[bool] `pulumi:"boolValue"`
 }
 
 // The set of arguments for the GenerateKubeconfig method of the Foo resource.
 type FooGenerateKubeconfigArgs struct {
-	BoolValue bool
+	BoolValue pulumix.Input[bool]
 }
 
 func (FooGenerateKubeconfigArgs) ElementType() reflect.Type {
@@ -139,33 +141,15 @@ func (FooGenerateKubeconfigResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*FooGenerateKubeconfigResult)(nil)).Elem()
 }
 
-func (o FooGenerateKubeconfigResultOutput) Kubeconfig() pulumi.StringOutput {
-	return o.ApplyT(func(v FooGenerateKubeconfigResult) string { return v.Kubeconfig }).(pulumi.StringOutput)
-}
-
-type FooInput interface {
-	pulumi.Input
-
-	ToFooOutput() FooOutput
-	ToFooOutputWithContext(ctx context.Context) FooOutput
-}
-
-func (*Foo) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
-}
-
-func (i *Foo) ToFooOutput() FooOutput {
-	return i.ToFooOutputWithContext(context.Background())
-}
-
-func (i *Foo) ToFooOutputWithContext(ctx context.Context) FooOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooOutput)
+func (o FooGenerateKubeconfigResultOutput) Kubeconfig() pulumix.Output[string] {
+	value := pulumix.Apply[FooGenerateKubeconfigResult](pulumix.Output[FooGenerateKubeconfigResult]{OutputState: o.OutputState}, func(v FooGenerateKubeconfigResult) string { return v.Kubeconfig })
+	return value
 }
 
 type FooOutput struct{ *pulumi.OutputState }
 
 func (FooOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Foo)(nil)).Elem()
+	return reflect.TypeOf((*Foo)(nil)).Elem()
 }
 
 func (o FooOutput) ToFooOutput() FooOutput {
@@ -176,8 +160,13 @@ func (o FooOutput) ToFooOutputWithContext(ctx context.Context) FooOutput {
 	return o
 }
 
+func (o FooOutput) ToOutput(ctx context.Context) pulumix.Output[Foo] {
+	return pulumix.Output[Foo]{
+		OutputState: o.OutputState,
+	}
+}
+
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FooInput)(nil)).Elem(), &Foo{})
 	pulumi.RegisterOutputType(FooOutput{})
 	pulumi.RegisterOutputType(FooBarResultOutput{})
 	pulumi.RegisterOutputType(FooGenerateKubeconfigResultOutput{})
@@ -9,21 +9,22 @@ import (
 
 	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-plain-schema-with-root-package/internal"
 )
 
 type Component struct {
 	pulumi.ResourceState
 
-	A   pulumi.BoolOutput      `pulumi:"a"`
-	B   pulumi.BoolPtrOutput   `pulumi:"b"`
-	Bar FooPtrOutput           `pulumi:"bar"`
-	Baz FooArrayOutput         `pulumi:"baz"`
-	C   pulumi.IntOutput       `pulumi:"c"`
-	D   pulumi.IntPtrOutput    `pulumi:"d"`
-	E   pulumi.StringOutput    `pulumi:"e"`
-	F   pulumi.StringPtrOutput `pulumi:"f"`
-	Foo FooPtrOutput           `pulumi:"foo"`
+	A   pulumix.Output[bool]                 `pulumi:"a"`
+	B   pulumix.Output[*bool]                `pulumi:"b"`
+	Bar pulumix.GPtrOutput[Foo, FooOutput]   `pulumi:"bar"`
+	Baz pulumix.GArrayOutput[Foo, FooOutput] `pulumi:"baz"`
+	C   pulumix.Output[int]                  `pulumi:"c"`
+	D   pulumix.Output[*int]                 `pulumi:"d"`
+	E   pulumix.Output[string]               `pulumi:"e"`
+	F   pulumix.Output[*string]              `pulumi:"f"`
+	Foo pulumix.GPtrOutput[Foo, FooOutput]   `pulumi:"foo"`
 }
 
 // NewComponent registers a new resource with the given unique name, arguments, and options.
@@ -58,42 +59,23 @@ type componentArgs struct {
 type ComponentArgs struct {
 	A   bool
 	B   *bool
-	Bar *FooArgs
-	Baz []FooInput
+	Bar *Foo
+	Baz []Foo
 	C   int
 	D   *int
 	E   string
 	F   *string
-	Foo FooPtrInput
+	Foo pulumix.Input[*FooArgs]
 }
 
 func (ComponentArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*componentArgs)(nil)).Elem()
 }
 
-type ComponentInput interface {
-	pulumi.Input
-
-	ToComponentOutput() ComponentOutput
-	ToComponentOutputWithContext(ctx context.Context) ComponentOutput
-}
-
-func (*Component) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
-}
-
-func (i *Component) ToComponentOutput() ComponentOutput {
-	return i.ToComponentOutputWithContext(context.Background())
-}
-
-func (i *Component) ToComponentOutputWithContext(ctx context.Context) ComponentOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ComponentOutput)
-}
-
 type ComponentOutput struct{ *pulumi.OutputState }
 
 func (ComponentOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Component)(nil)).Elem()
+	return reflect.TypeOf((*Component)(nil)).Elem()
 }
 
 func (o ComponentOutput) ToComponentOutput() ComponentOutput {
@@ -104,43 +86,60 @@ func (o ComponentOutput) ToComponentOutputWithContext(ctx context.Context) Compo
 	return o
 }
 
-func (o ComponentOutput) A() pulumi.BoolOutput {
-	return o.ApplyT(func(v *Component) pulumi.BoolOutput { return v.A }).(pulumi.BoolOutput)
+func (o ComponentOutput) ToOutput(ctx context.Context) pulumix.Output[Component] {
+	return pulumix.Output[Component]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ComponentOutput) A() pulumix.Output[bool] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[bool] { return v.A })
+	return pulumix.Flatten[bool, pulumix.Output[bool]](value)
 }
 
-func (o ComponentOutput) B() pulumi.BoolPtrOutput {
-	return o.ApplyT(func(v *Component) pulumi.BoolPtrOutput { return v.B }).(pulumi.BoolPtrOutput)
+func (o ComponentOutput) B() pulumix.Output[*bool] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[*bool] { return v.B })
+	return pulumix.Flatten[*bool, pulumix.Output[*bool]](value)
 }
 
-func (o ComponentOutput) Bar() FooPtrOutput {
-	return o.ApplyT(func(v *Component) FooPtrOutput { return v.Bar }).(FooPtrOutput)
+func (o ComponentOutput) Bar() pulumix.GPtrOutput[Foo, FooOutput] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.GPtrOutput[Foo, FooOutput] { return v.Bar })
+	unwrapped := pulumix.Flatten[*Foo, pulumix.GPtrOutput[Foo, FooOutput]](value)
+	return pulumix.GPtrOutput[Foo, FooOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ComponentOutput) Baz() FooArrayOutput {
-	return o.ApplyT(func(v *Component) FooArrayOutput { return v.Baz }).(FooArrayOutput)
+func (o ComponentOutput) Baz() pulumix.GArrayOutput[Foo, FooOutput] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.GArrayOutput[Foo, FooOutput] { return v.Baz })
+	unwrapped := pulumix.Flatten[[]Foo, pulumix.GArrayOutput[Foo, FooOutput]](value)
+	return pulumix.GArrayOutput[Foo, FooOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o ComponentOutput) C() pulumi.IntOutput {
-	return o.ApplyT(func(v *Component) pulumi.IntOutput { return v.C }).(pulumi.IntOutput)
+func (o ComponentOutput) C() pulumix.Output[int] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[int] { return v.C })
+	return pulumix.Flatten[int, pulumix.Output[int]](value)
 }
 
-func (o ComponentOutput) D() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *Component) pulumi.IntPtrOutput { return v.D }).(pulumi.IntPtrOutput)
+func (o ComponentOutput) D() pulumix.Output[*int] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[*int] { return v.D })
+	return pulumix.Flatten[*int, pulumix.Output[*int]](value)
 }
 
-func (o ComponentOutput) E() pulumi.StringOutput {
-	return o.ApplyT(func(v *Component) pulumi.StringOutput { return v.E }).(pulumi.StringOutput)
+func (o ComponentOutput) E() pulumix.Output[string] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[string] { return v.E })
+	return pulumix.Flatten[string, pulumix.Output[string]](value)
 }
 
-func (o ComponentOutput) F() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Component) pulumi.StringPtrOutput { return v.F }).(pulumi.StringPtrOutput)
+func (o ComponentOutput) F() pulumix.Output[*string] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.Output[*string] { return v.F })
+	return pulumix.Flatten[*string, pulumix.Output[*string]](value)
 }
 
-func (o ComponentOutput) Foo() FooPtrOutput {
-	return o.ApplyT(func(v *Component) FooPtrOutput { return v.Foo }).(FooPtrOutput)
+func (o ComponentOutput) Foo() pulumix.GPtrOutput[Foo, FooOutput] {
+	value := pulumix.Apply[Component](o, func(v Component) pulumix.GPtrOutput[Foo, FooOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Foo, pulumix.GPtrOutput[Foo, FooOutput]](value)
+	return pulumix.GPtrOutput[Foo, FooOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ComponentInput)(nil)).Elem(), &Component{})
 	pulumi.RegisterOutputType(ComponentOutput{})
 }
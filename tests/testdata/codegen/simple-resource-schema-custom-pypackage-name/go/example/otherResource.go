@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-resource-schema-custom-pypackage-name/example/internal"
 )
 
 type OtherResource struct {
 	pulumi.ResourceState
 
-	Foo ResourceOutput `pulumi:"foo"`
+	Foo pulumix.GPtrOutput[Resource, ResourceOutput] `pulumi:"foo"`
 }
 
 // NewOtherResource registers a new resource with the given unique name, arguments, and options.
@@ -39,36 +40,17 @@ type otherResourceArgs struct {
 
 // The set of arguments for constructing a OtherResource resource.
 type OtherResourceArgs struct {
-	Foo ResourceInput
+	Foo pulumix.Input[*Resource]
 }
 
 func (OtherResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*otherResourceArgs)(nil)).Elem()
 }
 
-type OtherResourceInput interface {
-	pulumi.Input
-
-	ToOtherResourceOutput() OtherResourceOutput
-	ToOtherResourceOutputWithContext(ctx context.Context) OtherResourceOutput
-}
-
-func (*OtherResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**OtherResource)(nil)).Elem()
-}
-
-func (i *OtherResource) ToOtherResourceOutput() OtherResourceOutput {
-	return i.ToOtherResourceOutputWithContext(context.Background())
-}
-
-func (i *OtherResource) ToOtherResourceOutputWithContext(ctx context.Context) OtherResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(OtherResourceOutput)
-}
-
 type OtherResourceOutput struct{ *pulumi.OutputState }
 
 func (OtherResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**OtherResource)(nil)).Elem()
+	return reflect.TypeOf((*OtherResource)(nil)).Elem()
 }
 
 func (o OtherResourceOutput) ToOtherResourceOutput() OtherResourceOutput {
@@ -79,11 +61,18 @@ func (o OtherResourceOutput) ToOtherResourceOutputWithContext(ctx context.Contex
 	return o
 }
 
-func (o OtherResourceOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v *OtherResource) ResourceOutput { return v.Foo }).(ResourceOutput)
+func (o OtherResourceOutput) ToOutput(ctx context.Context) pulumix.Output[OtherResource] {
+	return pulumix.Output[OtherResource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o OtherResourceOutput) Foo() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[OtherResource](o, func(v OtherResource) pulumix.GPtrOutput[Resource, ResourceOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Resource, pulumix.GPtrOutput[Resource, ResourceOutput]](value)
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*OtherResourceInput)(nil)).Elem(), &OtherResource{})
 	pulumi.RegisterOutputType(OtherResourceOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-resource-schema-custom-pypackage-name/example/internal"
 )
 
@@ -17,19 +18,8 @@ type ConfigMap struct {
 	Config *string `pulumi:"config"`
 }
 
-// ConfigMapInput is an input type that accepts ConfigMap and ConfigMapOutput values.
-// You can construct a concrete instance of `ConfigMapInput` via:
-//
-//	ConfigMap{ "key": ConfigArgs{...} }
-type ConfigMapInput interface {
-	pulumi.Input
-
-	ToConfigMapOutput() ConfigMapOutput
-	ToConfigMapOutputWithContext(context.Context) ConfigMapOutput
-}
-
 type ConfigMapArgs struct {
-	Config pulumi.StringPtrInput `pulumi:"config"`
+	Config pulumix.Input[*string] `pulumi:"config"`
 }
 
 func (ConfigMapArgs) ElementType() reflect.Type {
@@ -44,29 +34,8 @@ func (i ConfigMapArgs) ToConfigMapOutputWithContext(ctx context.Context) ConfigM
 	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapOutput)
 }
 
-// ConfigMapArrayInput is an input type that accepts ConfigMapArray and ConfigMapArrayOutput values.
-// You can construct a concrete instance of `ConfigMapArrayInput` via:
-//
-//	ConfigMapArray{ ConfigMapArgs{...} }
-type ConfigMapArrayInput interface {
-	pulumi.Input
-
-	ToConfigMapArrayOutput() ConfigMapArrayOutput
-	ToConfigMapArrayOutputWithContext(context.Context) ConfigMapArrayOutput
-}
-
-type ConfigMapArray []ConfigMapInput
-
-func (ConfigMapArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigMap)(nil)).Elem()
-}
-
-func (i ConfigMapArray) ToConfigMapArrayOutput() ConfigMapArrayOutput {
-	return i.ToConfigMapArrayOutputWithContext(context.Background())
-}
-
-func (i ConfigMapArray) ToConfigMapArrayOutputWithContext(ctx context.Context) ConfigMapArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapArrayOutput)
+func (i *ConfigMapArgs) ToOutput(ctx context.Context) pulumix.Output[*ConfigMapArgs] {
+	return pulumix.Val(i)
 }
 
 type ConfigMapOutput struct{ *pulumi.OutputState }
@@ -83,59 +52,34 @@ func (o ConfigMapOutput) ToConfigMapOutputWithContext(ctx context.Context) Confi
 	return o
 }
 
-func (o ConfigMapOutput) Config() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ConfigMap) *string { return v.Config }).(pulumi.StringPtrOutput)
-}
-
-type ConfigMapArrayOutput struct{ *pulumi.OutputState }
-
-func (ConfigMapArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigMap)(nil)).Elem()
-}
-
-func (o ConfigMapArrayOutput) ToConfigMapArrayOutput() ConfigMapArrayOutput {
-	return o
-}
-
-func (o ConfigMapArrayOutput) ToConfigMapArrayOutputWithContext(ctx context.Context) ConfigMapArrayOutput {
-	return o
+func (o ConfigMapOutput) ToOutput(ctx context.Context) pulumix.Output[ConfigMap] {
+	return pulumix.Output[ConfigMap]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ConfigMapArrayOutput) Index(i pulumi.IntInput) ConfigMapOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ConfigMap {
-		return vs[0].([]ConfigMap)[vs[1].(int)]
-	}).(ConfigMapOutput)
+func (o ConfigMapOutput) Config() pulumix.Output[*string] {
+	return pulumix.Apply[ConfigMap](o, func(v ConfigMap) *string { return v.Config })
 }
 
 type Object struct {
-	Bar     *string     `pulumi:"bar"`
-	Configs []ConfigMap `pulumi:"configs"`
-	Foo     *Resource   `pulumi:"foo"`
+	Bar     *string      `pulumi:"bar"`
+	Configs []*ConfigMap `pulumi:"configs"`
+	Foo     *Resource    `pulumi:"foo"`
 	// List of lists of other objects
-	Others [][]SomeOtherObject `pulumi:"others"`
+	Others [][]*SomeOtherObject `pulumi:"others"`
 	// Mapping from string to list of some other object
-	StillOthers map[string][]SomeOtherObject `pulumi:"stillOthers"`
-}
-
-// ObjectInput is an input type that accepts ObjectArgs and ObjectOutput values.
-// You can construct a concrete instance of `ObjectInput` via:
-//
-//	ObjectArgs{...}
-type ObjectInput interface {
-	pulumi.Input
-
-	ToObjectOutput() ObjectOutput
-	ToObjectOutputWithContext(context.Context) ObjectOutput
+	StillOthers map[string][]*SomeOtherObject `pulumi:"stillOthers"`
 }
 
 type ObjectArgs struct {
-	Bar     pulumi.StringPtrInput `pulumi:"bar"`
-	Configs ConfigMapArrayInput   `pulumi:"configs"`
-	Foo     ResourceInput         `pulumi:"foo"`
+	Bar     pulumix.Input[*string]          `pulumi:"bar"`
+	Configs pulumix.Input[[]*ConfigMapArgs] `pulumi:"configs"`
+	Foo     pulumix.Input[*Resource]        `pulumi:"foo"`
 	// List of lists of other objects
-	Others SomeOtherObjectArrayArrayInput `pulumi:"others"`
+	Others pulumix.Input[[][]*SomeOtherObjectArgs] `pulumi:"others"`
 	// Mapping from string to list of some other object
-	StillOthers SomeOtherObjectArrayMapInput `pulumi:"stillOthers"`
+	StillOthers pulumix.Input[map[string][]*SomeOtherObjectArgs] `pulumi:"stillOthers"`
 }
 
 func (ObjectArgs) ElementType() reflect.Type {
@@ -150,6 +94,10 @@ func (i ObjectArgs) ToObjectOutputWithContext(ctx context.Context) ObjectOutput
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectOutput)
 }
 
+func (i *ObjectArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectArgs] {
+	return pulumix.Val(i)
+}
+
 type ObjectOutput struct{ *pulumi.OutputState }
 
 func (ObjectOutput) ElementType() reflect.Type {
@@ -164,45 +112,44 @@ func (o ObjectOutput) ToObjectOutputWithContext(ctx context.Context) ObjectOutpu
 	return o
 }
 
-func (o ObjectOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Object) *string { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ObjectOutput) ToOutput(ctx context.Context) pulumix.Output[Object] {
+	return pulumix.Output[Object]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ObjectOutput) Bar() pulumix.Output[*string] {
+	return pulumix.Apply[Object](o, func(v Object) *string { return v.Bar })
 }
 
-func (o ObjectOutput) Configs() ConfigMapArrayOutput {
-	return o.ApplyT(func(v Object) []ConfigMap { return v.Configs }).(ConfigMapArrayOutput)
+func (o ObjectOutput) Configs() pulumix.GArrayOutput[ConfigMap, ConfigMapOutput] {
+	value := pulumix.Apply[Object](o, func(v Object) []*ConfigMap { return v.Configs })
+	return pulumix.GArrayOutput[ConfigMap, ConfigMapOutput]{OutputState: value.OutputState}
 }
 
-func (o ObjectOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v Object) *Resource { return v.Foo }).(ResourceOutput)
+func (o ObjectOutput) Foo() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[Object](o, func(v Object) *Resource { return v.Foo })
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{OutputState: value.OutputState}
 }
 
 // List of lists of other objects
-func (o ObjectOutput) Others() SomeOtherObjectArrayArrayOutput {
-	return o.ApplyT(func(v Object) [][]SomeOtherObject { return v.Others }).(SomeOtherObjectArrayArrayOutput)
+func (o ObjectOutput) Others() pulumix.ArrayOutput[[]SomeOtherObject] {
+	value := pulumix.Apply[Object](o, func(v Object) [][]*SomeOtherObject { return v.Others })
+	return pulumix.ArrayOutput[[]SomeOtherObject]{OutputState: value.OutputState}
 }
 
 // Mapping from string to list of some other object
-func (o ObjectOutput) StillOthers() SomeOtherObjectArrayMapOutput {
-	return o.ApplyT(func(v Object) map[string][]SomeOtherObject { return v.StillOthers }).(SomeOtherObjectArrayMapOutput)
+func (o ObjectOutput) StillOthers() pulumix.MapOutput[[]SomeOtherObject] {
+	value := pulumix.Apply[Object](o, func(v Object) map[string][]*SomeOtherObject { return v.StillOthers })
+	return pulumix.MapOutput[[]SomeOtherObject]{OutputState: value.OutputState}
 }
 
 type OtherResourceOutputType struct {
 	Foo *string `pulumi:"foo"`
 }
 
-// OtherResourceOutputTypeInput is an input type that accepts OtherResourceOutputTypeArgs and OtherResourceOutputTypeOutput values.
-// You can construct a concrete instance of `OtherResourceOutputTypeInput` via:
-//
-//	OtherResourceOutputTypeArgs{...}
-type OtherResourceOutputTypeInput interface {
-	pulumi.Input
-
-	ToOtherResourceOutputTypeOutput() OtherResourceOutputTypeOutput
-	ToOtherResourceOutputTypeOutputWithContext(context.Context) OtherResourceOutputTypeOutput
-}
-
 type OtherResourceOutputTypeArgs struct {
-	Foo pulumi.StringPtrInput `pulumi:"foo"`
+	Foo pulumix.Input[*string] `pulumi:"foo"`
 }
 
 func (OtherResourceOutputTypeArgs) ElementType() reflect.Type {
@@ -217,6 +164,10 @@ func (i OtherResourceOutputTypeArgs) ToOtherResourceOutputTypeOutputWithContext(
 	return pulumi.ToOutputWithContext(ctx, i).(OtherResourceOutputTypeOutput)
 }
 
+func (i *OtherResourceOutputTypeArgs) ToOutput(ctx context.Context) pulumix.Output[*OtherResourceOutputTypeArgs] {
+	return pulumix.Val(i)
+}
+
 type OtherResourceOutputTypeOutput struct{ *pulumi.OutputState }
 
 func (OtherResourceOutputTypeOutput) ElementType() reflect.Type {
@@ -231,27 +182,22 @@ func (o OtherResourceOutputTypeOutput) ToOtherResourceOutputTypeOutputWithContex
 	return o
 }
 
-func (o OtherResourceOutputTypeOutput) Foo() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v OtherResourceOutputType) *string { return v.Foo }).(pulumi.StringPtrOutput)
+func (o OtherResourceOutputTypeOutput) ToOutput(ctx context.Context) pulumix.Output[OtherResourceOutputType] {
+	return pulumix.Output[OtherResourceOutputType]{
+		OutputState: o.OutputState,
+	}
 }
 
-type SomeOtherObject struct {
-	Baz *string `pulumi:"baz"`
+func (o OtherResourceOutputTypeOutput) Foo() pulumix.Output[*string] {
+	return pulumix.Apply[OtherResourceOutputType](o, func(v OtherResourceOutputType) *string { return v.Foo })
 }
 
-// SomeOtherObjectInput is an input type that accepts SomeOtherObjectArgs and SomeOtherObjectOutput values.
-// You can construct a concrete instance of `SomeOtherObjectInput` via:
-//
-//	SomeOtherObjectArgs{...}
-type SomeOtherObjectInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectOutput() SomeOtherObjectOutput
-	ToSomeOtherObjectOutputWithContext(context.Context) SomeOtherObjectOutput
+type SomeOtherObject struct {
+	Baz *string `pulumi:"baz"`
 }
 
 type SomeOtherObjectArgs struct {
-	Baz pulumi.StringPtrInput `pulumi:"baz"`
+	Baz pulumix.Input[*string] `pulumi:"baz"`
 }
 
 func (SomeOtherObjectArgs) ElementType() reflect.Type {
@@ -266,29 +212,8 @@ func (i SomeOtherObjectArgs) ToSomeOtherObjectOutputWithContext(ctx context.Cont
 	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectOutput)
 }
 
-// SomeOtherObjectArrayInput is an input type that accepts SomeOtherObjectArray and SomeOtherObjectArrayOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayInput` via:
-//
-//	SomeOtherObjectArray{ SomeOtherObjectArgs{...} }
-type SomeOtherObjectArrayInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput
-	ToSomeOtherObjectArrayOutputWithContext(context.Context) SomeOtherObjectArrayOutput
-}
-
-type SomeOtherObjectArray []SomeOtherObjectInput
-
-func (SomeOtherObjectArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArray) ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput {
-	return i.ToSomeOtherObjectArrayOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArray) ToSomeOtherObjectArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayOutput)
+func (i *SomeOtherObjectArgs) ToOutput(ctx context.Context) pulumix.Output[*SomeOtherObjectArgs] {
+	return pulumix.Val(i)
 }
 
 type SomeOtherObjectOutput struct{ *pulumi.OutputState }
@@ -305,135 +230,19 @@ func (o SomeOtherObjectOutput) ToSomeOtherObjectOutputWithContext(ctx context.Co
 	return o
 }
 
-func (o SomeOtherObjectOutput) Baz() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v SomeOtherObject) *string { return v.Baz }).(pulumi.StringPtrOutput)
-}
-
-type SomeOtherObjectArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayOutput) ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayOutput) ToSomeOtherObjectArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayOutput) Index(i pulumi.IntInput) SomeOtherObjectOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SomeOtherObject {
-		return vs[0].([]SomeOtherObject)[vs[1].(int)]
-	}).(SomeOtherObjectOutput)
-}
-
-type SomeOtherObjectArrayArray []SomeOtherObjectArrayInput
-
-func (SomeOtherObjectArrayArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[][]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArrayArray) ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput {
-	return i.ToSomeOtherObjectArrayArrayOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArrayArray) ToSomeOtherObjectArrayArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayArrayOutput)
-}
-
-// SomeOtherObjectArrayArrayInput is an input type that accepts SomeOtherObjectArrayArray and SomeOtherObjectArrayArrayOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayArrayInput` via:
-//
-//	SomeOtherObjectArrayArray{ SomeOtherObjectArray{ SomeOtherObjectArgs{...} } }
-type SomeOtherObjectArrayArrayInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput
-	ToSomeOtherObjectArrayArrayOutputWithContext(context.Context) SomeOtherObjectArrayArrayOutput
-}
-
-type SomeOtherObjectArrayArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[][]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayArrayOutput) ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayArrayOutput) ToSomeOtherObjectArrayArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayArrayOutput) Index(i pulumi.IntInput) SomeOtherObjectArrayOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) []SomeOtherObject {
-		return vs[0].([][]SomeOtherObject)[vs[1].(int)]
-	}).(SomeOtherObjectArrayOutput)
-}
-
-type SomeOtherObjectArrayMap map[string]SomeOtherObjectArrayInput
-
-func (SomeOtherObjectArrayMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArrayMap) ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput {
-	return i.ToSomeOtherObjectArrayMapOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArrayMap) ToSomeOtherObjectArrayMapOutputWithContext(ctx context.Context) SomeOtherObjectArrayMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayMapOutput)
-}
-
-// SomeOtherObjectArrayMapInput is an input type that accepts SomeOtherObjectArrayMap and SomeOtherObjectArrayMapOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayMapInput` via:
-//
-//	SomeOtherObjectArrayMap{ "key": SomeOtherObjectArray{ SomeOtherObjectArgs{...} } }
-type SomeOtherObjectArrayMapInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput
-	ToSomeOtherObjectArrayMapOutputWithContext(context.Context) SomeOtherObjectArrayMapOutput
-}
-
-type SomeOtherObjectArrayMapOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayMapOutput) ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayMapOutput) ToSomeOtherObjectArrayMapOutputWithContext(ctx context.Context) SomeOtherObjectArrayMapOutput {
-	return o
+func (o SomeOtherObjectOutput) ToOutput(ctx context.Context) pulumix.Output[SomeOtherObject] {
+	return pulumix.Output[SomeOtherObject]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o SomeOtherObjectArrayMapOutput) MapIndex(k pulumi.StringInput) SomeOtherObjectArrayOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) []SomeOtherObject {
-		return vs[0].(map[string][]SomeOtherObject)[vs[1].(string)]
-	}).(SomeOtherObjectArrayOutput)
+func (o SomeOtherObjectOutput) Baz() pulumix.Output[*string] {
+	return pulumix.Apply[SomeOtherObject](o, func(v SomeOtherObject) *string { return v.Baz })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapInput)(nil)).Elem(), ConfigMapArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapArrayInput)(nil)).Elem(), ConfigMapArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectInput)(nil)).Elem(), ObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*OtherResourceOutputTypeInput)(nil)).Elem(), OtherResourceOutputTypeArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectInput)(nil)).Elem(), SomeOtherObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayInput)(nil)).Elem(), SomeOtherObjectArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayArrayInput)(nil)).Elem(), SomeOtherObjectArrayArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayMapInput)(nil)).Elem(), SomeOtherObjectArrayMap{})
 	pulumi.RegisterOutputType(ConfigMapOutput{})
-	pulumi.RegisterOutputType(ConfigMapArrayOutput{})
 	pulumi.RegisterOutputType(ObjectOutput{})
 	pulumi.RegisterOutputType(OtherResourceOutputTypeOutput{})
 	pulumi.RegisterOutputType(SomeOtherObjectOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayArrayOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayMapOutput{})
 }
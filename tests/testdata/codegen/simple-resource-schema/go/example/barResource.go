@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-resource-schema/example/internal"
 )
 
 type BarResource struct {
 	pulumi.ResourceState
 
-	Foo ResourceOutput `pulumi:"foo"`
+	Foo pulumix.GPtrOutput[Resource, ResourceOutput] `pulumi:"foo"`
 }
 
 // NewBarResource registers a new resource with the given unique name, arguments, and options.
@@ -39,36 +40,17 @@ type barResourceArgs struct {
 
 // The set of arguments for constructing a BarResource resource.
 type BarResourceArgs struct {
-	Foo ResourceInput
+	Foo pulumix.Input[*Resource]
 }
 
 func (BarResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*barResourceArgs)(nil)).Elem()
 }
 
-type BarResourceInput interface {
-	pulumi.Input
-
-	ToBarResourceOutput() BarResourceOutput
-	ToBarResourceOutputWithContext(ctx context.Context) BarResourceOutput
-}
-
-func (*BarResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**BarResource)(nil)).Elem()
-}
-
-func (i *BarResource) ToBarResourceOutput() BarResourceOutput {
-	return i.ToBarResourceOutputWithContext(context.Background())
-}
-
-func (i *BarResource) ToBarResourceOutputWithContext(ctx context.Context) BarResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(BarResourceOutput)
-}
-
 type BarResourceOutput struct{ *pulumi.OutputState }
 
 func (BarResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**BarResource)(nil)).Elem()
+	return reflect.TypeOf((*BarResource)(nil)).Elem()
 }
 
 func (o BarResourceOutput) ToBarResourceOutput() BarResourceOutput {
@@ -79,11 +61,18 @@ func (o BarResourceOutput) ToBarResourceOutputWithContext(ctx context.Context) B
 	return o
 }
 
-func (o BarResourceOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v *BarResource) ResourceOutput { return v.Foo }).(ResourceOutput)
+func (o BarResourceOutput) ToOutput(ctx context.Context) pulumix.Output[BarResource] {
+	return pulumix.Output[BarResource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o BarResourceOutput) Foo() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[BarResource](o, func(v BarResource) pulumix.GPtrOutput[Resource, ResourceOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Resource, pulumix.GPtrOutput[Resource, ResourceOutput]](value)
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*BarResourceInput)(nil)).Elem(), &BarResource{})
 	pulumi.RegisterOutputType(BarResourceOutput{})
 }
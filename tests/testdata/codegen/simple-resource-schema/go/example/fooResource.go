@@ -8,13 +8,14 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-resource-schema/example/internal"
 )
 
 type FooResource struct {
 	pulumi.ResourceState
 
-	Foo ResourceOutput `pulumi:"foo"`
+	Foo pulumix.GPtrOutput[Resource, ResourceOutput] `pulumi:"foo"`
 }
 
 // NewFooResource registers a new resource with the given unique name, arguments, and options.
@@ -39,36 +40,17 @@ type fooResourceArgs struct {
 
 // The set of arguments for constructing a FooResource resource.
 type FooResourceArgs struct {
-	Foo ResourceInput
+	Foo pulumix.Input[*Resource]
 }
 
 func (FooResourceArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fooResourceArgs)(nil)).Elem()
 }
 
-type FooResourceInput interface {
-	pulumi.Input
-
-	ToFooResourceOutput() FooResourceOutput
-	ToFooResourceOutputWithContext(ctx context.Context) FooResourceOutput
-}
-
-func (*FooResource) ElementType() reflect.Type {
-	return reflect.TypeOf((**FooResource)(nil)).Elem()
-}
-
-func (i *FooResource) ToFooResourceOutput() FooResourceOutput {
-	return i.ToFooResourceOutputWithContext(context.Background())
-}
-
-func (i *FooResource) ToFooResourceOutputWithContext(ctx context.Context) FooResourceOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(FooResourceOutput)
-}
-
 type FooResourceOutput struct{ *pulumi.OutputState }
 
 func (FooResourceOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**FooResource)(nil)).Elem()
+	return reflect.TypeOf((*FooResource)(nil)).Elem()
 }
 
 func (o FooResourceOutput) ToFooResourceOutput() FooResourceOutput {
@@ -79,11 +61,18 @@ func (o FooResourceOutput) ToFooResourceOutputWithContext(ctx context.Context) F
 	return o
 }
 
-func (o FooResourceOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v *FooResource) ResourceOutput { return v.Foo }).(ResourceOutput)
+func (o FooResourceOutput) ToOutput(ctx context.Context) pulumix.Output[FooResource] {
+	return pulumix.Output[FooResource]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o FooResourceOutput) Foo() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[FooResource](o, func(v FooResource) pulumix.GPtrOutput[Resource, ResourceOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Resource, pulumix.GPtrOutput[Resource, ResourceOutput]](value)
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*FooResourceInput)(nil)).Elem(), &FooResource{})
 	pulumi.RegisterOutputType(FooResourceOutput{})
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-yaml-schema/example/internal"
 )
 
@@ -17,19 +18,8 @@ type ConfigMap struct {
 	Config *string `pulumi:"config"`
 }
 
-// ConfigMapInput is an input type that accepts ConfigMap and ConfigMapOutput values.
-// You can construct a concrete instance of `ConfigMapInput` via:
-//
-//	ConfigMap{ "key": ConfigArgs{...} }
-type ConfigMapInput interface {
-	pulumi.Input
-
-	ToConfigMapOutput() ConfigMapOutput
-	ToConfigMapOutputWithContext(context.Context) ConfigMapOutput
-}
-
 type ConfigMapArgs struct {
-	Config pulumi.StringPtrInput `pulumi:"config"`
+	Config pulumix.Input[*string] `pulumi:"config"`
 }
 
 func (ConfigMapArgs) ElementType() reflect.Type {
@@ -44,29 +34,8 @@ func (i ConfigMapArgs) ToConfigMapOutputWithContext(ctx context.Context) ConfigM
 	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapOutput)
 }
 
-// ConfigMapArrayInput is an input type that accepts ConfigMapArray and ConfigMapArrayOutput values.
-// You can construct a concrete instance of `ConfigMapArrayInput` via:
-//
-//	ConfigMapArray{ ConfigMapArgs{...} }
-type ConfigMapArrayInput interface {
-	pulumi.Input
-
-	ToConfigMapArrayOutput() ConfigMapArrayOutput
-	ToConfigMapArrayOutputWithContext(context.Context) ConfigMapArrayOutput
-}
-
-type ConfigMapArray []ConfigMapInput
-
-func (ConfigMapArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigMap)(nil)).Elem()
-}
-
-func (i ConfigMapArray) ToConfigMapArrayOutput() ConfigMapArrayOutput {
-	return i.ToConfigMapArrayOutputWithContext(context.Background())
-}
-
-func (i ConfigMapArray) ToConfigMapArrayOutputWithContext(ctx context.Context) ConfigMapArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapArrayOutput)
+func (i *ConfigMapArgs) ToOutput(ctx context.Context) pulumix.Output[*ConfigMapArgs] {
+	return pulumix.Val(i)
 }
 
 type ConfigMapOutput struct{ *pulumi.OutputState }
@@ -83,59 +52,34 @@ func (o ConfigMapOutput) ToConfigMapOutputWithContext(ctx context.Context) Confi
 	return o
 }
 
-func (o ConfigMapOutput) Config() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v ConfigMap) *string { return v.Config }).(pulumi.StringPtrOutput)
+func (o ConfigMapOutput) ToOutput(ctx context.Context) pulumix.Output[ConfigMap] {
+	return pulumix.Output[ConfigMap]{
+		OutputState: o.OutputState,
+	}
 }
 
-type ConfigMapArrayOutput struct{ *pulumi.OutputState }
-
-func (ConfigMapArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]ConfigMap)(nil)).Elem()
-}
-
-func (o ConfigMapArrayOutput) ToConfigMapArrayOutput() ConfigMapArrayOutput {
-	return o
-}
-
-func (o ConfigMapArrayOutput) ToConfigMapArrayOutputWithContext(ctx context.Context) ConfigMapArrayOutput {
-	return o
-}
-
-func (o ConfigMapArrayOutput) Index(i pulumi.IntInput) ConfigMapOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ConfigMap {
-		return vs[0].([]ConfigMap)[vs[1].(int)]
-	}).(ConfigMapOutput)
+func (o ConfigMapOutput) Config() pulumix.Output[*string] {
+	return pulumix.Apply[ConfigMap](o, func(v ConfigMap) *string { return v.Config })
 }
 
 type Object struct {
-	Bar     *string     `pulumi:"bar"`
-	Configs []ConfigMap `pulumi:"configs"`
-	Foo     *Resource   `pulumi:"foo"`
+	Bar     *string      `pulumi:"bar"`
+	Configs []*ConfigMap `pulumi:"configs"`
+	Foo     *Resource    `pulumi:"foo"`
 	// List of lists of other objects
-	Others [][]SomeOtherObject `pulumi:"others"`
+	Others [][]*SomeOtherObject `pulumi:"others"`
 	// Mapping from string to list of some other object
-	StillOthers map[string][]SomeOtherObject `pulumi:"stillOthers"`
-}
-
-// ObjectInput is an input type that accepts ObjectArgs and ObjectOutput values.
-// You can construct a concrete instance of `ObjectInput` via:
-//
-//	ObjectArgs{...}
-type ObjectInput interface {
-	pulumi.Input
-
-	ToObjectOutput() ObjectOutput
-	ToObjectOutputWithContext(context.Context) ObjectOutput
+	StillOthers map[string][]*SomeOtherObject `pulumi:"stillOthers"`
 }
 
 type ObjectArgs struct {
-	Bar     pulumi.StringPtrInput `pulumi:"bar"`
-	Configs ConfigMapArrayInput   `pulumi:"configs"`
-	Foo     ResourceInput         `pulumi:"foo"`
+	Bar     pulumix.Input[*string]          `pulumi:"bar"`
+	Configs pulumix.Input[[]*ConfigMapArgs] `pulumi:"configs"`
+	Foo     pulumix.Input[*Resource]        `pulumi:"foo"`
 	// List of lists of other objects
-	Others SomeOtherObjectArrayArrayInput `pulumi:"others"`
+	Others pulumix.Input[[][]*SomeOtherObjectArgs] `pulumi:"others"`
 	// Mapping from string to list of some other object
-	StillOthers SomeOtherObjectArrayMapInput `pulumi:"stillOthers"`
+	StillOthers pulumix.Input[map[string][]*SomeOtherObjectArgs] `pulumi:"stillOthers"`
 }
 
 func (ObjectArgs) ElementType() reflect.Type {
@@ -150,45 +94,8 @@ func (i ObjectArgs) ToObjectOutputWithContext(ctx context.Context) ObjectOutput
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectOutput)
 }
 
-func (i ObjectArgs) ToObjectPtrOutput() ObjectPtrOutput {
-	return i.ToObjectPtrOutputWithContext(context.Background())
-}
-
-func (i ObjectArgs) ToObjectPtrOutputWithContext(ctx context.Context) ObjectPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectOutput).ToObjectPtrOutputWithContext(ctx)
-}
-
-// ObjectPtrInput is an input type that accepts ObjectArgs, ObjectPtr and ObjectPtrOutput values.
-// You can construct a concrete instance of `ObjectPtrInput` via:
-//
-//	        ObjectArgs{...}
-//
-//	or:
-//
-//	        nil
-type ObjectPtrInput interface {
-	pulumi.Input
-
-	ToObjectPtrOutput() ObjectPtrOutput
-	ToObjectPtrOutputWithContext(context.Context) ObjectPtrOutput
-}
-
-type objectPtrType ObjectArgs
-
-func ObjectPtr(v *ObjectArgs) ObjectPtrInput {
-	return (*objectPtrType)(v)
-}
-
-func (*objectPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**Object)(nil)).Elem()
-}
-
-func (i *objectPtrType) ToObjectPtrOutput() ObjectPtrOutput {
-	return i.ToObjectPtrOutputWithContext(context.Background())
-}
-
-func (i *objectPtrType) ToObjectPtrOutputWithContext(ctx context.Context) ObjectPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectPtrOutput)
+func (i *ObjectArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectArgs] {
+	return pulumix.Val(i)
 }
 
 type ObjectOutput struct{ *pulumi.OutputState }
@@ -205,107 +112,36 @@ func (o ObjectOutput) ToObjectOutputWithContext(ctx context.Context) ObjectOutpu
 	return o
 }
 
-func (o ObjectOutput) ToObjectPtrOutput() ObjectPtrOutput {
-	return o.ToObjectPtrOutputWithContext(context.Background())
+func (o ObjectOutput) ToOutput(ctx context.Context) pulumix.Output[Object] {
+	return pulumix.Output[Object]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ObjectOutput) ToObjectPtrOutputWithContext(ctx context.Context) ObjectPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v Object) *Object {
-		return &v
-	}).(ObjectPtrOutput)
+func (o ObjectOutput) Bar() pulumix.Output[*string] {
+	return pulumix.Apply[Object](o, func(v Object) *string { return v.Bar })
 }
 
-func (o ObjectOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v Object) *string { return v.Bar }).(pulumi.StringPtrOutput)
+func (o ObjectOutput) Configs() pulumix.GArrayOutput[ConfigMap, ConfigMapOutput] {
+	value := pulumix.Apply[Object](o, func(v Object) []*ConfigMap { return v.Configs })
+	return pulumix.GArrayOutput[ConfigMap, ConfigMapOutput]{OutputState: value.OutputState}
 }
 
-func (o ObjectOutput) Configs() ConfigMapArrayOutput {
-	return o.ApplyT(func(v Object) []ConfigMap { return v.Configs }).(ConfigMapArrayOutput)
-}
-
-func (o ObjectOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v Object) *Resource { return v.Foo }).(ResourceOutput)
+func (o ObjectOutput) Foo() pulumix.GPtrOutput[Resource, ResourceOutput] {
+	value := pulumix.Apply[Object](o, func(v Object) *Resource { return v.Foo })
+	return pulumix.GPtrOutput[Resource, ResourceOutput]{OutputState: value.OutputState}
 }
 
 // List of lists of other objects
-func (o ObjectOutput) Others() SomeOtherObjectArrayArrayOutput {
-	return o.ApplyT(func(v Object) [][]SomeOtherObject { return v.Others }).(SomeOtherObjectArrayArrayOutput)
+func (o ObjectOutput) Others() pulumix.ArrayOutput[[]SomeOtherObject] {
+	value := pulumix.Apply[Object](o, func(v Object) [][]*SomeOtherObject { return v.Others })
+	return pulumix.ArrayOutput[[]SomeOtherObject]{OutputState: value.OutputState}
 }
 
 // Mapping from string to list of some other object
-func (o ObjectOutput) StillOthers() SomeOtherObjectArrayMapOutput {
-	return o.ApplyT(func(v Object) map[string][]SomeOtherObject { return v.StillOthers }).(SomeOtherObjectArrayMapOutput)
-}
-
-type ObjectPtrOutput struct{ *pulumi.OutputState }
-
-func (ObjectPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Object)(nil)).Elem()
-}
-
-func (o ObjectPtrOutput) ToObjectPtrOutput() ObjectPtrOutput {
-	return o
-}
-
-func (o ObjectPtrOutput) ToObjectPtrOutputWithContext(ctx context.Context) ObjectPtrOutput {
-	return o
-}
-
-func (o ObjectPtrOutput) Elem() ObjectOutput {
-	return o.ApplyT(func(v *Object) Object {
-		if v != nil {
-			return *v
-		}
-		var ret Object
-		return ret
-	}).(ObjectOutput)
-}
-
-func (o ObjectPtrOutput) Bar() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *Object) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Bar
-	}).(pulumi.StringPtrOutput)
-}
-
-func (o ObjectPtrOutput) Configs() ConfigMapArrayOutput {
-	return o.ApplyT(func(v *Object) []ConfigMap {
-		if v == nil {
-			return nil
-		}
-		return v.Configs
-	}).(ConfigMapArrayOutput)
-}
-
-func (o ObjectPtrOutput) Foo() ResourceOutput {
-	return o.ApplyT(func(v *Object) *Resource {
-		if v == nil {
-			return nil
-		}
-		return v.Foo
-	}).(ResourceOutput)
-}
-
-// List of lists of other objects
-func (o ObjectPtrOutput) Others() SomeOtherObjectArrayArrayOutput {
-	return o.ApplyT(func(v *Object) [][]SomeOtherObject {
-		if v == nil {
-			return nil
-		}
-		return v.Others
-	}).(SomeOtherObjectArrayArrayOutput)
-}
-
-// Mapping from string to list of some other object
-func (o ObjectPtrOutput) StillOthers() SomeOtherObjectArrayMapOutput {
-	return o.ApplyT(func(v *Object) map[string][]SomeOtherObject {
-		if v == nil {
-			return nil
-		}
-		return v.StillOthers
-	}).(SomeOtherObjectArrayMapOutput)
+func (o ObjectOutput) StillOthers() pulumix.MapOutput[[]SomeOtherObject] {
+	value := pulumix.Apply[Object](o, func(v Object) map[string][]*SomeOtherObject { return v.StillOthers })
+	return pulumix.MapOutput[[]SomeOtherObject]{OutputState: value.OutputState}
 }
 
 type ObjectWithNodeOptionalInputs struct {
@@ -313,20 +149,9 @@ type ObjectWithNodeOptionalInputs struct {
 	Foo string `pulumi:"foo"`
 }
 
-// ObjectWithNodeOptionalInputsInput is an input type that accepts ObjectWithNodeOptionalInputsArgs and ObjectWithNodeOptionalInputsOutput values.
-// You can construct a concrete instance of `ObjectWithNodeOptionalInputsInput` via:
-//
-//	ObjectWithNodeOptionalInputsArgs{...}
-type ObjectWithNodeOptionalInputsInput interface {
-	pulumi.Input
-
-	ToObjectWithNodeOptionalInputsOutput() ObjectWithNodeOptionalInputsOutput
-	ToObjectWithNodeOptionalInputsOutputWithContext(context.Context) ObjectWithNodeOptionalInputsOutput
-}
-
 type ObjectWithNodeOptionalInputsArgs struct {
-	Bar pulumi.IntPtrInput `pulumi:"bar"`
-	Foo pulumi.StringInput `pulumi:"foo"`
+	Bar pulumix.Input[*int]   `pulumi:"bar"`
+	Foo pulumix.Input[string] `pulumi:"foo"`
 }
 
 func (ObjectWithNodeOptionalInputsArgs) ElementType() reflect.Type {
@@ -341,45 +166,8 @@ func (i ObjectWithNodeOptionalInputsArgs) ToObjectWithNodeOptionalInputsOutputWi
 	return pulumi.ToOutputWithContext(ctx, i).(ObjectWithNodeOptionalInputsOutput)
 }
 
-func (i ObjectWithNodeOptionalInputsArgs) ToObjectWithNodeOptionalInputsPtrOutput() ObjectWithNodeOptionalInputsPtrOutput {
-	return i.ToObjectWithNodeOptionalInputsPtrOutputWithContext(context.Background())
-}
-
-func (i ObjectWithNodeOptionalInputsArgs) ToObjectWithNodeOptionalInputsPtrOutputWithContext(ctx context.Context) ObjectWithNodeOptionalInputsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectWithNodeOptionalInputsOutput).ToObjectWithNodeOptionalInputsPtrOutputWithContext(ctx)
-}
-
-// ObjectWithNodeOptionalInputsPtrInput is an input type that accepts ObjectWithNodeOptionalInputsArgs, ObjectWithNodeOptionalInputsPtr and ObjectWithNodeOptionalInputsPtrOutput values.
-// You can construct a concrete instance of `ObjectWithNodeOptionalInputsPtrInput` via:
-//
-//	        ObjectWithNodeOptionalInputsArgs{...}
-//
-//	or:
-//
-//	        nil
-type ObjectWithNodeOptionalInputsPtrInput interface {
-	pulumi.Input
-
-	ToObjectWithNodeOptionalInputsPtrOutput() ObjectWithNodeOptionalInputsPtrOutput
-	ToObjectWithNodeOptionalInputsPtrOutputWithContext(context.Context) ObjectWithNodeOptionalInputsPtrOutput
-}
-
-type objectWithNodeOptionalInputsPtrType ObjectWithNodeOptionalInputsArgs
-
-func ObjectWithNodeOptionalInputsPtr(v *ObjectWithNodeOptionalInputsArgs) ObjectWithNodeOptionalInputsPtrInput {
-	return (*objectWithNodeOptionalInputsPtrType)(v)
-}
-
-func (*objectWithNodeOptionalInputsPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**ObjectWithNodeOptionalInputs)(nil)).Elem()
-}
-
-func (i *objectWithNodeOptionalInputsPtrType) ToObjectWithNodeOptionalInputsPtrOutput() ObjectWithNodeOptionalInputsPtrOutput {
-	return i.ToObjectWithNodeOptionalInputsPtrOutputWithContext(context.Background())
-}
-
-func (i *objectWithNodeOptionalInputsPtrType) ToObjectWithNodeOptionalInputsPtrOutputWithContext(ctx context.Context) ObjectWithNodeOptionalInputsPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ObjectWithNodeOptionalInputsPtrOutput)
+func (i *ObjectWithNodeOptionalInputsArgs) ToOutput(ctx context.Context) pulumix.Output[*ObjectWithNodeOptionalInputsArgs] {
+	return pulumix.Val(i)
 }
 
 type ObjectWithNodeOptionalInputsOutput struct{ *pulumi.OutputState }
@@ -396,64 +184,18 @@ func (o ObjectWithNodeOptionalInputsOutput) ToObjectWithNodeOptionalInputsOutput
 	return o
 }
 
-func (o ObjectWithNodeOptionalInputsOutput) ToObjectWithNodeOptionalInputsPtrOutput() ObjectWithNodeOptionalInputsPtrOutput {
-	return o.ToObjectWithNodeOptionalInputsPtrOutputWithContext(context.Background())
-}
-
-func (o ObjectWithNodeOptionalInputsOutput) ToObjectWithNodeOptionalInputsPtrOutputWithContext(ctx context.Context) ObjectWithNodeOptionalInputsPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v ObjectWithNodeOptionalInputs) *ObjectWithNodeOptionalInputs {
-		return &v
-	}).(ObjectWithNodeOptionalInputsPtrOutput)
+func (o ObjectWithNodeOptionalInputsOutput) ToOutput(ctx context.Context) pulumix.Output[ObjectWithNodeOptionalInputs] {
+	return pulumix.Output[ObjectWithNodeOptionalInputs]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o ObjectWithNodeOptionalInputsOutput) Bar() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v ObjectWithNodeOptionalInputs) *int { return v.Bar }).(pulumi.IntPtrOutput)
+func (o ObjectWithNodeOptionalInputsOutput) Bar() pulumix.Output[*int] {
+	return pulumix.Apply[ObjectWithNodeOptionalInputs](o, func(v ObjectWithNodeOptionalInputs) *int { return v.Bar })
 }
 
-func (o ObjectWithNodeOptionalInputsOutput) Foo() pulumi.StringOutput {
-	return o.ApplyT(func(v ObjectWithNodeOptionalInputs) string { return v.Foo }).(pulumi.StringOutput)
-}
-
-type ObjectWithNodeOptionalInputsPtrOutput struct{ *pulumi.OutputState }
-
-func (ObjectWithNodeOptionalInputsPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**ObjectWithNodeOptionalInputs)(nil)).Elem()
-}
-
-func (o ObjectWithNodeOptionalInputsPtrOutput) ToObjectWithNodeOptionalInputsPtrOutput() ObjectWithNodeOptionalInputsPtrOutput {
-	return o
-}
-
-func (o ObjectWithNodeOptionalInputsPtrOutput) ToObjectWithNodeOptionalInputsPtrOutputWithContext(ctx context.Context) ObjectWithNodeOptionalInputsPtrOutput {
-	return o
-}
-
-func (o ObjectWithNodeOptionalInputsPtrOutput) Elem() ObjectWithNodeOptionalInputsOutput {
-	return o.ApplyT(func(v *ObjectWithNodeOptionalInputs) ObjectWithNodeOptionalInputs {
-		if v != nil {
-			return *v
-		}
-		var ret ObjectWithNodeOptionalInputs
-		return ret
-	}).(ObjectWithNodeOptionalInputsOutput)
-}
-
-func (o ObjectWithNodeOptionalInputsPtrOutput) Bar() pulumi.IntPtrOutput {
-	return o.ApplyT(func(v *ObjectWithNodeOptionalInputs) *int {
-		if v == nil {
-			return nil
-		}
-		return v.Bar
-	}).(pulumi.IntPtrOutput)
-}
-
-func (o ObjectWithNodeOptionalInputsPtrOutput) Foo() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *ObjectWithNodeOptionalInputs) *string {
-		if v == nil {
-			return nil
-		}
-		return &v.Foo
-	}).(pulumi.StringPtrOutput)
+func (o ObjectWithNodeOptionalInputsOutput) Foo() pulumix.Output[string] {
+	return pulumix.Apply[ObjectWithNodeOptionalInputs](o, func(v ObjectWithNodeOptionalInputs) string { return v.Foo })
 }
 
 type OtherResourceOutputType struct {
@@ -478,80 +220,22 @@ func (o OutputOnlyObjectTypeOutput) ToOutputOnlyObjectTypeOutputWithContext(ctx
 	return o
 }
 
-func (o OutputOnlyObjectTypeOutput) Foo() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v OutputOnlyObjectType) *string { return v.Foo }).(pulumi.StringPtrOutput)
-}
-
-type OutputOnlyObjectTypePtrOutput struct{ *pulumi.OutputState }
-
-func (OutputOnlyObjectTypePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**OutputOnlyObjectType)(nil)).Elem()
-}
-
-func (o OutputOnlyObjectTypePtrOutput) ToOutputOnlyObjectTypePtrOutput() OutputOnlyObjectTypePtrOutput {
-	return o
-}
-
-func (o OutputOnlyObjectTypePtrOutput) ToOutputOnlyObjectTypePtrOutputWithContext(ctx context.Context) OutputOnlyObjectTypePtrOutput {
-	return o
-}
-
-func (o OutputOnlyObjectTypePtrOutput) Elem() OutputOnlyObjectTypeOutput {
-	return o.ApplyT(func(v *OutputOnlyObjectType) OutputOnlyObjectType {
-		if v != nil {
-			return *v
-		}
-		var ret OutputOnlyObjectType
-		return ret
-	}).(OutputOnlyObjectTypeOutput)
+func (o OutputOnlyObjectTypeOutput) ToOutput(ctx context.Context) pulumix.Output[OutputOnlyObjectType] {
+	return pulumix.Output[OutputOnlyObjectType]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o OutputOnlyObjectTypePtrOutput) Foo() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *OutputOnlyObjectType) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Foo
-	}).(pulumi.StringPtrOutput)
-}
-
-type OutputOnlyObjectTypeArrayOutput struct{ *pulumi.OutputState }
-
-func (OutputOnlyObjectTypeArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]OutputOnlyObjectType)(nil)).Elem()
-}
-
-func (o OutputOnlyObjectTypeArrayOutput) ToOutputOnlyObjectTypeArrayOutput() OutputOnlyObjectTypeArrayOutput {
-	return o
-}
-
-func (o OutputOnlyObjectTypeArrayOutput) ToOutputOnlyObjectTypeArrayOutputWithContext(ctx context.Context) OutputOnlyObjectTypeArrayOutput {
-	return o
-}
-
-func (o OutputOnlyObjectTypeArrayOutput) Index(i pulumi.IntInput) OutputOnlyObjectTypeOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OutputOnlyObjectType {
-		return vs[0].([]OutputOnlyObjectType)[vs[1].(int)]
-	}).(OutputOnlyObjectTypeOutput)
+func (o OutputOnlyObjectTypeOutput) Foo() pulumix.Output[*string] {
+	return pulumix.Apply[OutputOnlyObjectType](o, func(v OutputOnlyObjectType) *string { return v.Foo })
 }
 
 type SomeOtherObject struct {
 	Baz *string `pulumi:"baz"`
 }
 
-// SomeOtherObjectInput is an input type that accepts SomeOtherObjectArgs and SomeOtherObjectOutput values.
-// You can construct a concrete instance of `SomeOtherObjectInput` via:
-//
-//	SomeOtherObjectArgs{...}
-type SomeOtherObjectInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectOutput() SomeOtherObjectOutput
-	ToSomeOtherObjectOutputWithContext(context.Context) SomeOtherObjectOutput
-}
-
 type SomeOtherObjectArgs struct {
-	Baz pulumi.StringPtrInput `pulumi:"baz"`
+	Baz pulumix.Input[*string] `pulumi:"baz"`
 }
 
 func (SomeOtherObjectArgs) ElementType() reflect.Type {
@@ -566,70 +250,8 @@ func (i SomeOtherObjectArgs) ToSomeOtherObjectOutputWithContext(ctx context.Cont
 	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectOutput)
 }
 
-func (i SomeOtherObjectArgs) ToSomeOtherObjectPtrOutput() SomeOtherObjectPtrOutput {
-	return i.ToSomeOtherObjectPtrOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArgs) ToSomeOtherObjectPtrOutputWithContext(ctx context.Context) SomeOtherObjectPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectOutput).ToSomeOtherObjectPtrOutputWithContext(ctx)
-}
-
-// SomeOtherObjectPtrInput is an input type that accepts SomeOtherObjectArgs, SomeOtherObjectPtr and SomeOtherObjectPtrOutput values.
-// You can construct a concrete instance of `SomeOtherObjectPtrInput` via:
-//
-//	        SomeOtherObjectArgs{...}
-//
-//	or:
-//
-//	        nil
-type SomeOtherObjectPtrInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectPtrOutput() SomeOtherObjectPtrOutput
-	ToSomeOtherObjectPtrOutputWithContext(context.Context) SomeOtherObjectPtrOutput
-}
-
-type someOtherObjectPtrType SomeOtherObjectArgs
-
-func SomeOtherObjectPtr(v *SomeOtherObjectArgs) SomeOtherObjectPtrInput {
-	return (*someOtherObjectPtrType)(v)
-}
-
-func (*someOtherObjectPtrType) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeOtherObject)(nil)).Elem()
-}
-
-func (i *someOtherObjectPtrType) ToSomeOtherObjectPtrOutput() SomeOtherObjectPtrOutput {
-	return i.ToSomeOtherObjectPtrOutputWithContext(context.Background())
-}
-
-func (i *someOtherObjectPtrType) ToSomeOtherObjectPtrOutputWithContext(ctx context.Context) SomeOtherObjectPtrOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectPtrOutput)
-}
-
-// SomeOtherObjectArrayInput is an input type that accepts SomeOtherObjectArray and SomeOtherObjectArrayOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayInput` via:
-//
-//	SomeOtherObjectArray{ SomeOtherObjectArgs{...} }
-type SomeOtherObjectArrayInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput
-	ToSomeOtherObjectArrayOutputWithContext(context.Context) SomeOtherObjectArrayOutput
-}
-
-type SomeOtherObjectArray []SomeOtherObjectInput
-
-func (SomeOtherObjectArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArray) ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput {
-	return i.ToSomeOtherObjectArrayOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArray) ToSomeOtherObjectArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayOutput)
+func (i *SomeOtherObjectArgs) ToOutput(ctx context.Context) pulumix.Output[*SomeOtherObjectArgs] {
+	return pulumix.Val(i)
 }
 
 type SomeOtherObjectOutput struct{ *pulumi.OutputState }
@@ -646,187 +268,20 @@ func (o SomeOtherObjectOutput) ToSomeOtherObjectOutputWithContext(ctx context.Co
 	return o
 }
 
-func (o SomeOtherObjectOutput) ToSomeOtherObjectPtrOutput() SomeOtherObjectPtrOutput {
-	return o.ToSomeOtherObjectPtrOutputWithContext(context.Background())
-}
-
-func (o SomeOtherObjectOutput) ToSomeOtherObjectPtrOutputWithContext(ctx context.Context) SomeOtherObjectPtrOutput {
-	return o.ApplyTWithContext(ctx, func(_ context.Context, v SomeOtherObject) *SomeOtherObject {
-		return &v
-	}).(SomeOtherObjectPtrOutput)
-}
-
-func (o SomeOtherObjectOutput) Baz() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v SomeOtherObject) *string { return v.Baz }).(pulumi.StringPtrOutput)
-}
-
-type SomeOtherObjectPtrOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectPtrOutput) ToSomeOtherObjectPtrOutput() SomeOtherObjectPtrOutput {
-	return o
-}
-
-func (o SomeOtherObjectPtrOutput) ToSomeOtherObjectPtrOutputWithContext(ctx context.Context) SomeOtherObjectPtrOutput {
-	return o
-}
-
-func (o SomeOtherObjectPtrOutput) Elem() SomeOtherObjectOutput {
-	return o.ApplyT(func(v *SomeOtherObject) SomeOtherObject {
-		if v != nil {
-			return *v
-		}
-		var ret SomeOtherObject
-		return ret
-	}).(SomeOtherObjectOutput)
-}
-
-func (o SomeOtherObjectPtrOutput) Baz() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *SomeOtherObject) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Baz
-	}).(pulumi.StringPtrOutput)
-}
-
-type SomeOtherObjectArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayOutput) ToSomeOtherObjectArrayOutput() SomeOtherObjectArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayOutput) ToSomeOtherObjectArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayOutput) Index(i pulumi.IntInput) SomeOtherObjectOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SomeOtherObject {
-		return vs[0].([]SomeOtherObject)[vs[1].(int)]
-	}).(SomeOtherObjectOutput)
-}
-
-type SomeOtherObjectArrayArray []SomeOtherObjectArrayInput
-
-func (SomeOtherObjectArrayArray) ElementType() reflect.Type {
-	return reflect.TypeOf((*[][]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArrayArray) ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput {
-	return i.ToSomeOtherObjectArrayArrayOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArrayArray) ToSomeOtherObjectArrayArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayArrayOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayArrayOutput)
-}
-
-// SomeOtherObjectArrayArrayInput is an input type that accepts SomeOtherObjectArrayArray and SomeOtherObjectArrayArrayOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayArrayInput` via:
-//
-//	SomeOtherObjectArrayArray{ SomeOtherObjectArray{ SomeOtherObjectArgs{...} } }
-type SomeOtherObjectArrayArrayInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput
-	ToSomeOtherObjectArrayArrayOutputWithContext(context.Context) SomeOtherObjectArrayArrayOutput
-}
-
-type SomeOtherObjectArrayArrayOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayArrayOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*[][]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayArrayOutput) ToSomeOtherObjectArrayArrayOutput() SomeOtherObjectArrayArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayArrayOutput) ToSomeOtherObjectArrayArrayOutputWithContext(ctx context.Context) SomeOtherObjectArrayArrayOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayArrayOutput) Index(i pulumi.IntInput) SomeOtherObjectArrayOutput {
-	return pulumi.All(o, i).ApplyT(func(vs []interface{}) []SomeOtherObject {
-		return vs[0].([][]SomeOtherObject)[vs[1].(int)]
-	}).(SomeOtherObjectArrayOutput)
-}
-
-type SomeOtherObjectArrayMap map[string]SomeOtherObjectArrayInput
-
-func (SomeOtherObjectArrayMap) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]SomeOtherObject)(nil)).Elem()
-}
-
-func (i SomeOtherObjectArrayMap) ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput {
-	return i.ToSomeOtherObjectArrayMapOutputWithContext(context.Background())
-}
-
-func (i SomeOtherObjectArrayMap) ToSomeOtherObjectArrayMapOutputWithContext(ctx context.Context) SomeOtherObjectArrayMapOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(SomeOtherObjectArrayMapOutput)
-}
-
-// SomeOtherObjectArrayMapInput is an input type that accepts SomeOtherObjectArrayMap and SomeOtherObjectArrayMapOutput values.
-// You can construct a concrete instance of `SomeOtherObjectArrayMapInput` via:
-//
-//	SomeOtherObjectArrayMap{ "key": SomeOtherObjectArray{ SomeOtherObjectArgs{...} } }
-type SomeOtherObjectArrayMapInput interface {
-	pulumi.Input
-
-	ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput
-	ToSomeOtherObjectArrayMapOutputWithContext(context.Context) SomeOtherObjectArrayMapOutput
-}
-
-type SomeOtherObjectArrayMapOutput struct{ *pulumi.OutputState }
-
-func (SomeOtherObjectArrayMapOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((*map[string][]SomeOtherObject)(nil)).Elem()
-}
-
-func (o SomeOtherObjectArrayMapOutput) ToSomeOtherObjectArrayMapOutput() SomeOtherObjectArrayMapOutput {
-	return o
-}
-
-func (o SomeOtherObjectArrayMapOutput) ToSomeOtherObjectArrayMapOutputWithContext(ctx context.Context) SomeOtherObjectArrayMapOutput {
-	return o
+func (o SomeOtherObjectOutput) ToOutput(ctx context.Context) pulumix.Output[SomeOtherObject] {
+	return pulumix.Output[SomeOtherObject]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o SomeOtherObjectArrayMapOutput) MapIndex(k pulumi.StringInput) SomeOtherObjectArrayOutput {
-	return pulumi.All(o, k).ApplyT(func(vs []interface{}) []SomeOtherObject {
-		return vs[0].(map[string][]SomeOtherObject)[vs[1].(string)]
-	}).(SomeOtherObjectArrayOutput)
+func (o SomeOtherObjectOutput) Baz() pulumix.Output[*string] {
+	return pulumix.Apply[SomeOtherObject](o, func(v SomeOtherObject) *string { return v.Baz })
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapInput)(nil)).Elem(), ConfigMapArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapArrayInput)(nil)).Elem(), ConfigMapArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectInput)(nil)).Elem(), ObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectPtrInput)(nil)).Elem(), ObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectWithNodeOptionalInputsInput)(nil)).Elem(), ObjectWithNodeOptionalInputsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*ObjectWithNodeOptionalInputsPtrInput)(nil)).Elem(), ObjectWithNodeOptionalInputsArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectInput)(nil)).Elem(), SomeOtherObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectPtrInput)(nil)).Elem(), SomeOtherObjectArgs{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayInput)(nil)).Elem(), SomeOtherObjectArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayArrayInput)(nil)).Elem(), SomeOtherObjectArrayArray{})
-	pulumi.RegisterInputType(reflect.TypeOf((*SomeOtherObjectArrayMapInput)(nil)).Elem(), SomeOtherObjectArrayMap{})
 	pulumi.RegisterOutputType(ConfigMapOutput{})
-	pulumi.RegisterOutputType(ConfigMapArrayOutput{})
 	pulumi.RegisterOutputType(ObjectOutput{})
-	pulumi.RegisterOutputType(ObjectPtrOutput{})
 	pulumi.RegisterOutputType(ObjectWithNodeOptionalInputsOutput{})
-	pulumi.RegisterOutputType(ObjectWithNodeOptionalInputsPtrOutput{})
 	pulumi.RegisterOutputType(OutputOnlyObjectTypeOutput{})
-	pulumi.RegisterOutputType(OutputOnlyObjectTypePtrOutput{})
-	pulumi.RegisterOutputType(OutputOnlyObjectTypeArrayOutput{})
 	pulumi.RegisterOutputType(SomeOtherObjectOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectPtrOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayArrayOutput{})
-	pulumi.RegisterOutputType(SomeOtherObjectArrayMapOutput{})
 }
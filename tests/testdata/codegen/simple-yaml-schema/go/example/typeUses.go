@@ -8,20 +8,21 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"simple-yaml-schema/example/internal"
 )
 
 type TypeUses struct {
 	pulumi.CustomResourceState
 
-	Alpha OutputOnlyEnumTypePtrOutput           `pulumi:"alpha"`
-	Bar   SomeOtherObjectPtrOutput              `pulumi:"bar"`
-	Baz   ObjectWithNodeOptionalInputsPtrOutput `pulumi:"baz"`
-	Beta  OutputOnlyObjectTypeArrayOutput       `pulumi:"beta"`
-	Foo   ObjectPtrOutput                       `pulumi:"foo"`
-	Gamma OutputOnlyEnumTypeMapOutput           `pulumi:"gamma"`
-	Qux   RubberTreeVarietyPtrOutput            `pulumi:"qux"`
-	Zed   OutputOnlyObjectTypePtrOutput         `pulumi:"zed"`
+	Alpha pulumix.Output[*OutputOnlyEnumType]                                                  `pulumi:"alpha"`
+	Bar   pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]                           `pulumi:"bar"`
+	Baz   pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] `pulumi:"baz"`
+	Beta  pulumix.GArrayOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]               `pulumi:"beta"`
+	Foo   pulumix.GPtrOutput[Object, ObjectOutput]                                             `pulumi:"foo"`
+	Gamma pulumix.MapOutput[OutputOnlyEnumType]                                                `pulumi:"gamma"`
+	Qux   pulumix.Output[*RubberTreeVariety]                                                   `pulumi:"qux"`
+	Zed   pulumix.GPtrOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]                 `pulumi:"zed"`
 }
 
 // NewTypeUses registers a new resource with the given unique name, arguments, and options.
@@ -72,39 +73,20 @@ type typeUsesArgs struct {
 
 // The set of arguments for constructing a TypeUses resource.
 type TypeUsesArgs struct {
-	Bar SomeOtherObjectPtrInput
-	Baz ObjectWithNodeOptionalInputsPtrInput
-	Foo ObjectPtrInput
-	Qux RubberTreeVarietyPtrInput
+	Bar pulumix.Input[*SomeOtherObjectArgs]
+	Baz pulumix.Input[*ObjectWithNodeOptionalInputsArgs]
+	Foo pulumix.Input[*ObjectArgs]
+	Qux pulumix.Input[*RubberTreeVariety]
 }
 
 func (TypeUsesArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*typeUsesArgs)(nil)).Elem()
 }
 
-type TypeUsesInput interface {
-	pulumi.Input
-
-	ToTypeUsesOutput() TypeUsesOutput
-	ToTypeUsesOutputWithContext(ctx context.Context) TypeUsesOutput
-}
-
-func (*TypeUses) ElementType() reflect.Type {
-	return reflect.TypeOf((**TypeUses)(nil)).Elem()
-}
-
-func (i *TypeUses) ToTypeUsesOutput() TypeUsesOutput {
-	return i.ToTypeUsesOutputWithContext(context.Background())
-}
-
-func (i *TypeUses) ToTypeUsesOutputWithContext(ctx context.Context) TypeUsesOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(TypeUsesOutput)
-}
-
 type TypeUsesOutput struct{ *pulumi.OutputState }
 
 func (TypeUsesOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**TypeUses)(nil)).Elem()
+	return reflect.TypeOf((*TypeUses)(nil)).Elem()
 }
 
 func (o TypeUsesOutput) ToTypeUsesOutput() TypeUsesOutput {
@@ -115,39 +97,60 @@ func (o TypeUsesOutput) ToTypeUsesOutputWithContext(ctx context.Context) TypeUse
 	return o
 }
 
-func (o TypeUsesOutput) Alpha() OutputOnlyEnumTypePtrOutput {
-	return o.ApplyT(func(v *TypeUses) OutputOnlyEnumTypePtrOutput { return v.Alpha }).(OutputOnlyEnumTypePtrOutput)
+func (o TypeUsesOutput) ToOutput(ctx context.Context) pulumix.Output[TypeUses] {
+	return pulumix.Output[TypeUses]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o TypeUsesOutput) Alpha() pulumix.Output[*OutputOnlyEnumType] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.Output[*OutputOnlyEnumType] { return v.Alpha })
+	return pulumix.Flatten[*OutputOnlyEnumType, pulumix.Output[*OutputOnlyEnumType]](value)
 }
 
-func (o TypeUsesOutput) Bar() SomeOtherObjectPtrOutput {
-	return o.ApplyT(func(v *TypeUses) SomeOtherObjectPtrOutput { return v.Bar }).(SomeOtherObjectPtrOutput)
+func (o TypeUsesOutput) Bar() pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput] { return v.Bar })
+	unwrapped := pulumix.Flatten[*SomeOtherObject, pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]](value)
+	return pulumix.GPtrOutput[SomeOtherObject, SomeOtherObjectOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Baz() ObjectWithNodeOptionalInputsPtrOutput {
-	return o.ApplyT(func(v *TypeUses) ObjectWithNodeOptionalInputsPtrOutput { return v.Baz }).(ObjectWithNodeOptionalInputsPtrOutput)
+func (o TypeUsesOutput) Baz() pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput] {
+		return v.Baz
+	})
+	unwrapped := pulumix.Flatten[*ObjectWithNodeOptionalInputs, pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput]](value)
+	return pulumix.GPtrOutput[ObjectWithNodeOptionalInputs, ObjectWithNodeOptionalInputsOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Beta() OutputOnlyObjectTypeArrayOutput {
-	return o.ApplyT(func(v *TypeUses) OutputOnlyObjectTypeArrayOutput { return v.Beta }).(OutputOnlyObjectTypeArrayOutput)
+func (o TypeUsesOutput) Beta() pulumix.GArrayOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GArrayOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput] { return v.Beta })
+	unwrapped := pulumix.Flatten[[]OutputOnlyObjectType, pulumix.GArrayOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]](value)
+	return pulumix.GArrayOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Foo() ObjectPtrOutput {
-	return o.ApplyT(func(v *TypeUses) ObjectPtrOutput { return v.Foo }).(ObjectPtrOutput)
+func (o TypeUsesOutput) Foo() pulumix.GPtrOutput[Object, ObjectOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[Object, ObjectOutput] { return v.Foo })
+	unwrapped := pulumix.Flatten[*Object, pulumix.GPtrOutput[Object, ObjectOutput]](value)
+	return pulumix.GPtrOutput[Object, ObjectOutput]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Gamma() OutputOnlyEnumTypeMapOutput {
-	return o.ApplyT(func(v *TypeUses) OutputOnlyEnumTypeMapOutput { return v.Gamma }).(OutputOnlyEnumTypeMapOutput)
+func (o TypeUsesOutput) Gamma() pulumix.MapOutput[OutputOnlyEnumType] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.MapOutput[OutputOnlyEnumType] { return v.Gamma })
+	unwrapped := pulumix.Flatten[map[string]OutputOnlyEnumType, pulumix.MapOutput[OutputOnlyEnumType]](value)
+	return pulumix.MapOutput[OutputOnlyEnumType]{OutputState: unwrapped.OutputState}
 }
 
-func (o TypeUsesOutput) Qux() RubberTreeVarietyPtrOutput {
-	return o.ApplyT(func(v *TypeUses) RubberTreeVarietyPtrOutput { return v.Qux }).(RubberTreeVarietyPtrOutput)
+func (o TypeUsesOutput) Qux() pulumix.Output[*RubberTreeVariety] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.Output[*RubberTreeVariety] { return v.Qux })
+	return pulumix.Flatten[*RubberTreeVariety, pulumix.Output[*RubberTreeVariety]](value)
 }
 
-func (o TypeUsesOutput) Zed() OutputOnlyObjectTypePtrOutput {
-	return o.ApplyT(func(v *TypeUses) OutputOnlyObjectTypePtrOutput { return v.Zed }).(OutputOnlyObjectTypePtrOutput)
+func (o TypeUsesOutput) Zed() pulumix.GPtrOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput] {
+	value := pulumix.Apply[TypeUses](o, func(v TypeUses) pulumix.GPtrOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput] { return v.Zed })
+	unwrapped := pulumix.Flatten[*OutputOnlyObjectType, pulumix.GPtrOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]](value)
+	return pulumix.GPtrOutput[OutputOnlyObjectType, OutputOnlyObjectTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*TypeUsesInput)(nil)).Elem(), &TypeUses{})
 	pulumi.RegisterOutputType(TypeUsesOutput{})
 }
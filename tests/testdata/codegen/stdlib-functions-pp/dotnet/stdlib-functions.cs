@@ -0,0 +1,210 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Net;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.RegularExpressions;
+using Pulumi;
+using Random = Pulumi.Random;
+
+	
+Dictionary<string, T> Merge<T>(params IDictionary<string, T>[] maps)
+{
+    var merged = new Dictionary<string, T>();
+    foreach (var map in maps)
+    {
+        foreach (var entry in map)
+        {
+            merged[entry.Key] = entry.Value;
+        }
+    }
+    return merged;
+}
+
+	
+string CidrHost(string prefix, double hostnum)
+{
+    var parts = prefix.Split('/');
+    var bytes = IPAddress.Parse(parts[0]).GetAddressBytes();
+    var ip = (ulong)bytes[0] << 24 | (ulong)bytes[1] << 16 | (ulong)bytes[2] << 8 | bytes[3];
+    var host = ip - ip % (1UL << (32 - int.Parse(parts[1]))) + (ulong)hostnum;
+    var address = new IPAddress(new[] { (byte)(host >> 24), (byte)(host >> 16), (byte)(host >> 8), (byte)host });
+    return address.ToString();
+}
+
+	
+string CidrNetmask(string prefix)
+{
+    var mask = (1UL << 32) - (1UL << (32 - int.Parse(prefix.Split('/')[1])));
+    var address = new IPAddress(new[] { (byte)(mask >> 24), (byte)(mask >> 16), (byte)(mask >> 8), (byte)mask });
+    return address.ToString();
+}
+
+	
+string CidrSubnet(string prefix, double newbits, double netnum)
+{
+    var parts = prefix.Split('/');
+    var bytes = IPAddress.Parse(parts[0]).GetAddressBytes();
+    var ip = (ulong)bytes[0] << 24 | (ulong)bytes[1] << 16 | (ulong)bytes[2] << 8 | bytes[3];
+    var bits = int.Parse(parts[1]);
+    var length = bits + (int)newbits;
+    var subnet = ip - ip % (1UL << (32 - bits)) + ((ulong)netnum << (32 - length));
+    var address = new IPAddress(new[]
+    {
+        (byte)(subnet >> 24), (byte)(subnet >> 16), (byte)(subnet >> 8), (byte)subnet,
+    });
+    return $"{address}/{length}";
+}
+
+	
+string ComputeMD5(string input)
+{
+    var hash = MD5.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+}
+
+	
+string ComputeSHA256(string input)
+{
+    var hash = SHA256.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+}
+
+	
+string ComputeSHA512(string input)
+{
+    var hash = SHA512.Create().ComputeHash(Encoding.UTF8.GetBytes(input));
+    return BitConverter.ToString(hash).Replace("-","").ToLowerInvariant();
+}
+
+	
+string FormatString(string spec, params object[] args)
+{
+    var i = 0;
+    return Regex.Replace(spec, "%[%sdv]", verb =>
+        verb.Value == "%%" ? "%" : Convert.ToString(args[i++], CultureInfo.InvariantCulture));
+}
+
+	
+string TimeAdd(string timestamp, string duration)
+{
+    var units = new Dictionary<string, double> { ["h"] = 3600, ["m"] = 60, ["s"] = 1 };
+    var seconds = Regex.Matches(duration, @"([\d.]+)([hms])")
+        .Sum(m => double.Parse(m.Groups[1].Value, CultureInfo.InvariantCulture) * units[m.Groups[2].Value]);
+    if (duration.StartsWith("-"))
+    {
+        seconds = -seconds;
+    }
+    var time = DateTimeOffset.Parse(timestamp, CultureInfo.InvariantCulture).AddSeconds(seconds);
+    return time.UtcDateTime.ToString("yyyy-MM-dd'T'HH:mm:ss'Z'", CultureInfo.InvariantCulture);
+}
+
+	
+string TrimPrefix(string value, string prefix)
+{
+    return value.StartsWith(prefix) ? value.Substring(prefix.Length) : value;
+}
+
+	
+string TrimSuffix(string value, string suffix)
+{
+    return value.EndsWith(suffix) ? value.Substring(0, value.Length - suffix.Length) : value;
+}
+
+return await Deployment.RunAsync(() => 
+{
+    var config = new Config();
+    var cidrBlock = config.Get("cidrBlock") ?? "10.0.0.0/16";
+    var pet = new Random.RandomPet("pet");
+
+    // hashing
+    var sha256Hash = ComputeSHA256("content");
+
+    var sha512Hash = ComputeSHA512("content");
+
+    var md5Hash = pet.Id.Apply(id => ComputeMD5(id));
+
+    // strings
+    var upperName = pet.Id.Apply(id => id.ToUpperInvariant());
+
+    var lowerName = "Bucket".ToLowerInvariant();
+
+    var trimmed = "  padded  ".Trim();
+
+    var withoutPrefix = TrimPrefix("prefix-name", "prefix-");
+
+    var withoutSuffix = TrimSuffix("name-suffix", "-suffix");
+
+    var hasPrefix = pet.Id.Apply(id => id.StartsWith("my-"));
+
+    var hasSuffix = "name.txt".EndsWith(".txt");
+
+    var replaced = "a-b-c".Replace("-", "_");
+
+    var formatted = pet.Id.Apply(id => FormatString("%s-%d", id, 42));
+
+    // regular expressions
+    var isVersion = Regex.IsMatch("v42", "^v[0-9]+$");
+
+    var dashed = Regex.Replace("a1b22c333", "[0-9]+", "-");
+
+    // CIDR arithmetic
+    var gateway = CidrHost(cidrBlock, 1);
+
+    var subnet = CidrSubnet(cidrBlock, 8, 2);
+
+    var netmask = CidrNetmask(cidrBlock);
+
+    // collections
+    var merged = Merge(new Dictionary<string, object?>
+    {
+        ["a"] = "1",
+        ["b"] = "2",
+    }, new Dictionary<string, object?>
+    {
+        ["b"] = "3",
+    });
+
+    var flattened = new[]
+    {
+        new[]
+        {
+            "a",
+            "b",
+        },
+        new[]
+        {
+            "c",
+        },
+    }.SelectMany(items => items).ToList();
+
+    var mergedKeys = merged.Keys.OrderBy(key => key, StringComparer.Ordinal).ToList();
+
+    var mergedValues = merged.OrderBy(entry => entry.Key, StringComparer.Ordinal).Select(entry => entry.Value).ToList();
+
+    var smallest = new[] { 3, 1, 2 }.Min();
+
+    var largest = new[] { 3, 1, 2 }.Max();
+
+    // dates and times
+    var now = DateTime.UtcNow.ToString("yyyy-MM-dd'T'HH:mm:ss'Z'", CultureInfo.InvariantCulture);
+
+    var tomorrow = TimeAdd(now, "24h");
+
+    var prefixed = new Random.RandomPet("prefixed", new()
+    {
+        Prefix = FormatString("%s-%s", lowerName, string.Join("-", flattened)),
+        Separator = TrimPrefix("prefix-_", "prefix-"),
+        Keepers = 
+        {
+            { "gateway", gateway },
+            { "subnet", subnet },
+            { "expires", tomorrow },
+            { "pet", pet.Id },
+        },
+    });
+
+});
+
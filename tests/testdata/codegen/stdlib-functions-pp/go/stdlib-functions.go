@@ -0,0 +1,226 @@
+package main
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi-random/sdk/v4/go/random"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+)
+
+func cidrHost(prefix string, hostnum float64) string {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		panic(err.Error())
+	}
+	host := binary.BigEndian.Uint32(network.IP.To4()) + uint32(hostnum)
+	return net.IPv4(byte(host>>24), byte(host>>16), byte(host>>8), byte(host)).String()
+}
+
+func cidrNetmask(prefix string) string {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		panic(err.Error())
+	}
+	return net.IP(network.Mask).String()
+}
+
+func cidrSubnet(prefix string, newbits float64, netnum float64) string {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		panic(err.Error())
+	}
+	ones, bits := network.Mask.Size()
+	length := ones + int(newbits)
+	subnet := binary.BigEndian.Uint32(network.IP.To4()) | uint32(netnum)<<(bits-length)
+	ip := net.IPv4(byte(subnet>>24), byte(subnet>>16), byte(subnet>>8), byte(subnet))
+	return fmt.Sprintf("%v/%d", ip, length)
+}
+
+func flatten[T any](lists [][]T) []T {
+	var flattened []T
+	for _, list := range lists {
+		flattened = append(flattened, list...)
+	}
+	return flattened
+}
+
+func formatString(spec string, args ...interface{}) string {
+	return regexp.MustCompile("%[%sdv]").ReplaceAllStringFunc(spec, func(verb string) string {
+		if verb == "%%" {
+			return "%"
+		}
+		value := fmt.Sprint(args[0])
+		args = args[1:]
+		return value
+	})
+}
+
+func maxOf(values ...float64) float64 {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v > result {
+			result = v
+		}
+	}
+	return result
+}
+
+func md5Hash(input string) string {
+	hash := md5.Sum([]byte(input))
+	return hex.EncodeToString(hash[:])
+}
+
+func mergeMaps[T any](maps ...map[string]T) map[string]T {
+	merged := map[string]T{}
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func minOf(values ...float64) float64 {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
+
+func sha256Hash(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(hash[:])
+}
+
+func sha512Hash(input string) string {
+	hash := sha512.Sum512([]byte(input))
+	return hex.EncodeToString(hash[:])
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedValues[T any](m map[string]T) []T {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]T, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return values
+}
+
+func timeAdd(timestamp string, duration string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		panic(err.Error())
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		panic(err.Error())
+	}
+	return t.Add(d).UTC().Format(time.RFC3339)
+}
+
+func main() {
+	pulumi.Run(func(ctx *pulumi.Context) error {
+		cfg := config.New(ctx, "")
+		cidrBlock := "10.0.0.0/16"
+		if param := cfg.Get("cidrBlock"); param != "" {
+			cidrBlock = param
+		}
+		pet, err := random.NewRandomPet(ctx, "pet", nil)
+		if err != nil {
+			return err
+		}
+		// hashing
+		_ = sha256Hash("content")
+		_ = sha512Hash("content")
+		_ = pet.ID().ApplyT(func(id string) (pulumi.String, error) {
+			return pulumi.String(md5Hash(id)), nil
+		}).(pulumi.StringOutput)
+		// strings
+		_ = pet.ID().ApplyT(func(id string) (pulumi.String, error) {
+			return pulumi.String(strings.ToUpper(id)), nil
+		}).(pulumi.StringOutput)
+		lowerName := strings.ToLower("Bucket")
+		_ = strings.TrimSpace("  padded  ")
+		_ = strings.TrimPrefix("prefix-name", "prefix-")
+		_ = strings.TrimSuffix("name-suffix", "-suffix")
+		_ = pet.ID().ApplyT(func(id string) (pulumi.Bool, error) {
+			return pulumi.Bool(strings.HasPrefix(id, "my-")), nil
+		}).(pulumi.BoolOutput)
+		_ = strings.HasSuffix("name.txt", ".txt")
+		_ = strings.ReplaceAll("a-b-c", "-", "_")
+		_ = pet.ID().ApplyT(func(id string) (pulumi.String, error) {
+			return pulumi.String(formatString("%s-%d", id, 42)), nil
+		}).(pulumi.StringOutput)
+		// regular expressions
+		_ = regexp.MustCompile("^v[0-9]+$").MatchString("v42")
+		_ = regexp.MustCompile("[0-9]+").ReplaceAllString("a1b22c333", "-")
+		// CIDR arithmetic
+		gateway := cidrHost(cidrBlock, 1)
+		subnet := cidrSubnet(cidrBlock, 8, 2)
+		_ = cidrNetmask(cidrBlock)
+		// collections
+		merged := mergeMaps(map[string]string{
+			"a": "1",
+			"b": "2",
+		}, map[string]string{
+			"b": "3",
+		})
+		flattened := flatten([][]string{
+			[]string{
+				"a",
+				"b",
+			},
+			[]string{
+				"c",
+			},
+		})
+		_ = sortedKeys(merged)
+		_ = sortedValues(merged)
+		_ = minOf(3, 1, 2)
+		_ = maxOf(3, 1, 2)
+		// dates and times
+		now := time.Now().UTC().Format(time.RFC3339)
+		tomorrow := timeAdd(now, "24h")
+		_, err = random.NewRandomPet(ctx, "prefixed", &random.RandomPetArgs{
+			Prefix:    pulumi.String(formatString("%s-%s", lowerName, strings.Join(flattened, "-"))),
+			Separator: pulumi.String(strings.TrimPrefix("prefix-_", "prefix-")),
+			Keepers: pulumi.StringMap{
+				"gateway": pulumi.String(gateway),
+				"subnet":  pulumi.String(subnet),
+				"expires": pulumi.String(tomorrow),
+				"pet":     pet.ID(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
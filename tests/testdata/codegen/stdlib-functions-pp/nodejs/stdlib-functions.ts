@@ -0,0 +1,105 @@
+import * as pulumi from "@pulumi/pulumi";
+import * as crypto from "crypto";
+import * as random from "@pulumi/random";
+
+function cidrHost(prefix: string, hostnum: number): string {
+    const [address, bits] = prefix.split("/");
+    const ip = address.split(".").reduce((value, octet) => value * 256 + Number(octet), 0);
+    const host = ip - ip % 2 ** (32 - Number(bits)) + hostnum;
+    return [3, 2, 1, 0].map(i => Math.floor(host / 256 ** i) % 256).join(".");
+}
+
+function cidrNetmask(prefix: string): string {
+    const mask = 2 ** 32 - 2 ** (32 - Number(prefix.split("/")[1]));
+    return [3, 2, 1, 0].map(i => Math.floor(mask / 256 ** i) % 256).join(".");
+}
+
+function cidrSubnet(prefix: string, newbits: number, netnum: number): string {
+    const [address, bits] = prefix.split("/");
+    const ip = address.split(".").reduce((value, octet) => value * 256 + Number(octet), 0);
+    const length = Number(bits) + newbits;
+    const subnet = ip - ip % 2 ** (32 - Number(bits)) + netnum * 2 ** (32 - length);
+    return [3, 2, 1, 0].map(i => Math.floor(subnet / 256 ** i) % 256).join(".") + "/" + length;
+}
+
+function formatString(spec: string, ...args: any[]): string {
+    let i = 0;
+    return spec.replace(/%[%sdv]/g, verb => verb === "%%" ? "%" : String(args[i++]));
+}
+
+function timeAdd(timestamp: string, duration: string): string {
+    const units: Record<string, number> = { h: 3600000, m: 60000, s: 1000 };
+    let milliseconds = 0;
+    duration.replace(/([\d.]+)([hms])/g, (match, n, unit) => {
+        milliseconds += Number(n) * units[unit];
+        return match;
+    });
+    if (duration.startsWith("-")) {
+        milliseconds = -milliseconds;
+    }
+    return new Date(Date.parse(timestamp) + milliseconds).toISOString().replace(/\.\d+Z$/, "Z");
+}
+
+function trimPrefix(value: string, prefix: string): string {
+    return value.startsWith(prefix) ? value.slice(prefix.length) : value;
+}
+
+function trimSuffix(value: string, suffix: string): string {
+    return suffix && value.endsWith(suffix) ? value.slice(0, -suffix.length) : value;
+}
+
+const config = new pulumi.Config();
+const cidrBlock = config.get("cidrBlock") || "10.0.0.0/16";
+const pet = new random.RandomPet("pet", {});
+// hashing
+const sha256Hash = crypto.createHash('sha256').update("content").digest('hex');
+const sha512Hash = crypto.createHash('sha512').update("content").digest('hex');
+const md5Hash = pet.id.apply(id => crypto.createHash('md5').update(id).digest('hex'));
+// strings
+const upperName = pet.id.apply(id => id.toUpperCase());
+const lowerName = "Bucket".toLowerCase();
+const trimmed = "  padded  ".trim();
+const withoutPrefix = trimPrefix("prefix-name", "prefix-");
+const withoutSuffix = trimSuffix("name-suffix", "-suffix");
+const hasPrefix = pet.id.apply(id => id.startsWith("my-"));
+const hasSuffix = "name.txt".endsWith(".txt");
+const replaced = "a-b-c".split("-").join("_");
+const formatted = pet.id.apply(id => formatString("%s-%d", id, 42));
+// regular expressions
+const isVersion = new RegExp("^v[0-9]+$").test("v42");
+const dashed = "a1b22c333".replace(new RegExp("[0-9]+", "g"), "-");
+// CIDR arithmetic
+const gateway = cidrHost(cidrBlock, 1);
+const subnet = cidrSubnet(cidrBlock, 8, 2);
+const netmask = cidrNetmask(cidrBlock);
+// collections
+const merged = {...{
+    a: "1",
+    b: "2",
+}, ...{
+    b: "3",
+}};
+const flattened = [
+    [
+        "a",
+        "b",
+    ],
+    ["c"],
+].flat();
+const mergedKeys = Object.keys(merged).sort();
+const mergedValues = Object.entries(merged).sort(([a], [b]) => a < b ? -1 : 1).map(([, v]) => v);
+const smallest = Math.min(3, 1, 2);
+const largest = Math.max(3, 1, 2);
+// dates and times
+const now = new Date().toISOString().replace(/\.\d+Z$/, "Z");
+const tomorrow = timeAdd(now, "24h");
+const prefixed = new random.RandomPet("prefixed", {
+    prefix: formatString("%s-%s", lowerName, flattened.join("-")),
+    separator: trimPrefix("prefix-_", "prefix-"),
+    keepers: {
+        gateway: gateway,
+        subnet: subnet,
+        expires: tomorrow,
+        pet: pet.id,
+    },
+});
@@ -0,0 +1,87 @@
+import pulumi
+import datetime
+import hashlib
+import ipaddress
+import pulumi_random as random
+import re
+
+def cidr_host(prefix, hostnum):
+    return str(ipaddress.ip_network(prefix, strict=False)[int(hostnum)])
+
+def cidr_subnet(prefix, newbits, netnum):
+    network = ipaddress.ip_network(prefix, strict=False)
+    length = network.prefixlen + int(newbits)
+    address = int(network.network_address) + (int(netnum) << (network.max_prefixlen - length))
+    return str(ipaddress.ip_network((address, length)))
+
+def format_string(spec, *args):
+    values = iter(args)
+    return re.sub(r"%[%sdv]", lambda verb: "%" if verb.group(0) == "%%" else str(next(values)), spec)
+
+def regex_replace(value, pattern, replacement):
+    return re.sub(pattern, re.sub(r"\$(\d+)", r"\\\1", replacement), value)
+
+def time_add(timestamp, duration):
+    units = {"h": 3600, "m": 60, "s": 1}
+    seconds = sum(float(n) * units[unit] for n, unit in re.findall(r"([\d.]+)([hms])", duration))
+    if duration.startswith("-"):
+        seconds = -seconds
+    time = datetime.datetime.fromisoformat(timestamp.replace("Z", "+00:00")) + datetime.timedelta(seconds=seconds)
+    return time.astimezone(datetime.timezone.utc).strftime("%Y-%m-%dT%H:%M:%SZ")
+
+config = pulumi.Config()
+cidr_block = config.get("cidrBlock")
+if cidr_block is None:
+    cidr_block = "10.0.0.0/16"
+pet = random.RandomPet("pet")
+# hashing
+sha256_hash = hashlib.sha256("content".encode()).hexdigest()
+sha512_hash = hashlib.sha512("content".encode()).hexdigest()
+md5_hash = pet.id.apply(lambda id: hashlib.md5(id.encode()).hexdigest())
+# strings
+upper_name = pet.id.apply(lambda id: id.upper())
+lower_name = "Bucket".lower()
+trimmed = "  padded  ".strip()
+without_prefix = (lambda value, prefix: value[len(prefix):] if value.startswith(prefix) else value)("prefix-name", "prefix-")
+without_suffix = (lambda value, suffix: value[:-len(suffix)] if suffix and value.endswith(suffix) else value)("name-suffix", "-suffix")
+has_prefix = pet.id.apply(lambda id: id.startswith("my-"))
+has_suffix = "name.txt".endswith(".txt")
+replaced = "a-b-c".replace("-", "_")
+formatted = pet.id.apply(lambda id: format_string("%s-%d", id, 42))
+# regular expressions
+is_version = bool(re.search("^v[0-9]+$", "v42"))
+dashed = regex_replace("a1b22c333", "[0-9]+", "-")
+# CIDR arithmetic
+gateway = cidr_host(cidr_block, 1)
+subnet = cidr_subnet(cidr_block, 8, 2)
+netmask = str(ipaddress.ip_network(cidr_block, strict=False).netmask)
+# collections
+merged = {**{
+    "a": "1",
+    "b": "2",
+}, **{
+    "b": "3",
+}}
+flattened = [item for items in [
+    [
+        "a",
+        "b",
+    ],
+    ["c"],
+] for item in items]
+merged_keys = sorted(merged)
+merged_values = [v for _, v in sorted(merged.items())]
+smallest = min(3, 1, 2)
+largest = max(3, 1, 2)
+# dates and times
+now = datetime.datetime.now(datetime.timezone.utc).strftime("%Y-%m-%dT%H:%M:%SZ")
+tomorrow = time_add(now, "24h")
+prefixed = random.RandomPet("prefixed",
+    prefix=format_string("%s-%s", lower_name, "-".join(flattened)),
+    separator=(lambda value, prefix: value[len(prefix):] if value.startswith(prefix) else value)("prefix-_", "prefix-"),
+    keepers={
+        "gateway": gateway,
+        "subnet": subnet,
+        "expires": tomorrow,
+        "pet": pet.id,
+    })
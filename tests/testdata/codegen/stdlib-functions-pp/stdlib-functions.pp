@@ -0,0 +1,53 @@
+config cidrBlock string {
+	default = "10.0.0.0/16"
+}
+
+resource pet "random:index/randomPet:RandomPet" { }
+
+# hashing
+sha256Hash = sha256("content")
+sha512Hash = sha512("content")
+md5Hash = md5(pet.id)
+
+# strings
+upperName = upper(pet.id)
+lowerName = lower("Bucket")
+trimmed = trimSpace("  padded  ")
+withoutPrefix = trimPrefix("prefix-name", "prefix-")
+withoutSuffix = trimSuffix("name-suffix", "-suffix")
+hasPrefix = startsWith(pet.id, "my-")
+hasSuffix = endsWith("name.txt", ".txt")
+replaced = replace("a-b-c", "-", "_")
+formatted = format("%s-%d", pet.id, 42)
+
+# regular expressions
+isVersion = regexMatch("^v[0-9]+$", "v42")
+dashed = regexReplace("a1b22c333", "[0-9]+", "-")
+
+# CIDR arithmetic
+gateway = cidrHost(cidrBlock, 1)
+subnet = cidrSubnet(cidrBlock, 8, 2)
+netmask = cidrNetmask(cidrBlock)
+
+# collections
+merged = merge({ "a" = "1", "b" = "2" }, { "b" = "3" })
+flattened = flatten([["a", "b"], ["c"]])
+mergedKeys = keys(merged)
+mergedValues = values(merged)
+smallest = min(3, 1, 2)
+largest = max(3, 1, 2)
+
+# dates and times
+now = timestamp()
+tomorrow = timeAdd(now, "24h")
+
+resource prefixed "random:index/randomPet:RandomPet" {
+	prefix = format("%s-%s", lowerName, join("-", flattened))
+	separator = trimPrefix("prefix-_", "prefix-")
+	keepers = {
+		"gateway" = gateway
+		"subnet" = subnet
+		"expires" = tomorrow
+		"pet" = pet.id
+	}
+}
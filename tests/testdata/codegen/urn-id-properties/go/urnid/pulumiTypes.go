@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"urn-id-properties/urnid/internal"
 )
 
@@ -34,57 +35,20 @@ func (o InnerTypeOutput) ToInnerTypeOutputWithContext(ctx context.Context) Inner
 	return o
 }
 
-func (o InnerTypeOutput) Id() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v InnerType) *string { return v.Id }).(pulumi.StringPtrOutput)
+func (o InnerTypeOutput) ToOutput(ctx context.Context) pulumix.Output[InnerType] {
+	return pulumix.Output[InnerType]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o InnerTypeOutput) Urn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v InnerType) *string { return v.Urn }).(pulumi.StringPtrOutput)
+func (o InnerTypeOutput) Id() pulumix.Output[*string] {
+	return pulumix.Apply[InnerType](o, func(v InnerType) *string { return v.Id })
 }
 
-type InnerTypePtrOutput struct{ *pulumi.OutputState }
-
-func (InnerTypePtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**InnerType)(nil)).Elem()
-}
-
-func (o InnerTypePtrOutput) ToInnerTypePtrOutput() InnerTypePtrOutput {
-	return o
-}
-
-func (o InnerTypePtrOutput) ToInnerTypePtrOutputWithContext(ctx context.Context) InnerTypePtrOutput {
-	return o
-}
-
-func (o InnerTypePtrOutput) Elem() InnerTypeOutput {
-	return o.ApplyT(func(v *InnerType) InnerType {
-		if v != nil {
-			return *v
-		}
-		var ret InnerType
-		return ret
-	}).(InnerTypeOutput)
-}
-
-func (o InnerTypePtrOutput) Id() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *InnerType) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Id
-	}).(pulumi.StringPtrOutput)
-}
-
-func (o InnerTypePtrOutput) Urn() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *InnerType) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Urn
-	}).(pulumi.StringPtrOutput)
+func (o InnerTypeOutput) Urn() pulumix.Output[*string] {
+	return pulumix.Apply[InnerType](o, func(v InnerType) *string { return v.Urn })
 }
 
 func init() {
 	pulumi.RegisterOutputType(InnerTypeOutput{})
-	pulumi.RegisterOutputType(InnerTypePtrOutput{})
 }
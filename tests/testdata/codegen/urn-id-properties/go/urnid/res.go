@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"urn-id-properties/urnid/internal"
 )
 
@@ -15,7 +16,7 @@ import (
 type Res struct {
 	pulumi.CustomResourceState
 
-	Output InnerTypePtrOutput `pulumi:"output"`
+	Output pulumix.GPtrOutput[InnerType, InnerTypeOutput] `pulumi:"output"`
 }
 
 // NewRes registers a new resource with the given unique name, arguments, and options.
@@ -64,37 +65,18 @@ type resArgs struct {
 
 // The set of arguments for constructing a Res resource.
 type ResArgs struct {
-	Id  pulumi.StringPtrInput
-	Urn pulumi.StringPtrInput
+	Id  pulumix.Input[*string]
+	Urn pulumix.Input[*string]
 }
 
 func (ResArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*resArgs)(nil)).Elem()
 }
 
-type ResInput interface {
-	pulumi.Input
-
-	ToResOutput() ResOutput
-	ToResOutputWithContext(ctx context.Context) ResOutput
-}
-
-func (*Res) ElementType() reflect.Type {
-	return reflect.TypeOf((**Res)(nil)).Elem()
-}
-
-func (i *Res) ToResOutput() ResOutput {
-	return i.ToResOutputWithContext(context.Background())
-}
-
-func (i *Res) ToResOutputWithContext(ctx context.Context) ResOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ResOutput)
-}
-
 type ResOutput struct{ *pulumi.OutputState }
 
 func (ResOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Res)(nil)).Elem()
+	return reflect.TypeOf((*Res)(nil)).Elem()
 }
 
 func (o ResOutput) ToResOutput() ResOutput {
@@ -105,11 +87,18 @@ func (o ResOutput) ToResOutputWithContext(ctx context.Context) ResOutput {
 	return o
 }
 
-func (o ResOutput) Output() InnerTypePtrOutput {
-	return o.ApplyT(func(v *Res) InnerTypePtrOutput { return v.Output }).(InnerTypePtrOutput)
+func (o ResOutput) ToOutput(ctx context.Context) pulumix.Output[Res] {
+	return pulumix.Output[Res]{
+		OutputState: o.OutputState,
+	}
+}
+
+func (o ResOutput) Output() pulumix.GPtrOutput[InnerType, InnerTypeOutput] {
+	value := pulumix.Apply[Res](o, func(v Res) pulumix.GPtrOutput[InnerType, InnerTypeOutput] { return v.Output })
+	unwrapped := pulumix.Flatten[*InnerType, pulumix.GPtrOutput[InnerType, InnerTypeOutput]](value)
+	return pulumix.GPtrOutput[InnerType, InnerTypeOutput]{OutputState: unwrapped.OutputState}
 }
 
 func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ResInput)(nil)).Elem(), &Res{})
 	pulumi.RegisterOutputType(ResOutput{})
 }
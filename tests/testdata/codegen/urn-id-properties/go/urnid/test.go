@@ -8,6 +8,7 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumix"
 	"urn-id-properties/urnid/internal"
 )
 
@@ -33,25 +34,28 @@ type TestResult struct {
 }
 
 func TestOutput(ctx *pulumi.Context, args TestOutputArgs, opts ...pulumi.InvokeOption) TestResultOutput {
-	return pulumi.ToOutputWithContext(context.Background(), args).
-		ApplyT(func(v interface{}) (TestResult, error) {
-			args := v.(TestArgs)
-			r, err := Test(ctx, &args, opts...)
-			var s TestResult
-			if r != nil {
-				s = *r
-			}
-			return s, err
-		}).(TestResultOutput)
+	outputResult := pulumix.ApplyErr[*TestArgs](args.ToOutput(), func(plainArgs *TestArgs) (*TestResult, error) {
+		return Test(ctx, plainArgs, opts...)
+	})
+
+	return pulumix.Cast[TestResultOutput, *TestResult](outputResult)
 }
 
 type TestOutputArgs struct {
-	Id  pulumi.Float64Input `pulumi:"id"`
-	Urn pulumi.Float64Input `pulumi:"urn"`
+	Id  pulumix.Input[float64] `pulumi:"id"`
+	Urn pulumix.Input[float64] `pulumi:"urn"`
 }
 
-func (TestOutputArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*TestArgs)(nil)).Elem()
+func (args TestOutputArgs) ToOutput() pulumix.Output[*TestArgs] {
+	allArgs := pulumix.All(
+		args.Id.ToOutput(context.Background()).AsAny(),
+		args.Urn.ToOutput(context.Background()).AsAny())
+	return pulumix.Apply[[]any](allArgs, func(resolvedArgs []interface{}) *TestArgs {
+		return &TestArgs{
+			Id:  resolvedArgs[0].(float64),
+			Urn: resolvedArgs[1].(float64),
+		}
+	})
 }
 
 type TestResultOutput struct{ *pulumi.OutputState }
@@ -60,22 +64,16 @@ func (TestResultOutput) ElementType() reflect.Type {
 	return reflect.TypeOf((*TestResult)(nil)).Elem()
 }
 
-func (o TestResultOutput) ToTestResultOutput() TestResultOutput {
-	return o
-}
-
-func (o TestResultOutput) ToTestResultOutputWithContext(ctx context.Context) TestResultOutput {
-	return o
-}
-
-func (o TestResultOutput) Id() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v TestResult) *float64 { return v.Id }).(pulumi.Float64PtrOutput)
+func (o TestResultOutput) ToOutput(context.Context) pulumix.Output[*TestResult] {
+	return pulumix.Output[*TestResult]{
+		OutputState: o.OutputState,
+	}
 }
 
-func (o TestResultOutput) Urn() pulumi.Float64Output {
-	return o.ApplyT(func(v TestResult) float64 { return v.Urn }).(pulumi.Float64Output)
+func (o TestResultOutput) Id() pulumix.Output[*float64] {
+	return pulumix.Apply[*TestResult](o, func(v *TestResult) *float64 { return v.Id })
 }
 
-func init() {
-	pulumi.RegisterOutputType(TestResultOutput{})
+func (o TestResultOutput) Urn() pulumix.Output[float64] {
+	return pulumix.Apply[*TestResult](o, func(v *TestResult) float64 { return v.Urn })
 }
@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
@@ -87,12 +88,81 @@ func (p *echoResourceProvider) Create(ctx context.Context, req *rpc.CreateReques
 }
 
 func (p *echoResourceProvider) Read(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	if len(req.GetProperties().GetFields()) == 0 {
+		// A resource found by List is being imported, so return its state and inputs.
+		for _, listed := range listedEchoes {
+			if listed.id != req.Id {
+				continue
+			}
+			properties, err := plugin.MarshalProperties(resource.PropertyMap{
+				"echo": resource.NewStringProperty(listed.echo),
+			}, plugin.MarshalOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return &rpc.ReadResponse{
+				Id:         req.Id,
+				Properties: properties,
+				Inputs:     properties,
+			}, nil
+		}
+	}
+
 	return &rpc.ReadResponse{
 		Id:         req.Id,
 		Properties: req.Properties,
 	}, nil
 }
 
+// listedEchoes are the existing echo resources that List reports, for testing discovery imports.
+var listedEchoes = []struct {
+	id, name, parent, echo string
+}{
+	{id: "echo-a", name: "a", echo: "alpha"},
+	{id: "echo-b", name: "b", parent: "echo-a", echo: "beta"},
+	{id: "echo-c", name: "c", echo: "gamma"},
+}
+
+// List returns the listed echoes two at a time, to exercise pagination.
+func (p *echoResourceProvider) List(ctx context.Context, req *rpc.ListRequest) (*rpc.ListResponse, error) {
+	start := 0
+	if token := req.GetPageToken(); token != "" {
+		n, err := strconv.Atoi(token)
+		if err != nil || n < 0 || n > len(listedEchoes) {
+			return nil, fmt.Errorf("invalid page token '%s'", token)
+		}
+		start = n
+	}
+	end := start + 2
+	if end > len(listedEchoes) {
+		end = len(listedEchoes)
+	}
+
+	resp := &rpc.ListResponse{}
+	for _, listed := range listedEchoes[start:end] {
+		properties, err := plugin.MarshalProperties(resource.PropertyMap{
+			"echo": resource.NewStringProperty(listed.echo),
+		}, plugin.MarshalOptions{})
+		if err != nil {
+			return nil, err
+		}
+		res := &rpc.ListedResource{
+			Id:         listed.id,
+			Name:       listed.name,
+			Properties: properties,
+		}
+		if listed.parent != "" {
+			res.ParentType = req.GetType()
+			res.ParentId = listed.parent
+		}
+		resp.Resources = append(resp.Resources, res)
+	}
+	if end < len(listedEchoes) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
 func (p *echoResourceProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
 	panic("Update not implemented")
 }
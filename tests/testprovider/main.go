@@ -26,6 +26,8 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	rpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -44,6 +46,11 @@ type resourceProvider interface {
 	Delete(ctx context.Context, req *rpc.DeleteRequest) (*emptypb.Empty, error)
 }
 
+// Optional method for resource providers whose existing resources can be enumerated.
+type resourceLister interface {
+	List(ctx context.Context, req *rpc.ListRequest) (*rpc.ListResponse, error)
+}
+
 var resourceProviders = map[string]resourceProvider{
 	"testprovider:index:Random":        &randomResourceProvider{},
 	"testprovider:index:Echo":          &echoResourceProvider{},
@@ -153,6 +160,19 @@ func (k *testproviderProvider) Read(ctx context.Context, req *rpc.ReadRequest) (
 	return provider.Read(ctx, req)
 }
 
+// List enumerates the existing resources of a type.
+func (k *testproviderProvider) List(ctx context.Context, req *rpc.ListRequest) (*rpc.ListResponse, error) {
+	provider, ok := resourceProviders[req.GetType()]
+	if !ok {
+		return nil, fmt.Errorf("Unknown resource type '%s'", req.GetType())
+	}
+	lister, ok := provider.(resourceLister)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "resources of type '%s' cannot be listed", req.GetType())
+	}
+	return lister.List(ctx, req)
+}
+
 // Update updates an existing resource with new values.
 func (k *testproviderProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
 	provider, ty, ok := providerForURN(req.GetUrn())